@@ -111,7 +111,7 @@ func (o *Operator) connect() error {
 		rpcs = make(map[uint64]string)
 	}
 
-	opts := []sdk.Option{}
+	opts := []sdk.Option{sdk.WithReconnect(sdk.DefaultReconnectConfig)}
 	for chainID, rpcURL := range rpcs {
 		opts = append(opts, sdk.WithBlockchainRPC(chainID, rpcURL))
 	}
@@ -129,7 +129,7 @@ func (o *Operator) connect() error {
 		if o.client != client {
 			return // replaced by reconnect, ignore
 		}
-		fmt.Println("\nWARNING: WebSocket connection lost. Exiting...")
+		fmt.Println("\nWARNING: WebSocket connection lost and could not be restored. Exiting...")
 		select {
 		case <-o.exitCh:
 		default:
@@ -30,11 +30,14 @@ func TestCreateAppSession_Success(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xnode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -134,11 +137,14 @@ func TestCreateAppSession_QuorumWithMultipleSignatures(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xnode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -236,11 +242,14 @@ func TestCreateAppSession_ZeroNonce(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xnode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -300,11 +309,14 @@ func TestCreateAppSession_QuorumExceedsTotalWeights(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xnode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -370,11 +382,14 @@ func TestCreateAppSession_NoSignatures(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xnode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -434,11 +449,14 @@ func TestCreateAppSession_SignatureFromNonParticipant(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xnode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -516,11 +534,14 @@ func TestCreateAppSession_QuorumNotMet(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xnode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -612,11 +633,14 @@ func TestCreateAppSession_DuplicateSignatures(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xnode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -705,11 +729,14 @@ func TestCreateAppSession_InvalidSignatureHex(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xnode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -774,11 +801,14 @@ func TestCreateAppSession_SignatureRecoveryFailure(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xnode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -843,11 +873,14 @@ func TestCreateAppSession_AppNotRegistered(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xnode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -912,11 +945,14 @@ func TestCreateAppSession_OwnerSigRequired(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xnode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -988,11 +1024,14 @@ func TestCreateAppSession_OwnerSigSuccess(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xnode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -0,0 +1,35 @@
+package app_session_v1
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/layer-3/nitrolite/pkg/app"
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// publishAppSessionUpdated notifies the subscribers of every participant that the app session
+// has advanced to a new version. The allocations are the ones agreed upon in the applied update.
+func (h *Handler) publishAppSessionUpdated(ctx context.Context, session app.AppSessionV1, allocations []app.AppAllocationV1) {
+	allocationsMap := make(map[string]map[string]decimal.Decimal)
+	for _, alloc := range allocations {
+		if allocationsMap[alloc.Participant] == nil {
+			allocationsMap[alloc.Participant] = make(map[string]decimal.Decimal)
+		}
+		allocationsMap[alloc.Participant][alloc.Asset] = alloc.Amount
+	}
+
+	payload, err := rpc.NewPayload(rpc.AppSessionsV1AppSessionUpdatedEvent{
+		AppSession: mapAppSessionInfoV1(session, allocationsMap),
+	})
+	if err != nil {
+		log.FromContext(ctx).Error("failed to create event payload", "error", err, "appSessionID", session.SessionID)
+		return
+	}
+
+	for _, participant := range session.Participants {
+		h.notifier.Notify(participant.WalletAddress, rpc.AppSessionsV1AppSessionUpdatedEventName, payload)
+	}
+}
@@ -2,6 +2,7 @@ package app_session_v1
 
 import (
 	"context"
+	"sort"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -20,11 +21,14 @@ type Handler struct {
 	useStoreInTx     StoreTxProvider
 	assetStore       AssetStore
 	actionGateway    ActionGateway
+	feeEngine        FeeEngine
 	signer           sign.Signer
 	stateAdvancer    core.StateAdvancer
 	statePacker      core.StatePacker
 	nodeAddress      string // Node's wallet address
 	metrics          metrics.RuntimeMetricExporter
+	notifier         Notifier
+	stateValidators  AppStateValidatorRegistry
 	maxParticipants  int
 	maxSessionData   int
 	maxSessionKeyIDs int
@@ -36,22 +40,28 @@ func NewHandler(
 	useStoreInTx StoreTxProvider,
 	assetStore AssetStore,
 	actionGateway ActionGateway,
+	feeEngine FeeEngine,
 	signer sign.Signer,
 	stateAdvancer core.StateAdvancer,
 	statePacker core.StatePacker,
 	nodeAddress string,
 	m metrics.RuntimeMetricExporter,
+	notifier Notifier,
+	stateValidators AppStateValidatorRegistry,
 	maxParticipants, maxSessionData, maxSessionKeyIDs, maxSignedUpdates int,
 ) *Handler {
 	return &Handler{
 		useStoreInTx:     useStoreInTx,
 		assetStore:       assetStore,
 		actionGateway:    actionGateway,
+		feeEngine:        feeEngine,
 		signer:           signer,
 		stateAdvancer:    stateAdvancer,
 		statePacker:      statePacker,
 		nodeAddress:      nodeAddress,
 		metrics:          m,
+		notifier:         notifier,
+		stateValidators:  stateValidators,
 		maxParticipants:  maxParticipants,
 		maxSessionData:   maxSessionData,
 		maxSessionKeyIDs: maxSessionKeyIDs,
@@ -106,9 +116,48 @@ func (h *Handler) verifyQuorum(tx Store, appSessionId, applicationID string, par
 	return nil
 }
 
+// validateAppStateUpdate runs the validator attached to the session's application, if any,
+// against the previous session state and the proposed update.
+// currentAllocations are passed to the validator as a list sorted by participant and asset.
+func (h *Handler) validateAppStateUpdate(appSession app.AppSessionV1, currentAllocations map[string]map[string]decimal.Decimal, appStateUpd app.AppStateUpdateV1) error {
+	if h.stateValidators == nil {
+		return nil
+	}
+	validator, ok := h.stateValidators.Get(appSession.ApplicationID)
+	if !ok {
+		return nil
+	}
+
+	allocations := make([]app.AppAllocationV1, 0)
+	for participant, assets := range currentAllocations {
+		for asset, amount := range assets {
+			if amount.IsZero() {
+				continue
+			}
+			allocations = append(allocations, app.AppAllocationV1{
+				Participant: participant,
+				Asset:       asset,
+				Amount:      amount,
+			})
+		}
+	}
+	sort.Slice(allocations, func(i, j int) bool {
+		if allocations[i].Participant != allocations[j].Participant {
+			return allocations[i].Participant < allocations[j].Participant
+		}
+		return allocations[i].Asset < allocations[j].Asset
+	})
+
+	if err := validator.ValidateAppStateUpdate(appSession, allocations, appStateUpd); err != nil {
+		return rpc.Errorf("app state update rejected by application %s: %v", appSession.ApplicationID, err)
+	}
+	return nil
+}
+
 // issueReleaseReceiverState creates a new channel state for a participant receiving funds from app session.
-// This follows the same pattern as issueTransferReceiverState in channel_v1 for transfer_receive transitions.
-func (h *Handler) issueReleaseReceiverState(ctx context.Context, tx Store, receiverWallet, asset, appSessionID string, amount decimal.Decimal) error {
+// This follows the same pattern as issueReceiverState in channel_v1 for transfer_receive transitions.
+// The fee is deducted from the released amount and recorded as a fee transaction from the app session.
+func (h *Handler) issueReleaseReceiverState(ctx context.Context, tx Store, receiverWallet, asset, appSessionID string, amount, fee decimal.Decimal) error {
 	logger := log.FromContext(ctx)
 
 	// Lock the receiver's state to prevent concurrent modifications
@@ -130,13 +179,14 @@ func (h *Handler) issueReleaseReceiverState(ctx context.Context, tx Store, recei
 		WithKV("asset", asset)
 
 	// Create next state and apply release transition
-	newState := currentState.NextState()
+	newState := currentState.NextNodeIssuedState()
 	logger.Debug("issuing app session receiver state",
 		"stateVersion", newState.Version,
 		"appSessionID", appSessionID,
-		"amount", amount.String())
+		"amount", amount.String(),
+		"fee", fee.String())
 
-	releaseTransition, err := newState.ApplyReleaseTransition(appSessionID, amount)
+	releaseTransition, err := newState.ApplyReleaseTransition(appSessionID, amount.Sub(fee))
 	if err != nil {
 		return rpc.Errorf("failed to apply release transition: %v", err)
 	}
@@ -147,19 +197,7 @@ func (h *Handler) issueReleaseReceiverState(ctx context.Context, tx Store, recei
 		return rpc.Errorf("failed to get last signed state: %v", err)
 	}
 
-	// TODO: move to DB query
-	shouldSign := true
-	if lastSignedState != nil {
-		lastStateTransition := lastSignedState.Transition
-
-		if lastStateTransition.Type == core.TransitionTypeMutualLock ||
-			lastStateTransition.Type == core.TransitionTypeEscrowLock {
-			shouldSign = false
-		}
-
-	}
-
-	if newState.HomeChannelID != nil && shouldSign {
+	if newState.HomeChannelID != nil && (lastSignedState == nil || !lastSignedState.DefersNodeSignatures()) {
 		// Pack and sign the state
 		packedState, err := h.statePacker.PackState(*newState)
 		if err != nil {
@@ -196,6 +234,17 @@ func (h *Handler) issueReleaseReceiverState(ctx context.Context, tx Store, recei
 		"asset", transaction.Asset,
 		"amount", transaction.Amount.String())
 
+	if fee.IsPositive() {
+		feeTransaction, err := core.NewFeeTransaction(asset, appSessionID, h.feeEngine.FeeAccount(), newState.ID, fee)
+		if err != nil {
+			return rpc.Errorf("failed to create fee transaction: %v", err)
+		}
+		if err := tx.RecordTransaction(*feeTransaction); err != nil {
+			return rpc.Errorf("failed to record fee transaction: %v", err)
+		}
+		logger.Info("recorded withdrawal fee", "txID", feeTransaction.ID, "fee", fee.String())
+	}
+
 	logger.Info("issued app session receiver state",
 		"stateVersion", newState.Version,
 		"appSessionID", appSessionID,
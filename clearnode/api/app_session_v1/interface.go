@@ -4,6 +4,7 @@ import (
 	"github.com/layer-3/nitrolite/clearnode/action_gateway"
 	"github.com/layer-3/nitrolite/pkg/app"
 	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
 	"github.com/shopspring/decimal"
 )
 
@@ -55,6 +56,14 @@ type ActionGateway interface {
 	AllowAction(tx action_gateway.Store, userAddress string, gatedAction core.GatedAction) error
 }
 
+type FeeEngine interface {
+	// CalculateFee returns the fee charged for the gated action on the amount of the asset.
+	CalculateFee(asset string, action core.GatedAction, amount decimal.Decimal) decimal.Decimal
+
+	// FeeAccount returns the account the collected fees are credited to.
+	FeeAccount() string
+}
+
 // StoreTxHandler is a function that executes Store operations within a transaction.
 // If the handler returns an error, the transaction is rolled back; otherwise it's committed.
 type StoreTxHandler func(Store) error
@@ -79,6 +88,24 @@ type SigType string
 // validator, used for Ethereum-style signature verification.
 const EcdsaSigType SigType = "ecdsa"
 
+// Notifier manages event subscriptions of RPC connections and delivers server-push events to them.
+type Notifier interface {
+	// Subscribe registers the connection to receive events of the group concerning the user.
+	Subscribe(connID string, group rpc.Group, userID string) error
+
+	// Unsubscribe removes the connection's subscription to events of the group concerning the user.
+	Unsubscribe(connID string, group rpc.Group, userID string)
+
+	// Notify sends the event to all connections subscribed to the user's events of the event group.
+	Notify(userID string, event rpc.Event, params rpc.Payload)
+}
+
+// AppStateValidatorRegistry looks up the application-specific validators of app session state updates.
+type AppStateValidatorRegistry interface {
+	// Get returns the validator attached to the application, if any.
+	Get(applicationID string) (app.AppStateValidatorV1, bool)
+}
+
 type AssetStore interface {
 	// GetAssetDecimals checks if an asset exists and returns its decimals in YN
 	GetAssetDecimals(asset string) (uint8, error)
@@ -75,6 +75,7 @@ func (h *Handler) RebalanceAppSessions(c *rpc.Context) {
 	}
 
 	var batchID string
+	updatedSessions := make([]app.AppSessionV1, 0, len(updates))
 	err := h.useStoreInTx(func(tx Store) error {
 		// Generate deterministic batch ID from session IDs and versions
 		sessionVersions := make([]app.AppSessionVersionV1, len(updates))
@@ -147,6 +148,10 @@ func (h *Handler) RebalanceAppSessions(c *rpc.Context) {
 				return rpc.Errorf("failed to get current allocations for session %s: %v", update.AppStateUpdate.AppSessionID, err)
 			}
 
+			if err := h.validateAppStateUpdate(*appSession, currentAllocations, update.AppStateUpdate); err != nil {
+				return err
+			}
+
 			// Build map of new allocations
 			newAllocations := make(map[string]map[string]decimal.Decimal) // participant -> asset -> amount
 			for _, alloc := range update.AppStateUpdate.Allocations {
@@ -216,6 +221,7 @@ func (h *Handler) RebalanceAppSessions(c *rpc.Context) {
 			if err := tx.UpdateAppSession(*appSession); err != nil {
 				return rpc.Errorf("failed to update app session %s: %v", update.AppStateUpdate.AppSessionID, err)
 			}
+			updatedSessions = append(updatedSessions, *appSession)
 		}
 
 		// Validate conservation: sum of changes must be zero for each asset
@@ -331,4 +337,7 @@ func (h *Handler) RebalanceAppSessions(c *rpc.Context) {
 	}
 
 	c.Succeed(c.Request.Method, payload)
+	for i, session := range updatedSessions {
+		h.publishAppSessionUpdated(ctx, session, updates[i].AppStateUpdate.Allocations)
+	}
 }
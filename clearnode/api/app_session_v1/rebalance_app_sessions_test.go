@@ -44,11 +44,14 @@ func TestRebalanceAppSessions_Success_TwoSessions(t *testing.T) {
 		storeTxProvider,
 		nil,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		nil,
 		nil,
 		nil,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -215,11 +218,14 @@ func TestRebalanceAppSessions_Success_MultiAsset(t *testing.T) {
 		storeTxProvider,
 		nil,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		nil,
 		nil,
 		nil,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -375,11 +381,14 @@ func TestRebalanceAppSessions_Error_InsufficientSessions(t *testing.T) {
 		storeTxProvider,
 		nil,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		nil,
 		nil,
 		nil,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -433,11 +442,14 @@ func TestRebalanceAppSessions_Error_InvalidIntent(t *testing.T) {
 		storeTxProvider,
 		nil,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		nil,
 		nil,
 		nil,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -507,11 +519,14 @@ func TestRebalanceAppSessions_Error_DuplicateSession(t *testing.T) {
 		storeTxProvider,
 		nil,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		nil,
 		nil,
 		nil,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -583,11 +598,14 @@ func TestRebalanceAppSessions_Error_ConservationViolation(t *testing.T) {
 		storeTxProvider,
 		nil,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		nil,
 		nil,
 		nil,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -729,11 +747,14 @@ func TestRebalanceAppSessions_Error_SessionNotFound(t *testing.T) {
 		storeTxProvider,
 		nil,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		nil,
 		nil,
 		nil,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -810,11 +831,14 @@ func TestRebalanceAppSessions_Error_ClosedSession(t *testing.T) {
 		storeTxProvider,
 		nil,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		nil,
 		nil,
 		nil,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -905,11 +929,14 @@ func TestRebalanceAppSessions_Error_InvalidVersion(t *testing.T) {
 		storeTxProvider,
 		nil,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		nil,
 		nil,
 		nil,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -53,6 +53,7 @@ func (h *Handler) SubmitAppState(c *rpc.Context) {
 		return
 	}
 
+	var updatedSession *app.AppSessionV1
 	err = h.useStoreInTx(func(tx Store) error {
 		appSession, err := tx.GetAppSession(appStateUpd.AppSessionID)
 		if err != nil {
@@ -105,6 +106,10 @@ func (h *Handler) SubmitAppState(c *rpc.Context) {
 			return rpc.Errorf("failed to get current allocations: %v", err)
 		}
 
+		if err := h.validateAppStateUpdate(*appSession, currentAllocations, appStateUpd); err != nil {
+			return err
+		}
+
 		// Handle different intents
 		switch appStateUpd.Intent {
 		case app.AppStateUpdateIntentOperate:
@@ -137,6 +142,7 @@ func (h *Handler) SubmitAppState(c *rpc.Context) {
 		if err := tx.UpdateAppSession(*appSession); err != nil {
 			return rpc.Errorf("failed to update app session: %v", err)
 		}
+		updatedSession = appSession
 
 		logger.Info("processed app state update",
 			"appSessionID", appSession.SessionID,
@@ -162,6 +168,7 @@ func (h *Handler) SubmitAppState(c *rpc.Context) {
 	}
 
 	c.Succeed(c.Request.Method, payload)
+	h.publishAppSessionUpdated(ctx, *updatedSession, appStateUpd.Allocations)
 }
 
 // handleOperateIntent processes operate intent by validating total allocations and recording ledger changes.
@@ -371,8 +378,13 @@ func (h *Handler) handleWithdrawIntent(
 					return rpc.Errorf("invalid withdraw amount for allocation with asset %s and participant %s: %w", asset, participant, err)
 				}
 
+				fee := h.feeEngine.CalculateFee(asset, core.GatedActionAppSessionWithdrawal, withdrawAmount)
+				if fee.IsPositive() && fee.GreaterThanOrEqual(withdrawAmount) {
+					return rpc.Errorf("withdraw amount %s for participant %s does not cover the withdrawal fee %s", withdrawAmount.String(), participant, fee.String())
+				}
+
 				// Issue new channel state for participant receiving withdrawn funds
-				if err := h.issueReleaseReceiverState(ctx, tx, participant, asset, appStateUpd.AppSessionID, withdrawAmount); err != nil {
+				if err := h.issueReleaseReceiverState(ctx, tx, participant, asset, appStateUpd.AppSessionID, withdrawAmount, fee); err != nil {
 					return rpc.Errorf("failed to issue release state for participant %s: %v", participant, err)
 				}
 			}
@@ -459,8 +471,14 @@ func (h *Handler) handleCloseIntent(
 				return rpc.Errorf("failed to record close ledger entry: %v", err)
 			}
 
+			// Allocations that do not cover the withdrawal fee are released in full
+			fee := h.feeEngine.CalculateFee(asset, core.GatedActionAppSessionWithdrawal, amount)
+			if fee.GreaterThanOrEqual(amount) {
+				fee = decimal.Zero
+			}
+
 			// Issue new channel state for participant receiving funds back
-			if err := h.issueReleaseReceiverState(ctx, tx, participant, asset, appStateUpd.AppSessionID, amount); err != nil {
+			if err := h.issueReleaseReceiverState(ctx, tx, participant, asset, appStateUpd.AppSessionID, amount, fee); err != nil {
 				return rpc.Errorf("failed to issue release state for participant %s: %v", participant, err)
 			}
 		}
@@ -25,16 +25,20 @@ func TestSubmitAppState_OperateIntent_NoRedistribution_Success(t *testing.T) {
 	mockSigner := NewMockSigner()
 	mockAssetStore := new(MockAssetStore)
 	mockStatePacker := new(MockStatePacker)
+	mockNotifier := &MockNotifier{}
 
 	handler := NewHandler(
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		mockNotifier,
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -127,6 +131,17 @@ func TestSubmitAppState_OperateIntent_NoRedistribution_Success(t *testing.T) {
 	}
 	assert.Equal(t, rpc.MsgTypeResp, ctx.Response.Type)
 
+	// Every participant is notified about the new version
+	require.Len(t, mockNotifier.Notifications, 2)
+	assert.Equal(t, participant1, mockNotifier.Notifications[0].UserID)
+	assert.Equal(t, participant2, mockNotifier.Notifications[1].UserID)
+	var event rpc.AppSessionsV1AppSessionUpdatedEvent
+	require.NoError(t, mockNotifier.Notifications[0].Params.Translate(&event))
+	assert.Equal(t, rpc.AppSessionsV1AppSessionUpdatedEventName, mockNotifier.Notifications[0].Event)
+	assert.Equal(t, appSessionID, event.AppSession.AppSessionID)
+	assert.Equal(t, "2", event.AppSession.Version)
+	assert.Len(t, event.AppSession.Allocations, 2)
+
 	mockStore.AssertExpectations(t)
 }
 
@@ -145,11 +160,14 @@ func TestSubmitAppState_OperateIntent_WithRedistribution_Success(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -266,11 +284,14 @@ func TestSubmitAppState_WithdrawIntent_Success(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -364,6 +385,144 @@ func TestSubmitAppState_WithdrawIntent_Success(t *testing.T) {
 	mockStore.AssertExpectations(t)
 }
 
+func TestSubmitAppState_WithdrawIntent_WithFee_Success(t *testing.T) {
+	// Setup
+	mockStore := new(MockStore)
+	mockSigner := NewMockSigner()
+
+	storeTxProvider := func(fn StoreTxHandler) error {
+		return fn(mockStore)
+	}
+
+	mockAssetStore := new(MockAssetStore)
+	mockStatePacker := new(MockStatePacker)
+	feeAccount := "0x1111111111111111111111111111111111111111"
+	withdrawalFee := decimal.NewFromInt(2)
+
+	handler := NewHandler(
+		storeTxProvider,
+		mockAssetStore,
+		&MockActionGateway{},
+		&MockFeeEngine{
+			Fees:    map[core.GatedAction]decimal.Decimal{core.GatedActionAppSessionWithdrawal: withdrawalFee},
+			Account: feeAccount,
+		},
+		mockSigner,
+		core.NewStateAdvancerV1(mockAssetStore),
+		mockStatePacker,
+		"0xNode",
+		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
+		32, 1024, 256, 16,
+	)
+
+	appSessionID := "0x1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+	wallet1 := NewTestAppSessionWallet(t)
+	participant1 := wallet1.Address
+
+	existingSession := &app.AppSessionV1{
+		SessionID:     appSessionID,
+		ApplicationID: "test-app",
+		Participants: []app.AppParticipantV1{
+			{WalletAddress: participant1, SignatureWeight: 10},
+		},
+		Quorum:      10,
+		Status:      app.AppSessionStatusOpen,
+		Version:     1,
+		SessionData: "",
+	}
+
+	currentAllocations := map[string]map[string]decimal.Decimal{
+		participant1: {
+			"USDC": decimal.NewFromInt(100),
+		},
+	}
+
+	// Build the core app state update for signing
+	appStateUpdateCore := app.AppStateUpdateV1{
+		AppSessionID: appSessionID,
+		Intent:       app.AppStateUpdateIntentWithdraw,
+		Version:      2,
+		Allocations: []app.AppAllocationV1{
+			{Participant: participant1, Asset: "USDC", Amount: decimal.NewFromInt(60)},
+		},
+		SessionData: "",
+	}
+	sig1 := wallet1.SignAppStateUpdate(t, appStateUpdateCore)
+
+	reqPayload := rpc.AppSessionsV1SubmitAppStateRequest{
+		AppStateUpdate: rpc.AppStateUpdateV1{
+			AppSessionID: appSessionID,
+			Intent:       app.AppStateUpdateIntentWithdraw,
+			Version:      "2",
+			Allocations: []rpc.AppAllocationV1{
+				{Participant: participant1, Asset: "USDC", Amount: "60"}, // Withdraw 40
+			},
+			SessionData: "",
+		},
+		QuorumSigs: []string{sig1},
+	}
+
+	// Mock expectations
+	mockStore.On("GetApp", "test-app").Return(&app.AppInfoV1{
+		App: app.AppV1{ID: "test-app", OwnerWallet: "0x0000000000000000000000000000000000000001"},
+	}, nil).Maybe()
+	mockStore.On("GetAppSession", appSessionID).Return(existingSession, nil)
+	mockStore.On("GetParticipantAllocations", appSessionID).Return(currentAllocations, nil)
+	mockAssetStore.On("GetAssetDecimals", "USDC").Return(uint8(6), nil)
+	mockStore.On("RecordLedgerEntry", participant1, appSessionID, "USDC", decimal.NewFromInt(-40)).Return(nil)
+
+	// Mock expectations for channel state issuance (issueReleaseReceiverState)
+	mockStore.On("LockUserState", participant1, "USDC").Return(decimal.Zero, nil)
+	mockStore.On("GetLastUserState", participant1, "USDC", false).Return(nil, nil)
+	mockStore.On("GetLastUserState", participant1, "USDC", true).Return(nil, nil)
+	mockStatePacker.On("PackState", mock.Anything).Return([]byte("packed"), nil)
+
+	// The session is debited 40, of which 38 are released to the participant and 2 are charged as fee
+	mockStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == participant1 &&
+			state.Transition.Type == core.TransitionTypeRelease &&
+			state.Transition.Amount.Equal(decimal.NewFromInt(38))
+	})).Return(nil).Once()
+	mockStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
+		return tx.TxType == core.TransactionTypeRelease &&
+			tx.Amount.Equal(decimal.NewFromInt(38)) &&
+			tx.FromAccount == appSessionID
+	})).Return(nil).Once()
+	mockStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
+		return tx.TxType == core.TransactionTypeFee &&
+			tx.Amount.Equal(withdrawalFee) &&
+			tx.FromAccount == appSessionID &&
+			tx.ToAccount == feeAccount
+	})).Return(nil).Once()
+
+	mockStore.On("UpdateAppSession", mock.MatchedBy(func(session app.AppSessionV1) bool {
+		return session.Version == 2 && session.Status == app.AppSessionStatusOpen
+	})).Return(nil)
+
+	// Create RPC context
+	payload, err := rpc.NewPayload(reqPayload)
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context: context.Background(),
+		Request: rpc.NewRequest(1, string(rpc.AppSessionsV1SubmitAppStateMethod), payload),
+	}
+
+	// Execute
+	handler.SubmitAppState(ctx)
+
+	// Assert
+	require.NotNil(t, ctx.Response)
+	if respErr := ctx.Response.Error(); respErr != nil {
+		t.Fatalf("Unexpected error response: %v", respErr)
+	}
+	assert.Equal(t, rpc.MsgTypeResp, ctx.Response.Type)
+
+	mockStore.AssertExpectations(t)
+}
+
 func TestSubmitAppState_CloseIntent_Success(t *testing.T) {
 	// Setup
 	mockStore := new(MockStore)
@@ -380,11 +539,14 @@ func TestSubmitAppState_CloseIntent_Success(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -508,11 +670,14 @@ func TestSubmitAppState_CloseIntent_AllocationMismatch_Rejected(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -607,11 +772,14 @@ func TestSubmitAppState_OperateIntent_MissingAllocation_Rejected(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -720,11 +888,14 @@ func TestSubmitAppState_WithdrawIntent_MissingAllocation_Rejected(t *testing.T)
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -831,11 +1002,14 @@ func TestSubmitAppState_DepositIntent_Rejected(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -886,11 +1060,14 @@ func TestSubmitAppState_ClosedSession_Rejected(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -956,11 +1133,14 @@ func TestSubmitAppState_InvalidVersion_Rejected(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -1031,11 +1211,14 @@ func TestSubmitAppState_SessionNotFound_Rejected(t *testing.T) {
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -1091,11 +1274,14 @@ func TestSubmitAppState_OperateIntent_InvalidDecimalPrecision_Rejected(t *testin
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -1199,11 +1385,14 @@ func TestSubmitAppState_WithdrawIntent_InvalidDecimalPrecision_Rejected(t *testi
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -1303,11 +1492,14 @@ func TestSubmitAppState_OperateIntent_RedistributeToNewParticipant_Success(t *te
 		storeTxProvider,
 		mockAssetStore,
 		&MockActionGateway{},
+		&MockFeeEngine{},
 		mockSigner,
 		core.NewStateAdvancerV1(mockAssetStore),
 		mockStatePacker,
 		"0xNode",
 		metrics.NewNoopRuntimeMetricExporter(),
+		&MockNotifier{},
+		app.NewAppStateValidatorRegistryV1(),
 		32, 1024, 256, 16,
 	)
 
@@ -1407,3 +1599,142 @@ func TestSubmitAppState_OperateIntent_RedistributeToNewParticipant_Success(t *te
 	mockStore.AssertExpectations(t)
 	mockAssetStore.AssertExpectations(t)
 }
+
+func TestSubmitAppState_OperateIntent_AppStateValidator(t *testing.T) {
+	appSessionID := "0x1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef"
+	wallet1 := NewTestAppSessionWallet(t)
+	participant1 := wallet1.Address
+	participant2 := "0x2222222222222222222222222222222222222222"
+
+	for _, tc := range []struct {
+		name        string
+		sessionData string
+		expectedErr string
+	}{
+		{name: "accepted", sessionData: `{"turn":2}`},
+		{name: "rejected", sessionData: `{"turn":1}`, expectedErr: "app state update rejected by application test-app: turn must advance"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := new(MockStore)
+			storeTxProvider := func(fn StoreTxHandler) error {
+				return fn(mockStore)
+			}
+			mockAssetStore := new(MockAssetStore)
+
+			var validatedSession app.AppSessionV1
+			var validatedAllocations []app.AppAllocationV1
+			var validatedUpdate app.AppStateUpdateV1
+			validators := app.NewAppStateValidatorRegistryV1()
+			require.NoError(t, validators.Register("test-app", app.AppStateValidatorFuncV1(
+				func(session app.AppSessionV1, currentAllocations []app.AppAllocationV1, update app.AppStateUpdateV1) error {
+					validatedSession = session
+					validatedAllocations = currentAllocations
+					validatedUpdate = update
+					if update.SessionData == session.SessionData {
+						return errors.New("turn must advance")
+					}
+					return nil
+				})))
+
+			handler := NewHandler(
+				storeTxProvider,
+				mockAssetStore,
+				&MockActionGateway{},
+				&MockFeeEngine{},
+				NewMockSigner(),
+				core.NewStateAdvancerV1(mockAssetStore),
+				new(MockStatePacker),
+				"0xNode",
+				metrics.NewNoopRuntimeMetricExporter(),
+				&MockNotifier{},
+				validators,
+				32, 1024, 256, 16,
+			)
+
+			existingSession := &app.AppSessionV1{
+				SessionID:     appSessionID,
+				ApplicationID: "test-app",
+				Participants: []app.AppParticipantV1{
+					{WalletAddress: participant1, SignatureWeight: 5},
+					{WalletAddress: participant2, SignatureWeight: 5},
+				},
+				Quorum:      5,
+				Status:      app.AppSessionStatusOpen,
+				Version:     1,
+				SessionData: `{"turn":1}`,
+			}
+			currentAllocations := map[string]map[string]decimal.Decimal{
+				participant2: {"USDC": decimal.NewFromInt(50), "WETH": decimal.Zero},
+				participant1: {"USDC": decimal.NewFromInt(100)},
+			}
+
+			appStateUpdateCore := app.AppStateUpdateV1{
+				AppSessionID: appSessionID,
+				Intent:       app.AppStateUpdateIntentOperate,
+				Version:      2,
+				Allocations: []app.AppAllocationV1{
+					{Participant: participant1, Asset: "USDC", Amount: decimal.NewFromInt(90)},
+					{Participant: participant2, Asset: "USDC", Amount: decimal.NewFromInt(60)},
+				},
+				SessionData: tc.sessionData,
+			}
+			sig1 := wallet1.SignAppStateUpdate(t, appStateUpdateCore)
+
+			reqPayload := rpc.AppSessionsV1SubmitAppStateRequest{
+				AppStateUpdate: rpc.AppStateUpdateV1{
+					AppSessionID: appSessionID,
+					Intent:       app.AppStateUpdateIntentOperate,
+					Version:      "2",
+					Allocations: []rpc.AppAllocationV1{
+						{Participant: participant1, Asset: "USDC", Amount: "90"},
+						{Participant: participant2, Asset: "USDC", Amount: "60"},
+					},
+					SessionData: tc.sessionData,
+				},
+				QuorumSigs: []string{sig1},
+			}
+
+			mockStore.On("GetApp", "test-app").Return(&app.AppInfoV1{
+				App: app.AppV1{ID: "test-app", OwnerWallet: "0x0000000000000000000000000000000000000001"},
+			}, nil)
+			mockStore.On("GetAppSession", appSessionID).Return(existingSession, nil)
+			mockStore.On("GetParticipantAllocations", appSessionID).Return(currentAllocations, nil)
+			if tc.expectedErr == "" {
+				mockStore.On("GetAppSessionBalances", appSessionID).Return(map[string]decimal.Decimal{"USDC": decimal.NewFromInt(150)}, nil)
+				mockAssetStore.On("GetAssetDecimals", "USDC").Return(uint8(6), nil)
+				mockStore.On("RecordLedgerEntry", mock.Anything, appSessionID, "USDC", mock.Anything).Return(nil)
+				mockStore.On("UpdateAppSession", mock.Anything).Return(nil)
+			}
+
+			payload, err := rpc.NewPayload(reqPayload)
+			require.NoError(t, err)
+			ctx := &rpc.Context{
+				Context: context.Background(),
+				Request: rpc.NewRequest(1, string(rpc.AppSessionsV1SubmitAppStateMethod), payload),
+			}
+
+			handler.SubmitAppState(ctx)
+
+			require.NotNil(t, ctx.Response)
+			if tc.expectedErr == "" {
+				assert.NoError(t, ctx.Response.Error())
+			} else {
+				respErr := ctx.Response.Error()
+				require.Error(t, respErr)
+				assert.Contains(t, respErr.Error(), tc.expectedErr)
+				mockStore.AssertNotCalled(t, "RecordLedgerEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				mockStore.AssertNotCalled(t, "UpdateAppSession", mock.Anything)
+			}
+
+			// The validator sees the previous state and the proposed update
+			assert.Equal(t, `{"turn":1}`, validatedSession.SessionData)
+			assert.Equal(t, uint64(1), validatedSession.Version)
+			assert.ElementsMatch(t, []app.AppAllocationV1{
+				{Participant: participant1, Asset: "USDC", Amount: decimal.NewFromInt(100)},
+				{Participant: participant2, Asset: "USDC", Amount: decimal.NewFromInt(50)},
+			}, validatedAllocations)
+			assert.Equal(t, tc.sessionData, validatedUpdate.SessionData)
+			assert.Len(t, validatedUpdate.Allocations, 2)
+		})
+	}
+}
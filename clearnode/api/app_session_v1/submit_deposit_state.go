@@ -43,6 +43,7 @@ func (h *Handler) SubmitDepositState(c *rpc.Context) {
 	}
 
 	var nodeSig string
+	var updatedSession *app.AppSessionV1
 	err = h.useStoreInTx(func(tx Store) error {
 		appSession, err := tx.GetAppSession(appStateUpd.AppSessionID)
 		if err != nil {
@@ -171,6 +172,10 @@ func (h *Handler) SubmitDepositState(c *rpc.Context) {
 			return rpc.Errorf("failed to get current allocations: %v", err)
 		}
 
+		if err := h.validateAppStateUpdate(*appSession, currentAllocations, appStateUpd); err != nil {
+			return err
+		}
+
 		// Track total deposit amount to validate against transition amount
 		totalDepositAmount := decimal.Zero
 
@@ -244,8 +249,12 @@ func (h *Handler) SubmitDepositState(c *rpc.Context) {
 			}
 		}
 
-		// Validate that total deposit amount matches the transition amount
-		if !totalDepositAmount.Equal(lastTransition.Amount) {
+		// Validate that total deposit amount plus the deposit fee matches the transition amount
+		fee := h.feeEngine.CalculateFee(userState.Asset, core.GatedActionAppSessionDeposit, totalDepositAmount)
+		if !totalDepositAmount.Add(fee).Equal(lastTransition.Amount) {
+			if fee.IsPositive() {
+				return rpc.Errorf("total deposit amount %s plus deposit fee %s does not match transition amount %s", totalDepositAmount.String(), fee.String(), lastTransition.Amount.String())
+			}
 			return rpc.Errorf("total deposit amount %s does not match transition amount %s", totalDepositAmount.String(), lastTransition.Amount.String())
 		}
 
@@ -260,6 +269,7 @@ func (h *Handler) SubmitDepositState(c *rpc.Context) {
 		if err := tx.UpdateAppSession(*appSession); err != nil {
 			return rpc.Errorf("failed to update app session: %v", err)
 		}
+		updatedSession = appSession
 
 		// Sign the user state with node's signature
 		// TODO:create a function to handle state signing
@@ -290,6 +300,17 @@ func (h *Handler) SubmitDepositState(c *rpc.Context) {
 			"asset", transaction.Asset,
 			"amount", transaction.Amount.String())
 
+		if fee.IsPositive() {
+			feeTransaction, err := core.NewFeeTransaction(userState.Asset, appStateUpd.AppSessionID, h.feeEngine.FeeAccount(), userState.ID, fee)
+			if err != nil {
+				return rpc.Errorf("failed to create fee transaction: %v", err)
+			}
+			if err := tx.RecordTransaction(*feeTransaction); err != nil {
+				return rpc.Errorf("failed to record fee transaction: %v", err)
+			}
+			logger.Info("recorded deposit fee", "txID", feeTransaction.ID, "fee", fee.String())
+		}
+
 		return nil
 	})
 
@@ -310,6 +331,8 @@ func (h *Handler) SubmitDepositState(c *rpc.Context) {
 	}
 
 	c.Succeed(c.Request.Method, payload)
+	h.publishAppSessionUpdated(ctx, *updatedSession, appStateUpd.Allocations)
+
 	logger.Info("successfully processed deposit state",
 		"appSessionID", reqPayload.AppStateUpdate.AppSessionID,
 		"appSessionVersion", appStateUpd.Version,
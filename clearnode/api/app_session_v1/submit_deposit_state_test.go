@@ -30,6 +30,7 @@ func TestSubmitDepositState_Success(t *testing.T) {
 	handler := &Handler{
 		assetStore:    mockAssetStore,
 		actionGateway: &MockActionGateway{},
+		feeEngine:     &MockFeeEngine{},
 		stateAdvancer: core.NewStateAdvancerV1(mockAssetStore),
 		statePacker:   mockStatePacker,
 		useStoreInTx: func(handler StoreTxHandler) error {
@@ -38,6 +39,7 @@ func TestSubmitDepositState_Success(t *testing.T) {
 		signer:           mockSigner,
 		nodeAddress:      nodeAddress,
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
+		notifier:         &MockNotifier{},
 		maxParticipants:  32,
 		maxSessionData:   1024,
 		maxSessionKeyIDs: 256,
@@ -228,6 +230,359 @@ func TestSubmitDepositState_Success(t *testing.T) {
 	mockStore.AssertExpectations(t)
 }
 
+func TestSubmitDepositState_WithFee_Success(t *testing.T) {
+	// Setup
+	mockStore := new(MockStore)
+	mockSigner := NewMockSigner()
+	nodeAddress := mockSigner.PublicKey().Address().String()
+	mockAssetStore := new(MockAssetStore)
+	mockStatePacker := new(MockStatePacker)
+	feeAccount := "0x1111111111111111111111111111111111111111"
+	depositFee := decimal.NewFromInt(1)
+
+	handler := &Handler{
+		assetStore:    mockAssetStore,
+		actionGateway: &MockActionGateway{},
+		feeEngine: &MockFeeEngine{
+			Fees:    map[core.GatedAction]decimal.Decimal{core.GatedActionAppSessionDeposit: depositFee},
+			Account: feeAccount,
+		},
+		stateAdvancer: core.NewStateAdvancerV1(mockAssetStore),
+		statePacker:   mockStatePacker,
+		useStoreInTx: func(handler StoreTxHandler) error {
+			return handler(mockStore)
+		},
+		signer:           mockSigner,
+		nodeAddress:      nodeAddress,
+		metrics:          metrics.NewNoopRuntimeMetricExporter(),
+		notifier:         &MockNotifier{},
+		maxParticipants:  32,
+		maxSessionData:   1024,
+		maxSessionKeyIDs: 256,
+		maxSignedUpdates: 16,
+	}
+
+	// Test data - create one key for both app session and channel state signing
+	userRawSigner := NewMockSigner()
+	channelWalletSigner, _ := core.NewChannelDefaultSigner(userRawSigner)
+	appWalletSigner, _ := app.NewAppSessionWalletSignerV1(userRawSigner)
+	participant1 := strings.ToLower(userRawSigner.PublicKey().Address().String())
+	participant2 := "0x2222222222222222222222222222222222222222"
+	asset := "USDC"
+	homeChannelID := "0xHomeChannel123"
+	depositAmount := decimal.NewFromInt(100)
+	appSessionID := "0xAppSession123"
+
+	// Create existing app session
+	existingAppSession := &app.AppSessionV1{
+		SessionID:     appSessionID,
+		ApplicationID: "test-app",
+		Participants: []app.AppParticipantV1{
+			{
+				WalletAddress:   participant1,
+				SignatureWeight: 1,
+			},
+			{
+				WalletAddress:   participant2,
+				SignatureWeight: 1,
+			},
+		},
+		Quorum:      1,
+		Nonce:       12345,
+		Status:      app.AppSessionStatusOpen,
+		Version:     1,
+		SessionData: "",
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	// Create user's current state (before deposit)
+	currentUserState := core.State{
+		ID: core.GetStateID(participant1, asset, 1, 1),
+		Transition: core.Transition{
+			Type: core.TransitionTypeVoid,
+		},
+		Asset:         asset,
+		UserWallet:    participant1,
+		Epoch:         1,
+		Version:       1,
+		HomeChannelID: &homeChannelID,
+		HomeLedger: core.Ledger{
+			TokenAddress: "0xTokenAddress",
+			BlockchainID: 1,
+			UserBalance:  decimal.NewFromInt(500),
+			UserNetFlow:  decimal.NewFromInt(500),
+			NodeBalance:  decimal.NewFromInt(0),
+			NodeNetFlow:  decimal.NewFromInt(0),
+		},
+		EscrowLedger: nil,
+		UserSig:      nil,
+		NodeSig:      nil,
+	}
+
+	// Create incoming user state (with commit transition covering the deposit fee)
+	incomingUserState := currentUserState.NextState()
+
+	_, err := incomingUserState.ApplyCommitTransition(appSessionID, depositAmount.Add(depositFee))
+	require.NoError(t, err)
+
+	// Sign the incoming user state with channel wallet signer (adds 0x01 prefix)
+	mockStatePacker.On("PackState", mock.Anything).Return([]byte("packed"), nil)
+	packedUserState, _ := mockStatePacker.PackState(*incomingUserState)
+	userSig, _ := channelWalletSigner.Sign(packedUserState)
+	userSigStr := userSig.String()
+	incomingUserState.UserSig = &userSigStr
+
+	// Create app state update and sign with app wallet signer (includes 0xA1 prefix for verifyQuorum)
+	appStateUpdateCore := app.AppStateUpdateV1{
+		AppSessionID: appSessionID,
+		Intent:       app.AppStateUpdateIntentDeposit,
+		Version:      2,
+		Allocations: []app.AppAllocationV1{
+			{
+				Participant: participant1,
+				Asset:       asset,
+				Amount:      depositAmount,
+			},
+		},
+		SessionData: `{"updated": "data"}`,
+	}
+	packedAppUpdate, _ := app.PackAppStateUpdateV1(appStateUpdateCore)
+	appSigBytes, _ := appWalletSigner.Sign(packedAppUpdate)
+	appSigHex := hexutil.Encode(appSigBytes)
+
+	appStateUpdate := rpc.AppStateUpdateV1{
+		AppSessionID: appSessionID,
+		Intent:       app.AppStateUpdateIntentDeposit,
+		Version:      "2", // Next version
+		Allocations: []rpc.AppAllocationV1{
+			{
+				Participant: participant1,
+				Asset:       asset,
+				Amount:      depositAmount.String(),
+			},
+		},
+		SessionData: `{"updated": "data"}`,
+	}
+
+	// Mock expectations
+	mockStore.On("LockUserState", participant1, asset).Return(decimal.Zero, nil).Once()
+	mockStore.On("CheckOpenChannel", participant1, asset).Return("0x03", true, nil).Once()
+	mockStore.On("GetLastUserState", participant1, asset, false).Return(currentUserState, nil).Once()
+	mockStore.On("EnsureNoOngoingStateTransitions", participant1, asset).Return(nil).Once()
+	mockAssetStore.On("GetAssetDecimals", asset).Return(uint8(6), nil)
+	mockStore.On("GetApp", "test-app").Return(&app.AppInfoV1{
+		App: app.AppV1{ID: "test-app", OwnerWallet: "0x0000000000000000000000000000000000000001"},
+	}, nil).Maybe()
+	mockStore.On("GetAppSession", appSessionID).Return(existingAppSession, nil).Once()
+
+	// Mock allocations check - empty initially
+	mockStore.On("GetParticipantAllocations", appSessionID).Return(
+		map[string]map[string]decimal.Decimal{},
+		nil,
+	).Once()
+
+	// Mock ledger entry recording
+	mockStore.On("RecordLedgerEntry", participant1, appSessionID, asset, depositAmount).Return(nil).Once()
+
+	// Mock app session update
+	mockStore.On("UpdateAppSession", mock.MatchedBy(func(session app.AppSessionV1) bool {
+		return session.SessionID == appSessionID &&
+			session.Version == 2 &&
+			session.SessionData == `{"updated": "data"}`
+	})).Return(nil).Once()
+
+	// Mock user state storage
+	mockStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == participant1 &&
+			state.Version == incomingUserState.Version &&
+			state.Transition.Type == core.TransitionTypeCommit &&
+			state.NodeSig != nil
+	})).Return(nil).Once()
+
+	// Mock transaction recording
+	mockStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
+		return tx.TxType == core.TransactionTypeCommit &&
+			tx.Amount.Equal(depositAmount.Add(depositFee)) &&
+			tx.ToAccount == appSessionID
+	})).Return(nil).Once()
+
+	// Mock fee transaction recording
+	mockStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
+		return tx.TxType == core.TransactionTypeFee &&
+			tx.Amount.Equal(depositFee) &&
+			tx.FromAccount == appSessionID &&
+			tx.ToAccount == feeAccount
+	})).Return(nil).Once()
+
+	// Create RPC request
+	rpcState := toRPCState(*incomingUserState)
+	reqPayload := rpc.AppSessionsV1SubmitDepositStateRequest{
+		AppStateUpdate: appStateUpdate,
+		QuorumSigs:     []string{appSigHex},
+		UserState:      rpcState,
+	}
+
+	payload, err := rpc.NewPayload(reqPayload)
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context: context.Background(),
+		Request: rpc.NewRequest(1, string(rpc.AppSessionsV1SubmitDepositStateMethod), payload),
+	}
+
+	// Execute
+	handler.SubmitDepositState(ctx)
+
+	// Assert
+	assert.NotNil(t, ctx.Response)
+
+	// Check for errors first
+	if respErr := ctx.Response.Error(); respErr != nil {
+		t.Fatalf("Unexpected error response: %v", respErr)
+	}
+
+	assert.Equal(t, rpc.MsgTypeResp, ctx.Response.Type)
+
+	// Parse response
+	var response rpc.AppSessionsV1SubmitDepositStateResponse
+	err = ctx.Response.Payload.Translate(&response)
+	require.NoError(t, err)
+	assert.NotEmpty(t, response.StateNodeSig, "Node signature should be present")
+
+	// Verify all mock expectations
+	mockStore.AssertExpectations(t)
+}
+
+func TestSubmitDepositState_FeeNotCovered(t *testing.T) {
+	// Setup
+	mockStore := new(MockStore)
+	mockSigner := NewMockSigner()
+	mockAssetStore := new(MockAssetStore)
+	mockStatePacker := new(MockStatePacker)
+
+	handler := &Handler{
+		assetStore:    mockAssetStore,
+		actionGateway: &MockActionGateway{},
+		feeEngine: &MockFeeEngine{
+			Fees:    map[core.GatedAction]decimal.Decimal{core.GatedActionAppSessionDeposit: decimal.NewFromInt(1)},
+			Account: "0x1111111111111111111111111111111111111111",
+		},
+		stateAdvancer: core.NewStateAdvancerV1(mockAssetStore),
+		statePacker:   mockStatePacker,
+		useStoreInTx: func(handler StoreTxHandler) error {
+			return handler(mockStore)
+		},
+		signer:           mockSigner,
+		nodeAddress:      mockSigner.PublicKey().Address().String(),
+		metrics:          metrics.NewNoopRuntimeMetricExporter(),
+		notifier:         &MockNotifier{},
+		maxParticipants:  32,
+		maxSessionData:   1024,
+		maxSessionKeyIDs: 256,
+		maxSignedUpdates: 16,
+	}
+
+	userRawSigner := NewMockSigner()
+	channelWalletSigner, _ := core.NewChannelDefaultSigner(userRawSigner)
+	appWalletSigner, _ := app.NewAppSessionWalletSignerV1(userRawSigner)
+	participant1 := strings.ToLower(userRawSigner.PublicKey().Address().String())
+	asset := "USDC"
+	homeChannelID := "0xHomeChannel123"
+	depositAmount := decimal.NewFromInt(100)
+	appSessionID := "0xAppSession123"
+
+	existingAppSession := &app.AppSessionV1{
+		SessionID:     appSessionID,
+		ApplicationID: "test-app",
+		Participants: []app.AppParticipantV1{
+			{WalletAddress: participant1, SignatureWeight: 1},
+		},
+		Quorum:  1,
+		Status:  app.AppSessionStatusOpen,
+		Version: 1,
+	}
+
+	currentUserState := core.State{
+		ID:            core.GetStateID(participant1, asset, 1, 1),
+		Asset:         asset,
+		UserWallet:    participant1,
+		Epoch:         1,
+		Version:       1,
+		HomeChannelID: &homeChannelID,
+		HomeLedger: core.Ledger{
+			TokenAddress: "0xTokenAddress",
+			BlockchainID: 1,
+			UserBalance:  decimal.NewFromInt(500),
+			UserNetFlow:  decimal.NewFromInt(500),
+			NodeBalance:  decimal.NewFromInt(0),
+			NodeNetFlow:  decimal.NewFromInt(0),
+		},
+	}
+
+	// The committed amount does not include the deposit fee
+	incomingUserState := currentUserState.NextState()
+	_, err := incomingUserState.ApplyCommitTransition(appSessionID, depositAmount)
+	require.NoError(t, err)
+
+	mockStatePacker.On("PackState", mock.Anything).Return([]byte("packed"), nil)
+	userSig, _ := channelWalletSigner.Sign([]byte("packed"))
+	userSigStr := userSig.String()
+	incomingUserState.UserSig = &userSigStr
+
+	appStateUpdateCore := app.AppStateUpdateV1{
+		AppSessionID: appSessionID,
+		Intent:       app.AppStateUpdateIntentDeposit,
+		Version:      2,
+		Allocations: []app.AppAllocationV1{
+			{Participant: participant1, Asset: asset, Amount: depositAmount},
+		},
+	}
+	packedAppUpdate, _ := app.PackAppStateUpdateV1(appStateUpdateCore)
+	appSigBytes, _ := appWalletSigner.Sign(packedAppUpdate)
+
+	mockStore.On("GetAppSession", appSessionID).Return(existingAppSession, nil)
+	mockStore.On("GetApp", "test-app").Return(&app.AppInfoV1{
+		App: app.AppV1{ID: "test-app", OwnerWallet: "0x0000000000000000000000000000000000000001"},
+	}, nil)
+	mockStore.On("LockUserState", participant1, asset).Return(decimal.Zero, nil)
+	mockStore.On("CheckOpenChannel", participant1, asset).Return("0x03", true, nil)
+	mockStore.On("GetLastUserState", participant1, asset, false).Return(currentUserState, nil)
+	mockStore.On("EnsureNoOngoingStateTransitions", participant1, asset).Return(nil)
+	mockAssetStore.On("GetAssetDecimals", asset).Return(uint8(6), nil)
+	mockStore.On("GetParticipantAllocations", appSessionID).Return(map[string]map[string]decimal.Decimal{}, nil)
+	mockStore.On("RecordLedgerEntry", participant1, appSessionID, asset, depositAmount).Return(nil)
+
+	reqPayload := rpc.AppSessionsV1SubmitDepositStateRequest{
+		AppStateUpdate: rpc.AppStateUpdateV1{
+			AppSessionID: appSessionID,
+			Intent:       app.AppStateUpdateIntentDeposit,
+			Version:      "2",
+			Allocations: []rpc.AppAllocationV1{
+				{Participant: participant1, Asset: asset, Amount: depositAmount.String()},
+			},
+		},
+		QuorumSigs: []string{hexutil.Encode(appSigBytes)},
+		UserState:  toRPCState(*incomingUserState),
+	}
+	payload, err := rpc.NewPayload(reqPayload)
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context: context.Background(),
+		Request: rpc.NewRequest(1, string(rpc.AppSessionsV1SubmitDepositStateMethod), payload),
+	}
+
+	// Execute
+	handler.SubmitDepositState(ctx)
+
+	// Assert
+	respErr := ctx.Response.Error()
+	require.NotNil(t, respErr)
+	assert.Contains(t, respErr.Error(), "plus deposit fee 1 does not match transition amount 100")
+	mockStore.AssertNotCalled(t, "RecordTransaction", mock.Anything)
+}
+
 func TestSubmitDepositState_InvalidTransitionType(t *testing.T) {
 	// Setup
 	mockStore := new(MockStore)
@@ -239,6 +594,7 @@ func TestSubmitDepositState_InvalidTransitionType(t *testing.T) {
 	handler := &Handler{
 		assetStore:    mockAssetStore,
 		actionGateway: &MockActionGateway{},
+		feeEngine:     &MockFeeEngine{},
 		stateAdvancer: core.NewStateAdvancerV1(mockAssetStore),
 		statePacker:   mockStatePacker,
 		useStoreInTx: func(handler StoreTxHandler) error {
@@ -247,6 +603,7 @@ func TestSubmitDepositState_InvalidTransitionType(t *testing.T) {
 		signer:           mockSigner,
 		nodeAddress:      nodeAddress,
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
+		notifier:         &MockNotifier{},
 		maxParticipants:  32,
 		maxSessionData:   1024,
 		maxSessionKeyIDs: 256,
@@ -383,6 +740,7 @@ func TestSubmitDepositState_QuorumNotMet(t *testing.T) {
 	handler := &Handler{
 		assetStore:    mockAssetStore,
 		actionGateway: &MockActionGateway{},
+		feeEngine:     &MockFeeEngine{},
 		stateAdvancer: core.NewStateAdvancerV1(mockAssetStore),
 		statePacker:   mockStatePacker,
 		useStoreInTx: func(handler StoreTxHandler) error {
@@ -391,6 +749,7 @@ func TestSubmitDepositState_QuorumNotMet(t *testing.T) {
 		signer:           mockSigner,
 		nodeAddress:      nodeAddress,
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
+		notifier:         &MockNotifier{},
 		maxParticipants:  32,
 		maxSessionData:   1024,
 		maxSessionKeyIDs: 256,
@@ -0,0 +1,84 @@
+package app_session_v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+func TestSubscribe_Success(t *testing.T) {
+	handler := &Handler{notifier: &MockNotifier{}}
+
+	payload, err := rpc.NewPayload(rpc.AppSessionsV1SubscribeRequest{Wallet: "0x1234567890123456789012345678901234567890"})
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context:      context.Background(),
+		ConnectionID: "conn1",
+		Request:      rpc.Message{Method: rpc.AppSessionsV1SubscribeMethod.String(), Payload: payload},
+	}
+
+	handler.Subscribe(ctx)
+
+	assert.Nil(t, ctx.Response.Error())
+	assert.Equal(t, rpc.MsgTypeResp, ctx.Response.Type)
+}
+
+func TestSubscribe_InvalidWallet(t *testing.T) {
+	handler := &Handler{notifier: &MockNotifier{}}
+
+	payload, err := rpc.NewPayload(rpc.AppSessionsV1SubscribeRequest{Wallet: "garbage"})
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context:      context.Background(),
+		ConnectionID: "conn1",
+		Request:      rpc.Message{Method: rpc.AppSessionsV1SubscribeMethod.String(), Payload: payload},
+	}
+
+	handler.Subscribe(ctx)
+
+	require.NotNil(t, ctx.Response.Error())
+	assert.Contains(t, ctx.Response.Error().Error(), "invalid wallet address")
+}
+
+func TestSubscribe_NotifierError(t *testing.T) {
+	handler := &Handler{notifier: &MockNotifier{SubscribeErr: errors.New("connection with ID conn1 does not exist")}}
+
+	payload, err := rpc.NewPayload(rpc.AppSessionsV1SubscribeRequest{Wallet: "0x1234567890123456789012345678901234567890"})
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context:      context.Background(),
+		ConnectionID: "conn1",
+		Request:      rpc.Message{Method: rpc.AppSessionsV1SubscribeMethod.String(), Payload: payload},
+	}
+
+	handler.Subscribe(ctx)
+
+	require.NotNil(t, ctx.Response.Error())
+	assert.Equal(t, rpc.MsgTypeRespErr, ctx.Response.Type)
+}
+
+func TestUnsubscribe_Success(t *testing.T) {
+	handler := &Handler{notifier: &MockNotifier{}}
+
+	payload, err := rpc.NewPayload(rpc.AppSessionsV1UnsubscribeRequest{Wallet: "0x1234567890123456789012345678901234567890"})
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context:      context.Background(),
+		ConnectionID: "conn1",
+		Request:      rpc.Message{Method: rpc.AppSessionsV1UnsubscribeMethod.String(), Payload: payload},
+	}
+
+	handler.Unsubscribe(ctx)
+
+	assert.Nil(t, ctx.Response.Error())
+	assert.Equal(t, rpc.MsgTypeResp, ctx.Response.Type)
+}
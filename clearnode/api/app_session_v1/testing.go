@@ -2,6 +2,7 @@ package app_session_v1
 
 import (
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/layer-3/nitrolite/clearnode/action_gateway"
 	"github.com/layer-3/nitrolite/pkg/app"
 	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
 	"github.com/layer-3/nitrolite/pkg/sign"
 )
 
@@ -179,6 +181,20 @@ func (m *MockActionGateway) AllowAction(_ action_gateway.Store, _ string, _ core
 	return m.Err
 }
 
+// MockFeeEngine charges the configured fee per gated action, regardless of asset and amount.
+type MockFeeEngine struct {
+	Fees    map[core.GatedAction]decimal.Decimal
+	Account string
+}
+
+func (m *MockFeeEngine) CalculateFee(_ string, action core.GatedAction, _ decimal.Decimal) decimal.Decimal {
+	return m.Fees[action]
+}
+
+func (m *MockFeeEngine) FeeAccount() string {
+	return m.Account
+}
+
 // MockSigValidator is a mock implementation of the SigValidator interface
 type MockSigValidator struct {
 	mock.Mock
@@ -277,3 +293,30 @@ func (w *TestAppSessionWallet) SignCreateRequest(t *testing.T, def app.AppDefini
 
 	return hexutil.Encode(sig)
 }
+
+// MockNotification is a notification captured by MockNotifier
+type MockNotification struct {
+	UserID string
+	Event  rpc.Event
+	Params rpc.Payload
+}
+
+// MockNotifier is a mock implementation of the Notifier interface that records notifications
+type MockNotifier struct {
+	SubscribeErr  error
+	Notifications []MockNotification
+	mu            sync.Mutex
+}
+
+func (m *MockNotifier) Subscribe(_ string, _ rpc.Group, _ string) error {
+	return m.SubscribeErr
+}
+
+func (m *MockNotifier) Unsubscribe(_ string, _ rpc.Group, _ string) {}
+
+func (m *MockNotifier) Notify(userID string, event rpc.Event, params rpc.Payload) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Notifications = append(m.Notifications, MockNotification{UserID: userID, Event: event, Params: params})
+}
@@ -0,0 +1,36 @@
+package app_session_v1
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// Unsubscribe cancels the requesting connection's subscription to app session events of a participant.
+func (h *Handler) Unsubscribe(c *rpc.Context) {
+	ctx := c.Context
+	logger := log.FromContext(ctx)
+
+	var reqPayload rpc.AppSessionsV1UnsubscribeRequest
+	if err := c.Request.Payload.Translate(&reqPayload); err != nil {
+		c.Fail(err, "failed to parse parameters")
+		return
+	}
+
+	if !common.IsHexAddress(reqPayload.Wallet) {
+		c.Fail(rpc.Errorf("invalid wallet address"), "")
+		return
+	}
+
+	h.notifier.Unsubscribe(c.ConnectionID, rpc.AppSessionsV1Group, reqPayload.Wallet)
+
+	payload, err := rpc.NewPayload(rpc.AppSessionsV1UnsubscribeResponse{})
+	if err != nil {
+		c.Fail(err, "failed to create response")
+		return
+	}
+
+	c.Succeed(c.Request.Method, payload)
+	logger.Debug("unsubscribed from app session events", "wallet", reqPayload.Wallet)
+}
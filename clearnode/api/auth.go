@@ -0,0 +1,76 @@
+package api
+
+import (
+	"strings"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// privateReadMethods are the read methods returning the data of the wallet passed in their "wallet" parameter,
+// including the subscriptions streaming the wallet's events.
+var privateReadMethods = map[string]bool{
+	rpc.UserV1GetBalancesMethod.String():         true,
+	rpc.UserV1GetTransactionsMethod.String():     true,
+	rpc.UserV1GetActionAllowancesMethod.String(): true,
+	rpc.ChannelsV1GetHomeChannelMethod.String():  true,
+	rpc.ChannelsV1GetChannelsMethod.String():     true,
+	rpc.ChannelsV1GetLatestStateMethod.String():  true,
+	rpc.ChannelsV1SubscribeMethod.String():       true,
+	rpc.AppSessionsV1SubscribeMethod.String():    true,
+	rpc.StreamsV1GetMethod.String():              true,
+}
+
+// PaymentStreamGetter looks up payment streams to check who may read them.
+type PaymentStreamGetter interface {
+	// GetPaymentStream retrieves a payment stream by ID.
+	// Returns nil if the stream does not exist.
+	GetPaymentStream(id string) (*core.PaymentStream, error)
+}
+
+// AuthMiddleware restricts private read methods to the wallet the connection is authenticated as.
+// Requests are passed through unchanged unless RestrictPrivateReads is enabled.
+func (r *RPCRouter) AuthMiddleware(c *rpc.Context) {
+	if !r.restrictPrivateReads || !privateReadMethods[c.Request.Method] {
+		c.Next()
+		return
+	}
+
+	if c.UserID == "" {
+		c.Fail(rpc.Errorf("authentication required"), "")
+		return
+	}
+
+	var req struct {
+		Wallet   string `json:"wallet"`
+		StreamID string `json:"stream_id"`
+	}
+	if err := c.Request.Payload.Translate(&req); err != nil {
+		c.Fail(err, "failed to parse parameters")
+		return
+	}
+
+	// A payment stream may be looked up by ID alone, which is authorized by its participants below
+	streamLookup := c.Request.Method == rpc.StreamsV1GetMethod.String() && req.StreamID != ""
+	if (req.Wallet != "" || !streamLookup) && !strings.EqualFold(req.Wallet, c.UserID) {
+		c.Fail(rpc.Errorf("connection is not authenticated as wallet %s", req.Wallet), "")
+		return
+	}
+
+	// A payment stream looked up by ID must be sent or received by the authenticated wallet
+	if streamLookup {
+		stream, err := r.paymentStreams.GetPaymentStream(req.StreamID)
+		if err != nil {
+			c.Fail(err, "failed to retrieve payment stream")
+			return
+		}
+		if stream != nil &&
+			!strings.EqualFold(stream.Authorization.Sender, c.UserID) &&
+			!strings.EqualFold(stream.Authorization.Recipient, c.UserID) {
+			c.Fail(rpc.Errorf("connection is not authenticated as a participant of payment stream %s", req.StreamID), "")
+			return
+		}
+	}
+
+	c.Next()
+}
@@ -0,0 +1,205 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+func TestAuthMiddleware(t *testing.T) {
+	t.Parallel()
+
+	wallet := "0xabcdef1234567890abcdef1234567890abcdef12"
+
+	newTestContext := func(t *testing.T, method, userID, reqWallet string) *rpc.Context {
+		payload, err := rpc.NewPayload(rpc.UserV1GetBalancesRequest{Wallet: reqWallet})
+		require.NoError(t, err)
+		return &rpc.Context{
+			UserID:  userID,
+			Request: rpc.Message{Method: method, Payload: payload},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		restrict      bool
+		method        string
+		userID        string
+		reqWallet     string
+		expectedError string
+	}{
+		{
+			name:      "restriction disabled",
+			method:    rpc.UserV1GetBalancesMethod.String(),
+			reqWallet: wallet,
+		},
+		{
+			name:      "public method",
+			restrict:  true,
+			method:    rpc.NodeV1GetConfigMethod.String(),
+			reqWallet: wallet,
+		},
+		{
+			name:      "authenticated wallet",
+			restrict:  true,
+			method:    rpc.UserV1GetTransactionsMethod.String(),
+			userID:    wallet,
+			reqWallet: "0xABCDEF1234567890ABCDEF1234567890ABCDEF12",
+		},
+		{
+			name:          "unauthenticated connection",
+			restrict:      true,
+			method:        rpc.UserV1GetBalancesMethod.String(),
+			reqWallet:     wallet,
+			expectedError: "authentication required",
+		},
+		{
+			name:          "another wallet",
+			restrict:      true,
+			method:        rpc.ChannelsV1GetLatestStateMethod.String(),
+			userID:        wallet,
+			reqWallet:     "0x0000000000000000000000000000000000000001",
+			expectedError: "connection is not authenticated as wallet 0x0000000000000000000000000000000000000001",
+		},
+		{
+			name:          "unauthenticated channel subscription",
+			restrict:      true,
+			method:        rpc.ChannelsV1SubscribeMethod.String(),
+			reqWallet:     wallet,
+			expectedError: "authentication required",
+		},
+		{
+			name:          "home channel of another wallet",
+			restrict:      true,
+			method:        rpc.ChannelsV1GetHomeChannelMethod.String(),
+			userID:        wallet,
+			reqWallet:     "0x0000000000000000000000000000000000000001",
+			expectedError: "connection is not authenticated as wallet 0x0000000000000000000000000000000000000001",
+		},
+		{
+			name:          "app session subscription to another wallet",
+			restrict:      true,
+			method:        rpc.AppSessionsV1SubscribeMethod.String(),
+			userID:        wallet,
+			reqWallet:     "0x0000000000000000000000000000000000000001",
+			expectedError: "connection is not authenticated as wallet 0x0000000000000000000000000000000000000001",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := &RPCRouter{restrictPrivateReads: tc.restrict}
+			ctx := newTestContext(t, tc.method, tc.userID, tc.reqWallet)
+
+			router.AuthMiddleware(ctx)
+
+			if tc.expectedError == "" {
+				assert.Nil(t, ctx.Response.Error())
+				return
+			}
+			require.NotNil(t, ctx.Response.Error())
+			assert.Equal(t, tc.expectedError, ctx.Response.Error().Error())
+		})
+	}
+}
+
+// paymentStreamMap is a PaymentStreamGetter over a fixed set of payment streams.
+type paymentStreamMap map[string]core.PaymentStream
+
+func (m paymentStreamMap) GetPaymentStream(id string) (*core.PaymentStream, error) {
+	stream, ok := m[id]
+	if !ok {
+		return nil, nil
+	}
+	return &stream, nil
+}
+
+func TestAuthMiddleware_StreamsGet(t *testing.T) {
+	t.Parallel()
+
+	sender := "0x1111111111111111111111111111111111111111"
+	recipient := "0x2222222222222222222222222222222222222222"
+	other := "0x3333333333333333333333333333333333333333"
+	streamID := "0xstream"
+
+	streams := paymentStreamMap{
+		streamID: {
+			ID: streamID,
+			Authorization: core.PaymentStreamAuthorization{
+				Sender:    sender,
+				Recipient: recipient,
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		userID        string
+		req           rpc.StreamsV1GetRequest
+		expectedError string
+	}{
+		{
+			name:   "own streams by wallet",
+			userID: sender,
+			req:    rpc.StreamsV1GetRequest{Wallet: &sender},
+		},
+		{
+			name:          "streams of another wallet",
+			userID:        other,
+			req:           rpc.StreamsV1GetRequest{Wallet: &sender},
+			expectedError: "connection is not authenticated as wallet " + sender,
+		},
+		{
+			name:   "stream by ID as its recipient",
+			userID: recipient,
+			req:    rpc.StreamsV1GetRequest{StreamID: &streamID},
+		},
+		{
+			name:          "stream by ID of other wallets",
+			userID:        other,
+			req:           rpc.StreamsV1GetRequest{StreamID: &streamID},
+			expectedError: "connection is not authenticated as a participant of payment stream " + streamID,
+		},
+		{
+			name:          "stream by ID with another wallet",
+			userID:        other,
+			req:           rpc.StreamsV1GetRequest{StreamID: &streamID, Wallet: &sender},
+			expectedError: "connection is not authenticated as wallet " + sender,
+		},
+		{
+			name:          "unauthenticated connection",
+			req:           rpc.StreamsV1GetRequest{StreamID: &streamID},
+			expectedError: "authentication required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			payload, err := rpc.NewPayload(tc.req)
+			require.NoError(t, err)
+
+			router := &RPCRouter{restrictPrivateReads: true, paymentStreams: streams}
+			ctx := &rpc.Context{
+				UserID:  tc.userID,
+				Request: rpc.Message{Method: rpc.StreamsV1GetMethod.String(), Payload: payload},
+			}
+
+			router.AuthMiddleware(ctx)
+
+			if tc.expectedError == "" {
+				assert.Nil(t, ctx.Response.Error())
+				return
+			}
+			require.NotNil(t, ctx.Response.Error())
+			assert.Equal(t, tc.expectedError, ctx.Response.Error().Error())
+		})
+	}
+}
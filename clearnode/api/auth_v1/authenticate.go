@@ -0,0 +1,66 @@
+package auth_v1
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// Authenticate binds the connection to a wallet once it proves control over the wallet
+// by signing the pending challenge, either with the wallet or with one of its channel session keys.
+// A challenge can be used only once, whether the attempt succeeds or not.
+func (h *Handler) Authenticate(c *rpc.Context) {
+	var req rpc.AuthV1AuthenticateRequest
+	if err := c.Request.Payload.Translate(&req); err != nil {
+		c.Fail(err, "failed to parse parameters")
+		return
+	}
+
+	if !common.IsHexAddress(req.Wallet) {
+		c.Fail(rpc.Errorf("invalid wallet address: %s", req.Wallet), "")
+		return
+	}
+
+	val, _ := c.Storage.Get(challengeStorageKey)
+	pending, ok := val.(*pendingChallenge)
+	if !ok || pending == nil || pending.challenge != req.Challenge {
+		c.Fail(rpc.Errorf("unknown challenge, request a new one"), "")
+		return
+	}
+	c.Storage.Set(challengeStorageKey, nil)
+
+	if time.Now().After(pending.expiresAt) {
+		c.Fail(rpc.Errorf("challenge expired, request a new one"), "")
+		return
+	}
+
+	sig, err := hexutil.Decode(req.Signature)
+	if err != nil {
+		c.Fail(rpc.Errorf("invalid signature encoding"), "")
+		return
+	}
+
+	message := rpc.PackAuthChallengeV1(req.Wallet, req.Challenge)
+	if err := h.getChannelSigValidator().Verify(req.Wallet, message, sig); err != nil {
+		c.Fail(rpc.Errorf("invalid signature: %v", err), "")
+		return
+	}
+
+	c.UserID = strings.ToLower(req.Wallet)
+
+	response := rpc.AuthV1AuthenticateResponse{
+		Wallet: c.UserID,
+	}
+
+	payload, err := rpc.NewPayload(response)
+	if err != nil {
+		c.Fail(err, "failed to create response")
+		return
+	}
+
+	c.Succeed(c.Request.Method, payload)
+}
@@ -0,0 +1,219 @@
+package auth_v1
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/layer-3/nitrolite/pkg/sign"
+)
+
+const testChallenge = "0x0102030405060708091011121314151617181920212223242526272829303132"
+
+func newTestSigner(t *testing.T) sign.Signer {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer, err := sign.NewEthereumMsgSigner(hexutil.Encode(crypto.FromECDSA(key)))
+	require.NoError(t, err)
+	return signer
+}
+
+func newAuthenticateContext(t *testing.T, req rpc.AuthV1AuthenticateRequest, expiresAt time.Time) *rpc.Context {
+	payload, err := rpc.NewPayload(req)
+	require.NoError(t, err)
+
+	storage := rpc.NewSafeStorage()
+	storage.Set(challengeStorageKey, &pendingChallenge{challenge: testChallenge, expiresAt: expiresAt})
+
+	return &rpc.Context{
+		Context: context.Background(),
+		Request: rpc.Message{Method: rpc.AuthV1AuthenticateMethod.String(), Payload: payload},
+		Storage: storage,
+	}
+}
+
+func signChallenge(t *testing.T, signer core.ChannelSigner, wallet, challenge string) string {
+	sig, err := signer.Sign(rpc.PackAuthChallengeV1(wallet, challenge))
+	require.NoError(t, err)
+	return hexutil.Encode(sig)
+}
+
+func TestAuthenticate_WalletSignature(t *testing.T) {
+	handler := NewHandler(new(MockStore))
+
+	walletSigner := newTestSigner(t)
+	channelSigner, err := core.NewChannelDefaultSigner(walletSigner)
+	require.NoError(t, err)
+	wallet := walletSigner.PublicKey().Address().String()
+
+	ctx := newAuthenticateContext(t, rpc.AuthV1AuthenticateRequest{
+		Wallet:    wallet,
+		Challenge: testChallenge,
+		Signature: signChallenge(t, channelSigner, wallet, testChallenge),
+	}, time.Now().Add(time.Minute))
+
+	handler.Authenticate(ctx)
+
+	require.Nil(t, ctx.Response.Error())
+	var response rpc.AuthV1AuthenticateResponse
+	require.NoError(t, ctx.Response.Payload.Translate(&response))
+	assert.Equal(t, strings.ToLower(wallet), response.Wallet)
+	assert.Equal(t, strings.ToLower(wallet), ctx.UserID)
+
+	// The challenge cannot be used again
+	ctx.UserID = ""
+	handler.Authenticate(ctx)
+	require.NotNil(t, ctx.Response.Error())
+	assert.Contains(t, ctx.Response.Error().Error(), "unknown challenge")
+	assert.Empty(t, ctx.UserID)
+}
+
+func TestAuthenticate_SessionKeySignature(t *testing.T) {
+	walletSigner := newTestSigner(t)
+	wallet := walletSigner.PublicKey().Address().String()
+	sessionSigner := newTestSigner(t)
+	sessionKey := sessionSigner.PublicKey().Address().String()
+
+	sessionKeyState := core.ChannelSessionKeyStateV1{
+		UserAddress: strings.ToLower(wallet),
+		SessionKey:  strings.ToLower(sessionKey),
+		Version:     1,
+		Assets:      []string{"usdc"},
+		ExpiresAt:   time.Unix(time.Now().Add(time.Hour).Unix(), 0),
+	}
+	metadataHash, err := core.GetChannelSessionKeyAuthMetadataHashV1(sessionKeyState.Version, sessionKeyState.Assets, sessionKeyState.ExpiresAt.Unix())
+	require.NoError(t, err)
+	packedAuth, err := core.PackChannelKeyStateV1(sessionKey, metadataHash)
+	require.NoError(t, err)
+	authSig, err := walletSigner.Sign(packedAuth)
+	require.NoError(t, err)
+	sessionKeyState.UserSig = hexutil.Encode(authSig)
+
+	channelSigner, err := core.NewChannelSessionKeySignerV1(sessionSigner, metadataHash.Hex(), sessionKeyState.UserSig)
+	require.NoError(t, err)
+
+	t.Run("active session key", func(t *testing.T) {
+		mockStore := new(MockStore)
+		mockStore.On("GetLastChannelSessionKeyStates", strings.ToLower(wallet), mock.Anything).
+			Return([]core.ChannelSessionKeyStateV1{sessionKeyState}, nil)
+		handler := NewHandler(mockStore)
+
+		ctx := newAuthenticateContext(t, rpc.AuthV1AuthenticateRequest{
+			Wallet:    wallet,
+			Challenge: testChallenge,
+			Signature: signChallenge(t, channelSigner, wallet, testChallenge),
+		}, time.Now().Add(time.Minute))
+
+		handler.Authenticate(ctx)
+
+		require.Nil(t, ctx.Response.Error())
+		assert.Equal(t, strings.ToLower(wallet), ctx.UserID)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("expired session key", func(t *testing.T) {
+		expiredState := sessionKeyState
+		expiredState.ExpiresAt = time.Now().Add(-time.Minute)
+
+		mockStore := new(MockStore)
+		mockStore.On("GetLastChannelSessionKeyStates", strings.ToLower(wallet), mock.Anything).
+			Return([]core.ChannelSessionKeyStateV1{expiredState}, nil)
+		handler := NewHandler(mockStore)
+
+		ctx := newAuthenticateContext(t, rpc.AuthV1AuthenticateRequest{
+			Wallet:    wallet,
+			Challenge: testChallenge,
+			Signature: signChallenge(t, channelSigner, wallet, testChallenge),
+		}, time.Now().Add(time.Minute))
+
+		handler.Authenticate(ctx)
+
+		require.NotNil(t, ctx.Response.Error())
+		assert.Contains(t, ctx.Response.Error().Error(), "session key does not have permission")
+		assert.Empty(t, ctx.UserID)
+	})
+}
+
+func TestAuthenticate_Rejected(t *testing.T) {
+	walletSigner := newTestSigner(t)
+	channelSigner, err := core.NewChannelDefaultSigner(walletSigner)
+	require.NoError(t, err)
+	wallet := walletSigner.PublicKey().Address().String()
+	otherWallet := newTestSigner(t).PublicKey().Address().String()
+
+	tests := []struct {
+		name          string
+		req           rpc.AuthV1AuthenticateRequest
+		expiresAt     time.Time
+		expectedError string
+	}{
+		{
+			name:          "invalid wallet",
+			req:           rpc.AuthV1AuthenticateRequest{Wallet: "0xinvalid", Challenge: testChallenge},
+			expiresAt:     time.Now().Add(time.Minute),
+			expectedError: "invalid wallet address",
+		},
+		{
+			name: "unknown challenge",
+			req: rpc.AuthV1AuthenticateRequest{
+				Wallet:    wallet,
+				Challenge: "0xdeadbeef",
+				Signature: signChallenge(t, channelSigner, wallet, "0xdeadbeef"),
+			},
+			expiresAt:     time.Now().Add(time.Minute),
+			expectedError: "unknown challenge",
+		},
+		{
+			name: "expired challenge",
+			req: rpc.AuthV1AuthenticateRequest{
+				Wallet:    wallet,
+				Challenge: testChallenge,
+				Signature: signChallenge(t, channelSigner, wallet, testChallenge),
+			},
+			expiresAt:     time.Now().Add(-time.Second),
+			expectedError: "challenge expired",
+		},
+		{
+			name: "signed by another wallet",
+			req: rpc.AuthV1AuthenticateRequest{
+				Wallet:    otherWallet,
+				Challenge: testChallenge,
+				Signature: signChallenge(t, channelSigner, otherWallet, testChallenge),
+			},
+			expiresAt:     time.Now().Add(time.Minute),
+			expectedError: "invalid signature",
+		},
+		{
+			name: "signed for another wallet",
+			req: rpc.AuthV1AuthenticateRequest{
+				Wallet:    wallet,
+				Challenge: testChallenge,
+				Signature: signChallenge(t, channelSigner, otherWallet, testChallenge),
+			},
+			expiresAt:     time.Now().Add(time.Minute),
+			expectedError: "invalid signature",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewHandler(new(MockStore))
+			ctx := newAuthenticateContext(t, tc.req, tc.expiresAt)
+
+			handler.Authenticate(ctx)
+
+			require.NotNil(t, ctx.Response.Error())
+			assert.Contains(t, ctx.Response.Error().Error(), tc.expectedError)
+			assert.Empty(t, ctx.UserID)
+		})
+	}
+}
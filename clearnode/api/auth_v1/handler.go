@@ -0,0 +1,58 @@
+package auth_v1
+
+import (
+	"strings"
+	"time"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+)
+
+const (
+	// challengeStorageKey is the key used to store the pending challenge in connection storage.
+	challengeStorageKey = "auth_challenge"
+	// challengeTTL is how long an issued challenge can be used to authenticate.
+	challengeTTL = 5 * time.Minute
+)
+
+// Handler authenticates RPC connections with a challenge signed by the user's wallet
+// or by one of its channel session keys.
+type Handler struct {
+	store Store
+}
+
+// NewHandler creates a new Handler instance with the provided dependencies.
+func NewHandler(store Store) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+// pendingChallenge is a challenge issued to a connection and not used yet.
+type pendingChallenge struct {
+	challenge string
+	expiresAt time.Time
+}
+
+// getChannelSigValidator returns a validator accepting signatures of the wallet itself
+// and of its active channel session keys.
+func (h *Handler) getChannelSigValidator() *core.ChannelSigValidator {
+	return core.NewChannelSigValidator(func(walletAddr, sessionKeyAddr, metadataHash string) (bool, error) {
+		states, err := h.store.GetLastChannelSessionKeyStates(walletAddr, &sessionKeyAddr)
+		if err != nil {
+			return false, err
+		}
+
+		for _, state := range states {
+			if !strings.EqualFold(state.SessionKey, sessionKeyAddr) || !state.ExpiresAt.After(time.Now()) {
+				continue
+			}
+
+			stateMetadataHash, err := core.GetChannelSessionKeyAuthMetadataHashV1(state.Version, state.Assets, state.ExpiresAt.Unix())
+			if err != nil {
+				return false, err
+			}
+			return strings.EqualFold(stateMetadataHash.Hex(), metadataHash), nil
+		}
+		return false, nil
+	})
+}
@@ -0,0 +1,12 @@
+package auth_v1
+
+import (
+	"github.com/layer-3/nitrolite/pkg/core"
+)
+
+// Store defines the persistence layer interface for connection authentication.
+type Store interface {
+	// GetLastChannelSessionKeyStates retrieves the latest channel session key states for a user,
+	// optionally filtered by session key.
+	GetLastChannelSessionKeyStates(wallet string, sessionKey *string) ([]core.ChannelSessionKeyStateV1, error)
+}
@@ -0,0 +1,40 @@
+package auth_v1
+
+import (
+	"crypto/rand"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// RequestChallenge issues a random challenge for the connection to sign in Authenticate.
+// Requesting a new challenge replaces the pending one.
+func (h *Handler) RequestChallenge(c *rpc.Context) {
+	nonce := make([]byte, 32)
+	if _, err := rand.Read(nonce); err != nil {
+		c.Fail(err, "failed to generate challenge")
+		return
+	}
+
+	pending := pendingChallenge{
+		challenge: hexutil.Encode(nonce),
+		expiresAt: time.Now().Add(challengeTTL),
+	}
+	c.Storage.Set(challengeStorageKey, &pending)
+
+	response := rpc.AuthV1RequestChallengeResponse{
+		Challenge: pending.challenge,
+		ExpiresAt: strconv.FormatInt(pending.expiresAt.Unix(), 10),
+	}
+
+	payload, err := rpc.NewPayload(response)
+	if err != nil {
+		c.Fail(err, "failed to create response")
+		return
+	}
+
+	c.Succeed(c.Request.Method, payload)
+}
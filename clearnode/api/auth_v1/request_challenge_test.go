@@ -0,0 +1,44 @@
+package auth_v1
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+func TestRequestChallenge_Success(t *testing.T) {
+	handler := NewHandler(new(MockStore))
+
+	ctx := &rpc.Context{
+		Context: context.Background(),
+		Request: rpc.Message{Method: rpc.AuthV1RequestChallengeMethod.String()},
+		Storage: rpc.NewSafeStorage(),
+	}
+
+	handler.RequestChallenge(ctx)
+
+	require.Nil(t, ctx.Response.Error())
+	var response rpc.AuthV1RequestChallengeResponse
+	require.NoError(t, ctx.Response.Payload.Translate(&response))
+	assert.Len(t, response.Challenge, 66)
+
+	expiresAt, err := strconv.ParseInt(response.ExpiresAt, 10, 64)
+	require.NoError(t, err)
+	assert.InDelta(t, time.Now().Add(challengeTTL).Unix(), expiresAt, 5)
+
+	// A new request replaces the pending challenge
+	handler.RequestChallenge(ctx)
+	var next rpc.AuthV1RequestChallengeResponse
+	require.NoError(t, ctx.Response.Payload.Translate(&next))
+	assert.NotEqual(t, response.Challenge, next.Challenge)
+
+	val, ok := ctx.Storage.Get(challengeStorageKey)
+	require.True(t, ok)
+	assert.Equal(t, next.Challenge, val.(*pendingChallenge).challenge)
+}
@@ -0,0 +1,20 @@
+package auth_v1
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+)
+
+// MockStore is a mock implementation of the Store interface
+type MockStore struct {
+	mock.Mock
+}
+
+func (m *MockStore) GetLastChannelSessionKeyStates(wallet string, sessionKey *string) ([]core.ChannelSessionKeyStateV1, error) {
+	args := m.Called(wallet, sessionKey)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]core.ChannelSessionKeyStateV1), args.Error(1)
+}
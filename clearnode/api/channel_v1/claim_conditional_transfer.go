@@ -0,0 +1,76 @@
+package channel_v1
+
+import (
+	"time"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// ClaimConditionalTransfer credits a locked conditional transfer to its receiver.
+// The preimage of the hash lock must be revealed before the transfer expires.
+// The Node issues a conditional_claim state for the receiver and marks the transfer as claimed.
+func (h *Handler) ClaimConditionalTransfer(c *rpc.Context) {
+	ctx := c.Context
+	logger := log.FromContext(ctx)
+
+	var reqPayload rpc.ChannelsV1ClaimConditionalTransferRequest
+	if err := c.Request.Payload.Translate(&reqPayload); err != nil {
+		c.Fail(err, "failed to parse parameters")
+		return
+	}
+
+	var transfer core.ConditionalTransfer
+	var receiverState *core.State
+	err := h.useStoreInTx(func(tx Store) error {
+		lockedTransfer, err := tx.LockConditionalTransfer(reqPayload.ConditionalTransferID)
+		if err != nil {
+			return rpc.Errorf("failed to get conditional transfer: %v", err)
+		}
+		if lockedTransfer == nil {
+			return rpc.Errorf("conditional transfer %s not found", reqPayload.ConditionalTransferID)
+		}
+		if lockedTransfer.Status != core.ConditionalTransferStatusLocked {
+			return rpc.Errorf("conditional transfer is already %s", lockedTransfer.Status.String())
+		}
+		if lockedTransfer.IsExpired(time.Now()) {
+			return rpc.Errorf("conditional transfer has expired")
+		}
+		if err := lockedTransfer.VerifyPreimage(reqPayload.Preimage); err != nil {
+			return rpc.NewError(err)
+		}
+
+		transfer = *lockedTransfer
+		transfer.Status = core.ConditionalTransferStatusClaimed
+		transfer.Preimage = &reqPayload.Preimage
+
+		receiverState, err = h.settleConditionalTransfer(ctx, tx, transfer)
+		return err
+	})
+	if err != nil {
+		logger.Error("failed to claim conditional transfer", "error", err)
+		c.Fail(err, "failed to claim conditional transfer")
+		return
+	}
+
+	resp := rpc.ChannelsV1ClaimConditionalTransferResponse{
+		State: coreStateToRPC(*receiverState),
+	}
+	payload, err := rpc.NewPayload(resp)
+	if err != nil {
+		c.Fail(err, "failed to create response")
+		return
+	}
+
+	c.Succeed(c.Request.Method, payload)
+
+	h.eventPublisher.PublishTransferReceived(ctx, *receiverState)
+	h.eventPublisher.PublishConditionalTransferUpdated(ctx, transfer)
+
+	logger.Info("claimed conditional transfer",
+		"conditionalTransferID", transfer.ID,
+		"receiver", transfer.Receiver,
+		"asset", transfer.Asset,
+		"amount", transfer.Amount.String())
+}
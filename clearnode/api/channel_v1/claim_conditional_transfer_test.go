@@ -0,0 +1,168 @@
+package channel_v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+func newTestConditionalTransfer(t *testing.T, sender, receiver string, expiresAt time.Time) core.ConditionalTransfer {
+	hashLock, err := core.GetHashLock(testConditionalPreimage)
+	require.NoError(t, err)
+
+	return core.ConditionalTransfer{
+		ID:          "0x9f1c2d7e6a4b3c5d8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d",
+		Sender:      sender,
+		Receiver:    receiver,
+		Asset:       "USDC",
+		Amount:      decimal.NewFromInt(100),
+		HashLock:    hashLock,
+		ExpiresAt:   expiresAt,
+		Status:      core.ConditionalTransferStatusLocked,
+		LockStateID: core.GetStateID(sender, "USDC", 1, 2),
+	}
+}
+
+func claimConditionalTransferRequest(t *testing.T, handler *Handler, transferID, preimage string) *rpc.Context {
+	payload, err := rpc.NewPayload(rpc.ChannelsV1ClaimConditionalTransferRequest{
+		ConditionalTransferID: transferID,
+		Preimage:              preimage,
+	})
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context: context.Background(),
+		Request: rpc.Message{
+			Method:  rpc.ChannelsV1ClaimConditionalTransferMethod.String(),
+			Payload: payload,
+		},
+	}
+	handler.ClaimConditionalTransfer(ctx)
+	return ctx
+}
+
+func TestClaimConditionalTransfer_Success(t *testing.T) {
+	mockTxStore := new(MockStore)
+	mockStatePacker := new(MockStatePacker)
+	mockNotifier := new(MockNotifier)
+	handler := newSwapTestHandler(mockTxStore, new(MockAssetStore), mockStatePacker, mockNotifier)
+
+	senderWallet := NewMockSigner().PublicKey().Address().String()
+	receiverWallet := NewMockSigner().PublicKey().Address().String()
+	transfer := newTestConditionalTransfer(t, senderWallet, receiverWallet, time.Now().Add(time.Hour))
+	receiverState := newSwapTestState(receiverWallet, "USDC", "0xReceiverChannel", 50)
+
+	mockStatePacker.On("PackState", mock.Anything).Return([]byte("packed"), nil)
+	mockTxStore.On("LockConditionalTransfer", transfer.ID).Return(transfer, nil).Once()
+	mockTxStore.On("LockUserState", receiverWallet, "USDC").Return(decimal.Zero, nil).Once()
+	mockTxStore.On("GetLastUserState", receiverWallet, "USDC", false).Return(receiverState, nil)
+	mockTxStore.On("GetLastUserState", receiverWallet, "USDC", true).Return(receiverState, nil)
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == receiverWallet &&
+			state.Transition.Type == core.TransitionTypeConditionalClaim &&
+			state.Transition.AccountID == transfer.ID &&
+			state.HomeLedger.UserBalance.Equal(decimal.NewFromInt(150)) &&
+			state.NodeSig != nil
+	})).Return(nil).Once()
+	mockTxStore.On("UpdateConditionalTransfer", mock.MatchedBy(func(updated core.ConditionalTransfer) bool {
+		return updated.ID == transfer.ID &&
+			updated.Status == core.ConditionalTransferStatusClaimed &&
+			updated.Preimage != nil && *updated.Preimage == testConditionalPreimage
+	})).Return(nil).Once()
+	mockTxStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
+		return tx.TxType == core.TransactionTypeConditionalClaim &&
+			tx.FromAccount == transfer.ID && tx.ToAccount == receiverWallet &&
+			tx.Amount.Equal(transfer.Amount)
+	})).Return(nil).Once()
+	mockNotifier.On("Notify", receiverWallet, rpc.ChannelsV1TransferReceivedEventName, mock.Anything).Return().Once()
+	mockNotifier.On("Notify", senderWallet, rpc.ChannelsV1ConditionalTransferUpdatedEventName, mock.Anything).Return().Once()
+	mockNotifier.On("Notify", receiverWallet, rpc.ChannelsV1ConditionalTransferUpdatedEventName, mock.Anything).Return().Once()
+
+	ctx := claimConditionalTransferRequest(t, handler, transfer.ID, testConditionalPreimage)
+
+	require.Nil(t, ctx.Response.Error())
+	var response rpc.ChannelsV1ClaimConditionalTransferResponse
+	require.NoError(t, ctx.Response.Payload.Translate(&response))
+	assert.Equal(t, receiverWallet, response.State.UserWallet)
+	assert.Equal(t, core.TransitionTypeConditionalClaim, response.State.Transition.Type)
+	assert.Equal(t, "2", response.State.Version)
+
+	mockTxStore.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestClaimConditionalTransfer_Rejected(t *testing.T) {
+	senderWallet := NewMockSigner().PublicKey().Address().String()
+	receiverWallet := NewMockSigner().PublicKey().Address().String()
+
+	claimed := newTestConditionalTransfer(t, senderWallet, receiverWallet, time.Now().Add(time.Hour))
+	claimed.Status = core.ConditionalTransferStatusClaimed
+
+	tests := []struct {
+		name          string
+		transfer      *core.ConditionalTransfer
+		preimage      string
+		expectedError string
+	}{
+		{
+			name:          "not found",
+			transfer:      nil,
+			preimage:      testConditionalPreimage,
+			expectedError: "not found",
+		},
+		{
+			name:          "already claimed",
+			transfer:      &claimed,
+			preimage:      testConditionalPreimage,
+			expectedError: "conditional transfer is already claimed",
+		},
+		{
+			name: "expired",
+			transfer: func() *core.ConditionalTransfer {
+				transfer := newTestConditionalTransfer(t, senderWallet, receiverWallet, time.Now().Add(-time.Minute))
+				return &transfer
+			}(),
+			preimage:      testConditionalPreimage,
+			expectedError: "conditional transfer has expired",
+		},
+		{
+			name: "wrong preimage",
+			transfer: func() *core.ConditionalTransfer {
+				transfer := newTestConditionalTransfer(t, senderWallet, receiverWallet, time.Now().Add(time.Hour))
+				return &transfer
+			}(),
+			preimage:      "0x0202020202020202020202020202020202020202020202020202020202020202",
+			expectedError: "preimage does not match hash lock",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTxStore := new(MockStore)
+			handler := newSwapTestHandler(mockTxStore, new(MockAssetStore), new(MockStatePacker), new(MockNotifier))
+
+			transferID := claimed.ID
+			if tt.transfer != nil {
+				mockTxStore.On("LockConditionalTransfer", transferID).Return(*tt.transfer, nil).Once()
+			} else {
+				mockTxStore.On("LockConditionalTransfer", transferID).Return(nil, nil).Once()
+			}
+
+			ctx := claimConditionalTransferRequest(t, handler, transferID, tt.preimage)
+
+			respErr := ctx.Response.Error()
+			require.NotNil(t, respErr)
+			assert.Contains(t, respErr.Error(), tt.expectedError)
+			mockTxStore.AssertNotCalled(t, "StoreUserState", mock.Anything)
+			mockTxStore.AssertNotCalled(t, "UpdateConditionalTransfer", mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package channel_v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+)
+
+// ConditionalTransferSweeper periodically refunds expired conditional transfers to their senders,
+// so that funds of unclaimed transfers don't stay locked until the sender requests a refund.
+type ConditionalTransferSweeper struct {
+	handler   *Handler
+	interval  time.Duration
+	batchSize uint32
+	logger    log.Logger
+}
+
+// NewConditionalTransferSweeper creates a new ConditionalTransferSweeper that checks for expired
+// conditional transfers every interval and refunds at most batchSize of them per sweep.
+func NewConditionalTransferSweeper(
+	useStoreInTx StoreTxProvider,
+	nodeSigner *core.ChannelDefaultSigner,
+	statePacker core.StatePacker,
+	eventPublisher *EventPublisher,
+	interval time.Duration,
+	batchSize uint32,
+	logger log.Logger,
+) *ConditionalTransferSweeper {
+	return &ConditionalTransferSweeper{
+		handler: &Handler{
+			useStoreInTx:   useStoreInTx,
+			nodeSigner:     nodeSigner,
+			statePacker:    statePacker,
+			eventPublisher: eventPublisher,
+		},
+		interval:  interval,
+		batchSize: batchSize,
+		logger:    logger.WithName("conditional-transfer-sweeper"),
+	}
+}
+
+// Run sweeps expired conditional transfers until the context is cancelled.
+func (s *ConditionalTransferSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.Sweep(ctx, time.Now())
+		case <-ctx.Done():
+			s.logger.Info("stopping conditional transfer sweeper")
+			return
+		}
+	}
+}
+
+// Sweep refunds the conditional transfers that expired at or before the given time.
+// Each transfer is refunded in its own store transaction, so a failure doesn't block the others.
+// Returns the number of refunded transfers.
+func (s *ConditionalTransferSweeper) Sweep(ctx context.Context, now time.Time) int {
+	ctx = log.SetContextLogger(ctx, s.logger)
+
+	var expired []core.ConditionalTransfer
+	err := s.handler.useStoreInTx(func(tx Store) error {
+		var err error
+		expired, err = tx.GetExpiredConditionalTransfers(now, s.batchSize)
+		return err
+	})
+	if err != nil {
+		s.logger.Error("failed to get expired conditional transfers", "error", err)
+		return 0
+	}
+
+	refunded := 0
+	for _, t := range expired {
+		transfer, senderState, err := s.handler.refundConditionalTransfer(ctx, t.ID, now)
+		if err != nil {
+			s.logger.Error("failed to refund expired conditional transfer", "error", err, "conditionalTransferID", t.ID)
+			continue
+		}
+		refunded++
+
+		s.handler.eventPublisher.PublishTransferReceived(ctx, *senderState)
+		s.handler.eventPublisher.PublishConditionalTransferUpdated(ctx, *transfer)
+	}
+
+	if refunded > 0 {
+		s.logger.Info("refunded expired conditional transfers", "count", refunded)
+	}
+	return refunded
+}
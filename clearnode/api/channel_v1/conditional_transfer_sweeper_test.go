@@ -0,0 +1,82 @@
+package channel_v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+func TestConditionalTransferSweeper_Sweep(t *testing.T) {
+	mockTxStore := new(MockStore)
+	mockStatePacker := new(MockStatePacker)
+	mockNotifier := new(MockNotifier)
+
+	nodeSigner, _ := core.NewChannelDefaultSigner(NewMockSigner())
+	useStoreInTx := func(handler StoreTxHandler) error {
+		return handler(mockTxStore)
+	}
+	sweeper := NewConditionalTransferSweeper(useStoreInTx, nodeSigner, mockStatePacker, NewEventPublisher(mockNotifier), time.Minute, 10, log.NewNoopLogger())
+
+	now := time.Now()
+	senderWallet := NewMockSigner().PublicKey().Address().String()
+	receiverWallet := NewMockSigner().PublicKey().Address().String()
+
+	refundable := newTestConditionalTransfer(t, senderWallet, receiverWallet, now.Add(-time.Minute))
+	claimedMeanwhile := newTestConditionalTransfer(t, senderWallet, receiverWallet, now.Add(-time.Hour))
+	claimedMeanwhile.ID = "0x1111111111111111111111111111111111111111111111111111111111111111"
+	claimedMeanwhile.Status = core.ConditionalTransferStatusClaimed
+	failing := newTestConditionalTransfer(t, senderWallet, receiverWallet, now.Add(-time.Hour))
+	failing.ID = "0x2222222222222222222222222222222222222222222222222222222222222222"
+
+	mockTxStore.On("GetExpiredConditionalTransfers", now, uint32(10)).
+		Return([]core.ConditionalTransfer{failing, claimedMeanwhile, refundable}, nil).Once()
+	mockTxStore.On("LockConditionalTransfer", failing.ID).Return(nil, errors.New("db error")).Once()
+	mockTxStore.On("LockConditionalTransfer", claimedMeanwhile.ID).Return(claimedMeanwhile, nil).Once()
+	mockTxStore.On("LockConditionalTransfer", refundable.ID).Return(refundable, nil).Once()
+
+	senderState := newSwapTestState(senderWallet, "USDC", "0xSenderChannel", 400)
+	mockStatePacker.On("PackState", mock.Anything).Return([]byte("packed"), nil)
+	mockTxStore.On("LockUserState", senderWallet, "USDC").Return(decimal.Zero, nil).Once()
+	mockTxStore.On("GetLastUserState", senderWallet, "USDC", false).Return(senderState, nil)
+	mockTxStore.On("GetLastUserState", senderWallet, "USDC", true).Return(senderState, nil)
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.Transition.Type == core.TransitionTypeConditionalRefund &&
+			state.Transition.AccountID == refundable.ID
+	})).Return(nil).Once()
+	mockTxStore.On("UpdateConditionalTransfer", mock.MatchedBy(func(updated core.ConditionalTransfer) bool {
+		return updated.ID == refundable.ID && updated.Status == core.ConditionalTransferStatusRefunded
+	})).Return(nil).Once()
+	mockTxStore.On("RecordTransaction", mock.Anything).Return(nil).Once()
+	mockNotifier.On("Notify", senderWallet, rpc.ChannelsV1TransferReceivedEventName, mock.Anything).Return().Once()
+	mockNotifier.On("Notify", senderWallet, rpc.ChannelsV1ConditionalTransferUpdatedEventName, mock.Anything).Return().Once()
+	mockNotifier.On("Notify", receiverWallet, rpc.ChannelsV1ConditionalTransferUpdatedEventName, mock.Anything).Return().Once()
+
+	refunded := sweeper.Sweep(context.Background(), now)
+
+	assert.Equal(t, 1, refunded)
+	mockTxStore.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestConditionalTransferSweeper_Sweep_StoreError(t *testing.T) {
+	mockTxStore := new(MockStore)
+	useStoreInTx := func(handler StoreTxHandler) error {
+		return handler(mockTxStore)
+	}
+	sweeper := NewConditionalTransferSweeper(useStoreInTx, nil, new(MockStatePacker), NewEventPublisher(new(MockNotifier)), time.Minute, 10, log.NewNoopLogger())
+
+	now := time.Now()
+	mockTxStore.On("GetExpiredConditionalTransfers", now, uint32(10)).Return(nil, errors.New("db error")).Once()
+
+	assert.Equal(t, 0, sweeper.Sweep(context.Background(), now))
+	mockTxStore.AssertNotCalled(t, "LockConditionalTransfer", mock.Anything)
+}
@@ -0,0 +1,70 @@
+package channel_v1
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// EventPublisher maps channel updates to channels.v1 events and pushes them
+// to the connections subscribed to the affected user.
+// It is shared with components outside of the RPC handlers (blockchain event handlers,
+// blockchain workers) so that all channel events have a single wire representation.
+type EventPublisher struct {
+	notifier Notifier
+}
+
+// NewEventPublisher creates a new EventPublisher delivering events through the provided notifier.
+func NewEventPublisher(notifier Notifier) *EventPublisher {
+	return &EventPublisher{
+		notifier: notifier,
+	}
+}
+
+// PublishChannelUpdated notifies the channel owner's subscribers about a change of the channel status or version.
+func (p *EventPublisher) PublishChannelUpdated(ctx context.Context, channel core.Channel) {
+	p.publish(ctx, channel.UserWallet, rpc.ChannelsV1ChannelUpdatedEventName, rpc.ChannelsV1ChannelUpdatedEvent{
+		Channel: coreChannelToRPC(channel),
+	})
+}
+
+// PublishTransferReceived notifies the receiver's subscribers about the state issued for an incoming transfer.
+func (p *EventPublisher) PublishTransferReceived(ctx context.Context, receiverState core.State) {
+	p.publish(ctx, receiverState.UserWallet, rpc.ChannelsV1TransferReceivedEventName, rpc.ChannelsV1TransferReceivedEvent{
+		State: coreStateToRPC(receiverState),
+	})
+}
+
+// PublishBlockchainActionCompleted notifies the state owner's subscribers that the Node
+// has successfully submitted the state on-chain.
+func (p *EventPublisher) PublishBlockchainActionCompleted(ctx context.Context, actionType string, blockchainID uint64, txHash string, state core.State) {
+	p.publish(ctx, state.UserWallet, rpc.ChannelsV1BlockchainActionCompletedEventName, rpc.ChannelsV1BlockchainActionCompletedEvent{
+		ActionType:   actionType,
+		BlockchainID: strconv.FormatUint(blockchainID, 10),
+		TxHash:       txHash,
+		State:        coreStateToRPC(state),
+	})
+}
+
+// PublishConditionalTransferUpdated notifies the subscribers of both the sender and the receiver
+// about a change of the conditional transfer status.
+func (p *EventPublisher) PublishConditionalTransferUpdated(ctx context.Context, transfer core.ConditionalTransfer) {
+	event := rpc.ChannelsV1ConditionalTransferUpdatedEvent{
+		ConditionalTransfer: coreConditionalTransferToRPC(transfer),
+	}
+	p.publish(ctx, transfer.Sender, rpc.ChannelsV1ConditionalTransferUpdatedEventName, event)
+	p.publish(ctx, transfer.Receiver, rpc.ChannelsV1ConditionalTransferUpdatedEventName, event)
+}
+
+func (p *EventPublisher) publish(ctx context.Context, userWallet string, event rpc.Event, data any) {
+	payload, err := rpc.NewPayload(data)
+	if err != nil {
+		log.FromContext(ctx).Error("failed to create event payload", "error", err, "event", event, "userWallet", userWallet)
+		return
+	}
+
+	p.notifier.Notify(userWallet, event, payload)
+}
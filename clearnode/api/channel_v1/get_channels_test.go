@@ -32,6 +32,7 @@ func newGetChannelsHandler(mockTxStore *MockStore) *Handler {
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
 	}
 }
 
@@ -38,6 +38,7 @@ func TestGetEscrowChannel_Success(t *testing.T) {
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
 	}
 
 	// Test data
@@ -38,6 +38,7 @@ func TestGetHomeChannel_Success(t *testing.T) {
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
 	}
 
 	// Test data
@@ -126,6 +127,7 @@ func TestGetHomeChannel_NotFound(t *testing.T) {
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
 	}
 
 	// Test data
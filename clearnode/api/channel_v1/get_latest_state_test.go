@@ -39,6 +39,7 @@ func TestGetLatestState_Success(t *testing.T) {
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
 	}
 
 	// Test data
@@ -138,6 +139,7 @@ func TestGetLatestState_OnlySigned(t *testing.T) {
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
 	}
 
 	// Test data
@@ -2,6 +2,11 @@ package channel_v1
 
 import (
 	"context"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/shopspring/decimal"
 
 	"github.com/layer-3/nitrolite/clearnode/metrics"
 	"github.com/layer-3/nitrolite/pkg/core"
@@ -14,13 +19,19 @@ type Handler struct {
 	useStoreInTx     StoreTxProvider
 	memoryStore      MemoryStore
 	actionGateway    ActionGateway
+	feeEngine        FeeEngine
+	nodeBalances     NodeBalanceProvider
 	nodeSigner       *core.ChannelDefaultSigner
 	stateAdvancer    core.StateAdvancer
 	statePacker      core.StatePacker
 	nodeAddress      string // Node's wallet address for channel ID calculation
 	minChallenge     uint32
 	metrics          metrics.RuntimeMetricExporter
+	notifier         Notifier
+	eventPublisher   *EventPublisher
 	maxSessionKeyIDs int
+
+	streamCreditPeriod time.Duration
 }
 
 // NewHandler creates a new Handler instance with the provided dependencies.
@@ -28,12 +39,15 @@ func NewHandler(
 	useStoreInTx StoreTxProvider,
 	memoryStore MemoryStore,
 	actionGateway ActionGateway,
+	feeEngine FeeEngine,
+	nodeBalances NodeBalanceProvider,
 	nodeSigner *core.ChannelDefaultSigner,
 	stateAdvancer core.StateAdvancer,
 	statePacker core.StatePacker,
 	nodeAddress string,
 	minChallenge uint32,
 	m metrics.RuntimeMetricExporter,
+	notifier Notifier,
 	maxSessionKeyIDs int,
 ) *Handler {
 	return &Handler{
@@ -42,10 +56,14 @@ func NewHandler(
 		useStoreInTx:     useStoreInTx,
 		memoryStore:      memoryStore,
 		actionGateway:    actionGateway,
+		feeEngine:        feeEngine,
+		nodeBalances:     nodeBalances,
 		nodeSigner:       nodeSigner,
 		nodeAddress:      nodeAddress,
 		minChallenge:     minChallenge,
 		metrics:          m,
+		notifier:         notifier,
+		eventPublisher:   NewEventPublisher(notifier),
 		maxSessionKeyIDs: maxSessionKeyIDs,
 	}
 }
@@ -56,27 +74,133 @@ func (h *Handler) getChannelSigValidator(tx Store, asset string) *core.ChannelSi
 	})
 }
 
-// issueTransferReceiverState creates and stores a new state for the receiver of a transfer.
-// It reads the receiver's current state, applies a transfer_receive transition with the same
-// amount and tx hash, signs it with the node's key, and persists it.
-func (h *Handler) issueTransferReceiverState(ctx context.Context, tx Store, senderState core.State) (*core.State, error) {
+// acceptUserSignedState validates the incoming state against the user's current state,
+// verifies the user's signature and signs the state with the node's key.
+// The caller is expected to hold the lock on the user's state.
+func (h *Handler) acceptUserSignedState(tx Store, incomingState *core.State) error {
+	err := h.actionGateway.AllowAction(tx, incomingState.UserWallet, incomingState.Transition.Type.GatedAction())
+	if err != nil {
+		return rpc.NewError(err)
+	}
+
+	approvedSigValidators, userHasOpenChannel, err := tx.CheckOpenChannel(incomingState.UserWallet, incomingState.Asset)
+	if err != nil {
+		return rpc.Errorf("failed to check open channel: %v", err)
+	}
+	if !userHasOpenChannel {
+		return rpc.Errorf("user %s has no open %s channel", incomingState.UserWallet, incomingState.Asset)
+	}
+
+	currentState, err := tx.GetLastUserState(incomingState.UserWallet, incomingState.Asset, false)
+	if err != nil {
+		return rpc.Errorf("failed to get last user state: %v", err)
+	}
+	if currentState == nil {
+		currentState = core.NewVoidState(incomingState.Asset, incomingState.UserWallet)
+	}
+
+	if err := tx.EnsureNoOngoingStateTransitions(currentState.UserWallet, currentState.Asset); err != nil {
+		return rpc.Errorf("ongoing state transitions check failed: %v", err)
+	}
+
+	if err := h.stateAdvancer.ValidateAdvancement(*currentState, *incomingState); err != nil {
+		return rpc.Errorf("invalid state transition: %w", err)
+	}
+
+	packedState, err := h.statePacker.PackState(*incomingState)
+	if err != nil {
+		return rpc.Errorf("failed to pack state: %v", err)
+	}
+
+	if incomingState.UserSig == nil {
+		return rpc.Errorf("missing incoming state user signature")
+	}
+	userSigBytes, err := hexutil.Decode(*incomingState.UserSig)
+	if err != nil {
+		return rpc.Errorf("failed to decode incoming state user signature: %v", err)
+	}
+
+	sigType, err := core.GetSignerType(userSigBytes)
+	if err != nil {
+		return rpc.Errorf("failed to get user signature type: %v", err)
+	}
+	if !core.IsChannelSignerSupported(approvedSigValidators, sigType) {
+		return rpc.Errorf("user signature type '%d' is not supported by channel", sigType)
+	}
+	sigValidator := h.getChannelSigValidator(tx, incomingState.Asset)
+	if err := sigValidator.Verify(incomingState.UserWallet, packedState, userSigBytes); err != nil {
+		h.metrics.IncChannelStateSigValidation(sigType, false)
+		return rpc.Errorf("invalid incoming state user signature: %v", err)
+	}
+	h.metrics.IncChannelStateSigValidation(sigType, true)
+
+	_nodeSig, err := h.nodeSigner.Sign(packedState)
+	if err != nil {
+		return rpc.Errorf("failed to sign incoming state: %v", err)
+	}
+	nodeSig := _nodeSig.String()
+	incomingState.NodeSig = &nodeSig
+
+	return nil
+}
+
+// signNodeIssuedState signs a state the node issues on its own with the node's key.
+// The state is left unsigned if the user has no home channel, or while the user's last signed state
+// defers node signatures until its lock is completed.
+func (h *Handler) signNodeIssuedState(tx Store, state *core.State) error {
+	lastSignedState, err := tx.GetLastUserState(state.UserWallet, state.Asset, true)
+	if err != nil {
+		return rpc.Errorf("failed to get last signed %s user state for address %s", state.Asset, state.UserWallet)
+	}
+	if state.HomeChannelID == nil || (lastSignedState != nil && lastSignedState.DefersNodeSignatures()) {
+		return nil
+	}
+
+	packedState, err := h.statePacker.PackState(*state)
+	if err != nil {
+		return rpc.Errorf("failed to pack node-issued state: %v", err)
+	}
+
+	_nodeSig, err := h.nodeSigner.Sign(packedState)
+	if err != nil {
+		return rpc.Errorf("failed to sign node-issued state")
+	}
+	nodeSig := _nodeSig.String()
+	state.NodeSig = &nodeSig
+
+	return nil
+}
+
+// receiveTransitionTypes maps the sending transitions crediting another user to the transitions
+// issued to that user by the node.
+var receiveTransitionTypes = map[core.TransitionType]core.TransitionType{
+	core.TransitionTypeTransferSend: core.TransitionTypeTransferReceive,
+	core.TransitionTypeSwapSend:     core.TransitionTypeSwapReceive,
+}
+
+// issueReceiverState creates and stores a new state for receiverWallet, the user credited by the sender's state.
+// It reads the receiver's current state, applies a transition of the given receive type with the same
+// amount and tx hash as the sender's matching send transition, signs it with the node's key, and persists it.
+// If chargeFee is set, the fee of the sender's gated action is deducted from the amount credited to the
+// receiver and recorded as a separate fee transaction from the receiver to the node fee account.
+func (h *Handler) issueReceiverState(ctx context.Context, tx Store, senderState core.State, receiverWallet string, receiveType core.TransitionType, chargeFee bool) (*core.State, error) {
 	logger := log.FromContext(ctx)
 
 	incomingTransition := senderState.Transition
-	if incomingTransition.Type != core.TransitionTypeTransferSend {
-		return nil, rpc.Errorf("incoming state doesn't have 'transfer_send' transition")
+	if receiveTransitionTypes[incomingTransition.Type] != receiveType {
+		return nil, rpc.Errorf("incoming state transition '%s' cannot be received as '%s'", incomingTransition.Type.String(), receiveType.String())
 	}
-	receiverWallet := incomingTransition.AccountID
-	if senderState.UserWallet == receiverWallet {
+	if strings.EqualFold(senderState.UserWallet, receiverWallet) {
 		return nil, rpc.Errorf("sender and receiver wallets are the same")
 	}
 
 	logger = logger.
 		WithKV("sender", senderState.UserWallet).
 		WithKV("receiver", receiverWallet).
-		WithKV("asset", senderState.Asset)
+		WithKV("asset", senderState.Asset).
+		WithKV("transition", receiveType.String())
 
-	logger.Debug("issuing transfer receiver state")
+	logger.Debug("issuing receiver state")
 
 	// Lock the receiver's state to prevent concurrent modifications
 	if _, err := tx.LockUserState(receiverWallet, senderState.Asset); err != nil {
@@ -85,100 +209,171 @@ func (h *Handler) issueTransferReceiverState(ctx context.Context, tx Store, send
 
 	currentState, err := tx.GetLastUserState(receiverWallet, senderState.Asset, false)
 	if err != nil {
-		return nil, rpc.Errorf("failed to get last %s user state for transfer receiver with address %s", senderState.Asset, incomingTransition.AccountID)
+		return nil, rpc.Errorf("failed to get last %s user state for receiver with address %s", senderState.Asset, receiverWallet)
 	}
 	if currentState == nil {
 		currentState = core.NewVoidState(senderState.Asset, receiverWallet)
 	}
-	newState := currentState.NextState()
+	newState := currentState.NextNodeIssuedState()
 
-	_, err = newState.ApplyTransferReceiveTransition(
-		senderState.UserWallet,
-		incomingTransition.Amount,
-		incomingTransition.TxID)
+	fee := decimal.Zero
+	if chargeFee {
+		action := incomingTransition.Type.GatedAction()
+		fee = h.feeEngine.CalculateFee(senderState.Asset, action, incomingTransition.Amount)
+		if fee.IsPositive() && fee.GreaterThanOrEqual(incomingTransition.Amount) {
+			return nil, rpc.Errorf("%s amount %s does not cover the %s fee %s", action, incomingTransition.Amount.String(), action, fee.String())
+		}
+	}
+
+	switch receiveType {
+	case core.TransitionTypeTransferReceive:
+		_, err = newState.ApplyTransferReceiveTransition(senderState.UserWallet, incomingTransition.Amount.Sub(fee), incomingTransition.TxID)
+	case core.TransitionTypeSwapReceive:
+		_, err = newState.ApplySwapReceiveTransition(senderState.UserWallet, incomingTransition.Amount.Sub(fee), incomingTransition.TxID)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	lastSignedState, err := tx.GetLastUserState(receiverWallet, senderState.Asset, true)
-	if err != nil {
-		return nil, rpc.Errorf("failed to get last %s user state for transfer receiver with address %s", senderState.Asset, incomingTransition.AccountID)
+	if err := h.signNodeIssuedState(tx, newState); err != nil {
+		return nil, err
+	}
+	if err := tx.StoreUserState(*newState); err != nil {
+		return nil, rpc.Errorf("failed to store receiver state")
 	}
 
-	// TODO: move to DB query
-	shouldSign := true
-	if lastSignedState != nil {
-		lastStateTransition := lastSignedState.Transition
-		if lastStateTransition.Type == core.TransitionTypeMutualLock ||
-			lastStateTransition.Type == core.TransitionTypeEscrowLock {
-			shouldSign = false
+	if fee.IsPositive() {
+		feeTransaction, err := core.NewFeeTransaction(newState.Asset, receiverWallet, h.feeEngine.FeeAccount(), newState.ID, fee)
+		if err != nil {
+			return nil, rpc.Errorf("failed to create fee transaction: %v", err)
 		}
+		if err := tx.RecordTransaction(*feeTransaction); err != nil {
+			return nil, rpc.Errorf("failed to record fee transaction")
+		}
+		logger.Info("recorded receiver fee", "txID", feeTransaction.ID, "fee", fee.String())
+	}
+
+	logger.Info("issued receiver state", "receiverStateVersion", newState.Version)
+	return newState, nil
+}
+
+// settleConditionalTransfer issues the state settling a conditional transfer and persists the new
+// transfer status. Claimed transfers are credited to the receiver with a conditional_claim transition,
+// refunded transfers are returned to the sender with a conditional_refund transition.
+// It locks the credited user's state, signs the new state with the node's key, stores it and
+// records the settlement transaction. Returns the credited user's new state.
+func (h *Handler) settleConditionalTransfer(ctx context.Context, tx Store, transfer core.ConditionalTransfer) (*core.State, error) {
+	logger := log.FromContext(ctx)
+
+	var userWallet string
+	switch transfer.Status {
+	case core.ConditionalTransferStatusClaimed:
+		userWallet = transfer.Receiver
+	case core.ConditionalTransferStatusRefunded:
+		userWallet = transfer.Sender
+	default:
+		return nil, rpc.Errorf("conditional transfer cannot be settled with status '%s'", transfer.Status.String())
+	}
+
+	logger = logger.
+		WithKV("conditionalTransferID", transfer.ID).
+		WithKV("userWallet", userWallet).
+		WithKV("asset", transfer.Asset)
+
+	logger.Debug("issuing conditional transfer settlement state", "status", transfer.Status.String())
+
+	if _, err := tx.LockUserState(userWallet, transfer.Asset); err != nil {
+		return nil, rpc.Errorf("failed to lock user state: %v", err)
+	}
+
+	currentState, err := tx.GetLastUserState(userWallet, transfer.Asset, false)
+	if err != nil {
+		return nil, rpc.Errorf("failed to get last %s user state for address %s", transfer.Asset, userWallet)
+	}
+	if currentState == nil {
+		currentState = core.NewVoidState(transfer.Asset, userWallet)
+	}
+	newState := currentState.NextNodeIssuedState()
+
+	if transfer.Status == core.ConditionalTransferStatusClaimed {
+		_, err = newState.ApplyConditionalClaimTransition(transfer.ID, transfer.Amount)
+	} else {
+		_, err = newState.ApplyConditionalRefundTransition(transfer.ID, transfer.Amount)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	if newState.HomeChannelID != nil && shouldSign {
-		packedState, err := h.statePacker.PackState(*newState)
+	if err := h.signNodeIssuedState(tx, newState); err != nil {
+		return nil, err
+	}
+	if err := tx.StoreUserState(*newState); err != nil {
+		return nil, rpc.Errorf("failed to store settlement state")
+	}
+
+	if err := tx.UpdateConditionalTransfer(transfer); err != nil {
+		return nil, rpc.Errorf("failed to update conditional transfer: %v", err)
+	}
+
+	transaction, err := core.NewTransactionFromTransition(nil, newState, newState.Transition)
+	if err != nil {
+		return nil, rpc.Errorf("failed to create transaction: %v", err)
+	}
+	if err := tx.RecordTransaction(*transaction); err != nil {
+		return nil, rpc.Errorf("failed to record transaction")
+	}
+
+	logger.Info("recorded transaction",
+		"txID", transaction.ID,
+		"txType", transaction.TxType.String(),
+		"from", transaction.FromAccount,
+		"to", transaction.ToAccount,
+		"asset", transaction.Asset,
+		"amount", transaction.Amount.String())
+
+	logger.Info("issued conditional transfer settlement state", "stateVersion", newState.Version)
+	return newState, nil
+}
+
+// issueExtraState creates additional node-signed states by reapplying the transitions the node issued
+// while the user's last signed state was a pending lock. When the user completes the lock (e.g. with an
+// escrow_deposit), those transitions are reapplied on top of the incoming state so that no balance change
+// is lost. Versions continue after latestVersion, the highest version issued before the incoming state.
+func (h *Handler) issueExtraState(ctx context.Context, tx Store, incomingState core.State, latestVersion uint64, extraTransitions []core.Transition) (*core.State, error) {
+	logger := log.FromContext(ctx).
+		WithKV("userWallet", incomingState.UserWallet).
+		WithKV("asset", incomingState.Asset)
+
+	extraState := &incomingState
+	if extraState.Version < latestVersion {
+		baseState := incomingState
+		baseState.Version = latestVersion
+		extraState = &baseState
+	}
+
+	for _, transition := range extraTransitions {
+		extraState = extraState.NextState()
+		if _, err := extraState.ReapplyTransition(transition); err != nil {
+			return nil, rpc.Errorf("failed to reapply %s transition: %v", transition.Type.String(), err)
+		}
+
+		packedState, err := h.statePacker.PackState(*extraState)
 		if err != nil {
-			return nil, rpc.Errorf("failed to pack receiver state: %v", err)
+			return nil, rpc.Errorf("failed to pack extra state: %v", err)
 		}
 
 		_nodeSig, err := h.nodeSigner.Sign(packedState)
 		if err != nil {
-			return nil, rpc.Errorf("failed to sign receiver state")
+			return nil, rpc.Errorf("failed to sign extra state")
 		}
 		nodeSig := _nodeSig.String()
-		newState.NodeSig = &nodeSig
-	}
-	if err := tx.StoreUserState(*newState); err != nil {
-		return nil, rpc.Errorf("failed to store receiver state")
+		extraState.NodeSig = &nodeSig
+
+		if err := tx.StoreUserState(*extraState); err != nil {
+			return nil, rpc.Errorf("failed to store extra state: %v", err)
+		}
+		logger.Debug("issued extra state", "extraStateVersion", extraState.Version, "transitionType", transition.Type.String())
 	}
 
-	logger.Info("issued transfer receiver state", "receiverStateVersion", newState.Version)
-	return newState, nil
+	return extraState, nil
 }
-
-// issueExtraState creates an additional state by reapplying unsigned transitions to a newly signed state.
-// When a user submits a signed state (e.g., after escrow_deposit or escrow_withdraw), any pending
-// unsigned transitions from the previous state are reapplied to create a new unsigned state.
-// This ensures that pending operations are preserved across state updates that require user signatures.
-// func (h *Handler) issueExtraState(ctx context.Context, tx Store, incomingState core.State, extraTransitions []core.Transition) (*core.State, error) {
-// 	logger := log.FromContext(ctx)
-
-// 	lastTransition := incomingState.GetLastTransition()
-// 	if lastTransition == nil {
-// 		return nil, rpc.Errorf("incoming state has no transitions")
-// 	}
-
-// 	if len(extraTransitions) == 0 {
-// 		return &incomingState, nil
-// 	}
-
-// 	logger = logger.
-// 		WithKV("userWallet", incomingState.UserWallet).
-// 		WithKV("asset", incomingState.Asset)
-
-// 	extraState := incomingState.NextState()
-// 	logger.Debug("issuing extra state", "extraStateVersion", extraState.Version)
-
-// 	if err := extraState.ApplyReceiverTransitions(extraTransitions...); err != nil {
-// 		return nil, err
-// 	}
-
-// 	packedState, err := h.statePacker.PackState(*extraState)
-// 	if err != nil {
-// 		return nil, rpc.Errorf("failed to pack extra state")
-// 	}
-
-// 	_nodeSig, err := h.signer.Sign(packedState)
-// 	if err != nil {
-// 		return nil, rpc.Errorf("failed to sign extra state")
-// 	}
-// 	nodeSig := _nodeSig.String()
-// 	extraState.NodeSig = &nodeSig
-
-// 	if err := tx.StoreUserState(*extraState); err != nil {
-// 		return nil, rpc.Errorf("failed to store extra state")
-// 	}
-
-// 	logger.Info("issued extra state", "extraStateVersion", extraState.Version)
-// 	return extraState, nil
-// }
@@ -1,8 +1,12 @@
 package channel_v1
 
 import (
+	"context"
+	"time"
+
 	"github.com/layer-3/nitrolite/clearnode/action_gateway"
 	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
 	"github.com/shopspring/decimal"
 )
 
@@ -27,6 +31,10 @@ type Store interface {
 	// Returns nil state if no matching state exists.
 	GetLastUserState(wallet, asset string, signed bool) (*core.State, error)
 
+	// GetUserStatesAfterVersion retrieves the states of a user's asset in an epoch
+	// with a version greater than the given one, in ascending version order.
+	GetUserStatesAfterVersion(wallet, asset string, epoch, version uint64) ([]core.State, error)
+
 	// CheckOpenChannel verifies if a user has an active channel for the given asset
 	// and returns the approved signature validators if such a channel exists.
 	CheckOpenChannel(wallet, asset string) (string, bool, error)
@@ -42,6 +50,22 @@ type Store interface {
 	// withdrawal from an escrow channel (triggered by escrow_lock transition).
 	ScheduleInitiateEscrowWithdrawal(stateID string, chainID uint64) error
 
+	// ScheduleFinalizeEscrowDeposit queues a blockchain action to finalize
+	// an escrow deposit on the escrow chain (triggered by escrow_deposit transition).
+	ScheduleFinalizeEscrowDeposit(stateID string, chainID uint64) error
+
+	// ScheduleInitiateMigration queues a blockchain action to initiate the migration
+	// of a home channel on its new home chain (triggered by migrate transition).
+	ScheduleInitiateMigration(stateID string, chainID uint64) error
+
+	// ScheduleFinalizeMigration queues a blockchain action to finalize the migration
+	// of a home channel on its old home chain (triggered by finalize_migration transition).
+	ScheduleFinalizeMigration(stateID string, chainID uint64) error
+
+	// GetPendingNodeLocks returns the sum of the amounts the node is yet to lock on the blockchain
+	// in the token for scheduled blockchain actions that are not confirmed yet.
+	GetPendingNodeLocks(blockchainID uint64, tokenAddress string) (decimal.Decimal, error)
+
 	// RecordTransaction creates a transaction record linking state transitions
 	// to track the history of operations (deposits, withdrawals, transfers, etc.).
 	RecordTransaction(tx core.Transaction) error
@@ -55,6 +79,10 @@ type Store interface {
 	// Returns nil if the channel doesn't exist.
 	GetChannelByID(channelID string) (*core.Channel, error)
 
+	// UpdateChannel persists changes to a channel's metadata (status, version, blockchain, etc).
+	// The channel must already exist in the database.
+	UpdateChannel(channel core.Channel) error
+
 	// GetActiveHomeChannel retrieves the active home channel for a user's wallet and asset.
 	// Returns nil if no home channel exists for the given wallet and asset.
 	GetActiveHomeChannel(wallet, asset string) (*core.Channel, error)
@@ -62,6 +90,35 @@ type Store interface {
 	// GetUserChannels retrieves all channels for a user with optional status, asset, and type filters.
 	GetUserChannels(wallet string, status *core.ChannelStatus, asset *string, channelType *core.ChannelType, limit, offset uint32) ([]core.Channel, uint32, error)
 
+	// Conditional transfer operations
+
+	// CreateConditionalTransfer stores a newly locked conditional transfer.
+	CreateConditionalTransfer(transfer core.ConditionalTransfer) error
+
+	// LockConditionalTransfer retrieves a conditional transfer by ID and locks it for update,
+	// must be used within a transaction. Returns nil if the transfer doesn't exist.
+	LockConditionalTransfer(id string) (*core.ConditionalTransfer, error)
+
+	// UpdateConditionalTransfer persists the status and the revealed preimage of a conditional transfer.
+	UpdateConditionalTransfer(transfer core.ConditionalTransfer) error
+
+	// GetExpiredConditionalTransfers retrieves up to limit locked conditional transfers
+	// that expired at or before the given time, oldest expiry first.
+	GetExpiredConditionalTransfers(now time.Time, limit uint32) ([]core.ConditionalTransfer, error)
+
+	// Payment stream operations
+
+	// LockPaymentStream retrieves a payment stream by ID and locks it for update,
+	// must be used within a transaction. Returns nil if the stream doesn't exist.
+	LockPaymentStream(id string) (*core.PaymentStream, error)
+
+	// UpdatePaymentStream persists the status, end and settlement progress of a payment stream.
+	UpdatePaymentStream(stream core.PaymentStream) error
+
+	// GetDuePaymentStreams retrieves up to limit payment streams that still have funds to settle,
+	// least recently settled first.
+	GetDuePaymentStreams(limit uint32) ([]core.PaymentStream, error)
+
 	// Session key state operations
 
 	// StoreChannelSessionKeyState persists a channel session key state.
@@ -88,6 +145,20 @@ type ActionGateway interface {
 	AllowAction(tx action_gateway.Store, userAddress string, gatedAction core.GatedAction) error
 }
 
+type FeeEngine interface {
+	// CalculateFee returns the fee charged for the gated action on the amount of the asset.
+	CalculateFee(asset string, action core.GatedAction, amount decimal.Decimal) decimal.Decimal
+
+	// FeeAccount returns the account the collected fees are credited to.
+	FeeAccount() string
+}
+
+// NodeBalanceProvider reports the liquidity of the node on the blockchains it settles on.
+type NodeBalanceProvider interface {
+	// GetNodeBalance returns the node's balance of the token in the ChannelHub of the blockchain.
+	GetNodeBalance(ctx context.Context, blockchainID uint64, token string) (decimal.Decimal, error)
+}
+
 // SigValidator validates cryptographic signatures on state transitions.
 type SigValidator interface {
 	// Verify checks that the signature is valid for the given data and wallet address.
@@ -112,3 +183,15 @@ type MemoryStore interface {
 	// GetTokenDecimals returns the decimals for a token on a specific blockchain
 	GetTokenDecimals(blockchainID uint64, tokenAddress string) (uint8, error)
 }
+
+// Notifier manages event subscriptions of RPC connections and delivers server-push events to them.
+type Notifier interface {
+	// Subscribe registers the connection to receive events of the group concerning the user.
+	Subscribe(connID string, group rpc.Group, userID string) error
+
+	// Unsubscribe removes the connection's subscription to events of the group concerning the user.
+	Unsubscribe(connID string, group rpc.Group, userID string)
+
+	// Notify sends the event to all connections subscribed to the user's events of the event group.
+	Notify(userID string, event rpc.Event, params rpc.Payload)
+}
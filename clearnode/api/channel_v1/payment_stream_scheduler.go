@@ -0,0 +1,273 @@
+package channel_v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// PaymentStreamScheduler periodically settles the amounts accrued by payment streams.
+// Each settlement is issued as a regular transfer: a node-signed transfer_send state for the sender
+// and a transfer_receive state for the recipient.
+//
+// The sender doesn't sign the settlement states, so until they sign a newer state, for example by
+// acknowledging the settlements, they can still enforce their last signed balance on-chain and the
+// node covers the settled amounts. This credit is capped to the amount the stream accrues over the
+// credit period; beyond it, the settlement waits for the sender to sign.
+type PaymentStreamScheduler struct {
+	handler   *Handler
+	interval  time.Duration
+	batchSize uint32
+	logger    log.Logger
+}
+
+// NewPaymentStreamScheduler creates a new PaymentStreamScheduler that settles due payment streams
+// every interval and settles at most batchSize of them per run.
+// The amount settled without the sender's signature is limited to what a stream accrues over creditPeriod.
+func NewPaymentStreamScheduler(
+	useStoreInTx StoreTxProvider,
+	memoryStore MemoryStore,
+	feeEngine FeeEngine,
+	nodeSigner *core.ChannelDefaultSigner,
+	statePacker core.StatePacker,
+	eventPublisher *EventPublisher,
+	interval time.Duration,
+	batchSize uint32,
+	creditPeriod time.Duration,
+	logger log.Logger,
+) *PaymentStreamScheduler {
+	return &PaymentStreamScheduler{
+		handler: &Handler{
+			useStoreInTx:       useStoreInTx,
+			memoryStore:        memoryStore,
+			feeEngine:          feeEngine,
+			nodeSigner:         nodeSigner,
+			statePacker:        statePacker,
+			eventPublisher:     eventPublisher,
+			streamCreditPeriod: creditPeriod,
+		},
+		interval:  interval,
+		batchSize: batchSize,
+		logger:    logger.WithName("payment-stream-scheduler"),
+	}
+}
+
+// Run settles due payment streams until the context is cancelled.
+func (s *PaymentStreamScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.Settle(ctx, time.Now())
+		case <-ctx.Done():
+			s.logger.Info("stopping payment stream scheduler")
+			return
+		}
+	}
+}
+
+// Settle transfers the amounts accrued by due payment streams up to the given time.
+// Each stream is settled in its own store transaction, so a failure doesn't block the others;
+// a failed stream is retried on the next run with the amount accrued meanwhile.
+// Returns the number of streams a transfer was issued for.
+func (s *PaymentStreamScheduler) Settle(ctx context.Context, now time.Time) int {
+	ctx = log.SetContextLogger(ctx, s.logger)
+
+	var due []core.PaymentStream
+	err := s.handler.useStoreInTx(func(tx Store) error {
+		var err error
+		due, err = tx.GetDuePaymentStreams(s.batchSize)
+		return err
+	})
+	if err != nil {
+		s.logger.Error("failed to get due payment streams", "error", err)
+		return 0
+	}
+
+	settled := 0
+	for _, stream := range due {
+		recipientState, err := s.handler.settlePaymentStream(ctx, stream.ID, now)
+		if err != nil {
+			s.logger.Error("failed to settle payment stream", "error", err, "streamID", stream.ID)
+			continue
+		}
+		if recipientState == nil {
+			continue
+		}
+		settled++
+
+		s.handler.eventPublisher.PublishTransferReceived(ctx, *recipientState)
+	}
+
+	if settled > 0 {
+		s.logger.Info("settled payment streams", "count", settled)
+	}
+	return settled
+}
+
+// settlePaymentStream transfers the amount accrued by the payment stream since its last settlement
+// within a store transaction. The amount is limited by the sender's balance and by the node's credit
+// to the sender; the remainder stays due and is settled on a later run.
+// Active streams that reached their end or cap are marked as completed.
+// Returns the recipient's new state, or nil if nothing was transferred.
+func (h *Handler) settlePaymentStream(ctx context.Context, streamID string, now time.Time) (*core.State, error) {
+	logger := log.FromContext(ctx)
+
+	var recipientState *core.State
+	err := h.useStoreInTx(func(tx Store) error {
+		lockedStream, err := tx.LockPaymentStream(streamID)
+		if err != nil {
+			return rpc.Errorf("failed to get payment stream: %v", err)
+		}
+		if lockedStream == nil {
+			return rpc.Errorf("payment stream %s not found", streamID)
+		}
+		stream := *lockedStream
+		if stream.Status != core.PaymentStreamStatusActive && stream.Status != core.PaymentStreamStatusCancelled {
+			return nil
+		}
+
+		auth := stream.Authorization
+		logger := logger.
+			WithKV("streamID", stream.ID).
+			WithKV("sender", auth.Sender).
+			WithKV("recipient", auth.Recipient).
+			WithKV("asset", auth.Asset)
+
+		end := now
+		if end.After(stream.EndsAt) {
+			end = stream.EndsAt
+		}
+
+		decimals, err := h.memoryStore.GetAssetDecimals(auth.Asset)
+		if err != nil {
+			return rpc.Errorf("failed to get asset decimals: %v", err)
+		}
+		amount := stream.AccruedAt(end, decimals).Sub(stream.Settled)
+
+		if amount.IsPositive() {
+			if _, err := tx.LockUserState(auth.Sender, auth.Asset); err != nil {
+				return rpc.Errorf("failed to lock sender state: %v", err)
+			}
+
+			senderState, err := tx.GetLastUserState(auth.Sender, auth.Asset, false)
+			if err != nil {
+				return rpc.Errorf("failed to get last %s user state for address %s", auth.Asset, auth.Sender)
+			}
+			if senderState == nil || senderState.HomeChannelID == nil {
+				return rpc.Errorf("sender %s has no %s home channel", auth.Sender, auth.Asset)
+			}
+
+			if err := tx.EnsureNoOngoingStateTransitions(auth.Sender, auth.Asset); err != nil {
+				return rpc.Errorf("ongoing state transitions check failed: %v", err)
+			}
+
+			if amount.GreaterThan(senderState.HomeLedger.UserBalance) {
+				amount = senderState.HomeLedger.UserBalance
+			}
+			if !amount.IsPositive() {
+				logger.Debug("sender has no balance to settle payment stream")
+				return nil
+			}
+
+			lastSignedState, err := tx.GetLastUserState(auth.Sender, auth.Asset, true)
+			if err != nil {
+				return rpc.Errorf("failed to get last signed %s user state for address %s", auth.Asset, auth.Sender)
+			}
+			if lastSignedState == nil {
+				return rpc.Errorf("sender %s has no signed %s state", auth.Sender, auth.Asset)
+			}
+			credited, err := unsignedDebits(tx, *lastSignedState)
+			if err != nil {
+				return rpc.Errorf("failed to get unsigned debits of the sender: %v", err)
+			}
+			creditLimit := auth.Rate.Mul(decimal.NewFromFloat(h.streamCreditPeriod.Seconds())).Truncate(int32(decimals))
+			if available := creditLimit.Sub(credited); amount.GreaterThan(available) {
+				amount = available
+			}
+			if !amount.IsPositive() {
+				logger.Debug("node credit to the sender is exhausted, waiting for the sender to sign", "credited", credited.String())
+				return nil
+			}
+
+			newSenderState := senderState.NextNodeIssuedState()
+			if _, err := newSenderState.ApplyTransferSendTransition(auth.Recipient, amount); err != nil {
+				return rpc.Errorf("failed to apply transfer send transition: %v", err)
+			}
+
+			if err := h.signNodeIssuedState(tx, newSenderState); err != nil {
+				return err
+			}
+			if err := tx.StoreUserState(*newSenderState); err != nil {
+				return rpc.Errorf("failed to store sender state")
+			}
+
+			recipientState, err = h.issueReceiverState(ctx, tx, *newSenderState, newSenderState.Transition.AccountID, core.TransitionTypeTransferReceive, true)
+			if err != nil {
+				return rpc.Errorf("failed to issue recipient state: %v", err)
+			}
+
+			transaction, err := core.NewTransactionFromTransition(newSenderState, recipientState, newSenderState.Transition)
+			if err != nil {
+				return rpc.Errorf("failed to create transaction: %v", err)
+			}
+			if err := tx.RecordTransaction(*transaction); err != nil {
+				return rpc.Errorf("failed to record transaction")
+			}
+
+			logger.Info("recorded transaction",
+				"txID", transaction.ID,
+				"txType", transaction.TxType.String(),
+				"from", transaction.FromAccount,
+				"to", transaction.ToAccount,
+				"asset", transaction.Asset,
+				"amount", transaction.Amount.String())
+
+			stream.Settled = stream.Settled.Add(amount)
+		}
+
+		stream.SettledUntil = end
+		if stream.Status == core.PaymentStreamStatusActive && stream.IsFinished(end) {
+			stream.Status = core.PaymentStreamStatusCompleted
+		}
+
+		if err := tx.UpdatePaymentStream(stream); err != nil {
+			return rpc.Errorf("failed to update payment stream: %v", err)
+		}
+
+		logger.Debug("settled payment stream",
+			"amount", amount.String(),
+			"settled", stream.Settled.String(),
+			"status", stream.Status.String())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return recipientState, nil
+}
+
+// unsignedDebits returns the total amount sent on behalf of the user by the states the node issued
+// after the user's last signed state, which the user hasn't signed yet.
+func unsignedDebits(tx Store, lastSignedState core.State) (decimal.Decimal, error) {
+	states, err := tx.GetUserStatesAfterVersion(lastSignedState.UserWallet, lastSignedState.Asset, lastSignedState.Epoch, lastSignedState.Version)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	total := decimal.Zero
+	for _, state := range states {
+		if state.UserSig == nil && state.Transition.Type == core.TransitionTypeTransferSend {
+			total = total.Add(state.Transition.Amount)
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,288 @@
+package channel_v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+func newTestPaymentStream(id, sender, recipient string, startsAt, endsAt time.Time) core.PaymentStream {
+	return core.PaymentStream{
+		ID: id,
+		Authorization: core.PaymentStreamAuthorization{
+			Sender:    sender,
+			Recipient: recipient,
+			Asset:     "USDC",
+			Rate:      decimal.NewFromInt(1),
+			Cap:       decimal.NewFromInt(100),
+			ExpiresAt: uint64(endsAt.Unix()),
+			Nonce:     1,
+		},
+		Status:       core.PaymentStreamStatusActive,
+		StartsAt:     startsAt,
+		EndsAt:       endsAt,
+		Settled:      decimal.Zero,
+		SettledUntil: startsAt,
+	}
+}
+
+func newTestPaymentStreamScheduler(mockTxStore *MockStore, mockStatePacker *MockStatePacker, mockNotifier *MockNotifier) *PaymentStreamScheduler {
+	mockMemoryStore := new(MockMemoryStore)
+	mockMemoryStore.On("GetAssetDecimals", "USDC").Return(uint8(6), nil)
+
+	nodeSigner, _ := core.NewChannelDefaultSigner(NewMockSigner())
+	useStoreInTx := func(handler StoreTxHandler) error {
+		return handler(mockTxStore)
+	}
+	return NewPaymentStreamScheduler(useStoreInTx, mockMemoryStore, &MockFeeEngine{}, nodeSigner, mockStatePacker,
+		NewEventPublisher(mockNotifier), time.Minute, 10, time.Minute, log.NewNoopLogger())
+}
+
+func TestPaymentStreamScheduler_Settle(t *testing.T) {
+	mockTxStore := new(MockStore)
+	mockStatePacker := new(MockStatePacker)
+	mockNotifier := new(MockNotifier)
+	scheduler := newTestPaymentStreamScheduler(mockTxStore, mockStatePacker, mockNotifier)
+
+	now := time.Now()
+	senderWallet := NewMockSigner().PublicKey().Address().String()
+	recipientWallet := NewMockSigner().PublicKey().Address().String()
+
+	stream := newTestPaymentStream("0xstream", senderWallet, recipientWallet, now.Add(-10*time.Second), now.Add(time.Hour))
+	stream.Settled = decimal.NewFromInt(4)
+	failing := newTestPaymentStream("0xfailing", senderWallet, recipientWallet, now.Add(-time.Minute), now.Add(time.Hour))
+
+	mockTxStore.On("GetDuePaymentStreams", uint32(10)).Return([]core.PaymentStream{failing, stream}, nil).Once()
+	mockTxStore.On("LockPaymentStream", failing.ID).Return(nil, errors.New("db error")).Once()
+	mockTxStore.On("LockPaymentStream", stream.ID).Return(stream, nil).Once()
+
+	senderState := newSwapTestState(senderWallet, "USDC", "0xSenderChannel", 400)
+	recipientState := newSwapTestState(recipientWallet, "USDC", "0xRecipientChannel", 0)
+	mockStatePacker.On("PackState", mock.Anything).Return([]byte("packed"), nil)
+	mockTxStore.On("LockUserState", senderWallet, "USDC").Return(decimal.Zero, nil).Once()
+	mockTxStore.On("LockUserState", recipientWallet, "USDC").Return(decimal.Zero, nil).Once()
+	mockTxStore.On("GetLastUserState", senderWallet, "USDC", false).Return(senderState, nil)
+	mockTxStore.On("GetLastUserState", senderWallet, "USDC", true).Return(senderState, nil)
+	mockTxStore.On("GetLastUserState", recipientWallet, "USDC", false).Return(recipientState, nil)
+	mockTxStore.On("GetLastUserState", recipientWallet, "USDC", true).Return(recipientState, nil)
+	mockTxStore.On("GetUserStatesAfterVersion", senderWallet, "USDC", uint64(1), uint64(1)).Return(nil, nil)
+	mockTxStore.On("EnsureNoOngoingStateTransitions", senderWallet, "USDC").Return(nil)
+
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == senderWallet &&
+			state.Transition.Type == core.TransitionTypeTransferSend &&
+			state.Transition.AccountID == recipientWallet &&
+			state.Transition.Amount.Equal(decimal.NewFromInt(6)) &&
+			state.HomeLedger.UserBalance.Equal(decimal.NewFromInt(394)) &&
+			state.NodeSig != nil
+	})).Return(nil).Once()
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == recipientWallet &&
+			state.Transition.Type == core.TransitionTypeTransferReceive &&
+			state.HomeLedger.UserBalance.Equal(decimal.NewFromInt(6)) &&
+			state.NodeSig != nil
+	})).Return(nil).Once()
+	mockTxStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
+		return tx.TxType == core.TransactionTypeTransfer &&
+			tx.FromAccount == senderWallet && tx.ToAccount == recipientWallet &&
+			tx.Amount.Equal(decimal.NewFromInt(6))
+	})).Return(nil).Once()
+	mockTxStore.On("UpdatePaymentStream", mock.MatchedBy(func(updated core.PaymentStream) bool {
+		return updated.ID == stream.ID &&
+			updated.Status == core.PaymentStreamStatusActive &&
+			updated.Settled.Equal(decimal.NewFromInt(10)) &&
+			updated.SettledUntil.Equal(now)
+	})).Return(nil).Once()
+	mockNotifier.On("Notify", recipientWallet, rpc.ChannelsV1TransferReceivedEventName, mock.Anything).Return().Once()
+
+	settled := scheduler.Settle(context.Background(), now)
+
+	assert.Equal(t, 1, settled)
+	mockTxStore.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestPaymentStreamScheduler_Settle_LimitedBySenderBalance(t *testing.T) {
+	mockTxStore := new(MockStore)
+	mockStatePacker := new(MockStatePacker)
+	mockNotifier := new(MockNotifier)
+	scheduler := newTestPaymentStreamScheduler(mockTxStore, mockStatePacker, mockNotifier)
+
+	now := time.Now()
+	senderWallet := NewMockSigner().PublicKey().Address().String()
+	recipientWallet := NewMockSigner().PublicKey().Address().String()
+
+	// Cancelled stream that ended after 50 seconds, sender only has 30 left
+	stream := newTestPaymentStream("0xstream", senderWallet, recipientWallet, now.Add(-time.Hour), now.Add(-time.Hour+50*time.Second))
+	stream.Status = core.PaymentStreamStatusCancelled
+
+	mockTxStore.On("GetDuePaymentStreams", uint32(10)).Return([]core.PaymentStream{stream}, nil).Once()
+	mockTxStore.On("LockPaymentStream", stream.ID).Return(stream, nil).Once()
+
+	senderState := newSwapTestState(senderWallet, "USDC", "0xSenderChannel", 30)
+	mockStatePacker.On("PackState", mock.Anything).Return([]byte("packed"), nil)
+	mockTxStore.On("LockUserState", mock.Anything, "USDC").Return(decimal.Zero, nil)
+	mockTxStore.On("GetLastUserState", senderWallet, "USDC", mock.Anything).Return(senderState, nil)
+	mockTxStore.On("GetLastUserState", recipientWallet, "USDC", mock.Anything).Return(nil, nil)
+	mockTxStore.On("GetUserStatesAfterVersion", senderWallet, "USDC", uint64(1), uint64(1)).Return(nil, nil)
+	mockTxStore.On("EnsureNoOngoingStateTransitions", senderWallet, "USDC").Return(nil)
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == senderWallet && state.HomeLedger.UserBalance.IsZero()
+	})).Return(nil).Once()
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == recipientWallet && state.HomeLedger.UserBalance.Equal(decimal.NewFromInt(30))
+	})).Return(nil).Once()
+	mockTxStore.On("RecordTransaction", mock.Anything).Return(nil).Once()
+	mockTxStore.On("UpdatePaymentStream", mock.MatchedBy(func(updated core.PaymentStream) bool {
+		return updated.Status == core.PaymentStreamStatusCancelled &&
+			updated.Settled.Equal(decimal.NewFromInt(30)) &&
+			updated.SettledUntil.Equal(stream.EndsAt)
+	})).Return(nil).Once()
+	mockNotifier.On("Notify", recipientWallet, rpc.ChannelsV1TransferReceivedEventName, mock.Anything).Return().Once()
+
+	assert.Equal(t, 1, scheduler.Settle(context.Background(), now))
+	mockTxStore.AssertExpectations(t)
+}
+
+func TestPaymentStreamScheduler_Settle_LimitedByNodeCredit(t *testing.T) {
+	mockTxStore := new(MockStore)
+	mockStatePacker := new(MockStatePacker)
+	mockNotifier := new(MockNotifier)
+	scheduler := newTestPaymentStreamScheduler(mockTxStore, mockStatePacker, mockNotifier)
+
+	now := time.Now()
+	senderWallet := NewMockSigner().PublicKey().Address().String()
+	recipientWallet := NewMockSigner().PublicKey().Address().String()
+
+	// 70 accrued, of which 50 were settled by states the sender hasn't signed yet
+	stream := newTestPaymentStream("0xstream", senderWallet, recipientWallet, now.Add(-70*time.Second), now.Add(time.Hour))
+	stream.Settled = decimal.NewFromInt(50)
+
+	lastSignedState := newSwapTestState(senderWallet, "USDC", "0xSenderChannel", 400)
+	settlementState := lastSignedState.NextNodeIssuedState()
+	_, err := settlementState.ApplyTransferSendTransition(recipientWallet, decimal.NewFromInt(50))
+	require.NoError(t, err)
+	// Transfers the sender signed are not covered by the node
+	userSignedState := *lastSignedState.NextState()
+	userSignedState.Transition = core.Transition{Type: core.TransitionTypeTransferSend, Amount: decimal.NewFromInt(20)}
+	userSig := "0xUserSig"
+	userSignedState.UserSig = &userSig
+
+	mockTxStore.On("GetDuePaymentStreams", uint32(10)).Return([]core.PaymentStream{stream}, nil).Once()
+	mockTxStore.On("LockPaymentStream", stream.ID).Return(stream, nil).Once()
+	mockStatePacker.On("PackState", mock.Anything).Return([]byte("packed"), nil)
+	mockTxStore.On("LockUserState", mock.Anything, "USDC").Return(decimal.Zero, nil)
+	mockTxStore.On("GetLastUserState", senderWallet, "USDC", false).Return(*settlementState, nil)
+	mockTxStore.On("GetLastUserState", senderWallet, "USDC", true).Return(lastSignedState, nil)
+	mockTxStore.On("GetLastUserState", recipientWallet, "USDC", mock.Anything).Return(nil, nil)
+	mockTxStore.On("GetUserStatesAfterVersion", senderWallet, "USDC", uint64(1), uint64(1)).
+		Return([]core.State{*settlementState, userSignedState}, nil)
+	mockTxStore.On("EnsureNoOngoingStateTransitions", senderWallet, "USDC").Return(nil)
+
+	// The credit period covers 60 seconds of accrual, 10 of which are left
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == senderWallet && state.Transition.Amount.Equal(decimal.NewFromInt(10))
+	})).Return(nil).Once()
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == recipientWallet && state.HomeLedger.UserBalance.Equal(decimal.NewFromInt(10))
+	})).Return(nil).Once()
+	mockTxStore.On("RecordTransaction", mock.Anything).Return(nil).Once()
+	mockTxStore.On("UpdatePaymentStream", mock.MatchedBy(func(updated core.PaymentStream) bool {
+		return updated.Settled.Equal(decimal.NewFromInt(60))
+	})).Return(nil).Once()
+	mockNotifier.On("Notify", recipientWallet, rpc.ChannelsV1TransferReceivedEventName, mock.Anything).Return().Once()
+
+	assert.Equal(t, 1, scheduler.Settle(context.Background(), now))
+	mockTxStore.AssertExpectations(t)
+}
+
+func TestPaymentStreamScheduler_Settle_NodeCreditExhausted(t *testing.T) {
+	mockTxStore := new(MockStore)
+	mockNotifier := new(MockNotifier)
+	scheduler := newTestPaymentStreamScheduler(mockTxStore, new(MockStatePacker), mockNotifier)
+
+	now := time.Now()
+	senderWallet := NewMockSigner().PublicKey().Address().String()
+	stream := newTestPaymentStream("0xstream", senderWallet, "0xrecipient", now.Add(-90*time.Second), now.Add(time.Hour))
+	stream.Settled = decimal.NewFromInt(60)
+
+	lastSignedState := newSwapTestState(senderWallet, "USDC", "0xSenderChannel", 400)
+	settlementState := lastSignedState.NextNodeIssuedState()
+	_, err := settlementState.ApplyTransferSendTransition("0xrecipient", decimal.NewFromInt(60))
+	require.NoError(t, err)
+
+	mockTxStore.On("GetDuePaymentStreams", uint32(10)).Return([]core.PaymentStream{stream}, nil).Once()
+	mockTxStore.On("LockPaymentStream", stream.ID).Return(stream, nil).Once()
+	mockTxStore.On("LockUserState", senderWallet, "USDC").Return(decimal.Zero, nil).Once()
+	mockTxStore.On("GetLastUserState", senderWallet, "USDC", false).Return(*settlementState, nil)
+	mockTxStore.On("GetLastUserState", senderWallet, "USDC", true).Return(lastSignedState, nil)
+	mockTxStore.On("GetUserStatesAfterVersion", senderWallet, "USDC", uint64(1), uint64(1)).Return([]core.State{*settlementState}, nil)
+	mockTxStore.On("EnsureNoOngoingStateTransitions", senderWallet, "USDC").Return(nil)
+
+	// Nothing is settled until the sender signs a state covering the previous settlements
+	assert.Equal(t, 0, scheduler.Settle(context.Background(), now))
+	mockTxStore.AssertExpectations(t)
+	mockTxStore.AssertNotCalled(t, "StoreUserState", mock.Anything)
+	mockTxStore.AssertNotCalled(t, "UpdatePaymentStream", mock.Anything)
+}
+
+func TestPaymentStreamScheduler_Settle_CompletesAtCap(t *testing.T) {
+	mockTxStore := new(MockStore)
+	mockNotifier := new(MockNotifier)
+	scheduler := newTestPaymentStreamScheduler(mockTxStore, new(MockStatePacker), mockNotifier)
+
+	now := time.Now()
+	stream := newTestPaymentStream("0xstream", "0xsender", "0xrecipient", now.Add(-10*time.Minute), now.Add(time.Hour))
+	stream.Settled = stream.Authorization.Cap
+
+	mockTxStore.On("GetDuePaymentStreams", uint32(10)).Return([]core.PaymentStream{stream}, nil).Once()
+	mockTxStore.On("LockPaymentStream", stream.ID).Return(stream, nil).Once()
+	mockTxStore.On("UpdatePaymentStream", mock.MatchedBy(func(updated core.PaymentStream) bool {
+		return updated.Status == core.PaymentStreamStatusCompleted
+	})).Return(nil).Once()
+
+	assert.Equal(t, 0, scheduler.Settle(context.Background(), now))
+	mockTxStore.AssertExpectations(t)
+	mockTxStore.AssertNotCalled(t, "LockUserState", mock.Anything, mock.Anything)
+}
+
+func TestPaymentStreamScheduler_Settle_NoSenderBalance(t *testing.T) {
+	mockTxStore := new(MockStore)
+	mockNotifier := new(MockNotifier)
+	scheduler := newTestPaymentStreamScheduler(mockTxStore, new(MockStatePacker), mockNotifier)
+
+	now := time.Now()
+	senderWallet := NewMockSigner().PublicKey().Address().String()
+	stream := newTestPaymentStream("0xstream", senderWallet, "0xrecipient", now.Add(-10*time.Second), now.Add(time.Hour))
+
+	mockTxStore.On("GetDuePaymentStreams", uint32(10)).Return([]core.PaymentStream{stream}, nil).Once()
+	mockTxStore.On("LockPaymentStream", stream.ID).Return(stream, nil).Once()
+	mockTxStore.On("LockUserState", senderWallet, "USDC").Return(decimal.Zero, nil).Once()
+	mockTxStore.On("GetLastUserState", senderWallet, "USDC", false).Return(newSwapTestState(senderWallet, "USDC", "0xSenderChannel", 0), nil)
+	mockTxStore.On("EnsureNoOngoingStateTransitions", senderWallet, "USDC").Return(nil)
+
+	assert.Equal(t, 0, scheduler.Settle(context.Background(), now))
+	mockTxStore.AssertExpectations(t)
+	mockTxStore.AssertNotCalled(t, "StoreUserState", mock.Anything)
+	mockTxStore.AssertNotCalled(t, "UpdatePaymentStream", mock.Anything)
+}
+
+func TestPaymentStreamScheduler_Settle_StoreError(t *testing.T) {
+	mockTxStore := new(MockStore)
+	scheduler := newTestPaymentStreamScheduler(mockTxStore, new(MockStatePacker), new(MockNotifier))
+
+	mockTxStore.On("GetDuePaymentStreams", uint32(10)).Return(nil, errors.New("db error")).Once()
+
+	assert.Equal(t, 0, scheduler.Settle(context.Background(), time.Now()))
+	mockTxStore.AssertNotCalled(t, "LockPaymentStream", mock.Anything)
+}
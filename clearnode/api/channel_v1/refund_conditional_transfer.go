@@ -0,0 +1,87 @@
+package channel_v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// RefundConditionalTransfer returns the funds of an expired conditional transfer to its sender.
+// Expired transfers are also refunded automatically by the ConditionalTransferSweeper;
+// this endpoint allows the sender to get the funds back without waiting for the next sweep.
+func (h *Handler) RefundConditionalTransfer(c *rpc.Context) {
+	ctx := c.Context
+	logger := log.FromContext(ctx)
+
+	var reqPayload rpc.ChannelsV1RefundConditionalTransferRequest
+	if err := c.Request.Payload.Translate(&reqPayload); err != nil {
+		c.Fail(err, "failed to parse parameters")
+		return
+	}
+
+	transfer, senderState, err := h.refundConditionalTransfer(ctx, reqPayload.ConditionalTransferID, time.Now())
+	if err != nil {
+		logger.Error("failed to refund conditional transfer", "error", err)
+		c.Fail(err, "failed to refund conditional transfer")
+		return
+	}
+
+	resp := rpc.ChannelsV1RefundConditionalTransferResponse{
+		State: coreStateToRPC(*senderState),
+	}
+	payload, err := rpc.NewPayload(resp)
+	if err != nil {
+		c.Fail(err, "failed to create response")
+		return
+	}
+
+	c.Succeed(c.Request.Method, payload)
+
+	h.eventPublisher.PublishTransferReceived(ctx, *senderState)
+	h.eventPublisher.PublishConditionalTransferUpdated(ctx, *transfer)
+}
+
+// refundConditionalTransfer refunds the conditional transfer to its sender within a store transaction,
+// provided it is still locked and has expired at the given time.
+// It returns the refunded transfer and the sender's new state.
+func (h *Handler) refundConditionalTransfer(ctx context.Context, transferID string, now time.Time) (*core.ConditionalTransfer, *core.State, error) {
+	logger := log.FromContext(ctx)
+
+	var transfer core.ConditionalTransfer
+	var senderState *core.State
+	err := h.useStoreInTx(func(tx Store) error {
+		lockedTransfer, err := tx.LockConditionalTransfer(transferID)
+		if err != nil {
+			return rpc.Errorf("failed to get conditional transfer: %v", err)
+		}
+		if lockedTransfer == nil {
+			return rpc.Errorf("conditional transfer %s not found", transferID)
+		}
+		if lockedTransfer.Status != core.ConditionalTransferStatusLocked {
+			return rpc.Errorf("conditional transfer is already %s", lockedTransfer.Status.String())
+		}
+		if !lockedTransfer.IsExpired(now) {
+			return rpc.Errorf("conditional transfer has not expired yet")
+		}
+
+		transfer = *lockedTransfer
+		transfer.Status = core.ConditionalTransferStatusRefunded
+
+		senderState, err = h.settleConditionalTransfer(ctx, tx, transfer)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logger.Info("refunded conditional transfer",
+		"conditionalTransferID", transfer.ID,
+		"sender", transfer.Sender,
+		"asset", transfer.Asset,
+		"amount", transfer.Amount.String())
+
+	return &transfer, senderState, nil
+}
@@ -0,0 +1,99 @@
+package channel_v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+func refundConditionalTransferRequest(t *testing.T, handler *Handler, transferID string) *rpc.Context {
+	payload, err := rpc.NewPayload(rpc.ChannelsV1RefundConditionalTransferRequest{
+		ConditionalTransferID: transferID,
+	})
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context: context.Background(),
+		Request: rpc.Message{
+			Method:  rpc.ChannelsV1RefundConditionalTransferMethod.String(),
+			Payload: payload,
+		},
+	}
+	handler.RefundConditionalTransfer(ctx)
+	return ctx
+}
+
+func TestRefundConditionalTransfer_Success(t *testing.T) {
+	mockTxStore := new(MockStore)
+	mockStatePacker := new(MockStatePacker)
+	mockNotifier := new(MockNotifier)
+	handler := newSwapTestHandler(mockTxStore, new(MockAssetStore), mockStatePacker, mockNotifier)
+
+	senderWallet := NewMockSigner().PublicKey().Address().String()
+	receiverWallet := NewMockSigner().PublicKey().Address().String()
+	transfer := newTestConditionalTransfer(t, senderWallet, receiverWallet, time.Now().Add(-time.Minute))
+	senderState := newSwapTestState(senderWallet, "USDC", "0xSenderChannel", 400)
+
+	mockStatePacker.On("PackState", mock.Anything).Return([]byte("packed"), nil)
+	mockTxStore.On("LockConditionalTransfer", transfer.ID).Return(transfer, nil).Once()
+	mockTxStore.On("LockUserState", senderWallet, "USDC").Return(decimal.Zero, nil).Once()
+	mockTxStore.On("GetLastUserState", senderWallet, "USDC", false).Return(senderState, nil)
+	mockTxStore.On("GetLastUserState", senderWallet, "USDC", true).Return(senderState, nil)
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == senderWallet &&
+			state.Transition.Type == core.TransitionTypeConditionalRefund &&
+			state.Transition.AccountID == transfer.ID &&
+			state.HomeLedger.UserBalance.Equal(decimal.NewFromInt(500)) &&
+			state.NodeSig != nil
+	})).Return(nil).Once()
+	mockTxStore.On("UpdateConditionalTransfer", mock.MatchedBy(func(updated core.ConditionalTransfer) bool {
+		return updated.ID == transfer.ID &&
+			updated.Status == core.ConditionalTransferStatusRefunded &&
+			updated.Preimage == nil
+	})).Return(nil).Once()
+	mockTxStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
+		return tx.TxType == core.TransactionTypeConditionalRefund &&
+			tx.FromAccount == transfer.ID && tx.ToAccount == senderWallet &&
+			tx.Amount.Equal(transfer.Amount)
+	})).Return(nil).Once()
+	mockNotifier.On("Notify", senderWallet, rpc.ChannelsV1TransferReceivedEventName, mock.Anything).Return().Once()
+	mockNotifier.On("Notify", senderWallet, rpc.ChannelsV1ConditionalTransferUpdatedEventName, mock.Anything).Return().Once()
+	mockNotifier.On("Notify", receiverWallet, rpc.ChannelsV1ConditionalTransferUpdatedEventName, mock.Anything).Return().Once()
+
+	ctx := refundConditionalTransferRequest(t, handler, transfer.ID)
+
+	require.Nil(t, ctx.Response.Error())
+	var response rpc.ChannelsV1RefundConditionalTransferResponse
+	require.NoError(t, ctx.Response.Payload.Translate(&response))
+	assert.Equal(t, senderWallet, response.State.UserWallet)
+	assert.Equal(t, core.TransitionTypeConditionalRefund, response.State.Transition.Type)
+
+	mockTxStore.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestRefundConditionalTransfer_NotExpired(t *testing.T) {
+	mockTxStore := new(MockStore)
+	handler := newSwapTestHandler(mockTxStore, new(MockAssetStore), new(MockStatePacker), new(MockNotifier))
+
+	senderWallet := NewMockSigner().PublicKey().Address().String()
+	receiverWallet := NewMockSigner().PublicKey().Address().String()
+	transfer := newTestConditionalTransfer(t, senderWallet, receiverWallet, time.Now().Add(time.Hour))
+
+	mockTxStore.On("LockConditionalTransfer", transfer.ID).Return(transfer, nil).Once()
+
+	ctx := refundConditionalTransferRequest(t, handler, transfer.ID)
+
+	respErr := ctx.Response.Error()
+	require.NotNil(t, respErr)
+	assert.Contains(t, respErr.Error(), "conditional transfer has not expired yet")
+	mockTxStore.AssertNotCalled(t, "StoreUserState", mock.Anything)
+}
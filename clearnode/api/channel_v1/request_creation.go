@@ -195,7 +195,7 @@ func (h *Handler) RequestCreation(c *rpc.Context) {
 
 				// We return Node's signature, the user is expected to submit this on blockchain.
 			case core.TransitionTypeTransferSend:
-				newReceiverState, err := h.issueTransferReceiverState(ctx, tx, incomingState)
+				newReceiverState, err := h.issueReceiverState(ctx, tx, incomingState, incomingTransition.AccountID, core.TransitionTypeTransferReceive, true)
 				if err != nil {
 					return rpc.Errorf("failed to issue receiver state: %v", err)
 				}
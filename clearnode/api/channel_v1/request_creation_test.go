@@ -43,6 +43,7 @@ func TestRequestCreation_Success(t *testing.T) {
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
 	}
 
 	// Test data - derive userWallet from a user signer key
@@ -193,6 +194,7 @@ func TestRequestCreation_Acknowledgement_Success(t *testing.T) {
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
 	}
 
 	// Test data - derive userWallet from a user signer key
@@ -335,6 +337,7 @@ func TestRequestCreation_InvalidChallenge(t *testing.T) {
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
 	}
 
 	// Test data
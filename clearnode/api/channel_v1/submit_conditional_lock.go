@@ -0,0 +1,155 @@
+package channel_v1
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// SubmitConditionalLock processes a user state locking funds in a hash-time-locked transfer.
+// The state carries a conditional_lock transition whose account ID commits to the transfer terms.
+// Within a single store transaction it validates and co-signs the state, creates the conditional
+// transfer record and records the lock transaction. The receiver can claim the funds by revealing
+// the preimage before the expiry; afterwards the funds are refunded to the sender.
+func (h *Handler) SubmitConditionalLock(c *rpc.Context) {
+	ctx := c.Context
+	logger := log.FromContext(ctx)
+
+	var reqPayload rpc.ChannelsV1SubmitConditionalLockRequest
+	if err := c.Request.Payload.Translate(&reqPayload); err != nil {
+		c.Fail(err, "failed to parse parameters")
+		return
+	}
+
+	state, err := toCoreState(reqPayload.State)
+	if err != nil {
+		c.Fail(err, "failed to parse state")
+		return
+	}
+
+	expiresAt, err := strconv.ParseUint(reqPayload.ExpiresAt, 10, 64)
+	if err != nil {
+		c.Fail(err, "invalid expires_at")
+		return
+	}
+
+	transfer, err := newConditionalTransfer(state, reqPayload.Receiver, reqPayload.HashLock, expiresAt, time.Now())
+	if err != nil {
+		c.Fail(err, "invalid conditional lock")
+		return
+	}
+
+	err = h.useStoreInTx(func(tx Store) error {
+		if _, err := tx.LockUserState(state.UserWallet, state.Asset); err != nil {
+			return rpc.Errorf("failed to lock user state: %v", err)
+		}
+
+		if err := h.acceptUserSignedState(tx, &state); err != nil {
+			return err
+		}
+
+		if err := tx.CreateConditionalTransfer(transfer); err != nil {
+			return rpc.Errorf("failed to create conditional transfer: %v", err)
+		}
+
+		transaction, err := core.NewTransactionFromTransition(&state, nil, state.Transition)
+		if err != nil {
+			return rpc.Errorf("failed to create transaction: %v", err)
+		}
+		if err := tx.RecordTransaction(*transaction); err != nil {
+			return rpc.Errorf("failed to record transaction")
+		}
+
+		logger.Info("recorded transaction",
+			"txID", transaction.ID,
+			"txType", transaction.TxType.String(),
+			"from", transaction.FromAccount,
+			"to", transaction.ToAccount,
+			"asset", transaction.Asset,
+			"amount", transaction.Amount.String())
+
+		if err := tx.StoreUserState(state); err != nil {
+			return rpc.Errorf("failed to store user state: %v", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.Error("failed to process conditional lock", "error", err)
+		c.Fail(err, "failed to process conditional lock")
+		return
+	}
+
+	resp := rpc.ChannelsV1SubmitConditionalLockResponse{
+		Signature:             *state.NodeSig,
+		ConditionalTransferID: transfer.ID,
+	}
+	payload, err := rpc.NewPayload(resp)
+	if err != nil {
+		c.Fail(err, "failed to create response")
+		return
+	}
+
+	c.Succeed(c.Request.Method, payload)
+
+	h.eventPublisher.PublishConditionalTransferUpdated(ctx, transfer)
+
+	logger.Info("processed conditional lock",
+		"conditionalTransferID", transfer.ID,
+		"sender", transfer.Sender,
+		"receiver", transfer.Receiver,
+		"asset", transfer.Asset,
+		"amount", transfer.Amount.String(),
+		"expiresAt", transfer.ExpiresAt)
+}
+
+// newConditionalTransfer validates the conditional_lock state against the requested terms
+// and builds the conditional transfer it locks.
+func newConditionalTransfer(state core.State, receiver, hashLock string, expiresAt uint64, now time.Time) (core.ConditionalTransfer, error) {
+	transition := state.Transition
+	if transition.Type != core.TransitionTypeConditionalLock {
+		return core.ConditionalTransfer{}, rpc.Errorf("state must have a 'conditional_lock' transition, got '%s'", transition.Type.String())
+	}
+	if !common.IsHexAddress(receiver) {
+		return core.ConditionalTransfer{}, rpc.Errorf("invalid receiver address: %s", receiver)
+	}
+	if strings.EqualFold(receiver, state.UserWallet) {
+		return core.ConditionalTransfer{}, rpc.Errorf("sender and receiver wallets are the same")
+	}
+	if err := core.ValidateHashLock(hashLock); err != nil {
+		return core.ConditionalTransfer{}, rpc.NewError(err)
+	}
+	if !transition.Amount.IsPositive() {
+		return core.ConditionalTransfer{}, rpc.Errorf("conditional transfer amount must be positive")
+	}
+
+	expiry := time.Unix(int64(expiresAt), 0)
+	if !expiry.After(now) {
+		return core.ConditionalTransfer{}, rpc.Errorf("conditional transfer expiry must be in the future")
+	}
+
+	transferID, err := core.GetConditionalTransferID(state.ID, receiver, hashLock, expiresAt)
+	if err != nil {
+		return core.ConditionalTransfer{}, rpc.Errorf("failed to compute conditional transfer ID: %v", err)
+	}
+	if !strings.EqualFold(transition.AccountID, transferID) {
+		return core.ConditionalTransfer{}, rpc.Errorf("conditional transfer ID mismatch: expected=%s, proposed=%s", transferID, transition.AccountID)
+	}
+
+	return core.ConditionalTransfer{
+		ID:          transferID,
+		Sender:      state.UserWallet,
+		Receiver:    receiver,
+		Asset:       state.Asset,
+		Amount:      transition.Amount,
+		HashLock:    hashLock,
+		ExpiresAt:   expiry,
+		Status:      core.ConditionalTransferStatusLocked,
+		LockStateID: state.ID,
+	}, nil
+}
@@ -0,0 +1,207 @@
+package channel_v1
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+const testConditionalPreimage = "0x0101010101010101010101010101010101010101010101010101010101010101"
+
+func submitConditionalLockRequest(t *testing.T, handler *Handler, state core.State, receiver, hashLock string, expiresAt uint64) *rpc.Context {
+	payload, err := rpc.NewPayload(rpc.ChannelsV1SubmitConditionalLockRequest{
+		State:     toRPCState(state),
+		Receiver:  receiver,
+		HashLock:  hashLock,
+		ExpiresAt: strconv.FormatUint(expiresAt, 10),
+	})
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context: context.Background(),
+		Request: rpc.Message{
+			Method:  rpc.ChannelsV1SubmitConditionalLockMethod.String(),
+			Payload: payload,
+		},
+	}
+	handler.SubmitConditionalLock(ctx)
+	return ctx
+}
+
+func TestSubmitConditionalLock_Success(t *testing.T) {
+	mockTxStore := new(MockStore)
+	mockAssetStore := new(MockAssetStore)
+	mockStatePacker := new(MockStatePacker)
+	mockNotifier := new(MockNotifier)
+	handler := newSwapTestHandler(mockTxStore, mockAssetStore, mockStatePacker, mockNotifier)
+
+	senderSigner := NewMockSigner()
+	senderChannelSigner, _ := core.NewChannelDefaultSigner(senderSigner)
+	senderWallet := senderSigner.PublicKey().Address().String()
+	receiverWallet := NewMockSigner().PublicKey().Address().String()
+
+	hashLock, err := core.GetHashLock(testConditionalPreimage)
+	require.NoError(t, err)
+	expiresAt := uint64(time.Now().Add(time.Hour).Unix())
+	amount := decimal.NewFromInt(100)
+
+	mockAssetStore.On("GetAssetDecimals", mock.Anything).Return(uint8(6), nil)
+	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil)
+
+	currentState := newSwapTestState(senderWallet, "USDC", "0xSenderChannel", 500)
+	lockState := currentState.NextState()
+	transferID, err := core.GetConditionalTransferID(lockState.ID, receiverWallet, hashLock, expiresAt)
+	require.NoError(t, err)
+	_, err = lockState.ApplyConditionalLockTransition(transferID, amount)
+	require.NoError(t, err)
+	packed, err := core.PackState(*lockState, mockAssetStore)
+	require.NoError(t, err)
+	sig, err := senderChannelSigner.Sign(packed)
+	require.NoError(t, err)
+	sigStr := sig.String()
+	lockState.UserSig = &sigStr
+
+	mockStatePacker.On("PackState", mock.Anything).Return(packed, nil)
+	mockTxStore.On("LockUserState", senderWallet, "USDC").Return(decimal.Zero, nil).Once()
+	mockTxStore.On("CheckOpenChannel", senderWallet, "USDC").Return("0x03", true, nil)
+	mockTxStore.On("GetLastUserState", senderWallet, "USDC", false).Return(currentState, nil)
+	mockTxStore.On("EnsureNoOngoingStateTransitions", senderWallet, "USDC").Return(nil)
+	mockTxStore.On("CreateConditionalTransfer", mock.MatchedBy(func(transfer core.ConditionalTransfer) bool {
+		return transfer.ID == transferID &&
+			transfer.Sender == senderWallet &&
+			transfer.Receiver == receiverWallet &&
+			transfer.Amount.Equal(amount) &&
+			transfer.HashLock == hashLock &&
+			transfer.ExpiresAt.Unix() == int64(expiresAt) &&
+			transfer.Status == core.ConditionalTransferStatusLocked &&
+			transfer.LockStateID == lockState.ID
+	})).Return(nil).Once()
+	mockTxStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
+		return tx.TxType == core.TransactionTypeConditionalLock &&
+			tx.FromAccount == senderWallet && tx.ToAccount == transferID &&
+			tx.Amount.Equal(amount)
+	})).Return(nil).Once()
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.ID == lockState.ID &&
+			state.Transition.Type == core.TransitionTypeConditionalLock &&
+			state.HomeLedger.UserBalance.Equal(decimal.NewFromInt(400)) &&
+			state.NodeSig != nil
+	})).Return(nil).Once()
+	mockNotifier.On("Notify", senderWallet, rpc.ChannelsV1ConditionalTransferUpdatedEventName, mock.Anything).Return().Once()
+	mockNotifier.On("Notify", receiverWallet, rpc.ChannelsV1ConditionalTransferUpdatedEventName, mock.Anything).Return().Once()
+
+	ctx := submitConditionalLockRequest(t, handler, *lockState, receiverWallet, hashLock, expiresAt)
+
+	require.Nil(t, ctx.Response.Error())
+	var response rpc.ChannelsV1SubmitConditionalLockResponse
+	require.NoError(t, ctx.Response.Payload.Translate(&response))
+	assert.NotEmpty(t, response.Signature)
+	assert.Equal(t, transferID, response.ConditionalTransferID)
+
+	mockTxStore.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestSubmitConditionalLock_InvalidTerms(t *testing.T) {
+	senderWallet := NewMockSigner().PublicKey().Address().String()
+	receiverWallet := NewMockSigner().PublicKey().Address().String()
+	hashLock, err := core.GetHashLock(testConditionalPreimage)
+	require.NoError(t, err)
+	expiresAt := uint64(time.Now().Add(time.Hour).Unix())
+
+	newLockState := func(receiver, hashLock string, expiresAt uint64) core.State {
+		currentState := newSwapTestState(senderWallet, "USDC", "0xSenderChannel", 500)
+		lockState := currentState.NextState()
+		transferID, err := core.GetConditionalTransferID(lockState.ID, receiver, hashLock, expiresAt)
+		require.NoError(t, err)
+		_, err = lockState.ApplyConditionalLockTransition(transferID, decimal.NewFromInt(100))
+		require.NoError(t, err)
+		return *lockState
+	}
+
+	tests := []struct {
+		name          string
+		state         core.State
+		receiver      string
+		hashLock      string
+		expiresAt     uint64
+		expectedError string
+	}{
+		{
+			name: "wrong transition",
+			state: func() core.State {
+				s := newSwapTestState(senderWallet, "USDC", "0xSenderChannel", 500).NextState()
+				_, err := s.ApplyTransferSendTransition(receiverWallet, decimal.NewFromInt(100))
+				require.NoError(t, err)
+				return *s
+			}(),
+			receiver:      receiverWallet,
+			hashLock:      hashLock,
+			expiresAt:     expiresAt,
+			expectedError: "'conditional_lock' transition",
+		},
+		{
+			name:          "invalid receiver",
+			state:         newLockState(receiverWallet, hashLock, expiresAt),
+			receiver:      "not-an-address",
+			hashLock:      hashLock,
+			expiresAt:     expiresAt,
+			expectedError: "invalid receiver address",
+		},
+		{
+			name:          "receiver is sender",
+			state:         newLockState(senderWallet, hashLock, expiresAt),
+			receiver:      senderWallet,
+			hashLock:      hashLock,
+			expiresAt:     expiresAt,
+			expectedError: "sender and receiver wallets are the same",
+		},
+		{
+			name:          "invalid hash lock",
+			state:         newLockState(receiverWallet, "0x1234", expiresAt),
+			receiver:      receiverWallet,
+			hashLock:      "0x1234",
+			expiresAt:     expiresAt,
+			expectedError: "invalid hash lock length",
+		},
+		{
+			name:          "expiry in the past",
+			state:         newLockState(receiverWallet, hashLock, uint64(time.Now().Add(-time.Minute).Unix())),
+			receiver:      receiverWallet,
+			hashLock:      hashLock,
+			expiresAt:     uint64(time.Now().Add(-time.Minute).Unix()),
+			expectedError: "expiry must be in the future",
+		},
+		{
+			name:          "terms don't match transfer ID",
+			state:         newLockState(receiverWallet, hashLock, expiresAt),
+			receiver:      receiverWallet,
+			hashLock:      hashLock,
+			expiresAt:     expiresAt + 1,
+			expectedError: "conditional transfer ID mismatch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTxStore := new(MockStore)
+			handler := newSwapTestHandler(mockTxStore, new(MockAssetStore), new(MockStatePacker), new(MockNotifier))
+
+			ctx := submitConditionalLockRequest(t, handler, tt.state, tt.receiver, tt.hashLock, tt.expiresAt)
+
+			respErr := ctx.Response.Error()
+			require.NotNil(t, respErr)
+			assert.Contains(t, respErr.Error(), tt.expectedError)
+			mockTxStore.AssertNotCalled(t, "LockUserState", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -1,16 +1,21 @@
 package channel_v1
 
 import (
+	"context"
+
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/layer-3/nitrolite/pkg/core"
 	"github.com/layer-3/nitrolite/pkg/log"
 	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/shopspring/decimal"
 )
 
 // SubmitState processes user-submitted state transitions, validates them against the current state,
 // verifies user signatures, signs the new state with the node's key, and persists changes.
 // For transfer transitions, it automatically creates corresponding receiver states.
-// For certain transitions (escrow lock, etc.), it schedules blockchain actions.
+// For certain transitions (escrow lock, migration, etc.), it schedules blockchain actions.
+// An escrow deposit, an escrow withdrawal or a migration finalization completes the lock or the migration
+// of the last signed state; states the node issued in the meantime are issued again on top of it.
 func (h *Handler) SubmitState(c *rpc.Context) {
 	ctx := c.Context
 	logger := log.FromContext(ctx)
@@ -27,8 +32,21 @@ func (h *Handler) SubmitState(c *rpc.Context) {
 		return
 	}
 
-	var nodeSig string
+	// The node balance is read before the transaction, so that the user state isn't locked during the blockchain call.
+	// The amounts reserved by scheduled actions are subtracted from it within the transaction.
+	var nodeBalance decimal.Decimal
 	incomingTransition := incomingState.Transition
+	if incomingTransition.Type == core.TransitionTypeEscrowLock || incomingTransition.Type == core.TransitionTypeMigrate {
+		nodeBalance, err = h.getNodeBalance(ctx, incomingState.EscrowLedger)
+		if err != nil {
+			logger.Error("failed to process incoming state", "error", err)
+			c.Fail(err, "failed to process incoming state")
+			return
+		}
+	}
+
+	var nodeSig string
+	var receiverState *core.State
 	err = h.useStoreInTx(func(tx Store) error {
 		err := h.actionGateway.AllowAction(tx, incomingState.UserWallet, incomingState.Transition.Type.GatedAction())
 		if err != nil {
@@ -58,28 +76,31 @@ func (h *Handler) SubmitState(c *rpc.Context) {
 			return rpc.Errorf("failed to get last user state: %v", err)
 		}
 
-		// FIXME:
-		// var extraTransitions []core.Transition
+		var extraTransitions []core.Transition
+		var latestVersion uint64
 		switch incomingTransition.Type {
-		case core.TransitionTypeEscrowDeposit, core.TransitionTypeEscrowWithdraw, core.TransitionTypeMigrate:
-			return rpc.Errorf("transition is not supported yet")
-			// latestStateVersion := currentState.Version
-			// extraTransitions = currentState.Transitions
-
-			// currentState, err = tx.GetLastUserState(incomingState.UserWallet, incomingState.Asset, true)
-			// if err != nil {
-			// 	return rpc.Errorf("failed to get last user state: %v", err)
-			// }
-
-			// // User has no signed previous state
-			// if currentState == nil {
-			// 	return rpc.Errorf("no signed previous state found for escrow/migrate transition")
-			// }
-			// if currentState.Version < latestStateVersion {
-			// 	currentState.Version = latestStateVersion
-			// } else if currentState.Version == latestStateVersion {
-			// 	extraTransitions = nil // no extra transitions to reapply
-			// }
+		case core.TransitionTypeEscrowDeposit, core.TransitionTypeEscrowWithdraw, core.TransitionTypeFinalizeMigration:
+			// The incoming state completes the lock or the migration of the last signed state. States the node
+			// issued after it are issued again on top of the incoming state.
+			lastSignedState, err := tx.GetLastUserState(incomingState.UserWallet, incomingState.Asset, true)
+			if err != nil {
+				return rpc.Errorf("failed to get last signed user state: %v", err)
+			}
+			if lastSignedState == nil {
+				return rpc.Errorf("no signed previous state found for %s transition", incomingTransition.Type.String())
+			}
+
+			if currentState != nil && currentState.Epoch == lastSignedState.Epoch && currentState.Version > lastSignedState.Version {
+				pendingStates, err := tx.GetUserStatesAfterVersion(incomingState.UserWallet, incomingState.Asset, lastSignedState.Epoch, lastSignedState.Version)
+				if err != nil {
+					return rpc.Errorf("failed to get user states issued after the last signed state: %v", err)
+				}
+				for _, pendingState := range pendingStates {
+					extraTransitions = append(extraTransitions, pendingState.Transition)
+				}
+				latestVersion = currentState.Version
+			}
+			currentState = lastSignedState
 		default:
 			// User has no previous state
 			if currentState == nil {
@@ -143,7 +164,7 @@ func (h *Handler) SubmitState(c *rpc.Context) {
 				}
 
 			case core.TransitionTypeTransferSend:
-				newReceiverState, err := h.issueTransferReceiverState(ctx, tx, incomingState)
+				newReceiverState, err := h.issueReceiverState(ctx, tx, incomingState, incomingTransition.AccountID, core.TransitionTypeTransferReceive, true)
 				if err != nil {
 					return rpc.Errorf("failed to issue receiver state: %v", err)
 				}
@@ -151,63 +172,83 @@ func (h *Handler) SubmitState(c *rpc.Context) {
 				if err != nil {
 					return rpc.Errorf("failed to create transaction: %v", err)
 				}
+				receiverState = newReceiverState
 			case core.TransitionTypeMutualLock:
-				return rpc.Errorf("transition is not supported yet")
-				// if err := h.createEscrowChannel(tx, incomingState); err != nil {
-				// 	return err
-				// }
-
-				// transaction, err = core.NewTransactionFromTransition(&incomingState, nil, *incomingTransition)
-				// if err != nil {
-				// 	return rpc.Errorf("failed to create transaction: %v", err)
-				// }
+				if err := h.createEscrowChannel(tx, incomingState); err != nil {
+					return err
+				}
+
+				transaction, err = core.NewTransactionFromTransition(&incomingState, nil, incomingTransition)
+				if err != nil {
+					return rpc.Errorf("failed to create transaction: %v", err)
+				}
 			case core.TransitionTypeEscrowLock:
-				return rpc.Errorf("transition is not supported yet")
-				// if err := h.createEscrowChannel(tx, incomingState); err != nil {
-				// 	return err
-				// }
-
-				// if err := tx.ScheduleInitiateEscrowWithdrawal(incomingState.ID, incomingState.EscrowLedger.BlockchainID); err != nil {
-				// 	return rpc.Errorf("failed to schedule blockchain action: %v", err)
-				// }
-				// transaction, err = core.NewTransactionFromTransition(&incomingState, nil, *incomingTransition)
-				// if err != nil {
-				// 	return rpc.Errorf("failed to create transaction: %v", err)
-				// }
+				if err := h.ensureEscrowLiquidity(tx, incomingState, nodeBalance); err != nil {
+					return err
+				}
+				if err := h.createEscrowChannel(tx, incomingState); err != nil {
+					return err
+				}
+
+				if err := tx.ScheduleInitiateEscrowWithdrawal(incomingState.ID, incomingState.EscrowLedger.BlockchainID); err != nil {
+					return rpc.Errorf("failed to schedule blockchain action: %v", err)
+				}
+				transaction, err = core.NewTransactionFromTransition(&incomingState, nil, incomingTransition)
+				if err != nil {
+					return rpc.Errorf("failed to create transaction: %v", err)
+				}
 			case core.TransitionTypeEscrowDeposit:
-				return rpc.Errorf("transition is not supported yet")
-				// transaction, err = core.NewTransactionFromTransition(&incomingState, nil, *incomingTransition)
-				// if err != nil {
-				// 	return rpc.Errorf("failed to create transaction: %v", err)
-				// }
-				// extraState, err := h.issueExtraState(ctx, tx, incomingState, extraTransitions)
-				// if err != nil {
-				// 	return rpc.Errorf("failed to issue an extra state: %v", err)
-				// }
-				// logger.Info("extra state issued", "userID", extraState.UserWallet, "asset", extraState.Asset, "version", extraState.Version)
+				if err := tx.ScheduleFinalizeEscrowDeposit(incomingState.ID, incomingState.EscrowLedger.BlockchainID); err != nil {
+					return rpc.Errorf("failed to schedule blockchain action: %v", err)
+				}
+				transaction, err = core.NewTransactionFromTransition(&incomingState, nil, incomingTransition)
+				if err != nil {
+					return rpc.Errorf("failed to create transaction: %v", err)
+				}
 			case core.TransitionTypeEscrowWithdraw:
-				return rpc.Errorf("transition is not supported yet")
-				// transaction, err = core.NewTransactionFromTransition(&incomingState, nil, *incomingTransition)
-				// if err != nil {
-				// 	return rpc.Errorf("failed to create transaction: %v", err)
-				// }
-
-				// extraState, err := h.issueExtraState(ctx, tx, incomingState, extraTransitions)
-				// if err != nil {
-				// 	return rpc.Errorf("failed to issue an extra state: %v", err)
-				// }
-				// logger.Info("extra state issued", "userID", extraState.UserWallet, "asset", extraState.Asset, "version", extraState.Version)
+				// The user finalizes the escrow withdrawal on the escrow chain to receive the funds
+				transaction, err = core.NewTransactionFromTransition(&incomingState, nil, incomingTransition)
+				if err != nil {
+					return rpc.Errorf("failed to create transaction: %v", err)
+				}
 			case core.TransitionTypeFinalize:
 				transaction, err = core.NewTransactionFromTransition(&incomingState, nil, incomingTransition)
 				if err != nil {
 					return rpc.Errorf("failed to create transaction: %v", err)
 				}
 			case core.TransitionTypeMigrate:
-				return rpc.Errorf("transition is not supported yet")
-				// extraState, err := h.issueExtraState(ctx, tx, incomingState)
-				// if err != nil {
-				// 	return rpc.Errorf("failed to issue extra state: %v", err)
-				// }
+				// The node locks the migrated amount in the channel on the new home chain
+				if err := h.ensureAvailableNodeLiquidity(tx, nodeBalance, *incomingState.EscrowLedger, incomingTransition.Amount); err != nil {
+					return err
+				}
+				if err := h.createEscrowChannel(tx, incomingState); err != nil {
+					return err
+				}
+
+				if err := tx.ScheduleInitiateMigration(incomingState.ID, incomingState.EscrowLedger.BlockchainID); err != nil {
+					return rpc.Errorf("failed to schedule blockchain action: %v", err)
+				}
+				transaction, err = core.NewTransactionFromTransition(&incomingState, nil, incomingTransition)
+				if err != nil {
+					return rpc.Errorf("failed to create transaction: %v", err)
+				}
+			case core.TransitionTypeFinalizeMigration:
+				// The escrow ledger is the one of the old home chain, which releases the channel.
+				// The home channel operates on the new home chain from now on.
+				if err := h.createEscrowChannel(tx, incomingState); err != nil {
+					return err
+				}
+				if err := h.moveHomeChannel(tx, incomingState); err != nil {
+					return err
+				}
+
+				if err := tx.ScheduleFinalizeMigration(incomingState.ID, incomingState.EscrowLedger.BlockchainID); err != nil {
+					return rpc.Errorf("failed to schedule blockchain action: %v", err)
+				}
+				transaction, err = core.NewTransactionFromTransition(&incomingState, nil, incomingTransition)
+				if err != nil {
+					return rpc.Errorf("failed to create transaction: %v", err)
+				}
 			default:
 				return rpc.Errorf("transition '%s' is not supported by this endpoint", incomingTransition.Type.String())
 			}
@@ -229,6 +270,14 @@ func (h *Handler) SubmitState(c *rpc.Context) {
 			return rpc.Errorf("failed to store user state: %v", err)
 		}
 
+		if len(extraTransitions) > 0 {
+			extraState, err := h.issueExtraState(ctx, tx, incomingState, latestVersion, extraTransitions)
+			if err != nil {
+				return rpc.Errorf("failed to issue an extra state: %v", err)
+			}
+			logger.Info("extra state issued", "userWallet", extraState.UserWallet, "asset", extraState.Asset, "version", extraState.Version)
+		}
+
 		// TODO: consider state checkpoint if channel is challenged
 
 		return nil
@@ -249,6 +298,11 @@ func (h *Handler) SubmitState(c *rpc.Context) {
 	}
 
 	c.Succeed(c.Request.Method, payload)
+
+	if receiverState != nil {
+		h.eventPublisher.PublishTransferReceived(ctx, *receiverState)
+	}
+
 	logger.Info("processed incoming state",
 		"userWallet", incomingState.UserWallet,
 		"asset", incomingState.Asset,
@@ -256,6 +310,54 @@ func (h *Handler) SubmitState(c *rpc.Context) {
 		"incomingTransition", incomingTransition.Type.String())
 }
 
+// ensureEscrowLiquidity checks that the user can cover an escrow lock from the home channel and that
+// the node has enough funds left on the escrow chain to lock the amount in the escrow.
+func (h *Handler) ensureEscrowLiquidity(tx Store, incomingState core.State, nodeBalance decimal.Decimal) error {
+	if incomingState.EscrowLedger == nil {
+		return rpc.Errorf("missing escrow ledger")
+	}
+	amount := incomingState.Transition.Amount
+	if !amount.IsPositive() {
+		return rpc.Errorf("escrow lock amount must be positive")
+	}
+	if incomingState.HomeLedger.UserBalance.LessThan(amount) {
+		return rpc.Errorf("insufficient balance for escrow lock: %s < %s", incomingState.HomeLedger.UserBalance, amount)
+	}
+
+	return h.ensureAvailableNodeLiquidity(tx, nodeBalance, *incomingState.EscrowLedger, amount)
+}
+
+// getNodeBalance returns the node's balance of the ledger's token on the ledger's blockchain.
+func (h *Handler) getNodeBalance(ctx context.Context, ledger *core.Ledger) (decimal.Decimal, error) {
+	if ledger == nil {
+		return decimal.Zero, rpc.Errorf("missing escrow ledger")
+	}
+	if h.nodeBalances == nil {
+		return decimal.Zero, rpc.Errorf("node liquidity is not available")
+	}
+	nodeBalance, err := h.nodeBalances.GetNodeBalance(ctx, ledger.BlockchainID, ledger.TokenAddress)
+	if err != nil {
+		return decimal.Zero, rpc.Errorf("failed to get node balance on blockchain %d: %v", ledger.BlockchainID, err)
+	}
+	return nodeBalance, nil
+}
+
+// ensureAvailableNodeLiquidity checks that the node balance of the ledger's token on the ledger's blockchain
+// still covers the amount once the amounts the node is yet to lock for scheduled actions are reserved.
+func (h *Handler) ensureAvailableNodeLiquidity(tx Store, nodeBalance decimal.Decimal, ledger core.Ledger, amount decimal.Decimal) error {
+	blockchainID := ledger.BlockchainID
+	reserved, err := tx.GetPendingNodeLocks(blockchainID, ledger.TokenAddress)
+	if err != nil {
+		return rpc.Errorf("failed to get pending node locks on blockchain %d: %v", blockchainID, err)
+	}
+	available := nodeBalance.Sub(reserved)
+	if available.LessThan(amount) {
+		return rpc.Errorf("insufficient node liquidity on blockchain %d: %s < %s", blockchainID, available, amount)
+	}
+
+	return nil
+}
+
 func (h *Handler) createEscrowChannel(tx Store, incomingState core.State) error {
 	if incomingState.EscrowChannelID == nil {
 		return rpc.Errorf("missing escrow channel ID")
@@ -306,3 +408,21 @@ func (h *Handler) createEscrowChannel(tx Store, incomingState core.State) error
 	}
 	return nil
 }
+
+// moveHomeChannel moves the home channel to the blockchain and token of the incoming state's home ledger.
+func (h *Handler) moveHomeChannel(tx Store, incomingState core.State) error {
+	homeChannel, err := tx.GetChannelByID(*incomingState.HomeChannelID)
+	if err != nil {
+		return rpc.Errorf("failed to get home channel: %v", err)
+	}
+	if homeChannel == nil {
+		return rpc.Errorf("home channel does not exist")
+	}
+
+	homeChannel.BlockchainID = incomingState.HomeLedger.BlockchainID
+	homeChannel.TokenAddress = incomingState.HomeLedger.TokenAddress
+	if err := tx.UpdateChannel(*homeChannel); err != nil {
+		return rpc.Errorf("failed to update home channel: %v", err)
+	}
+	return nil
+}
@@ -25,6 +25,7 @@ func TestSubmitState_TransferSend_Success(t *testing.T) {
 	nodeAddress := mockSigner.PublicKey().Address().String()
 	minChallenge := uint32(3600)
 	mockStatePacker := new(MockStatePacker)
+	mockNotifier := new(MockNotifier)
 
 	handler := &Handler{
 		stateAdvancer: core.NewStateAdvancerV1(mockAssetStore),
@@ -41,8 +42,11 @@ func TestSubmitState_TransferSend_Success(t *testing.T) {
 		nodeAddress:      nodeAddress,
 		minChallenge:     minChallenge,
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
+		notifier:         mockNotifier,
+		eventPublisher:   NewEventPublisher(mockNotifier),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
 	}
 
 	// Test data - derive senderWallet from a user signer key
@@ -126,7 +130,7 @@ func TestSubmitState_TransferSend_Success(t *testing.T) {
 	mockTxStore.On("EnsureNoOngoingStateTransitions", senderWallet, asset).Return(nil)
 	mockStatePacker.On("PackState", mock.Anything).Return(packedSenderState, nil).Maybe()
 
-	// For issueTransferReceiverState
+	// For issueReceiverState
 	mockTxStore.On("LockUserState", receiverWallet, asset).Return(decimal.Zero, nil)
 	mockTxStore.On("GetLastUserState", receiverWallet, asset, false).Return(currentReceiverState, nil)
 	mockTxStore.On("GetLastUserState", receiverWallet, asset, true).Return(nil, nil)
@@ -155,6 +159,223 @@ func TestSubmitState_TransferSend_Success(t *testing.T) {
 			state.NodeSig != nil
 	})).Return(nil)
 
+	// For notifying the receiver about the incoming transfer
+	mockNotifier.On("Notify", receiverWallet, rpc.ChannelsV1TransferReceivedEventName, mock.MatchedBy(func(params rpc.Payload) bool {
+		var event rpc.ChannelsV1TransferReceivedEvent
+		if err := params.Translate(&event); err != nil {
+			return false
+		}
+		return event.State.UserWallet == receiverWallet &&
+			event.State.Version == strconv.FormatUint(expectedReceiverState.Version, 10) &&
+			event.State.Transition.Type == core.TransitionTypeTransferReceive
+	})).Return().Once()
+
+	// Create RPC request
+	rpcState := toRPCState(*incomingSenderState)
+	reqPayload := rpc.ChannelsV1SubmitStateRequest{
+		State: rpcState,
+	}
+	payload, err := rpc.NewPayload(reqPayload)
+	require.NoError(t, err)
+
+	rpcRequest := rpc.Message{
+		Method:  "channels.v1.submit_state",
+		Payload: payload,
+	}
+
+	ctx := &rpc.Context{
+		Context: context.Background(),
+		Request: rpcRequest,
+	}
+
+	// Execute
+	handler.SubmitState(ctx)
+
+	// Assert
+	assert.NotNil(t, ctx.Response.Payload)
+
+	var response rpc.ChannelsV1SubmitStateResponse
+	err = ctx.Response.Payload.Translate(&response)
+	require.NoError(t, err)
+	assert.Nil(t, ctx.Response.Error())
+	assert.NotEmpty(t, response.Signature, "Node signature should be present")
+
+	// Verify all mock expectations
+	mockTxStore.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestSubmitState_TransferSend_WithFee_Success(t *testing.T) {
+	// Setup
+	mockTxStore := new(MockStore)
+	mockMemoryStore := new(MockMemoryStore)
+	mockAssetStore := new(MockAssetStore)
+	mockSigner := NewMockSigner()
+	nodeSigner, _ := core.NewChannelDefaultSigner(mockSigner)
+	nodeAddress := mockSigner.PublicKey().Address().String()
+	minChallenge := uint32(3600)
+	mockStatePacker := new(MockStatePacker)
+	mockNotifier := new(MockNotifier)
+	feeAccount := "0x1111111111111111111111111111111111111111"
+	transferFee := decimal.NewFromInt(1)
+
+	handler := &Handler{
+		stateAdvancer: core.NewStateAdvancerV1(mockAssetStore),
+		statePacker:   mockStatePacker,
+		useStoreInTx: func(handler StoreTxHandler) error {
+			err := handler(mockTxStore)
+			if err != nil {
+				return err
+			}
+			return nil
+		},
+		memoryStore:      mockMemoryStore,
+		nodeSigner:       nodeSigner,
+		nodeAddress:      nodeAddress,
+		minChallenge:     minChallenge,
+		metrics:          metrics.NewNoopRuntimeMetricExporter(),
+		notifier:         mockNotifier,
+		eventPublisher:   NewEventPublisher(mockNotifier),
+		maxSessionKeyIDs: 256,
+		actionGateway:    &MockActionGateway{},
+		feeEngine: &MockFeeEngine{
+			Fees:    map[core.GatedAction]decimal.Decimal{core.GatedActionTransfer: transferFee},
+			Account: feeAccount,
+		},
+	}
+
+	// Test data - derive senderWallet from a user signer key
+	userSigner := NewMockSigner()
+	userWalletSigner, _ := core.NewChannelDefaultSigner(userSigner)
+	senderWallet := userSigner.PublicKey().Address().String()
+	receiverWallet := "0x0987654321098765432109876543210987654321"
+	asset := "USDC"
+	homeChannelID := "0xHomeChannel123"
+	transferAmount := decimal.NewFromInt(100)
+
+	// Create sender's current state (before transfer)
+	currentSenderState := core.State{
+		ID:            core.GetStateID(senderWallet, asset, 1, 1),
+		Transition:    core.Transition{},
+		Asset:         asset,
+		UserWallet:    senderWallet,
+		Epoch:         1,
+		Version:       1,
+		HomeChannelID: &homeChannelID,
+		HomeLedger: core.Ledger{
+			TokenAddress: "0xTokenAddress",
+			BlockchainID: 1,
+			UserBalance:  decimal.NewFromInt(500),
+			UserNetFlow:  decimal.NewFromInt(500),
+			NodeBalance:  decimal.NewFromInt(0),
+			NodeNetFlow:  decimal.NewFromInt(0),
+		},
+		EscrowLedger: nil,
+		UserSig:      nil,
+		NodeSig:      nil,
+	}
+
+	// Create incoming sender state (with transfer send transition)
+	incomingSenderState := currentSenderState.NextState()
+
+	// Apply the transfer send transition to update balances
+	transferSendTransition, err := incomingSenderState.ApplyTransferSendTransition(receiverWallet, transferAmount)
+	require.NoError(t, err)
+
+	// Sign the incoming sender state with user's wallet signer (adds 0x01 prefix)
+	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil).Once()
+	packedSenderState, _ := core.PackState(*incomingSenderState, mockAssetStore)
+	userSig, _ := userWalletSigner.Sign(packedSenderState)
+	userSigStr := userSig.String()
+	incomingSenderState.UserSig = &userSigStr
+
+	// Create receiver's current state
+	currentReceiverState := core.State{
+		ID:            core.GetStateID(receiverWallet, asset, 1, 1),
+		Transition:    core.Transition{},
+		Asset:         asset,
+		UserWallet:    receiverWallet,
+		Epoch:         1,
+		Version:       1,
+		HomeChannelID: &homeChannelID,
+		HomeLedger: core.Ledger{
+			TokenAddress: "0xTokenAddress",
+			BlockchainID: 1,
+			UserBalance:  decimal.NewFromInt(200),
+			UserNetFlow:  decimal.NewFromInt(200),
+			NodeBalance:  decimal.NewFromInt(0),
+			NodeNetFlow:  decimal.NewFromInt(0),
+		},
+		EscrowLedger: nil,
+		UserSig:      nil,
+		NodeSig:      nil,
+	}
+
+	// Expected receiver state after transfer receive, the fee is deducted from the received amount
+	expectedReceiverState := currentReceiverState.NextState()
+	_, err = expectedReceiverState.ApplyTransferReceiveTransition(senderWallet, transferAmount.Sub(transferFee), transferSendTransition.TxID)
+	require.NoError(t, err)
+
+	// Mock expectations
+	mockAssetStore.On("GetAssetDecimals", asset).Return(uint8(6), nil)
+	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil)
+	mockTxStore.On("LockUserState", senderWallet, asset).Return(decimal.Zero, nil)
+	mockTxStore.On("CheckOpenChannel", senderWallet, asset).Return("0x03", true, nil)
+	mockTxStore.On("GetLastUserState", senderWallet, asset, false).Return(currentSenderState, nil)
+	mockTxStore.On("EnsureNoOngoingStateTransitions", senderWallet, asset).Return(nil)
+	mockStatePacker.On("PackState", mock.Anything).Return(packedSenderState, nil).Maybe()
+
+	// For issueReceiverState
+	mockTxStore.On("LockUserState", receiverWallet, asset).Return(decimal.Zero, nil)
+	mockTxStore.On("GetLastUserState", receiverWallet, asset, false).Return(currentReceiverState, nil)
+	mockTxStore.On("GetLastUserState", receiverWallet, asset, true).Return(nil, nil)
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		// Verify receiver state
+		return state.UserWallet == receiverWallet &&
+			state.Version == expectedReceiverState.Version &&
+			state.Transition.Type == core.TransitionTypeTransferReceive &&
+			state.Transition.Amount.Equal(decimal.NewFromInt(99)) &&
+			state.HomeLedger.UserBalance.Equal(decimal.NewFromInt(299)) &&
+			state.NodeSig != nil
+	})).Return(nil)
+
+	// For recording the fee transaction
+	mockTxStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
+		return tx.TxType == core.TransactionTypeFee &&
+			tx.Amount.Equal(transferFee) &&
+			tx.FromAccount == receiverWallet &&
+			tx.ToAccount == feeAccount &&
+			tx.SenderNewStateID != nil && *tx.SenderNewStateID == expectedReceiverState.ID
+	})).Return(nil).Once()
+
+	// For recordTransaction
+	mockTxStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
+		return tx.TxType == core.TransactionTypeTransfer &&
+			tx.Amount.Equal(transferAmount) &&
+			tx.FromAccount == senderWallet &&
+			tx.ToAccount == receiverWallet
+	})).Return(nil)
+
+	// For storing sender state
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		// Verify sender state
+		return state.UserWallet == senderWallet &&
+			state.Version == incomingSenderState.Version &&
+			state.Transition.Type == core.TransitionTypeTransferSend &&
+			state.NodeSig != nil
+	})).Return(nil)
+
+	// For notifying the receiver about the incoming transfer
+	mockNotifier.On("Notify", receiverWallet, rpc.ChannelsV1TransferReceivedEventName, mock.MatchedBy(func(params rpc.Payload) bool {
+		var event rpc.ChannelsV1TransferReceivedEvent
+		if err := params.Translate(&event); err != nil {
+			return false
+		}
+		return event.State.UserWallet == receiverWallet &&
+			event.State.Version == strconv.FormatUint(expectedReceiverState.Version, 10) &&
+			event.State.Transition.Type == core.TransitionTypeTransferReceive
+	})).Return().Once()
+
 	// Create RPC request
 	rpcState := toRPCState(*incomingSenderState)
 	reqPayload := rpc.ChannelsV1SubmitStateRequest{
@@ -187,10 +408,103 @@ func TestSubmitState_TransferSend_Success(t *testing.T) {
 
 	// Verify all mock expectations
 	mockTxStore.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestSubmitState_TransferSend_FeeExceedsAmount(t *testing.T) {
+	// Setup
+	mockTxStore := new(MockStore)
+	mockAssetStore := new(MockAssetStore)
+	mockSigner := NewMockSigner()
+	nodeSigner, _ := core.NewChannelDefaultSigner(mockSigner)
+	mockStatePacker := new(MockStatePacker)
+	mockNotifier := new(MockNotifier)
+
+	handler := &Handler{
+		stateAdvancer: core.NewStateAdvancerV1(mockAssetStore),
+		statePacker:   mockStatePacker,
+		useStoreInTx: func(handler StoreTxHandler) error {
+			return handler(mockTxStore)
+		},
+		memoryStore:      new(MockMemoryStore),
+		nodeSigner:       nodeSigner,
+		nodeAddress:      mockSigner.PublicKey().Address().String(),
+		metrics:          metrics.NewNoopRuntimeMetricExporter(),
+		notifier:         mockNotifier,
+		eventPublisher:   NewEventPublisher(mockNotifier),
+		maxSessionKeyIDs: 256,
+		actionGateway:    &MockActionGateway{},
+		feeEngine: &MockFeeEngine{
+			Fees:    map[core.GatedAction]decimal.Decimal{core.GatedActionTransfer: decimal.NewFromInt(5)},
+			Account: "0x1111111111111111111111111111111111111111",
+		},
+	}
+
+	userSigner := NewMockSigner()
+	userWalletSigner, _ := core.NewChannelDefaultSigner(userSigner)
+	senderWallet := userSigner.PublicKey().Address().String()
+	receiverWallet := "0x0987654321098765432109876543210987654321"
+	asset := "USDC"
+	homeChannelID := "0xHomeChannel123"
+
+	currentSenderState := core.State{
+		ID:            core.GetStateID(senderWallet, asset, 1, 1),
+		Asset:         asset,
+		UserWallet:    senderWallet,
+		Epoch:         1,
+		Version:       1,
+		HomeChannelID: &homeChannelID,
+		HomeLedger: core.Ledger{
+			TokenAddress: "0xTokenAddress",
+			BlockchainID: 1,
+			UserBalance:  decimal.NewFromInt(500),
+			UserNetFlow:  decimal.NewFromInt(500),
+			NodeBalance:  decimal.NewFromInt(0),
+			NodeNetFlow:  decimal.NewFromInt(0),
+		},
+	}
+
+	// The transfer amount does not cover the transfer fee
+	incomingSenderState := currentSenderState.NextState()
+	_, err := incomingSenderState.ApplyTransferSendTransition(receiverWallet, decimal.NewFromInt(5))
+	require.NoError(t, err)
+
+	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil)
+	mockAssetStore.On("GetAssetDecimals", asset).Return(uint8(6), nil)
+	packedSenderState, _ := core.PackState(*incomingSenderState, mockAssetStore)
+	userSig, _ := userWalletSigner.Sign(packedSenderState)
+	userSigStr := userSig.String()
+	incomingSenderState.UserSig = &userSigStr
+
+	mockTxStore.On("LockUserState", mock.Anything, asset).Return(decimal.Zero, nil)
+	mockTxStore.On("CheckOpenChannel", senderWallet, asset).Return("0x03", true, nil)
+	mockTxStore.On("GetLastUserState", senderWallet, asset, false).Return(currentSenderState, nil)
+	mockTxStore.On("EnsureNoOngoingStateTransitions", senderWallet, asset).Return(nil)
+	mockTxStore.On("GetLastUserState", receiverWallet, asset, false).Return(nil, nil)
+	mockStatePacker.On("PackState", mock.Anything).Return(packedSenderState, nil).Maybe()
+
+	payload, err := rpc.NewPayload(rpc.ChannelsV1SubmitStateRequest{State: toRPCState(*incomingSenderState)})
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context: context.Background(),
+		Request: rpc.Message{
+			Method:  "channels.v1.submit_state",
+			Payload: payload,
+		},
+	}
+
+	// Execute
+	handler.SubmitState(ctx)
+
+	// Assert
+	require.NotNil(t, ctx.Response.Error())
+	assert.Contains(t, ctx.Response.Error().Error(), "does not cover the transfer fee")
+	mockTxStore.AssertNotCalled(t, "RecordTransaction", mock.Anything)
+	mockTxStore.AssertNotCalled(t, "StoreUserState", mock.Anything)
 }
 
 func TestSubmitState_EscrowLock_Success(t *testing.T) {
-	t.Skip("transition is not supported yet")
 	// Setup
 	mockTxStore := new(MockStore)
 	mockMemoryStore := new(MockMemoryStore)
@@ -218,6 +532,8 @@ func TestSubmitState_EscrowLock_Success(t *testing.T) {
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
+		nodeBalances:     &MockNodeBalances{Balances: map[uint64]decimal.Decimal{2: decimal.NewFromInt(1000)}},
 	}
 
 	// Test data - derive userWallet from a user signer key
@@ -287,8 +603,10 @@ func TestSubmitState_EscrowLock_Success(t *testing.T) {
 	mockAssetStore.On("GetAssetDecimals", asset).Return(uint8(6), nil)
 	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil)
 	mockAssetStore.On("GetTokenDecimals", uint64(2), "0xTokenAddress").Return(uint8(6), nil)
+	mockTxStore.On("LockUserState", userWallet, asset).Return(decimal.Zero, nil)
 	mockTxStore.On("CheckOpenChannel", userWallet, asset).Return("0x03", true, nil)
 	mockTxStore.On("GetLastUserState", userWallet, asset, false).Return(currentState, nil)
+	mockTxStore.On("EnsureNoOngoingStateTransitions", userWallet, asset).Return(nil)
 	mockStatePacker.On("PackState", mock.Anything).Return(packedState, nil)
 	mockTxStore.On("GetChannelByID", homeChannelID).Return(&homeChannel, nil)
 	mockMemoryStore.On("IsAssetSupported", asset, "0xTokenAddress", uint64(2)).Return(true, nil)
@@ -297,6 +615,7 @@ func TestSubmitState_EscrowLock_Success(t *testing.T) {
 			channel.Type == core.ChannelTypeEscrow &&
 			channel.UserWallet == userWallet
 	})).Return(nil)
+	mockTxStore.On("GetPendingNodeLocks", uint64(2), "0xTokenAddress").Return(decimal.NewFromInt(500), nil)
 	mockTxStore.On("ScheduleInitiateEscrowWithdrawal", incomingState.ID, uint64(2)).Return(nil)
 	mockTxStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
 		return tx.TxType == core.TransactionTypeEscrowLock &&
@@ -345,12 +664,122 @@ func TestSubmitState_EscrowLock_Success(t *testing.T) {
 	mockTxStore.AssertExpectations(t)
 }
 
-func TestSubmitState_EscrowWithdraw_Success(t *testing.T) {
-	t.Skip("transition is not supported yet")
-	// Setup
-	mockTxStore := new(MockStore)
-	mockMemoryStore := new(MockMemoryStore)
-	mockAssetStore := new(MockAssetStore)
+func TestSubmitState_EscrowLock_InsufficientNodeLiquidity(t *testing.T) {
+	tests := []struct {
+		name        string
+		nodeBalance decimal.Decimal
+		pendingLock decimal.Decimal
+	}{
+		{
+			name:        "node balance below the lock amount",
+			nodeBalance: decimal.NewFromInt(50),
+			pendingLock: decimal.Zero,
+		},
+		{
+			name:        "node balance reserved by scheduled locks",
+			nodeBalance: decimal.NewFromInt(1000),
+			pendingLock: decimal.NewFromInt(950),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup
+			mockTxStore := new(MockStore)
+			mockAssetStore := new(MockAssetStore)
+			mockSigner := NewMockSigner()
+			nodeSigner, _ := core.NewChannelDefaultSigner(mockSigner)
+			mockStatePacker := new(MockStatePacker)
+
+			handler := &Handler{
+				stateAdvancer: core.NewStateAdvancerV1(mockAssetStore),
+				statePacker:   mockStatePacker,
+				useStoreInTx: func(handler StoreTxHandler) error {
+					return handler(mockTxStore)
+				},
+				memoryStore:      new(MockMemoryStore),
+				nodeSigner:       nodeSigner,
+				nodeAddress:      mockSigner.PublicKey().Address().String(),
+				minChallenge:     3600,
+				metrics:          metrics.NewNoopRuntimeMetricExporter(),
+				maxSessionKeyIDs: 256,
+				actionGateway:    &MockActionGateway{},
+				feeEngine:        &MockFeeEngine{},
+				nodeBalances:     &MockNodeBalances{Balances: map[uint64]decimal.Decimal{2: tc.nodeBalance}},
+			}
+
+			// Test data - derive userWallet from a user signer key
+			userSigner := NewMockSigner()
+			userWalletSigner, _ := core.NewChannelDefaultSigner(userSigner)
+			userWallet := userSigner.PublicKey().Address().String()
+			asset := "USDC"
+			homeChannelID := "0xHomeChannel123"
+
+			currentState := core.State{
+				ID:            core.GetStateID(userWallet, asset, 1, 1),
+				Asset:         asset,
+				UserWallet:    userWallet,
+				Epoch:         1,
+				Version:       1,
+				HomeChannelID: &homeChannelID,
+				HomeLedger: core.Ledger{
+					TokenAddress: "0xTokenAddress",
+					BlockchainID: 1,
+					UserBalance:  decimal.NewFromInt(500),
+					UserNetFlow:  decimal.NewFromInt(500),
+					NodeBalance:  decimal.Zero,
+					NodeNetFlow:  decimal.Zero,
+				},
+			}
+
+			// The node has less than the lock amount left on the escrow chain
+			incomingState := currentState.NextState()
+			_, err := incomingState.ApplyEscrowLockTransition(2, "0xTokenAddress", decimal.NewFromInt(100))
+			require.NoError(t, err)
+
+			mockAssetStore.On("GetAssetDecimals", asset).Return(uint8(6), nil)
+			mockAssetStore.On("GetTokenDecimals", mock.Anything, "0xTokenAddress").Return(uint8(6), nil)
+			packedState, _ := core.PackState(*incomingState, mockAssetStore)
+			userSig, _ := userWalletSigner.Sign(packedState)
+			userSigStr := userSig.String()
+			incomingState.UserSig = &userSigStr
+
+			mockTxStore.On("LockUserState", userWallet, asset).Return(decimal.Zero, nil)
+			mockTxStore.On("CheckOpenChannel", userWallet, asset).Return("0x03", true, nil)
+			mockTxStore.On("GetLastUserState", userWallet, asset, false).Return(currentState, nil)
+			mockTxStore.On("EnsureNoOngoingStateTransitions", userWallet, asset).Return(nil)
+			mockStatePacker.On("PackState", mock.Anything).Return(packedState, nil)
+			mockTxStore.On("GetPendingNodeLocks", uint64(2), "0xTokenAddress").Return(tc.pendingLock, nil)
+
+			payload, err := rpc.NewPayload(rpc.ChannelsV1SubmitStateRequest{State: toRPCState(*incomingState)})
+			require.NoError(t, err)
+			ctx := &rpc.Context{
+				Context: context.Background(),
+				Request: rpc.Message{
+					Method:  "channels.v1.submit_state",
+					Payload: payload,
+				},
+			}
+
+			// Execute
+			handler.SubmitState(ctx)
+
+			// Assert
+			respErr := ctx.Response.Error()
+			require.Error(t, respErr)
+			assert.Contains(t, respErr.Error(), "insufficient node liquidity on blockchain 2: 50 < 100")
+			mockTxStore.AssertNotCalled(t, "CreateChannel", mock.Anything)
+			mockTxStore.AssertNotCalled(t, "ScheduleInitiateEscrowWithdrawal", mock.Anything, mock.Anything)
+			mockTxStore.AssertNotCalled(t, "StoreUserState", mock.Anything)
+		})
+	}
+}
+
+func TestSubmitState_EscrowWithdraw_Success(t *testing.T) {
+	// Setup
+	mockTxStore := new(MockStore)
+	mockMemoryStore := new(MockMemoryStore)
+	mockAssetStore := new(MockAssetStore)
 	mockSigner := NewMockSigner()
 	nodeSigner, _ := core.NewChannelDefaultSigner(mockSigner)
 	nodeAddress := mockSigner.PublicKey().Address().String()
@@ -374,6 +803,7 @@ func TestSubmitState_EscrowWithdraw_Success(t *testing.T) {
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
 	}
 
 	// Test data - derive userWallet from a user signer key
@@ -404,99 +834,453 @@ func TestSubmitState_EscrowWithdraw_Success(t *testing.T) {
 		HomeLedger: core.Ledger{
 			TokenAddress: "0xTokenAddress",
 			BlockchainID: 1,
-			UserBalance:  decimal.NewFromInt(400),
-			UserNetFlow:  decimal.NewFromInt(400),
-			NodeBalance:  decimal.NewFromInt(0),
-			NodeNetFlow:  decimal.NewFromInt(0),
-		},
-		EscrowLedger: &core.Ledger{
-			TokenAddress: "0xTokenAddress",
-			BlockchainID: 2,
-			UserBalance:  decimal.NewFromInt(0),
-			UserNetFlow:  decimal.NewFromInt(0),
-			NodeBalance:  decimal.NewFromInt(100),
-			NodeNetFlow:  decimal.NewFromInt(100),
+			UserBalance:  decimal.NewFromInt(400),
+			UserNetFlow:  decimal.NewFromInt(400),
+			NodeBalance:  decimal.NewFromInt(0),
+			NodeNetFlow:  decimal.NewFromInt(0),
+		},
+		EscrowLedger: &core.Ledger{
+			TokenAddress: "0xTokenAddress",
+			BlockchainID: 2,
+			UserBalance:  decimal.NewFromInt(0),
+			UserNetFlow:  decimal.NewFromInt(0),
+			NodeBalance:  decimal.NewFromInt(100),
+			NodeNetFlow:  decimal.NewFromInt(100),
+		},
+		UserSig: stringPtr("0xPreviousUserSig"),
+		NodeSig: stringPtr("0xPreviousNodeSig"),
+	}
+
+	// Create incoming state with escrow withdraw transition
+	incomingState := currentSignedState.NextState()
+
+	// Apply the escrow withdraw transition to update balances
+	_, err := incomingState.ApplyEscrowWithdrawTransition(withdrawAmount)
+	require.NoError(t, err)
+
+	// Sign the incoming state with user's wallet signer (adds 0x01 prefix)
+	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil).Maybe()
+	mockAssetStore.On("GetTokenDecimals", uint64(2), "0xTokenAddress").Return(uint8(6), nil).Maybe()
+	mockAssetStore.On("GetTokenDecimals", uint64(2), "0xEscrowToken").Return(uint8(6), nil).Maybe()
+	packedState, _ := core.PackState(*incomingState, mockAssetStore)
+	userSig, _ := userWalletSigner.Sign(packedState)
+	userSigStr := userSig.String()
+	incomingState.UserSig = &userSigStr
+
+	// Create a copy for the unsigned state mock
+	currentUnsignedState := currentSignedState
+
+	// Mock expectations
+	mockAssetStore.On("GetAssetDecimals", asset).Return(uint8(6), nil)
+	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil)
+	mockAssetStore.On("GetTokenDecimals", uint64(2), "0xTokenAddress").Return(uint8(6), nil)
+	mockTxStore.On("LockUserState", userWallet, asset).Return(decimal.Zero, nil)
+	mockTxStore.On("CheckOpenChannel", userWallet, asset).Return("0x03", true, nil)
+	mockTxStore.On("GetLastUserState", userWallet, asset, false).Return(currentUnsignedState, nil)
+	mockTxStore.On("GetLastUserState", userWallet, asset, true).Return(currentSignedState, nil)
+	mockTxStore.On("EnsureNoOngoingStateTransitions", userWallet, asset).Return(nil)
+
+	mockStatePacker.On("PackState", mock.Anything).Return(packedState, nil)
+
+	mockTxStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
+		return tx.TxType == core.TransactionTypeEscrowWithdraw &&
+			tx.FromAccount == homeChannelID &&
+			tx.ToAccount == escrowChannelID &&
+			tx.Amount.Equal(withdrawAmount)
+	})).Return(nil)
+
+	// Store incoming state with node signature
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == userWallet &&
+			state.Version == incomingState.Version &&
+			state.Transition.Type == core.TransitionTypeEscrowWithdraw &&
+			state.NodeSig != nil
+	})).Return(nil)
+
+	// Create RPC request
+	rpcState := toRPCState(*incomingState)
+	reqPayload := rpc.ChannelsV1SubmitStateRequest{
+		State: rpcState,
+	}
+	payload, err := rpc.NewPayload(reqPayload)
+	require.NoError(t, err)
+
+	rpcRequest := rpc.Message{
+		Method:  "channels.v1.submit_state",
+		Payload: payload,
+	}
+
+	ctx := &rpc.Context{
+		Context: context.Background(),
+		Request: rpcRequest,
+	}
+
+	// Execute
+	handler.SubmitState(ctx)
+
+	// Assert
+	assert.NotNil(t, ctx.Response.Payload)
+
+	var response rpc.ChannelsV1SubmitStateResponse
+	err = ctx.Response.Payload.Translate(&response)
+	require.NoError(t, err)
+	assert.Nil(t, ctx.Response.Error())
+	assert.NotEmpty(t, response.Signature, "Node signature should be present")
+
+	// Verify all mock expectations
+	mockTxStore.AssertExpectations(t)
+}
+
+func TestSubmitState_Migrate_Success(t *testing.T) {
+	// Setup
+	mockTxStore := new(MockStore)
+	mockMemoryStore := new(MockMemoryStore)
+	mockAssetStore := new(MockAssetStore)
+	mockSigner := NewMockSigner()
+	nodeSigner, _ := core.NewChannelDefaultSigner(mockSigner)
+	mockStatePacker := new(MockStatePacker)
+
+	handler := &Handler{
+		stateAdvancer: core.NewStateAdvancerV1(mockAssetStore),
+		statePacker:   mockStatePacker,
+		useStoreInTx: func(handler StoreTxHandler) error {
+			return handler(mockTxStore)
+		},
+		memoryStore:      mockMemoryStore,
+		nodeSigner:       nodeSigner,
+		nodeAddress:      mockSigner.PublicKey().Address().String(),
+		minChallenge:     3600,
+		metrics:          metrics.NewNoopRuntimeMetricExporter(),
+		maxSessionKeyIDs: 256,
+		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
+		nodeBalances:     &MockNodeBalances{Balances: map[uint64]decimal.Decimal{2: decimal.NewFromInt(1000)}},
+	}
+
+	// Test data - derive userWallet from a user signer key
+	userSigner := NewMockSigner()
+	userWalletSigner, _ := core.NewChannelDefaultSigner(userSigner)
+	userWallet := userSigner.PublicKey().Address().String()
+	asset := "USDC"
+	homeChannelID := "0xHomeChannel123"
+	migrateAmount := decimal.NewFromInt(500)
+
+	currentState := core.State{
+		ID:            core.GetStateID(userWallet, asset, 1, 1),
+		Asset:         asset,
+		UserWallet:    userWallet,
+		Epoch:         1,
+		Version:       1,
+		HomeChannelID: &homeChannelID,
+		HomeLedger: core.Ledger{
+			TokenAddress: "0xTokenAddress",
+			BlockchainID: 1,
+			UserBalance:  decimal.NewFromInt(500),
+			UserNetFlow:  decimal.NewFromInt(500),
+			NodeBalance:  decimal.Zero,
+			NodeNetFlow:  decimal.Zero,
+		},
+	}
+
+	// The whole user balance moves to the home channel on blockchain 2
+	incomingState := currentState.NextState()
+	_, err := incomingState.ApplyMigrateTransition(2, "0xEscrowToken", migrateAmount)
+	require.NoError(t, err)
+	escrowChannelID := *incomingState.EscrowChannelID
+
+	homeChannel := core.Channel{
+		ChannelID:         homeChannelID,
+		UserWallet:        userWallet,
+		Asset:             asset,
+		Type:              core.ChannelTypeHome,
+		BlockchainID:      1,
+		TokenAddress:      "0xTokenAddress",
+		ChallengeDuration: 86400,
+		Nonce:             12345,
+		Status:            core.ChannelStatusOpen,
+		StateVersion:      1,
+	}
+
+	mockAssetStore.On("GetAssetDecimals", asset).Return(uint8(6), nil)
+	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil)
+	mockAssetStore.On("GetTokenDecimals", uint64(2), "0xEscrowToken").Return(uint8(6), nil)
+	packedState, _ := core.PackState(*incomingState, mockAssetStore)
+	userSig, _ := userWalletSigner.Sign(packedState)
+	userSigStr := userSig.String()
+	incomingState.UserSig = &userSigStr
+
+	mockTxStore.On("LockUserState", userWallet, asset).Return(decimal.Zero, nil)
+	mockTxStore.On("CheckOpenChannel", userWallet, asset).Return("0x03", true, nil)
+	mockTxStore.On("GetLastUserState", userWallet, asset, false).Return(currentState, nil)
+	mockTxStore.On("EnsureNoOngoingStateTransitions", userWallet, asset).Return(nil)
+	mockStatePacker.On("PackState", mock.Anything).Return(packedState, nil)
+	mockTxStore.On("GetChannelByID", homeChannelID).Return(&homeChannel, nil)
+	mockMemoryStore.On("IsAssetSupported", asset, "0xEscrowToken", uint64(2)).Return(true, nil)
+	mockTxStore.On("CreateChannel", mock.MatchedBy(func(channel core.Channel) bool {
+		return channel.ChannelID == escrowChannelID &&
+			channel.Type == core.ChannelTypeEscrow &&
+			channel.BlockchainID == 2
+	})).Return(nil)
+	mockTxStore.On("GetPendingNodeLocks", uint64(2), "0xEscrowToken").Return(decimal.NewFromInt(400), nil)
+	mockTxStore.On("ScheduleInitiateMigration", incomingState.ID, uint64(2)).Return(nil)
+	mockTxStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
+		return tx.TxType == core.TransactionTypeMigrate &&
+			tx.FromAccount == homeChannelID &&
+			tx.ToAccount == escrowChannelID &&
+			tx.Amount.Equal(migrateAmount)
+	})).Return(nil)
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == userWallet &&
+			state.Version == incomingState.Version &&
+			state.Transition.Type == core.TransitionTypeMigrate &&
+			state.NodeSig != nil
+	})).Return(nil)
+
+	payload, err := rpc.NewPayload(rpc.ChannelsV1SubmitStateRequest{State: toRPCState(*incomingState)})
+	require.NoError(t, err)
+	ctx := &rpc.Context{
+		Context: context.Background(),
+		Request: rpc.Message{
+			Method:  "channels.v1.submit_state",
+			Payload: payload,
+		},
+	}
+
+	// Execute
+	handler.SubmitState(ctx)
+
+	// Assert
+	require.NoError(t, ctx.Response.Error())
+	var response rpc.ChannelsV1SubmitStateResponse
+	require.NoError(t, ctx.Response.Payload.Translate(&response))
+	assert.NotEmpty(t, response.Signature, "Node signature should be present")
+	mockTxStore.AssertExpectations(t)
+	mockMemoryStore.AssertExpectations(t)
+}
+
+func TestSubmitState_Migrate_ReservedNodeLiquidity(t *testing.T) {
+	// Setup
+	mockTxStore := new(MockStore)
+	mockAssetStore := new(MockAssetStore)
+	mockSigner := NewMockSigner()
+	nodeSigner, _ := core.NewChannelDefaultSigner(mockSigner)
+	mockStatePacker := new(MockStatePacker)
+
+	handler := &Handler{
+		stateAdvancer: core.NewStateAdvancerV1(mockAssetStore),
+		statePacker:   mockStatePacker,
+		useStoreInTx: func(handler StoreTxHandler) error {
+			return handler(mockTxStore)
+		},
+		memoryStore:      new(MockMemoryStore),
+		nodeSigner:       nodeSigner,
+		nodeAddress:      mockSigner.PublicKey().Address().String(),
+		minChallenge:     3600,
+		metrics:          metrics.NewNoopRuntimeMetricExporter(),
+		maxSessionKeyIDs: 256,
+		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
+		nodeBalances:     &MockNodeBalances{Balances: map[uint64]decimal.Decimal{2: decimal.NewFromInt(1000)}},
+	}
+
+	// Test data - derive userWallet from a user signer key
+	userSigner := NewMockSigner()
+	userWalletSigner, _ := core.NewChannelDefaultSigner(userSigner)
+	userWallet := userSigner.PublicKey().Address().String()
+	asset := "USDC"
+	homeChannelID := "0xHomeChannel123"
+
+	currentState := core.State{
+		ID:            core.GetStateID(userWallet, asset, 1, 1),
+		Asset:         asset,
+		UserWallet:    userWallet,
+		Epoch:         1,
+		Version:       1,
+		HomeChannelID: &homeChannelID,
+		HomeLedger: core.Ledger{
+			TokenAddress: "0xTokenAddress",
+			BlockchainID: 1,
+			UserBalance:  decimal.NewFromInt(500),
+			UserNetFlow:  decimal.NewFromInt(500),
+			NodeBalance:  decimal.Zero,
+			NodeNetFlow:  decimal.Zero,
+		},
+	}
+
+	// Most of the node balance on blockchain 2 is reserved by scheduled locks and migrations
+	incomingState := currentState.NextState()
+	_, err := incomingState.ApplyMigrateTransition(2, "0xEscrowToken", decimal.NewFromInt(500))
+	require.NoError(t, err)
+
+	mockAssetStore.On("GetAssetDecimals", asset).Return(uint8(6), nil)
+	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil)
+	mockAssetStore.On("GetTokenDecimals", uint64(2), "0xEscrowToken").Return(uint8(6), nil)
+	packedState, _ := core.PackState(*incomingState, mockAssetStore)
+	userSig, _ := userWalletSigner.Sign(packedState)
+	userSigStr := userSig.String()
+	incomingState.UserSig = &userSigStr
+
+	mockTxStore.On("LockUserState", userWallet, asset).Return(decimal.Zero, nil)
+	mockTxStore.On("CheckOpenChannel", userWallet, asset).Return("0x03", true, nil)
+	mockTxStore.On("GetLastUserState", userWallet, asset, false).Return(currentState, nil)
+	mockTxStore.On("EnsureNoOngoingStateTransitions", userWallet, asset).Return(nil)
+	mockStatePacker.On("PackState", mock.Anything).Return(packedState, nil)
+	mockTxStore.On("GetPendingNodeLocks", uint64(2), "0xEscrowToken").Return(decimal.NewFromInt(700), nil)
+
+	payload, err := rpc.NewPayload(rpc.ChannelsV1SubmitStateRequest{State: toRPCState(*incomingState)})
+	require.NoError(t, err)
+	ctx := &rpc.Context{
+		Context: context.Background(),
+		Request: rpc.Message{
+			Method:  "channels.v1.submit_state",
+			Payload: payload,
+		},
+	}
+
+	// Execute
+	handler.SubmitState(ctx)
+
+	// Assert
+	respErr := ctx.Response.Error()
+	require.Error(t, respErr)
+	assert.Contains(t, respErr.Error(), "insufficient node liquidity on blockchain 2: 300 < 500")
+	mockTxStore.AssertNotCalled(t, "CreateChannel", mock.Anything)
+	mockTxStore.AssertNotCalled(t, "ScheduleInitiateMigration", mock.Anything, mock.Anything)
+	mockTxStore.AssertNotCalled(t, "StoreUserState", mock.Anything)
+}
+
+func TestSubmitState_FinalizeMigration_Success(t *testing.T) {
+	// Setup
+	mockTxStore := new(MockStore)
+	mockMemoryStore := new(MockMemoryStore)
+	mockAssetStore := new(MockAssetStore)
+	mockSigner := NewMockSigner()
+	nodeSigner, _ := core.NewChannelDefaultSigner(mockSigner)
+	mockStatePacker := new(MockStatePacker)
+
+	handler := &Handler{
+		stateAdvancer: core.NewStateAdvancerV1(mockAssetStore),
+		statePacker:   mockStatePacker,
+		useStoreInTx: func(handler StoreTxHandler) error {
+			return handler(mockTxStore)
+		},
+		memoryStore:      mockMemoryStore,
+		nodeSigner:       nodeSigner,
+		nodeAddress:      mockSigner.PublicKey().Address().String(),
+		minChallenge:     3600,
+		metrics:          metrics.NewNoopRuntimeMetricExporter(),
+		maxSessionKeyIDs: 256,
+		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
+	}
+
+	// Test data - derive userWallet from a user signer key
+	userSigner := NewMockSigner()
+	userWalletSigner, _ := core.NewChannelDefaultSigner(userSigner)
+	userWallet := userSigner.PublicKey().Address().String()
+	asset := "USDC"
+	homeChannelID := "0xHomeChannel123"
+	migrateAmount := decimal.NewFromInt(500)
+
+	// The last signed state prepares the migration to blockchain 2
+	migrateState := core.State{
+		ID:            core.GetStateID(userWallet, asset, 1, 1),
+		Asset:         asset,
+		UserWallet:    userWallet,
+		Epoch:         1,
+		Version:       1,
+		HomeChannelID: &homeChannelID,
+		HomeLedger: core.Ledger{
+			TokenAddress: "0xTokenAddress",
+			BlockchainID: 1,
+			UserBalance:  decimal.NewFromInt(500),
+			UserNetFlow:  decimal.NewFromInt(500),
+			NodeBalance:  decimal.Zero,
+			NodeNetFlow:  decimal.Zero,
 		},
-		UserSig: stringPtr("0xPreviousUserSig"),
-		NodeSig: stringPtr("0xPreviousNodeSig"),
 	}
+	migrateState = *migrateState.NextState()
+	_, err := migrateState.ApplyMigrateTransition(2, "0xEscrowToken", migrateAmount)
+	require.NoError(t, err)
+	migrateState.UserSig = stringPtr("0xPreviousUserSig")
+	migrateState.NodeSig = stringPtr("0xPreviousNodeSig")
 
-	// Create incoming state with escrow withdraw transition
-	incomingState := currentSignedState.NextState()
-
-	// Apply the escrow withdraw transition to update balances
-	_, err := incomingState.ApplyEscrowWithdrawTransition(withdrawAmount)
+	incomingState := migrateState.NextState()
+	_, err = incomingState.ApplyFinalizeMigrationTransition(migrateAmount)
 	require.NoError(t, err)
 
-	// Sign the incoming state with user's wallet signer (adds 0x01 prefix)
-	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil).Maybe()
-	mockAssetStore.On("GetTokenDecimals", uint64(2), "0xTokenAddress").Return(uint8(6), nil).Maybe()
-	mockAssetStore.On("GetTokenDecimals", uint64(2), "0xEscrowToken").Return(uint8(6), nil).Maybe()
+	homeChannel := core.Channel{
+		ChannelID:         homeChannelID,
+		UserWallet:        userWallet,
+		Asset:             asset,
+		Type:              core.ChannelTypeHome,
+		BlockchainID:      1,
+		TokenAddress:      "0xTokenAddress",
+		ChallengeDuration: 86400,
+		Nonce:             12345,
+		Status:            core.ChannelStatusOpen,
+		StateVersion:      1,
+	}
+
+	mockAssetStore.On("GetAssetDecimals", asset).Return(uint8(6), nil)
+	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil)
+	mockAssetStore.On("GetTokenDecimals", uint64(2), "0xEscrowToken").Return(uint8(6), nil)
 	packedState, _ := core.PackState(*incomingState, mockAssetStore)
 	userSig, _ := userWalletSigner.Sign(packedState)
 	userSigStr := userSig.String()
 	incomingState.UserSig = &userSigStr
 
-	// Create a copy for the unsigned state mock
-	currentUnsignedState := currentSignedState
-
-	// Mock expectations
-	mockAssetStore.On("GetAssetDecimals", asset).Return(uint8(6), nil)
-	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil)
-	mockAssetStore.On("GetTokenDecimals", uint64(2), "0xTokenAddress").Return(uint8(6), nil)
+	mockTxStore.On("LockUserState", userWallet, asset).Return(decimal.Zero, nil)
 	mockTxStore.On("CheckOpenChannel", userWallet, asset).Return("0x03", true, nil)
-	mockTxStore.On("GetLastUserState", userWallet, asset, false).Return(currentUnsignedState, nil)
-	mockTxStore.On("GetLastUserState", userWallet, asset, true).Return(currentSignedState, nil)
+	mockTxStore.On("GetLastUserState", userWallet, asset, false).Return(migrateState, nil)
+	mockTxStore.On("GetLastUserState", userWallet, asset, true).Return(migrateState, nil)
 	mockTxStore.On("EnsureNoOngoingStateTransitions", userWallet, asset).Return(nil)
-
 	mockStatePacker.On("PackState", mock.Anything).Return(packedState, nil)
-
+	mockTxStore.On("GetChannelByID", homeChannelID).Return(&homeChannel, nil)
+	// The escrow channel holds the ledger of the old home blockchain, which releases the channel
+	mockMemoryStore.On("IsAssetSupported", asset, "0xTokenAddress", uint64(1)).Return(true, nil)
+	mockTxStore.On("CreateChannel", mock.MatchedBy(func(channel core.Channel) bool {
+		return channel.ChannelID == *incomingState.EscrowChannelID &&
+			channel.Type == core.ChannelTypeEscrow &&
+			channel.BlockchainID == 1
+	})).Return(nil)
+	mockTxStore.On("UpdateChannel", mock.MatchedBy(func(channel core.Channel) bool {
+		return channel.ChannelID == homeChannelID &&
+			channel.BlockchainID == 2 &&
+			channel.TokenAddress == "0xEscrowToken"
+	})).Return(nil)
+	mockTxStore.On("ScheduleFinalizeMigration", incomingState.ID, uint64(1)).Return(nil)
 	mockTxStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
-		return tx.TxType == core.TransactionTypeEscrowWithdraw &&
-			tx.FromAccount == homeChannelID &&
-			tx.ToAccount == escrowChannelID &&
-			tx.Amount.Equal(withdrawAmount)
+		return tx.TxType == core.TransactionTypeFinalizeMigration &&
+			tx.FromAccount == *incomingState.EscrowChannelID &&
+			tx.ToAccount == homeChannelID &&
+			tx.Amount.Equal(migrateAmount)
 	})).Return(nil)
-
-	// Store incoming state with node signature
 	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
-		return state.UserWallet == userWallet &&
-			state.Version == incomingState.Version &&
-			state.Transition.Type == core.TransitionTypeEscrowWithdraw &&
+		return state.Version == incomingState.Version &&
+			state.Transition.Type == core.TransitionTypeFinalizeMigration &&
+			state.HomeLedger.BlockchainID == 2 &&
 			state.NodeSig != nil
 	})).Return(nil)
 
-	// Create RPC request
-	rpcState := toRPCState(*incomingState)
-	reqPayload := rpc.ChannelsV1SubmitStateRequest{
-		State: rpcState,
-	}
-	payload, err := rpc.NewPayload(reqPayload)
+	payload, err := rpc.NewPayload(rpc.ChannelsV1SubmitStateRequest{State: toRPCState(*incomingState)})
 	require.NoError(t, err)
-
-	rpcRequest := rpc.Message{
-		Method:  "channels.v1.submit_state",
-		Payload: payload,
-	}
-
 	ctx := &rpc.Context{
 		Context: context.Background(),
-		Request: rpcRequest,
+		Request: rpc.Message{
+			Method:  "channels.v1.submit_state",
+			Payload: payload,
+		},
 	}
 
 	// Execute
 	handler.SubmitState(ctx)
 
 	// Assert
-	assert.NotNil(t, ctx.Response.Payload)
-
+	require.NoError(t, ctx.Response.Error())
 	var response rpc.ChannelsV1SubmitStateResponse
-	err = ctx.Response.Payload.Translate(&response)
-	require.NoError(t, err)
-	assert.Nil(t, ctx.Response.Error())
+	require.NoError(t, ctx.Response.Payload.Translate(&response))
 	assert.NotEmpty(t, response.Signature, "Node signature should be present")
-
-	// Verify all mock expectations
 	mockTxStore.AssertExpectations(t)
 }
 
@@ -528,6 +1312,7 @@ func TestSubmitState_HomeDeposit_Success(t *testing.T) {
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
 	}
 
 	// Test data - derive userWallet from a user signer key
@@ -660,6 +1445,7 @@ func TestSubmitState_HomeWithdrawal_Success(t *testing.T) {
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
 	}
 
 	// Test data - derive userWallet from a user signer key
@@ -766,7 +1552,6 @@ func TestSubmitState_HomeWithdrawal_Success(t *testing.T) {
 }
 
 func TestSubmitState_MutualLock_Success(t *testing.T) {
-	t.Skip("transition is not supported yet")
 	// Setup
 	mockTxStore := new(MockStore)
 	mockMemoryStore := new(MockMemoryStore)
@@ -794,6 +1579,7 @@ func TestSubmitState_MutualLock_Success(t *testing.T) {
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
 	}
 
 	// Test data - derive userWallet from a user signer key
@@ -863,8 +1649,10 @@ func TestSubmitState_MutualLock_Success(t *testing.T) {
 	mockAssetStore.On("GetAssetDecimals", asset).Return(uint8(6), nil)
 	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil)
 	mockAssetStore.On("GetTokenDecimals", uint64(2), "0xTokenAddress").Return(uint8(6), nil)
+	mockTxStore.On("LockUserState", userWallet, asset).Return(decimal.Zero, nil)
 	mockTxStore.On("CheckOpenChannel", userWallet, asset).Return("0x03", true, nil)
 	mockTxStore.On("GetLastUserState", userWallet, asset, false).Return(currentState, nil)
+	mockTxStore.On("EnsureNoOngoingStateTransitions", userWallet, asset).Return(nil)
 	mockStatePacker.On("PackState", mock.Anything).Return(packedState, nil)
 	mockTxStore.On("GetChannelByID", homeChannelID).Return(&homeChannel, nil)
 	mockMemoryStore.On("IsAssetSupported", asset, "0xTokenAddress", uint64(2)).Return(true, nil)
@@ -922,7 +1710,6 @@ func TestSubmitState_MutualLock_Success(t *testing.T) {
 }
 
 func TestSubmitState_EscrowDeposit_Success(t *testing.T) {
-	t.Skip("transition is not supported yet")
 	// Setup
 	mockTxStore := new(MockStore)
 	mockMemoryStore := new(MockMemoryStore)
@@ -950,6 +1737,7 @@ func TestSubmitState_EscrowDeposit_Success(t *testing.T) {
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
 	}
 
 	// Test data - derive userWallet from a user signer key
@@ -1021,12 +1809,179 @@ func TestSubmitState_EscrowDeposit_Success(t *testing.T) {
 	mockAssetStore.On("GetAssetDecimals", asset).Return(uint8(6), nil)
 	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil)
 	mockAssetStore.On("GetTokenDecimals", uint64(2), "0xTokenAddress").Return(uint8(6), nil)
+	mockTxStore.On("LockUserState", userWallet, asset).Return(decimal.Zero, nil)
 	mockTxStore.On("CheckOpenChannel", userWallet, asset).Return("0x03", true, nil)
 	mockTxStore.On("GetLastUserState", userWallet, asset, false).Return(currentUnsignedState, nil)
 	mockTxStore.On("GetLastUserState", userWallet, asset, true).Return(currentSignedState, nil)
 	mockTxStore.On("EnsureNoOngoingStateTransitions", userWallet, asset).Return(nil)
 
 	mockStatePacker.On("PackState", mock.Anything).Return(packedState, nil)
+	mockTxStore.On("ScheduleFinalizeEscrowDeposit", incomingState.ID, uint64(2)).Return(nil)
+
+	mockTxStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
+		return tx.TxType == core.TransactionTypeEscrowDeposit &&
+			tx.FromAccount == escrowChannelID &&
+			tx.ToAccount == userWallet &&
+			tx.Amount.Equal(depositAmount)
+	})).Return(nil)
+
+	// Store incoming state with node signature
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == userWallet &&
+			state.Version == incomingState.Version &&
+
+			state.Transition.Type == core.TransitionTypeEscrowDeposit &&
+			state.NodeSig != nil
+	})).Return(nil)
+
+	// Create RPC request
+	rpcState := toRPCState(*incomingState)
+	reqPayload := rpc.ChannelsV1SubmitStateRequest{
+		State: rpcState,
+	}
+	payload, err := rpc.NewPayload(reqPayload)
+	require.NoError(t, err)
+
+	rpcRequest := rpc.Message{
+		Method:  "channels.v1.submit_state",
+		Payload: payload,
+	}
+
+	ctx := &rpc.Context{
+		Context: context.Background(),
+		Request: rpcRequest,
+	}
+
+	// Execute
+	handler.SubmitState(ctx)
+
+	// Assert
+	assert.NotNil(t, ctx.Response.Payload)
+
+	var response rpc.ChannelsV1SubmitStateResponse
+	err = ctx.Response.Payload.Translate(&response)
+	require.NoError(t, err)
+	assert.Nil(t, ctx.Response.Error())
+	assert.NotEmpty(t, response.Signature, "Node signature should be present")
+
+	// Verify all mock expectations
+	mockTxStore.AssertExpectations(t)
+}
+
+func TestSubmitState_EscrowDeposit_ReissuesPendingStates(t *testing.T) {
+	// Setup
+	mockTxStore := new(MockStore)
+	mockMemoryStore := new(MockMemoryStore)
+	mockAssetStore := new(MockAssetStore)
+	mockSigner := NewMockSigner()
+	nodeSigner, _ := core.NewChannelDefaultSigner(mockSigner)
+	nodeAddress := mockSigner.PublicKey().Address().String()
+	minChallenge := uint32(3600)
+	mockStatePacker := new(MockStatePacker)
+
+	handler := &Handler{
+		stateAdvancer: core.NewStateAdvancerV1(mockAssetStore),
+		statePacker:   mockStatePacker,
+		useStoreInTx: func(handler StoreTxHandler) error {
+			err := handler(mockTxStore)
+			if err != nil {
+				return err
+			}
+			return nil
+		},
+		memoryStore:      mockMemoryStore,
+		nodeSigner:       nodeSigner,
+		nodeAddress:      nodeAddress,
+		minChallenge:     minChallenge,
+		metrics:          metrics.NewNoopRuntimeMetricExporter(),
+		maxSessionKeyIDs: 256,
+		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
+	}
+
+	// Test data - derive userWallet from a user signer key
+	userSigner := NewMockSigner()
+	userWalletSigner, _ := core.NewChannelDefaultSigner(userSigner)
+	userWallet := userSigner.PublicKey().Address().String()
+	asset := "USDC"
+	homeChannelID := "0xHomeChannel123"
+	escrowChannelID := "0xEscrowChannel456"
+	depositAmount := decimal.NewFromInt(100)
+
+	// Create user's last signed state (with escrow ledger)
+	// The last transition must be a MutualLock for the EscrowDeposit to be valid
+	currentSignedState := core.State{
+		ID: core.GetStateID(userWallet, asset, 1, 2),
+		Transition: core.Transition{
+
+			Type:      core.TransitionTypeMutualLock,
+			TxID:      "0xPreviousMutualLockTx",
+			AccountID: "",
+			Amount:    depositAmount,
+		},
+		Asset:           asset,
+		UserWallet:      userWallet,
+		Epoch:           1,
+		Version:         2,
+		HomeChannelID:   &homeChannelID,
+		EscrowChannelID: &escrowChannelID,
+		HomeLedger: core.Ledger{
+			TokenAddress: "0xTokenAddress",
+			BlockchainID: 1,
+			UserBalance:  decimal.NewFromInt(400),
+			UserNetFlow:  decimal.NewFromInt(400),
+			NodeBalance:  decimal.NewFromInt(100),
+			NodeNetFlow:  decimal.NewFromInt(100),
+		},
+		EscrowLedger: &core.Ledger{
+			TokenAddress: "0xTokenAddress",
+			BlockchainID: 2,
+			UserBalance:  decimal.NewFromInt(100),
+			UserNetFlow:  decimal.NewFromInt(100),
+			NodeBalance:  decimal.NewFromInt(0),
+			NodeNetFlow:  decimal.NewFromInt(0),
+		},
+		UserSig: stringPtr("0xPreviousUserSig"),
+		NodeSig: stringPtr("0xPreviousNodeSig"),
+	}
+
+	// Create incoming state with escrow deposit transition
+	incomingState := currentSignedState.NextState()
+
+	// Apply the escrow deposit transition to update balances
+	_, err := incomingState.ApplyEscrowDepositTransition(depositAmount)
+	require.NoError(t, err)
+
+	// Sign the incoming state with user's wallet signer (adds 0x01 prefix)
+	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil).Maybe()
+	mockAssetStore.On("GetTokenDecimals", uint64(2), "0xTokenAddress").Return(uint8(6), nil).Maybe()
+	mockAssetStore.On("GetTokenDecimals", uint64(2), "0xEscrowToken").Return(uint8(6), nil).Maybe()
+	packedState, _ := core.PackState(*incomingState, mockAssetStore)
+	userSig, _ := userWalletSigner.Sign(packedState)
+	userSigStr := userSig.String()
+	incomingState.UserSig = &userSigStr
+
+	// A transfer was received while the mutual lock was pending, so the node issued an unsigned state
+	// that skips the version reserved for the escrow deposit
+	receiveAmount := decimal.NewFromInt(25)
+	currentUnsignedState := currentSignedState.NextNodeIssuedState()
+	receiveTransition, err := currentUnsignedState.ApplyTransferReceiveTransition("0xSender", receiveAmount, "0xTransferTx")
+	require.NoError(t, err)
+	require.Equal(t, incomingState.Version+1, currentUnsignedState.Version)
+
+	// Mock expectations
+	mockAssetStore.On("GetAssetDecimals", asset).Return(uint8(6), nil)
+	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil)
+	mockAssetStore.On("GetTokenDecimals", uint64(2), "0xTokenAddress").Return(uint8(6), nil)
+	mockTxStore.On("LockUserState", userWallet, asset).Return(decimal.Zero, nil)
+	mockTxStore.On("CheckOpenChannel", userWallet, asset).Return("0x03", true, nil)
+	mockTxStore.On("GetLastUserState", userWallet, asset, false).Return(*currentUnsignedState, nil)
+	mockTxStore.On("GetUserStatesAfterVersion", userWallet, asset, uint64(1), uint64(2)).Return([]core.State{*currentUnsignedState}, nil)
+	mockTxStore.On("GetLastUserState", userWallet, asset, true).Return(currentSignedState, nil)
+	mockTxStore.On("EnsureNoOngoingStateTransitions", userWallet, asset).Return(nil)
+
+	mockStatePacker.On("PackState", mock.Anything).Return(packedState, nil)
+	mockTxStore.On("ScheduleFinalizeEscrowDeposit", incomingState.ID, uint64(2)).Return(nil)
 
 	mockTxStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
 		return tx.TxType == core.TransactionTypeEscrowDeposit &&
@@ -1044,6 +1999,18 @@ func TestSubmitState_EscrowDeposit_Success(t *testing.T) {
 			state.NodeSig != nil
 	})).Return(nil)
 
+	// Reissue the pending transfer receive on top of the escrow deposit
+	expectedUserBalance := incomingState.HomeLedger.UserBalance.Add(receiveAmount)
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == userWallet &&
+			state.Version == currentUnsignedState.Version+1 &&
+			state.Transition.Type == core.TransitionTypeTransferReceive &&
+			state.Transition.TxID == receiveTransition.TxID &&
+			state.HomeLedger.UserBalance.Equal(expectedUserBalance) &&
+			state.EscrowLedger == nil &&
+			state.NodeSig != nil
+	})).Return(nil)
+
 	// Create RPC request
 	rpcState := toRPCState(*incomingState)
 	reqPayload := rpc.ChannelsV1SubmitStateRequest{
@@ -1106,6 +2073,7 @@ func TestSubmitState_Finalize_Success(t *testing.T) {
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
 	}
 
 	// Test data - derive userWallet from a user signer key
@@ -1246,6 +2214,7 @@ func TestSubmitState_Acknowledgement_Success(t *testing.T) {
 		metrics:          metrics.NewNoopRuntimeMetricExporter(),
 		maxSessionKeyIDs: 256,
 		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
 	}
 
 	// Test data - derive userWallet from a user signer key
@@ -0,0 +1,188 @@
+package channel_v1
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// SubmitSwap processes an atomic swap of two assets between two users.
+// Each user provides a signed swap_send state for the asset they give. The account ID of both transitions
+// is the swap ID derived from both legs and the agreed nonce, so each signature covers the terms of the whole swap.
+// Within a single store transaction it validates and co-signs both swap_send states, issues the
+// swap_receive states for the opposite assets and records the transactions of both legs.
+// Either all four states are stored or none of them.
+func (h *Handler) SubmitSwap(c *rpc.Context) {
+	ctx := c.Context
+	logger := log.FromContext(ctx)
+
+	var reqPayload rpc.ChannelsV1SubmitSwapRequest
+	if err := c.Request.Payload.Translate(&reqPayload); err != nil {
+		c.Fail(err, "failed to parse parameters")
+		return
+	}
+
+	state, err := toCoreState(reqPayload.State)
+	if err != nil {
+		c.Fail(err, "failed to parse state")
+		return
+	}
+	counterpartyState, err := toCoreState(reqPayload.CounterpartyState)
+	if err != nil {
+		c.Fail(err, "failed to parse counterparty state")
+		return
+	}
+
+	nonce, err := strconv.ParseUint(reqPayload.Nonce, 10, 64)
+	if err != nil {
+		c.Fail(err, "invalid nonce")
+		return
+	}
+
+	swapID, err := validateSwapStates(state, counterpartyState, nonce)
+	if err != nil {
+		c.Fail(err, "invalid swap")
+		return
+	}
+
+	var receiverStates []core.State
+	err = h.useStoreInTx(func(tx Store) error {
+		// Lock all four affected user states in a deterministic order to avoid deadlocks
+		// between swaps submitted concurrently by the same pair of users.
+		type userAsset struct{ wallet, asset string }
+		locks := []userAsset{
+			{state.UserWallet, state.Asset},
+			{state.UserWallet, counterpartyState.Asset},
+			{counterpartyState.UserWallet, state.Asset},
+			{counterpartyState.UserWallet, counterpartyState.Asset},
+		}
+		slices.SortFunc(locks, func(a, b userAsset) int {
+			if c := strings.Compare(strings.ToLower(a.wallet), strings.ToLower(b.wallet)); c != 0 {
+				return c
+			}
+			return strings.Compare(a.asset, b.asset)
+		})
+		for _, l := range locks {
+			if _, err := tx.LockUserState(l.wallet, l.asset); err != nil {
+				return rpc.Errorf("failed to lock user state: %v", err)
+			}
+		}
+
+		for _, sendState := range []*core.State{&state, &counterpartyState} {
+			if err := h.acceptUserSignedState(tx, sendState); err != nil {
+				return err
+			}
+		}
+
+		// Each leg credits the user giving the opposite asset
+		legs := []struct {
+			sendState      core.State
+			receiverWallet string
+		}{
+			{state, counterpartyState.UserWallet},
+			{counterpartyState, state.UserWallet},
+		}
+		for _, leg := range legs {
+			sendState := leg.sendState
+			receiverState, err := h.issueReceiverState(ctx, tx, sendState, leg.receiverWallet, core.TransitionTypeSwapReceive, false)
+			if err != nil {
+				return rpc.Errorf("failed to issue receiver state: %v", err)
+			}
+			receiverStates = append(receiverStates, *receiverState)
+
+			transaction, err := core.NewTransactionFromTransition(&sendState, receiverState, sendState.Transition)
+			if err != nil {
+				return rpc.Errorf("failed to create transaction: %v", err)
+			}
+			if err := tx.RecordTransaction(*transaction); err != nil {
+				return rpc.Errorf("failed to record transaction")
+			}
+
+			logger.Info("recorded transaction",
+				"txID", transaction.ID,
+				"txType", transaction.TxType.String(),
+				"from", transaction.FromAccount,
+				"to", transaction.ToAccount,
+				"asset", transaction.Asset,
+				"amount", transaction.Amount.String())
+
+			if err := tx.StoreUserState(sendState); err != nil {
+				return rpc.Errorf("failed to store user state: %v", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.Error("failed to process swap", "error", err)
+		c.Fail(err, "failed to process swap")
+		return
+	}
+
+	resp := rpc.ChannelsV1SubmitSwapResponse{
+		Signature:             *state.NodeSig,
+		CounterpartySignature: *counterpartyState.NodeSig,
+		SwapID:                swapID,
+	}
+	payload, err := rpc.NewPayload(resp)
+	if err != nil {
+		c.Fail(err, "failed to create response")
+		return
+	}
+
+	c.Succeed(c.Request.Method, payload)
+
+	for _, receiverState := range receiverStates {
+		h.eventPublisher.PublishTransferReceived(ctx, receiverState)
+	}
+
+	logger.Info("processed swap",
+		"swapID", swapID,
+		"userWallet", state.UserWallet,
+		"asset", state.Asset,
+		"amount", state.Transition.Amount.String(),
+		"counterpartyWallet", counterpartyState.UserWallet,
+		"counterpartyAsset", counterpartyState.Asset,
+		"counterpartyAmount", counterpartyState.Transition.Amount.String())
+}
+
+// validateSwapStates checks that the two states form the opposite legs of the swap with the given nonce
+// and returns the swap ID. Both transitions must carry the swap ID derived from the two legs,
+// so that neither user can be paired with a leg other than the one they agreed to.
+func validateSwapStates(state, counterpartyState core.State, nonce uint64) (string, error) {
+	if state.Transition.Type != core.TransitionTypeSwapSend {
+		return "", rpc.Errorf("state must have a 'swap_send' transition, got '%s'", state.Transition.Type.String())
+	}
+	if counterpartyState.Transition.Type != core.TransitionTypeSwapSend {
+		return "", rpc.Errorf("counterparty state must have a 'swap_send' transition, got '%s'", counterpartyState.Transition.Type.String())
+	}
+	if strings.EqualFold(state.UserWallet, counterpartyState.UserWallet) {
+		return "", rpc.Errorf("swap counterparties must be different wallets")
+	}
+	if state.Asset == counterpartyState.Asset {
+		return "", rpc.Errorf("swap assets must be different")
+	}
+	if !state.Transition.Amount.IsPositive() || !counterpartyState.Transition.Amount.IsPositive() {
+		return "", rpc.Errorf("swap amounts must be positive")
+	}
+
+	swapID, err := core.GetSwapID(
+		core.SwapLeg{Wallet: state.UserWallet, Asset: state.Asset, Amount: state.Transition.Amount},
+		core.SwapLeg{Wallet: counterpartyState.UserWallet, Asset: counterpartyState.Asset, Amount: counterpartyState.Transition.Amount},
+		nonce,
+	)
+	if err != nil {
+		return "", rpc.Errorf("failed to derive swap ID: %v", err)
+	}
+	if !strings.EqualFold(state.Transition.AccountID, swapID) {
+		return "", rpc.Errorf("swap ID of the state does not match the swap terms")
+	}
+	if !strings.EqualFold(counterpartyState.Transition.AccountID, swapID) {
+		return "", rpc.Errorf("swap ID of the counterparty state does not match the swap terms")
+	}
+	return swapID, nil
+}
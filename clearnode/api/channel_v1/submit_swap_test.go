@@ -0,0 +1,395 @@
+package channel_v1
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/clearnode/metrics"
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+const testSwapNonce uint64 = 7
+
+func newSwapTestHandler(mockTxStore *MockStore, mockAssetStore *MockAssetStore, mockStatePacker *MockStatePacker, mockNotifier *MockNotifier) *Handler {
+	mockSigner := NewMockSigner()
+	nodeSigner, _ := core.NewChannelDefaultSigner(mockSigner)
+
+	return &Handler{
+		stateAdvancer: core.NewStateAdvancerV1(mockAssetStore),
+		statePacker:   mockStatePacker,
+		useStoreInTx: func(handler StoreTxHandler) error {
+			return handler(mockTxStore)
+		},
+		memoryStore:      new(MockMemoryStore),
+		nodeSigner:       nodeSigner,
+		nodeAddress:      mockSigner.PublicKey().Address().String(),
+		minChallenge:     3600,
+		metrics:          metrics.NewNoopRuntimeMetricExporter(),
+		notifier:         mockNotifier,
+		eventPublisher:   NewEventPublisher(mockNotifier),
+		maxSessionKeyIDs: 256,
+		actionGateway:    &MockActionGateway{},
+		feeEngine:        &MockFeeEngine{},
+	}
+}
+
+func newSwapTestState(wallet, asset, homeChannelID string, balance int64) core.State {
+	return core.State{
+		ID:            core.GetStateID(wallet, asset, 1, 1),
+		Asset:         asset,
+		UserWallet:    wallet,
+		Epoch:         1,
+		Version:       1,
+		HomeChannelID: &homeChannelID,
+		HomeLedger: core.Ledger{
+			TokenAddress: "0xTokenAddress",
+			BlockchainID: 1,
+			UserBalance:  decimal.NewFromInt(balance),
+			UserNetFlow:  decimal.NewFromInt(balance),
+			NodeBalance:  decimal.Zero,
+			NodeNetFlow:  decimal.Zero,
+		},
+	}
+}
+
+func getTestSwapID(t *testing.T, wallet, asset string, amount decimal.Decimal, counterpartyWallet, counterpartyAsset string, counterpartyAmount decimal.Decimal, nonce uint64) string {
+	swapID, err := core.GetSwapID(
+		core.SwapLeg{Wallet: wallet, Asset: asset, Amount: amount},
+		core.SwapLeg{Wallet: counterpartyWallet, Asset: counterpartyAsset, Amount: counterpartyAmount},
+		nonce,
+	)
+	require.NoError(t, err)
+	return swapID
+}
+
+func submitSwapRequest(t *testing.T, handler *Handler, state, counterpartyState core.State) *rpc.Context {
+	payload, err := rpc.NewPayload(rpc.ChannelsV1SubmitSwapRequest{
+		State:             toRPCState(state),
+		CounterpartyState: toRPCState(counterpartyState),
+		Nonce:             strconv.FormatUint(testSwapNonce, 10),
+	})
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context: context.Background(),
+		Request: rpc.Message{
+			Method:  rpc.ChannelsV1SubmitSwapMethod.String(),
+			Payload: payload,
+		},
+	}
+	handler.SubmitSwap(ctx)
+	return ctx
+}
+
+func TestSubmitSwap_Success(t *testing.T) {
+	mockTxStore := new(MockStore)
+	mockAssetStore := new(MockAssetStore)
+	mockStatePacker := new(MockStatePacker)
+	mockNotifier := new(MockNotifier)
+	handler := newSwapTestHandler(mockTxStore, mockAssetStore, mockStatePacker, mockNotifier)
+
+	aliceSigner := NewMockSigner()
+	aliceChannelSigner, _ := core.NewChannelDefaultSigner(aliceSigner)
+	aliceWallet := aliceSigner.PublicKey().Address().String()
+	bobSigner := NewMockSigner()
+	bobChannelSigner, _ := core.NewChannelDefaultSigner(bobSigner)
+	bobWallet := bobSigner.PublicKey().Address().String()
+
+	usdcAmount := decimal.NewFromInt(100)
+	ethAmount := decimal.NewFromInt(2)
+
+	aliceUSDCState := newSwapTestState(aliceWallet, "USDC", "0xAliceUSDCChannel", 500)
+	aliceETHState := newSwapTestState(aliceWallet, "ETH", "0xAliceETHChannel", 1)
+	bobUSDCState := newSwapTestState(bobWallet, "USDC", "0xBobUSDCChannel", 0)
+	bobETHState := newSwapTestState(bobWallet, "ETH", "0xBobETHChannel", 10)
+
+	mockAssetStore.On("GetAssetDecimals", mock.Anything).Return(uint8(6), nil)
+	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil)
+
+	swapID := getTestSwapID(t, aliceWallet, "USDC", usdcAmount, bobWallet, "ETH", ethAmount, testSwapNonce)
+
+	// Alice gives USDC
+	aliceSendState := aliceUSDCState.NextState()
+	aliceTransition, err := aliceSendState.ApplySwapSendTransition(swapID, usdcAmount)
+	require.NoError(t, err)
+	alicePacked, err := core.PackState(*aliceSendState, mockAssetStore)
+	require.NoError(t, err)
+	aliceSig, err := aliceChannelSigner.Sign(alicePacked)
+	require.NoError(t, err)
+	aliceSigStr := aliceSig.String()
+	aliceSendState.UserSig = &aliceSigStr
+
+	// Bob gives ETH
+	bobSendState := bobETHState.NextState()
+	bobTransition, err := bobSendState.ApplySwapSendTransition(swapID, ethAmount)
+	require.NoError(t, err)
+	bobPacked, err := core.PackState(*bobSendState, mockAssetStore)
+	require.NoError(t, err)
+	bobSig, err := bobChannelSigner.Sign(bobPacked)
+	require.NoError(t, err)
+	bobSigStr := bobSig.String()
+	bobSendState.UserSig = &bobSigStr
+
+	mockStatePacker.On("PackState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == aliceWallet && state.Asset == "USDC"
+	})).Return(alicePacked, nil)
+	mockStatePacker.On("PackState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == bobWallet && state.Asset == "ETH"
+	})).Return(bobPacked, nil)
+	mockStatePacker.On("PackState", mock.Anything).Return([]byte("receiver"), nil)
+
+	for _, wallet := range []string{aliceWallet, bobWallet} {
+		for _, asset := range []string{"USDC", "ETH"} {
+			mockTxStore.On("LockUserState", wallet, asset).Return(decimal.Zero, nil).Once()
+			mockTxStore.On("GetLastUserState", wallet, asset, true).Return(nil, nil).Maybe()
+		}
+	}
+	// The receiver states are locked again when the swap_receive states are issued
+	mockTxStore.On("LockUserState", aliceWallet, "ETH").Return(decimal.Zero, nil).Once()
+	mockTxStore.On("LockUserState", bobWallet, "USDC").Return(decimal.Zero, nil).Once()
+	mockTxStore.On("CheckOpenChannel", aliceWallet, "USDC").Return("0x03", true, nil)
+	mockTxStore.On("CheckOpenChannel", bobWallet, "ETH").Return("0x03", true, nil)
+	mockTxStore.On("EnsureNoOngoingStateTransitions", aliceWallet, "USDC").Return(nil)
+	mockTxStore.On("EnsureNoOngoingStateTransitions", bobWallet, "ETH").Return(nil)
+	mockTxStore.On("GetLastUserState", aliceWallet, "USDC", false).Return(aliceUSDCState, nil)
+	mockTxStore.On("GetLastUserState", aliceWallet, "ETH", false).Return(aliceETHState, nil)
+	mockTxStore.On("GetLastUserState", bobWallet, "USDC", false).Return(bobUSDCState, nil)
+	mockTxStore.On("GetLastUserState", bobWallet, "ETH", false).Return(bobETHState, nil)
+
+	// Swap send states of both users
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == aliceWallet && state.Asset == "USDC" &&
+			state.Transition.Type == core.TransitionTypeSwapSend &&
+			state.HomeLedger.UserBalance.Equal(decimal.NewFromInt(400)) &&
+			state.NodeSig != nil
+	})).Return(nil).Once()
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == bobWallet && state.Asset == "ETH" &&
+			state.Transition.Type == core.TransitionTypeSwapSend &&
+			state.HomeLedger.UserBalance.Equal(decimal.NewFromInt(8)) &&
+			state.NodeSig != nil
+	})).Return(nil).Once()
+
+	// Swap receive states of both users
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == bobWallet && state.Asset == "USDC" &&
+			state.Transition.Type == core.TransitionTypeSwapReceive &&
+			state.Transition.TxID == aliceTransition.TxID &&
+			state.HomeLedger.UserBalance.Equal(usdcAmount) &&
+			state.NodeSig != nil
+	})).Return(nil).Once()
+	mockTxStore.On("StoreUserState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == aliceWallet && state.Asset == "ETH" &&
+			state.Transition.Type == core.TransitionTypeSwapReceive &&
+			state.Transition.TxID == bobTransition.TxID &&
+			state.HomeLedger.UserBalance.Equal(decimal.NewFromInt(3)) &&
+			state.NodeSig != nil
+	})).Return(nil).Once()
+
+	// Transactions of both legs
+	mockTxStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
+		return tx.TxType == core.TransactionTypeSwap && tx.Asset == "USDC" &&
+			tx.FromAccount == aliceWallet && tx.ToAccount == bobWallet &&
+			tx.Amount.Equal(usdcAmount)
+	})).Return(nil).Once()
+	mockTxStore.On("RecordTransaction", mock.MatchedBy(func(tx core.Transaction) bool {
+		return tx.TxType == core.TransactionTypeSwap && tx.Asset == "ETH" &&
+			tx.FromAccount == bobWallet && tx.ToAccount == aliceWallet &&
+			tx.Amount.Equal(ethAmount)
+	})).Return(nil).Once()
+
+	mockNotifier.On("Notify", bobWallet, rpc.ChannelsV1TransferReceivedEventName, mock.Anything).Return().Once()
+	mockNotifier.On("Notify", aliceWallet, rpc.ChannelsV1TransferReceivedEventName, mock.Anything).Return().Once()
+
+	ctx := submitSwapRequest(t, handler, *aliceSendState, *bobSendState)
+
+	require.Nil(t, ctx.Response.Error())
+	var response rpc.ChannelsV1SubmitSwapResponse
+	require.NoError(t, ctx.Response.Payload.Translate(&response))
+	assert.NotEmpty(t, response.Signature)
+	assert.NotEmpty(t, response.CounterpartySignature)
+	assert.Equal(t, swapID, response.SwapID)
+
+	mockTxStore.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestSubmitSwap_InvalidCounterpartySignature(t *testing.T) {
+	mockTxStore := new(MockStore)
+	mockAssetStore := new(MockAssetStore)
+	mockStatePacker := new(MockStatePacker)
+	mockNotifier := new(MockNotifier)
+	handler := newSwapTestHandler(mockTxStore, mockAssetStore, mockStatePacker, mockNotifier)
+
+	aliceSigner := NewMockSigner()
+	aliceChannelSigner, _ := core.NewChannelDefaultSigner(aliceSigner)
+	aliceWallet := aliceSigner.PublicKey().Address().String()
+	bobWallet := NewMockSigner().PublicKey().Address().String()
+
+	aliceUSDCState := newSwapTestState(aliceWallet, "USDC", "0xAliceUSDCChannel", 500)
+	bobETHState := newSwapTestState(bobWallet, "ETH", "0xBobETHChannel", 10)
+
+	mockAssetStore.On("GetAssetDecimals", mock.Anything).Return(uint8(6), nil)
+	mockAssetStore.On("GetTokenDecimals", uint64(1), "0xTokenAddress").Return(uint8(6), nil)
+
+	swapID := getTestSwapID(t, aliceWallet, "USDC", decimal.NewFromInt(100), bobWallet, "ETH", decimal.NewFromInt(2), testSwapNonce)
+
+	aliceSendState := aliceUSDCState.NextState()
+	_, err := aliceSendState.ApplySwapSendTransition(swapID, decimal.NewFromInt(100))
+	require.NoError(t, err)
+	alicePacked, err := core.PackState(*aliceSendState, mockAssetStore)
+	require.NoError(t, err)
+	aliceSig, err := aliceChannelSigner.Sign(alicePacked)
+	require.NoError(t, err)
+	aliceSigStr := aliceSig.String()
+	aliceSendState.UserSig = &aliceSigStr
+
+	// Bob's state is signed by Alice instead of Bob
+	bobSendState := bobETHState.NextState()
+	_, err = bobSendState.ApplySwapSendTransition(swapID, decimal.NewFromInt(2))
+	require.NoError(t, err)
+	bobPacked, err := core.PackState(*bobSendState, mockAssetStore)
+	require.NoError(t, err)
+	forgedSig, err := aliceChannelSigner.Sign(bobPacked)
+	require.NoError(t, err)
+	forgedSigStr := forgedSig.String()
+	bobSendState.UserSig = &forgedSigStr
+
+	mockStatePacker.On("PackState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == aliceWallet
+	})).Return(alicePacked, nil)
+	mockStatePacker.On("PackState", mock.MatchedBy(func(state core.State) bool {
+		return state.UserWallet == bobWallet
+	})).Return(bobPacked, nil)
+
+	mockTxStore.On("LockUserState", mock.Anything, mock.Anything).Return(decimal.Zero, nil)
+	mockTxStore.On("CheckOpenChannel", mock.Anything, mock.Anything).Return("0x03", true, nil)
+	mockTxStore.On("EnsureNoOngoingStateTransitions", mock.Anything, mock.Anything).Return(nil)
+	mockTxStore.On("GetLastUserState", aliceWallet, "USDC", false).Return(aliceUSDCState, nil)
+	mockTxStore.On("GetLastUserState", bobWallet, "ETH", false).Return(bobETHState, nil)
+
+	ctx := submitSwapRequest(t, handler, *aliceSendState, *bobSendState)
+
+	require.NotNil(t, ctx.Response.Error())
+	assert.Contains(t, ctx.Response.Error().Error(), "invalid incoming state user signature")
+
+	mockTxStore.AssertNotCalled(t, "StoreUserState", mock.Anything)
+	mockTxStore.AssertNotCalled(t, "RecordTransaction", mock.Anything)
+	mockNotifier.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSubmitSwap_InvalidLegs(t *testing.T) {
+	aliceWallet := "0x1111111111111111111111111111111111111111"
+	bobWallet := "0x2222222222222222222222222222222222222222"
+	carolWallet := "0x3333333333333333333333333333333333333333"
+
+	// Alice agreed to give 100 USDC to Bob for 2 ETH
+	agreedSwapID := getTestSwapID(t, aliceWallet, "USDC", decimal.NewFromInt(100), bobWallet, "ETH", decimal.NewFromInt(2), testSwapNonce)
+
+	newLeg := func(wallet, asset string, amount int64, swapID string) core.State {
+		state := newSwapTestState(wallet, asset, "0xChannel", 100).NextState()
+		_, err := state.ApplySwapSendTransition(swapID, decimal.NewFromInt(amount))
+		require.NoError(t, err)
+		return *state
+	}
+	transferLeg := func(wallet, asset, counterparty string) core.State {
+		state := newSwapTestState(wallet, asset, "0xChannel", 100).NextState()
+		_, err := state.ApplyTransferSendTransition(counterparty, decimal.NewFromInt(1))
+		require.NoError(t, err)
+		return *state
+	}
+
+	tests := []struct {
+		name              string
+		state             core.State
+		counterpartyState core.State
+		expectedErr       string
+	}{
+		{
+			name:              "not a swap transition",
+			state:             transferLeg(aliceWallet, "USDC", bobWallet),
+			counterpartyState: newLeg(bobWallet, "ETH", 2, agreedSwapID),
+			expectedErr:       "state must have a 'swap_send' transition",
+		},
+		{
+			name:              "same wallet",
+			state:             newLeg(aliceWallet, "USDC", 100, agreedSwapID),
+			counterpartyState: newLeg(aliceWallet, "ETH", 2, agreedSwapID),
+			expectedErr:       "swap counterparties must be different wallets",
+		},
+		{
+			name:              "same asset",
+			state:             newLeg(aliceWallet, "USDC", 100, agreedSwapID),
+			counterpartyState: newLeg(bobWallet, "USDC", 2, agreedSwapID),
+			expectedErr:       "swap assets must be different",
+		},
+		{
+			name:              "zero amount",
+			state:             newLeg(aliceWallet, "USDC", 0, agreedSwapID),
+			counterpartyState: newLeg(bobWallet, "ETH", 2, agreedSwapID),
+			expectedErr:       "swap amounts must be positive",
+		},
+		{
+			name:  "counterparty gives less than agreed",
+			state: newLeg(aliceWallet, "USDC", 100, agreedSwapID),
+			counterpartyState: newLeg(bobWallet, "ETH", 1,
+				getTestSwapID(t, aliceWallet, "USDC", decimal.NewFromInt(100), bobWallet, "ETH", decimal.NewFromInt(1), testSwapNonce)),
+			expectedErr: "swap ID of the state does not match the swap terms",
+		},
+		{
+			name:              "counterparty gives less than agreed under the agreed swap ID",
+			state:             newLeg(aliceWallet, "USDC", 100, agreedSwapID),
+			counterpartyState: newLeg(bobWallet, "ETH", 1, agreedSwapID),
+			expectedErr:       "swap ID of the state does not match the swap terms",
+		},
+		{
+			name: "leg agreed with another counterparty",
+			state: newLeg(aliceWallet, "USDC", 100,
+				getTestSwapID(t, aliceWallet, "USDC", decimal.NewFromInt(100), carolWallet, "ETH", decimal.NewFromInt(2), testSwapNonce)),
+			counterpartyState: newLeg(bobWallet, "ETH", 2, agreedSwapID),
+			expectedErr:       "swap ID of the state does not match the swap terms",
+		},
+		{
+			name:  "counterparty leg of another swap",
+			state: newLeg(aliceWallet, "USDC", 100, agreedSwapID),
+			counterpartyState: newLeg(bobWallet, "ETH", 2,
+				getTestSwapID(t, aliceWallet, "USDC", decimal.NewFromInt(100), bobWallet, "ETH", decimal.NewFromInt(2), testSwapNonce+1)),
+			expectedErr: "swap ID of the counterparty state does not match the swap terms",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTxStore := new(MockStore)
+			handler := newSwapTestHandler(mockTxStore, new(MockAssetStore), new(MockStatePacker), new(MockNotifier))
+
+			ctx := submitSwapRequest(t, handler, tt.state, tt.counterpartyState)
+
+			require.NotNil(t, ctx.Response.Error())
+			assert.Contains(t, ctx.Response.Error().Error(), tt.expectedErr)
+			mockTxStore.AssertNotCalled(t, "LockUserState", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestIssueReceiverState_TransitionMismatch(t *testing.T) {
+	mockTxStore := new(MockStore)
+	handler := newSwapTestHandler(mockTxStore, new(MockAssetStore), new(MockStatePacker), new(MockNotifier))
+
+	senderState := newSwapTestState("0xSender", "USDC", "0xSenderChannel", 10).NextState()
+	_, err := senderState.ApplyTransferSendTransition("0xReceiver", decimal.NewFromInt(1))
+	require.NoError(t, err)
+
+	// A transfer cannot be credited to the receiver as a swap leg
+	_, err = handler.issueReceiverState(context.Background(), mockTxStore, *senderState, "0xReceiver", core.TransitionTypeSwapReceive, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be received as 'swap_receive'")
+	mockTxStore.AssertNotCalled(t, "LockUserState", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,41 @@
+package channel_v1
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// Subscribe registers the requesting connection to receive channel events of a user:
+// channel status updates, incoming transfers and completed blockchain actions.
+func (h *Handler) Subscribe(c *rpc.Context) {
+	ctx := c.Context
+	logger := log.FromContext(ctx)
+
+	var reqPayload rpc.ChannelsV1SubscribeRequest
+	if err := c.Request.Payload.Translate(&reqPayload); err != nil {
+		c.Fail(err, "failed to parse parameters")
+		return
+	}
+
+	if !common.IsHexAddress(reqPayload.Wallet) {
+		c.Fail(rpc.Errorf("invalid wallet address"), "")
+		return
+	}
+
+	if err := h.notifier.Subscribe(c.ConnectionID, rpc.ChannelV1Group, reqPayload.Wallet); err != nil {
+		logger.Error("failed to subscribe to channel events", "error", err, "wallet", reqPayload.Wallet)
+		c.Fail(err, "failed to subscribe to channel events")
+		return
+	}
+
+	payload, err := rpc.NewPayload(rpc.ChannelsV1SubscribeResponse{})
+	if err != nil {
+		c.Fail(err, "failed to create response")
+		return
+	}
+
+	c.Succeed(c.Request.Method, payload)
+	logger.Debug("subscribed to channel events", "wallet", reqPayload.Wallet)
+}
@@ -0,0 +1,105 @@
+package channel_v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+func TestSubscribe_Success(t *testing.T) {
+	mockNotifier := new(MockNotifier)
+	handler := &Handler{notifier: mockNotifier}
+
+	wallet := "0x1234567890123456789012345678901234567890"
+	mockNotifier.On("Subscribe", "conn1", rpc.ChannelV1Group, wallet).Return(nil)
+
+	payload, err := rpc.NewPayload(rpc.ChannelsV1SubscribeRequest{Wallet: wallet})
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context:      context.Background(),
+		ConnectionID: "conn1",
+		Request:      rpc.Message{Method: rpc.ChannelsV1SubscribeMethod.String(), Payload: payload},
+	}
+
+	handler.Subscribe(ctx)
+
+	assert.Nil(t, ctx.Response.Error())
+	assert.Equal(t, rpc.MsgTypeResp, ctx.Response.Type)
+
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestSubscribe_InvalidWallet(t *testing.T) {
+	mockNotifier := new(MockNotifier)
+	handler := &Handler{notifier: mockNotifier}
+
+	payload, err := rpc.NewPayload(rpc.ChannelsV1SubscribeRequest{Wallet: "garbage"})
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context:      context.Background(),
+		ConnectionID: "conn1",
+		Request:      rpc.Message{Method: rpc.ChannelsV1SubscribeMethod.String(), Payload: payload},
+	}
+
+	handler.Subscribe(ctx)
+
+	require.NotNil(t, ctx.Response.Error())
+	assert.Contains(t, ctx.Response.Error().Error(), "invalid wallet address")
+
+	mockNotifier.AssertNotCalled(t, "Subscribe")
+}
+
+func TestSubscribe_NotifierError(t *testing.T) {
+	mockNotifier := new(MockNotifier)
+	handler := &Handler{notifier: mockNotifier}
+
+	wallet := "0x1234567890123456789012345678901234567890"
+	mockNotifier.On("Subscribe", "conn1", rpc.ChannelV1Group, wallet).Return(errors.New("connection with ID conn1 does not exist"))
+
+	payload, err := rpc.NewPayload(rpc.ChannelsV1SubscribeRequest{Wallet: wallet})
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context:      context.Background(),
+		ConnectionID: "conn1",
+		Request:      rpc.Message{Method: rpc.ChannelsV1SubscribeMethod.String(), Payload: payload},
+	}
+
+	handler.Subscribe(ctx)
+
+	require.NotNil(t, ctx.Response.Error())
+	assert.Equal(t, rpc.MsgTypeRespErr, ctx.Response.Type)
+
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestUnsubscribe_Success(t *testing.T) {
+	mockNotifier := new(MockNotifier)
+	handler := &Handler{notifier: mockNotifier}
+
+	wallet := "0x1234567890123456789012345678901234567890"
+	mockNotifier.On("Unsubscribe", "conn1", rpc.ChannelV1Group, wallet).Return()
+
+	payload, err := rpc.NewPayload(rpc.ChannelsV1UnsubscribeRequest{Wallet: wallet})
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context:      context.Background(),
+		ConnectionID: "conn1",
+		Request:      rpc.Message{Method: rpc.ChannelsV1UnsubscribeMethod.String(), Payload: payload},
+	}
+
+	handler.Unsubscribe(ctx)
+
+	assert.Nil(t, ctx.Response.Error())
+	assert.Equal(t, rpc.MsgTypeResp, ctx.Response.Type)
+
+	mockNotifier.AssertExpectations(t)
+}
@@ -1,6 +1,7 @@
 package channel_v1
 
 import (
+	"context"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/layer-3/nitrolite/clearnode/action_gateway"
 	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
 	"github.com/layer-3/nitrolite/pkg/sign"
 )
 
@@ -47,6 +49,14 @@ func (m *MockStore) StoreUserState(state core.State) error {
 	return args.Error(0)
 }
 
+func (m *MockStore) GetUserStatesAfterVersion(wallet, asset string, epoch, version uint64) ([]core.State, error) {
+	args := m.Called(wallet, asset, epoch, version)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]core.State), args.Error(1)
+}
+
 func (m *MockStore) EnsureNoOngoingStateTransitions(wallet, asset string) error {
 	args := m.Called(wallet, asset)
 	return args.Error(0)
@@ -57,6 +67,26 @@ func (m *MockStore) ScheduleInitiateEscrowWithdrawal(stateID string, chainID uin
 	return args.Error(0)
 }
 
+func (m *MockStore) ScheduleFinalizeEscrowDeposit(stateID string, chainID uint64) error {
+	args := m.Called(stateID, chainID)
+	return args.Error(0)
+}
+
+func (m *MockStore) ScheduleInitiateMigration(stateID string, chainID uint64) error {
+	args := m.Called(stateID, chainID)
+	return args.Error(0)
+}
+
+func (m *MockStore) GetPendingNodeLocks(blockchainID uint64, tokenAddress string) (decimal.Decimal, error) {
+	args := m.Called(blockchainID, tokenAddress)
+	return args.Get(0).(decimal.Decimal), args.Error(1)
+}
+
+func (m *MockStore) ScheduleFinalizeMigration(stateID string, chainID uint64) error {
+	args := m.Called(stateID, chainID)
+	return args.Error(0)
+}
+
 func (m *MockStore) RecordTransaction(tx core.Transaction) error {
 	args := m.Called(tx)
 	return args.Error(0)
@@ -75,6 +105,11 @@ func (m *MockStore) GetChannelByID(channelID string) (*core.Channel, error) {
 	return args.Get(0).(*core.Channel), args.Error(1)
 }
 
+func (m *MockStore) UpdateChannel(channel core.Channel) error {
+	args := m.Called(channel)
+	return args.Error(0)
+}
+
 func (m *MockStore) GetActiveHomeChannel(wallet, asset string) (*core.Channel, error) {
 	args := m.Called(wallet, asset)
 	if args.Get(0) == nil {
@@ -91,6 +126,55 @@ func (m *MockStore) GetUserChannels(wallet string, status *core.ChannelStatus, a
 	return args.Get(0).([]core.Channel), args.Get(1).(uint32), args.Error(2)
 }
 
+func (m *MockStore) CreateConditionalTransfer(transfer core.ConditionalTransfer) error {
+	args := m.Called(transfer)
+	return args.Error(0)
+}
+
+func (m *MockStore) LockConditionalTransfer(id string) (*core.ConditionalTransfer, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	transfer := args.Get(0).(core.ConditionalTransfer)
+	return &transfer, args.Error(1)
+}
+
+func (m *MockStore) UpdateConditionalTransfer(transfer core.ConditionalTransfer) error {
+	args := m.Called(transfer)
+	return args.Error(0)
+}
+
+func (m *MockStore) GetExpiredConditionalTransfers(now time.Time, limit uint32) ([]core.ConditionalTransfer, error) {
+	args := m.Called(now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]core.ConditionalTransfer), args.Error(1)
+}
+
+func (m *MockStore) LockPaymentStream(id string) (*core.PaymentStream, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	stream := args.Get(0).(core.PaymentStream)
+	return &stream, args.Error(1)
+}
+
+func (m *MockStore) UpdatePaymentStream(stream core.PaymentStream) error {
+	args := m.Called(stream)
+	return args.Error(0)
+}
+
+func (m *MockStore) GetDuePaymentStreams(limit uint32) ([]core.PaymentStream, error) {
+	args := m.Called(limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]core.PaymentStream), args.Error(1)
+}
+
 func (m *MockStore) StoreChannelSessionKeyState(state core.ChannelSessionKeyStateV1) error {
 	args := m.Called(state)
 	return args.Error(0)
@@ -210,3 +294,44 @@ type MockActionGateway struct {
 func (m *MockActionGateway) AllowAction(_ action_gateway.Store, _ string, _ core.GatedAction) error {
 	return m.Err
 }
+
+// MockFeeEngine charges the configured fee per gated action, regardless of asset and amount.
+type MockFeeEngine struct {
+	Fees    map[core.GatedAction]decimal.Decimal
+	Account string
+}
+
+func (m *MockFeeEngine) CalculateFee(_ string, action core.GatedAction, _ decimal.Decimal) decimal.Decimal {
+	return m.Fees[action]
+}
+
+func (m *MockFeeEngine) FeeAccount() string {
+	return m.Account
+}
+
+// MockNodeBalances reports the configured node balance per blockchain, regardless of token.
+type MockNodeBalances struct {
+	Balances map[uint64]decimal.Decimal
+}
+
+func (m *MockNodeBalances) GetNodeBalance(_ context.Context, blockchainID uint64, _ string) (decimal.Decimal, error) {
+	return m.Balances[blockchainID], nil
+}
+
+// MockNotifier is a mock implementation of the Notifier interface
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) Subscribe(connID string, group rpc.Group, userID string) error {
+	args := m.Called(connID, group, userID)
+	return args.Error(0)
+}
+
+func (m *MockNotifier) Unsubscribe(connID string, group rpc.Group, userID string) {
+	m.Called(connID, group, userID)
+}
+
+func (m *MockNotifier) Notify(userID string, event rpc.Event, params rpc.Payload) {
+	m.Called(userID, event, params)
+}
@@ -0,0 +1,36 @@
+package channel_v1
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// Unsubscribe cancels the requesting connection's subscription to channel events of a user.
+func (h *Handler) Unsubscribe(c *rpc.Context) {
+	ctx := c.Context
+	logger := log.FromContext(ctx)
+
+	var reqPayload rpc.ChannelsV1UnsubscribeRequest
+	if err := c.Request.Payload.Translate(&reqPayload); err != nil {
+		c.Fail(err, "failed to parse parameters")
+		return
+	}
+
+	if !common.IsHexAddress(reqPayload.Wallet) {
+		c.Fail(rpc.Errorf("invalid wallet address"), "")
+		return
+	}
+
+	h.notifier.Unsubscribe(c.ConnectionID, rpc.ChannelV1Group, reqPayload.Wallet)
+
+	payload, err := rpc.NewPayload(rpc.ChannelsV1UnsubscribeResponse{})
+	if err != nil {
+		c.Fail(err, "failed to create response")
+		return
+	}
+
+	c.Succeed(c.Request.Method, payload)
+	logger.Debug("unsubscribed from channel events", "wallet", reqPayload.Wallet)
+}
@@ -206,6 +206,21 @@ func coreStateToRPC(state core.State) rpc.StateV1 {
 	}
 }
 
+// coreConditionalTransferToRPC converts a core.ConditionalTransfer to rpc.ConditionalTransferV1
+func coreConditionalTransferToRPC(transfer core.ConditionalTransfer) rpc.ConditionalTransferV1 {
+	return rpc.ConditionalTransferV1{
+		ID:        transfer.ID,
+		Sender:    transfer.Sender,
+		Receiver:  transfer.Receiver,
+		Asset:     transfer.Asset,
+		Amount:    transfer.Amount.String(),
+		HashLock:  transfer.HashLock,
+		ExpiresAt: strconv.FormatInt(transfer.ExpiresAt.Unix(), 10),
+		Status:    transfer.Status.String(),
+		Preimage:  transfer.Preimage,
+	}
+}
+
 // unmapChannelSessionKeyStateV1 converts an RPC ChannelSessionKeyStateV1 to a core.ChannelSessionKeyStateV1.
 func unmapChannelSessionKeyStateV1(state *rpc.ChannelSessionKeyStateV1) (core.ChannelSessionKeyStateV1, error) {
 	version, err := strconv.ParseUint(state.Version, 10, 64)
@@ -24,11 +24,15 @@ func (h *Handler) GetConfig(c *rpc.Context) {
 		NodeVersion:            h.nodeVersion,
 		SupportedSigValidators: core.ChannelSignerTypes,
 		Blockchains:            []rpc.BlockchainInfoV1{},
+		FeeSchedule:            []rpc.FeeRuleV1{},
 	}
 
 	for _, bc := range blockchains {
 		response.Blockchains = append(response.Blockchains, mapBlockchainV1(bc))
 	}
+	for _, rule := range h.feeEngine.Schedule() {
+		response.FeeSchedule = append(response.FeeSchedule, mapFeeRuleV1(rule))
+	}
 
 	payload, err := rpc.NewPayload(response)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -14,11 +15,13 @@ import (
 func TestGetConfig_Success(t *testing.T) {
 	// Setup
 	mockMemoryStore := new(MockMemoryStore)
+	mockFeeEngine := new(MockFeeEngine)
 	nodeAddress := "0x1234567890123456789012345678901234567890"
 	nodeVersion := "v1.0.0"
 
 	handler := &Handler{
 		memoryStore: mockMemoryStore,
+		feeEngine:   mockFeeEngine,
 		nodeAddress: nodeAddress,
 		nodeVersion: nodeVersion,
 	}
@@ -39,6 +42,14 @@ func TestGetConfig_Success(t *testing.T) {
 
 	// Mock expectations
 	mockMemoryStore.On("GetBlockchains").Return(blockchains, nil)
+	mockFeeEngine.On("Schedule").Return([]core.FeeRule{
+		{
+			Asset:      "usdc",
+			Action:     core.GatedActionTransfer,
+			Flat:       decimal.RequireFromString("0.01"),
+			Percentage: decimal.RequireFromString("0.1"),
+		},
+	})
 
 	// Create RPC request
 	reqPayload := rpc.NodeV1GetConfigRequest{}
@@ -75,7 +86,10 @@ func TestGetConfig_Success(t *testing.T) {
 	assert.Equal(t, "Polygon", response.Blockchains[1].Name)
 	assert.Equal(t, "137", response.Blockchains[1].BlockchainID)
 	assert.Equal(t, "0xContract137", response.Blockchains[1].ChannelHubAddress)
+	require.Len(t, response.FeeSchedule, 1)
+	assert.Equal(t, rpc.FeeRuleV1{Asset: "usdc", Action: "transfer", Flat: "0.01", Percentage: "0.1"}, response.FeeSchedule[0])
 
 	// Verify all mock expectations
 	mockMemoryStore.AssertExpectations(t)
+	mockFeeEngine.AssertExpectations(t)
 }
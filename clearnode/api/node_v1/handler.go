@@ -3,6 +3,7 @@ package node_v1
 // Handler manages channel state transitions and provides RPC endpoints for state submission.
 type Handler struct {
 	memoryStore MemoryStore
+	feeEngine   FeeEngine
 	nodeVersion string // Node software version
 	nodeAddress string // Node's wallet address for channel ID calculation
 }
@@ -10,11 +11,13 @@ type Handler struct {
 // NewHandler creates a new Handler instance with the provided dependencies.
 func NewHandler(
 	memoryStore MemoryStore,
+	feeEngine FeeEngine,
 	nodeAddress string,
 	nodeVersion string,
 ) *Handler {
 	return &Handler{
 		memoryStore: memoryStore,
+		feeEngine:   feeEngine,
 		nodeAddress: nodeAddress,
 		nodeVersion: nodeVersion,
 	}
@@ -14,3 +14,9 @@ type MemoryStore interface {
 	// If blockchainID is provided, filters assets to only include tokens on that blockchain.
 	GetAssets(blockchainID *uint64) ([]core.Asset, error)
 }
+
+// FeeEngine provides the fees charged by the node.
+type FeeEngine interface {
+	// Schedule returns all configured fee rules.
+	Schedule() []core.FeeRule
+}
@@ -26,3 +26,16 @@ func (m *MockMemoryStore) GetAssets(blockchainID *uint64) ([]core.Asset, error)
 	}
 	return args.Get(0).([]core.Asset), args.Error(1)
 }
+
+// MockFeeEngine is a mock implementation of the FeeEngine interface
+type MockFeeEngine struct {
+	mock.Mock
+}
+
+func (m *MockFeeEngine) Schedule() []core.FeeRule {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).([]core.FeeRule)
+}
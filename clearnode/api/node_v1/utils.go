@@ -17,6 +17,15 @@ func mapBlockchainV1(blockchain core.Blockchain) rpc.BlockchainInfoV1 {
 	}
 }
 
+func mapFeeRuleV1(rule core.FeeRule) rpc.FeeRuleV1 {
+	return rpc.FeeRuleV1{
+		Asset:      rule.Asset,
+		Action:     string(rule.Action),
+		Flat:       rule.Flat.String(),
+		Percentage: rule.Percentage.String(),
+	}
+}
+
 func mapAssetV1(asset core.Asset) rpc.AssetV1 {
 	tokens := []rpc.TokenV1{}
 	for _, token := range asset.Tokens {
@@ -7,8 +7,8 @@ import (
 )
 
 const (
-	// rateLimitStorageKey is the key used to store the token bucket in connection storage.
-	rateLimitStorageKey = "rate_limiter"
+	// RateLimitStorageKey is the key used to store the token bucket in connection storage.
+	RateLimitStorageKey = "rate_limiter"
 )
 
 // tokenBucket holds the mutable state for per-connection rate limiting.
@@ -24,7 +24,7 @@ func (r *RPCRouter) RateLimitMiddleware(c *rpc.Context) {
 		tokens: r.rateLimitBurst,
 		last:   time.Now().Add(-time.Second),
 	}
-	if val, ok := c.Storage.Get(rateLimitStorageKey); ok {
+	if val, ok := c.Storage.Get(RateLimitStorageKey); ok {
 		if b, ok := val.(*tokenBucket); ok {
 			bucket = b
 		}
@@ -45,7 +45,7 @@ func (r *RPCRouter) RateLimitMiddleware(c *rpc.Context) {
 		return
 	}
 	bucket.tokens--
-	c.Storage.Set(rateLimitStorageKey, bucket)
+	c.Storage.Set(RateLimitStorageKey, bucket)
 
 	c.Next()
 }
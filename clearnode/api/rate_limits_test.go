@@ -151,7 +151,7 @@ func TestRateLimitMiddleware(t *testing.T) {
 		router.RateLimitMiddleware(ctx)
 
 		// Check bucket is stored
-		val, ok := storage.Get(rateLimitStorageKey)
+		val, ok := storage.Get(RateLimitStorageKey)
 		require.True(t, ok, "bucket should be stored")
 
 		bucket, ok := val.(*tokenBucket)
@@ -6,12 +6,16 @@ import (
 	"github.com/layer-3/nitrolite/clearnode/action_gateway"
 	"github.com/layer-3/nitrolite/clearnode/api/app_session_v1"
 	"github.com/layer-3/nitrolite/clearnode/api/apps_v1"
+	"github.com/layer-3/nitrolite/clearnode/api/auth_v1"
 	"github.com/layer-3/nitrolite/clearnode/api/channel_v1"
 	"github.com/layer-3/nitrolite/clearnode/api/node_v1"
+	"github.com/layer-3/nitrolite/clearnode/api/stream_v1"
 	"github.com/layer-3/nitrolite/clearnode/api/user_v1"
+	"github.com/layer-3/nitrolite/clearnode/fee_engine"
 	"github.com/layer-3/nitrolite/clearnode/metrics"
 	"github.com/layer-3/nitrolite/clearnode/store/database"
 	"github.com/layer-3/nitrolite/clearnode/store/memory"
+	"github.com/layer-3/nitrolite/pkg/app"
 	"github.com/layer-3/nitrolite/pkg/core"
 	"github.com/layer-3/nitrolite/pkg/log"
 	"github.com/layer-3/nitrolite/pkg/rpc"
@@ -25,6 +29,9 @@ type RPCRouter struct {
 
 	rateLimitPerSec float64
 	rateLimitBurst  float64
+
+	restrictPrivateReads bool
+	paymentStreams       PaymentStreamGetter
 }
 
 type RPCRouterConfig struct {
@@ -39,6 +46,16 @@ type RPCRouterConfig struct {
 
 	RateLimitPerSec float64
 	RateLimitBurst  float64
+
+	// RestrictPrivateReads limits private read methods (balances, transactions, etc.)
+	// to the wallet the connection is authenticated as.
+	RestrictPrivateReads bool
+
+	// StatePacker packs the states the node signs (default: core.NewStatePackerV1).
+	StatePacker core.StatePacker
+
+	// NodeBalances reports the node's liquidity on each blockchain, required for escrow withdrawals.
+	NodeBalances channel_v1.NodeBalanceProvider
 }
 
 func NewRPCRouter(
@@ -48,6 +65,8 @@ func NewRPCRouter(
 	dbStore database.DatabaseStore,
 	memoryStore memory.MemoryStore,
 	actionGateway *action_gateway.ActionGateway,
+	feeEngine *fee_engine.FeeEngine,
+	appStateValidators *app.AppStateValidatorRegistryV1,
 	runtimeMetrics metrics.RuntimeMetricExporter,
 	logger log.Logger,
 ) *RPCRouter {
@@ -57,10 +76,14 @@ func NewRPCRouter(
 		runtimeMetrics:  runtimeMetrics,
 		rateLimitPerSec: cfg.RateLimitPerSec,
 		rateLimitBurst:  cfg.RateLimitBurst,
+
+		restrictPrivateReads: cfg.RestrictPrivateReads,
+		paymentStreams:       dbStore,
 	}
 
 	r.Node.Use(r.ObservabilityMiddleware)
 	r.Node.Use(r.RateLimitMiddleware)
+	r.Node.Use(r.AuthMiddleware)
 
 	// Transaction wrapper helpers for each store type.
 	// wrapWithMetrics executes fn inside a DB transaction with a metricStore wrapper,
@@ -88,10 +111,16 @@ func NewRPCRouter(
 	useUserV1StoreInTx := func(h user_v1.StoreTxHandler) error {
 		return wrapWithMetrics(func(ms *metricStore) error { return h(ms) })
 	}
+	useStreamV1StoreInTx := func(h stream_v1.StoreTxHandler) error {
+		return wrapWithMetrics(func(ms *metricStore) error { return h(ms) })
+	}
 
 	nodeAddress := signer.PublicKey().Address().String()
 
-	statePacker := core.NewStatePackerV1(memoryStore)
+	statePacker := cfg.StatePacker
+	if statePacker == nil {
+		statePacker = core.NewStatePackerV1(memoryStore)
+	}
 	stateAdvancer := core.NewStateAdvancerV1(memoryStore)
 
 	nodeChannelSigner, err := core.NewChannelDefaultSigner(signer)
@@ -99,12 +128,14 @@ func NewRPCRouter(
 		panic("failed to create channel wallet signer: " + err.Error())
 	}
 
-	channelV1Handler := channel_v1.NewHandler(useChannelV1StoreInTx, memoryStore, actionGateway, nodeChannelSigner, stateAdvancer, statePacker, nodeAddress, cfg.MinChallenge, runtimeMetrics, cfg.MaxSessionKeyIDs)
-	appSessionV1Handler := app_session_v1.NewHandler(useAppSessionV1StoreInTx, memoryStore, actionGateway, signer, stateAdvancer, statePacker, nodeAddress, runtimeMetrics,
+	channelV1Handler := channel_v1.NewHandler(useChannelV1StoreInTx, memoryStore, actionGateway, feeEngine, cfg.NodeBalances, nodeChannelSigner, stateAdvancer, statePacker, nodeAddress, cfg.MinChallenge, runtimeMetrics, node, cfg.MaxSessionKeyIDs)
+	appSessionV1Handler := app_session_v1.NewHandler(useAppSessionV1StoreInTx, memoryStore, actionGateway, feeEngine, signer, stateAdvancer, statePacker, nodeAddress, runtimeMetrics, node, appStateValidators,
 		cfg.MaxParticipants, cfg.MaxSessionDataLen, cfg.MaxSessionKeyIDs, cfg.MaxRebalanceSignedUpdates)
 	appsV1Handler := apps_v1.NewHandler(dbStore, useAppV1StoreInTx, actionGateway, cfg.MaxAppMetadataLen)
-	nodeV1Handler := node_v1.NewHandler(memoryStore, nodeAddress, cfg.NodeVersion)
+	nodeV1Handler := node_v1.NewHandler(memoryStore, feeEngine, nodeAddress, cfg.NodeVersion)
 	userV1Handler := user_v1.NewHandler(dbStore, useUserV1StoreInTx, actionGateway)
+	authV1Handler := auth_v1.NewHandler(dbStore)
+	streamV1Handler := stream_v1.NewHandler(dbStore, useStreamV1StoreInTx, memoryStore, actionGateway)
 
 	appSessionV1Group := r.Node.NewGroup(rpc.AppSessionsV1Group.String())
 	appSessionV1Group.Handle(rpc.AppSessionsV1SubmitDepositStateMethod.String(), appSessionV1Handler.SubmitDepositState)
@@ -114,6 +145,8 @@ func NewRPCRouter(
 	appSessionV1Group.Handle(rpc.AppSessionsV1GetAppSessionsMethod.String(), appSessionV1Handler.GetAppSessions)
 	appSessionV1Group.Handle(rpc.AppSessionsV1SubmitSessionKeyStateMethod.String(), appSessionV1Handler.SubmitSessionKeyState)
 	appSessionV1Group.Handle(rpc.AppSessionsV1GetLastKeyStatesMethod.String(), appSessionV1Handler.GetLastKeyStates)
+	appSessionV1Group.Handle(rpc.AppSessionsV1SubscribeMethod.String(), appSessionV1Handler.Subscribe)
+	appSessionV1Group.Handle(rpc.AppSessionsV1UnsubscribeMethod.String(), appSessionV1Handler.Unsubscribe)
 	if cfg.MaxRebalanceSignedUpdates >= 2 {
 		appSessionV1Group.Handle(rpc.AppSessionsV1RebalanceAppSessionsMethod.String(), appSessionV1Handler.RebalanceAppSessions)
 	}
@@ -125,8 +158,14 @@ func NewRPCRouter(
 	channelV1Group.Handle(rpc.ChannelsV1GetLatestStateMethod.String(), channelV1Handler.GetLatestState)
 	channelV1Group.Handle(rpc.ChannelsV1RequestCreationMethod.String(), channelV1Handler.RequestCreation)
 	channelV1Group.Handle(rpc.ChannelsV1SubmitStateMethod.String(), channelV1Handler.SubmitState)
+	channelV1Group.Handle(rpc.ChannelsV1SubmitSwapMethod.String(), channelV1Handler.SubmitSwap)
+	channelV1Group.Handle(rpc.ChannelsV1SubmitConditionalLockMethod.String(), channelV1Handler.SubmitConditionalLock)
+	channelV1Group.Handle(rpc.ChannelsV1ClaimConditionalTransferMethod.String(), channelV1Handler.ClaimConditionalTransfer)
+	channelV1Group.Handle(rpc.ChannelsV1RefundConditionalTransferMethod.String(), channelV1Handler.RefundConditionalTransfer)
 	channelV1Group.Handle(rpc.ChannelsV1SubmitSessionKeyStateMethod.String(), channelV1Handler.SubmitSessionKeyState)
 	channelV1Group.Handle(rpc.ChannelsV1GetLastKeyStatesMethod.String(), channelV1Handler.GetLastKeyStates)
+	channelV1Group.Handle(rpc.ChannelsV1SubscribeMethod.String(), channelV1Handler.Subscribe)
+	channelV1Group.Handle(rpc.ChannelsV1UnsubscribeMethod.String(), channelV1Handler.Unsubscribe)
 
 	nodeV1Group := r.Node.NewGroup(rpc.NodeV1Group.String())
 	nodeV1Group.Handle(rpc.NodeV1PingMethod.String(), nodeV1Handler.Ping)
@@ -137,11 +176,20 @@ func NewRPCRouter(
 	appsV1Group.Handle(rpc.AppsV1GetAppsMethod.String(), appsV1Handler.GetApps)
 	appsV1Group.Handle(rpc.AppsV1SubmitAppVersionMethod.String(), appsV1Handler.SubmitAppVersion)
 
+	streamV1Group := r.Node.NewGroup(rpc.StreamsV1Group.String())
+	streamV1Group.Handle(rpc.StreamsV1CreateMethod.String(), streamV1Handler.Create)
+	streamV1Group.Handle(rpc.StreamsV1CancelMethod.String(), streamV1Handler.Cancel)
+	streamV1Group.Handle(rpc.StreamsV1GetMethod.String(), streamV1Handler.Get)
+
 	userV1Group := r.Node.NewGroup(rpc.UserV1Group.String())
 	userV1Group.Handle(rpc.UserV1GetBalancesMethod.String(), userV1Handler.GetBalances)
 	userV1Group.Handle(rpc.UserV1GetTransactionsMethod.String(), userV1Handler.GetTransactions)
 	userV1Group.Handle(rpc.UserV1GetActionAllowancesMethod.String(), userV1Handler.GetActionAllowances)
 
+	authV1Group := r.Node.NewGroup(rpc.AuthV1Group.String())
+	authV1Group.Handle(rpc.AuthV1RequestChallengeMethod.String(), authV1Handler.RequestChallenge)
+	authV1Group.Handle(rpc.AuthV1AuthenticateMethod.String(), authV1Handler.Authenticate)
+
 	return r
 }
 
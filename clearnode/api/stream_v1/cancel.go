@@ -0,0 +1,108 @@
+package stream_v1
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/layer-3/nitrolite/pkg/sign"
+)
+
+// Cancel stops an active payment stream on behalf of its sender or recipient.
+// The stream stops accruing at the time of cancellation; the amount accrued until then
+// is still settled to the recipient by the payment stream scheduler.
+func (h *Handler) Cancel(c *rpc.Context) {
+	ctx := c.Context
+	logger := log.FromContext(ctx)
+
+	var req rpc.StreamsV1CancelRequest
+	if err := c.Request.Payload.Translate(&req); err != nil {
+		c.Fail(err, "failed to parse parameters")
+		return
+	}
+
+	if req.StreamID == "" {
+		c.Fail(nil, "stream_id is required")
+		return
+	}
+	if req.Signature == "" {
+		c.Fail(nil, "signature is required")
+		return
+	}
+	sigBytes, err := hexutil.Decode(req.Signature)
+	if err != nil {
+		c.Fail(rpc.Errorf("failed to decode signature: %v", err), "")
+		return
+	}
+
+	packedCancellation, err := core.PackPaymentStreamCancellation(req.StreamID)
+	if err != nil {
+		c.Fail(err, "failed to pack payment stream cancellation")
+		return
+	}
+
+	sigValidator, err := sign.NewSigValidator(sign.TypeEthereumMsg)
+	if err != nil {
+		c.Fail(err, "failed to create signature validator")
+		return
+	}
+
+	var stream core.PaymentStream
+	err = h.useStoreInTx(func(tx Store) error {
+		lockedStream, err := tx.LockPaymentStream(req.StreamID)
+		if err != nil {
+			return rpc.Errorf("failed to get payment stream: %v", err)
+		}
+		if lockedStream == nil {
+			return rpc.Errorf("payment stream %s not found", req.StreamID)
+		}
+
+		senderErr := sigValidator.Verify(lockedStream.Authorization.Sender, packedCancellation, sigBytes)
+		if senderErr != nil {
+			if err := sigValidator.Verify(lockedStream.Authorization.Recipient, packedCancellation, sigBytes); err != nil {
+				return rpc.Errorf("invalid signature: must be signed by the stream sender or recipient")
+			}
+		}
+
+		if lockedStream.Status != core.PaymentStreamStatusActive {
+			return rpc.Errorf("payment stream is already %s", lockedStream.Status.String())
+		}
+
+		stream = *lockedStream
+		stream.Status = core.PaymentStreamStatusCancelled
+		if now := time.Now(); now.Before(stream.EndsAt) {
+			stream.EndsAt = now
+		}
+
+		if err := tx.UpdatePaymentStream(stream); err != nil {
+			return rpc.Errorf("failed to update payment stream")
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.Error("failed to cancel payment stream", "error", err)
+		c.Fail(err, "failed to cancel payment stream")
+		return
+	}
+
+	resp := rpc.StreamsV1CancelResponse{
+		Stream: corePaymentStreamToRPC(stream),
+	}
+	payload, err := rpc.NewPayload(resp)
+	if err != nil {
+		c.Fail(err, "failed to create response")
+		return
+	}
+
+	c.Succeed(c.Request.Method, payload)
+
+	logger.Info("cancelled payment stream",
+		"streamID", stream.ID,
+		"sender", stream.Authorization.Sender,
+		"recipient", stream.Authorization.Recipient,
+		"endsAt", stream.EndsAt.Unix())
+}
@@ -0,0 +1,193 @@
+package stream_v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/layer-3/nitrolite/pkg/sign"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStreamID = "0x1111111111111111111111111111111111111111111111111111111111111111"
+
+func newTestStream(sender, recipient string, endsAt time.Time) *core.PaymentStream {
+	return &core.PaymentStream{
+		ID: testStreamID,
+		Authorization: core.PaymentStreamAuthorization{
+			Sender:    sender,
+			Recipient: recipient,
+			Asset:     "usdc",
+			Rate:      decimal.RequireFromString("0.5"),
+			Cap:       decimal.NewFromInt(100),
+			ExpiresAt: uint64(endsAt.Unix()),
+			Nonce:     1,
+		},
+		Status:       core.PaymentStreamStatusActive,
+		StartsAt:     endsAt.Add(-time.Hour),
+		EndsAt:       endsAt,
+		Settled:      decimal.Zero,
+		SettledUntil: endsAt.Add(-time.Hour),
+	}
+}
+
+func signTestCancellation(t *testing.T, signer sign.Signer, streamID string) string {
+	t.Helper()
+
+	packed, err := core.PackPaymentStreamCancellation(streamID)
+	require.NoError(t, err)
+
+	return testSign(t, signer, packed)
+}
+
+func callCancel(t *testing.T, handler *Handler, req rpc.StreamsV1CancelRequest) *rpc.Context {
+	t.Helper()
+
+	payload, err := rpc.NewPayload(req)
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context: context.Background(),
+		Request: rpc.NewRequest(1, rpc.StreamsV1CancelMethod.String(), payload),
+	}
+	handler.Cancel(ctx)
+
+	require.NotNil(t, ctx.Response)
+	return ctx
+}
+
+func TestCancel_BySender(t *testing.T) {
+	sender, signer := testWallet(t)
+	endsAt := time.Now().Add(time.Hour)
+
+	var updated core.PaymentStream
+	mockStore := &MockStore{
+		lockPaymentStreamFn: func(id string) (*core.PaymentStream, error) {
+			assert.Equal(t, testStreamID, id)
+			return newTestStream(sender, testRecipientWallet, endsAt), nil
+		},
+		updatePaymentStreamFn: func(stream core.PaymentStream) error {
+			updated = stream
+			return nil
+		},
+	}
+
+	ctx := callCancel(t, newTestHandler(mockStore), rpc.StreamsV1CancelRequest{
+		StreamID:  testStreamID,
+		Signature: signTestCancellation(t, signer, testStreamID),
+	})
+	require.NoError(t, ctx.Response.Error())
+
+	assert.Equal(t, core.PaymentStreamStatusCancelled, updated.Status)
+	assert.True(t, updated.EndsAt.Before(endsAt))
+
+	var resp rpc.StreamsV1CancelResponse
+	require.NoError(t, ctx.Response.Payload.Translate(&resp))
+	assert.Equal(t, "cancelled", resp.Stream.Status)
+}
+
+func TestCancel_ByRecipient(t *testing.T) {
+	recipient, signer := testWallet(t)
+	sender, _ := testWallet(t)
+
+	var updated core.PaymentStream
+	mockStore := &MockStore{
+		lockPaymentStreamFn: func(id string) (*core.PaymentStream, error) {
+			return newTestStream(sender, recipient, time.Now().Add(time.Hour)), nil
+		},
+		updatePaymentStreamFn: func(stream core.PaymentStream) error {
+			updated = stream
+			return nil
+		},
+	}
+
+	ctx := callCancel(t, newTestHandler(mockStore), rpc.StreamsV1CancelRequest{
+		StreamID:  testStreamID,
+		Signature: signTestCancellation(t, signer, testStreamID),
+	})
+	require.NoError(t, ctx.Response.Error())
+	assert.Equal(t, core.PaymentStreamStatusCancelled, updated.Status)
+}
+
+func TestCancel_KeepsEarlierEnd(t *testing.T) {
+	sender, signer := testWallet(t)
+	endsAt := time.Now().Add(-time.Minute)
+
+	var updated core.PaymentStream
+	mockStore := &MockStore{
+		lockPaymentStreamFn: func(id string) (*core.PaymentStream, error) {
+			return newTestStream(sender, testRecipientWallet, endsAt), nil
+		},
+		updatePaymentStreamFn: func(stream core.PaymentStream) error {
+			updated = stream
+			return nil
+		},
+	}
+
+	ctx := callCancel(t, newTestHandler(mockStore), rpc.StreamsV1CancelRequest{
+		StreamID:  testStreamID,
+		Signature: signTestCancellation(t, signer, testStreamID),
+	})
+	require.NoError(t, ctx.Response.Error())
+	assert.True(t, endsAt.Equal(updated.EndsAt))
+}
+
+func TestCancel_Errors(t *testing.T) {
+	sender, signer := testWallet(t)
+	_, strangerSigner := testWallet(t)
+
+	tests := []struct {
+		name        string
+		stream      *core.PaymentStream
+		signer      sign.Signer
+		expectedErr string
+	}{
+		{
+			name:        "not found",
+			stream:      nil,
+			signer:      signer,
+			expectedErr: "not found",
+		},
+		{
+			name:        "not a participant",
+			stream:      newTestStream(sender, testRecipientWallet, time.Now().Add(time.Hour)),
+			signer:      strangerSigner,
+			expectedErr: "must be signed by the stream sender or recipient",
+		},
+		{
+			name: "already cancelled",
+			stream: func() *core.PaymentStream {
+				stream := newTestStream(sender, testRecipientWallet, time.Now().Add(time.Hour))
+				stream.Status = core.PaymentStreamStatusCancelled
+				return stream
+			}(),
+			signer:      signer,
+			expectedErr: "payment stream is already cancelled",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := &MockStore{
+				lockPaymentStreamFn: func(id string) (*core.PaymentStream, error) {
+					return tc.stream, nil
+				},
+				updatePaymentStreamFn: func(stream core.PaymentStream) error {
+					t.Fatal("stream must not be updated")
+					return nil
+				},
+			}
+
+			ctx := callCancel(t, newTestHandler(mockStore), rpc.StreamsV1CancelRequest{
+				StreamID:  testStreamID,
+				Signature: signTestCancellation(t, tc.signer, testStreamID),
+			})
+			require.Error(t, ctx.Response.Error())
+			assert.Contains(t, ctx.Response.Error().Error(), tc.expectedErr)
+		})
+	}
+}
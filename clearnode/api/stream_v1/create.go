@@ -0,0 +1,151 @@
+package stream_v1
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/shopspring/decimal"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/layer-3/nitrolite/pkg/sign"
+)
+
+// Create registers a payment stream authorized by its sender.
+// The stream starts accruing immediately at the authorized rate per second and stops at its expiry
+// or once the cap is reached. The sender must have an open home channel for the asset.
+func (h *Handler) Create(c *rpc.Context) {
+	ctx := c.Context
+	logger := log.FromContext(ctx)
+
+	var req rpc.StreamsV1CreateRequest
+	if err := c.Request.Payload.Translate(&req); err != nil {
+		c.Fail(err, "failed to parse parameters")
+		return
+	}
+
+	auth, err := toCorePaymentStreamAuthorization(req.Authorization)
+	if err != nil {
+		c.Fail(err, "invalid payment stream authorization")
+		return
+	}
+	auth.Sender = strings.ToLower(auth.Sender)
+	auth.Recipient = strings.ToLower(auth.Recipient)
+
+	decimals, err := h.memoryStore.GetAssetDecimals(auth.Asset)
+	if err != nil {
+		c.Fail(rpc.Errorf("asset %s is not supported", auth.Asset), "")
+		return
+	}
+	if auth.Cap.Exponent() < -int32(decimals) {
+		c.Fail(rpc.Errorf("cap %s exceeds maximum decimals %d for asset %s", auth.Cap.String(), decimals, auth.Asset), "")
+		return
+	}
+
+	now := time.Now()
+	expiry := time.Unix(int64(auth.ExpiresAt), 0)
+	if !expiry.After(now) {
+		c.Fail(rpc.Errorf("payment stream expiry must be in the future"), "")
+		return
+	}
+
+	if req.SenderSig == "" {
+		c.Fail(nil, "sender_sig is required")
+		return
+	}
+	sigBytes, err := hexutil.Decode(req.SenderSig)
+	if err != nil {
+		c.Fail(rpc.Errorf("failed to decode sender signature: %v", err), "")
+		return
+	}
+
+	packedAuth, err := core.PackPaymentStreamAuthorization(auth)
+	if err != nil {
+		c.Fail(rpc.Errorf("failed to pack payment stream authorization: %v", err), "")
+		return
+	}
+
+	sigValidator, err := sign.NewSigValidator(sign.TypeEthereumMsg)
+	if err != nil {
+		c.Fail(err, "failed to create signature validator")
+		return
+	}
+	if err := sigValidator.Verify(auth.Sender, packedAuth, sigBytes); err != nil {
+		c.Fail(rpc.Errorf("invalid sender signature: %v", err), "")
+		return
+	}
+
+	streamID, err := core.GetPaymentStreamID(auth)
+	if err != nil {
+		c.Fail(err, "failed to compute payment stream ID")
+		return
+	}
+
+	stream := core.PaymentStream{
+		ID:            streamID,
+		Authorization: auth,
+		SenderSig:     req.SenderSig,
+		Status:        core.PaymentStreamStatusActive,
+		StartsAt:      now,
+		EndsAt:        expiry,
+		Settled:       decimal.Zero,
+		SettledUntil:  now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	err = h.useStoreInTx(func(tx Store) error {
+		if err := h.actionGateway.AllowAction(tx, auth.Sender, core.GatedActionTransfer); err != nil {
+			return rpc.NewError(err)
+		}
+
+		_, hasOpenChannel, err := tx.CheckOpenChannel(auth.Sender, auth.Asset)
+		if err != nil {
+			return rpc.Errorf("failed to check open channel: %v", err)
+		}
+		if !hasOpenChannel {
+			return rpc.Errorf("sender %s has no open %s channel", auth.Sender, auth.Asset)
+		}
+
+		existing, err := tx.GetPaymentStream(streamID)
+		if err != nil {
+			return rpc.Errorf("failed to get payment stream: %v", err)
+		}
+		if existing != nil {
+			return rpc.Errorf("payment stream %s already exists", streamID)
+		}
+
+		if err := tx.CreatePaymentStream(stream); err != nil {
+			return rpc.Errorf("failed to create payment stream")
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.Error("failed to create payment stream", "error", err)
+		c.Fail(err, "failed to create payment stream")
+		return
+	}
+
+	resp := rpc.StreamsV1CreateResponse{
+		Stream: corePaymentStreamToRPC(stream),
+	}
+	payload, err := rpc.NewPayload(resp)
+	if err != nil {
+		c.Fail(err, "failed to create response")
+		return
+	}
+
+	c.Succeed(c.Request.Method, payload)
+
+	logger.Info("created payment stream",
+		"streamID", stream.ID,
+		"sender", auth.Sender,
+		"recipient", auth.Recipient,
+		"asset", auth.Asset,
+		"rate", auth.Rate.String(),
+		"cap", auth.Cap.String(),
+		"expiresAt", auth.ExpiresAt)
+}
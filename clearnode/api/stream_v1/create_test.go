@@ -0,0 +1,260 @@
+package stream_v1
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/layer-3/nitrolite/pkg/sign"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRecipientWallet = "0x2222222222222222222222222222222222222222"
+
+// testWallet generates a real ECDSA key pair and returns the wallet address (lowercase hex) and its signer.
+func testWallet(t *testing.T) (string, sign.Signer) {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	signer, err := sign.NewEthereumMsgSigner(hexutil.Encode(crypto.FromECDSA(key)))
+	require.NoError(t, err)
+
+	return strings.ToLower(crypto.PubkeyToAddress(key.PublicKey).Hex()), signer
+}
+
+func testSign(t *testing.T, signer sign.Signer, data []byte) string {
+	t.Helper()
+
+	sig, err := signer.Sign(data)
+	require.NoError(t, err)
+	return hexutil.Encode(sig)
+}
+
+func newTestAuthorization(sender string) rpc.PaymentStreamAuthorizationV1 {
+	return rpc.PaymentStreamAuthorizationV1{
+		Sender:    sender,
+		Recipient: testRecipientWallet,
+		Asset:     "usdc",
+		Rate:      "0.5",
+		Cap:       "100",
+		ExpiresAt: strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10),
+		Nonce:     "1",
+	}
+}
+
+func signTestAuthorization(t *testing.T, signer sign.Signer, auth rpc.PaymentStreamAuthorizationV1) string {
+	t.Helper()
+
+	coreAuth, err := toCorePaymentStreamAuthorization(auth)
+	require.NoError(t, err)
+	packed, err := core.PackPaymentStreamAuthorization(coreAuth)
+	require.NoError(t, err)
+
+	return testSign(t, signer, packed)
+}
+
+func newTestHandler(store Store) *Handler {
+	storeTxProvider := func(fn StoreTxHandler) error {
+		return fn(store)
+	}
+	memoryStore := &MockMemoryStore{Decimals: map[string]uint8{"usdc": 6}}
+	return NewHandler(store, storeTxProvider, memoryStore, &MockActionGateway{})
+}
+
+func callCreate(t *testing.T, handler *Handler, req rpc.StreamsV1CreateRequest) *rpc.Context {
+	t.Helper()
+
+	payload, err := rpc.NewPayload(req)
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context: context.Background(),
+		Request: rpc.NewRequest(1, rpc.StreamsV1CreateMethod.String(), payload),
+	}
+	handler.Create(ctx)
+
+	require.NotNil(t, ctx.Response)
+	return ctx
+}
+
+func TestCreate_Success(t *testing.T) {
+	sender, signer := testWallet(t)
+	auth := newTestAuthorization(sender)
+
+	var created core.PaymentStream
+	mockStore := &MockStore{
+		createPaymentStreamFn: func(stream core.PaymentStream) error {
+			created = stream
+			return nil
+		},
+	}
+
+	ctx := callCreate(t, newTestHandler(mockStore), rpc.StreamsV1CreateRequest{
+		Authorization: auth,
+		SenderSig:     signTestAuthorization(t, signer, auth),
+	})
+	require.NoError(t, ctx.Response.Error())
+
+	var resp rpc.StreamsV1CreateResponse
+	require.NoError(t, ctx.Response.Payload.Translate(&resp))
+
+	expectedID, err := core.GetPaymentStreamID(created.Authorization)
+	require.NoError(t, err)
+	assert.Equal(t, expectedID, created.ID)
+	assert.Equal(t, expectedID, resp.Stream.ID)
+	assert.Equal(t, core.PaymentStreamStatusActive, created.Status)
+	assert.Equal(t, sender, created.Authorization.Sender)
+	assert.Equal(t, testRecipientWallet, created.Authorization.Recipient)
+	assert.True(t, created.Settled.IsZero())
+	assert.Equal(t, created.StartsAt, created.SettledUntil)
+	assert.Equal(t, auth.ExpiresAt, strconv.FormatInt(created.EndsAt.Unix(), 10))
+	assert.Equal(t, "active", resp.Stream.Status)
+	assert.Equal(t, "0.5", resp.Stream.Rate)
+	assert.Equal(t, "100", resp.Stream.Cap)
+}
+
+func TestCreate_InvalidSignature(t *testing.T) {
+	sender, _ := testWallet(t)
+	_, otherSigner := testWallet(t)
+	auth := newTestAuthorization(sender)
+
+	mockStore := &MockStore{
+		createPaymentStreamFn: func(stream core.PaymentStream) error {
+			t.Fatal("stream must not be created")
+			return nil
+		},
+	}
+
+	ctx := callCreate(t, newTestHandler(mockStore), rpc.StreamsV1CreateRequest{
+		Authorization: auth,
+		SenderSig:     signTestAuthorization(t, otherSigner, auth),
+	})
+	require.Error(t, ctx.Response.Error())
+	assert.Contains(t, ctx.Response.Error().Error(), "invalid sender signature")
+}
+
+func TestCreate_InvalidAuthorization(t *testing.T) {
+	sender, signer := testWallet(t)
+
+	tests := []struct {
+		name        string
+		modify      func(auth *rpc.PaymentStreamAuthorizationV1)
+		expectedErr string
+	}{
+		{
+			name:        "same wallets",
+			modify:      func(auth *rpc.PaymentStreamAuthorizationV1) { auth.Recipient = "0x" + strings.ToUpper(auth.Sender[2:]) },
+			expectedErr: "sender and recipient wallets are the same",
+		},
+		{
+			name:        "invalid recipient",
+			modify:      func(auth *rpc.PaymentStreamAuthorizationV1) { auth.Recipient = "0x1234" },
+			expectedErr: "invalid recipient address",
+		},
+		{
+			name:        "zero rate",
+			modify:      func(auth *rpc.PaymentStreamAuthorizationV1) { auth.Rate = "0" },
+			expectedErr: "rate must be positive",
+		},
+		{
+			name:        "negative cap",
+			modify:      func(auth *rpc.PaymentStreamAuthorizationV1) { auth.Cap = "-1" },
+			expectedErr: "cap must be positive",
+		},
+		{
+			name:        "unsupported asset",
+			modify:      func(auth *rpc.PaymentStreamAuthorizationV1) { auth.Asset = "doge" },
+			expectedErr: "asset doge is not supported",
+		},
+		{
+			name:        "cap precision",
+			modify:      func(auth *rpc.PaymentStreamAuthorizationV1) { auth.Cap = "1.0000001" },
+			expectedErr: "exceeds maximum decimals",
+		},
+		{
+			name: "expired",
+			modify: func(auth *rpc.PaymentStreamAuthorizationV1) {
+				auth.ExpiresAt = strconv.FormatInt(time.Now().Add(-time.Minute).Unix(), 10)
+			},
+			expectedErr: "expiry must be in the future",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			auth := newTestAuthorization(sender)
+			tc.modify(&auth)
+
+			ctx := callCreate(t, newTestHandler(&MockStore{}), rpc.StreamsV1CreateRequest{
+				Authorization: auth,
+				SenderSig:     testSign(t, signer, []byte("unused")),
+			})
+			require.Error(t, ctx.Response.Error())
+			assert.Contains(t, ctx.Response.Error().Error(), tc.expectedErr)
+		})
+	}
+}
+
+func TestCreate_NoOpenChannel(t *testing.T) {
+	sender, signer := testWallet(t)
+	auth := newTestAuthorization(sender)
+
+	mockStore := &MockStore{
+		checkOpenChannelFn: func(wallet, asset string) (string, bool, error) {
+			return "", false, nil
+		},
+	}
+
+	ctx := callCreate(t, newTestHandler(mockStore), rpc.StreamsV1CreateRequest{
+		Authorization: auth,
+		SenderSig:     signTestAuthorization(t, signer, auth),
+	})
+	require.Error(t, ctx.Response.Error())
+	assert.Contains(t, ctx.Response.Error().Error(), "has no open usdc channel")
+}
+
+func TestCreate_AlreadyExists(t *testing.T) {
+	sender, signer := testWallet(t)
+	auth := newTestAuthorization(sender)
+
+	mockStore := &MockStore{
+		getPaymentStreamFn: func(id string) (*core.PaymentStream, error) {
+			return &core.PaymentStream{ID: id}, nil
+		},
+	}
+
+	ctx := callCreate(t, newTestHandler(mockStore), rpc.StreamsV1CreateRequest{
+		Authorization: auth,
+		SenderSig:     signTestAuthorization(t, signer, auth),
+	})
+	require.Error(t, ctx.Response.Error())
+	assert.Contains(t, ctx.Response.Error().Error(), "already exists")
+}
+
+func TestCreate_ActionNotAllowed(t *testing.T) {
+	sender, signer := testWallet(t)
+	auth := newTestAuthorization(sender)
+
+	storeTxProvider := func(fn StoreTxHandler) error {
+		return fn(&MockStore{})
+	}
+	memoryStore := &MockMemoryStore{Decimals: map[string]uint8{"usdc": 6}}
+	handler := NewHandler(&MockStore{}, storeTxProvider, memoryStore, &MockActionGateway{Err: errors.New("action transfer limit reached")})
+
+	ctx := callCreate(t, handler, rpc.StreamsV1CreateRequest{
+		Authorization: auth,
+		SenderSig:     signTestAuthorization(t, signer, auth),
+	})
+	require.Error(t, ctx.Response.Error())
+	assert.Contains(t, ctx.Response.Error().Error(), "limit reached")
+}
@@ -0,0 +1,59 @@
+package stream_v1
+
+import (
+	"strings"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// Get retrieves payment streams by ID or by the wallet sending or receiving them.
+// When both filters are provided, the stream is returned only if the wallet participates in it.
+func (h *Handler) Get(c *rpc.Context) {
+	var req rpc.StreamsV1GetRequest
+	if err := c.Request.Payload.Translate(&req); err != nil {
+		c.Fail(err, "failed to parse parameters")
+		return
+	}
+
+	if req.StreamID == nil && req.Wallet == nil {
+		c.Fail(nil, "stream_id or wallet is required")
+		return
+	}
+
+	var streams []core.PaymentStream
+	if req.StreamID != nil {
+		stream, err := h.store.GetPaymentStream(*req.StreamID)
+		if err != nil {
+			c.Fail(err, "failed to retrieve payment stream")
+			return
+		}
+		if stream != nil && (req.Wallet == nil ||
+			strings.EqualFold(stream.Authorization.Sender, *req.Wallet) ||
+			strings.EqualFold(stream.Authorization.Recipient, *req.Wallet)) {
+			streams = append(streams, *stream)
+		}
+	} else {
+		var err error
+		streams, err = h.store.GetPaymentStreams(*req.Wallet)
+		if err != nil {
+			c.Fail(err, "failed to retrieve payment streams")
+			return
+		}
+	}
+
+	response := rpc.StreamsV1GetResponse{
+		Streams: make([]rpc.PaymentStreamV1, len(streams)),
+	}
+	for i, stream := range streams {
+		response.Streams[i] = corePaymentStreamToRPC(stream)
+	}
+
+	payload, err := rpc.NewPayload(response)
+	if err != nil {
+		c.Fail(err, "failed to create response")
+		return
+	}
+
+	c.Succeed(c.Request.Method, payload)
+}
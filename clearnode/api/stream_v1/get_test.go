@@ -0,0 +1,100 @@
+package stream_v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSenderWallet = "0x1111111111111111111111111111111111111111"
+
+func callGet(t *testing.T, handler *Handler, req rpc.StreamsV1GetRequest) *rpc.Context {
+	t.Helper()
+
+	payload, err := rpc.NewPayload(req)
+	require.NoError(t, err)
+
+	ctx := &rpc.Context{
+		Context: context.Background(),
+		Request: rpc.NewRequest(1, rpc.StreamsV1GetMethod.String(), payload),
+	}
+	handler.Get(ctx)
+
+	require.NotNil(t, ctx.Response)
+	return ctx
+}
+
+func TestGet_ByID(t *testing.T) {
+	mockStore := &MockStore{
+		getPaymentStreamFn: func(id string) (*core.PaymentStream, error) {
+			assert.Equal(t, testStreamID, id)
+			return newTestStream(testSenderWallet, testRecipientWallet, time.Now().Add(time.Hour)), nil
+		},
+	}
+
+	streamID := testStreamID
+	ctx := callGet(t, newTestHandler(mockStore), rpc.StreamsV1GetRequest{StreamID: &streamID})
+	require.NoError(t, ctx.Response.Error())
+
+	var resp rpc.StreamsV1GetResponse
+	require.NoError(t, ctx.Response.Payload.Translate(&resp))
+	require.Len(t, resp.Streams, 1)
+	assert.Equal(t, testStreamID, resp.Streams[0].ID)
+	assert.Equal(t, testSenderWallet, resp.Streams[0].Sender)
+	assert.Equal(t, "active", resp.Streams[0].Status)
+}
+
+func TestGet_ByIDAndWallet(t *testing.T) {
+	mockStore := &MockStore{
+		getPaymentStreamFn: func(id string) (*core.PaymentStream, error) {
+			return newTestStream(testSenderWallet, testRecipientWallet, time.Now().Add(time.Hour)), nil
+		},
+	}
+	handler := newTestHandler(mockStore)
+	streamID := testStreamID
+
+	wallet := "0x3333333333333333333333333333333333333333"
+	ctx := callGet(t, handler, rpc.StreamsV1GetRequest{StreamID: &streamID, Wallet: &wallet})
+	require.NoError(t, ctx.Response.Error())
+
+	var resp rpc.StreamsV1GetResponse
+	require.NoError(t, ctx.Response.Payload.Translate(&resp))
+	assert.Empty(t, resp.Streams)
+
+	wallet = testRecipientWallet
+	ctx = callGet(t, handler, rpc.StreamsV1GetRequest{StreamID: &streamID, Wallet: &wallet})
+	require.NoError(t, ctx.Response.Error())
+	require.NoError(t, ctx.Response.Payload.Translate(&resp))
+	assert.Len(t, resp.Streams, 1)
+}
+
+func TestGet_ByWallet(t *testing.T) {
+	mockStore := &MockStore{
+		getPaymentStreamsFn: func(wallet string) ([]core.PaymentStream, error) {
+			assert.Equal(t, testSenderWallet, wallet)
+			return []core.PaymentStream{
+				*newTestStream(testSenderWallet, testRecipientWallet, time.Now().Add(time.Hour)),
+				*newTestStream(testRecipientWallet, testSenderWallet, time.Now().Add(time.Hour)),
+			}, nil
+		},
+	}
+
+	wallet := testSenderWallet
+	ctx := callGet(t, newTestHandler(mockStore), rpc.StreamsV1GetRequest{Wallet: &wallet})
+	require.NoError(t, ctx.Response.Error())
+
+	var resp rpc.StreamsV1GetResponse
+	require.NoError(t, ctx.Response.Payload.Translate(&resp))
+	assert.Len(t, resp.Streams, 2)
+}
+
+func TestGet_MissingFilters(t *testing.T) {
+	ctx := callGet(t, newTestHandler(&MockStore{}), rpc.StreamsV1GetRequest{})
+	require.Error(t, ctx.Response.Error())
+	assert.Contains(t, ctx.Response.Error().Error(), "stream_id or wallet is required")
+}
@@ -0,0 +1,20 @@
+package stream_v1
+
+// Handler manages payment stream authorizations and provides RPC endpoints.
+// Accrued stream amounts are settled separately by the channel_v1.PaymentStreamScheduler.
+type Handler struct {
+	store         Store
+	useStoreInTx  StoreTxProvider
+	memoryStore   MemoryStore
+	actionGateway ActionGateway
+}
+
+// NewHandler creates a new Handler instance with the provided dependencies.
+func NewHandler(store Store, useStoreInTx StoreTxProvider, memoryStore MemoryStore, actionGateway ActionGateway) *Handler {
+	return &Handler{
+		store:         store,
+		useStoreInTx:  useStoreInTx,
+		memoryStore:   memoryStore,
+		actionGateway: actionGateway,
+	}
+}
@@ -0,0 +1,53 @@
+package stream_v1
+
+import (
+	"github.com/layer-3/nitrolite/clearnode/action_gateway"
+	"github.com/layer-3/nitrolite/pkg/core"
+)
+
+// StoreTxHandler is a function that executes Store operations within a transaction.
+// If the handler returns an error, the transaction is rolled back; otherwise it's committed.
+type StoreTxHandler func(Store) error
+
+// StoreTxProvider wraps Store operations in a database transaction.
+// It accepts a StoreTxHandler and manages transaction lifecycle (begin, commit, rollback).
+// Returns an error if the handler fails or the transaction cannot be committed.
+type StoreTxProvider func(StoreTxHandler) error
+
+// Store defines the persistence layer interface for payment stream management.
+// All methods should be implemented to work within database transactions.
+type Store interface {
+	// CreatePaymentStream stores a newly authorized payment stream.
+	CreatePaymentStream(stream core.PaymentStream) error
+
+	// GetPaymentStream retrieves a payment stream by ID.
+	// Returns nil if the stream does not exist.
+	GetPaymentStream(id string) (*core.PaymentStream, error)
+
+	// GetPaymentStreams retrieves the payment streams the wallet sends or receives.
+	GetPaymentStreams(wallet string) ([]core.PaymentStream, error)
+
+	// LockPaymentStream retrieves a payment stream by ID and locks it for update.
+	// Returns nil if the stream does not exist.
+	LockPaymentStream(id string) (*core.PaymentStream, error)
+
+	// UpdatePaymentStream persists the status, end and settlement progress of a payment stream.
+	UpdatePaymentStream(stream core.PaymentStream) error
+
+	// CheckOpenChannel verifies if a user has an active channel for the given asset
+	// and returns the approved signature validators if such a channel exists.
+	CheckOpenChannel(wallet, asset string) (string, bool, error)
+
+	action_gateway.Store
+}
+
+// MemoryStore provides in-memory access to the supported assets configuration.
+type MemoryStore interface {
+	// GetAssetDecimals checks if an asset exists and returns its decimals in YN
+	GetAssetDecimals(asset string) (uint8, error)
+}
+
+type ActionGateway interface {
+	// AllowAction checks if a user is allowed to perform a specific gated action based on their past activity and allowances.
+	AllowAction(tx action_gateway.Store, userAddress string, gatedAction core.GatedAction) error
+}
@@ -0,0 +1,103 @@
+package stream_v1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/layer-3/nitrolite/clearnode/action_gateway"
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/shopspring/decimal"
+)
+
+// MockStore implements the Store interface for testing.
+type MockStore struct {
+	createPaymentStreamFn func(stream core.PaymentStream) error
+	getPaymentStreamFn    func(id string) (*core.PaymentStream, error)
+	getPaymentStreamsFn   func(wallet string) ([]core.PaymentStream, error)
+	lockPaymentStreamFn   func(id string) (*core.PaymentStream, error)
+	updatePaymentStreamFn func(stream core.PaymentStream) error
+	checkOpenChannelFn    func(wallet, asset string) (string, bool, error)
+}
+
+func (m *MockStore) CreatePaymentStream(stream core.PaymentStream) error {
+	if m.createPaymentStreamFn != nil {
+		return m.createPaymentStreamFn(stream)
+	}
+	return nil
+}
+
+func (m *MockStore) GetPaymentStream(id string) (*core.PaymentStream, error) {
+	if m.getPaymentStreamFn != nil {
+		return m.getPaymentStreamFn(id)
+	}
+	return nil, nil
+}
+
+func (m *MockStore) GetPaymentStreams(wallet string) ([]core.PaymentStream, error) {
+	if m.getPaymentStreamsFn != nil {
+		return m.getPaymentStreamsFn(wallet)
+	}
+	return nil, nil
+}
+
+func (m *MockStore) LockPaymentStream(id string) (*core.PaymentStream, error) {
+	if m.lockPaymentStreamFn != nil {
+		return m.lockPaymentStreamFn(id)
+	}
+	return nil, nil
+}
+
+func (m *MockStore) UpdatePaymentStream(stream core.PaymentStream) error {
+	if m.updatePaymentStreamFn != nil {
+		return m.updatePaymentStreamFn(stream)
+	}
+	return nil
+}
+
+func (m *MockStore) CheckOpenChannel(wallet, asset string) (string, bool, error) {
+	if m.checkOpenChannelFn != nil {
+		return m.checkOpenChannelFn(wallet, asset)
+	}
+	return "0x01", true, nil
+}
+
+func (m *MockStore) GetAppCount(_ string) (uint64, error) {
+	return 0, nil
+}
+
+func (m *MockStore) GetTotalUserStaked(_ string) (decimal.Decimal, error) {
+	return decimal.Zero, nil
+}
+
+func (m *MockStore) RecordAction(_ string, _ core.GatedAction) error {
+	return nil
+}
+
+func (m *MockStore) GetUserActionCount(_ string, _ core.GatedAction, _ time.Duration) (uint64, error) {
+	return 0, nil
+}
+
+func (m *MockStore) GetUserActionCounts(_ string, _ time.Duration) (map[core.GatedAction]uint64, error) {
+	return nil, nil
+}
+
+// MockMemoryStore implements the MemoryStore interface for testing.
+type MockMemoryStore struct {
+	Decimals map[string]uint8
+}
+
+func (m *MockMemoryStore) GetAssetDecimals(asset string) (uint8, error) {
+	decimals, ok := m.Decimals[asset]
+	if !ok {
+		return 0, fmt.Errorf("asset %s not found", asset)
+	}
+	return decimals, nil
+}
+
+type MockActionGateway struct {
+	Err error
+}
+
+func (m *MockActionGateway) AllowAction(_ action_gateway.Store, _ string, _ core.GatedAction) error {
+	return m.Err
+}
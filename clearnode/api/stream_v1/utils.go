@@ -0,0 +1,86 @@
+package stream_v1
+
+import (
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// toCorePaymentStreamAuthorization parses and validates the RPC representation of a payment stream authorization.
+func toCorePaymentStreamAuthorization(auth rpc.PaymentStreamAuthorizationV1) (core.PaymentStreamAuthorization, error) {
+	if !common.IsHexAddress(auth.Sender) {
+		return core.PaymentStreamAuthorization{}, rpc.Errorf("invalid sender address: %s", auth.Sender)
+	}
+	if !common.IsHexAddress(auth.Recipient) {
+		return core.PaymentStreamAuthorization{}, rpc.Errorf("invalid recipient address: %s", auth.Recipient)
+	}
+	if common.HexToAddress(auth.Sender) == common.HexToAddress(auth.Recipient) {
+		return core.PaymentStreamAuthorization{}, rpc.Errorf("sender and recipient wallets are the same")
+	}
+	if auth.Asset == "" {
+		return core.PaymentStreamAuthorization{}, rpc.Errorf("asset is required")
+	}
+
+	rate, err := decimal.NewFromString(auth.Rate)
+	if err != nil {
+		return core.PaymentStreamAuthorization{}, rpc.Errorf("invalid rate: %v", err)
+	}
+	if !rate.IsPositive() {
+		return core.PaymentStreamAuthorization{}, rpc.Errorf("rate must be positive")
+	}
+
+	streamCap, err := decimal.NewFromString(auth.Cap)
+	if err != nil {
+		return core.PaymentStreamAuthorization{}, rpc.Errorf("invalid cap: %v", err)
+	}
+	if !streamCap.IsPositive() {
+		return core.PaymentStreamAuthorization{}, rpc.Errorf("cap must be positive")
+	}
+
+	expiresAt, err := strconv.ParseUint(auth.ExpiresAt, 10, 64)
+	if err != nil {
+		return core.PaymentStreamAuthorization{}, rpc.Errorf("invalid expires_at: %v", err)
+	}
+
+	nonce, err := strconv.ParseUint(auth.Nonce, 10, 64)
+	if err != nil {
+		return core.PaymentStreamAuthorization{}, rpc.Errorf("invalid nonce: %v", err)
+	}
+
+	return core.PaymentStreamAuthorization{
+		Sender:    auth.Sender,
+		Recipient: auth.Recipient,
+		Asset:     auth.Asset,
+		Rate:      rate,
+		Cap:       streamCap,
+		ExpiresAt: expiresAt,
+		Nonce:     nonce,
+	}, nil
+}
+
+// corePaymentStreamToRPC converts a core payment stream to its RPC representation.
+func corePaymentStreamToRPC(stream core.PaymentStream) rpc.PaymentStreamV1 {
+	return rpc.PaymentStreamV1{
+		PaymentStreamAuthorizationV1: rpc.PaymentStreamAuthorizationV1{
+			Sender:    stream.Authorization.Sender,
+			Recipient: stream.Authorization.Recipient,
+			Asset:     stream.Authorization.Asset,
+			Rate:      stream.Authorization.Rate.String(),
+			Cap:       stream.Authorization.Cap.String(),
+			ExpiresAt: strconv.FormatUint(stream.Authorization.ExpiresAt, 10),
+			Nonce:     strconv.FormatUint(stream.Authorization.Nonce, 10),
+		},
+		ID:           stream.ID,
+		Status:       stream.Status.String(),
+		StartsAt:     strconv.FormatInt(stream.StartsAt.Unix(), 10),
+		EndsAt:       strconv.FormatInt(stream.EndsAt.Unix(), 10),
+		Settled:      stream.Settled.String(),
+		SettledUntil: strconv.FormatInt(stream.SettledUntil.Unix(), 10),
+		CreatedAt:    strconv.FormatInt(stream.CreatedAt.Unix(), 10),
+		UpdatedAt:    strconv.FormatInt(stream.UpdatedAt.Unix(), 10),
+	}
+}
@@ -7,24 +7,39 @@ import (
 	"time"
 
 	"github.com/layer-3/nitrolite/clearnode/store/database"
+	"github.com/layer-3/nitrolite/pkg/blockchain"
 	"github.com/layer-3/nitrolite/pkg/core"
 	"github.com/layer-3/nitrolite/pkg/log"
 )
 
 type BlockchainWorkerStore interface {
 	GetActions(limit uint8, chainID uint64) ([]database.BlockchainAction, error)
+	GetSentActions(limit uint8, chainID uint64) ([]database.BlockchainAction, error)
 	GetStateByID(stateID string) (*core.State, error)
 	GetChannelByID(channelID string) (*core.Channel, error)
-	Complete(actionID int64, txHash string) error
+	MarkSent(actionID int64, txHash string) error
+	UpdateSentTx(actionID int64, txHash string, nonce uint64) error
+	Confirm(actionID int64, txHash string) error
+	MarkReverted(actionID int64, txHash, err string) error
 	Fail(actionID int64, err string) error
 	FailNoRetry(actionID int64, err string) error
 	RecordAttempt(actionID int64, err string) error
 }
 
+// TxTracker reports the on-chain outcome of the transactions sent for blockchain actions.
+type TxTracker interface {
+	Check(ctx context.Context, txHash string) (blockchain.TxResult, error)
+	Release(nonce uint64)
+}
+
 type MetricsExporter interface {
 	IncBlockchainAction(asset string, blockchainID uint64, actionType string, success bool)
 }
 
+type ActionEventPublisher interface {
+	PublishBlockchainActionCompleted(ctx context.Context, actionType string, blockchainID uint64, txHash string, state core.State)
+}
+
 const (
 	// actionBatchSize determines how many blockchain actions to process at once
 	actionBatchSize = 20
@@ -38,19 +53,25 @@ const (
 
 type BlockchainWorker struct {
 	blockchainID uint64
-	client       core.BlockchainClient
+	client       core.BlockchainClientV2
+	txTracker    TxTracker
 	store        BlockchainWorkerStore
 	logger       log.Logger
 	metrics      MetricsExporter
+	publisher    ActionEventPublisher
+	tickInterval time.Duration
 }
 
-func NewBlockchainWorker(blockchainID uint64, client core.BlockchainClient, store BlockchainWorkerStore, logger log.Logger, m MetricsExporter) *BlockchainWorker {
+func NewBlockchainWorker(blockchainID uint64, client core.BlockchainClientV2, txTracker TxTracker, store BlockchainWorkerStore, logger log.Logger, m MetricsExporter, publisher ActionEventPublisher, tickInterval time.Duration) *BlockchainWorker {
 	return &BlockchainWorker{
 		blockchainID: blockchainID,
 		client:       client,
+		txTracker:    txTracker,
 		store:        store,
 		logger:       logger.WithName("bw").WithKV("blockchainID", blockchainID),
 		metrics:      m,
+		publisher:    publisher,
+		tickInterval: tickInterval,
 	}
 }
 
@@ -91,10 +112,11 @@ func (w *BlockchainWorker) Start(ctx context.Context, handleClosure func(err err
 }
 
 func (w *BlockchainWorker) run(ctx context.Context) {
-	ticker := time.NewTicker(blockchainWorkerTickInterval)
+	ticker := time.NewTicker(w.tickInterval)
 	defer ticker.Stop()
 
 	// Process immediately on start
+	w.trackSentActions(ctx)
 	w.processActions(ctx)
 
 	for {
@@ -103,6 +125,7 @@ func (w *BlockchainWorker) run(ctx context.Context) {
 			w.logger.Info("blockchain worker stopped")
 			return
 		case <-ticker.C:
+			w.trackSentActions(ctx)
 			for w.processActions(ctx) {
 			}
 		}
@@ -136,7 +159,7 @@ func (w *BlockchainWorker) processActions(ctx context.Context) bool {
 	return allSuccess
 }
 
-func (w *BlockchainWorker) processAction(_ context.Context, action database.BlockchainAction) bool {
+func (w *BlockchainWorker) processAction(ctx context.Context, action database.BlockchainAction) bool {
 	logger := w.logger.
 		WithKV("actionID", action.ID).
 		WithKV("type", action.Type).
@@ -164,19 +187,25 @@ func (w *BlockchainWorker) processAction(_ context.Context, action database.Bloc
 
 	switch action.Type {
 	case database.ActionTypeCheckpoint:
-		txHash, err = w.client.Checkpoint(*state)
+		txHash, err = w.client.Checkpoint(ctx, *state)
 
-	// case database.ActionTypeInitiateEscrowDeposit:
-	// 	txHash, err = w.processInitiateEscrow(state, w.client.InitiateEscrowDeposit)
+	case database.ActionTypeInitiateEscrowDeposit:
+		txHash, err = w.processInitiateEscrow(ctx, state, w.client.InitiateEscrowDeposit)
 
-	// case database.ActionTypeFinalizeEscrowDeposit:
-	// 	txHash, err = w.client.FinalizeEscrowDeposit(*state)
+	case database.ActionTypeFinalizeEscrowDeposit:
+		txHash, err = w.client.FinalizeEscrowDeposit(ctx, *state)
 
-	// case database.ActionTypeInitiateEscrowWithdrawal:
-	// 	txHash, err = w.processInitiateEscrow(state, w.client.InitiateEscrowWithdrawal)
+	case database.ActionTypeInitiateEscrowWithdrawal:
+		txHash, err = w.processInitiateEscrow(ctx, state, w.client.InitiateEscrowWithdrawal)
 
-	// case database.ActionTypeFinalizeEscrowWithdrawal:
-	// 	txHash, err = w.client.FinalizeEscrowWithdrawal(*state)
+	case database.ActionTypeFinalizeEscrowWithdrawal:
+		txHash, err = w.client.FinalizeEscrowWithdrawal(ctx, *state)
+
+	case database.ActionTypeInitiateMigration:
+		txHash, err = w.processInitiateMigration(ctx, state)
+
+	case database.ActionTypeFinalizeMigration:
+		txHash, err = w.client.FinalizeMigration(ctx, *state)
 
 	default:
 		err = fmt.Errorf("unknown action type: %d", action.Type)
@@ -188,37 +217,146 @@ func (w *BlockchainWorker) processAction(_ context.Context, action database.Bloc
 		return false
 	}
 
-	if completeErr := w.store.Complete(action.ID, txHash); completeErr != nil {
-		logger.Error("failed to mark action as completed", "error", completeErr)
+	if sentErr := w.store.MarkSent(action.ID, txHash); sentErr != nil {
+		logger.Error("failed to mark action as sent", "error", sentErr, "txHash", txHash)
 		return false
 	}
-	w.metrics.IncBlockchainAction(state.Asset, w.blockchainID, action.Type.String(), true)
-	logger.Info("action completed successfully", "txHash", txHash)
+	logger.Info("action transaction sent", "txHash", txHash)
 
 	return true
 }
 
-// func (w *BlockchainWorker) processInitiateEscrow(state *core.State, initiate func(core.ChannelDefinition, core.State) (string, error)) (string, error) {
-// 	if state.EscrowChannelID == nil {
-// 		return "", fmt.Errorf("state has no escrow channel ID")
-// 	}
+// trackSentActions checks the transactions of sent actions and records their final on-chain outcome.
+func (w *BlockchainWorker) trackSentActions(ctx context.Context) {
+	actions, err := w.store.GetSentActions(actionBatchSize, w.blockchainID)
+	if err != nil {
+		w.logger.Error("failed to get sent actions", "error", err)
+		return
+	}
 
-// 	channel, err := w.store.GetChannelByID(*state.EscrowChannelID)
-// 	if err != nil {
-// 		return "", fmt.Errorf("failed to get escrow channel: %w", err)
-// 	}
-// 	if channel == nil {
-// 		return "", fmt.Errorf("escrow channel not found: %s", *state.EscrowChannelID)
-// 	}
+	for _, action := range actions {
+		if ctx.Err() != nil {
+			w.logger.Info("context cancelled, stopping sent actions tracking")
+			return
+		}
 
-// 	def := core.ChannelDefinition{
-// 		Nonce:                 channel.Nonce,
-// 		Challenge:             channel.ChallengeDuration,
-// 		ApprovedSigValidators: channel.ApprovedSigValidators,
-// 	}
+		w.trackSentAction(ctx, action)
+	}
+}
 
-// 	return initiate(def, *state)
-// }
+func (w *BlockchainWorker) trackSentAction(ctx context.Context, action database.BlockchainAction) {
+	logger := w.logger.
+		WithKV("actionID", action.ID).
+		WithKV("type", action.Type).
+		WithKV("state", action.StateID).
+		WithKV("txHash", action.TxHash)
+
+	result, err := w.txTracker.Check(ctx, action.TxHash)
+	if err != nil {
+		logger.Error("failed to check action transaction", "error", err)
+		return
+	}
+
+	switch result.Status {
+	case blockchain.TxStatusPending:
+		logger.Debug("action transaction is pending", "currentTxHash", result.TxHash, "confirmations", result.Confirmations)
+		// The replacement hash and the nonce are kept, so the transaction is still tracked
+		// after a restart and its nonce can be reused if it gets dropped
+		if result.TxHash != action.TxHash || action.TxNonce == nil || *action.TxNonce != result.Nonce {
+			if updateErr := w.store.UpdateSentTx(action.ID, result.TxHash, result.Nonce); updateErr != nil {
+				logger.Error("failed to update action transaction", "error", updateErr)
+			}
+		}
+
+	case blockchain.TxStatusConfirmed:
+		state, err := w.store.GetStateByID(action.StateID)
+		if err != nil || state == nil {
+			logger.Error("failed to get state for confirmed action", "error", err)
+			return
+		}
+		if confirmErr := w.store.Confirm(action.ID, result.TxHash); confirmErr != nil {
+			logger.Error("failed to mark action as confirmed", "error", confirmErr)
+			return
+		}
+		w.metrics.IncBlockchainAction(state.Asset, w.blockchainID, action.Type.String(), true)
+		logger.Info("action completed successfully", "txHash", result.TxHash, "blockNumber", result.BlockNumber)
+
+		if w.publisher != nil {
+			w.publisher.PublishBlockchainActionCompleted(ctx, action.Type.String(), w.blockchainID, result.TxHash, *state)
+		}
+
+	case blockchain.TxStatusReverted:
+		errMsg := fmt.Sprintf("transaction %s reverted in block %d", result.TxHash, result.BlockNumber)
+		logger.Warn("action transaction reverted", "txHash", result.TxHash, "blockNumber", result.BlockNumber)
+		if revertErr := w.store.MarkReverted(action.ID, result.TxHash, errMsg); revertErr != nil {
+			logger.Error("failed to mark action as reverted", "error", revertErr)
+			return
+		}
+		w.incActionMetric(action, false, logger)
+
+	case blockchain.TxStatusDropped:
+		// The action goes back to pending and its transaction is sent again with the same nonce,
+		// unless the nonce was used by another transaction in the meantime
+		if action.TxNonce != nil {
+			w.txTracker.Release(*action.TxNonce)
+		}
+		w.handleActionError(action, fmt.Errorf("transaction %s was dropped", result.TxHash), logger)
+		w.incActionMetric(action, false, logger)
+	}
+}
+
+// incActionMetric records the action outcome in metrics, labelled with the asset of the action's state.
+func (w *BlockchainWorker) incActionMetric(action database.BlockchainAction, success bool, logger log.Logger) {
+	state, err := w.store.GetStateByID(action.StateID)
+	if err != nil || state == nil {
+		logger.Warn("failed to get state for action metrics", "error", err)
+		return
+	}
+	w.metrics.IncBlockchainAction(state.Asset, w.blockchainID, action.Type.String(), success)
+}
+
+// processInitiateEscrow sends an escrow initiation with the channel definition of the state's escrow channel.
+func (w *BlockchainWorker) processInitiateEscrow(ctx context.Context, state *core.State, initiate func(context.Context, core.ChannelDefinition, core.State) (string, error)) (string, error) {
+	if state.EscrowChannelID == nil {
+		return "", fmt.Errorf("state has no escrow channel ID")
+	}
+
+	channel, err := w.store.GetChannelByID(*state.EscrowChannelID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get escrow channel: %w", err)
+	}
+	if channel == nil {
+		return "", fmt.Errorf("escrow channel not found: %s", *state.EscrowChannelID)
+	}
+
+	return initiate(ctx, channelDefinition(*channel), *state)
+}
+
+// processInitiateMigration moves the state's home channel to this blockchain with its channel definition.
+func (w *BlockchainWorker) processInitiateMigration(ctx context.Context, state *core.State) (string, error) {
+	if state.HomeChannelID == nil {
+		return "", fmt.Errorf("state has no home channel ID")
+	}
+
+	channel, err := w.store.GetChannelByID(*state.HomeChannelID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get home channel: %w", err)
+	}
+	if channel == nil {
+		return "", fmt.Errorf("home channel not found: %s", *state.HomeChannelID)
+	}
+
+	return w.client.MigrateChannelHere(ctx, channelDefinition(*channel), *state)
+}
+
+// channelDefinition returns the on-chain definition of the channel.
+func channelDefinition(channel core.Channel) core.ChannelDefinition {
+	return core.ChannelDefinition{
+		Nonce:                 channel.Nonce,
+		Challenge:             channel.ChallengeDuration,
+		ApprovedSigValidators: channel.ApprovedSigValidators,
+	}
+}
 
 func (w *BlockchainWorker) handleActionError(action database.BlockchainAction, err error, logger log.Logger) {
 	if action.Retries >= maxActionRetries {
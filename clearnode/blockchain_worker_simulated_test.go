@@ -0,0 +1,326 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/clearnode/store/database"
+	"github.com/layer-3/nitrolite/pkg/blockchain/evm"
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/sign"
+)
+
+const (
+	simAsset          = "eth"
+	simNonce          = 42
+	simChallenge      = 86400
+	simSigValidators  = "0x1"
+	simHomeChainID    = 1
+	simHomeToken      = "0x3333333333333333333333333333333333333333"
+	simNativeDecimals = 18
+)
+
+// Escrow statuses as stored by ChannelHub.
+const (
+	simEscrowInitialized uint8 = 1
+	simEscrowFinalized   uint8 = 3
+)
+
+// channelHubDeployMu serializes ChannelHub deployments.
+// DeployChannelHub links the addresses of the libraries it deploys into evm.ChannelHubBin,
+// the unlinked bytecode is restored so that every backend deploys its own libraries.
+var channelHubDeployMu sync.Mutex
+
+// deploySimulatedChannelHub deploys ChannelHub with an ECDSAValidator as its default signature validator.
+func deploySimulatedChannelHub(t *testing.T, opts *bind.TransactOpts, backend *simulated.Backend) (common.Address, *evm.ChannelHub) {
+	t.Helper()
+
+	validator, _, _, err := evm.DeployECDSAValidator(opts, backend.Client())
+	require.NoError(t, err)
+	backend.Commit()
+
+	channelHubDeployMu.Lock()
+	unlinkedBin := evm.ChannelHubBin
+	address, _, channelHub, err := evm.DeployChannelHub(opts, backend.Client(), validator)
+	evm.ChannelHubBin = unlinkedBin
+	channelHubDeployMu.Unlock()
+	require.NoError(t, err)
+	backend.Commit()
+
+	return address, channelHub
+}
+
+type simulatedWorkerAssetStore struct{}
+
+func (simulatedWorkerAssetStore) GetAssetDecimals(string) (uint8, error) {
+	return simNativeDecimals, nil
+}
+
+func (simulatedWorkerAssetStore) GetTokenDecimals(uint64, string) (uint8, error) {
+	return simNativeDecimals, nil
+}
+
+func (simulatedWorkerAssetStore) GetTokenAddress(string, uint64) (string, error) {
+	return common.Address{}.Hex(), nil
+}
+
+type simulatedWorkerChain struct {
+	backend       *simulated.Backend
+	chainID       uint64
+	channelHub    *evm.ChannelHub
+	hubAddress    common.Address
+	nodeAddress   common.Address
+	userAddress   common.Address
+	homeChannelID string
+	statePacker   *core.StatePackerV1
+	nodeSigner    sign.Signer
+	userSigner    sign.Signer
+	worker        *BlockchainWorker
+	store         *workerTestStore
+}
+
+func newChannelSigner(t *testing.T, key *ecdsa.PrivateKey) sign.Signer {
+	t.Helper()
+
+	msgSigner, err := sign.NewEthereumMsgSigner(hexutil.Encode(crypto.FromECDSA(key)))
+	require.NoError(t, err)
+	signer, err := core.NewChannelDefaultSigner(msgSigner)
+	require.NoError(t, err)
+	return signer
+}
+
+func newSimulatedWorkerChain(t *testing.T) *simulatedWorkerChain {
+	t.Helper()
+
+	nodeKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	nodeAddress := crypto.PubkeyToAddress(nodeKey.PublicKey)
+	userKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	userAddress := crypto.PubkeyToAddress(userKey.PublicKey)
+
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		nodeAddress: {Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(1e18))},
+	})
+	t.Cleanup(func() { backend.Close() })
+
+	chainID, err := backend.Client().ChainID(context.Background())
+	require.NoError(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(nodeKey, chainID)
+	require.NoError(t, err)
+	hubAddress, channelHub := deploySimulatedChannelHub(t, opts, backend)
+
+	txSigner, err := sign.NewEthereumRawSigner(hexutil.Encode(crypto.FromECDSA(nodeKey)))
+	require.NoError(t, err)
+
+	txManager := evm.NewTxManager(backend.Client(), txSigner, chainID.Uint64(), evm.TxManagerConfig{
+		Confirmations:  2,
+		StuckTimeout:   time.Hour,
+		FeeBumpPercent: 10,
+		MaxFeeBumps:    1,
+	})
+	client, err := evm.NewBlockchainClient(hubAddress, backend.Client(), txSigner, chainID.Uint64(),
+		nodeAddress.Hex(), simulatedWorkerAssetStore{}, evm.ClientTxManager{TxManager: txManager})
+	require.NoError(t, err)
+
+	homeChannelID, err := core.GetHomeChannelID(nodeAddress.Hex(), userAddress.Hex(), simAsset, simNonce, simChallenge, simSigValidators)
+	require.NoError(t, err)
+
+	store := &workerTestStore{
+		actions:  map[int64]*database.BlockchainAction{},
+		states:   map[string]*core.State{},
+		channels: map[string]*core.Channel{},
+	}
+
+	return &simulatedWorkerChain{
+		backend:       backend,
+		chainID:       chainID.Uint64(),
+		channelHub:    channelHub,
+		hubAddress:    hubAddress,
+		nodeAddress:   nodeAddress,
+		userAddress:   userAddress,
+		homeChannelID: homeChannelID,
+		statePacker:   core.NewStatePackerV1(simulatedWorkerAssetStore{}),
+		nodeSigner:    newChannelSigner(t, nodeKey),
+		userSigner:    newChannelSigner(t, userKey),
+		worker:        NewBlockchainWorker(chainID.Uint64(), client, txManager, store, log.NewNoopLogger(), &workerTestMetrics{}, &workerTestPublisher{}, blockchainWorkerTickInterval),
+		store:         store,
+	}
+}
+
+// escrowChannelID returns the escrow channel opened on this chain by the state with the given version.
+// The escrow channel is stored with the definition of the home channel.
+func (c *simulatedWorkerChain) escrowChannelID(t *testing.T, version uint64) string {
+	t.Helper()
+
+	escrowChannelID, err := core.GetEscrowChannelID(c.homeChannelID, version)
+	require.NoError(t, err)
+	c.store.channels[escrowChannelID] = core.NewChannel(escrowChannelID, c.userAddress.Hex(), simAsset, core.ChannelTypeEscrow,
+		c.chainID, common.Address{}.Hex(), simNonce, simChallenge, simSigValidators)
+	return escrowChannelID
+}
+
+// newEscrowState returns a cross-chain state signed by the user and the node.
+// Ledger amounts are given in whole native tokens as [userBalance, userNetFlow, nodeBalance, nodeNetFlow].
+func (c *simulatedWorkerChain) newEscrowState(t *testing.T, version uint64, transitionType core.TransitionType, escrowChannelID string, home, escrow [4]int64) *core.State {
+	t.Helper()
+
+	homeChannelID := c.homeChannelID
+	state := &core.State{
+		ID:              core.GetStateID(c.userAddress.Hex(), simAsset, 0, version),
+		Transition:      *core.NewTransition(transitionType, crypto.Keccak256Hash([]byte(transitionType.String())).Hex(), escrowChannelID, decimal.NewFromInt(1)),
+		Asset:           simAsset,
+		UserWallet:      c.userAddress.Hex(),
+		Version:         version,
+		HomeChannelID:   &homeChannelID,
+		EscrowChannelID: &escrowChannelID,
+		HomeLedger:      simLedger(simHomeChainID, simHomeToken, home),
+	}
+	escrowLedger := simLedger(c.chainID, common.Address{}.Hex(), escrow)
+	state.EscrowLedger = &escrowLedger
+
+	packed, err := c.statePacker.PackState(*state)
+	require.NoError(t, err)
+	userSig, err := c.userSigner.Sign(packed)
+	require.NoError(t, err)
+	nodeSig, err := c.nodeSigner.Sign(packed)
+	require.NoError(t, err)
+	userSigStr, nodeSigStr := userSig.String(), nodeSig.String()
+	state.UserSig = &userSigStr
+	state.NodeSig = &nodeSigStr
+
+	c.store.states[state.ID] = state
+	return state
+}
+
+func simLedger(blockchainID uint64, token string, amounts [4]int64) core.Ledger {
+	return core.Ledger{
+		TokenAddress: token,
+		BlockchainID: blockchainID,
+		UserBalance:  decimal.NewFromInt(amounts[0]),
+		UserNetFlow:  decimal.NewFromInt(amounts[1]),
+		NodeBalance:  decimal.NewFromInt(amounts[2]),
+		NodeNetFlow:  decimal.NewFromInt(amounts[3]),
+	}
+}
+
+// runAction lets the worker send the action for the state and waits until its transaction is confirmed.
+func (c *simulatedWorkerChain) runAction(t *testing.T, actionType database.BlockchainActionType, state *core.State) {
+	t.Helper()
+
+	id := int64(len(c.store.actions) + 1)
+	c.store.actions[id] = &database.BlockchainAction{
+		ID:      id,
+		Type:    actionType,
+		StateID: state.ID,
+		Status:  database.BlockchainActionStatusPending,
+	}
+	action := c.store.actions[id]
+
+	require.True(t, c.worker.processActions(context.Background()), action.Error)
+	require.Equal(t, database.BlockchainActionStatusSent, action.Status)
+
+	c.backend.Commit()
+	c.worker.trackSentActions(context.Background())
+	assert.Equal(t, database.BlockchainActionStatusSent, action.Status, "not enough confirmations yet")
+
+	c.backend.Commit()
+	c.worker.trackSentActions(context.Background())
+	require.Equal(t, database.BlockchainActionStatusConfirmed, action.Status, action.Error)
+}
+
+func (c *simulatedWorkerChain) nativeBalance(t *testing.T, account common.Address) *big.Int {
+	t.Helper()
+
+	balance, err := c.backend.Client().BalanceAt(context.Background(), account, nil)
+	require.NoError(t, err)
+	return balance
+}
+
+func TestBlockchainWorker_EscrowActions_Simulated(t *testing.T) {
+	chain := newSimulatedWorkerChain(t)
+	native := common.Address{}
+	amount := decimal.NewFromInt(1).Shift(simNativeDecimals).BigInt()
+
+	// Escrow deposit: the user's funds are locked on this chain while the node locks the same amount on the home chain
+	depositID := chain.escrowChannelID(t, 2)
+	chain.runAction(t, database.ActionTypeInitiateEscrowDeposit,
+		chain.newEscrowState(t, 2, core.TransitionTypeMutualLock, depositID, [4]int64{0, 0, 1, 1}, [4]int64{1, 1, 0, 0}))
+
+	deposit, err := chain.channelHub.GetEscrowDepositData(nil, common.HexToHash(depositID))
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToHash(chain.homeChannelID), common.Hash(deposit.ChannelId))
+	assert.Equal(t, simEscrowInitialized, deposit.Status)
+	assert.Equal(t, amount, deposit.LockedAmount)
+	assert.Equal(t, uint64(2), deposit.InitState.Version)
+	assert.Equal(t, amount, chain.nativeBalance(t, chain.hubAddress))
+
+	// Once the deposit is credited on the home chain, the locked funds are released to the node's vault
+	chain.runAction(t, database.ActionTypeFinalizeEscrowDeposit,
+		chain.newEscrowState(t, 3, core.TransitionTypeEscrowDeposit, depositID, [4]int64{1, 0, 0, 1}, [4]int64{0, 1, 0, -1}))
+
+	deposit, err = chain.channelHub.GetEscrowDepositData(nil, common.HexToHash(depositID))
+	require.NoError(t, err)
+	assert.Equal(t, simEscrowFinalized, deposit.Status)
+	assert.Zero(t, deposit.LockedAmount.Sign())
+	nodeBalance, err := chain.channelHub.GetAccountBalance(nil, chain.nodeAddress, native)
+	require.NoError(t, err)
+	assert.Equal(t, amount, nodeBalance)
+
+	// Escrow withdrawal: the node locks funds from its vault for the user
+	withdrawalID := chain.escrowChannelID(t, 4)
+	chain.runAction(t, database.ActionTypeInitiateEscrowWithdrawal,
+		chain.newEscrowState(t, 4, core.TransitionTypeEscrowLock, withdrawalID, [4]int64{1, 0, 0, 1}, [4]int64{0, 0, 1, 1}))
+
+	withdrawal, err := chain.channelHub.GetEscrowWithdrawalData(nil, common.HexToHash(withdrawalID))
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToHash(chain.homeChannelID), common.Hash(withdrawal.ChannelId))
+	assert.Equal(t, simEscrowInitialized, withdrawal.Status)
+	assert.Equal(t, amount, withdrawal.LockedAmount)
+	nodeBalance, err = chain.channelHub.GetAccountBalance(nil, chain.nodeAddress, native)
+	require.NoError(t, err)
+	assert.Zero(t, nodeBalance.Sign())
+
+	// Once the withdrawal is debited on the home chain, the locked funds are sent to the user
+	chain.runAction(t, database.ActionTypeFinalizeEscrowWithdrawal,
+		chain.newEscrowState(t, 5, core.TransitionTypeEscrowWithdraw, withdrawalID, [4]int64{0, 0, 0, 0}, [4]int64{0, -1, 0, 1}))
+
+	withdrawal, err = chain.channelHub.GetEscrowWithdrawalData(nil, common.HexToHash(withdrawalID))
+	require.NoError(t, err)
+	assert.Equal(t, simEscrowFinalized, withdrawal.Status)
+	assert.Zero(t, withdrawal.LockedAmount.Sign())
+	assert.Equal(t, amount, chain.nativeBalance(t, chain.userAddress))
+	assert.Zero(t, chain.nativeBalance(t, chain.hubAddress).Sign())
+}
+
+func TestBlockchainWorker_InitiateEscrow_ChannelNotFound(t *testing.T) {
+	chain := newSimulatedWorkerChain(t)
+	unknownChannelID := "0x00000000000000000000000000000000000000000000000000000000000000ff"
+	state := chain.newEscrowState(t, 2, core.TransitionTypeMutualLock, unknownChannelID, [4]int64{0, 0, 1, 1}, [4]int64{1, 1, 0, 0})
+	chain.store.actions[1] = &database.BlockchainAction{
+		ID:      1,
+		Type:    database.ActionTypeInitiateEscrowDeposit,
+		StateID: state.ID,
+		Status:  database.BlockchainActionStatusPending,
+	}
+
+	assert.False(t, chain.worker.processActions(context.Background()))
+	assert.Equal(t, database.BlockchainActionStatusPending, chain.store.actions[1].Status)
+	assert.Equal(t, uint8(1), chain.store.actions[1].Retries)
+	assert.Contains(t, chain.store.actions[1].Error, "escrow channel not found")
+}
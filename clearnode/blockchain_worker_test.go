@@ -1,5 +1,19 @@
 package main
 
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/clearnode/store/database"
+	"github.com/layer-3/nitrolite/pkg/blockchain"
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+)
+
 // type MockCustody struct {
 // 	checkpointFn func() (common.Hash, error)
 // 	mu           sync.Mutex
@@ -164,3 +178,205 @@ package main
 // 		assert.Contains(t, updatedAction.Error, fmt.Sprintf("failed after %d retries: RPC still down", maxActionRetries))
 // 	})
 // }
+
+type workerTestClient struct {
+	core.BlockchainClientV2
+	checkpointFn func(state core.State) (string, error)
+}
+
+func (c *workerTestClient) Checkpoint(_ context.Context, state core.State) (string, error) {
+	return c.checkpointFn(state)
+}
+
+type workerTestTxTracker struct {
+	results  map[string]blockchain.TxResult
+	released []uint64
+}
+
+func (t *workerTestTxTracker) Check(_ context.Context, txHash string) (blockchain.TxResult, error) {
+	result, ok := t.results[txHash]
+	if !ok {
+		return blockchain.TxResult{}, errors.New("rpc unavailable")
+	}
+	return result, nil
+}
+
+func (t *workerTestTxTracker) Release(nonce uint64) {
+	t.released = append(t.released, nonce)
+}
+
+// workerTestStore keeps blockchain actions in memory.
+type workerTestStore struct {
+	actions  map[int64]*database.BlockchainAction
+	states   map[string]*core.State
+	channels map[string]*core.Channel
+}
+
+func (s *workerTestStore) byStatus(status database.BlockchainActionStatus) []database.BlockchainAction {
+	var actions []database.BlockchainAction
+	for id := int64(1); id <= int64(len(s.actions)); id++ {
+		if a := s.actions[id]; a != nil && a.Status == status {
+			actions = append(actions, *a)
+		}
+	}
+	return actions
+}
+
+func (s *workerTestStore) GetActions(uint8, uint64) ([]database.BlockchainAction, error) {
+	return s.byStatus(database.BlockchainActionStatusPending), nil
+}
+
+func (s *workerTestStore) GetSentActions(uint8, uint64) ([]database.BlockchainAction, error) {
+	return s.byStatus(database.BlockchainActionStatusSent), nil
+}
+
+func (s *workerTestStore) GetStateByID(stateID string) (*core.State, error) {
+	return s.states[stateID], nil
+}
+
+func (s *workerTestStore) GetChannelByID(channelID string) (*core.Channel, error) {
+	return s.channels[channelID], nil
+}
+
+func (s *workerTestStore) update(actionID int64, status database.BlockchainActionStatus, txHash, err string, retry bool) error {
+	a := s.actions[actionID]
+	a.Status = status
+	a.Error = err
+	if txHash != "" {
+		a.TxHash = txHash
+	}
+	if retry {
+		a.Retries++
+	}
+	return nil
+}
+
+func (s *workerTestStore) MarkSent(actionID int64, txHash string) error {
+	s.actions[actionID].TxNonce = nil
+	return s.update(actionID, database.BlockchainActionStatusSent, txHash, "", false)
+}
+
+func (s *workerTestStore) UpdateSentTx(actionID int64, txHash string, nonce uint64) error {
+	s.actions[actionID].TxHash = txHash
+	s.actions[actionID].TxNonce = &nonce
+	return nil
+}
+
+func (s *workerTestStore) Confirm(actionID int64, txHash string) error {
+	return s.update(actionID, database.BlockchainActionStatusConfirmed, txHash, "", false)
+}
+
+func (s *workerTestStore) MarkReverted(actionID int64, txHash, err string) error {
+	return s.update(actionID, database.BlockchainActionStatusReverted, txHash, err, false)
+}
+
+func (s *workerTestStore) Fail(actionID int64, err string) error {
+	return s.update(actionID, database.BlockchainActionStatusFailed, "", err, true)
+}
+
+func (s *workerTestStore) FailNoRetry(actionID int64, err string) error {
+	return s.update(actionID, database.BlockchainActionStatusFailed, "", err, false)
+}
+
+func (s *workerTestStore) RecordAttempt(actionID int64, err string) error {
+	return s.update(actionID, database.BlockchainActionStatusPending, "", err, true)
+}
+
+type workerTestMetrics struct {
+	results []bool
+}
+
+func (m *workerTestMetrics) IncBlockchainAction(_ string, _ uint64, _ string, success bool) {
+	m.results = append(m.results, success)
+}
+
+type workerTestPublisher struct {
+	txHashes []string
+}
+
+func (p *workerTestPublisher) PublishBlockchainActionCompleted(_ context.Context, _ string, _ uint64, txHash string, _ core.State) {
+	p.txHashes = append(p.txHashes, txHash)
+}
+
+func TestBlockchainWorker_TxLifecycle(t *testing.T) {
+	store := &workerTestStore{
+		actions: map[int64]*database.BlockchainAction{},
+		states:  map[string]*core.State{},
+	}
+	for i, stateID := range []string{"0xconfirmed", "0xreverted", "0xdropped", "0xpending"} {
+		store.states[stateID] = &core.State{ID: stateID, Asset: "usdc"}
+		store.actions[int64(i+1)] = &database.BlockchainAction{
+			ID:      int64(i + 1),
+			Type:    database.ActionTypeCheckpoint,
+			StateID: stateID,
+			Status:  database.BlockchainActionStatusPending,
+		}
+	}
+
+	client := &workerTestClient{checkpointFn: func(state core.State) (string, error) {
+		return "0xtx" + state.ID[2:], nil
+	}}
+	tracker := &workerTestTxTracker{results: map[string]blockchain.TxResult{
+		"0xtxconfirmed": {Status: blockchain.TxStatusConfirmed, TxHash: "0xtxconfirmedbumped", BlockNumber: 10},
+		"0xtxreverted":  {Status: blockchain.TxStatusReverted, TxHash: "0xtxreverted", BlockNumber: 11},
+		"0xtxdropped":   {Status: blockchain.TxStatusDropped, TxHash: "0xtxdropped"},
+		"0xtxpending":   {Status: blockchain.TxStatusPending, TxHash: "0xtxpendingbumped", Nonce: 4},
+	}}
+	metrics := &workerTestMetrics{}
+	publisher := &workerTestPublisher{}
+	worker := NewBlockchainWorker(1, client, tracker, store, log.NewNoopLogger(), metrics, publisher, blockchainWorkerTickInterval)
+
+	// Sending a transaction doesn't complete the action
+	assert.True(t, worker.processActions(context.Background()))
+	for _, action := range store.actions {
+		assert.Equal(t, database.BlockchainActionStatusSent, action.Status)
+		assert.Equal(t, "0xtx"+action.StateID[2:], action.TxHash)
+	}
+	assert.Empty(t, metrics.results)
+	assert.Empty(t, publisher.txHashes)
+
+	// The nonce of the dropped transaction was recorded while it was pending
+	droppedNonce := uint64(3)
+	store.actions[3].TxNonce = &droppedNonce
+
+	worker.trackSentActions(context.Background())
+
+	assert.Equal(t, database.BlockchainActionStatusConfirmed, store.actions[1].Status)
+	assert.Equal(t, "0xtxconfirmedbumped", store.actions[1].TxHash)
+	assert.Equal(t, []string{"0xtxconfirmedbumped"}, publisher.txHashes)
+
+	assert.Equal(t, database.BlockchainActionStatusReverted, store.actions[2].Status)
+	assert.Contains(t, store.actions[2].Error, "reverted in block 11")
+
+	// Dropped transactions are sent again with the same nonce
+	assert.Equal(t, database.BlockchainActionStatusPending, store.actions[3].Status)
+	assert.Equal(t, uint8(1), store.actions[3].Retries)
+	assert.Equal(t, []uint64{droppedNonce}, tracker.released)
+
+	// The fee-bumped replacement of a pending transaction is recorded with its nonce
+	assert.Equal(t, database.BlockchainActionStatusSent, store.actions[4].Status)
+	assert.Equal(t, "0xtxpendingbumped", store.actions[4].TxHash)
+	require.NotNil(t, store.actions[4].TxNonce)
+	assert.Equal(t, uint64(4), *store.actions[4].TxNonce)
+	assert.Equal(t, []bool{true, false, false}, metrics.results)
+}
+
+func TestBlockchainWorker_CheckpointError(t *testing.T) {
+	store := &workerTestStore{
+		actions: map[int64]*database.BlockchainAction{
+			1: {ID: 1, Type: database.ActionTypeCheckpoint, StateID: "0xstate", Status: database.BlockchainActionStatusPending},
+		},
+		states: map[string]*core.State{"0xstate": {ID: "0xstate", Asset: "usdc"}},
+	}
+	client := &workerTestClient{checkpointFn: func(core.State) (string, error) {
+		return "", errors.New("execution reverted")
+	}}
+	metrics := &workerTestMetrics{}
+	worker := NewBlockchainWorker(1, client, &workerTestTxTracker{}, store, log.NewNoopLogger(), metrics, nil, blockchainWorkerTickInterval)
+
+	assert.False(t, worker.processActions(context.Background()))
+	assert.Equal(t, database.BlockchainActionStatusPending, store.actions[1].Status)
+	assert.Equal(t, uint8(1), store.actions[1].Retries)
+	assert.Equal(t, "execution reverted", store.actions[1].Error)
+	assert.Equal(t, []bool{false}, metrics.results)
+}
@@ -0,0 +1,27 @@
+-- +goose Up
+
+-- Conditional Transfers table: Hash-time-locked transfers between users
+CREATE TABLE conditional_transfers_v1 (
+    id CHAR(66) PRIMARY KEY, -- Deterministic hash: Hash(LockStateID, Receiver, HashLock, ExpiresAt)
+    sender CHAR(42) NOT NULL,
+    receiver CHAR(42) NOT NULL,
+    asset VARCHAR(20) NOT NULL,
+    amount NUMERIC(38, 18) NOT NULL,
+    hash_lock CHAR(66) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    status SMALLINT NOT NULL, -- ConditionalTransferStatus enum: 0=void, 1=locked, 2=claimed, 3=refunded
+    preimage CHAR(66),
+    lock_state_id CHAR(66) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_conditional_transfers_v1_status_expires ON conditional_transfers_v1(status, expires_at);
+CREATE INDEX idx_conditional_transfers_v1_sender ON conditional_transfers_v1(sender);
+CREATE INDEX idx_conditional_transfers_v1_receiver ON conditional_transfers_v1(receiver);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_conditional_transfers_v1_receiver;
+DROP INDEX IF EXISTS idx_conditional_transfers_v1_sender;
+DROP INDEX IF EXISTS idx_conditional_transfers_v1_status_expires;
+DROP TABLE IF EXISTS conditional_transfers_v1;
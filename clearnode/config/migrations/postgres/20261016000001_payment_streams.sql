@@ -0,0 +1,31 @@
+-- +goose Up
+
+-- Payment Streams table: Sender-authorized recurring payments settled by the node as transfers
+CREATE TABLE payment_streams_v1 (
+    id CHAR(66) PRIMARY KEY, -- Deterministic hash of the stream authorization
+    sender CHAR(42) NOT NULL,
+    recipient CHAR(42) NOT NULL,
+    asset VARCHAR(20) NOT NULL,
+    rate NUMERIC(38, 18) NOT NULL, -- Amount accrued per second
+    cap NUMERIC(38, 18) NOT NULL, -- Maximum total amount transferred by the stream
+    expires_at BIGINT NOT NULL, -- Unix timestamp (seconds) signed in the authorization
+    nonce BIGINT NOT NULL,
+    sender_sig TEXT NOT NULL,
+    status SMALLINT NOT NULL, -- PaymentStreamStatus enum: 0=void, 1=active, 2=cancelled, 3=completed
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    settled NUMERIC(38, 18) NOT NULL DEFAULT 0,
+    settled_until TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_payment_streams_v1_status_settled_until ON payment_streams_v1(status, settled_until);
+CREATE INDEX idx_payment_streams_v1_sender ON payment_streams_v1(sender);
+CREATE INDEX idx_payment_streams_v1_recipient ON payment_streams_v1(recipient);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_payment_streams_v1_recipient;
+DROP INDEX IF EXISTS idx_payment_streams_v1_sender;
+DROP INDEX IF EXISTS idx_payment_streams_v1_status_settled_until;
+DROP TABLE IF EXISTS payment_streams_v1;
@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- Blockchain actions now go through pending (0) -> sent (3) -> confirmed (4) or reverted (5).
+-- Sent actions are polled by the blockchain worker until their transactions are final.
+CREATE INDEX idx_blockchain_actions_sent ON blockchain_actions(status, created_at) WHERE status = 3;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_blockchain_actions_sent;
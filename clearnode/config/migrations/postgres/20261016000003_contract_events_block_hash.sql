@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- The block hash lets listeners detect processed events that were removed by a chain reorganization.
+-- Events stored before this migration have an empty block hash and are treated as final.
+ALTER TABLE contract_events ADD COLUMN block_hash VARCHAR(66) NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE contract_events DROP COLUMN IF EXISTS block_hash;
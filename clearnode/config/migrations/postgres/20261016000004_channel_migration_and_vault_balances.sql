@@ -0,0 +1,19 @@
+-- +goose Up
+
+-- ChannelMigrationStatus enum: 0=none, 1=initiated, 2=migrated_out, 3=completed
+ALTER TABLE channels ADD COLUMN migration_status SMALLINT NOT NULL DEFAULT 0;
+
+-- Vault balances table: Deposited minus withdrawn amounts per wallet and token in the ChannelHub vault of each blockchain
+CREATE TABLE vault_balances_v1 (
+    wallet CHAR(42) NOT NULL,
+    blockchain_id NUMERIC(20,0) NOT NULL,
+    token CHAR(42) NOT NULL,
+    balance NUMERIC(78, 18) NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (wallet, blockchain_id, token)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS vault_balances_v1;
+ALTER TABLE channels DROP COLUMN IF EXISTS migration_status;
@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- Nonce of the action's transaction, kept across fee-bumped replacements.
+-- The nonce of a dropped transaction is reused when the action is sent again, so no nonce gap is left.
+ALTER TABLE blockchain_actions ADD COLUMN transaction_nonce NUMERIC(20,0);
+
+-- +goose Down
+ALTER TABLE blockchain_actions DROP COLUMN IF EXISTS transaction_nonce;
@@ -0,0 +1,19 @@
+-- +goose Up
+
+-- Changes made while handling contract events, reverted when a chain reorganization removes the event.
+-- effect_data holds the JSON encoded change, e.g. the channel before an update or a vault balance delta.
+CREATE TABLE contract_event_effects (
+    id BIGSERIAL PRIMARY KEY,
+    contract_address CHAR(42) NOT NULL,
+    blockchain_id NUMERIC(20,0) NOT NULL,
+    block_number NUMERIC(20,0) NOT NULL,
+    block_hash VARCHAR(66) NOT NULL DEFAULT '',
+    log_index BIGINT NOT NULL DEFAULT 0,
+    effect_data TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_contract_event_effects_block ON contract_event_effects(blockchain_id, contract_address, block_number);
+
+-- +goose Down
+DROP TABLE IF EXISTS contract_event_effects;
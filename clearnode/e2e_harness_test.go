@@ -0,0 +1,615 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/program"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+
+	"github.com/layer-3/nitrolite/clearnode/action_gateway"
+	"github.com/layer-3/nitrolite/clearnode/fee_engine"
+	"github.com/layer-3/nitrolite/clearnode/metrics"
+	"github.com/layer-3/nitrolite/clearnode/store/database"
+	"github.com/layer-3/nitrolite/clearnode/store/memory"
+	"github.com/layer-3/nitrolite/pkg/app"
+	"github.com/layer-3/nitrolite/pkg/blockchain"
+	"github.com/layer-3/nitrolite/pkg/blockchain/evm"
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/layer-3/nitrolite/pkg/sign"
+	sdk "github.com/layer-3/nitrolite/sdk/go"
+)
+
+const (
+	// e2eAsset is the native coin of the simulated chain.
+	e2eAsset          = "eth"
+	e2eChallenge      = 3600
+	e2eBlockchainName = "simulated"
+	e2eMiningInterval = 10 * time.Millisecond
+	// e2eNodeLiquidity is the ether the node has in the ChannelHub vault to fund withdrawals and transfers received.
+	e2eNodeLiquidity = 100
+	// e2eWorkerTickInterval is how often the node's blockchain workers pick up scheduled actions.
+	e2eWorkerTickInterval = 100 * time.Millisecond
+	e2eEventualTimeout    = 10 * time.Second
+	// e2eUnlockPeriod is the time staked tokens take to unlock in the Locking contract.
+	e2eUnlockPeriod = 24 * time.Hour
+)
+
+// e2eHarness runs a clearnode against in-process simulated chains with ChannelHub deployed and an SQLite database.
+// SDK clients connect to it over WebSocket, so that tests exercise the same path as real users with no network.
+// The first chain is the home chain of the users, its methods are promoted to the harness.
+type e2eHarness struct {
+	*e2eChain
+	t       *testing.T
+	chains  []*e2eChain
+	bb      *Backbone
+	nodeURL string
+}
+
+// e2eChain is a simulated chain with ChannelHub and the Locking contract deployed.
+type e2eChain struct {
+	t          *testing.T
+	chain      *simulated.Backend
+	chainID    uint64
+	chainURL   string
+	channelHub common.Address
+	locking    *evm.AppRegistry
+	lockingAt  common.Address
+	faucet     *bind.TransactOpts
+}
+
+// newE2EHarness deploys ChannelHub on a new simulated chain and starts a clearnode settling on it.
+// Blocks are mined as soon as transactions are pending. Everything stops when the test ends.
+func newE2EHarness(t *testing.T) *e2eHarness {
+	t.Helper()
+
+	return newE2EHarnessWithChains(t, 1)
+}
+
+// newE2EHarnessWithChains is newE2EHarness with the given number of simulated chains, each with its own chain ID.
+// The configure functions can adjust the node's backbone before it starts.
+func newE2EHarnessWithChains(t *testing.T, chainCount int, configure ...func(*Backbone)) *e2eHarness {
+	t.Helper()
+
+	nodeKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	nodeAddress := crypto.PubkeyToAddress(nodeKey.PublicKey)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	h := &e2eHarness{t: t}
+	for i := 0; i < chainCount; i++ {
+		h.chains = append(h.chains, newE2EChain(t, ctx, params.AllDevChainProtocolChanges.ChainID.Uint64()+uint64(i), nodeAddress))
+	}
+	h.e2eChain = h.chains[0]
+	h.bb = h.newBackbone(nodeKey)
+	for _, fn := range configure {
+		fn(h.bb)
+	}
+	for _, chain := range h.chains {
+		chain.depositToVault(nodeAddress, ether(e2eNodeLiquidity))
+	}
+
+	require.NoError(t, startNode(ctx, ctx, h.bb))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ws", h.bb.RpcNode.ServeHTTP)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	h.nodeURL = "ws" + strings.TrimPrefix(server.URL, "http") + "/ws"
+
+	return h
+}
+
+// newE2EChain deploys ChannelHub and the Locking contract on a new simulated chain with the chain ID, funding the node for gas.
+// Blocks are mined as soon as transactions are pending, until ctx is done.
+func newE2EChain(t *testing.T, ctx context.Context, chainID uint64, nodeAddress common.Address) *e2eChain {
+	t.Helper()
+
+	faucetKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	wsPort := freePort(t)
+	chain := simulated.NewBackend(types.GenesisAlloc{
+		crypto.PubkeyToAddress(faucetKey.PublicKey): {Balance: ether(1_000_000)},
+		nodeAddress: {Balance: ether(1_000)},
+	}, func(nodeConf *node.Config, ethConf *ethconfig.Config) {
+		nodeConf.WSHost = "127.0.0.1"
+		nodeConf.WSPort = wsPort
+		nodeConf.WSModules = []string{"eth", "net", "web3"}
+
+		chainConfig := *ethConf.Genesis.Config
+		chainConfig.ChainID = new(big.Int).SetUint64(chainID)
+		ethConf.Genesis.Config = &chainConfig
+	})
+	t.Cleanup(func() { chain.Close() })
+
+	faucet, err := bind.NewKeyedTransactorWithChainID(faucetKey, new(big.Int).SetUint64(chainID))
+	require.NoError(t, err)
+
+	// The deployment commits its own blocks, the miner only starts once it is done:
+	// concurrent commits can deadlock the simulated chain.
+	channelHub, _ := deploySimulatedChannelHub(t, faucet, chain)
+	lockingAt, locking := deployLocking(t, faucet, chain)
+
+	// The miner is stopped before the chain is closed, so that it never uses a closed chain.
+	miningCtx, stopMining := context.WithCancel(ctx)
+	mined := make(chan struct{})
+	go func() {
+		defer close(mined)
+		mine(miningCtx, chain)
+	}()
+	t.Cleanup(func() {
+		stopMining()
+		<-mined
+	})
+
+	return &e2eChain{
+		t:          t,
+		chain:      chain,
+		chainID:    chainID,
+		chainURL:   fmt.Sprintf("ws://127.0.0.1:%d", wsPort),
+		channelHub: channelHub,
+		locking:    locking,
+		lockingAt:  lockingAt,
+		faucet:     faucet,
+	}
+}
+
+// newBackbone assembles the node's backbone the way InitBackbone does, from in-memory configuration.
+func (h *e2eHarness) newBackbone(nodeKey *ecdsa.PrivateKey) *Backbone {
+	t := h.t
+
+	txSigner, err := sign.NewEthereumRawSigner(hexutil.Encode(crypto.FromECDSA(nodeKey)))
+	require.NoError(t, err)
+	stateSigner, err := sign.NewEthereumMsgSignerFromRaw(txSigner)
+	require.NoError(t, err)
+	nodeAddress := stateSigner.PublicKey().Address().String()
+
+	db, cleanup := database.SetupTestDB(t)
+	t.Cleanup(cleanup)
+	// Lookups of missing records are part of the normal flow, don't log them
+	db = db.Session(&gorm.Session{Logger: gormlogger.Discard})
+
+	tokens := make([]memory.TokenConfig, 0, len(h.chains))
+	blockchains := make(map[uint64]memory.BlockchainConfig, len(h.chains))
+	blockchainRPCs := make(map[uint64][]string, len(h.chains))
+	for i, chain := range h.chains {
+		tokens = append(tokens, memory.TokenConfig{
+			Name:         "Ether",
+			Symbol:       e2eAsset,
+			BlockchainID: chain.chainID,
+			Address:      common.Address{}.Hex(),
+			Decimals:     18,
+		})
+		blockchains[chain.chainID] = memory.BlockchainConfig{
+			Name:                   fmt.Sprintf("%s_%d", e2eBlockchainName, i),
+			ID:                     chain.chainID,
+			Type:                   evm.BackendType,
+			BlockStep:              1000,
+			ChannelHubAddress:      chain.channelHub.Hex(),
+			LockingContractAddress: chain.lockingAt.Hex(),
+		}
+		blockchainRPCs[chain.chainID] = []string{chain.chainURL}
+	}
+
+	memoryStore, err := memory.NewMemoryStoreV1(memory.AssetsConfig{
+		Assets: []memory.AssetConfig{{
+			Name:                  "Ether",
+			Symbol:                e2eAsset,
+			Decimals:              18,
+			SuggestedBlockchainID: h.chainID,
+			Tokens:                tokens,
+		}},
+	}, blockchains)
+	require.NoError(t, err)
+
+	actionGateway, err := action_gateway.NewActionGateway(action_gateway.ActionLimitConfig{
+		LevelStepTokens: decimal.NewFromInt(1),
+		AppCost:         decimal.NewFromInt(1),
+	})
+	require.NoError(t, err)
+	feeEngine, err := fee_engine.NewFeeEngine(fee_engine.FeeConfig{FeeAccount: nodeAddress}, memoryStore)
+	require.NoError(t, err)
+
+	registry := prometheus.NewRegistry()
+	runtimeMetrics, err := metrics.NewRuntimeMetricExporter(registry)
+	require.NoError(t, err)
+	storeMetrics, err := metrics.NewStoreMetricExporter(registry)
+	require.NoError(t, err)
+
+	logger := log.NewNoopLogger()
+	rpcNode, err := rpc.NewWebsocketNode(rpc.WebsocketNodeConfig{Logger: logger, Signer: stateSigner})
+	require.NoError(t, err)
+	httpRpcNode, err := rpc.NewHTTPNode(rpc.HTTPNodeConfig{Logger: logger, NotifyFn: rpcNode.Notify, Signer: stateSigner})
+	require.NoError(t, err)
+
+	backends := blockchain.NewBackendRegistry()
+	require.NoError(t, backends.Register(evm.BackendType, evm.NewBackend))
+
+	return &Backbone{
+		NodeVersion:                 Version,
+		ChannelMinChallengeDuration: e2eChallenge,
+		BlockchainRPCs:              blockchainRPCs,
+		ValidationLimits: ValidationLimits{
+			MaxParticipants:   32,
+			MaxSessionDataLen: 1024,
+			MaxAppMetadataLen: 1024,
+			MaxSessionKeyIDs:  256,
+		},
+		RateLimitPerSec:           1000,
+		RateLimitBurst:            1000,
+		PaymentStreamCreditPeriod: time.Hour,
+		WorkerTickInterval:        e2eWorkerTickInterval,
+		AppStateValidators:        app.NewAppStateValidatorRegistryV1(),
+		BlockchainBackends:        backends,
+
+		DbStore:        database.NewDBStore(db),
+		MemoryStore:    memoryStore,
+		ActionGateway:  actionGateway,
+		FeeEngine:      feeEngine,
+		RpcNode:        rpcNode,
+		HttpRpcNode:    httpRpcNode,
+		StateSigner:    stateSigner,
+		TxSigner:       txSigner,
+		Logger:         logger,
+		RuntimeMetrics: runtimeMetrics,
+		StoreMetrics:   storeMetrics,
+	}
+}
+
+// depositToVault deposits ether from the faucet into the node's ChannelHub vault.
+func (h *e2eChain) depositToVault(node common.Address, amount *big.Int) {
+	t := h.t
+	t.Helper()
+
+	channelHub, err := evm.NewChannelHub(h.channelHub, h.chain.Client())
+	require.NoError(t, err)
+
+	opts := *h.faucet
+	opts.Value = amount
+	tx, err := channelHub.DepositToVault(&opts, node, common.Address{}, amount)
+	require.NoError(t, err)
+	h.waitMined(tx)
+}
+
+// e2eUser is a wallet funded on the simulated chain with an SDK client connected to the node.
+type e2eUser struct {
+	*sdk.Client
+	Address   string
+	AppSigner *app.AppSessionSignerV1
+	TxSigner  sign.Signer
+}
+
+// newUser funds a new wallet with the given amount of ether on every chain and connects an SDK client for it.
+func (h *e2eHarness) newUser(balance int64, opts ...sdk.Option) *e2eUser {
+	t := h.t
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	for _, chain := range h.chains {
+		chain.fund(address, ether(balance))
+	}
+
+	rawSigner, err := sign.NewEthereumRawSigner(hexutil.Encode(crypto.FromECDSA(key)))
+	require.NoError(t, err)
+	msgSigner, err := sign.NewEthereumMsgSignerFromRaw(rawSigner)
+	require.NoError(t, err)
+	stateSigner, err := core.NewChannelDefaultSigner(msgSigner)
+	require.NoError(t, err)
+	appSigner, err := app.NewAppSessionWalletSignerV1(msgSigner)
+	require.NoError(t, err)
+
+	chainOpts := make([]sdk.Option, 0, len(h.chains))
+	for _, chain := range h.chains {
+		chainOpts = append(chainOpts, sdk.WithBlockchainRPC(chain.chainID, chain.chainURL))
+	}
+	opts = append(chainOpts, opts...)
+	client, err := sdk.NewClient(h.nodeURL, stateSigner, rawSigner, opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+
+	return &e2eUser{Client: client, Address: address.Hex(), AppSigner: appSigner, TxSigner: rawSigner}
+}
+
+// fund sends ether from the faucet to the address and waits for the transfer to be mined.
+func (h *e2eChain) fund(to common.Address, amount *big.Int) {
+	t := h.t
+	t.Helper()
+
+	ctx := context.Background()
+	client := h.chain.Client()
+	nonce, err := client.PendingNonceAt(ctx, h.faucet.From)
+	require.NoError(t, err)
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	require.NoError(t, err)
+
+	tx, err := h.faucet.Signer(h.faucet.From, types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		To:       &to,
+		Value:    amount,
+		Gas:      21_000,
+		GasPrice: gasPrice,
+	}))
+	require.NoError(t, err)
+	require.NoError(t, client.SendTransaction(ctx, tx))
+	h.waitMined(tx)
+}
+
+// waitMined waits for the transaction to be mined and requires it to succeed.
+func (h *e2eChain) waitMined(tx *types.Transaction) {
+	h.t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), e2eEventualTimeout)
+	defer cancel()
+	receipt, err := bind.WaitMined(ctx, h.chain.Client(), tx)
+	require.NoError(h.t, err)
+	require.Equal(h.t, types.ReceiptStatusSuccessful, receipt.Status, "transaction %s reverted", tx.Hash())
+}
+
+// advanceTimePast moves the clock of the simulated chain past the deadline and mines a block with the new time.
+func (h *e2eChain) advanceTimePast(deadline time.Time) {
+	h.t.Helper()
+
+	head, err := h.chain.Client().HeaderByNumber(context.Background(), nil)
+	require.NoError(h.t, err)
+	if d := deadline.Sub(time.Unix(int64(head.Time), 0)); d >= 0 {
+		require.NoError(h.t, h.chain.AdjustTime(d+time.Second))
+	}
+}
+
+// onChainBalance returns the ether balance of the user's wallet on the simulated chain.
+func (h *e2eChain) onChainBalance(user *e2eUser) decimal.Decimal {
+	h.t.Helper()
+
+	balance, err := h.chain.Client().BalanceAt(context.Background(), common.HexToAddress(user.Address), nil)
+	require.NoError(h.t, err)
+	return decimal.NewFromBigInt(balance, -18)
+}
+
+// balance returns the user's off-chain balance of the asset as reported by the node.
+func (h *e2eHarness) balance(user *e2eUser) decimal.Decimal {
+	h.t.Helper()
+
+	balances, err := user.GetBalances(context.Background(), user.Address)
+	require.NoError(h.t, err)
+	for _, entry := range balances {
+		if entry.Asset == e2eAsset {
+			return entry.Balance
+		}
+	}
+	return decimal.Zero
+}
+
+// homeChannelID returns the ID of the user's home channel for the asset, from the user's latest state.
+func (h *e2eHarness) homeChannelID(user *e2eUser) string {
+	h.t.Helper()
+
+	state, err := user.GetLatestState(context.Background(), user.Address, e2eAsset, false)
+	require.NoError(h.t, err)
+	require.NotNil(h.t, state.HomeChannelID, "user has no home channel")
+	return *state.HomeChannelID
+}
+
+// closeChannel closes the user's home channel on-chain with the state, signing the transaction with the user's key.
+// Unlike the SDK, it doesn't check the challenge expiry against the wall clock, which advanceTime doesn't move.
+func (h *e2eHarness) closeChannel(user *e2eUser, state core.State) {
+	h.t.Helper()
+
+	client, err := evm.NewBlockchainClient(h.channelHub, h.chain.Client(), user.TxSigner, h.chainID, h.bb.StateSigner.PublicKey().Address().String(), h.bb.MemoryStore)
+	require.NoError(h.t, err)
+	_, err = client.Close(context.Background(), state)
+	require.NoError(h.t, err)
+}
+
+// signApp signs app session data with the user's wallet.
+func (h *e2eHarness) signApp(user *e2eUser, data []byte) string {
+	h.t.Helper()
+
+	sig, err := user.AppSigner.Sign(data)
+	require.NoError(h.t, err)
+	return sig.String()
+}
+
+// signAppUpdate signs an app state update with the user's wallet.
+func (h *e2eHarness) signAppUpdate(user *e2eUser, update app.AppStateUpdateV1) string {
+	h.t.Helper()
+
+	packed, err := app.PackAppStateUpdateV1(update)
+	require.NoError(h.t, err)
+	return h.signApp(user, packed)
+}
+
+// stake locks tokens from the faucet for the user in the Locking contract
+// and waits for the node to record the user's staked amount.
+func (h *e2eHarness) stake(user *e2eUser, amount decimal.Decimal) {
+	t := h.t
+	t.Helper()
+
+	staked, err := h.bb.DbStore.GetTotalUserStaked(user.Address)
+	require.NoError(t, err)
+
+	tx, err := h.locking.Lock(h.faucet, common.HexToAddress(user.Address), amount.Shift(e2eLockingTokenDecimals).BigInt())
+	require.NoError(t, err)
+	h.waitMined(tx)
+
+	require.Eventually(t, func() bool {
+		total, err := h.bb.DbStore.GetTotalUserStaked(user.Address)
+		return err == nil && total.Equal(staked.Add(amount))
+	}, e2eEventualTimeout, e2eMiningInterval)
+}
+
+// channelStatus returns the status of the user's home channel for the asset as recorded by the node.
+func (h *e2eHarness) channelStatus(user *e2eUser) core.ChannelStatus {
+	h.t.Helper()
+
+	channel, err := h.bb.DbStore.GetChannelByID(h.homeChannelID(user))
+	require.NoError(h.t, err)
+	if channel == nil {
+		return core.ChannelStatusVoid
+	}
+	return channel.Status
+}
+
+// e2eLockingTokenDecimals are the decimals of the token staked in the Locking contract.
+const e2eLockingTokenDecimals = 18
+
+// deployLocking deploys the Locking contract with a token whose whole supply is held by the deployer.
+func deployLocking(t *testing.T, opts *bind.TransactOpts, chain *simulated.Backend) (common.Address, *evm.AppRegistry) {
+	t.Helper()
+
+	token, _, _, err := bind.DeployContract(opts, abi.ABI{}, lockingTokenCode(), chain.Client())
+	require.NoError(t, err)
+	chain.Commit()
+
+	address, _, locking, err := evm.DeployAppRegistry(opts, chain.Client(), token, big.NewInt(int64(e2eUnlockPeriod/time.Second)), opts.From)
+	require.NoError(t, err)
+	chain.Commit()
+
+	code, err := chain.Client().CodeAt(context.Background(), address, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, code, "the Locking contract was not deployed")
+
+	return address, locking
+}
+
+// lockingTokenCode returns the init code of the minimal token the Locking contract is deployed with.
+// It implements decimals, balanceOf, approve, transfer and transferFrom, the latter without allowances,
+// and assigns the whole supply to the deployer. Balances are kept in the storage slot of the holder's address.
+func lockingTokenCode() []byte {
+	selectors := []struct {
+		label    string
+		selector uint32
+	}{
+		{"decimals", 0x313ce567},
+		{"balanceOf", 0x70a08231},
+		{"approve", 0x095ea7b3},
+		{"transfer", 0xa9059cbb},
+		{"transferFrom", 0x23b872dd},
+	}
+
+	// The code is built twice: the first pass records the offsets of the labels that the second pass jumps to
+	labels := make(map[string]uint16)
+	build := func() []byte {
+		p := program.New()
+		label := func(name string) {
+			labels[name] = uint16(p.Size())
+			p.Op(vm.JUMPDEST)
+		}
+		jump := func(name string, op vm.OpCode) {
+			p.Op(vm.PUSH2).Append(binary.BigEndian.AppendUint16(nil, labels[name])).Op(op)
+		}
+		returnWord := func() {
+			p.Push(0).Op(vm.MSTORE).Return(0, 32)
+		}
+
+		// Dispatch on the function selector
+		p.Push(0).Op(vm.CALLDATALOAD).Push(224).Op(vm.SHR)
+		for _, s := range selectors {
+			p.Op(vm.DUP1).Push(s.selector).Op(vm.EQ)
+			jump(s.label, vm.JUMPI)
+		}
+		jump("revert", vm.JUMP)
+
+		label("decimals")
+		p.Push(e2eLockingTokenDecimals)
+		returnWord()
+
+		label("balanceOf")
+		p.Push(4).Op(vm.CALLDATALOAD, vm.SLOAD)
+		returnWord()
+
+		label("approve")
+		jump("returnTrue", vm.JUMP)
+
+		// Both transfers leave [from, to, amount] on the stack for the balance update
+		label("transfer")
+		p.Op(vm.CALLER).Push(4).Op(vm.CALLDATALOAD).Push(36).Op(vm.CALLDATALOAD)
+		jump("move", vm.JUMP)
+
+		label("transferFrom")
+		p.Push(4).Op(vm.CALLDATALOAD).Push(36).Op(vm.CALLDATALOAD).Push(68).Op(vm.CALLDATALOAD)
+		jump("move", vm.JUMP)
+
+		label("move")
+		p.Op(vm.DUP3, vm.SLOAD, vm.DUP2, vm.DUP2, vm.LT) // balance of from < amount
+		jump("revert", vm.JUMPI)
+		p.Op(vm.DUP2, vm.SWAP1, vm.SUB, vm.DUP4, vm.SSTORE)  // from -= amount
+		p.Op(vm.DUP2, vm.SLOAD, vm.ADD, vm.SWAP1, vm.SSTORE) // to += amount
+
+		label("returnTrue")
+		p.Push(1)
+		returnWord()
+
+		label("revert")
+		p.Push(0).Push(0).Op(vm.REVERT)
+
+		return p.Bytes()
+	}
+	build()
+	runtime := build()
+
+	supply := new(big.Int).Lsh(big.NewInt(1), 128)
+	return program.New().Push(supply).Op(vm.CALLER, vm.SSTORE).ReturnViaCodeCopy(runtime).Bytes()
+}
+
+// mine mines a block whenever transactions are pending, until ctx is done.
+func mine(ctx context.Context, chain *simulated.Backend) {
+	ticker := time.NewTicker(e2eMiningInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			pending, err := chain.Client().PendingTransactionCount(ctx)
+			if err == nil && pending > 0 {
+				chain.Commit()
+			}
+		}
+	}
+}
+
+func freePort(t *testing.T) int {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+// ether converts an amount of ether to wei.
+func ether(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), big.NewInt(1e18))
+}
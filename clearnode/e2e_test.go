@@ -0,0 +1,401 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/app"
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/layer-3/nitrolite/pkg/sign"
+	sdk "github.com/layer-3/nitrolite/sdk/go"
+)
+
+func TestE2E_DepositTransferWithdraw(t *testing.T) {
+	h := newE2EHarness(t)
+	ctx := context.Background()
+
+	alice := h.newUser(10)
+	bob := h.newUser(1)
+
+	// Alice opens her home channel with a deposit
+	_, err := alice.Deposit(ctx, h.chainID, e2eAsset, decimal.NewFromInt(2))
+	require.NoError(t, err)
+	_, err = alice.Checkpoint(ctx, e2eAsset)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return h.channelStatus(alice) == core.ChannelStatusOpen }, e2eEventualTimeout, e2eMiningInterval)
+	assert.True(t, h.onChainBalance(alice).LessThan(decimal.NewFromInt(8)))
+
+	// Alice pays Bob off-chain
+	_, err = alice.Transfer(ctx, bob.Address, e2eAsset, decimal.NewFromFloat(0.5))
+	require.NoError(t, err)
+	assert.True(t, h.balance(alice).Equal(decimal.NewFromFloat(1.5)))
+	assert.True(t, h.balance(bob).Equal(decimal.NewFromFloat(0.5)))
+
+	// Bob withdraws what he received: his home channel is created with the withdrawal
+	bobBefore := h.onChainBalance(bob)
+	_, err = bob.Withdraw(ctx, h.chainID, e2eAsset, decimal.NewFromFloat(0.5))
+	require.NoError(t, err)
+	_, err = bob.Checkpoint(ctx, e2eAsset)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return h.channelStatus(bob) == core.ChannelStatusOpen }, e2eEventualTimeout, e2eMiningInterval)
+	assert.True(t, h.onChainBalance(bob).GreaterThan(bobBefore.Add(decimal.NewFromFloat(0.49))))
+	assert.True(t, h.balance(bob).IsZero())
+
+	// Alice closes her channel and gets the rest back
+	aliceBefore := h.onChainBalance(alice)
+	_, err = alice.CloseHomeChannel(ctx, e2eAsset)
+	require.NoError(t, err)
+	_, err = alice.Checkpoint(ctx, e2eAsset)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return h.channelStatus(alice) == core.ChannelStatusClosed }, e2eEventualTimeout, e2eMiningInterval)
+	assert.True(t, h.onChainBalance(alice).GreaterThan(aliceBefore.Add(decimal.NewFromFloat(1.49))))
+}
+
+func TestE2E_EscrowDeposit(t *testing.T) {
+	h := newE2EHarnessWithChains(t, 2)
+	ctx := context.Background()
+	escrowChain := h.chains[1]
+
+	alice := h.newUser(10)
+
+	_, err := alice.Deposit(ctx, h.chainID, e2eAsset, decimal.NewFromInt(2))
+	require.NoError(t, err)
+	_, err = alice.Checkpoint(ctx, e2eAsset)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return h.channelStatus(alice) == core.ChannelStatusOpen }, e2eEventualTimeout, e2eMiningInterval)
+
+	// Alice deposits from the other chain: her funds are locked in an escrow there
+	lockState, err := alice.Deposit(ctx, escrowChain.chainID, e2eAsset, decimal.NewFromInt(1))
+	require.NoError(t, err)
+	require.Equal(t, core.TransitionTypeMutualLock, lockState.Transition.Type)
+	require.NotNil(t, lockState.EscrowChannelID)
+
+	escrowBefore := escrowChain.onChainBalance(alice)
+	_, err = alice.Checkpoint(ctx, e2eAsset)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return escrowChain.onChainBalance(alice).LessThan(escrowBefore.Sub(decimal.NewFromFloat(0.99)))
+	}, e2eEventualTimeout, e2eMiningInterval)
+
+	// Once the escrow deposit is initiated, the node checkpoints the lock on the home chain
+	require.Eventually(t, func() bool {
+		channel, err := h.bb.DbStore.GetChannelByID(h.homeChannelID(alice))
+		require.NoError(t, err)
+		return channel.StateVersion == lockState.Version
+	}, e2eEventualTimeout, e2eMiningInterval)
+
+	_, err = alice.CompleteEscrowDeposit(ctx, e2eAsset)
+	require.NoError(t, err)
+	assert.True(t, h.balance(alice).Equal(decimal.NewFromInt(3)))
+
+	// The node finalizes the escrow deposit on the escrow chain
+	require.Eventually(t, func() bool {
+		channel, err := h.bb.DbStore.GetChannelByID(*lockState.EscrowChannelID)
+		require.NoError(t, err)
+		return channel != nil && channel.Status == core.ChannelStatusClosed
+	}, e2eEventualTimeout, e2eMiningInterval)
+}
+
+func TestE2E_EscrowWithdrawal(t *testing.T) {
+	h := newE2EHarnessWithChains(t, 2)
+	ctx := context.Background()
+	escrowChain := h.chains[1]
+
+	alice := h.newUser(10)
+
+	_, err := alice.Deposit(ctx, h.chainID, e2eAsset, decimal.NewFromInt(2))
+	require.NoError(t, err)
+	_, err = alice.Checkpoint(ctx, e2eAsset)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return h.channelStatus(alice) == core.ChannelStatusOpen }, e2eEventualTimeout, e2eMiningInterval)
+
+	// Alice withdraws to the other chain: the node locks its own funds in an escrow there
+	lockState, err := alice.Withdraw(ctx, escrowChain.chainID, e2eAsset, decimal.NewFromInt(1))
+	require.NoError(t, err)
+	require.Equal(t, core.TransitionTypeEscrowLock, lockState.Transition.Type)
+	require.NotNil(t, lockState.EscrowChannelID)
+
+	require.Eventually(t, func() bool {
+		channel, err := h.bb.DbStore.GetChannelByID(*lockState.EscrowChannelID)
+		require.NoError(t, err)
+		return channel != nil && channel.Status == core.ChannelStatusOpen
+	}, e2eEventualTimeout, e2eMiningInterval)
+
+	_, err = alice.CompleteEscrowWithdrawal(ctx, e2eAsset)
+	require.NoError(t, err)
+	assert.True(t, h.balance(alice).Equal(decimal.NewFromInt(1)))
+
+	// Alice finalizes the escrow withdrawal on the escrow chain to receive the funds
+	escrowBefore := escrowChain.onChainBalance(alice)
+	_, err = alice.Checkpoint(ctx, e2eAsset)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		channel, err := h.bb.DbStore.GetChannelByID(*lockState.EscrowChannelID)
+		require.NoError(t, err)
+		return channel.Status == core.ChannelStatusClosed
+	}, e2eEventualTimeout, e2eMiningInterval)
+	assert.True(t, escrowChain.onChainBalance(alice).GreaterThan(escrowBefore.Add(decimal.NewFromFloat(0.99))))
+}
+
+func TestE2E_HomeChannelMigration(t *testing.T) {
+	h := newE2EHarnessWithChains(t, 2)
+	ctx := context.Background()
+	newHomeChain := h.chains[1]
+
+	alice := h.newUser(10)
+
+	_, err := alice.Deposit(ctx, h.chainID, e2eAsset, decimal.NewFromInt(2))
+	require.NoError(t, err)
+	_, err = alice.Checkpoint(ctx, e2eAsset)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return h.channelStatus(alice) == core.ChannelStatusOpen }, e2eEventualTimeout, e2eMiningInterval)
+	homeChannelID := h.homeChannelID(alice)
+
+	// Alice moves her home channel to the other chain: the node locks her balance there
+	migrateState, err := alice.MigrateHomeChannel(ctx, e2eAsset, newHomeChain.chainID)
+	require.NoError(t, err)
+	require.Equal(t, core.TransitionTypeMigrate, migrateState.Transition.Type)
+
+	require.Eventually(t, func() bool {
+		channel, err := h.bb.DbStore.GetChannelByID(homeChannelID)
+		require.NoError(t, err)
+		return channel.MigrationStatus == core.ChannelMigrationStatusInitiated
+	}, e2eEventualTimeout, e2eMiningInterval)
+
+	// Completing the migration releases the channel on the old chain
+	_, err = alice.CompleteHomeChannelMigration(ctx, e2eAsset)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		channel, err := h.bb.DbStore.GetChannelByID(homeChannelID)
+		require.NoError(t, err)
+		return channel.MigrationStatus == core.ChannelMigrationStatusMigratedOut && channel.BlockchainID == newHomeChain.chainID
+	}, e2eEventualTimeout, e2eMiningInterval)
+	assert.True(t, h.balance(alice).Equal(decimal.NewFromInt(2)))
+
+	// The channel now settles on the new home chain
+	before := newHomeChain.onChainBalance(alice)
+	_, err = alice.Withdraw(ctx, newHomeChain.chainID, e2eAsset, decimal.NewFromInt(1))
+	require.NoError(t, err)
+	_, err = alice.Checkpoint(ctx, e2eAsset)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return newHomeChain.onChainBalance(alice).GreaterThan(before.Add(decimal.NewFromFloat(0.99)))
+	}, e2eEventualTimeout, e2eMiningInterval)
+	assert.True(t, h.balance(alice).Equal(decimal.NewFromInt(1)))
+}
+
+func TestE2E_AuthenticatedPrivateReads(t *testing.T) {
+	h := newE2EHarnessWithChains(t, 1, func(bb *Backbone) { bb.RestrictPrivateReads = true })
+	ctx := context.Background()
+
+	alice := h.newUser(10)
+	bob := h.newUser(1)
+
+	// Private reads are rejected until the connection proves control over the wallet
+	_, err := alice.GetBalances(ctx, alice.Address)
+	require.ErrorContains(t, err, "authentication required")
+
+	require.NoError(t, alice.Authenticate(ctx))
+	require.NoError(t, bob.Authenticate(ctx))
+
+	_, err = alice.Deposit(ctx, h.chainID, e2eAsset, decimal.NewFromInt(2))
+	require.NoError(t, err)
+	assert.True(t, h.balance(alice).Equal(decimal.NewFromInt(2)))
+
+	// An authenticated connection cannot read the data of another wallet
+	_, err = bob.GetBalances(ctx, alice.Address)
+	require.ErrorContains(t, err, "connection is not authenticated as wallet")
+	require.ErrorContains(t, bob.SubscribeChannelEvents(ctx, alice.Address), "connection is not authenticated as wallet")
+	require.ErrorContains(t, bob.SubscribeAppSessionEvents(ctx, alice.Address), "connection is not authenticated as wallet")
+	_, err = bob.GetPaymentStreams(ctx, alice.Address)
+	require.ErrorContains(t, err, "connection is not authenticated as wallet")
+	require.NoError(t, bob.SubscribeChannelEvents(ctx, bob.Address))
+
+	// A channel session key of the wallet can authenticate a connection as well
+	sessionKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sessionRawSigner, err := sign.NewEthereumRawSigner(hexutil.Encode(crypto.FromECDSA(sessionKey)))
+	require.NoError(t, err)
+	sessionMsgSigner, err := sign.NewEthereumMsgSignerFromRaw(sessionRawSigner)
+	require.NoError(t, err)
+
+	keyState := core.ChannelSessionKeyStateV1{
+		UserAddress: alice.Address,
+		SessionKey:  sessionMsgSigner.PublicKey().Address().String(),
+		Version:     1,
+		Assets:      []string{e2eAsset},
+		ExpiresAt:   time.Now().Add(time.Hour).Truncate(time.Second),
+	}
+	keyState.UserSig, err = alice.SignChannelSessionKeyState(keyState)
+	require.NoError(t, err)
+	require.NoError(t, alice.SubmitChannelSessionKeyState(ctx, keyState))
+
+	metadataHash, err := core.GetChannelSessionKeyAuthMetadataHashV1(keyState.Version, keyState.Assets, keyState.ExpiresAt.Unix())
+	require.NoError(t, err)
+	sessionSigner, err := core.NewChannelSessionKeySignerV1(sessionMsgSigner, metadataHash.Hex(), keyState.UserSig)
+	require.NoError(t, err)
+	sessionClient, err := sdk.NewClient(h.nodeURL, sessionSigner, alice.TxSigner)
+	require.NoError(t, err)
+	t.Cleanup(func() { sessionClient.Close() })
+
+	require.NoError(t, sessionClient.Authenticate(ctx))
+	balances, err := sessionClient.GetBalances(ctx, alice.Address)
+	require.NoError(t, err)
+	require.Len(t, balances, 1)
+	assert.True(t, balances[0].Balance.Equal(decimal.NewFromInt(2)))
+}
+
+func TestE2E_SignedResponses(t *testing.T) {
+	h := newE2EHarness(t)
+	ctx := context.Background()
+
+	nodeAddress := h.bb.StateSigner.PublicKey().Address().String()
+	alice := h.newUser(10, sdk.WithResponseVerification(sdk.ResponseVerificationConfig{NodeAddress: nodeAddress, Strict: true}))
+
+	_, err := alice.Deposit(ctx, h.chainID, e2eAsset, decimal.NewFromInt(2))
+	require.NoError(t, err)
+	balances, err := alice.GetBalances(ctx, alice.Address)
+	require.NoError(t, err)
+	require.Len(t, balances, 1)
+	assert.True(t, balances[0].Balance.Equal(decimal.NewFromInt(2)))
+
+	// A client expecting another node key refuses to connect
+	_, err = sdk.NewClient(h.nodeURL, nil, alice.TxSigner, sdk.WithResponseVerification(sdk.ResponseVerificationConfig{
+		NodeAddress: alice.Address,
+		Strict:      true,
+	}))
+	require.ErrorIs(t, err, rpc.ErrInvalidSignature)
+}
+
+func TestE2E_AppSession(t *testing.T) {
+	h := newE2EHarness(t)
+	ctx := context.Background()
+
+	alice := h.newUser(10)
+	bob := h.newUser(1)
+
+	_, err := alice.Deposit(ctx, h.chainID, e2eAsset, decimal.NewFromInt(2))
+	require.NoError(t, err)
+	_, err = alice.Checkpoint(ctx, e2eAsset)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return h.channelStatus(alice) == core.ChannelStatusOpen }, e2eEventualTimeout, e2eMiningInterval)
+
+	appID := "e2e-app"
+	h.stake(alice, decimal.NewFromInt(1))
+	require.NoError(t, alice.RegisterApp(ctx, appID, "{}", true))
+
+	definition := app.AppDefinitionV1{
+		ApplicationID: appID,
+		Participants: []app.AppParticipantV1{
+			{WalletAddress: alice.Address, SignatureWeight: 100},
+			{WalletAddress: bob.Address, SignatureWeight: 0},
+		},
+		Quorum: 100,
+		Nonce:  uint64(time.Now().UnixNano()),
+	}
+	createRequest, err := app.PackCreateAppSessionRequestV1(definition, "{}")
+	require.NoError(t, err)
+	appSessionID, _, _, err := alice.CreateAppSession(ctx, definition, "{}", []string{h.signApp(alice, createRequest)})
+	require.NoError(t, err)
+
+	// Alice moves funds from her channel into the app session
+	deposit := app.AppStateUpdateV1{
+		AppSessionID: appSessionID,
+		Intent:       app.AppStateUpdateIntentDeposit,
+		Version:      2,
+		Allocations:  []app.AppAllocationV1{{Participant: alice.Address, Asset: e2eAsset, Amount: decimal.NewFromInt(1)}},
+	}
+	_, err = alice.SubmitAppSessionDeposit(ctx, deposit, []string{h.signAppUpdate(alice, deposit)}, e2eAsset, decimal.NewFromInt(1))
+	require.NoError(t, err)
+	assert.True(t, h.balance(alice).Equal(decimal.NewFromInt(1)))
+
+	// The app gives part of it to Bob and closes, releasing the allocations to the participants' channels
+	operate := app.AppStateUpdateV1{
+		AppSessionID: appSessionID,
+		Intent:       app.AppStateUpdateIntentOperate,
+		Version:      3,
+		Allocations: []app.AppAllocationV1{
+			{Participant: alice.Address, Asset: e2eAsset, Amount: decimal.NewFromFloat(0.6)},
+			{Participant: bob.Address, Asset: e2eAsset, Amount: decimal.NewFromFloat(0.4)},
+		},
+	}
+	require.NoError(t, alice.SubmitAppState(ctx, operate, []string{h.signAppUpdate(alice, operate)}))
+
+	closeUpdate := operate
+	closeUpdate.Intent = app.AppStateUpdateIntentClose
+	closeUpdate.Version = 4
+	require.NoError(t, alice.SubmitAppState(ctx, closeUpdate, []string{h.signAppUpdate(alice, closeUpdate)}))
+
+	sessions, _, err := alice.GetAppSessions(ctx, &sdk.GetAppSessionsOptions{AppSessionID: &appSessionID})
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.True(t, sessions[0].IsClosed)
+	assert.True(t, h.balance(alice).Equal(decimal.NewFromFloat(1.6)))
+	assert.True(t, h.balance(bob).Equal(decimal.NewFromFloat(0.4)))
+}
+
+func TestE2E_ChallengeWithStaleState(t *testing.T) {
+	h := newE2EHarness(t)
+	ctx := context.Background()
+
+	alice := h.newUser(10)
+	bob := h.newUser(1)
+
+	_, err := alice.Deposit(ctx, h.chainID, e2eAsset, decimal.NewFromInt(2))
+	require.NoError(t, err)
+	_, err = alice.Checkpoint(ctx, e2eAsset)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return h.channelStatus(alice) == core.ChannelStatusOpen }, e2eEventualTimeout, e2eMiningInterval)
+
+	depositState, err := alice.GetLatestState(ctx, alice.Address, e2eAsset, true)
+	require.NoError(t, err)
+
+	latestState, err := alice.Transfer(ctx, bob.Address, e2eAsset, decimal.NewFromFloat(0.5))
+	require.NoError(t, err)
+
+	// Alice disputes the transfer with the state before it: the node answers with the latest state
+	_, err = alice.Challenge(ctx, *depositState)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		channel, err := h.bb.DbStore.GetChannelByID(h.homeChannelID(alice))
+		require.NoError(t, err)
+		return channel.Status == core.ChannelStatusOpen && channel.StateVersion == latestState.Version
+	}, e2eEventualTimeout, e2eMiningInterval)
+}
+
+func TestE2E_ChallengeExpires(t *testing.T) {
+	h := newE2EHarness(t)
+	ctx := context.Background()
+
+	alice := h.newUser(10)
+
+	_, err := alice.Deposit(ctx, h.chainID, e2eAsset, decimal.NewFromInt(2))
+	require.NoError(t, err)
+	_, err = alice.Checkpoint(ctx, e2eAsset)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return h.channelStatus(alice) == core.ChannelStatusOpen }, e2eEventualTimeout, e2eMiningInterval)
+
+	state, err := alice.GetLatestState(ctx, alice.Address, e2eAsset, true)
+	require.NoError(t, err)
+	_, err = alice.Challenge(ctx, *state)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return h.channelStatus(alice) == core.ChannelStatusChallenged }, e2eEventualTimeout, e2eMiningInterval)
+
+	// Nobody answers the challenge: once it expires, Alice closes the channel with the challenged state
+	channel, err := h.bb.DbStore.GetChannelByID(h.homeChannelID(alice))
+	require.NoError(t, err)
+	require.NotNil(t, channel.ChallengeExpiresAt)
+	h.advanceTimePast(*channel.ChallengeExpiresAt)
+	aliceBefore := h.onChainBalance(alice)
+	h.closeChannel(alice, *state)
+	require.Eventually(t, func() bool { return h.channelStatus(alice) == core.ChannelStatusClosed }, e2eEventualTimeout, e2eMiningInterval)
+	assert.True(t, h.onChainBalance(alice).GreaterThan(aliceBefore.Add(decimal.NewFromFloat(1.99))))
+}
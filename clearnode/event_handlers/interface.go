@@ -1,6 +1,8 @@
 package event_handlers
 
 import (
+	"context"
+
 	"github.com/layer-3/nitrolite/pkg/core"
 	"github.com/shopspring/decimal"
 )
@@ -35,6 +37,10 @@ type Store interface {
 	// Returns nil if the channel does not exist.
 	GetChannelByID(channelID string) (*core.Channel, error)
 
+	// GetPendingEscrowDepositChannels retrieves up to limit escrow deposit channels of a blockchain
+	// that are not finalized yet, oldest first.
+	GetPendingEscrowDepositChannels(blockchainID uint64, limit uint32) ([]core.Channel, error)
+
 	// ScheduleCheckpoint schedules a checkpoint operation for a home channel state.
 	// This queues the state to be submitted on-chain to update the channel's on-chain state.
 	ScheduleCheckpoint(stateID string, chainID uint64) error
@@ -53,4 +59,21 @@ type Store interface {
 
 	// UpdateUserStaked updates the total staked amount for a user on a specific blockchain.
 	UpdateUserStaked(wallet string, blockchainID uint64, amount decimal.Decimal) error
+
+	// GetUserStaked retrieves the staked amount of a user on a specific blockchain, zero if none is recorded.
+	GetUserStaked(wallet string, blockchainID uint64) (decimal.Decimal, error)
+
+	// AdjustVaultBalance adds delta, negative for withdrawals, to the vault balance of a wallet
+	// for a token on a specific blockchain.
+	AdjustVaultBalance(wallet string, blockchainID uint64, tokenAddress string, delta decimal.Decimal) error
+
+	// RecordBlockchainEventEffect records a change made while handling a contract event,
+	// so that it is reverted if a chain reorganization removes the event.
+	RecordBlockchainEventEffect(event core.BlockchainEvent, effect core.BlockchainEventEffect) error
+}
+
+// EventPublisher pushes channel updates to the RPC clients subscribed to the channel owner.
+type EventPublisher interface {
+	// PublishChannelUpdated notifies subscribers that the channel metadata has changed.
+	PublishChannelUpdated(ctx context.Context, channel core.Channel)
 }
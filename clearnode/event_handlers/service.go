@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/layer-3/nitrolite/pkg/core"
 	"github.com/layer-3/nitrolite/pkg/log"
 )
@@ -15,16 +17,114 @@ var _ core.LockingContractEventHandler = &EventHandlerService{}
 // It handles events from both home channels (user state channels) and escrow channels (temporary lock channels).
 // All handlers execute within database transactions provided by useStoreInTx to ensure atomicity.
 type EventHandlerService struct {
-	useStoreInTx StoreTxProvider
+	useStoreInTx   StoreTxProvider
+	eventPublisher EventPublisher
 }
 
 // NewEventHandlerService creates a new EventHandlerService instance.
 // The useStoreInTx parameter wraps all store operations in database transactions.
+// The eventPublisher is notified about every channel update once its transaction is committed.
 // The logger is used for structured logging of event processing.
-func NewEventHandlerService(useStoreInTx StoreTxProvider, logger log.Logger) *EventHandlerService {
+func NewEventHandlerService(useStoreInTx StoreTxProvider, eventPublisher EventPublisher, logger log.Logger) *EventHandlerService {
 	return &EventHandlerService{
-		useStoreInTx: useStoreInTx,
+		useStoreInTx:   useStoreInTx,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// executeInTx runs the handler within a database transaction and, once the transaction is committed,
+// publishes every channel the handler has updated. Nothing is published if the transaction fails.
+// When ctx carries the contract event being handled, the changes are recorded for the event.
+func (s *EventHandlerService) executeInTx(ctx context.Context, handler StoreTxHandler) error {
+	event, hasEvent := core.BlockchainEventFromContext(ctx)
+
+	var updatedChannels []core.Channel
+	err := s.useStoreInTx(func(tx Store) error {
+		updatedChannels = nil
+		if hasEvent {
+			tx = &eventEffectStore{Store: tx, event: event}
+		}
+		return handler(&channelTrackingStore{Store: tx, updatedChannels: &updatedChannels})
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.eventPublisher != nil {
+		for _, channel := range updatedChannels {
+			s.eventPublisher.PublishChannelUpdated(ctx, channel)
+		}
+	}
+	return nil
+}
+
+// channelTrackingStore records channels persisted through UpdateChannel.
+type channelTrackingStore struct {
+	Store
+	updatedChannels *[]core.Channel
+}
+
+func (s *channelTrackingStore) UpdateChannel(channel core.Channel) error {
+	if err := s.Store.UpdateChannel(channel); err != nil {
+		return err
+	}
+	*s.updatedChannels = append(*s.updatedChannels, channel)
+	return nil
+}
+
+// eventEffectStore records the changes made while handling a contract event,
+// so that they are reverted if a chain reorganization removes the event.
+type eventEffectStore struct {
+	Store
+	event core.BlockchainEvent
+}
+
+func (s *eventEffectStore) UpdateChannel(channel core.Channel) error {
+	previous, err := s.Store.GetChannelByID(channel.ChannelID)
+	if err != nil {
+		return err
+	}
+	if previous != nil {
+		err := s.Store.RecordBlockchainEventEffect(s.event, core.BlockchainEventEffect{
+			Type:    core.BlockchainEventEffectChannelUpdated,
+			Channel: previous,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return s.Store.UpdateChannel(channel)
+}
+
+func (s *eventEffectStore) AdjustVaultBalance(wallet string, blockchainID uint64, tokenAddress string, delta decimal.Decimal) error {
+	err := s.Store.RecordBlockchainEventEffect(s.event, core.BlockchainEventEffect{
+		Type:         core.BlockchainEventEffectVaultBalanceAdjusted,
+		Wallet:       wallet,
+		BlockchainID: blockchainID,
+		TokenAddress: tokenAddress,
+		Amount:       delta,
+	})
+	if err != nil {
+		return err
 	}
+	return s.Store.AdjustVaultBalance(wallet, blockchainID, tokenAddress, delta)
+}
+
+func (s *eventEffectStore) UpdateUserStaked(wallet string, blockchainID uint64, amount decimal.Decimal) error {
+	previous, err := s.Store.GetUserStaked(wallet, blockchainID)
+	if err != nil {
+		return err
+	}
+	err = s.Store.RecordBlockchainEventEffect(s.event, core.BlockchainEventEffect{
+		Type:         core.BlockchainEventEffectUserStakedUpdated,
+		Wallet:       wallet,
+		BlockchainID: blockchainID,
+		Amount:       previous,
+	})
+	if err != nil {
+		return err
+	}
+	return s.Store.UpdateUserStaked(wallet, blockchainID, amount)
 }
 
 // HandleHomeChannelCreated processes the HomeChannelCreated event emitted when a home channel
@@ -32,7 +132,7 @@ func NewEventHandlerService(useStoreInTx StoreTxProvider, logger log.Logger) *Ev
 // The channel must exist in the database with type ChannelTypeHome, otherwise a warning is logged.
 func (s *EventHandlerService) HandleHomeChannelCreated(ctx context.Context, event *core.HomeChannelCreatedEvent) error {
 	logger := log.FromContext(ctx)
-	return s.useStoreInTx(func(tx Store) error {
+	return s.executeInTx(ctx, func(tx Store) error {
 		chanID := event.ChannelID
 		channel, err := tx.GetChannelByID(chanID)
 		if err != nil {
@@ -59,13 +159,143 @@ func (s *EventHandlerService) HandleHomeChannelCreated(ctx context.Context, even
 	})
 }
 
-// HandleHomeChannelMigrated processes the HomeChannelMigrated event emitted when a home channel
-// is migrated to a new version or blockchain. This is currently not implemented and logs a warning.
-// TODO: Implement HomeChannelMigrated handler logic
+// HandleHomeChannelMigrated processes the MigrationInInitiated event emitted on the blockchain a home channel
+// is moving to, once the migration is initiated there. It updates the state version and marks the migration
+// as initiated. The channel keeps its current home blockchain until the migration is finalized.
 func (s *EventHandlerService) HandleHomeChannelMigrated(ctx context.Context, event *core.HomeChannelMigratedEvent) error {
 	logger := log.FromContext(ctx)
-	logger.Warn("unexpected HomeChannelMigrated event", "channelId", event.ChannelID, "stateVersion", event.StateVersion)
-	return nil
+	return s.executeInTx(ctx, func(tx Store) error {
+		chanID := event.ChannelID
+		channel, err := tx.GetChannelByID(chanID)
+		if err != nil {
+			return err
+		}
+		if channel == nil {
+			logger.Warn("channel not found in DB during HomeChannelMigrated event", "channelId", chanID)
+			return nil
+		}
+		if channel.Type != core.ChannelTypeHome {
+			logger.Warn("channel type mismatch during HomeChannelMigrated event", "channelId", chanID, "expectedType", core.ChannelTypeHome, "actualType", channel.Type)
+			return nil
+		}
+		channel.StateVersion = event.StateVersion
+		initiateMigration(channel)
+
+		if err := tx.UpdateChannel(*channel); err != nil {
+			return err
+		}
+
+		logger.Info("handled HomeChannelMigrated event", "channelId", event.ChannelID, "stateVersion", event.StateVersion, "migrationStatus", channel.MigrationStatus, "userWallet", channel.UserWallet)
+		return nil
+	})
+}
+
+// HandleHomeChannelMigrationOutInitiated processes the MigrationOutInitiated event emitted on the blockchain
+// a home channel leaves, once the migration is initiated there. It updates the state version and marks
+// the migration as initiated.
+func (s *EventHandlerService) HandleHomeChannelMigrationOutInitiated(ctx context.Context, event *core.HomeChannelMigrationOutInitiatedEvent) error {
+	logger := log.FromContext(ctx)
+	return s.executeInTx(ctx, func(tx Store) error {
+		chanID := event.ChannelID
+		channel, err := tx.GetChannelByID(chanID)
+		if err != nil {
+			return err
+		}
+		if channel == nil {
+			logger.Warn("channel not found in DB during HomeChannelMigrationOutInitiated event", "channelId", chanID)
+			return nil
+		}
+		if channel.Type != core.ChannelTypeHome {
+			logger.Warn("channel type mismatch during HomeChannelMigrationOutInitiated event", "channelId", chanID, "expectedType", core.ChannelTypeHome, "actualType", channel.Type)
+			return nil
+		}
+		channel.StateVersion = event.StateVersion
+		initiateMigration(channel)
+
+		if err := tx.UpdateChannel(*channel); err != nil {
+			return err
+		}
+
+		logger.Info("handled HomeChannelMigrationOutInitiated event", "channelId", event.ChannelID, "stateVersion", event.StateVersion, "migrationStatus", channel.MigrationStatus, "userWallet", channel.UserWallet)
+		return nil
+	})
+}
+
+// HandleHomeChannelMigrationOutFinalized processes the MigrationOutFinalized event emitted when the blockchain
+// a home channel leaves releases it. The migration is marked as migrated out, unless the new home blockchain
+// has already finalized it.
+func (s *EventHandlerService) HandleHomeChannelMigrationOutFinalized(ctx context.Context, event *core.HomeChannelMigrationOutFinalizedEvent) error {
+	logger := log.FromContext(ctx)
+	return s.executeInTx(ctx, func(tx Store) error {
+		chanID := event.ChannelID
+		channel, err := tx.GetChannelByID(chanID)
+		if err != nil {
+			return err
+		}
+		if channel == nil {
+			logger.Warn("channel not found in DB during HomeChannelMigrationOutFinalized event", "channelId", chanID)
+			return nil
+		}
+		if channel.Type != core.ChannelTypeHome {
+			logger.Warn("channel type mismatch during HomeChannelMigrationOutFinalized event", "channelId", chanID, "expectedType", core.ChannelTypeHome, "actualType", channel.Type)
+			return nil
+		}
+		channel.StateVersion = event.StateVersion
+		if channel.MigrationStatus != core.ChannelMigrationStatusCompleted {
+			channel.MigrationStatus = core.ChannelMigrationStatusMigratedOut
+		}
+
+		if err := tx.UpdateChannel(*channel); err != nil {
+			return err
+		}
+
+		logger.Info("handled HomeChannelMigrationOutFinalized event", "channelId", event.ChannelID, "stateVersion", event.StateVersion, "migrationStatus", channel.MigrationStatus, "userWallet", channel.UserWallet)
+		return nil
+	})
+}
+
+// HandleHomeChannelMigrationInFinalized processes the MigrationInFinalized event emitted when the migration
+// of a home channel is finalized on its new home blockchain. The channel moves to the blockchain and token
+// of the event, returns to Open status and its migration is marked as completed.
+func (s *EventHandlerService) HandleHomeChannelMigrationInFinalized(ctx context.Context, event *core.HomeChannelMigrationInFinalizedEvent) error {
+	logger := log.FromContext(ctx)
+	return s.executeInTx(ctx, func(tx Store) error {
+		chanID := event.ChannelID
+		channel, err := tx.GetChannelByID(chanID)
+		if err != nil {
+			return err
+		}
+		if channel == nil {
+			logger.Warn("channel not found in DB during HomeChannelMigrationInFinalized event", "channelId", chanID)
+			return nil
+		}
+		if channel.Type != core.ChannelTypeHome {
+			logger.Warn("channel type mismatch during HomeChannelMigrationInFinalized event", "channelId", chanID, "expectedType", core.ChannelTypeHome, "actualType", channel.Type)
+			return nil
+		}
+		channel.StateVersion = event.StateVersion
+		channel.BlockchainID = event.BlockchainID
+		channel.TokenAddress = event.TokenAddress
+		channel.Status = core.ChannelStatusOpen
+		channel.ChallengeExpiresAt = nil
+		channel.MigrationStatus = core.ChannelMigrationStatusCompleted
+
+		if err := tx.UpdateChannel(*channel); err != nil {
+			return err
+		}
+
+		logger.Info("handled HomeChannelMigrationInFinalized event", "channelId", event.ChannelID, "stateVersion", event.StateVersion, "migrationStatus", channel.MigrationStatus, "userWallet", channel.UserWallet)
+		return nil
+	})
+}
+
+// initiateMigration marks the migration of the channel as initiated, unless an ongoing migration is
+// already past that point. The initiation on the second blockchain leaves the status unchanged.
+func initiateMigration(channel *core.Channel) {
+	switch channel.MigrationStatus {
+	case core.ChannelMigrationStatusNone, core.ChannelMigrationStatusCompleted:
+		channel.MigrationStatus = core.ChannelMigrationStatusInitiated
+	}
 }
 
 // HandleHomeChannelCheckpointed processes the HomeChannelCheckpointed event emitted when a channel
@@ -73,7 +303,7 @@ func (s *EventHandlerService) HandleHomeChannelMigrated(ctx context.Context, eve
 // the Challenged status if present, returning the channel to Open status.
 func (s *EventHandlerService) HandleHomeChannelCheckpointed(ctx context.Context, event *core.HomeChannelCheckpointedEvent) error {
 	logger := log.FromContext(ctx)
-	return s.useStoreInTx(func(tx Store) error {
+	return s.executeInTx(ctx, func(tx Store) error {
 		chanID := event.ChannelID
 		channel, err := tx.GetChannelByID(chanID)
 		if err != nil {
@@ -109,7 +339,7 @@ func (s *EventHandlerService) HandleHomeChannelCheckpointed(ctx context.Context,
 // to resolve the challenge.
 func (s *EventHandlerService) HandleHomeChannelChallenged(ctx context.Context, event *core.HomeChannelChallengedEvent) error {
 	logger := log.FromContext(ctx)
-	return s.useStoreInTx(func(tx Store) error {
+	return s.executeInTx(ctx, func(tx Store) error {
 		chanID := event.ChannelID
 		channel, err := tx.GetChannelByID(chanID)
 		if err != nil {
@@ -163,7 +393,7 @@ func (s *EventHandlerService) HandleHomeChannelChallenged(ctx context.Context, e
 // Once closed, no further state updates are possible for this channel.
 func (s *EventHandlerService) HandleHomeChannelClosed(ctx context.Context, event *core.HomeChannelClosedEvent) error {
 	logger := log.FromContext(ctx)
-	return s.useStoreInTx(func(tx Store) error {
+	return s.executeInTx(ctx, func(tx Store) error {
 		chanID := event.ChannelID
 		channel, err := tx.GetChannelByID(chanID)
 		if err != nil {
@@ -195,7 +425,7 @@ func (s *EventHandlerService) HandleHomeChannelClosed(ctx context.Context, event
 // version, and schedules a checkpoint to finalize the deposit if a matching state exists in the database.
 func (s *EventHandlerService) HandleEscrowDepositInitiated(ctx context.Context, event *core.EscrowDepositInitiatedEvent) error {
 	logger := log.FromContext(ctx)
-	return s.useStoreInTx(func(tx Store) error {
+	return s.executeInTx(ctx, func(tx Store) error {
 		chanID := event.ChannelID
 		channel, err := tx.GetChannelByID(chanID)
 		if err != nil {
@@ -240,7 +470,7 @@ func (s *EventHandlerService) HandleEscrowDepositInitiated(ctx context.Context,
 // to resolve the challenge.
 func (s *EventHandlerService) HandleEscrowDepositChallenged(ctx context.Context, event *core.EscrowDepositChallengedEvent) error {
 	logger := log.FromContext(ctx)
-	return s.useStoreInTx(func(tx Store) error {
+	return s.executeInTx(ctx, func(tx Store) error {
 		chanID := event.ChannelID
 		channel, err := tx.GetChannelByID(chanID)
 		if err != nil {
@@ -298,7 +528,7 @@ func (s *EventHandlerService) HandleEscrowDepositChallenged(ctx context.Context,
 // the final state version, completing the deposit lifecycle.
 func (s *EventHandlerService) HandleEscrowDepositFinalized(ctx context.Context, event *core.EscrowDepositFinalizedEvent) error {
 	logger := log.FromContext(ctx)
-	return s.useStoreInTx(func(tx Store) error {
+	return s.executeInTx(ctx, func(tx Store) error {
 		chanID := event.ChannelID
 		channel, err := tx.GetChannelByID(chanID)
 		if err != nil {
@@ -325,12 +555,46 @@ func (s *EventHandlerService) HandleEscrowDepositFinalized(ctx context.Context,
 	})
 }
 
+// HandleEscrowDepositsPurged processes the EscrowDepositsPurged event emitted when the ChannelHub contract
+// finalizes the oldest escrow deposits of its blockchain whose unlock delay has passed, returning their locked
+// funds to the node. The same number of pending escrow deposit channels, oldest first, are marked as Closed.
+// The contract stops purging at the first challenged deposit, so a challenged channel ends the cleanup.
+func (s *EventHandlerService) HandleEscrowDepositsPurged(ctx context.Context, event *core.EscrowDepositsPurgedEvent) error {
+	logger := log.FromContext(ctx)
+	return s.executeInTx(ctx, func(tx Store) error {
+		channels, err := tx.GetPendingEscrowDepositChannels(event.BlockchainID, uint32(event.PurgedCount))
+		if err != nil {
+			return err
+		}
+		if uint64(len(channels)) < event.PurgedCount {
+			logger.Warn("fewer pending escrow deposits in DB than purged during EscrowDepositsPurged event", "blockchainId", event.BlockchainID, "purgedCount", event.PurgedCount, "pendingCount", len(channels))
+		}
+
+		var purged int
+		for _, channel := range channels {
+			if channel.Status == core.ChannelStatusChallenged {
+				logger.Warn("challenged escrow deposit precedes purged ones during EscrowDepositsPurged event", "channelId", channel.ChannelID, "blockchainId", event.BlockchainID)
+				break
+			}
+
+			channel.Status = core.ChannelStatusClosed
+			if err := tx.UpdateChannel(channel); err != nil {
+				return err
+			}
+			purged++
+		}
+
+		logger.Info("handled EscrowDepositsPurged event", "blockchainId", event.BlockchainID, "purgedCount", event.PurgedCount, "closedChannels", purged)
+		return nil
+	})
+}
+
 // HandleEscrowWithdrawalInitiated processes the EscrowWithdrawalInitiated event emitted when an escrow
 // withdrawal operation begins on-chain. It updates the escrow channel status to Open and sets the state
 // version to reflect the initiated withdrawal.
 func (s *EventHandlerService) HandleEscrowWithdrawalInitiated(ctx context.Context, event *core.EscrowWithdrawalInitiatedEvent) error {
 	logger := log.FromContext(ctx)
-	return s.useStoreInTx(func(tx Store) error {
+	return s.executeInTx(ctx, func(tx Store) error {
 		chanID := event.ChannelID
 		channel, err := tx.GetChannelByID(chanID)
 		if err != nil {
@@ -362,7 +626,7 @@ func (s *EventHandlerService) HandleEscrowWithdrawalInitiated(ctx context.Contex
 // and schedules a checkpoint for escrow withdrawal with the latest signed state to resolve the challenge.
 func (s *EventHandlerService) HandleEscrowWithdrawalChallenged(ctx context.Context, event *core.EscrowWithdrawalChallengedEvent) error {
 	logger := log.FromContext(ctx)
-	return s.useStoreInTx(func(tx Store) error {
+	return s.executeInTx(ctx, func(tx Store) error {
 		chanID := event.ChannelID
 		channel, err := tx.GetChannelByID(chanID)
 		if err != nil {
@@ -420,7 +684,7 @@ func (s *EventHandlerService) HandleEscrowWithdrawalChallenged(ctx context.Conte
 // the final state version, completing the withdrawal lifecycle.
 func (s *EventHandlerService) HandleEscrowWithdrawalFinalized(ctx context.Context, event *core.EscrowWithdrawalFinalizedEvent) error {
 	logger := log.FromContext(ctx)
-	return s.useStoreInTx(func(tx Store) error {
+	return s.executeInTx(ctx, func(tx Store) error {
 		chanID := event.ChannelID
 		channel, err := tx.GetChannelByID(chanID)
 		if err != nil {
@@ -447,9 +711,37 @@ func (s *EventHandlerService) HandleEscrowWithdrawalFinalized(ctx context.Contex
 	})
 }
 
+// HandleVaultDeposited processes the Deposited event emitted when a wallet, typically the node,
+// deposits funds to the vault of the ChannelHub contract. It increases the vault balance of the wallet.
+func (s *EventHandlerService) HandleVaultDeposited(ctx context.Context, event *core.VaultDepositedEvent) error {
+	logger := log.FromContext(ctx)
+	return s.executeInTx(ctx, func(tx Store) error {
+		if err := tx.AdjustVaultBalance(event.Wallet, event.BlockchainID, event.TokenAddress, event.Amount); err != nil {
+			return err
+		}
+
+		logger.Info("handled Deposited event", "wallet", event.Wallet, "blockchainId", event.BlockchainID, "token", event.TokenAddress, "amount", event.Amount)
+		return nil
+	})
+}
+
+// HandleVaultWithdrawn processes the Withdrawn event emitted when a wallet withdraws funds
+// from the vault of the ChannelHub contract. It decreases the vault balance of the wallet.
+func (s *EventHandlerService) HandleVaultWithdrawn(ctx context.Context, event *core.VaultWithdrawnEvent) error {
+	logger := log.FromContext(ctx)
+	return s.executeInTx(ctx, func(tx Store) error {
+		if err := tx.AdjustVaultBalance(event.Wallet, event.BlockchainID, event.TokenAddress, event.Amount.Neg()); err != nil {
+			return err
+		}
+
+		logger.Info("handled Withdrawn event", "wallet", event.Wallet, "blockchainId", event.BlockchainID, "token", event.TokenAddress, "amount", event.Amount)
+		return nil
+	})
+}
+
 func (s *EventHandlerService) HandleUserLockedBalanceUpdated(ctx context.Context, event *core.UserLockedBalanceUpdatedEvent) error {
 	logger := log.FromContext(ctx)
-	return s.useStoreInTx(func(tx Store) error {
+	return s.executeInTx(ctx, func(tx Store) error {
 		err := tx.UpdateUserStaked(event.UserAddress, event.BlockchainID, event.Balance)
 		if err != nil {
 			return err
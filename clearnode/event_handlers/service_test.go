@@ -518,6 +518,296 @@ func TestHandleEscrowWithdrawalFinalized_Success(t *testing.T) {
 	mockStore.AssertExpectations(t)
 }
 
+func TestHandleHomeChannelMigrated_Success(t *testing.T) {
+	// Setup
+	mockStore := new(MockStore)
+	ctx := log.SetContextLogger(context.Background(), log.NewNoopLogger())
+
+	service := &EventHandlerService{
+		useStoreInTx: func(handler StoreTxHandler) error {
+			return handler(mockStore)
+		},
+	}
+
+	// Test data
+	channelID := "0xHomeChannel123"
+
+	channel := &core.Channel{
+		ChannelID:    channelID,
+		Type:         core.ChannelTypeHome,
+		BlockchainID: 1,
+		Status:       core.ChannelStatusOpen,
+		StateVersion: 4,
+	}
+
+	event := &core.HomeChannelMigratedEvent{
+		ChannelID:    channelID,
+		StateVersion: 5,
+	}
+
+	// Mock expectations
+	mockStore.On("GetChannelByID", channelID).Return(channel, nil)
+	mockStore.On("UpdateChannel", mock.MatchedBy(func(ch core.Channel) bool {
+		return ch.ChannelID == channelID &&
+			ch.BlockchainID == 1 &&
+			ch.StateVersion == 5 &&
+			ch.MigrationStatus == core.ChannelMigrationStatusInitiated
+	})).Return(nil)
+
+	// Execute
+	err := service.HandleHomeChannelMigrated(ctx, event)
+
+	// Assert
+	require.NoError(t, err)
+	mockStore.AssertExpectations(t)
+}
+
+func TestHandleHomeChannelMigrationOutInitiated_KeepsLaterStatus(t *testing.T) {
+	// Setup
+	mockStore := new(MockStore)
+	ctx := log.SetContextLogger(context.Background(), log.NewNoopLogger())
+
+	service := &EventHandlerService{
+		useStoreInTx: func(handler StoreTxHandler) error {
+			return handler(mockStore)
+		},
+	}
+
+	// Test data: the old home blockchain has already released the channel
+	channelID := "0xHomeChannel123"
+
+	channel := &core.Channel{
+		ChannelID:       channelID,
+		Type:            core.ChannelTypeHome,
+		Status:          core.ChannelStatusOpen,
+		StateVersion:    5,
+		MigrationStatus: core.ChannelMigrationStatusMigratedOut,
+	}
+
+	event := &core.HomeChannelMigrationOutInitiatedEvent{
+		ChannelID:    channelID,
+		StateVersion: 5,
+	}
+
+	// Mock expectations
+	mockStore.On("GetChannelByID", channelID).Return(channel, nil)
+	mockStore.On("UpdateChannel", mock.MatchedBy(func(ch core.Channel) bool {
+		return ch.ChannelID == channelID &&
+			ch.MigrationStatus == core.ChannelMigrationStatusMigratedOut
+	})).Return(nil)
+
+	// Execute
+	err := service.HandleHomeChannelMigrationOutInitiated(ctx, event)
+
+	// Assert
+	require.NoError(t, err)
+	mockStore.AssertExpectations(t)
+}
+
+func TestHandleHomeChannelMigrationOutFinalized_Success(t *testing.T) {
+	// Setup
+	mockStore := new(MockStore)
+	ctx := log.SetContextLogger(context.Background(), log.NewNoopLogger())
+
+	service := &EventHandlerService{
+		useStoreInTx: func(handler StoreTxHandler) error {
+			return handler(mockStore)
+		},
+	}
+
+	// Test data
+	channelID := "0xHomeChannel123"
+
+	channel := &core.Channel{
+		ChannelID:       channelID,
+		Type:            core.ChannelTypeHome,
+		BlockchainID:    1,
+		Status:          core.ChannelStatusOpen,
+		StateVersion:    5,
+		MigrationStatus: core.ChannelMigrationStatusInitiated,
+	}
+
+	event := &core.HomeChannelMigrationOutFinalizedEvent{
+		ChannelID:    channelID,
+		StateVersion: 6,
+	}
+
+	// Mock expectations
+	mockStore.On("GetChannelByID", channelID).Return(channel, nil)
+	mockStore.On("UpdateChannel", mock.MatchedBy(func(ch core.Channel) bool {
+		return ch.ChannelID == channelID &&
+			ch.BlockchainID == 1 &&
+			ch.StateVersion == 6 &&
+			ch.MigrationStatus == core.ChannelMigrationStatusMigratedOut
+	})).Return(nil)
+
+	// Execute
+	err := service.HandleHomeChannelMigrationOutFinalized(ctx, event)
+
+	// Assert
+	require.NoError(t, err)
+	mockStore.AssertExpectations(t)
+}
+
+func TestHandleHomeChannelMigrationInFinalized_Success(t *testing.T) {
+	// Setup
+	mockStore := new(MockStore)
+	ctx := log.SetContextLogger(context.Background(), log.NewNoopLogger())
+
+	service := &EventHandlerService{
+		useStoreInTx: func(handler StoreTxHandler) error {
+			return handler(mockStore)
+		},
+	}
+
+	// Test data
+	channelID := "0xHomeChannel123"
+	newToken := "0x2222222222222222222222222222222222222222"
+
+	channel := &core.Channel{
+		ChannelID:       channelID,
+		Type:            core.ChannelTypeHome,
+		BlockchainID:    1,
+		TokenAddress:    "0x1111111111111111111111111111111111111111",
+		Status:          core.ChannelStatusOpen,
+		StateVersion:    5,
+		MigrationStatus: core.ChannelMigrationStatusMigratedOut,
+	}
+
+	event := &core.HomeChannelMigrationInFinalizedEvent{
+		ChannelID:    channelID,
+		StateVersion: 6,
+		BlockchainID: 2,
+		TokenAddress: newToken,
+	}
+
+	// Mock expectations
+	mockStore.On("GetChannelByID", channelID).Return(channel, nil)
+	mockStore.On("UpdateChannel", mock.MatchedBy(func(ch core.Channel) bool {
+		return ch.ChannelID == channelID &&
+			ch.BlockchainID == 2 &&
+			ch.TokenAddress == newToken &&
+			ch.Status == core.ChannelStatusOpen &&
+			ch.StateVersion == 6 &&
+			ch.MigrationStatus == core.ChannelMigrationStatusCompleted
+	})).Return(nil)
+
+	// Execute
+	err := service.HandleHomeChannelMigrationInFinalized(ctx, event)
+
+	// Assert
+	require.NoError(t, err)
+	mockStore.AssertExpectations(t)
+}
+
+func TestHandleEscrowDepositsPurged_Success(t *testing.T) {
+	// Setup
+	mockStore := new(MockStore)
+	ctx := log.SetContextLogger(context.Background(), log.NewNoopLogger())
+
+	service := &EventHandlerService{
+		useStoreInTx: func(handler StoreTxHandler) error {
+			return handler(mockStore)
+		},
+	}
+
+	// Test data: the contract stops purging at the challenged deposit
+	blockchainID := uint64(2)
+	channels := []core.Channel{
+		{ChannelID: "0xEscrow1", Type: core.ChannelTypeEscrow, BlockchainID: blockchainID, Status: core.ChannelStatusOpen},
+		{ChannelID: "0xEscrow2", Type: core.ChannelTypeEscrow, BlockchainID: blockchainID, Status: core.ChannelStatusChallenged},
+		{ChannelID: "0xEscrow3", Type: core.ChannelTypeEscrow, BlockchainID: blockchainID, Status: core.ChannelStatusOpen},
+	}
+
+	event := &core.EscrowDepositsPurgedEvent{
+		BlockchainID: blockchainID,
+		PurgedCount:  3,
+	}
+
+	// Mock expectations
+	mockStore.On("GetPendingEscrowDepositChannels", blockchainID, uint32(3)).Return(channels, nil)
+	mockStore.On("UpdateChannel", mock.MatchedBy(func(ch core.Channel) bool {
+		return ch.ChannelID == "0xEscrow1" && ch.Status == core.ChannelStatusClosed
+	})).Return(nil).Once()
+
+	// Execute
+	err := service.HandleEscrowDepositsPurged(ctx, event)
+
+	// Assert
+	require.NoError(t, err)
+	mockStore.AssertExpectations(t)
+	mockStore.AssertNumberOfCalls(t, "UpdateChannel", 1)
+}
+
+func TestHandleVaultDeposited_Success(t *testing.T) {
+	// Setup
+	mockStore := new(MockStore)
+	ctx := log.SetContextLogger(context.Background(), log.NewNoopLogger())
+
+	service := &EventHandlerService{
+		useStoreInTx: func(handler StoreTxHandler) error {
+			return handler(mockStore)
+		},
+	}
+
+	// Test data
+	nodeWallet := "0x1234567890123456789012345678901234567890"
+	token := "0x1111111111111111111111111111111111111111"
+	amount := decimal.NewFromInt(100)
+
+	event := &core.VaultDepositedEvent{
+		Wallet:       nodeWallet,
+		BlockchainID: 1,
+		TokenAddress: token,
+		Amount:       amount,
+	}
+
+	// Mock expectations
+	mockStore.On("AdjustVaultBalance", nodeWallet, uint64(1), token, amount).Return(nil)
+
+	// Execute
+	err := service.HandleVaultDeposited(ctx, event)
+
+	// Assert
+	require.NoError(t, err)
+	mockStore.AssertExpectations(t)
+}
+
+func TestHandleVaultWithdrawn_Success(t *testing.T) {
+	// Setup
+	mockStore := new(MockStore)
+	ctx := log.SetContextLogger(context.Background(), log.NewNoopLogger())
+
+	service := &EventHandlerService{
+		useStoreInTx: func(handler StoreTxHandler) error {
+			return handler(mockStore)
+		},
+	}
+
+	// Test data
+	nodeWallet := "0x1234567890123456789012345678901234567890"
+	token := "0x1111111111111111111111111111111111111111"
+
+	event := &core.VaultWithdrawnEvent{
+		Wallet:       nodeWallet,
+		BlockchainID: 1,
+		TokenAddress: token,
+		Amount:       decimal.NewFromInt(40),
+	}
+
+	// Mock expectations
+	mockStore.On("AdjustVaultBalance", nodeWallet, uint64(1), token, mock.MatchedBy(func(delta decimal.Decimal) bool {
+		return delta.Equal(decimal.NewFromInt(-40))
+	})).Return(nil)
+
+	// Execute
+	err := service.HandleVaultWithdrawn(ctx, event)
+
+	// Assert
+	require.NoError(t, err)
+	mockStore.AssertExpectations(t)
+}
+
 func TestHandleUserLockedBalanceUpdated_Success(t *testing.T) {
 	// Setup
 	mockStore := new(MockStore)
@@ -584,3 +874,130 @@ func TestHandleUserLockedBalanceUpdated_StoreError(t *testing.T) {
 	require.Contains(t, err.Error(), "db error")
 	mockStore.AssertExpectations(t)
 }
+
+func TestHandleHomeChannelCreated_PublishesChannelUpdate(t *testing.T) {
+	// Setup
+	mockStore := new(MockStore)
+	mockPublisher := new(MockEventPublisher)
+	ctx := log.SetContextLogger(context.Background(), log.NewNoopLogger())
+
+	service := NewEventHandlerService(func(handler StoreTxHandler) error {
+		return handler(mockStore)
+	}, mockPublisher, log.NewNoopLogger())
+
+	channelID := "0xHomeChannel123"
+	channel := &core.Channel{
+		ChannelID:  channelID,
+		UserWallet: "0x1234567890123456789012345678901234567890",
+		Type:       core.ChannelTypeHome,
+		Status:     core.ChannelStatusVoid,
+	}
+
+	// Mock expectations
+	mockStore.On("GetChannelByID", channelID).Return(channel, nil)
+	mockStore.On("UpdateChannel", mock.Anything).Return(nil)
+	mockPublisher.On("PublishChannelUpdated", ctx, mock.MatchedBy(func(ch core.Channel) bool {
+		return ch.ChannelID == channelID && ch.Status == core.ChannelStatusOpen && ch.StateVersion == 1
+	})).Return()
+
+	// Execute
+	err := service.HandleHomeChannelCreated(ctx, &core.HomeChannelCreatedEvent{ChannelID: channelID, StateVersion: 1})
+
+	// Assert
+	require.NoError(t, err)
+	mockStore.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestHandleHomeChannelClosed_TxFailure_DoesNotPublish(t *testing.T) {
+	// Setup
+	mockStore := new(MockStore)
+	mockPublisher := new(MockEventPublisher)
+	ctx := log.SetContextLogger(context.Background(), log.NewNoopLogger())
+
+	commitErr := errors.New("commit failed")
+	service := NewEventHandlerService(func(handler StoreTxHandler) error {
+		if err := handler(mockStore); err != nil {
+			return err
+		}
+		return commitErr
+	}, mockPublisher, log.NewNoopLogger())
+
+	channelID := "0xHomeChannel123"
+	channel := &core.Channel{
+		ChannelID: channelID,
+		Type:      core.ChannelTypeHome,
+		Status:    core.ChannelStatusOpen,
+	}
+
+	// Mock expectations
+	mockStore.On("GetChannelByID", channelID).Return(channel, nil)
+	mockStore.On("UpdateChannel", mock.Anything).Return(nil)
+
+	// Execute
+	err := service.HandleHomeChannelClosed(ctx, &core.HomeChannelClosedEvent{ChannelID: channelID, StateVersion: 2})
+
+	// Assert
+	require.ErrorIs(t, err, commitErr)
+	mockPublisher.AssertNotCalled(t, "PublishChannelUpdated", mock.Anything, mock.Anything)
+}
+
+func TestEventHandlers_RecordEffectsOfContractEvent(t *testing.T) {
+	mockStore := new(MockStore)
+	contractEvent := core.BlockchainEvent{BlockchainID: 1, Name: "ChannelCheckpointed", BlockNumber: 100, BlockHash: "0xa100", LogIndex: 2}
+	ctx := core.ContextWithBlockchainEvent(log.SetContextLogger(context.Background(), log.NewNoopLogger()), contractEvent)
+
+	service := &EventHandlerService{
+		useStoreInTx: func(handler StoreTxHandler) error {
+			return handler(mockStore)
+		},
+	}
+
+	channelID := "0xHomeChannel123"
+	userWallet := "0x1234567890123456789012345678901234567890"
+	token := "0x0000000000000000000000000000000000000000"
+	channel := &core.Channel{
+		ChannelID:    channelID,
+		UserWallet:   userWallet,
+		Type:         core.ChannelTypeHome,
+		Status:       core.ChannelStatusOpen,
+		StateVersion: 1,
+	}
+
+	// The channel before the update is recorded, it is read again as the handler modifies its copy
+	loaded, previous := *channel, *channel
+	mockStore.On("GetChannelByID", channelID).Return(&loaded, nil).Once()
+	mockStore.On("GetChannelByID", channelID).Return(&previous, nil).Once()
+	mockStore.On("RecordBlockchainEventEffect", contractEvent, core.BlockchainEventEffect{
+		Type:    core.BlockchainEventEffectChannelUpdated,
+		Channel: channel,
+	}).Return(nil).Once()
+	mockStore.On("UpdateChannel", mock.MatchedBy(func(ch core.Channel) bool { return ch.StateVersion == 2 })).Return(nil)
+	require.NoError(t, service.HandleHomeChannelCheckpointed(ctx, &core.HomeChannelCheckpointedEvent{ChannelID: channelID, StateVersion: 2}))
+
+	// Vault balance changes are recorded as deltas
+	amount := decimal.NewFromInt(5)
+	mockStore.On("RecordBlockchainEventEffect", contractEvent, core.BlockchainEventEffect{
+		Type:         core.BlockchainEventEffectVaultBalanceAdjusted,
+		Wallet:       userWallet,
+		BlockchainID: 1,
+		TokenAddress: token,
+		Amount:       amount.Neg(),
+	}).Return(nil).Once()
+	mockStore.On("AdjustVaultBalance", userWallet, uint64(1), token, amount.Neg()).Return(nil)
+	require.NoError(t, service.HandleVaultWithdrawn(ctx, &core.VaultWithdrawnEvent{Wallet: userWallet, BlockchainID: 1, TokenAddress: token, Amount: amount}))
+
+	// The staked amount before the update is recorded
+	previousStaked := decimal.NewFromInt(3)
+	mockStore.On("GetUserStaked", userWallet, uint64(1)).Return(previousStaked, nil)
+	mockStore.On("RecordBlockchainEventEffect", contractEvent, core.BlockchainEventEffect{
+		Type:         core.BlockchainEventEffectUserStakedUpdated,
+		Wallet:       userWallet,
+		BlockchainID: 1,
+		Amount:       previousStaked,
+	}).Return(nil).Once()
+	mockStore.On("UpdateUserStaked", userWallet, uint64(1), amount).Return(nil)
+	require.NoError(t, service.HandleUserLockedBalanceUpdated(ctx, &core.UserLockedBalanceUpdatedEvent{UserAddress: userWallet, BlockchainID: 1, Balance: amount}))
+
+	mockStore.AssertExpectations(t)
+}
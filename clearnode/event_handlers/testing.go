@@ -1,6 +1,8 @@
 package event_handlers
 
 import (
+	"context"
+
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 
@@ -45,6 +47,15 @@ func (m *MockStore) GetChannelByID(channelID string) (*core.Channel, error) {
 	return args.Get(0).(*core.Channel), args.Error(1)
 }
 
+// GetPendingEscrowDepositChannels mocks retrieving the escrow deposit channels that are not finalized yet
+func (m *MockStore) GetPendingEscrowDepositChannels(blockchainID uint64, limit uint32) ([]core.Channel, error) {
+	args := m.Called(blockchainID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]core.Channel), args.Error(1)
+}
+
 // ScheduleCheckpoint mocks scheduling a checkpoint operation
 func (m *MockStore) ScheduleCheckpoint(stateID string, chainID uint64) error {
 	args := m.Called(stateID, chainID)
@@ -74,3 +85,31 @@ func (m *MockStore) UpdateUserStaked(wallet string, blockchainID uint64, amount
 	args := m.Called(wallet, blockchainID, amount)
 	return args.Error(0)
 }
+
+// GetUserStaked mocks retrieving the staked amount of a user on a blockchain
+func (m *MockStore) GetUserStaked(wallet string, blockchainID uint64) (decimal.Decimal, error) {
+	args := m.Called(wallet, blockchainID)
+	return args.Get(0).(decimal.Decimal), args.Error(1)
+}
+
+// AdjustVaultBalance mocks adjusting the vault balance of a wallet
+func (m *MockStore) AdjustVaultBalance(wallet string, blockchainID uint64, tokenAddress string, delta decimal.Decimal) error {
+	args := m.Called(wallet, blockchainID, tokenAddress, delta)
+	return args.Error(0)
+}
+
+// RecordBlockchainEventEffect mocks recording a change made while handling a contract event
+func (m *MockStore) RecordBlockchainEventEffect(event core.BlockchainEvent, effect core.BlockchainEventEffect) error {
+	args := m.Called(event, effect)
+	return args.Error(0)
+}
+
+// MockEventPublisher is a mock implementation of the EventPublisher interface for testing
+type MockEventPublisher struct {
+	mock.Mock
+}
+
+// PublishChannelUpdated mocks publishing a channel update
+func (m *MockEventPublisher) PublishChannelUpdated(ctx context.Context, channel core.Channel) {
+	m.Called(ctx, channel)
+}
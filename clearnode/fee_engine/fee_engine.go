@@ -0,0 +1,148 @@
+package fee_engine
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/shopspring/decimal"
+	"go.yaml.in/yaml/v2"
+)
+
+const feesFileName = "fees.yaml"
+
+// FeeActions lists the gated actions a fee can be charged on.
+var FeeActions = []core.GatedAction{
+	core.GatedActionTransfer,
+	core.GatedActionAppSessionDeposit,
+	core.GatedActionAppSessionWithdrawal,
+}
+
+type FeeConfig struct {
+	// FeeAccount is the account the collected fees are credited to. Defaults to the node address.
+	FeeAccount string                                        `yaml:"fee_account"`
+	Assets     map[string]map[core.GatedAction]FeeRuleConfig `yaml:"assets"`
+}
+
+type FeeRuleConfig struct {
+	Flat       decimal.Decimal `yaml:"flat"`
+	Percentage decimal.Decimal `yaml:"percentage"`
+}
+
+type AssetStore interface {
+	// GetAssetDecimals checks if an asset exists and returns its decimals in YN
+	GetAssetDecimals(asset string) (uint8, error)
+}
+
+// FeeEngine calculates the fees charged by the node on transfers and app session operations.
+type FeeEngine struct {
+	feeAccount string
+	rules      map[string]map[core.GatedAction]core.FeeRule
+	decimals   map[string]uint8
+}
+
+func NewFeeEngine(cfg FeeConfig, assetStore AssetStore) (*FeeEngine, error) {
+	if !common.IsHexAddress(cfg.FeeAccount) {
+		return nil, fmt.Errorf("invalid fee_account: %q", cfg.FeeAccount)
+	}
+
+	rules := make(map[string]map[core.GatedAction]core.FeeRule, len(cfg.Assets))
+	decimals := make(map[string]uint8, len(cfg.Assets))
+	for asset, actions := range cfg.Assets {
+		assetDecimals, err := assetStore.GetAssetDecimals(asset)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fee asset %q: %w", asset, err)
+		}
+		decimals[asset] = assetDecimals
+
+		rules[asset] = make(map[core.GatedAction]core.FeeRule, len(actions))
+		for action, ruleCfg := range actions {
+			if !slices.Contains(FeeActions, action) {
+				return nil, fmt.Errorf("unsupported fee action %q for asset %q", action, asset)
+			}
+			if ruleCfg.Flat.IsNegative() {
+				return nil, fmt.Errorf("flat fee for %s on asset %q must not be negative", action, asset)
+			}
+			if ruleCfg.Percentage.IsNegative() || ruleCfg.Percentage.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+				return nil, fmt.Errorf("percentage fee for %s on asset %q must be in range [0, 100)", action, asset)
+			}
+
+			rules[asset][action] = core.FeeRule{
+				Asset:      asset,
+				Action:     action,
+				Flat:       ruleCfg.Flat,
+				Percentage: ruleCfg.Percentage,
+			}
+		}
+	}
+
+	return &FeeEngine{
+		feeAccount: strings.ToLower(cfg.FeeAccount),
+		rules:      rules,
+		decimals:   decimals,
+	}, nil
+}
+
+// NewFeeEngineFromYaml loads the fee schedule from fees.yaml in the config directory.
+// When the file does not exist, no fees are charged.
+func NewFeeEngineFromYaml(configDirPath string, assetStore AssetStore, nodeAddress string) (*FeeEngine, error) {
+	cfg := FeeConfig{}
+
+	feesPath := filepath.Join(configDirPath, feesFileName)
+	f, err := os.Open(feesPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if err == nil {
+		defer f.Close()
+		if err := yaml.NewDecoder(f).Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+	}
+
+	if cfg.FeeAccount == "" {
+		cfg.FeeAccount = nodeAddress
+	}
+
+	return NewFeeEngine(cfg, assetStore)
+}
+
+// FeeAccount returns the account the collected fees are credited to.
+func (e *FeeEngine) FeeAccount() string {
+	return e.feeAccount
+}
+
+// CalculateFee returns the fee charged for the gated action on the amount of the asset,
+// rounded up to the asset decimals. It returns zero if no fee is configured.
+func (e *FeeEngine) CalculateFee(asset string, action core.GatedAction, amount decimal.Decimal) decimal.Decimal {
+	rule, ok := e.rules[asset][action]
+	if !ok {
+		return decimal.Zero
+	}
+
+	return rule.Calculate(amount, e.decimals[asset])
+}
+
+// Schedule returns all configured fee rules, sorted by asset and action.
+func (e *FeeEngine) Schedule() []core.FeeRule {
+	schedule := make([]core.FeeRule, 0)
+	for _, actions := range e.rules {
+		for _, rule := range actions {
+			schedule = append(schedule, rule)
+		}
+	}
+
+	slices.SortFunc(schedule, func(a, b core.FeeRule) int {
+		if a.Asset != b.Asset {
+			return strings.Compare(a.Asset, b.Asset)
+		}
+		return strings.Compare(string(a.Action), string(b.Action))
+	})
+	return schedule
+}
@@ -0,0 +1,179 @@
+package fee_engine
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testNodeAddress = "0x1111111111111111111111111111111111111111"
+
+// mockAssetStore implements AssetStore for unit tests.
+type mockAssetStore map[string]uint8
+
+func (m mockAssetStore) GetAssetDecimals(asset string) (uint8, error) {
+	decimals, ok := m[asset]
+	if !ok {
+		return 0, fmt.Errorf("asset %s not found", asset)
+	}
+	return decimals, nil
+}
+
+func defaultAssetStore() mockAssetStore {
+	return mockAssetStore{"usdc": 6, "weth": 18}
+}
+
+func defaultConfig() FeeConfig {
+	return FeeConfig{
+		FeeAccount: testNodeAddress,
+		Assets: map[string]map[core.GatedAction]FeeRuleConfig{
+			"usdc": {
+				core.GatedActionTransfer:          {Flat: decimal.RequireFromString("0.01"), Percentage: decimal.RequireFromString("0.1")},
+				core.GatedActionAppSessionDeposit: {Percentage: decimal.RequireFromString("0.5")},
+			},
+		},
+	}
+}
+
+// --- NewFeeEngine ---
+
+func TestNewFeeEngine(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		engine, err := NewFeeEngine(defaultConfig(), defaultAssetStore())
+		require.NoError(t, err)
+		assert.Equal(t, testNodeAddress, engine.FeeAccount())
+	})
+
+	t.Run("invalid fee account", func(t *testing.T) {
+		cfg := defaultConfig()
+		cfg.FeeAccount = "not-an-address"
+		_, err := NewFeeEngine(cfg, defaultAssetStore())
+		assert.ErrorContains(t, err, "invalid fee_account")
+	})
+
+	t.Run("unknown asset", func(t *testing.T) {
+		cfg := defaultConfig()
+		cfg.Assets["dai"] = map[core.GatedAction]FeeRuleConfig{core.GatedActionTransfer: {}}
+		_, err := NewFeeEngine(cfg, defaultAssetStore())
+		assert.ErrorContains(t, err, `invalid fee asset "dai"`)
+	})
+
+	t.Run("unsupported action", func(t *testing.T) {
+		cfg := defaultConfig()
+		cfg.Assets["usdc"][core.GatedActionAppSessionCreation] = FeeRuleConfig{}
+		_, err := NewFeeEngine(cfg, defaultAssetStore())
+		assert.ErrorContains(t, err, "unsupported fee action")
+	})
+
+	t.Run("negative flat fee", func(t *testing.T) {
+		cfg := defaultConfig()
+		cfg.Assets["usdc"][core.GatedActionTransfer] = FeeRuleConfig{Flat: decimal.NewFromInt(-1)}
+		_, err := NewFeeEngine(cfg, defaultAssetStore())
+		assert.ErrorContains(t, err, "must not be negative")
+	})
+
+	t.Run("percentage out of range", func(t *testing.T) {
+		cfg := defaultConfig()
+		cfg.Assets["usdc"][core.GatedActionTransfer] = FeeRuleConfig{Percentage: decimal.NewFromInt(100)}
+		_, err := NewFeeEngine(cfg, defaultAssetStore())
+		assert.ErrorContains(t, err, "must be in range [0, 100)")
+	})
+}
+
+// --- CalculateFee ---
+
+func TestCalculateFee(t *testing.T) {
+	engine, err := NewFeeEngine(defaultConfig(), defaultAssetStore())
+	require.NoError(t, err)
+
+	t.Run("flat and percentage", func(t *testing.T) {
+		// 0.01 + 100 * 0.1% = 0.11
+		fee := engine.CalculateFee("usdc", core.GatedActionTransfer, decimal.NewFromInt(100))
+		assert.Equal(t, "0.11", fee.String())
+	})
+
+	t.Run("rounded up to asset decimals", func(t *testing.T) {
+		// 0.0000011 * 0.5% = 0.0000000055 -> 0.000001
+		fee := engine.CalculateFee("usdc", core.GatedActionAppSessionDeposit, decimal.RequireFromString("0.0000011"))
+		assert.Equal(t, "0.000001", fee.String())
+	})
+
+	t.Run("no rule for action", func(t *testing.T) {
+		fee := engine.CalculateFee("usdc", core.GatedActionAppSessionWithdrawal, decimal.NewFromInt(100))
+		assert.True(t, fee.IsZero())
+	})
+
+	t.Run("no rule for asset", func(t *testing.T) {
+		fee := engine.CalculateFee("weth", core.GatedActionTransfer, decimal.NewFromInt(100))
+		assert.True(t, fee.IsZero())
+	})
+}
+
+// --- Schedule ---
+
+func TestSchedule(t *testing.T) {
+	engine, err := NewFeeEngine(defaultConfig(), defaultAssetStore())
+	require.NoError(t, err)
+
+	schedule := engine.Schedule()
+	require.Len(t, schedule, 2)
+	assert.Equal(t, core.GatedActionAppSessionDeposit, schedule[0].Action)
+	assert.Equal(t, core.GatedActionTransfer, schedule[1].Action)
+	assert.Equal(t, "usdc", schedule[1].Asset)
+	assert.Equal(t, "0.01", schedule[1].Flat.String())
+	assert.Equal(t, "0.1", schedule[1].Percentage.String())
+}
+
+// --- NewFeeEngineFromYaml ---
+
+func TestNewFeeEngineFromYaml(t *testing.T) {
+	t.Run("missing file charges no fees", func(t *testing.T) {
+		engine, err := NewFeeEngineFromYaml(t.TempDir(), defaultAssetStore(), testNodeAddress)
+		require.NoError(t, err)
+		assert.Equal(t, testNodeAddress, engine.FeeAccount())
+		assert.Empty(t, engine.Schedule())
+	})
+
+	t.Run("fee account defaults to node address", func(t *testing.T) {
+		dir := t.TempDir()
+		content := `
+assets:
+  usdc:
+    transfer:
+      flat: "0.5"
+    app_session_withdrawal:
+      percentage: "0.25"
+`
+		require.NoError(t, os.WriteFile(filepath.Join(dir, feesFileName), []byte(content), 0o600))
+
+		engine, err := NewFeeEngineFromYaml(dir, defaultAssetStore(), testNodeAddress)
+		require.NoError(t, err)
+		assert.Equal(t, testNodeAddress, engine.FeeAccount())
+		assert.Equal(t, "0.5", engine.CalculateFee("usdc", core.GatedActionTransfer, decimal.NewFromInt(10)).String())
+		assert.Equal(t, "0.025", engine.CalculateFee("usdc", core.GatedActionAppSessionWithdrawal, decimal.NewFromInt(10)).String())
+	})
+
+	t.Run("custom fee account", func(t *testing.T) {
+		dir := t.TempDir()
+		content := `fee_account: "0x2222222222222222222222222222222222222222"`
+		require.NoError(t, os.WriteFile(filepath.Join(dir, feesFileName), []byte(content), 0o600))
+
+		engine, err := NewFeeEngineFromYaml(dir, defaultAssetStore(), testNodeAddress)
+		require.NoError(t, err)
+		assert.Equal(t, "0x2222222222222222222222222222222222222222", engine.FeeAccount())
+	})
+
+	t.Run("invalid yaml", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, feesFileName), []byte("assets: ["), 0o600))
+
+		_, err := NewFeeEngineFromYaml(dir, defaultAssetStore(), testNodeAddress)
+		assert.Error(t, err)
+	})
+}
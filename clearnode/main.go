@@ -9,16 +9,15 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/layer-3/nitrolite/clearnode/api"
+	"github.com/layer-3/nitrolite/clearnode/api/channel_v1"
 	"github.com/layer-3/nitrolite/clearnode/event_handlers"
 	"github.com/layer-3/nitrolite/clearnode/metrics"
 	"github.com/layer-3/nitrolite/clearnode/store/database"
 	"github.com/layer-3/nitrolite/clearnode/stress"
-	"github.com/layer-3/nitrolite/pkg/blockchain/evm"
+	"github.com/layer-3/nitrolite/pkg/blockchain"
 	"github.com/layer-3/nitrolite/pkg/core"
 	"github.com/layer-3/nitrolite/pkg/log"
 )
@@ -39,116 +38,20 @@ func main() {
 	blockchainCtx, cancelBlockchain := context.WithCancel(rootCtx)
 	ctx := rootCtx
 
-	vl := bb.ValidationLimits
-	rpcRouterCfg := api.RPCRouterConfig{
-		NodeVersion:               bb.NodeVersion,
-		MinChallenge:              bb.ChannelMinChallengeDuration,
-		MaxParticipants:           vl.MaxParticipants,
-		MaxSessionDataLen:         vl.MaxSessionDataLen,
-		MaxAppMetadataLen:         vl.MaxAppMetadataLen,
-		MaxRebalanceSignedUpdates: vl.MaxSignedUpdates,
-		MaxSessionKeyIDs:          vl.MaxSessionKeyIDs,
-		RateLimitPerSec:           bb.RateLimitPerSec,
-		RateLimitBurst:            bb.RateLimitBurst,
-	}
-	api.NewRPCRouter(rpcRouterCfg, bb.RpcNode, bb.StateSigner, bb.DbStore, bb.MemoryStore, bb.ActionGateway, bb.RuntimeMetrics, bb.Logger)
-
 	rpcListenAddr := ":7824"
 	rpcListenEndpoint := "/ws"
+	httpRpcListenEndpoint := "/rpc"
 	rpcMux := http.NewServeMux()
 	rpcMux.HandleFunc(rpcListenEndpoint, bb.RpcNode.ServeHTTP)
+	rpcMux.HandleFunc(httpRpcListenEndpoint, bb.HttpRpcNode.ServeHTTP)
 
 	rpcServer := &http.Server{
 		Addr:    rpcListenAddr,
 		Handler: rpcMux,
 	}
 
-	blockchains, err := bb.MemoryStore.GetBlockchains()
-	if err != nil {
-		logger.Fatal("failed to get blockchains from memory store", "error", err)
-	}
-
-	wrapInTx := func(handler func(database.DatabaseStore) error) error {
-		return bb.DbStore.ExecuteInTransaction(handler)
-	}
-	useEHV1StoreInTx := func(h event_handlers.StoreTxHandler) error {
-		return wrapInTx(func(s database.DatabaseStore) error { return h(s) })
-	}
-
-	eventHandlerService := event_handlers.NewEventHandlerService(useEHV1StoreInTx, logger)
-
-	for _, b := range blockchains {
-		rpcURL, ok := bb.BlockchainRPCs[b.ID]
-		if !ok {
-			logger.Fatal("no RPC URL configured for blockchain", "blockchainID", b.ID)
-		}
-
-		client, err := ethclient.Dial(rpcURL)
-		if err != nil {
-			logger.Fatal("failed to connect to EVM Node")
-		}
-
-		if b.ChannelHubAddress != "" {
-			// For the node itself, the node address is the signer's address
-			nodeAddress := bb.StateSigner.PublicKey().Address().String()
-
-			clientOpts := []evm.ClientOption{
-				evm.ClientBalanceCheck{RequireBalanceCheck: false},
-				evm.ClientAllowanceCheck{RequireAllowanceCheck: false},
-			}
-
-			blockchainClient, err := evm.NewBlockchainClient(common.HexToAddress(b.ChannelHubAddress), client, bb.TxSigner, b.ID, nodeAddress, bb.MemoryStore, clientOpts...)
-			if err != nil {
-				logger.Fatal("failed to create EVM client")
-			}
-
-			sigValidators, err := bb.MemoryStore.GetChannelSigValidators(b.ID)
-			if err != nil {
-				logger.Fatal("failed to get channel signature validators from memory store", "error", err, "blockchainID", b.ID)
-			}
-
-			if err := ensureSigValidatorsRegistered(blockchainClient, sigValidators, true); err != nil {
-				logger.Fatal("failed to ensure signature validators are registered", "error", err, "blockchainID", b.ID)
-			}
-
-			reactor := evm.NewChannelHubReactor(b.ID, eventHandlerService, bb.DbStore.StoreContractEvent)
-			reactor.SetOnEventProcessed(bb.RuntimeMetrics.IncBlockchainEvent)
-			l := evm.NewListener(common.HexToAddress(b.ChannelHubAddress), client, b.ID, b.BlockStep, logger, reactor.HandleEvent, bb.DbStore.GetLatestEvent)
-			l.Listen(blockchainCtx, func(err error) {
-				if err != nil {
-					logger.Fatal("blockchain listener stopped", "error", err, "blockchainID", b.ID)
-				}
-			})
-
-			worker := NewBlockchainWorker(b.ID, blockchainClient, bb.DbStore, logger, bb.RuntimeMetrics)
-			worker.Start(blockchainCtx, func(err error) {
-				if err != nil {
-					logger.Fatal("blockchain worker stopped", "error", err, "blockchainID", b.ID)
-				}
-			})
-		} else {
-			logger.Info("channel hub address is not configured for blockchain", "blockchainID", b.ID)
-		}
-
-		if b.LockingContractAddress != "" {
-			appRegistryClient, err := evm.NewLockingClient(common.HexToAddress(b.LockingContractAddress), client, b.ID)
-			if err != nil {
-				logger.Fatal("failed to create locking client", "error", err, "blockchainID", b.ID)
-			}
-
-			reactor, err := evm.NewLockingContractReactor(b.ID, eventHandlerService, appRegistryClient.GetTokenDecimals, bb.DbStore.StoreContractEvent)
-			if err != nil {
-				logger.Fatal("failed to create app registry reactor", "error", err, "blockchainID", b.ID)
-			}
-
-			reactor.SetOnEventProcessed(bb.RuntimeMetrics.IncBlockchainEvent)
-			l := evm.NewListener(common.HexToAddress(b.LockingContractAddress), client, b.ID, b.BlockStep, logger, reactor.HandleEvent, bb.DbStore.GetLatestEvent)
-			l.Listen(blockchainCtx, func(err error) {
-				if err != nil {
-					logger.Fatal("blockchain listener stopped", "error", err, "blockchainID", b.ID)
-				}
-			})
-		}
+	if err := startNode(ctx, blockchainCtx, bb); err != nil {
+		logger.Fatal("failed to start node", "error", err)
 	}
 
 	go runStoreMetricsExporter(ctx, 30*time.Second, bb.DbStore, bb.StoreMetrics, logger)
@@ -212,6 +115,139 @@ func main() {
 	logger.Info("shutdown complete")
 }
 
+// startNode registers the RPC handlers of the node and starts its blockchain listeners and workers
+// and its background services. The blockchain components run until blockchainCtx is done, the rest until ctx is done.
+func startNode(ctx, blockchainCtx context.Context, bb *Backbone) error {
+	logger := bb.Logger
+
+	blockchains, err := bb.MemoryStore.GetBlockchains()
+	if err != nil {
+		return fmt.Errorf("failed to get blockchains from memory store: %w", err)
+	}
+
+	wrapInTx := func(handler func(database.DatabaseStore) error) error {
+		return bb.DbStore.ExecuteInTransaction(handler)
+	}
+	useEHV1StoreInTx := func(h event_handlers.StoreTxHandler) error {
+		return wrapInTx(func(s database.DatabaseStore) error { return h(s) })
+	}
+
+	eventPublisher := channel_v1.NewEventPublisher(bb.RpcNode)
+	eventHandlerService := event_handlers.NewEventHandlerService(useEHV1StoreInTx, eventPublisher, logger)
+
+	// For the node itself, the node address is the signer's address
+	nodeAddress := bb.StateSigner.PublicKey().Address().String()
+	listenerConfig := blockchain.ListenerConfig{
+		StoreContractEvent: bb.DbStore.StoreContractEvent,
+		GetLatestEvent:     bb.DbStore.GetLatestEvent,
+		RollbackEvents:     bb.DbStore.RollbackContractEvents,
+		OnEventProcessed:   bb.RuntimeMetrics.IncBlockchainEvent,
+		OnCatchUpProgress:  bb.RuntimeMetrics.SetBlockchainCatchUpProgress,
+	}
+	statePackers := make(map[uint64]core.StatePacker)
+	blockchainClients := make(map[uint64]core.BlockchainClientV2)
+
+	for _, b := range blockchains {
+		rpcURLs, ok := bb.BlockchainRPCs[b.ID]
+		if !ok {
+			return fmt.Errorf("no RPC URL configured for blockchain %d", b.ID)
+		}
+
+		backend, err := bb.BlockchainBackends.New(blockchainCtx, blockchain.Config{
+			Blockchain:  b,
+			RPCURLs:     rpcURLs,
+			TxSigner:    bb.TxSigner,
+			NodeAddress: nodeAddress,
+			AssetStore:  bb.MemoryStore,
+			Logger:      logger,
+		})
+		if err != nil {
+			return err
+		}
+		statePackers[b.ID] = backend.StatePacker()
+
+		if b.ChannelHubAddress != "" {
+			blockchainClient, err := backend.ChannelHubClient()
+			if err != nil {
+				return fmt.Errorf("failed to create ChannelHub client for blockchain %d: %w", b.ID, err)
+			}
+			blockchainClients[b.ID] = blockchainClient
+
+			sigValidators, err := bb.MemoryStore.GetChannelSigValidators(b.ID)
+			if err != nil {
+				return fmt.Errorf("failed to get channel signature validators of blockchain %d: %w", b.ID, err)
+			}
+
+			if err := ensureSigValidatorsRegistered(ctx, blockchainClient, sigValidators, true); err != nil {
+				return fmt.Errorf("failed to ensure signature validators are registered on blockchain %d: %w", b.ID, err)
+			}
+
+			err = backend.ListenChannelHub(blockchainCtx, eventHandlerService, listenerConfig, func(err error) {
+				if err != nil {
+					logger.Fatal("blockchain listener stopped", "error", err, "blockchainID", b.ID)
+				}
+			})
+			if err != nil {
+				return fmt.Errorf("failed to listen to ChannelHub events on blockchain %d: %w", b.ID, err)
+			}
+
+			worker := NewBlockchainWorker(b.ID, blockchainClient, backend.TxTracker(), bb.DbStore, logger, bb.RuntimeMetrics, eventPublisher, bb.WorkerTickInterval)
+			worker.Start(blockchainCtx, func(err error) {
+				if err != nil {
+					logger.Fatal("blockchain worker stopped", "error", err, "blockchainID", b.ID)
+				}
+			})
+		} else {
+			logger.Info("channel hub address is not configured for blockchain", "blockchainID", b.ID)
+		}
+
+		if b.LockingContractAddress != "" {
+			err = backend.ListenLocking(blockchainCtx, eventHandlerService, listenerConfig, func(err error) {
+				if err != nil {
+					logger.Fatal("blockchain listener stopped", "error", err, "blockchainID", b.ID)
+				}
+			})
+			if err != nil {
+				return fmt.Errorf("failed to listen to locking contract events on blockchain %d: %w", b.ID, err)
+			}
+		}
+	}
+
+	statePacker := blockchain.NewStatePackerRouter(statePackers, core.NewStatePackerV1(bb.MemoryStore))
+
+	vl := bb.ValidationLimits
+	rpcRouterCfg := api.RPCRouterConfig{
+		NodeVersion:               bb.NodeVersion,
+		MinChallenge:              bb.ChannelMinChallengeDuration,
+		MaxParticipants:           vl.MaxParticipants,
+		MaxSessionDataLen:         vl.MaxSessionDataLen,
+		MaxAppMetadataLen:         vl.MaxAppMetadataLen,
+		MaxRebalanceSignedUpdates: vl.MaxSignedUpdates,
+		MaxSessionKeyIDs:          vl.MaxSessionKeyIDs,
+		RateLimitPerSec:           bb.RateLimitPerSec,
+		RateLimitBurst:            bb.RateLimitBurst,
+		RestrictPrivateReads:      bb.RestrictPrivateReads,
+		StatePacker:               statePacker,
+		NodeBalances:              blockchain.NewNodeBalanceRouter(blockchainClients),
+	}
+	api.NewRPCRouter(rpcRouterCfg, bb.RpcNode, bb.StateSigner, bb.DbStore, bb.MemoryStore, bb.ActionGateway, bb.FeeEngine, bb.AppStateValidators, bb.RuntimeMetrics, bb.Logger)
+	api.NewRPCRouter(rpcRouterCfg, bb.HttpRpcNode, bb.StateSigner, bb.DbStore, bb.MemoryStore, bb.ActionGateway, bb.FeeEngine, bb.AppStateValidators, bb.RuntimeMetrics, bb.Logger)
+
+	nodeChannelSigner, err := core.NewChannelDefaultSigner(bb.StateSigner)
+	if err != nil {
+		return fmt.Errorf("failed to create channel signer: %w", err)
+	}
+	useChannelV1StoreInTx := func(h channel_v1.StoreTxHandler) error {
+		return wrapInTx(func(s database.DatabaseStore) error { return h(s) })
+	}
+	conditionalTransferSweeper := channel_v1.NewConditionalTransferSweeper(useChannelV1StoreInTx, nodeChannelSigner, statePacker, eventPublisher, 10*time.Second, 100, logger)
+	go conditionalTransferSweeper.Run(ctx)
+	paymentStreamScheduler := channel_v1.NewPaymentStreamScheduler(useChannelV1StoreInTx, bb.MemoryStore, bb.FeeEngine, nodeChannelSigner, statePacker, eventPublisher, 10*time.Second, 100, bb.PaymentStreamCreditPeriod, logger)
+	go paymentStreamScheduler.Run(ctx)
+
+	return nil
+}
+
 func runOperatorCommand(args []string) {
 	if len(args) == 0 {
 		fmt.Println("Usage: clearnode operator <command>")
@@ -255,25 +291,26 @@ func runRegisterValidators() {
 			continue
 		}
 
-		rpcURL, ok := bb.BlockchainRPCs[b.ID]
+		rpcURLs, ok := bb.BlockchainRPCs[b.ID]
 		if !ok {
 			logger.Fatal("no RPC URL configured for blockchain", "blockchainID", b.ID)
 		}
 
-		client, err := ethclient.Dial(rpcURL)
+		backend, err := bb.BlockchainBackends.New(context.Background(), blockchain.Config{
+			Blockchain:  b,
+			RPCURLs:     rpcURLs,
+			TxSigner:    bb.TxSigner,
+			NodeAddress: bb.StateSigner.PublicKey().Address().String(),
+			AssetStore:  bb.MemoryStore,
+			Logger:      logger,
+		})
 		if err != nil {
-			logger.Fatal("failed to connect to EVM Node", "blockchainID", b.ID)
-		}
-
-		nodeAddress := bb.StateSigner.PublicKey().Address().String()
-		clientOpts := []evm.ClientOption{
-			evm.ClientBalanceCheck{RequireBalanceCheck: false},
-			evm.ClientAllowanceCheck{RequireAllowanceCheck: false},
+			logger.Fatal("failed to create blockchain backend", "error", err, "blockchainID", b.ID)
 		}
 
-		blockchainClient, err := evm.NewBlockchainClient(common.HexToAddress(b.ChannelHubAddress), client, bb.TxSigner, b.ID, nodeAddress, bb.MemoryStore, clientOpts...)
+		blockchainClient, err := backend.ChannelHubClient()
 		if err != nil {
-			logger.Fatal("failed to create EVM client", "blockchainID", b.ID)
+			logger.Fatal("failed to create ChannelHub client", "error", err, "blockchainID", b.ID)
 		}
 
 		sigValidators, err := bb.MemoryStore.GetChannelSigValidators(b.ID)
@@ -281,9 +318,10 @@ func runRegisterValidators() {
 			logger.Fatal("failed to get channel signature validators from memory store", "error", err, "blockchainID", b.ID)
 		}
 
-		if err := ensureSigValidatorsRegistered(blockchainClient, sigValidators, false); err != nil {
+		if err := ensureSigValidatorsRegistered(context.Background(), blockchainClient, sigValidators, false); err != nil {
 			logger.Fatal("failed to register signature validators", "error", err, "blockchainID", b.ID)
 		}
+		backend.Close()
 
 		logger.Info("signature validators registered successfully", "blockchainID", b.ID)
 	}
@@ -291,9 +329,9 @@ func runRegisterValidators() {
 	logger.Info("all signature validators registered")
 }
 
-func ensureSigValidatorsRegistered(client core.BlockchainClient, validators map[uint8]string, checkOnly bool) error {
+func ensureSigValidatorsRegistered(ctx context.Context, client core.BlockchainClientV2, validators map[uint8]string, checkOnly bool) error {
 	for id, addr := range validators {
-		if err := client.EnsureSigValidatorRegistered(id, addr, checkOnly); err != nil {
+		if err := client.EnsureSigValidatorRegistered(ctx, id, addr, checkOnly); err != nil {
 			return err
 		}
 	}
@@ -117,7 +117,10 @@ type runtimeMetricExporter struct {
 	blockchainActionsTotal *prometheus.CounterVec
 
 	// Event Listener
-	blockchainEventsTotal *prometheus.CounterVec
+	blockchainEventsTotal    *prometheus.CounterVec
+	blockchainCatchUpFetched *prometheus.GaugeVec
+	blockchainCatchUpTarget  *prometheus.GaugeVec
+	blockchainCatchUpRange   *prometheus.GaugeVec
 
 	// Metric Worker
 	channelSessionKeysTotal prometheus.Counter
@@ -207,6 +210,21 @@ func NewRuntimeMetricExporter(reg prometheus.Registerer) (RuntimeMetricExporter,
 			Name:      "blockchain_events_total",
 			Help:      "Total number of blockchain events processed",
 		}, []string{"blockchain_id", "process_result"}),
+		blockchainCatchUpFetched: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Name:      "blockchain_catch_up_fetched_block",
+			Help:      "Latest block up to which historical events were fetched",
+		}, []string{"blockchain_id", "contract_address"}),
+		blockchainCatchUpTarget: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Name:      "blockchain_catch_up_target_block",
+			Help:      "Block up to which historical events are being fetched",
+		}, []string{"blockchain_id", "contract_address"}),
+		blockchainCatchUpRange: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Name:      "blockchain_catch_up_block_range",
+			Help:      "Current block range of historical event queries",
+		}, []string{"blockchain_id", "contract_address"}),
 	}
 
 	if reg != nil {
@@ -223,6 +241,9 @@ func NewRuntimeMetricExporter(reg prometheus.Registerer) (RuntimeMetricExporter,
 			m.appSessionUpdateSigValidationsTotal,
 			m.blockchainActionsTotal,
 			m.blockchainEventsTotal,
+			m.blockchainCatchUpFetched,
+			m.blockchainCatchUpTarget,
+			m.blockchainCatchUpRange,
 			m.channelSessionKeysTotal,
 			m.appSessionKeysTotal,
 		)
@@ -310,6 +331,13 @@ func (m *runtimeMetricExporter) IncBlockchainEvent(blockchainID uint64, result b
 	m.blockchainEventsTotal.WithLabelValues(stringBlockchainID, res.String()).Inc()
 }
 
+func (m *runtimeMetricExporter) SetBlockchainCatchUpProgress(blockchainID uint64, contractAddress string, fetchedBlock, targetBlock, blockRange uint64) {
+	stringBlockchainID := strconv.FormatUint(blockchainID, 10)
+	m.blockchainCatchUpFetched.WithLabelValues(stringBlockchainID, contractAddress).Set(float64(fetchedBlock))
+	m.blockchainCatchUpTarget.WithLabelValues(stringBlockchainID, contractAddress).Set(float64(targetBlock))
+	m.blockchainCatchUpRange.WithLabelValues(stringBlockchainID, contractAddress).Set(float64(blockRange))
+}
+
 // Metric Worker
 func (m *runtimeMetricExporter) IncChannelSessionKeys() {
 	m.channelSessionKeysTotal.Inc()
@@ -33,7 +33,8 @@ type RuntimeMetricExporter interface {
 	IncBlockchainAction(asset string, blockchainID uint64, actionType string, success bool) // +
 
 	// Event Listener
-	IncBlockchainEvent(blockchainID uint64, handledSuccessfully bool) // +
+	IncBlockchainEvent(blockchainID uint64, handledSuccessfully bool)                                                       // +
+	SetBlockchainCatchUpProgress(blockchainID uint64, contractAddress string, fetchedBlock, targetBlock, blockRange uint64) // +
 }
 
 // noopRuntimeMetricExporter is a no-op implementation for use in tests.
@@ -55,6 +56,8 @@ func (noopRuntimeMetricExporter) IncAppSessionUpdateSigValidation(string, app.Ap
 func (noopRuntimeMetricExporter) IncBlockchainAction(string, uint64, string, bool) {
 }
 func (noopRuntimeMetricExporter) IncBlockchainEvent(uint64, bool) {}
+func (noopRuntimeMetricExporter) SetBlockchainCatchUpProgress(uint64, string, uint64, uint64, uint64) {
+}
 
 // StoreMetricExporter defines the interface for setting metrics that are stored and updated by a separate metric worker.
 type StoreMetricExporter interface {
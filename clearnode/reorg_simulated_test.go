@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/clearnode/event_handlers"
+	"github.com/layer-3/nitrolite/clearnode/store/database"
+	"github.com/layer-3/nitrolite/pkg/blockchain/evm"
+	"github.com/layer-3/nitrolite/pkg/log"
+)
+
+// TestChannelHubEvents_ReorgedEventNotMinedAgain_Simulated handles a vault deposit whose block is
+// reorganized out of the chain, while the deposit transaction is replaced and never mined again.
+// The handled event must be rolled back together with the vault balance it credited.
+func TestChannelHubEvents_ReorgedEventNotMinedAgain_Simulated(t *testing.T) {
+	nodeKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	nodeAddress := crypto.PubkeyToAddress(nodeKey.PublicKey)
+
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		nodeAddress: {Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(1e18))},
+	})
+	t.Cleanup(func() { backend.Close() })
+
+	chainID, err := backend.Client().ChainID(context.Background())
+	require.NoError(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(nodeKey, chainID)
+	require.NoError(t, err)
+	hubAddress, channelHub := deploySimulatedChannelHub(t, opts, backend)
+
+	db, cleanup := database.SetupTestDB(t)
+	t.Cleanup(cleanup)
+	store := database.NewDBStore(db)
+
+	useStoreInTx := func(h event_handlers.StoreTxHandler) error {
+		return store.ExecuteInTransaction(func(s database.DatabaseStore) error { return h(s) })
+	}
+	handlers := event_handlers.NewEventHandlerService(useStoreInTx, nil, log.NewNoopLogger())
+	reactor := evm.NewChannelHubReactor(chainID.Uint64(), handlers, store.StoreContractEvent)
+	reactor.SetAssetStore(simulatedWorkerAssetStore{})
+
+	listener := evm.NewListener(hubAddress, backend.Client(), chainID.Uint64(), 100, log.NewNoopLogger(), reactor.HandleEvent, store.GetLatestEvent)
+	listener.SetRollbackEvents(store.RollbackContractEvents)
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan error, 1)
+	listener.Listen(ctx, func(err error) { stopped <- err })
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-stopped)
+	})
+	time.Sleep(200 * time.Millisecond) // let the listener subscribe
+
+	vaultBalance := func() decimal.Decimal {
+		balance, err := store.GetVaultBalance(nodeAddress.Hex(), chainID.Uint64(), common.Address{}.Hex())
+		require.NoError(t, err)
+		return balance
+	}
+
+	depositOpts := *opts
+	depositOpts.Value = big.NewInt(1e18)
+	deposit, err := channelHub.DepositToVault(&depositOpts, nodeAddress, common.Address{}, depositOpts.Value)
+	require.NoError(t, err)
+	backend.Commit()
+	receipt, err := backend.Client().TransactionReceipt(context.Background(), deposit.Hash())
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return vaultBalance().Equal(decimal.NewFromInt(1)) }, 5*time.Second, 10*time.Millisecond)
+
+	// The deposit block is reorganized out and the deposit is replaced by a transfer with the same nonce
+	parent, err := backend.Client().HeaderByNumber(context.Background(), new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1)))
+	require.NoError(t, err)
+	require.NoError(t, backend.Fork(parent.Hash()))
+	// Drop the deposit that the transaction pool takes back from the reorganized block
+	backend.Rollback()
+
+	replacement, err := types.SignNewTx(nodeKey, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     deposit.Nonce(),
+		GasTipCap: new(big.Int).Mul(deposit.GasTipCap(), big.NewInt(10)),
+		GasFeeCap: new(big.Int).Mul(deposit.GasFeeCap(), big.NewInt(10)),
+		Gas:       21000,
+		To:        &nodeAddress,
+	})
+	require.NoError(t, err)
+	require.NoError(t, backend.Client().SendTransaction(context.Background(), replacement))
+	for range 3 {
+		backend.Commit()
+	}
+
+	_, err = backend.Client().TransactionReceipt(context.Background(), replacement.Hash())
+	require.NoError(t, err, "the replacement is mined on the new chain")
+	_, err = backend.Client().TransactionReceipt(context.Background(), deposit.Hash())
+	require.ErrorIs(t, err, ethereum.NotFound, "the deposit is not mined again")
+
+	require.Eventually(t, func() bool { return vaultBalance().IsZero() }, 15*time.Second, 10*time.Millisecond,
+		"the vault balance credited by the reorganized deposit is reverted")
+
+	latest, err := store.GetLatestEvent(hubAddress.Hex(), chainID.Uint64())
+	require.NoError(t, err)
+	assert.Less(t, latest.BlockNumber, receipt.BlockNumber.Uint64(), "the reorganized event is removed")
+
+	var effects []database.ContractEventEffect
+	require.NoError(t, db.Find(&effects).Error)
+	assert.Empty(t, effects)
+}
@@ -16,9 +16,13 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/layer-3/nitrolite/clearnode/action_gateway"
+	"github.com/layer-3/nitrolite/clearnode/api"
+	"github.com/layer-3/nitrolite/clearnode/fee_engine"
 	"github.com/layer-3/nitrolite/clearnode/metrics"
 	"github.com/layer-3/nitrolite/clearnode/store/database"
 	"github.com/layer-3/nitrolite/clearnode/store/memory"
+	"github.com/layer-3/nitrolite/pkg/app"
+	"github.com/layer-3/nitrolite/pkg/blockchain"
 	"github.com/layer-3/nitrolite/pkg/blockchain/evm"
 	"github.com/layer-3/nitrolite/pkg/core"
 	"github.com/layer-3/nitrolite/pkg/log"
@@ -35,15 +39,22 @@ var Version = "v1.0.0" // set at build time with -ldflags "-X main.Version=x.y.z
 type Backbone struct {
 	NodeVersion                 string
 	ChannelMinChallengeDuration uint32
-	BlockchainRPCs              map[uint64]string
+	BlockchainRPCs              map[uint64][]string
 	ValidationLimits            ValidationLimits
 	RateLimitPerSec             float64
 	RateLimitBurst              float64
+	RestrictPrivateReads        bool
+	PaymentStreamCreditPeriod   time.Duration
+	WorkerTickInterval          time.Duration
+	AppStateValidators          *app.AppStateValidatorRegistryV1
+	BlockchainBackends          *blockchain.BackendRegistry
 
 	DbStore        database.DatabaseStore
 	MemoryStore    memory.MemoryStore
 	ActionGateway  *action_gateway.ActionGateway
+	FeeEngine      *fee_engine.FeeEngine
 	RpcNode        rpc.Node
+	HttpRpcNode    rpc.Node
 	StateSigner    sign.Signer
 	TxSigner       sign.Signer
 	Logger         log.Logger
@@ -72,8 +83,12 @@ type Config struct {
 	ValidationLimits            ValidationLimits `yaml:"validation_limits"`
 	RateLimitPerSec             float64          `yaml:"rate_limit_per_sec" env:"CLEARNODE_RATE_LIMIT_PER_SEC" env-default:"10"`
 	RateLimitBurst              float64          `yaml:"rate_limit_burst" env:"CLEARNODE_RATE_LIMIT_BURST" env-default:"20"`
+	RestrictPrivateReads        bool             `yaml:"restrict_private_reads" env:"CLEARNODE_RESTRICT_PRIVATE_READS" env-default:"false"` // limit private reads to the authenticated wallet
+	SignRPCResponses            bool             `yaml:"sign_rpc_responses" env:"CLEARNODE_SIGN_RPC_RESPONSES" env-default:"false"`         // sign RPC responses with the node key
 	WsProcessBufferSize         int              `yaml:"ws_process_buffer_size" env:"CLEARNODE_WS_PROCESS_BUFFER_SIZE" env-default:"64"`
 	WsWriteBufferSize           int              `yaml:"ws_write_buffer_size" env:"CLEARNODE_WS_WRITE_BUFFER_SIZE" env-default:"64"`
+	PaymentStreamCreditPeriod   time.Duration    `yaml:"payment_stream_credit_period" env:"CLEARNODE_PAYMENT_STREAM_CREDIT_PERIOD" env-default:"1h"` // stream accrual settled without the sender's signature
+	HTTPTrustedProxies          []string         `yaml:"http_trusted_proxies" env:"CLEARNODE_HTTP_TRUSTED_PROXIES" env-separator:","`                // proxies whose X-Forwarded-For header identifies HTTP clients
 }
 
 // ValidationLimits defines configurable upper bounds for dynamic-length request fields.
@@ -189,6 +204,15 @@ func InitBackbone() *Backbone {
 
 	logger.Info("signer initialized", "type", conf.SignerType, "address", stateSigner.PublicKey().Address())
 
+	// ------------------------------------------------
+	// Fee Engine
+	// ------------------------------------------------
+
+	feeEngine, err := fee_engine.NewFeeEngineFromYaml(configDirPath, memoryStore, stateSigner.PublicKey().Address().String())
+	if err != nil {
+		logger.Fatal("failed to initialize fee engine", "error", err)
+	}
+
 	// ------------------------------------------------
 	// Metrics
 	// ------------------------------------------------
@@ -206,8 +230,15 @@ func InitBackbone() *Backbone {
 	// RPC Node
 	// ------------------------------------------------
 
+	// Signing is opt-in, as older clients reject messages carrying the extra signature element
+	var rpcSigner sign.Signer
+	if conf.SignRPCResponses {
+		rpcSigner = stateSigner
+	}
+
 	rpcNode, err := rpc.NewWebsocketNode(rpc.WebsocketNodeConfig{
 		Logger:                  logger,
+		Signer:                  rpcSigner,
 		ObserveConnections:      runtimeMetrics.SetRPCConnections,
 		WsConnProcessBufferSize: conf.WsProcessBufferSize,
 		WsConnWriteBufferSize:   conf.WsWriteBufferSize,
@@ -216,6 +247,29 @@ func InitBackbone() *Backbone {
 		logger.Fatal("failed to initialize RPC node", "error", err)
 	}
 
+	// The HTTP node serves the same handlers for plain request/response clients.
+	// Events raised by its handlers are delivered to the WebSocket subscribers.
+	// HTTP requests only share their rate limit, per client IP found through the trusted proxies.
+	httpRpcNode, err := rpc.NewHTTPNode(rpc.HTTPNodeConfig{
+		Logger:              logger,
+		NotifyFn:            rpcNode.Notify,
+		Signer:              rpcSigner,
+		TrustedProxies:      conf.HTTPTrustedProxies,
+		ClientIPStorageKeys: []string{api.RateLimitStorageKey},
+	})
+	if err != nil {
+		logger.Fatal("failed to initialize HTTP RPC node", "error", err)
+	}
+
+	// ------------------------------------------------
+	// Blockchain Backends
+	// ------------------------------------------------
+
+	blockchainBackends := blockchain.NewBackendRegistry()
+	if err := blockchainBackends.Register(evm.BackendType, evm.NewBackend); err != nil {
+		logger.Fatal("failed to register blockchain backend", "type", evm.BackendType, "error", err)
+	}
+
 	// ------------------------------------------------
 	// Blockchain RPCs
 	// ------------------------------------------------
@@ -225,26 +279,48 @@ func InitBackbone() *Backbone {
 		logger.Fatal("failed to get blockchains", "error", err)
 	}
 
-	blockchainRPCs := make(map[uint64]string)
+	blockchainRPCs := make(map[uint64][]string)
 	for _, bc := range blockchains {
 		envVarName := "CLEARNODE_BLOCKCHAIN_RPC_" + strings.ToUpper(bc.Name)
-		rpcURL := os.Getenv(envVarName)
-		if rpcURL == "" {
+		var rpcURLs []string
+		for _, rpcURL := range strings.Split(os.Getenv(envVarName), ",") {
+			if rpcURL = strings.TrimSpace(rpcURL); rpcURL != "" {
+				rpcURLs = append(rpcURLs, rpcURL)
+			}
+		}
+		if len(rpcURLs) == 0 {
 			logger.Fatal("blockchain RPC URL not set in env", "blockchainID", bc.ID, "env_var", envVarName)
 		}
 
-		// Test connection
-		if err := checkChainId(rpcURL, bc.ID); err != nil {
-			logger.Fatal("failed to verify blockchain RPC", "blockchainID", bc.ID, "error", err)
+		// The checks below are specific to EVM endpoints, other backends verify their endpoints themselves
+		if bc.Type != evm.BackendType {
+			blockchainRPCs[bc.ID] = rpcURLs
+			continue
 		}
 
-		// Verify ChannelHub version
-		channelHubAddress := common.HexToAddress(bc.ChannelHubAddress)
-		if err := checkChannelHubVersion(rpcURL, channelHubAddress, core.ChannelHubVersion); err != nil {
-			logger.Fatal("failed to verify ChannelHub version", "blockchainID", bc.ID, "address", bc.ChannelHubAddress, "error", err)
+		// Endpoints failing the checks are left out, as long as enough of them remain
+		verifiedRPCURLs := make([]string, 0, len(rpcURLs))
+		for i, rpcURL := range rpcURLs {
+			// Test connection
+			if err := checkChainId(rpcURL, bc.ID); err != nil {
+				logger.Error("failed to verify blockchain RPC", "blockchainID", bc.ID, "rpcIndex", i, "error", err)
+				continue
+			}
+
+			// Verify ChannelHub version
+			channelHubAddress := common.HexToAddress(bc.ChannelHubAddress)
+			if err := checkChannelHubVersion(rpcURL, channelHubAddress, core.ChannelHubVersion); err != nil {
+				logger.Error("failed to verify ChannelHub version", "blockchainID", bc.ID, "rpcIndex", i, "address", bc.ChannelHubAddress, "error", err)
+				continue
+			}
+
+			verifiedRPCURLs = append(verifiedRPCURLs, rpcURL)
+		}
+		if len(verifiedRPCURLs) == 0 || len(verifiedRPCURLs) < bc.RPCQuorum {
+			logger.Fatal("not enough verified blockchain RPCs", "blockchainID", bc.ID, "verified", len(verifiedRPCURLs), "configured", len(rpcURLs), "quorum", bc.RPCQuorum)
 		}
 
-		blockchainRPCs[bc.ID] = rpcURL
+		blockchainRPCs[bc.ID] = verifiedRPCURLs
 	}
 
 	return &Backbone{
@@ -254,11 +330,18 @@ func InitBackbone() *Backbone {
 		ValidationLimits:            conf.ValidationLimits,
 		RateLimitPerSec:             conf.RateLimitPerSec,
 		RateLimitBurst:              conf.RateLimitBurst,
+		RestrictPrivateReads:        conf.RestrictPrivateReads,
+		PaymentStreamCreditPeriod:   conf.PaymentStreamCreditPeriod,
+		WorkerTickInterval:          blockchainWorkerTickInterval,
+		AppStateValidators:          app.NewAppStateValidatorRegistryV1(),
+		BlockchainBackends:          blockchainBackends,
 
 		DbStore:        dbStore,
 		MemoryStore:    memoryStore,
 		ActionGateway:  actionGateway,
+		FeeEngine:      feeEngine,
 		RpcNode:        rpcNode,
+		HttpRpcNode:    httpRpcNode,
 		StateSigner:    stateSigner,
 		TxSigner:       txSigner,
 		Logger:         logger,
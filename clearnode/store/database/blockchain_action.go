@@ -2,8 +2,10 @@ package database
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
@@ -18,6 +20,9 @@ const (
 
 	ActionTypeInitiateEscrowWithdrawal BlockchainActionType = 20
 	ActionTypeFinalizeEscrowWithdrawal BlockchainActionType = 21
+
+	ActionTypeInitiateMigration BlockchainActionType = 30
+	ActionTypeFinalizeMigration BlockchainActionType = 31
 )
 
 func (t BlockchainActionType) String() string {
@@ -32,6 +37,10 @@ func (t BlockchainActionType) String() string {
 		return "initiate_escrow_withdrawal"
 	case ActionTypeFinalizeEscrowWithdrawal:
 		return "finalize_escrow_withdrawal"
+	case ActionTypeInitiateMigration:
+		return "initiate_migration"
+	case ActionTypeFinalizeMigration:
+		return "finalize_migration"
 	default:
 		return fmt.Sprintf("unknown(%d)", t)
 	}
@@ -43,8 +52,33 @@ const (
 	BlockchainActionStatusPending BlockchainActionStatus = iota
 	BlockchainActionStatusCompleted
 	BlockchainActionStatusFailed
+	// BlockchainActionStatusSent means the transaction was broadcast and awaits confirmations.
+	BlockchainActionStatusSent
+	// BlockchainActionStatusConfirmed means the transaction succeeded and has enough confirmations.
+	BlockchainActionStatusConfirmed
+	// BlockchainActionStatusReverted means the transaction was included on-chain, but reverted.
+	BlockchainActionStatusReverted
 )
 
+func (s BlockchainActionStatus) String() string {
+	switch s {
+	case BlockchainActionStatusPending:
+		return "pending"
+	case BlockchainActionStatusCompleted:
+		return "completed"
+	case BlockchainActionStatusFailed:
+		return "failed"
+	case BlockchainActionStatusSent:
+		return "sent"
+	case BlockchainActionStatusConfirmed:
+		return "confirmed"
+	case BlockchainActionStatusReverted:
+		return "reverted"
+	default:
+		return fmt.Sprintf("unknown(%d)", s)
+	}
+}
+
 type BlockchainAction struct {
 	ID           int64                  `gorm:"primary_key"`
 	Type         BlockchainActionType   `gorm:"column:action_type;not null"`
@@ -55,6 +89,7 @@ type BlockchainAction struct {
 	Retries      uint8                  `gorm:"column:retry_count;default:0"`
 	Error        string                 `gorm:"column:last_error;type:text"`
 	TxHash       string                 `gorm:"column:transaction_hash;size:66"`
+	TxNonce      *uint64                `gorm:"column:transaction_nonce"`
 	CreatedAt    time.Time              `gorm:"column:created_at"`
 	UpdatedAt    time.Time              `gorm:"column:updated_at"`
 }
@@ -88,6 +123,16 @@ func (s *DBStore) ScheduleFinalizeEscrowWithdrawal(stateID string, blockchainID
 	return s.scheduleStateEnforcement(stateID, blockchainID, ActionTypeFinalizeEscrowWithdrawal)
 }
 
+// ScheduleInitiateMigration queues a blockchain action to initiate a home channel migration on the new home blockchain.
+func (s *DBStore) ScheduleInitiateMigration(stateID string, blockchainID uint64) error {
+	return s.scheduleStateEnforcement(stateID, blockchainID, ActionTypeInitiateMigration)
+}
+
+// ScheduleFinalizeMigration queues a blockchain action to finalize a home channel migration on the old home blockchain.
+func (s *DBStore) ScheduleFinalizeMigration(stateID string, blockchainID uint64) error {
+	return s.scheduleStateEnforcement(stateID, blockchainID, ActionTypeFinalizeMigration)
+}
+
 // scheduleStateEnforcement is a helper to create a blockchain action for state enforcement.
 func (s *DBStore) scheduleStateEnforcement(stateID string, blockchainID uint64, actionType BlockchainActionType) error {
 	action := &BlockchainAction{
@@ -118,6 +163,51 @@ func (s *DBStore) Complete(actionID int64, txHash string) error {
 	return s.updateAction(actionID, BlockchainActionStatusCompleted, txHash, "", false)
 }
 
+// MarkSent records that the action's transaction was broadcast.
+// The nonce of a previously sent transaction is cleared, it is recorded by UpdateSentTx.
+func (s *DBStore) MarkSent(actionID int64, txHash string) error {
+	updates := map[string]any{
+		"status":            BlockchainActionStatusSent,
+		"transaction_hash":  txHash,
+		"transaction_nonce": nil,
+		"last_error":        "",
+		"updated_at":        time.Now(),
+	}
+
+	if err := s.db.Model(&BlockchainAction{}).Where("id = ?", actionID).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to update blockchain action: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateSentTx records the latest broadcast version of the action's transaction,
+// which is a fee-bumped replacement once the original was stuck, and its nonce.
+func (s *DBStore) UpdateSentTx(actionID int64, txHash string, nonce uint64) error {
+	updates := map[string]any{
+		"transaction_hash":  txHash,
+		"transaction_nonce": nonce,
+		"updated_at":        time.Now(),
+	}
+
+	if err := s.db.Model(&BlockchainAction{}).Where("id = ?", actionID).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to update blockchain action transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Confirm marks the action's transaction as succeeded with enough confirmations.
+// txHash replaces the sent hash, as the included transaction may be a fee-bumped replacement.
+func (s *DBStore) Confirm(actionID int64, txHash string) error {
+	return s.updateAction(actionID, BlockchainActionStatusConfirmed, txHash, "", false)
+}
+
+// MarkReverted marks the action's transaction as included on-chain, but reverted.
+func (s *DBStore) MarkReverted(actionID int64, txHash, err string) error {
+	return s.updateAction(actionID, BlockchainActionStatusReverted, txHash, err, false)
+}
+
 func (s *DBStore) updateAction(actionID int64, status BlockchainActionStatus, txHash, err string, increaseRetryCounter bool) error {
 	updates := map[string]any{
 		"status":     status,
@@ -140,8 +230,41 @@ func (s *DBStore) updateAction(actionID int64, status BlockchainActionStatus, tx
 }
 
 func (s *DBStore) GetActions(limit uint8, blockchainID uint64) ([]BlockchainAction, error) {
+	return s.getActionsByStatus(BlockchainActionStatusPending, limit, blockchainID)
+}
+
+// GetSentActions retrieves blockchain actions whose transactions await confirmations, oldest first.
+func (s *DBStore) GetSentActions(limit uint8, blockchainID uint64) ([]BlockchainAction, error) {
+	return s.getActionsByStatus(BlockchainActionStatusSent, limit, blockchainID)
+}
+
+// GetPendingNodeLocks returns the sum of the amounts the node is yet to lock on the blockchain in the token
+// for scheduled escrow withdrawal and migration initiations whose transactions are not confirmed yet.
+// The node balance reported by the blockchain doesn't include these amounts.
+func (s *DBStore) GetPendingNodeLocks(blockchainID uint64, tokenAddress string) (decimal.Decimal, error) {
+	var amounts []decimal.Decimal
+	err := s.db.Table("blockchain_actions a").
+		Joins("JOIN channel_states s ON s.id = a.state_id").
+		Joins("JOIN channels ec ON ec.channel_id = s.escrow_channel_id").
+		Where("a.action_type IN ? AND a.status IN ? AND a.blockchain_id = ? AND ec.token = ?",
+			[]BlockchainActionType{ActionTypeInitiateEscrowWithdrawal, ActionTypeInitiateMigration},
+			[]BlockchainActionStatus{BlockchainActionStatusPending, BlockchainActionStatusSent},
+			blockchainID, strings.ToLower(tokenAddress)).
+		Pluck("s.transition_amount", &amounts).Error
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to get pending node locks: %w", err)
+	}
+
+	total := decimal.Zero
+	for _, amount := range amounts {
+		total = total.Add(amount)
+	}
+	return total, nil
+}
+
+func (s *DBStore) getActionsByStatus(status BlockchainActionStatus, limit uint8, blockchainID uint64) ([]BlockchainAction, error) {
 	var actions []BlockchainAction
-	query := s.db.Where("status = ? AND blockchain_id = ?", BlockchainActionStatusPending, blockchainID).Order("created_at ASC")
+	query := s.db.Where("status = ? AND blockchain_id = ?", status, blockchainID).Order("created_at ASC")
 	if limit > 0 {
 		query = query.Limit(int(limit))
 	}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -354,6 +355,141 @@ func TestDBStore_Complete(t *testing.T) {
 	})
 }
 
+func TestDBStore_TxLifecycle(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	store := NewDBStore(db)
+
+	newAction := func(stateID string) BlockchainAction {
+		state := core.State{
+			ID:         stateID,
+			Asset:      "USDC",
+			UserWallet: "0xd234567890123456789012345678901234567890",
+			Epoch:      1,
+			Version:    1,
+			Transition: core.Transition{},
+			HomeLedger: core.Ledger{UserBalance: decimal.NewFromInt(100)},
+		}
+		require.NoError(t, store.StoreUserState(state))
+		require.NoError(t, store.ScheduleCheckpoint(state.ID, 1))
+
+		var action BlockchainAction
+		require.NoError(t, db.Where("state_id = ?", state.ID).First(&action).Error)
+		return action
+	}
+
+	confirmed := newAction("0xd134567890123456789012345678901234567890123456789012345678901234")
+	reverted := newAction("0xd234567890123456789012345678901234567890123456789012345678901234")
+	pending := newAction("0xd334567890123456789012345678901234567890123456789012345678901234")
+
+	sentHash := "0x1111111111111111111111111111111111111111111111111111111111111111"
+	bumpedHash := "0x2222222222222222222222222222222222222222222222222222222222222222"
+	require.NoError(t, store.MarkSent(confirmed.ID, sentHash))
+	require.NoError(t, store.MarkSent(reverted.ID, sentHash))
+
+	sent, err := store.GetSentActions(0, 1)
+	require.NoError(t, err)
+	require.Len(t, sent, 2)
+	assert.Equal(t, confirmed.ID, sent[0].ID)
+	assert.Equal(t, BlockchainActionStatusSent, sent[0].Status)
+	assert.Equal(t, sentHash, sent[0].TxHash)
+
+	pendingActions, err := store.GetActions(0, 1)
+	require.NoError(t, err)
+	require.Len(t, pendingActions, 1)
+	assert.Equal(t, pending.ID, pendingActions[0].ID)
+
+	// The fee-bumped replacement is recorded with the nonce while pending
+	require.NoError(t, store.UpdateSentTx(confirmed.ID, bumpedHash, 7))
+	require.NoError(t, db.First(&confirmed, confirmed.ID).Error)
+	assert.Equal(t, BlockchainActionStatusSent, confirmed.Status)
+	assert.Equal(t, bumpedHash, confirmed.TxHash)
+	require.NotNil(t, confirmed.TxNonce)
+	assert.Equal(t, uint64(7), *confirmed.TxNonce)
+
+	// Sending the action again clears the nonce of the previous transaction
+	require.NoError(t, store.MarkSent(confirmed.ID, sentHash))
+	require.NoError(t, db.First(&confirmed, confirmed.ID).Error)
+	assert.Nil(t, confirmed.TxNonce)
+
+	// The included transaction is a fee-bumped replacement
+	require.NoError(t, store.Confirm(confirmed.ID, bumpedHash))
+	require.NoError(t, store.MarkReverted(reverted.ID, sentHash, "transaction reverted"))
+
+	require.NoError(t, db.First(&confirmed, confirmed.ID).Error)
+	assert.Equal(t, BlockchainActionStatusConfirmed, confirmed.Status)
+	assert.Equal(t, bumpedHash, confirmed.TxHash)
+
+	require.NoError(t, db.First(&reverted, reverted.ID).Error)
+	assert.Equal(t, BlockchainActionStatusReverted, reverted.Status)
+	assert.Equal(t, "transaction reverted", reverted.Error)
+	assert.Equal(t, uint8(0), reverted.Retries)
+
+	sent, err = store.GetSentActions(0, 1)
+	require.NoError(t, err)
+	assert.Empty(t, sent)
+}
+
+func TestDBStore_GetPendingNodeLocks(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	store := NewDBStore(db)
+
+	// scheduleLock stores a lock of the amount on blockchain 2 and schedules its blockchain action
+	scheduleLock := func(n int, token string, amount int64, schedule func(stateID string, blockchainID uint64) error) int64 {
+		escrowChannelID := fmt.Sprintf("0xe%063d", n)
+		require.NoError(t, store.CreateChannel(core.Channel{
+			ChannelID:         escrowChannelID,
+			UserWallet:        "0xe234567890123456789012345678901234567890",
+			Asset:             "usdc",
+			Type:              core.ChannelTypeEscrow,
+			BlockchainID:      2,
+			TokenAddress:      token,
+			ChallengeDuration: 86400,
+			Status:            core.ChannelStatusOpen,
+		}))
+
+		state := core.State{
+			ID:              fmt.Sprintf("0xf%063d", n),
+			Asset:           "USDC",
+			UserWallet:      "0xe234567890123456789012345678901234567890",
+			Epoch:           1,
+			Version:         uint64(n),
+			Transition:      core.Transition{Type: core.TransitionTypeEscrowLock, Amount: decimal.NewFromInt(amount)},
+			EscrowChannelID: &escrowChannelID,
+			HomeLedger:      core.Ledger{UserBalance: decimal.NewFromInt(1000)},
+			EscrowLedger:    &core.Ledger{NodeBalance: decimal.NewFromInt(amount)},
+		}
+		require.NoError(t, store.StoreUserState(state))
+		require.NoError(t, schedule(state.ID, 2))
+
+		var action BlockchainAction
+		require.NoError(t, db.Where("state_id = ?", state.ID).First(&action).Error)
+		return action.ID
+	}
+
+	scheduleLock(1, "0xToken", 100, store.ScheduleInitiateEscrowWithdrawal)
+	sent := scheduleLock(2, "0xtoken", 20, store.ScheduleInitiateEscrowWithdrawal)
+	require.NoError(t, store.MarkSent(sent, "0x1111111111111111111111111111111111111111111111111111111111111111"))
+	scheduleLock(6, "0xtoken", 3, store.ScheduleInitiateMigration)
+
+	// Confirmed locks are included in the node balance, other tokens and actions are not locks of the token
+	confirmed := scheduleLock(3, "0xtoken", 300, store.ScheduleInitiateEscrowWithdrawal)
+	require.NoError(t, store.Confirm(confirmed, "0x2222222222222222222222222222222222222222222222222222222222222222"))
+	scheduleLock(4, "0xother", 400, store.ScheduleInitiateEscrowWithdrawal)
+	scheduleLock(5, "0xtoken", 500, store.ScheduleCheckpoint)
+
+	locked, err := store.GetPendingNodeLocks(2, "0xTOKEN")
+	require.NoError(t, err)
+	assert.Equal(t, "123", locked.String())
+
+	locked, err = store.GetPendingNodeLocks(1, "0xtoken")
+	require.NoError(t, err)
+	assert.True(t, locked.IsZero())
+}
+
 func TestDBStore_GetActions(t *testing.T) {
 	t.Run("Success - Get pending actions ordered by creation time", func(t *testing.T) {
 		db, cleanup := SetupTestDB(t)
@@ -11,18 +11,19 @@ import (
 
 // Channel represents a state channel between participants
 type Channel struct {
-	ChannelID             string             `gorm:"column:channel_id;primaryKey;"`
-	UserWallet            string             `gorm:"column:user_wallet;not null"`
-	Asset                 string             `gorm:"column:asset;not null"`
-	Type                  core.ChannelType   `gorm:"column:type;not null"`
-	BlockchainID          uint64             `gorm:"column:blockchain_id;not null"`
-	Token                 string             `gorm:"column:token;not null"`
-	ChallengeDuration     uint32             `gorm:"column:challenge_duration;not null"`
-	ChallengeExpiresAt    *time.Time         `gorm:"column:challenge_expires_at;default:null"`
-	Nonce                 uint64             `gorm:"column:nonce;not null;"`
-	ApprovedSigValidators string             `gorm:"column:approved_sig_validators;not null;"`
-	Status                core.ChannelStatus `gorm:"column:status;not null;"`
-	StateVersion          uint64             `gorm:"column:state_version;not null;"`
+	ChannelID             string                      `gorm:"column:channel_id;primaryKey;"`
+	UserWallet            string                      `gorm:"column:user_wallet;not null"`
+	Asset                 string                      `gorm:"column:asset;not null"`
+	Type                  core.ChannelType            `gorm:"column:type;not null"`
+	BlockchainID          uint64                      `gorm:"column:blockchain_id;not null"`
+	Token                 string                      `gorm:"column:token;not null"`
+	ChallengeDuration     uint32                      `gorm:"column:challenge_duration;not null"`
+	ChallengeExpiresAt    *time.Time                  `gorm:"column:challenge_expires_at;default:null"`
+	Nonce                 uint64                      `gorm:"column:nonce;not null;"`
+	ApprovedSigValidators string                      `gorm:"column:approved_sig_validators;not null;"`
+	Status                core.ChannelStatus          `gorm:"column:status;not null;"`
+	StateVersion          uint64                      `gorm:"column:state_version;not null;"`
+	MigrationStatus       core.ChannelMigrationStatus `gorm:"column:migration_status;not null;default:0"`
 	CreatedAt             time.Time
 	UpdatedAt             time.Time
 }
@@ -47,6 +48,7 @@ func (s *DBStore) CreateChannel(channel core.Channel) error {
 		ApprovedSigValidators: channel.ApprovedSigValidators,
 		Status:                channel.Status,
 		StateVersion:          channel.StateVersion,
+		MigrationStatus:       channel.MigrationStatus,
 		CreatedAt:             time.Now(),
 		UpdatedAt:             time.Now(),
 	}
@@ -161,6 +163,7 @@ func (s *DBStore) UpdateChannel(channel core.Channel) error {
 		"token":                strings.ToLower(channel.TokenAddress),
 		"nonce":                channel.Nonce,
 		"challenge_expires_at": channel.ChallengeExpiresAt,
+		"migration_status":     channel.MigrationStatus,
 		"updated_at":           time.Now(),
 	}
 
@@ -170,3 +173,26 @@ func (s *DBStore) UpdateChannel(channel core.Channel) error {
 
 	return nil
 }
+
+// GetPendingEscrowDepositChannels retrieves the escrow deposit channels of a blockchain that are not finalized yet,
+// oldest first, which is the order the ChannelHub contract purges them in.
+func (s *DBStore) GetPendingEscrowDepositChannels(blockchainID uint64, limit uint32) ([]core.Channel, error) {
+	var dbChannels []Channel
+	err := s.db.
+		Where("type = ? AND blockchain_id = ?", core.ChannelTypeEscrow, blockchainID).
+		Where("status IN ?", []core.ChannelStatus{core.ChannelStatusOpen, core.ChannelStatusChallenged}).
+		Where("EXISTS (SELECT 1 FROM channel_states s WHERE s.escrow_channel_id = channels.channel_id AND s.transition_type = ?)", core.TransitionTypeMutualLock).
+		Order("created_at ASC").
+		Limit(int(limit)).
+		Find(&dbChannels).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending escrow deposit channels: %w", err)
+	}
+
+	channels := make([]core.Channel, len(dbChannels))
+	for i := range dbChannels {
+		channels[i] = *databaseChannelToCore(&dbChannels[i])
+	}
+
+	return channels, nil
+}
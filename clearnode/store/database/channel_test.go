@@ -644,6 +644,40 @@ func TestDBStore_UpdateChannel(t *testing.T) {
 		assert.Equal(t, "0xnewtoken456", result.TokenAddress)
 	})
 
+	t.Run("Success - Update migration status", func(t *testing.T) {
+		db, cleanup := SetupTestDB(t)
+		defer cleanup()
+
+		store := NewDBStore(db)
+
+		channel := core.Channel{
+			ChannelID:         "0xhomechannel123",
+			UserWallet:        "0xuser123",
+			Asset:             "usdc",
+			Type:              core.ChannelTypeHome,
+			BlockchainID:      1,
+			TokenAddress:      "0xtoken123",
+			ChallengeDuration: 86400,
+			Nonce:             1,
+			Status:            core.ChannelStatusOpen,
+			StateVersion:      0,
+		}
+		require.NoError(t, store.CreateChannel(channel))
+
+		result, err := store.GetChannelByID("0xhomechannel123")
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.Equal(t, core.ChannelMigrationStatusNone, result.MigrationStatus)
+
+		channel.MigrationStatus = core.ChannelMigrationStatusInitiated
+		require.NoError(t, store.UpdateChannel(channel))
+
+		result, err = store.GetChannelByID("0xhomechannel123")
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.Equal(t, core.ChannelMigrationStatusInitiated, result.MigrationStatus)
+	})
+
 	t.Run("Error - Update non-existent channel", func(t *testing.T) {
 		db, cleanup := SetupTestDB(t)
 		defer cleanup()
@@ -668,6 +702,59 @@ func TestDBStore_UpdateChannel(t *testing.T) {
 	})
 }
 
+func TestDBStore_GetPendingEscrowDepositChannels(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	store := NewDBStore(db)
+
+	createEscrow := func(channelID string, blockchainID uint64, status core.ChannelStatus, transitionType core.TransitionType, createdAt time.Time) {
+		require.NoError(t, store.CreateChannel(core.Channel{
+			ChannelID:         channelID,
+			UserWallet:        "0xuser123",
+			Asset:             "usdc",
+			Type:              core.ChannelTypeEscrow,
+			BlockchainID:      blockchainID,
+			TokenAddress:      "0xtoken123",
+			ChallengeDuration: 86400,
+			Status:            status,
+		}))
+		require.NoError(t, db.Model(&Channel{}).Where("channel_id = ?", channelID).Update("created_at", createdAt).Error)
+
+		escrowChannelID := channelID
+		require.NoError(t, store.StoreUserState(core.State{
+			ID:              "state" + channelID,
+			Asset:           "usdc",
+			UserWallet:      "0xuser123",
+			Epoch:           1,
+			Version:         1,
+			EscrowChannelID: &escrowChannelID,
+			Transition:      core.Transition{Type: transitionType, AccountID: channelID, Amount: decimal.NewFromInt(10)},
+			EscrowLedger:    &core.Ledger{BlockchainID: blockchainID, TokenAddress: "0xtoken123"},
+		}))
+	}
+
+	now := time.Now()
+	createEscrow("0xdeposit2", 137, core.ChannelStatusChallenged, core.TransitionTypeMutualLock, now.Add(-2*time.Hour))
+	createEscrow("0xdeposit1", 137, core.ChannelStatusOpen, core.TransitionTypeMutualLock, now.Add(-3*time.Hour))
+	createEscrow("0xdeposit3", 137, core.ChannelStatusOpen, core.TransitionTypeMutualLock, now.Add(-time.Hour))
+	createEscrow("0xfinalized", 137, core.ChannelStatusClosed, core.TransitionTypeMutualLock, now.Add(-4*time.Hour))
+	createEscrow("0xwithdrawal", 137, core.ChannelStatusOpen, core.TransitionTypeEscrowLock, now.Add(-4*time.Hour))
+	createEscrow("0xotherchain", 1, core.ChannelStatusOpen, core.TransitionTypeMutualLock, now.Add(-4*time.Hour))
+
+	channels, err := store.GetPendingEscrowDepositChannels(137, 10)
+	require.NoError(t, err)
+	require.Len(t, channels, 3)
+	assert.Equal(t, "0xdeposit1", channels[0].ChannelID)
+	assert.Equal(t, "0xdeposit2", channels[1].ChannelID)
+	assert.Equal(t, "0xdeposit3", channels[2].ChannelID)
+
+	channels, err = store.GetPendingEscrowDepositChannels(137, 1)
+	require.NoError(t, err)
+	require.Len(t, channels, 1)
+	assert.Equal(t, "0xdeposit1", channels[0].ChannelID)
+}
+
 func TestDBStore_GetUserChannels(t *testing.T) {
 	t.Run("Success - Get all channels for user", func(t *testing.T) {
 		db, cleanup := SetupTestDB(t)
@@ -0,0 +1,133 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ConditionalTransferV1 represents a hash-time-locked transfer between two users
+type ConditionalTransferV1 struct {
+	ID          string                         `gorm:"column:id;primaryKey"`
+	Sender      string                         `gorm:"column:sender;not null"`
+	Receiver    string                         `gorm:"column:receiver;not null"`
+	Asset       string                         `gorm:"column:asset;not null"`
+	Amount      decimal.Decimal                `gorm:"column:amount;type:decimal(38,18);not null"`
+	HashLock    string                         `gorm:"column:hash_lock;not null"`
+	ExpiresAt   time.Time                      `gorm:"column:expires_at;not null;index:idx_conditional_transfers_v1_status_expires"`
+	Status      core.ConditionalTransferStatus `gorm:"column:status;not null;index:idx_conditional_transfers_v1_status_expires"`
+	Preimage    *string                        `gorm:"column:preimage"`
+	LockStateID string                         `gorm:"column:lock_state_id;not null"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (ConditionalTransferV1) TableName() string {
+	return "conditional_transfers_v1"
+}
+
+// CreateConditionalTransfer stores a newly locked conditional transfer.
+func (s *DBStore) CreateConditionalTransfer(transfer core.ConditionalTransfer) error {
+	now := time.Now()
+	dbTransfer := ConditionalTransferV1{
+		ID:          strings.ToLower(transfer.ID),
+		Sender:      strings.ToLower(transfer.Sender),
+		Receiver:    strings.ToLower(transfer.Receiver),
+		Asset:       transfer.Asset,
+		Amount:      transfer.Amount,
+		HashLock:    strings.ToLower(transfer.HashLock),
+		ExpiresAt:   transfer.ExpiresAt.UTC(),
+		Status:      transfer.Status,
+		Preimage:    transfer.Preimage,
+		LockStateID: strings.ToLower(transfer.LockStateID),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := s.db.Create(&dbTransfer).Error; err != nil {
+		return fmt.Errorf("failed to create conditional transfer: %w", err)
+	}
+
+	return nil
+}
+
+// LockConditionalTransfer retrieves a conditional transfer by ID and locks its row for update
+// (postgres only, must be used within a transaction). Returns nil if the transfer does not exist.
+func (s *DBStore) LockConditionalTransfer(id string) (*core.ConditionalTransfer, error) {
+	query := s.db.Where("id = ?", strings.ToLower(id))
+	if s.db.Dialector.Name() == "postgres" {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+
+	var dbTransfer ConditionalTransferV1
+	if err := query.First(&dbTransfer).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get conditional transfer: %w", err)
+	}
+
+	return databaseConditionalTransferToCore(&dbTransfer), nil
+}
+
+// UpdateConditionalTransfer persists the status and the revealed preimage of a conditional transfer.
+func (s *DBStore) UpdateConditionalTransfer(transfer core.ConditionalTransfer) error {
+	result := s.db.Model(&ConditionalTransferV1{}).
+		Where("id = ?", strings.ToLower(transfer.ID)).
+		Updates(map[string]any{
+			"status":     transfer.Status,
+			"preimage":   transfer.Preimage,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update conditional transfer: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("conditional transfer not found: %s", transfer.ID)
+	}
+
+	return nil
+}
+
+// GetExpiredConditionalTransfers retrieves locked conditional transfers that expired at or before the given time,
+// oldest expiry first.
+func (s *DBStore) GetExpiredConditionalTransfers(now time.Time, limit uint32) ([]core.ConditionalTransfer, error) {
+	var dbTransfers []ConditionalTransferV1
+	err := s.db.
+		Where("status = ? AND expires_at <= ?", core.ConditionalTransferStatusLocked, now.UTC()).
+		Order("expires_at ASC").
+		Limit(int(limit)).
+		Find(&dbTransfers).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expired conditional transfers: %w", err)
+	}
+
+	transfers := make([]core.ConditionalTransfer, 0, len(dbTransfers))
+	for i := range dbTransfers {
+		transfers = append(transfers, *databaseConditionalTransferToCore(&dbTransfers[i]))
+	}
+
+	return transfers, nil
+}
+
+func databaseConditionalTransferToCore(dbTransfer *ConditionalTransferV1) *core.ConditionalTransfer {
+	return &core.ConditionalTransfer{
+		ID:          dbTransfer.ID,
+		Sender:      dbTransfer.Sender,
+		Receiver:    dbTransfer.Receiver,
+		Asset:       dbTransfer.Asset,
+		Amount:      dbTransfer.Amount,
+		HashLock:    dbTransfer.HashLock,
+		ExpiresAt:   dbTransfer.ExpiresAt,
+		Status:      dbTransfer.Status,
+		Preimage:    dbTransfer.Preimage,
+		LockStateID: dbTransfer.LockStateID,
+		CreatedAt:   dbTransfer.CreatedAt,
+		UpdatedAt:   dbTransfer.UpdatedAt,
+	}
+}
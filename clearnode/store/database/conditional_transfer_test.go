@@ -0,0 +1,142 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionalTransferV1_TableName(t *testing.T) {
+	transfer := ConditionalTransferV1{}
+	assert.Equal(t, "conditional_transfers_v1", transfer.TableName())
+}
+
+func newTestConditionalTransfer(id string, expiresAt time.Time) core.ConditionalTransfer {
+	return core.ConditionalTransfer{
+		ID:          id,
+		Sender:      "0xSender",
+		Receiver:    "0xReceiver",
+		Asset:       "usdc",
+		Amount:      decimal.NewFromInt(100),
+		HashLock:    "0xHashLock",
+		ExpiresAt:   expiresAt,
+		Status:      core.ConditionalTransferStatusLocked,
+		LockStateID: "0xLockState",
+	}
+}
+
+func TestDBStore_CreateConditionalTransfer(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	store := NewDBStore(db)
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	require.NoError(t, store.CreateConditionalTransfer(newTestConditionalTransfer("0xTransfer1", expiresAt)))
+
+	var dbTransfer ConditionalTransferV1
+	require.NoError(t, db.Where("id = ?", "0xtransfer1").First(&dbTransfer).Error)
+	assert.Equal(t, "0xsender", dbTransfer.Sender)
+	assert.Equal(t, "0xreceiver", dbTransfer.Receiver)
+	assert.Equal(t, "usdc", dbTransfer.Asset)
+	assert.True(t, decimal.NewFromInt(100).Equal(dbTransfer.Amount))
+	assert.Equal(t, "0xhashlock", dbTransfer.HashLock)
+	assert.True(t, expiresAt.Equal(dbTransfer.ExpiresAt))
+	assert.Equal(t, core.ConditionalTransferStatusLocked, dbTransfer.Status)
+	assert.Nil(t, dbTransfer.Preimage)
+	assert.Equal(t, "0xlockstate", dbTransfer.LockStateID)
+
+	err := store.CreateConditionalTransfer(newTestConditionalTransfer("0xTransfer1", expiresAt))
+	assert.Error(t, err)
+}
+
+func TestDBStore_LockConditionalTransfer(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, cleanup := SetupTestDB(t)
+		defer cleanup()
+
+		store := NewDBStore(db)
+		require.NoError(t, store.CreateConditionalTransfer(newTestConditionalTransfer("0xtransfer1", time.Now().Add(time.Hour))))
+
+		transfer, err := store.LockConditionalTransfer("0xTRANSFER1")
+		require.NoError(t, err)
+		require.NotNil(t, transfer)
+		assert.Equal(t, "0xtransfer1", transfer.ID)
+		assert.Equal(t, core.ConditionalTransferStatusLocked, transfer.Status)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		db, cleanup := SetupTestDB(t)
+		defer cleanup()
+
+		store := NewDBStore(db)
+
+		transfer, err := store.LockConditionalTransfer("0xmissing")
+		require.NoError(t, err)
+		assert.Nil(t, transfer)
+	})
+}
+
+func TestDBStore_UpdateConditionalTransfer(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, cleanup := SetupTestDB(t)
+		defer cleanup()
+
+		store := NewDBStore(db)
+		transfer := newTestConditionalTransfer("0xtransfer1", time.Now().Add(time.Hour))
+		require.NoError(t, store.CreateConditionalTransfer(transfer))
+
+		preimage := "0xpreimage"
+		transfer.Status = core.ConditionalTransferStatusClaimed
+		transfer.Preimage = &preimage
+		require.NoError(t, store.UpdateConditionalTransfer(transfer))
+
+		updated, err := store.LockConditionalTransfer("0xtransfer1")
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+		assert.Equal(t, core.ConditionalTransferStatusClaimed, updated.Status)
+		require.NotNil(t, updated.Preimage)
+		assert.Equal(t, preimage, *updated.Preimage)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		db, cleanup := SetupTestDB(t)
+		defer cleanup()
+
+		store := NewDBStore(db)
+
+		err := store.UpdateConditionalTransfer(newTestConditionalTransfer("0xmissing", time.Now()))
+		assert.Error(t, err)
+	})
+}
+
+func TestDBStore_GetExpiredConditionalTransfers(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	store := NewDBStore(db)
+	now := time.Now()
+
+	require.NoError(t, store.CreateConditionalTransfer(newTestConditionalTransfer("0xexpired2", now.Add(-time.Minute))))
+	require.NoError(t, store.CreateConditionalTransfer(newTestConditionalTransfer("0xexpired1", now.Add(-time.Hour))))
+	require.NoError(t, store.CreateConditionalTransfer(newTestConditionalTransfer("0xactive", now.Add(time.Hour))))
+
+	claimed := newTestConditionalTransfer("0xclaimed", now.Add(-time.Hour))
+	claimed.Status = core.ConditionalTransferStatusClaimed
+	require.NoError(t, store.CreateConditionalTransfer(claimed))
+
+	transfers, err := store.GetExpiredConditionalTransfers(now, 10)
+	require.NoError(t, err)
+	require.Len(t, transfers, 2)
+	assert.Equal(t, "0xexpired1", transfers[0].ID)
+	assert.Equal(t, "0xexpired2", transfers[1].ID)
+
+	transfers, err = store.GetExpiredConditionalTransfers(now, 1)
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+	assert.Equal(t, "0xexpired1", transfers[0].ID)
+}
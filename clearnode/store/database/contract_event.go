@@ -1,11 +1,14 @@
 package database
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
 	"github.com/layer-3/nitrolite/pkg/core"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
@@ -17,6 +20,7 @@ type ContractEvent struct {
 	BlockchainID    uint64    `gorm:"column:blockchain_id"`
 	Name            string    `gorm:"column:name"`
 	BlockNumber     uint64    `gorm:"column:block_number"`
+	BlockHash       string    `gorm:"column:block_hash"`
 	TransactionHash string    `gorm:"column:transaction_hash"`
 	LogIndex        uint32    `gorm:"column:log_index"`
 	CreatedAt       time.Time `gorm:"column:created_at"`
@@ -26,6 +30,23 @@ func (ContractEvent) TableName() string {
 	return "contract_events"
 }
 
+// ContractEventEffect is a change made to the node's data while handling a contract event,
+// kept so that it can be reverted if a chain reorganization removes the event.
+type ContractEventEffect struct {
+	ID              int64          `gorm:"primary_key;column:id"`
+	ContractAddress string         `gorm:"column:contract_address"`
+	BlockchainID    uint64         `gorm:"column:blockchain_id"`
+	BlockNumber     uint64         `gorm:"column:block_number"`
+	BlockHash       string         `gorm:"column:block_hash"`
+	LogIndex        uint32         `gorm:"column:log_index"`
+	Data            datatypes.JSON `gorm:"column:effect_data;type:text"`
+	CreatedAt       time.Time      `gorm:"column:created_at"`
+}
+
+func (ContractEventEffect) TableName() string {
+	return "contract_event_effects"
+}
+
 // StoreContractEvent stores a blockchain event to the database.
 // This function matches the signature required by pkg/blockchain/evm.StoreContractEvent.
 func (s *DBStore) StoreContractEvent(ev core.BlockchainEvent) error {
@@ -34,6 +55,7 @@ func (s *DBStore) StoreContractEvent(ev core.BlockchainEvent) error {
 		BlockchainID:    ev.BlockchainID,
 		Name:            ev.Name,
 		BlockNumber:     ev.BlockNumber,
+		BlockHash:       strings.ToLower(ev.BlockHash),
 		TransactionHash: ev.TransactionHash,
 		LogIndex:        ev.LogIndex,
 		CreatedAt:       time.Now(),
@@ -59,12 +81,116 @@ func (s *DBStore) GetLatestEvent(contractAddress string, blockchainID uint64) (c
 		return core.BlockchainEvent{}, err
 	}
 
+	return ev.toCore(), nil
+}
+
+// RecordBlockchainEventEffect records a change made while handling a contract event,
+// so that it is reverted if a chain reorganization removes the event.
+func (s *DBStore) RecordBlockchainEventEffect(ev core.BlockchainEvent, effect core.BlockchainEventEffect) error {
+	data, err := json.Marshal(effect)
+	if err != nil {
+		return fmt.Errorf("failed to encode contract event effect: %w", err)
+	}
+
+	return s.db.Create(&ContractEventEffect{
+		ContractAddress: strings.ToLower(ev.ContractAddress),
+		BlockchainID:    ev.BlockchainID,
+		BlockNumber:     ev.BlockNumber,
+		BlockHash:       strings.ToLower(ev.BlockHash),
+		LogIndex:        ev.LogIndex,
+		Data:            data,
+		CreatedAt:       time.Now(),
+	}).Error
+}
+
+// RollbackContractEvents removes the events of a contract stored from the given block on, reverts
+// their recorded effects and returns the removed events.
+// This function matches the signature required by pkg/blockchain/evm.RollbackEvents.
+func (s *DBStore) RollbackContractEvents(contractAddress string, blockchainID uint64, fromBlock uint64) ([]core.BlockchainEvent, error) {
+	var removed []ContractEvent
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := revertContractEventEffects(tx, contractAddress, blockchainID, fromBlock); err != nil {
+			return err
+		}
+
+		query := tx.Where("blockchain_id = ? AND contract_address = ? AND block_number >= ?", blockchainID, strings.ToLower(contractAddress), fromBlock)
+		if err := query.Order("block_number ASC, log_index ASC").Find(&removed).Error; err != nil {
+			return err
+		}
+		if len(removed) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, len(removed))
+		for i, ev := range removed {
+			ids[i] = ev.ID
+		}
+		return tx.Where("id IN ?", ids).Delete(&ContractEvent{}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]core.BlockchainEvent, len(removed))
+	for i, ev := range removed {
+		events[i] = ev.toCore()
+	}
+	return events, nil
+}
+
+// revertContractEventEffects reverts and removes the recorded effects of the contract's events
+// from the given block on, newest first.
+func revertContractEventEffects(tx *gorm.DB, contractAddress string, blockchainID uint64, fromBlock uint64) error {
+	var effects []ContractEventEffect
+	err := tx.Where("blockchain_id = ? AND contract_address = ? AND block_number >= ?", blockchainID, strings.ToLower(contractAddress), fromBlock).
+		Order("block_number DESC, log_index DESC, id DESC").
+		Find(&effects).Error
+	if err != nil {
+		return err
+	}
+	if len(effects) == 0 {
+		return nil
+	}
+
+	store := &DBStore{db: tx, inTx: true}
+	ids := make([]int64, len(effects))
+	for i, e := range effects {
+		ids[i] = e.ID
+
+		var effect core.BlockchainEventEffect
+		if err := json.Unmarshal(e.Data, &effect); err != nil {
+			return fmt.Errorf("failed to decode contract event effect %d: %w", e.ID, err)
+		}
+
+		switch effect.Type {
+		case core.BlockchainEventEffectChannelUpdated:
+			if effect.Channel == nil {
+				return fmt.Errorf("contract event effect %d has no channel", e.ID)
+			}
+			err = store.UpdateChannel(*effect.Channel)
+		case core.BlockchainEventEffectVaultBalanceAdjusted:
+			err = store.AdjustVaultBalance(effect.Wallet, effect.BlockchainID, effect.TokenAddress, effect.Amount.Neg())
+		case core.BlockchainEventEffectUserStakedUpdated:
+			err = store.UpdateUserStaked(effect.Wallet, effect.BlockchainID, effect.Amount)
+		default:
+			err = fmt.Errorf("unknown type %d of contract event effect %d", effect.Type, e.ID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Where("id IN ?", ids).Delete(&ContractEventEffect{}).Error
+}
+
+func (ev ContractEvent) toCore() core.BlockchainEvent {
 	return core.BlockchainEvent{
 		BlockNumber:     ev.BlockNumber,
+		BlockHash:       ev.BlockHash,
 		BlockchainID:    ev.BlockchainID,
 		Name:            ev.Name,
 		ContractAddress: ev.ContractAddress,
 		TransactionHash: ev.TransactionHash,
 		LogIndex:        ev.LogIndex,
-	}, nil
+	}
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -160,3 +161,111 @@ func TestGetLatestEvent(t *testing.T) {
 		assert.Equal(t, "EventB", latestEvent.Name)
 	})
 }
+
+func TestRollbackContractEvents(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	store := NewDBStore(db)
+
+	contractAddress := "0x1234567890123456789012345678901234567890"
+	events := []core.BlockchainEvent{
+		{ContractAddress: contractAddress, BlockchainID: 1, Name: "Event1", BlockNumber: 100, BlockHash: "0xa100", TransactionHash: "0xaaa", LogIndex: 0},
+		{ContractAddress: contractAddress, BlockchainID: 1, Name: "Event2", BlockNumber: 101, BlockHash: "0xa101", TransactionHash: "0xbbb", LogIndex: 3},
+		{ContractAddress: contractAddress, BlockchainID: 1, Name: "Event3", BlockNumber: 102, BlockHash: "0xa102", TransactionHash: "0xccc", LogIndex: 1},
+		// Other chains and contracts are not affected
+		{ContractAddress: contractAddress, BlockchainID: 2, Name: "Event4", BlockNumber: 105, BlockHash: "0xb105", TransactionHash: "0xddd", LogIndex: 0},
+		{ContractAddress: "0x9999999999999999999999999999999999999999", BlockchainID: 1, Name: "Event5", BlockNumber: 105, BlockHash: "0xc105", TransactionHash: "0xeee", LogIndex: 0},
+	}
+	for _, ev := range events {
+		require.NoError(t, store.StoreContractEvent(ev))
+	}
+
+	latest, err := store.GetLatestEvent(contractAddress, 1)
+	require.NoError(t, err)
+	assert.Equal(t, events[2], latest)
+
+	removed, err := store.RollbackContractEvents(contractAddress, 1, 101)
+	require.NoError(t, err)
+	assert.Equal(t, []core.BlockchainEvent{events[1], events[2]}, removed)
+
+	latest, err = store.GetLatestEvent(contractAddress, 1)
+	require.NoError(t, err)
+	assert.Equal(t, events[0], latest)
+
+	latest, err = store.GetLatestEvent(contractAddress, 2)
+	require.NoError(t, err)
+	assert.Equal(t, events[3], latest)
+
+	// The removed events can be stored again when they are replayed
+	require.NoError(t, store.StoreContractEvent(events[1]))
+
+	removed, err = store.RollbackContractEvents(contractAddress, 1, 200)
+	require.NoError(t, err)
+	assert.Empty(t, removed)
+}
+
+func TestRollbackContractEvents_RevertsEffects(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	store := NewDBStore(db)
+
+	contractAddress := "0x1234567890123456789012345678901234567890"
+	wallet := "0xabcdefabcdefabcdefabcdefabcdefabcdefabcd"
+	token := "0x0000000000000000000000000000000000000000"
+	kept := core.BlockchainEvent{ContractAddress: contractAddress, BlockchainID: 1, Name: "Deposited", BlockNumber: 100, BlockHash: "0xa100", TransactionHash: "0xaaa"}
+	reorged := core.BlockchainEvent{ContractAddress: contractAddress, BlockchainID: 1, Name: "ChannelCheckpointed", BlockNumber: 101, BlockHash: "0xa101", TransactionHash: "0xbbb", LogIndex: 2}
+
+	channel := core.NewChannel("0x1111111111111111111111111111111111111111111111111111111111111111", wallet, "usdc",
+		core.ChannelTypeHome, 1, token, 1, 86400, "0x1")
+	channel.Status = core.ChannelStatusOpen
+	channel.StateVersion = 3
+	require.NoError(t, store.CreateChannel(*channel))
+
+	// Applies a change the way the event handlers do: the effect is recorded before the change
+	apply := func(ev core.BlockchainEvent, effect core.BlockchainEventEffect, change func() error) {
+		require.NoError(t, store.RecordBlockchainEventEffect(ev, effect))
+		require.NoError(t, change())
+	}
+
+	apply(kept, core.BlockchainEventEffect{Type: core.BlockchainEventEffectVaultBalanceAdjusted, Wallet: wallet, BlockchainID: 1, TokenAddress: token, Amount: decimal.NewFromInt(10)},
+		func() error { return store.AdjustVaultBalance(wallet, 1, token, decimal.NewFromInt(10)) })
+	require.NoError(t, store.StoreContractEvent(kept))
+
+	previous := *channel
+	updated := *channel
+	updated.StateVersion = 5
+	updated.Status = core.ChannelStatusChallenged
+	apply(reorged, core.BlockchainEventEffect{Type: core.BlockchainEventEffectChannelUpdated, Channel: &previous},
+		func() error { return store.UpdateChannel(updated) })
+	apply(reorged, core.BlockchainEventEffect{Type: core.BlockchainEventEffectVaultBalanceAdjusted, Wallet: wallet, BlockchainID: 1, TokenAddress: token, Amount: decimal.NewFromInt(-4)},
+		func() error { return store.AdjustVaultBalance(wallet, 1, token, decimal.NewFromInt(-4)) })
+	apply(reorged, core.BlockchainEventEffect{Type: core.BlockchainEventEffectUserStakedUpdated, Wallet: wallet, BlockchainID: 1, Amount: decimal.Zero},
+		func() error { return store.UpdateUserStaked(wallet, 1, decimal.NewFromInt(7)) })
+	require.NoError(t, store.StoreContractEvent(reorged))
+
+	removed, err := store.RollbackContractEvents(contractAddress, 1, 101)
+	require.NoError(t, err)
+	assert.Equal(t, []core.BlockchainEvent{reorged}, removed)
+
+	// Only the changes of the removed event are reverted
+	restored, err := store.GetChannelByID(channel.ChannelID)
+	require.NoError(t, err)
+	require.NotNil(t, restored)
+	assert.Equal(t, core.ChannelStatusOpen, restored.Status)
+	assert.Equal(t, uint64(3), restored.StateVersion)
+
+	balance, err := store.GetVaultBalance(wallet, 1, token)
+	require.NoError(t, err)
+	assert.True(t, decimal.NewFromInt(10).Equal(balance), "vault balance %s", balance)
+
+	staked, err := store.GetUserStaked(wallet, 1)
+	require.NoError(t, err)
+	assert.True(t, staked.IsZero(), "staked %s", staked)
+
+	var effects []ContractEventEffect
+	require.NoError(t, db.Find(&effects).Error)
+	require.Len(t, effects, 1)
+	assert.Equal(t, uint64(100), effects[0].BlockNumber)
+}
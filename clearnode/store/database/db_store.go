@@ -201,6 +201,12 @@ func (s *DBStore) EnsureNoOngoingStateTransitions(wallet, asset string) error {
 		if result.HomeChannelVersion != nil && result.StateVersion != *result.HomeChannelVersion {
 			return fmt.Errorf("home chain migration is still ongoing")
 		}
+
+	case core.TransitionTypeFinalizeMigration:
+		// Verify last_state.version == home_channel.state_version
+		if result.HomeChannelVersion != nil && result.StateVersion != *result.HomeChannelVersion {
+			return fmt.Errorf("home chain migration finalization is still ongoing")
+		}
 	}
 
 	return nil
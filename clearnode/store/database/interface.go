@@ -55,6 +55,10 @@ type DatabaseStore interface {
 	// GetUserChannels retrieves all channels for a user with optional status, asset, and type filters.
 	GetUserChannels(wallet string, status *core.ChannelStatus, asset *string, channelType *core.ChannelType, limit, offset uint32) ([]core.Channel, uint32, error)
 
+	// GetPendingEscrowDepositChannels retrieves the escrow deposit channels of a blockchain that are not finalized yet,
+	// oldest first, which is the order the ChannelHub contract purges them in.
+	GetPendingEscrowDepositChannels(blockchainID uint64, limit uint32) ([]core.Channel, error)
+
 	// --- State Management ---
 
 	// GetLastStateByChannelID retrieves the most recent state for a given channel.
@@ -68,12 +72,53 @@ type DatabaseStore interface {
 	// GetLastUserState retrieves the most recent state for a user's asset.
 	GetLastUserState(wallet, asset string, signed bool) (*core.State, error)
 
+	// GetUserStatesAfterVersion retrieves the states of a user's asset in an epoch
+	// with a version greater than the given one, in ascending version order.
+	GetUserStatesAfterVersion(wallet, asset string, epoch, version uint64) ([]core.State, error)
+
 	// StoreUserState persists a new user state to the database.
 	StoreUserState(state core.State) error
 
 	// EnsureNoOngoingStateTransitions validates that no conflicting blockchain operations are pending.
 	EnsureNoOngoingStateTransitions(wallet, asset string) error
 
+	// --- Conditional Transfer Operations ---
+
+	// CreateConditionalTransfer stores a newly locked conditional transfer.
+	CreateConditionalTransfer(transfer core.ConditionalTransfer) error
+
+	// LockConditionalTransfer retrieves a conditional transfer by ID and locks it for update.
+	// Returns nil if the transfer does not exist.
+	LockConditionalTransfer(id string) (*core.ConditionalTransfer, error)
+
+	// UpdateConditionalTransfer persists the status and the revealed preimage of a conditional transfer.
+	UpdateConditionalTransfer(transfer core.ConditionalTransfer) error
+
+	// GetExpiredConditionalTransfers retrieves locked conditional transfers that expired at or before the given time.
+	GetExpiredConditionalTransfers(now time.Time, limit uint32) ([]core.ConditionalTransfer, error)
+
+	// --- Payment Stream Operations ---
+
+	// CreatePaymentStream stores a newly authorized payment stream.
+	CreatePaymentStream(stream core.PaymentStream) error
+
+	// GetPaymentStream retrieves a payment stream by ID.
+	// Returns nil if the stream does not exist.
+	GetPaymentStream(id string) (*core.PaymentStream, error)
+
+	// GetPaymentStreams retrieves the payment streams the wallet sends or receives.
+	GetPaymentStreams(wallet string) ([]core.PaymentStream, error)
+
+	// LockPaymentStream retrieves a payment stream by ID and locks it for update.
+	// Returns nil if the stream does not exist.
+	LockPaymentStream(id string) (*core.PaymentStream, error)
+
+	// UpdatePaymentStream persists the status, end and settlement progress of a payment stream.
+	UpdatePaymentStream(stream core.PaymentStream) error
+
+	// GetDuePaymentStreams retrieves payment streams that still have funds to settle.
+	GetDuePaymentStreams(limit uint32) ([]core.PaymentStream, error)
+
 	// --- Blockchain Action Operations ---
 
 	// ScheduleInitiateEscrowWithdrawal queues a blockchain action to initiate withdrawal.
@@ -92,10 +137,22 @@ type DatabaseStore interface {
 	// This queues the state to be submitted on-chain to finalize an escrow withdrawal.
 	ScheduleFinalizeEscrowWithdrawal(stateID string, chainID uint64) error
 
+	// ScheduleInitiateMigration schedules the initiation of a home channel migration.
+	// This queues the state to be submitted on the new home blockchain to move the channel there.
+	ScheduleInitiateMigration(stateID string, chainID uint64) error
+
+	// ScheduleFinalizeMigration schedules the finalization of a home channel migration.
+	// This queues the state to be submitted on the old home blockchain to release the channel.
+	ScheduleFinalizeMigration(stateID string, chainID uint64) error
+
 	// ScheduleInitiateEscrowDeposit schedules a checkpoint for an escrow deposit operation.
 	// This queues the state to be submitted on-chain for an escrow deposit on home chain.
 	ScheduleInitiateEscrowDeposit(stateID string, chainID uint64) error
 
+	// GetPendingNodeLocks returns the sum of the amounts the node is yet to lock on the blockchain in the token
+	// for scheduled blockchain actions whose transactions are not confirmed yet.
+	GetPendingNodeLocks(blockchainID uint64, tokenAddress string) (decimal.Decimal, error)
+
 	// Fail marks a blockchain action as failed and increments the retry counter.
 	Fail(actionID int64, err string) error
 
@@ -109,9 +166,24 @@ type DatabaseStore interface {
 	// Complete marks a blockchain action as completed with the given transaction hash.
 	Complete(actionID int64, txHash string) error
 
+	// MarkSent records that the transaction of a blockchain action was broadcast.
+	MarkSent(actionID int64, txHash string) error
+
+	// UpdateSentTx records the latest broadcast version of a blockchain action's transaction and its nonce.
+	UpdateSentTx(actionID int64, txHash string, nonce uint64) error
+
+	// Confirm marks the transaction of a blockchain action as succeeded with enough confirmations.
+	Confirm(actionID int64, txHash string) error
+
+	// MarkReverted marks the transaction of a blockchain action as included on-chain, but reverted.
+	MarkReverted(actionID int64, txHash, err string) error
+
 	// GetActions retrieves pending blockchain actions, optionally limited by count.
 	GetActions(limit uint8, chainID uint64) ([]BlockchainAction, error)
 
+	// GetSentActions retrieves blockchain actions whose transactions await confirmations, optionally limited by count.
+	GetSentActions(limit uint8, chainID uint64) ([]BlockchainAction, error)
+
 	// GetStateByID retrieves a state by its deterministic ID.
 	GetStateByID(stateID string) (*core.State, error)
 
@@ -217,9 +289,21 @@ type DatabaseStore interface {
 	// UpdateUserStaked upserts the staked amount for a user on a specific blockchain.
 	UpdateUserStaked(wallet string, blockchainID uint64, amount decimal.Decimal) error
 
+	// GetUserStaked returns the staked amount of a user on a specific blockchain, zero if none is recorded.
+	GetUserStaked(wallet string, blockchainID uint64) (decimal.Decimal, error)
+
 	// GetTotalUserStaked returns the total staked amount for a user across all blockchains.
 	GetTotalUserStaked(wallet string) (decimal.Decimal, error)
 
+	// --- Vault Balance Operations ---
+
+	// AdjustVaultBalance adds delta, negative for withdrawals, to the vault balance of a wallet
+	// for a token on a specific blockchain. The resulting balance must not be negative.
+	AdjustVaultBalance(wallet string, blockchainID uint64, tokenAddress string, delta decimal.Decimal) error
+
+	// GetVaultBalance returns the vault balance of a wallet for a token on a specific blockchain.
+	GetVaultBalance(wallet string, blockchainID uint64, tokenAddress string) (decimal.Decimal, error)
+
 	// --- Action Log Operations ---
 
 	// RecordAction inserts a new action log entry for a user.
@@ -239,4 +323,13 @@ type DatabaseStore interface {
 
 	// GetLatestEvent returns the latest block number and log index for a given contract.
 	GetLatestEvent(contractAddress string, blockchainID uint64) (core.BlockchainEvent, error)
+
+	// RecordBlockchainEventEffect records a change made while handling a contract event,
+	// so that it is reverted if a chain reorganization removes the event.
+	RecordBlockchainEventEffect(event core.BlockchainEvent, effect core.BlockchainEventEffect) error
+
+	// RollbackContractEvents removes the events of a contract stored from the given block on and reverts
+	// their recorded effects, so that they are processed again after a chain reorganization.
+	// It returns the removed events.
+	RollbackContractEvents(contractAddress string, blockchainID uint64, fromBlock uint64) ([]core.BlockchainEvent, error)
 }
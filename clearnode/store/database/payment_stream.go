@@ -0,0 +1,182 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PaymentStreamV1 represents a sender-authorized payment stream settled by the Node
+type PaymentStreamV1 struct {
+	ID           string                   `gorm:"column:id;primaryKey"`
+	Sender       string                   `gorm:"column:sender;not null;index"`
+	Recipient    string                   `gorm:"column:recipient;not null;index"`
+	Asset        string                   `gorm:"column:asset;not null"`
+	Rate         decimal.Decimal          `gorm:"column:rate;type:decimal(38,18);not null"`
+	Cap          decimal.Decimal          `gorm:"column:cap;type:decimal(38,18);not null"`
+	ExpiresAt    uint64                   `gorm:"column:expires_at;not null"`
+	Nonce        uint64                   `gorm:"column:nonce;not null"`
+	SenderSig    string                   `gorm:"column:sender_sig;not null"`
+	Status       core.PaymentStreamStatus `gorm:"column:status;not null;index:idx_payment_streams_v1_status_settled_until"`
+	StartsAt     time.Time                `gorm:"column:starts_at;not null"`
+	EndsAt       time.Time                `gorm:"column:ends_at;not null"`
+	Settled      decimal.Decimal          `gorm:"column:settled;type:decimal(38,18);not null"`
+	SettledUntil time.Time                `gorm:"column:settled_until;not null;index:idx_payment_streams_v1_status_settled_until"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (PaymentStreamV1) TableName() string {
+	return "payment_streams_v1"
+}
+
+// CreatePaymentStream stores a newly authorized payment stream.
+func (s *DBStore) CreatePaymentStream(stream core.PaymentStream) error {
+	now := time.Now()
+	dbStream := PaymentStreamV1{
+		ID:           strings.ToLower(stream.ID),
+		Sender:       strings.ToLower(stream.Authorization.Sender),
+		Recipient:    strings.ToLower(stream.Authorization.Recipient),
+		Asset:        stream.Authorization.Asset,
+		Rate:         stream.Authorization.Rate,
+		Cap:          stream.Authorization.Cap,
+		ExpiresAt:    stream.Authorization.ExpiresAt,
+		Nonce:        stream.Authorization.Nonce,
+		SenderSig:    stream.SenderSig,
+		Status:       stream.Status,
+		StartsAt:     stream.StartsAt.UTC(),
+		EndsAt:       stream.EndsAt.UTC(),
+		Settled:      stream.Settled,
+		SettledUntil: stream.SettledUntil.UTC(),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := s.db.Create(&dbStream).Error; err != nil {
+		return fmt.Errorf("failed to create payment stream: %w", err)
+	}
+
+	return nil
+}
+
+// GetPaymentStream retrieves a payment stream by ID. Returns nil if the stream does not exist.
+func (s *DBStore) GetPaymentStream(id string) (*core.PaymentStream, error) {
+	var dbStream PaymentStreamV1
+	if err := s.db.Where("id = ?", strings.ToLower(id)).First(&dbStream).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get payment stream: %w", err)
+	}
+
+	return databasePaymentStreamToCore(&dbStream), nil
+}
+
+// GetPaymentStreams retrieves the payment streams the wallet sends or receives, newest first.
+func (s *DBStore) GetPaymentStreams(wallet string) ([]core.PaymentStream, error) {
+	wallet = strings.ToLower(wallet)
+
+	var dbStreams []PaymentStreamV1
+	err := s.db.
+		Where("sender = ? OR recipient = ?", wallet, wallet).
+		Order("created_at DESC").
+		Find(&dbStreams).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment streams: %w", err)
+	}
+
+	return databasePaymentStreamsToCore(dbStreams), nil
+}
+
+// LockPaymentStream retrieves a payment stream by ID and locks its row for update
+// (postgres only, must be used within a transaction). Returns nil if the stream does not exist.
+func (s *DBStore) LockPaymentStream(id string) (*core.PaymentStream, error) {
+	query := s.db.Where("id = ?", strings.ToLower(id))
+	if s.db.Dialector.Name() == "postgres" {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+
+	var dbStream PaymentStreamV1
+	if err := query.First(&dbStream).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get payment stream: %w", err)
+	}
+
+	return databasePaymentStreamToCore(&dbStream), nil
+}
+
+// UpdatePaymentStream persists the status, end and settlement progress of a payment stream.
+func (s *DBStore) UpdatePaymentStream(stream core.PaymentStream) error {
+	result := s.db.Model(&PaymentStreamV1{}).
+		Where("id = ?", strings.ToLower(stream.ID)).
+		Updates(map[string]any{
+			"status":        stream.Status,
+			"ends_at":       stream.EndsAt.UTC(),
+			"settled":       stream.Settled,
+			"settled_until": stream.SettledUntil.UTC(),
+			"updated_at":    time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update payment stream: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("payment stream not found: %s", stream.ID)
+	}
+
+	return nil
+}
+
+// GetDuePaymentStreams retrieves active and cancelled payment streams that have not been settled up to their end
+// and have not reached their cap, least recently settled first.
+func (s *DBStore) GetDuePaymentStreams(limit uint32) ([]core.PaymentStream, error) {
+	var dbStreams []PaymentStreamV1
+	err := s.db.
+		Where("status IN ? AND settled_until < ends_at AND settled < cap",
+			[]core.PaymentStreamStatus{core.PaymentStreamStatusActive, core.PaymentStreamStatusCancelled}).
+		Order("settled_until ASC").
+		Limit(int(limit)).
+		Find(&dbStreams).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due payment streams: %w", err)
+	}
+
+	return databasePaymentStreamsToCore(dbStreams), nil
+}
+
+func databasePaymentStreamsToCore(dbStreams []PaymentStreamV1) []core.PaymentStream {
+	streams := make([]core.PaymentStream, 0, len(dbStreams))
+	for i := range dbStreams {
+		streams = append(streams, *databasePaymentStreamToCore(&dbStreams[i]))
+	}
+	return streams
+}
+
+func databasePaymentStreamToCore(dbStream *PaymentStreamV1) *core.PaymentStream {
+	return &core.PaymentStream{
+		ID: dbStream.ID,
+		Authorization: core.PaymentStreamAuthorization{
+			Sender:    dbStream.Sender,
+			Recipient: dbStream.Recipient,
+			Asset:     dbStream.Asset,
+			Rate:      dbStream.Rate,
+			Cap:       dbStream.Cap,
+			ExpiresAt: dbStream.ExpiresAt,
+			Nonce:     dbStream.Nonce,
+		},
+		SenderSig:    dbStream.SenderSig,
+		Status:       dbStream.Status,
+		StartsAt:     dbStream.StartsAt,
+		EndsAt:       dbStream.EndsAt,
+		Settled:      dbStream.Settled,
+		SettledUntil: dbStream.SettledUntil,
+		CreatedAt:    dbStream.CreatedAt,
+		UpdatedAt:    dbStream.UpdatedAt,
+	}
+}
@@ -0,0 +1,198 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentStreamV1_TableName(t *testing.T) {
+	stream := PaymentStreamV1{}
+	assert.Equal(t, "payment_streams_v1", stream.TableName())
+}
+
+func newTestPaymentStream(id, sender, recipient string, startsAt time.Time) core.PaymentStream {
+	return core.PaymentStream{
+		ID: id,
+		Authorization: core.PaymentStreamAuthorization{
+			Sender:    sender,
+			Recipient: recipient,
+			Asset:     "usdc",
+			Rate:      decimal.RequireFromString("0.5"),
+			Cap:       decimal.NewFromInt(100),
+			ExpiresAt: uint64(startsAt.Add(time.Hour).Unix()),
+			Nonce:     1,
+		},
+		SenderSig:    "0xSig",
+		Status:       core.PaymentStreamStatusActive,
+		StartsAt:     startsAt,
+		EndsAt:       startsAt.Add(time.Hour),
+		Settled:      decimal.Zero,
+		SettledUntil: startsAt,
+	}
+}
+
+func TestDBStore_CreatePaymentStream(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	store := NewDBStore(db)
+	startsAt := time.Now().Truncate(time.Second)
+
+	require.NoError(t, store.CreatePaymentStream(newTestPaymentStream("0xStream1", "0xSender", "0xRecipient", startsAt)))
+
+	var dbStream PaymentStreamV1
+	require.NoError(t, db.Where("id = ?", "0xstream1").First(&dbStream).Error)
+	assert.Equal(t, "0xsender", dbStream.Sender)
+	assert.Equal(t, "0xrecipient", dbStream.Recipient)
+	assert.Equal(t, "usdc", dbStream.Asset)
+	assert.True(t, decimal.RequireFromString("0.5").Equal(dbStream.Rate))
+	assert.True(t, decimal.NewFromInt(100).Equal(dbStream.Cap))
+	assert.Equal(t, uint64(startsAt.Add(time.Hour).Unix()), dbStream.ExpiresAt)
+	assert.Equal(t, "0xSig", dbStream.SenderSig)
+	assert.Equal(t, core.PaymentStreamStatusActive, dbStream.Status)
+	assert.True(t, startsAt.Equal(dbStream.StartsAt))
+	assert.True(t, startsAt.Add(time.Hour).Equal(dbStream.EndsAt))
+	assert.True(t, dbStream.Settled.IsZero())
+
+	err := store.CreatePaymentStream(newTestPaymentStream("0xStream1", "0xSender", "0xRecipient", startsAt))
+	assert.Error(t, err)
+}
+
+func TestDBStore_GetPaymentStream(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, cleanup := SetupTestDB(t)
+		defer cleanup()
+
+		store := NewDBStore(db)
+		require.NoError(t, store.CreatePaymentStream(newTestPaymentStream("0xstream1", "0xsender", "0xrecipient", time.Now())))
+
+		stream, err := store.GetPaymentStream("0xSTREAM1")
+		require.NoError(t, err)
+		require.NotNil(t, stream)
+		assert.Equal(t, "0xstream1", stream.ID)
+		assert.Equal(t, "0xsender", stream.Authorization.Sender)
+		assert.Equal(t, uint64(1), stream.Authorization.Nonce)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		db, cleanup := SetupTestDB(t)
+		defer cleanup()
+
+		store := NewDBStore(db)
+
+		stream, err := store.GetPaymentStream("0xmissing")
+		require.NoError(t, err)
+		assert.Nil(t, stream)
+
+		stream, err = store.LockPaymentStream("0xmissing")
+		require.NoError(t, err)
+		assert.Nil(t, stream)
+	})
+}
+
+func TestDBStore_GetPaymentStreams(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	store := NewDBStore(db)
+	now := time.Now()
+
+	require.NoError(t, store.CreatePaymentStream(newTestPaymentStream("0xstream1", "0xalice", "0xbob", now)))
+	require.NoError(t, store.CreatePaymentStream(newTestPaymentStream("0xstream2", "0xbob", "0xcarol", now)))
+	require.NoError(t, store.CreatePaymentStream(newTestPaymentStream("0xstream3", "0xcarol", "0xalice", now)))
+
+	streams, err := store.GetPaymentStreams("0xBOB")
+	require.NoError(t, err)
+	require.Len(t, streams, 2)
+
+	ids := []string{streams[0].ID, streams[1].ID}
+	assert.ElementsMatch(t, []string{"0xstream1", "0xstream2"}, ids)
+
+	streams, err = store.GetPaymentStreams("0xdave")
+	require.NoError(t, err)
+	assert.Empty(t, streams)
+}
+
+func TestDBStore_UpdatePaymentStream(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, cleanup := SetupTestDB(t)
+		defer cleanup()
+
+		store := NewDBStore(db)
+		now := time.Now().Truncate(time.Second)
+		stream := newTestPaymentStream("0xstream1", "0xsender", "0xrecipient", now)
+		require.NoError(t, store.CreatePaymentStream(stream))
+
+		stream.Status = core.PaymentStreamStatusCancelled
+		stream.EndsAt = now.Add(time.Minute)
+		stream.Settled = decimal.NewFromInt(30)
+		stream.SettledUntil = now.Add(time.Minute)
+		require.NoError(t, store.UpdatePaymentStream(stream))
+
+		updated, err := store.LockPaymentStream("0xstream1")
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+		assert.Equal(t, core.PaymentStreamStatusCancelled, updated.Status)
+		assert.True(t, now.Add(time.Minute).Equal(updated.EndsAt))
+		assert.True(t, decimal.NewFromInt(30).Equal(updated.Settled))
+		assert.True(t, now.Add(time.Minute).Equal(updated.SettledUntil))
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		db, cleanup := SetupTestDB(t)
+		defer cleanup()
+
+		store := NewDBStore(db)
+
+		err := store.UpdatePaymentStream(newTestPaymentStream("0xmissing", "0xsender", "0xrecipient", time.Now()))
+		assert.Error(t, err)
+	})
+}
+
+func TestDBStore_GetDuePaymentStreams(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	store := NewDBStore(db)
+	now := time.Now()
+
+	older := newTestPaymentStream("0xolder", "0xsender", "0xrecipient", now.Add(-time.Minute))
+	require.NoError(t, store.CreatePaymentStream(older))
+	require.NoError(t, store.CreatePaymentStream(newTestPaymentStream("0xnewer", "0xsender", "0xrecipient", now)))
+
+	cancelled := newTestPaymentStream("0xcancelled", "0xsender", "0xrecipient", now.Add(-time.Hour))
+	cancelled.Status = core.PaymentStreamStatusCancelled
+	cancelled.EndsAt = now
+	require.NoError(t, store.CreatePaymentStream(cancelled))
+
+	settledCancelled := newTestPaymentStream("0xsettledcancelled", "0xsender", "0xrecipient", now.Add(-time.Hour))
+	settledCancelled.Status = core.PaymentStreamStatusCancelled
+	settledCancelled.EndsAt = now
+	settledCancelled.SettledUntil = now
+	require.NoError(t, store.CreatePaymentStream(settledCancelled))
+
+	capped := newTestPaymentStream("0xcapped", "0xsender", "0xrecipient", now.Add(-time.Hour))
+	capped.Settled = decimal.NewFromInt(100)
+	require.NoError(t, store.CreatePaymentStream(capped))
+
+	completed := newTestPaymentStream("0xcompleted", "0xsender", "0xrecipient", now.Add(-time.Hour))
+	completed.Status = core.PaymentStreamStatusCompleted
+	require.NoError(t, store.CreatePaymentStream(completed))
+
+	streams, err := store.GetDuePaymentStreams(10)
+	require.NoError(t, err)
+	require.Len(t, streams, 3)
+	assert.Equal(t, "0xcancelled", streams[0].ID)
+	assert.Equal(t, "0xolder", streams[1].ID)
+	assert.Equal(t, "0xnewer", streams[2].ID)
+
+	streams, err = store.GetDuePaymentStreams(1)
+	require.NoError(t, err)
+	require.Len(t, streams, 1)
+	assert.Equal(t, "0xcancelled", streams[0].ID)
+}
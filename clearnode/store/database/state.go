@@ -127,6 +127,33 @@ func (s *DBStore) GetLastUserState(wallet, asset string, signed bool) (*core.Sta
 	return databaseStateToCore(&dbState)
 }
 
+// GetUserStatesAfterVersion retrieves the states of a user's asset in an epoch with a version
+// greater than the given one, in ascending version order.
+func (s *DBStore) GetUserStatesAfterVersion(wallet, asset string, epoch, version uint64) ([]core.State, error) {
+	var dbStates []State
+	err := s.db.Table("channel_states AS s").
+		Select("s.*, hc.blockchain_id AS home_blockchain_id, hc.token AS home_token_address, ec.blockchain_id AS escrow_blockchain_id, ec.token AS escrow_token_address").
+		Joins("LEFT JOIN channels AS hc ON s.home_channel_id = hc.channel_id").
+		Joins("LEFT JOIN channels AS ec ON s.escrow_channel_id = ec.channel_id").
+		Where("s.user_wallet = ? AND s.asset = ? AND s.epoch = ? AND s.version > ?", strings.ToLower(wallet), asset, epoch, version).
+		Order("s.version ASC").
+		Find(&dbStates).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user states: %w", err)
+	}
+
+	states := make([]core.State, 0, len(dbStates))
+	for i := range dbStates {
+		state, err := databaseStateToCore(&dbStates[i])
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, *state)
+	}
+
+	return states, nil
+}
+
 // StoreUserState persists a new user state to the database.
 func (s *DBStore) StoreUserState(state core.State) error {
 	dbState, err := coreStateToDB(&state)
@@ -370,6 +370,62 @@ func TestDBStore_GetLastUserState(t *testing.T) {
 	})
 }
 
+func TestDBStore_GetUserStatesAfterVersion(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	store := NewDBStore(db)
+
+	homeChannelID := "0xhomechannel123"
+	require.NoError(t, store.CreateChannel(core.Channel{
+		ChannelID:    homeChannelID,
+		UserWallet:   "0xuser123",
+		Asset:        "usdc",
+		Type:         core.ChannelTypeHome,
+		BlockchainID: 1,
+		TokenAddress: "0xtoken123",
+		Status:       core.ChannelStatusOpen,
+	}))
+
+	storeState := func(id string, epoch, version uint64) {
+		require.NoError(t, store.StoreUserState(core.State{
+			ID:            id,
+			Asset:         "USDC",
+			UserWallet:    "0xuser123",
+			Epoch:         epoch,
+			Version:       version,
+			HomeChannelID: &homeChannelID,
+			Transition:    core.Transition{},
+			HomeLedger: core.Ledger{
+				UserBalance: decimal.NewFromInt(int64(version)),
+				UserNetFlow: decimal.Zero,
+				NodeBalance: decimal.Zero,
+				NodeNetFlow: decimal.Zero,
+			},
+		}))
+	}
+	storeState("state1", 1, 1)
+	storeState("state4", 1, 4)
+	storeState("state2", 1, 2)
+	storeState("state5", 2, 5)
+
+	t.Run("Success - States after version in ascending order", func(t *testing.T) {
+		states, err := store.GetUserStatesAfterVersion("0xUSER123", "USDC", 1, 1)
+		require.NoError(t, err)
+		require.Len(t, states, 2)
+		assert.Equal(t, "state2", states[0].ID)
+		assert.Equal(t, "state4", states[1].ID)
+		assert.Equal(t, uint64(1), states[1].HomeLedger.BlockchainID)
+		assert.Equal(t, "4", states[1].HomeLedger.UserBalance.String())
+	})
+
+	t.Run("Success - No states after the last version", func(t *testing.T) {
+		states, err := store.GetUserStatesAfterVersion("0xuser123", "USDC", 1, 4)
+		require.NoError(t, err)
+		assert.Empty(t, states)
+	})
+}
+
 func TestDBStore_GetLastStateByChannelID(t *testing.T) {
 	t.Run("Success - Get by home channel ID", func(t *testing.T) {
 		db, cleanup := SetupTestDB(t)
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
@@ -56,6 +57,20 @@ func (s *DBStore) UpdateUserStaked(wallet string, blockchainID uint64, amount de
 	return nil
 }
 
+// GetUserStaked returns the staked amount of a user on a specific blockchain, zero if none is recorded.
+func (s *DBStore) GetUserStaked(wallet string, blockchainID uint64) (decimal.Decimal, error) {
+	var record UserStakedV1
+	err := s.db.Where("user_wallet = ? AND blockchain_id = ?", strings.ToLower(wallet), blockchainID).First(&record).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return decimal.Zero, nil
+		}
+		return decimal.Zero, fmt.Errorf("failed to get user staked amount: %w", err)
+	}
+
+	return record.Amount, nil
+}
+
 // GetTotalUserStaked returns the total staked amount for a user across all blockchains.
 func (s *DBStore) GetTotalUserStaked(wallet string) (decimal.Decimal, error) {
 	wallet = strings.ToLower(wallet)
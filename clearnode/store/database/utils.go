@@ -24,6 +24,7 @@ func databaseChannelToCore(dbChannel *Channel) *core.Channel {
 		Nonce:                 dbChannel.Nonce,
 		Status:                dbChannel.Status,
 		StateVersion:          dbChannel.StateVersion,
+		MigrationStatus:       dbChannel.MigrationStatus,
 	}
 }
 
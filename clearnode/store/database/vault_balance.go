@@ -0,0 +1,93 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// VaultBalanceV1 is the amount of a token a wallet holds in the vault of a ChannelHub contract,
+// as the sum of its Deposited events minus the sum of its Withdrawn events.
+type VaultBalanceV1 struct {
+	Wallet       string          `gorm:"column:wallet;primaryKey;not null"`
+	BlockchainID uint64          `gorm:"column:blockchain_id;primaryKey;not null"`
+	Token        string          `gorm:"column:token;primaryKey;not null"`
+	Balance      decimal.Decimal `gorm:"column:balance;type:varchar(78);not null"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (VaultBalanceV1) TableName() string {
+	return "vault_balances_v1"
+}
+
+// AdjustVaultBalance adds delta, negative for withdrawals, to the vault balance of a wallet
+// for a token on a specific blockchain. The resulting balance must not be negative.
+func (s *DBStore) AdjustVaultBalance(wallet string, blockchainID uint64, tokenAddress string, delta decimal.Decimal) error {
+	wallet = strings.ToLower(wallet)
+	tokenAddress = strings.ToLower(tokenAddress)
+
+	if wallet == "" {
+		return fmt.Errorf("wallet address must not be empty")
+	}
+	if blockchainID == 0 {
+		return fmt.Errorf("blockchain ID must not be zero")
+	}
+
+	query := s.db
+	if s.db.Dialector.Name() == "postgres" {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+
+	var record VaultBalanceV1
+	err := query.Where("wallet = ? AND blockchain_id = ? AND token = ?", wallet, blockchainID, tokenAddress).First(&record).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return fmt.Errorf("failed to get vault balance: %w", err)
+	}
+
+	balance := record.Balance.Add(delta)
+	if balance.IsNegative() {
+		return fmt.Errorf("vault balance of %s for token %s on blockchain %d would become negative: %s", wallet, tokenAddress, blockchainID, balance)
+	}
+
+	now := time.Now()
+	record = VaultBalanceV1{
+		Wallet:       wallet,
+		BlockchainID: blockchainID,
+		Token:        tokenAddress,
+		Balance:      balance,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	err = s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "wallet"}, {Name: "blockchain_id"}, {Name: "token"}},
+		DoUpdates: clause.AssignmentColumns([]string{"balance", "updated_at"}),
+	}).Create(&record).Error
+	if err != nil {
+		return fmt.Errorf("failed to update vault balance: %w", err)
+	}
+
+	return nil
+}
+
+// GetVaultBalance returns the vault balance of a wallet for a token on a specific blockchain.
+// It is zero if the wallet has never deposited the token.
+func (s *DBStore) GetVaultBalance(wallet string, blockchainID uint64, tokenAddress string) (decimal.Decimal, error) {
+	var record VaultBalanceV1
+	err := s.db.
+		Where("wallet = ? AND blockchain_id = ? AND token = ?", strings.ToLower(wallet), blockchainID, strings.ToLower(tokenAddress)).
+		First(&record).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return decimal.Zero, nil
+		}
+		return decimal.Zero, fmt.Errorf("failed to get vault balance: %w", err)
+	}
+
+	return record.Balance, nil
+}
@@ -0,0 +1,61 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultBalanceV1_TableName(t *testing.T) {
+	assert.Equal(t, "vault_balances_v1", VaultBalanceV1{}.TableName())
+}
+
+func TestDBStore_AdjustVaultBalance(t *testing.T) {
+	t.Run("Success - Deposits and withdrawals", func(t *testing.T) {
+		db, cleanup := SetupTestDB(t)
+		defer cleanup()
+
+		store := NewDBStore(db)
+
+		require.NoError(t, store.AdjustVaultBalance("0xNode", 1, "0xToken", decimal.NewFromInt(100)))
+		require.NoError(t, store.AdjustVaultBalance("0xnode", 1, "0xtoken", decimal.NewFromFloat(25.5)))
+		require.NoError(t, store.AdjustVaultBalance("0xnode", 1, "0xtoken", decimal.NewFromInt(-40)))
+
+		balance, err := store.GetVaultBalance("0xnode", 1, "0xtoken")
+		require.NoError(t, err)
+		assert.True(t, balance.Equal(decimal.NewFromFloat(85.5)), "balance: %s", balance)
+
+		// Balances are kept per blockchain
+		balance, err = store.GetVaultBalance("0xnode", 137, "0xtoken")
+		require.NoError(t, err)
+		assert.True(t, balance.IsZero())
+	})
+
+	t.Run("Error - Negative balance", func(t *testing.T) {
+		db, cleanup := SetupTestDB(t)
+		defer cleanup()
+
+		store := NewDBStore(db)
+
+		require.NoError(t, store.AdjustVaultBalance("0xnode", 1, "0xtoken", decimal.NewFromInt(10)))
+		err := store.AdjustVaultBalance("0xnode", 1, "0xtoken", decimal.NewFromInt(-11))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "would become negative")
+
+		balance, err := store.GetVaultBalance("0xnode", 1, "0xtoken")
+		require.NoError(t, err)
+		assert.True(t, balance.Equal(decimal.NewFromInt(10)))
+	})
+
+	t.Run("Error - Empty wallet", func(t *testing.T) {
+		db, cleanup := SetupTestDB(t)
+		defer cleanup()
+
+		store := NewDBStore(db)
+
+		err := store.AdjustVaultBalance("", 1, "0xtoken", decimal.NewFromInt(10))
+		require.Error(t, err)
+	})
+}
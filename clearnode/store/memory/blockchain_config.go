@@ -5,14 +5,16 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"time"
 
 	"github.com/layer-3/nitrolite/pkg/core"
 	"gopkg.in/yaml.v3"
 )
 
 const (
-	defaultBlockStep    = uint64(10000)
-	blockchainsFileName = "blockchains.yaml"
+	defaultBlockStep      = uint64(10000)
+	defaultBlockchainType = "evm"
+	blockchainsFileName   = "blockchains.yaml"
 )
 
 var (
@@ -35,10 +37,22 @@ type BlockchainConfig struct {
 	Name string `yaml:"name"`
 	// ID is the chain ID used for RPC validation
 	ID uint64 `yaml:"id"`
+	// Type selects the blockchain backend the node connects to the blockchain with (default: "evm").
+	// Contract addresses are validated as EVM addresses only for "evm" blockchains.
+	Type string `yaml:"type"`
 	// TODO: blockchains must not be disabled in prod deployment
 	Disabled bool `yaml:"disabled"`
 	// BlockStep defines the block range for scanning (default: 10000)
 	BlockStep uint64 `yaml:"block_step"`
+	// Confirmations is the number of blocks built on top of an event's block before the event is processed (default: 0).
+	// Processed events removed by deeper chain reorganizations are rolled back and replayed.
+	Confirmations uint64 `yaml:"confirmations"`
+	// EventPollInterval switches event listening to polling with eth_getLogs at the given interval (e.g. "15s"),
+	// for RPC endpoints that don't support subscriptions. Events are subscribed to when it is not set.
+	EventPollInterval time.Duration `yaml:"event_poll_interval"`
+	// RPCQuorum is the number of RPC endpoints that must agree on the result of contract reads (default: 1).
+	// Several endpoints are configured as a comma-separated list in CLEARNODE_BLOCKCHAIN_RPC_<NAME>.
+	RPCQuorum int `yaml:"rpc_quorum"`
 	// ChannelHubAddress is the address of the ChannelHub contract on this blockchain
 	ChannelHubAddress string `yaml:"channel_hub_address"`
 	// ChannelHubSigValidators maps validator IDs to the addresses of signature validators for the ChannelHub contract on this blockchain
@@ -52,7 +66,7 @@ type BlockchainConfig struct {
 // verifies RPC connections, and returns a map of enabled blockchains indexed by chain ID.
 //
 // The function performs the following validations:
-// - Contract addresses format (0x + 40 hex chars)
+// - Contract addresses format (0x + 40 hex chars) of EVM blockchains
 // - Blockchain names (lowercase with underscores)
 // - RPC endpoint availability and chain ID matching
 // - Required contract addresses (using defaults when not specified)
@@ -86,23 +100,36 @@ func verifyBlockchainsConfig(cfg *BlockchainsConfig) error {
 			return fmt.Errorf("invalid blockchain name '%s', should match snake_case format", bc.Name)
 		}
 
+		if bc.Type == "" {
+			cfg.Blockchains[i].Type = defaultBlockchainType
+		}
+		isEVM := cfg.Blockchains[i].Type == defaultBlockchainType
+
 		if bc.ChannelHubAddress == "" && bc.LockingContractAddress == "" {
 			return fmt.Errorf("blockchain '%s' must specify at least one of channel_hub_address or locking_contract_address", bc.Name)
 		}
 
-		if bc.ChannelHubAddress != "" && !contractAddressRegex.MatchString(bc.ChannelHubAddress) {
+		if isEVM && bc.ChannelHubAddress != "" && !contractAddressRegex.MatchString(bc.ChannelHubAddress) {
 			return fmt.Errorf("invalid channel hub address '%s' for blockchain '%s'", bc.ChannelHubAddress, bc.Name)
 		}
 
-		if bc.LockingContractAddress != "" && !contractAddressRegex.MatchString(bc.LockingContractAddress) {
+		if isEVM && bc.LockingContractAddress != "" && !contractAddressRegex.MatchString(bc.LockingContractAddress) {
 			return fmt.Errorf("invalid locking contract address '%s' for blockchain '%s'", bc.LockingContractAddress, bc.Name)
 		}
 
+		if bc.EventPollInterval < 0 {
+			return fmt.Errorf("invalid event poll interval '%s' for blockchain '%s'", bc.EventPollInterval, bc.Name)
+		}
+
+		if bc.RPCQuorum < 0 {
+			return fmt.Errorf("invalid RPC quorum %d for blockchain '%s'", bc.RPCQuorum, bc.Name)
+		}
+
 		if bc.BlockStep == 0 {
 			cfg.Blockchains[i].BlockStep = defaultBlockStep
 		}
 
-		if bc.ChannelHubAddress != "" && len(core.ChannelSignerTypes) > 1 {
+		if isEVM && bc.ChannelHubAddress != "" && len(core.ChannelSignerTypes) > 1 {
 			for _, channelSignerType := range core.ChannelSignerTypes[1:] {
 				validatorAddress, ok := bc.ChannelHubSigValidators[uint8(channelSignerType)]
 				if !ok {
@@ -1,10 +1,13 @@
 package memory
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestBlockchainConfig_verifyVariables(t *testing.T) {
@@ -42,6 +45,7 @@ func TestBlockchainConfig_verifyVariables(t *testing.T) {
 				assert.Equal(t, "0x1111111111111111111111111111111111111111", ethCfg.ChannelHubAddress)
 				assert.False(t, ethCfg.Disabled)
 				assert.Equal(t, uint64(10), ethCfg.BlockStep)
+				assert.Equal(t, defaultBlockchainType, ethCfg.Type)
 
 				sepoliaCfg := blockchains[1]
 				assert.Equal(t, "ethereum_sepolia", sepoliaCfg.Name)
@@ -119,6 +123,66 @@ func TestBlockchainConfig_verifyVariables(t *testing.T) {
 			},
 			expectedErrorStr: "invalid channel hub address '0x0000s00000000000000000000000000000000001' for blockchain 'ethereum'",
 		},
+		{
+			name: "negative event poll interval",
+			cfg: BlockchainsConfig{
+				Blockchains: []BlockchainConfig{
+					{
+						ID:                     1,
+						Name:                   "ethereum",
+						LockingContractAddress: "0x2222222222222222222222222222222222222222",
+						EventPollInterval:      -time.Second,
+					},
+				},
+			},
+			expectedErrorStr: "invalid event poll interval '-1s' for blockchain 'ethereum'",
+		},
+		{
+			name: "negative RPC quorum",
+			cfg: BlockchainsConfig{
+				Blockchains: []BlockchainConfig{
+					{
+						ID:                     1,
+						Name:                   "ethereum",
+						LockingContractAddress: "0x2222222222222222222222222222222222222222",
+						RPCQuorum:              -1,
+					},
+				},
+			},
+			expectedErrorStr: "invalid RPC quorum -1 for blockchain 'ethereum'",
+		},
+		{
+			name: "non-EVM blockchain with non-EVM addresses",
+			cfg: BlockchainsConfig{
+				Blockchains: []BlockchainConfig{
+					{
+						ID:                1,
+						Name:              "mockchain",
+						Type:              "mock",
+						ChannelHubAddress: "channel_hub",
+					},
+				},
+			},
+			assertFunc: func(t *testing.T, blockchains []BlockchainConfig) {
+				require.Len(t, blockchains, 1)
+				assert.Equal(t, "mock", blockchains[0].Type)
+				assert.Equal(t, "channel_hub", blockchains[0].ChannelHubAddress)
+			},
+		},
+		{
+			name: "EVM blockchain with non-EVM address",
+			cfg: BlockchainsConfig{
+				Blockchains: []BlockchainConfig{
+					{
+						ID:                1,
+						Name:              "ethereum",
+						Type:              "evm",
+						ChannelHubAddress: "channel_hub",
+					},
+				},
+			},
+			expectedErrorStr: "invalid channel hub address 'channel_hub' for blockchain 'ethereum'",
+		},
 	}
 
 	for _, tc := range tcs {
@@ -137,3 +201,23 @@ func TestBlockchainConfig_verifyVariables(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockchainConfig_EventPollInterval(t *testing.T) {
+	var cfg BlockchainsConfig
+	err := yaml.NewDecoder(strings.NewReader(`
+blockchains:
+  - name: polygon_amoy
+    id: 80002
+    locking_contract_address: "0x2222222222222222222222222222222222222222"
+    event_poll_interval: 15s
+  - name: base_sepolia
+    id: 84532
+    locking_contract_address: "0x2222222222222222222222222222222222222222"
+`)).Decode(&cfg)
+	require.NoError(t, err)
+	require.NoError(t, verifyBlockchainsConfig(&cfg))
+
+	require.Len(t, cfg.Blockchains, 2)
+	assert.Equal(t, 15*time.Second, cfg.Blockchains[0].EventPollInterval)
+	assert.Zero(t, cfg.Blockchains[1].EventPollInterval, "events are subscribed to by default")
+}
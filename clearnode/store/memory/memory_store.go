@@ -34,9 +34,13 @@ func NewMemoryStoreV1(assetsConfig AssetsConfig, blockchainsConfig map[uint64]Bl
 		blockchains = append(blockchains, core.Blockchain{
 			ID:                     bc.ID,
 			Name:                   bc.Name,
+			Type:                   bc.Type,
 			ChannelHubAddress:      bc.ChannelHubAddress,
 			LockingContractAddress: bc.LockingContractAddress,
 			BlockStep:              bc.BlockStep,
+			Confirmations:          bc.Confirmations,
+			EventPollInterval:      bc.EventPollInterval,
+			RPCQuorum:              bc.RPCQuorum,
 		})
 	}
 	slices.SortFunc(blockchains, func(a, b core.Blockchain) int {
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"fmt"
+	"sync"
+)
+
+// AppStateValidatorV1 enforces application-specific rules on app session state updates,
+// so that game or escrow logic is checked by the node instead of being trusted to participants.
+type AppStateValidatorV1 interface {
+	// ValidateAppStateUpdate is invoked for every state update of sessions of the application
+	// after the quorum signatures were verified and before any funds are moved.
+	// The session holds the previous version and session data, currentAllocations the previous
+	// non-zero allocations, and update the proposed state. An empty update.SessionData leaves
+	// the previous session data unchanged.
+	// A non-nil error rejects the update.
+	ValidateAppStateUpdate(session AppSessionV1, currentAllocations []AppAllocationV1, update AppStateUpdateV1) error
+}
+
+// AppStateValidatorFuncV1 is an adapter to allow the use of ordinary functions as app state validators.
+type AppStateValidatorFuncV1 func(session AppSessionV1, currentAllocations []AppAllocationV1, update AppStateUpdateV1) error
+
+// ValidateAppStateUpdate calls f(session, currentAllocations, update).
+func (f AppStateValidatorFuncV1) ValidateAppStateUpdate(session AppSessionV1, currentAllocations []AppAllocationV1, update AppStateUpdateV1) error {
+	return f(session, currentAllocations, update)
+}
+
+// AppStateValidatorRegistryV1 holds the state validators attached to registered applications.
+// Applications without a validator are only subject to the node's generic checks.
+type AppStateValidatorRegistryV1 struct {
+	mu         sync.RWMutex
+	validators map[string]AppStateValidatorV1
+}
+
+// NewAppStateValidatorRegistryV1 creates an empty AppStateValidatorRegistryV1.
+func NewAppStateValidatorRegistryV1() *AppStateValidatorRegistryV1 {
+	return &AppStateValidatorRegistryV1{
+		validators: make(map[string]AppStateValidatorV1),
+	}
+}
+
+// Register attaches the validator to the application with the given ID.
+// An application can have at most one validator.
+func (r *AppStateValidatorRegistryV1) Register(applicationID string, validator AppStateValidatorV1) error {
+	if !AppIDV1Regex.MatchString(applicationID) {
+		return fmt.Errorf("invalid application ID: %s", applicationID)
+	}
+	if validator == nil {
+		return fmt.Errorf("validator for application %s is nil", applicationID)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.validators[applicationID]; ok {
+		return fmt.Errorf("validator for application %s is already registered", applicationID)
+	}
+	r.validators[applicationID] = validator
+	return nil
+}
+
+// Get returns the validator attached to the application, if any.
+func (r *AppStateValidatorRegistryV1) Get(applicationID string) (AppStateValidatorV1, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	validator, ok := r.validators[applicationID]
+	return validator, ok
+}
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppStateValidatorRegistryV1(t *testing.T) {
+	t.Parallel()
+	registry := NewAppStateValidatorRegistryV1()
+
+	errRejected := errors.New("rejected")
+	validator := AppStateValidatorFuncV1(func(session AppSessionV1, _ []AppAllocationV1, update AppStateUpdateV1) error {
+		if update.SessionData == session.SessionData {
+			return errRejected
+		}
+		return nil
+	})
+
+	_, ok := registry.Get("chess-v1")
+	assert.False(t, ok)
+
+	require.NoError(t, registry.Register("chess-v1", validator))
+
+	got, ok := registry.Get("chess-v1")
+	require.True(t, ok)
+	assert.ErrorIs(t, got.ValidateAppStateUpdate(AppSessionV1{SessionData: "a"}, nil, AppStateUpdateV1{SessionData: "a"}), errRejected)
+	assert.NoError(t, got.ValidateAppStateUpdate(AppSessionV1{SessionData: "a"}, nil, AppStateUpdateV1{SessionData: "b"}))
+
+	assert.Error(t, registry.Register("chess-v1", validator), "duplicate registration")
+	assert.Error(t, registry.Register("Invalid ID", validator), "invalid application ID")
+	assert.Error(t, registry.Register("poker-v1", nil), "nil validator")
+}
@@ -0,0 +1,126 @@
+// Package blockchain abstracts the blockchain families the node settles channels on.
+// Each family provides a Backend, created by the factory registered for its type in a BackendRegistry.
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/sign"
+)
+
+// Backend connects the node to the ChannelHub and Locking contracts of one blockchain.
+type Backend interface {
+	// ChannelHubClient returns the client sending the node's transactions to the ChannelHub contract.
+	// It fails if no ChannelHub is configured for the blockchain.
+	ChannelHubClient() (core.BlockchainClientV2, error)
+	// TxTracker reports the outcome of the transactions sent by the ChannelHub client.
+	TxTracker() TxTracker
+	// StatePacker packs channel states the way the blockchain's ChannelHub verifies their signatures.
+	StatePacker() core.StatePacker
+
+	// ListenChannelHub delivers the ChannelHub events to handler until ctx is done.
+	// handleClosure is called once listening stops, with the error that stopped it, if any.
+	ListenChannelHub(ctx context.Context, handler core.ChannelHubEventHandler, cfg ListenerConfig, handleClosure func(err error)) error
+	// ListenLocking delivers the Locking contract events to handler until ctx is done.
+	// handleClosure is called once listening stops, with the error that stopped it, if any.
+	ListenLocking(ctx context.Context, handler core.LockingContractEventHandler, cfg ListenerConfig, handleClosure func(err error)) error
+
+	// Close releases the connections of the backend.
+	Close()
+}
+
+// AssetStore provides the asset and token metadata backends convert amounts with.
+type AssetStore interface {
+	// GetAssetDecimals checks if an asset exists and returns its decimals in YN
+	GetAssetDecimals(asset string) (uint8, error)
+
+	// GetTokenDecimals returns the decimals for a token on a specific blockchain
+	GetTokenDecimals(blockchainID uint64, tokenAddress string) (uint8, error)
+
+	// GetTokenAddress returns the token address for a given asset on a specific blockchain
+	GetTokenAddress(asset string, blockchainID uint64) (string, error)
+}
+
+// Config describes the blockchain a Backend connects to.
+type Config struct {
+	Blockchain core.Blockchain
+	// RPCURLs are the endpoints of the blockchain nodes, in order of preference.
+	RPCURLs []string
+	// TxSigner signs the node's transactions.
+	TxSigner sign.Signer
+	// NodeAddress is the address of the node in the ChannelHub contract.
+	NodeAddress string
+	AssetStore  AssetStore
+	Logger      log.Logger
+}
+
+// ListenerConfig holds the callbacks of a contract listener.
+// Only StoreContractEvent and GetLatestEvent are required.
+type ListenerConfig struct {
+	// StoreContractEvent records a processed event.
+	StoreContractEvent func(ev core.BlockchainEvent) error
+	// GetLatestEvent returns the last recorded event of a contract, from which listening resumes.
+	GetLatestEvent func(contractAddress string, blockchainID uint64) (core.BlockchainEvent, error)
+	// RollbackEvents removes the recorded events of a contract from the given block on and returns them.
+	RollbackEvents func(contractAddress string, blockchainID uint64, fromBlock uint64) ([]core.BlockchainEvent, error)
+	// OnEventProcessed is called for every event handled.
+	OnEventProcessed func(blockchainID uint64, success bool)
+	// OnCatchUpProgress reports the progress of fetching the events emitted while the node was offline.
+	OnCatchUpProgress func(blockchainID uint64, contractAddress string, fetchedBlock, targetBlock, blockRange uint64)
+}
+
+// BackendFactory creates the Backend of a blockchain. ctx bounds the lifetime of the backend's background work.
+type BackendFactory func(ctx context.Context, cfg Config) (Backend, error)
+
+// BackendRegistry holds the backend factories of the supported blockchain types.
+type BackendRegistry struct {
+	mu        sync.RWMutex
+	factories map[string]BackendFactory
+}
+
+// NewBackendRegistry creates an empty BackendRegistry.
+func NewBackendRegistry() *BackendRegistry {
+	return &BackendRegistry{
+		factories: make(map[string]BackendFactory),
+	}
+}
+
+// Register makes the factory create the backends of blockchains of the given type.
+// A type can have at most one factory.
+func (r *BackendRegistry) Register(blockchainType string, factory BackendFactory) error {
+	if blockchainType == "" {
+		return fmt.Errorf("blockchain type is empty")
+	}
+	if factory == nil {
+		return fmt.Errorf("backend factory for blockchain type %s is nil", blockchainType)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.factories[blockchainType]; ok {
+		return fmt.Errorf("backend factory for blockchain type %s is already registered", blockchainType)
+	}
+	r.factories[blockchainType] = factory
+	return nil
+}
+
+// New creates the backend of the blockchain in cfg with the factory registered for its type.
+func (r *BackendRegistry) New(ctx context.Context, cfg Config) (Backend, error) {
+	r.mu.RLock()
+	factory, ok := r.factories[cfg.Blockchain.Type]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unsupported blockchain type '%s' for blockchain %d", cfg.Blockchain.Type, cfg.Blockchain.ID)
+	}
+
+	backend, err := factory(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s backend for blockchain %d: %w", cfg.Blockchain.Type, cfg.Blockchain.ID, err)
+	}
+	return backend, nil
+}
@@ -0,0 +1,92 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+)
+
+func TestBackendRegistry_Register(t *testing.T) {
+	registry := NewBackendRegistry()
+
+	require.NoError(t, registry.Register(MockBackendType, NewMockBackend))
+
+	err := registry.Register(MockBackendType, NewMockBackend)
+	require.EqualError(t, err, "backend factory for blockchain type mock is already registered")
+
+	err = registry.Register("", NewMockBackend)
+	require.EqualError(t, err, "blockchain type is empty")
+
+	err = registry.Register("other", nil)
+	require.EqualError(t, err, "backend factory for blockchain type other is nil")
+}
+
+func TestBackendRegistry_New(t *testing.T) {
+	registry := NewBackendRegistry()
+	require.NoError(t, registry.Register(MockBackendType, NewMockBackend))
+	require.NoError(t, registry.Register("failing", func(context.Context, Config) (Backend, error) {
+		return nil, errors.New("dial failed")
+	}))
+
+	t.Run("registered type", func(t *testing.T) {
+		backend, err := registry.New(context.Background(), Config{Blockchain: core.Blockchain{ID: 1, Type: MockBackendType}})
+		require.NoError(t, err)
+		assert.IsType(t, &MockBackend{}, backend)
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		_, err := registry.New(context.Background(), Config{Blockchain: core.Blockchain{ID: 2, Type: "solana"}})
+		require.EqualError(t, err, "unsupported blockchain type 'solana' for blockchain 2")
+	})
+
+	t.Run("factory error", func(t *testing.T) {
+		_, err := registry.New(context.Background(), Config{Blockchain: core.Blockchain{ID: 3, Type: "failing"}})
+		require.EqualError(t, err, "failed to create failing backend for blockchain 3: dial failed")
+	})
+}
+
+type testStatePacker struct {
+	packed []byte
+}
+
+func (p testStatePacker) PackState(core.State) ([]byte, error) {
+	return p.packed, nil
+}
+
+func TestStatePackerRouter_PackState(t *testing.T) {
+	router := NewStatePackerRouter(map[uint64]core.StatePacker{
+		1: testStatePacker{packed: []byte{1}},
+	}, testStatePacker{packed: []byte{0}})
+
+	packed, err := router.PackState(core.State{HomeLedger: core.Ledger{BlockchainID: 1}})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1}, packed)
+
+	packed, err = router.PackState(core.State{HomeLedger: core.Ledger{BlockchainID: 2}})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0}, packed, "states of other blockchains are packed with the fallback")
+}
+
+func TestNodeBalanceRouter_GetNodeBalance(t *testing.T) {
+	ctx := context.Background()
+	backend := newTestMockBackend(t)
+	client, err := backend.ChannelHubClient()
+	require.NoError(t, err)
+	_, err = client.Deposit(ctx, "node", "token", decimal.NewFromInt(10))
+	require.NoError(t, err)
+
+	router := NewNodeBalanceRouter(map[uint64]core.BlockchainClientV2{1: client})
+
+	balance, err := router.GetNodeBalance(ctx, 1, "token")
+	require.NoError(t, err)
+	assert.True(t, balance.Equal(decimal.NewFromInt(10)))
+
+	_, err = router.GetNodeBalance(ctx, 2, "token")
+	require.EqualError(t, err, "no ChannelHub client for blockchain 2")
+}
@@ -0,0 +1,127 @@
+package evm
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/layer-3/nitrolite/pkg/blockchain"
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+)
+
+// BackendType is the type of EVM blockchains in the blockchain configuration.
+const BackendType = "evm"
+
+var _ blockchain.Backend = &Backend{}
+
+// Backend connects the node to the contracts of an EVM blockchain through a MultiClient.
+type Backend struct {
+	blockchain       core.Blockchain
+	client           *MultiClient
+	txManager        *TxManager
+	channelHubClient *BlockchainClient
+	statePacker      core.StatePacker
+	assetStore       blockchain.AssetStore
+	logger           log.Logger
+}
+
+// NewBackend dials the RPC endpoints of the blockchain and creates the node's ChannelHub client.
+// The health checks of the endpoints run until ctx is done. It is the BackendFactory of EVM blockchains.
+func NewBackend(ctx context.Context, cfg blockchain.Config) (blockchain.Backend, error) {
+	clientConfig := DefaultMultiClientConfig()
+	clientConfig.Quorum = cfg.Blockchain.RPCQuorum
+	client, err := DialMultiClient(ctx, cfg.RPCURLs, clientConfig, cfg.Logger)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to EVM node")
+	}
+	go client.Start(ctx)
+
+	b := &Backend{
+		blockchain: cfg.Blockchain,
+		client:     client,
+		// Shared by all senders of the node's transactions on this blockchain
+		txManager:   NewTxManager(client, cfg.TxSigner, cfg.Blockchain.ID, DefaultTxManagerConfig()),
+		statePacker: core.NewStatePackerV1(cfg.AssetStore),
+		assetStore:  cfg.AssetStore,
+		logger:      cfg.Logger,
+	}
+
+	if cfg.Blockchain.ChannelHubAddress != "" {
+		b.channelHubClient, err = NewBlockchainClient(common.HexToAddress(cfg.Blockchain.ChannelHubAddress), client, cfg.TxSigner, cfg.Blockchain.ID, cfg.NodeAddress, cfg.AssetStore,
+			ClientBalanceCheck{RequireBalanceCheck: false},
+			ClientAllowanceCheck{RequireAllowanceCheck: false},
+			ClientTxManager{TxManager: b.txManager},
+		)
+		if err != nil {
+			client.Close()
+			return nil, errors.Wrap(err, "failed to create ChannelHub client")
+		}
+	}
+
+	return b, nil
+}
+
+func (b *Backend) ChannelHubClient() (core.BlockchainClientV2, error) {
+	if b.channelHubClient == nil {
+		return nil, errors.New("channel hub address is not configured")
+	}
+	return b.channelHubClient, nil
+}
+
+func (b *Backend) TxTracker() blockchain.TxTracker {
+	return b.txManager
+}
+
+func (b *Backend) StatePacker() core.StatePacker {
+	return b.statePacker
+}
+
+func (b *Backend) ListenChannelHub(ctx context.Context, handler core.ChannelHubEventHandler, cfg blockchain.ListenerConfig, handleClosure func(err error)) error {
+	if b.blockchain.ChannelHubAddress == "" {
+		return errors.New("channel hub address is not configured")
+	}
+
+	reactor := NewChannelHubReactor(b.blockchain.ID, handler, cfg.StoreContractEvent)
+	reactor.SetAssetStore(b.assetStore)
+	reactor.SetOnEventProcessed(cfg.OnEventProcessed)
+	b.listen(ctx, b.blockchain.ChannelHubAddress, reactor.HandleEvent, cfg, handleClosure)
+	return nil
+}
+
+func (b *Backend) ListenLocking(ctx context.Context, handler core.LockingContractEventHandler, cfg blockchain.ListenerConfig, handleClosure func(err error)) error {
+	if b.blockchain.LockingContractAddress == "" {
+		return errors.New("locking contract address is not configured")
+	}
+
+	appRegistryClient, err := NewLockingClient(common.HexToAddress(b.blockchain.LockingContractAddress), b.client, b.blockchain.ID)
+	if err != nil {
+		return errors.Wrap(err, "failed to create locking client")
+	}
+
+	getTokenDecimals := func() (uint8, error) { return appRegistryClient.GetTokenDecimals(ctx) }
+	reactor, err := NewLockingContractReactor(b.blockchain.ID, handler, getTokenDecimals, cfg.StoreContractEvent)
+	if err != nil {
+		return errors.Wrap(err, "failed to create app registry reactor")
+	}
+	reactor.SetOnEventProcessed(cfg.OnEventProcessed)
+	b.listen(ctx, b.blockchain.LockingContractAddress, reactor.HandleEvent, cfg, handleClosure)
+	return nil
+}
+
+// listen starts listening to the events of the contract at the given address.
+func (b *Backend) listen(ctx context.Context, contractAddress string, handleEvent HandleEvent, cfg blockchain.ListenerConfig, handleClosure func(err error)) {
+	l := NewListener(common.HexToAddress(contractAddress), b.client, b.blockchain.ID, b.blockchain.BlockStep, b.logger, handleEvent, cfg.GetLatestEvent)
+	l.SetConfirmations(b.blockchain.Confirmations)
+	l.SetPollInterval(b.blockchain.EventPollInterval)
+	if cfg.RollbackEvents != nil {
+		l.SetRollbackEvents(cfg.RollbackEvents)
+	}
+	l.SetOnCatchUpProgress(cfg.OnCatchUpProgress)
+	l.Listen(ctx, handleClosure)
+}
+
+func (b *Backend) Close() {
+	b.client.Close()
+}
@@ -16,7 +16,7 @@ import (
 	"github.com/layer-3/nitrolite/pkg/sign"
 )
 
-var _ core.BlockchainClient = &BlockchainClient{}
+var _ core.BlockchainClientV2 = &BlockchainClient{}
 
 type BlockchainClient struct {
 	BaseClient
@@ -30,6 +30,7 @@ type BlockchainClient struct {
 	requireCheckAllowance bool
 	requireCheckBalance   bool
 	checkFeeFn            func(ctx context.Context, account common.Address) error
+	txManager             *TxManager
 }
 
 type ClientOption interface {
@@ -72,9 +73,22 @@ func NewBlockchainClient(
 	return client, nil
 }
 
+// transact sends the contract transaction built by send through the tx manager if one is configured,
+// or directly otherwise. The transaction carries value, which may be nil, and is bound to ctx.
+// send gets a copy of the client's transact options, so concurrent calls don't share them.
+func (c *BlockchainClient) transact(ctx context.Context, value *big.Int, send func(opts *bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+	opts := *c.transactOpts
+	opts.Context = ctx
+	opts.Value = value
+	if c.txManager == nil {
+		return send(&opts)
+	}
+	return c.txManager.Transact(ctx, &opts, send)
+}
+
 // ========= Getters - IVault =========
 
-func (c *BlockchainClient) GetAccountsBalances(accounts []string, tokens []string) ([][]decimal.Decimal, error) {
+func (c *BlockchainClient) GetAccountsBalances(ctx context.Context, accounts []string, tokens []string) ([][]decimal.Decimal, error) {
 	if len(accounts) == 0 || len(tokens) == 0 {
 		return [][]decimal.Decimal{}, nil
 	}
@@ -86,7 +100,7 @@ func (c *BlockchainClient) GetAccountsBalances(accounts []string, tokens []strin
 
 		for j, token := range tokens {
 			tokenAddr := common.HexToAddress(token)
-			balance, err := c.contract.GetAccountBalance(nil, accountAddr, tokenAddr)
+			balance, err := c.contract.GetAccountBalance(&bind.CallOpts{Context: ctx}, accountAddr, tokenAddr)
 			if err != nil {
 				return nil, errors.Wrapf(err, "failed to get balance for account %s and token %s", account, token)
 			}
@@ -97,7 +111,7 @@ func (c *BlockchainClient) GetAccountsBalances(accounts []string, tokens []strin
 	return result, nil
 }
 
-func (c *BlockchainClient) getAllowance(asset string, owner string) (decimal.Decimal, error) {
+func (c *BlockchainClient) getAllowance(ctx context.Context, asset string, owner string) (decimal.Decimal, error) {
 	tokenAddrHex, err := c.assetStore.GetTokenAddress(asset, c.blockchainID)
 	if err != nil {
 		return decimal.Zero, errors.Wrap(err, "failed to get token address")
@@ -114,7 +128,7 @@ func (c *BlockchainClient) getAllowance(asset string, owner string) (decimal.Dec
 	if err != nil {
 		return decimal.Zero, errors.Wrap(err, "failed to instantiate token contract")
 	}
-	allowance, err := erc20Contract.Allowance(&bind.CallOpts{}, ownerAddr, c.channelHubContractAddress)
+	allowance, err := erc20Contract.Allowance(&bind.CallOpts{Context: ctx}, ownerAddr, c.channelHubContractAddress)
 	if err != nil {
 		return decimal.Zero, errors.Wrapf(err, "failed to get allowance for token %s", asset)
 	}
@@ -127,7 +141,7 @@ func (c *BlockchainClient) getAllowance(asset string, owner string) (decimal.Dec
 	return decimal.NewFromBigInt(allowance, -int32(decimals)), nil
 }
 
-func (c *BlockchainClient) GetTokenBalance(asset string, walletAddress string) (decimal.Decimal, error) {
+func (c *BlockchainClient) GetTokenBalance(ctx context.Context, asset string, walletAddress string) (decimal.Decimal, error) {
 	tokenAddrHex, err := c.assetStore.GetTokenAddress(asset, c.blockchainID)
 	if err != nil {
 		return decimal.Zero, errors.Wrap(err, "failed to get token address")
@@ -138,7 +152,7 @@ func (c *BlockchainClient) GetTokenBalance(asset string, walletAddress string) (
 
 	// Native token (zero address) — query ETH balance directly
 	if tokenAddr == (common.Address{}) {
-		balance, err := c.evmClient.BalanceAt(context.Background(), walletAddr, nil)
+		balance, err := c.evmClient.BalanceAt(ctx, walletAddr, nil)
 		if err != nil {
 			return decimal.Zero, errors.Wrapf(err, "failed to get native balance for wallet %s", walletAddress)
 		}
@@ -151,7 +165,7 @@ func (c *BlockchainClient) GetTokenBalance(asset string, walletAddress string) (
 		return decimal.Zero, errors.Wrap(err, "failed to instantiate token contract")
 	}
 
-	balance, err := tokenContract.BalanceOf(&bind.CallOpts{}, walletAddr)
+	balance, err := tokenContract.BalanceOf(&bind.CallOpts{Context: ctx}, walletAddr)
 	if err != nil {
 		return decimal.Zero, errors.Wrapf(err, "failed to get balance for wallet %s on token %s", walletAddress, tokenAddrHex)
 	}
@@ -165,9 +179,9 @@ func (c *BlockchainClient) GetTokenBalance(asset string, walletAddress string) (
 
 // ========= Getters - ChannelsHub =========
 
-func (c *BlockchainClient) GetNodeBalance(token string) (decimal.Decimal, error) {
+func (c *BlockchainClient) GetNodeBalance(ctx context.Context, token string) (decimal.Decimal, error) {
 	tokenAddr := common.HexToAddress(token)
-	balance, err := c.contract.GetAccountBalance(nil, c.nodeAddress, tokenAddr)
+	balance, err := c.contract.GetAccountBalance(&bind.CallOpts{Context: ctx}, c.nodeAddress, tokenAddr)
 	if err != nil {
 		return decimal.Zero, errors.Wrapf(err, "failed to get node balance for token %s", token)
 	}
@@ -178,9 +192,9 @@ func (c *BlockchainClient) GetNodeBalance(token string) (decimal.Decimal, error)
 	return decimal.NewFromBigInt(balance, -int32(decimals)), nil
 }
 
-func (c *BlockchainClient) GetOpenChannels(user string) ([]string, error) {
+func (c *BlockchainClient) GetOpenChannels(ctx context.Context, user string) ([]string, error) {
 	userAddr := common.HexToAddress(user)
-	channelIDs, err := c.contract.GetOpenChannels(nil, userAddr)
+	channelIDs, err := c.contract.GetOpenChannels(&bind.CallOpts{Context: ctx}, userAddr)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get open channels for user %s", user)
 	}
@@ -192,13 +206,13 @@ func (c *BlockchainClient) GetOpenChannels(user string) ([]string, error) {
 	return result, nil
 }
 
-func (c *BlockchainClient) GetHomeChannelData(homeChannelID string) (core.HomeChannelDataResponse, error) {
+func (c *BlockchainClient) GetHomeChannelData(ctx context.Context, homeChannelID string) (core.HomeChannelDataResponse, error) {
 	channelIDBytes, err := hexToBytes32(homeChannelID)
 	if err != nil {
 		return core.HomeChannelDataResponse{}, errors.Wrap(err, "invalid channel ID")
 	}
 
-	data, err := c.contract.GetChannelData(nil, channelIDBytes)
+	data, err := c.contract.GetChannelData(&bind.CallOpts{Context: ctx}, channelIDBytes)
 	if err != nil {
 		return core.HomeChannelDataResponse{}, errors.Wrapf(err, "failed to get channel data for channel %s", homeChannelID)
 	}
@@ -219,13 +233,13 @@ func (c *BlockchainClient) GetHomeChannelData(homeChannelID string) (core.HomeCh
 	}, nil
 }
 
-func (c *BlockchainClient) GetEscrowDepositData(escrowChannelID string) (core.EscrowDepositDataResponse, error) {
+func (c *BlockchainClient) GetEscrowDepositData(ctx context.Context, escrowChannelID string) (core.EscrowDepositDataResponse, error) {
 	escrowIDBytes, err := hexToBytes32(escrowChannelID)
 	if err != nil {
 		return core.EscrowDepositDataResponse{}, errors.Wrap(err, "invalid escrow ID")
 	}
 
-	data, err := c.contract.GetEscrowDepositData(nil, escrowIDBytes)
+	data, err := c.contract.GetEscrowDepositData(&bind.CallOpts{Context: ctx}, escrowIDBytes)
 	if err != nil {
 		return core.EscrowDepositDataResponse{}, errors.Wrapf(err, "failed to get escrow deposit data for escrow %s", escrowChannelID)
 	}
@@ -244,13 +258,13 @@ func (c *BlockchainClient) GetEscrowDepositData(escrowChannelID string) (core.Es
 	}, nil
 }
 
-func (c *BlockchainClient) GetEscrowWithdrawalData(escrowChannelID string) (core.EscrowWithdrawalDataResponse, error) {
+func (c *BlockchainClient) GetEscrowWithdrawalData(ctx context.Context, escrowChannelID string) (core.EscrowWithdrawalDataResponse, error) {
 	escrowIDBytes, err := hexToBytes32(escrowChannelID)
 	if err != nil {
 		return core.EscrowWithdrawalDataResponse{}, errors.Wrap(err, "invalid escrow ID")
 	}
 
-	data, err := c.contract.GetEscrowWithdrawalData(nil, escrowIDBytes)
+	data, err := c.contract.GetEscrowWithdrawalData(&bind.CallOpts{Context: ctx}, escrowIDBytes)
 	if err != nil {
 		return core.EscrowWithdrawalDataResponse{}, errors.Wrapf(err, "failed to get escrow withdrawal data for escrow %s", escrowChannelID)
 	}
@@ -269,7 +283,7 @@ func (c *BlockchainClient) GetEscrowWithdrawalData(escrowChannelID string) (core
 
 // ========= IVault Functions =========
 
-func (c *BlockchainClient) Deposit(node, token string, amount decimal.Decimal) (string, error) {
+func (c *BlockchainClient) Deposit(ctx context.Context, node, token string, amount decimal.Decimal) (string, error) {
 	nodeAddr := common.HexToAddress(node)
 	tokenAddr := common.HexToAddress(token)
 
@@ -282,16 +296,18 @@ func (c *BlockchainClient) Deposit(node, token string, amount decimal.Decimal) (
 		return "", errors.Wrapf(err, "failed to convert amount %s to big.Int", amount.String())
 	}
 
+	var value *big.Int
 	if tokenAddr == (common.Address{}) {
-		c.transactOpts.Value = amountBig
-		defer func() { c.transactOpts.Value = nil }()
+		value = amountBig
 	}
 
-	if err := c.checkFeeFn(context.Background(), c.transactOpts.From); err != nil {
+	if err := c.checkFeeFn(ctx, c.transactOpts.From); err != nil {
 		return "", err
 	}
 
-	tx, err := c.contract.DepositToVault(c.transactOpts, nodeAddr, tokenAddr, amountBig)
+	tx, err := c.transact(ctx, value, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.DepositToVault(opts, nodeAddr, tokenAddr, amountBig)
+	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to deposit to vault")
 	}
@@ -299,7 +315,7 @@ func (c *BlockchainClient) Deposit(node, token string, amount decimal.Decimal) (
 	return tx.Hash().Hex(), nil
 }
 
-func (c *BlockchainClient) Withdraw(node, token string, amount decimal.Decimal) (string, error) {
+func (c *BlockchainClient) Withdraw(ctx context.Context, node, token string, amount decimal.Decimal) (string, error) {
 	nodeAddr := common.HexToAddress(node)
 	tokenAddr := common.HexToAddress(token)
 
@@ -312,11 +328,13 @@ func (c *BlockchainClient) Withdraw(node, token string, amount decimal.Decimal)
 		return "", errors.Wrapf(err, "failed to convert amount %s to big.Int", amount.String())
 	}
 
-	if err := c.checkFeeFn(context.Background(), c.transactOpts.From); err != nil {
+	if err := c.checkFeeFn(ctx, c.transactOpts.From); err != nil {
 		return "", err
 	}
 
-	tx, err := c.contract.WithdrawFromVault(c.transactOpts, nodeAddr, tokenAddr, amountBig)
+	tx, err := c.transact(ctx, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.WithdrawFromVault(opts, nodeAddr, tokenAddr, amountBig)
+	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to withdraw from vault")
 	}
@@ -326,7 +344,7 @@ func (c *BlockchainClient) Withdraw(node, token string, amount decimal.Decimal)
 
 // ========= Getters - ERC20 =========
 
-func (c *BlockchainClient) Approve(asset string, amount decimal.Decimal) (string, error) {
+func (c *BlockchainClient) Approve(ctx context.Context, asset string, amount decimal.Decimal) (string, error) {
 	tokenAddrHex, err := c.assetStore.GetTokenAddress(asset, c.blockchainID)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to get token address")
@@ -352,11 +370,13 @@ func (c *BlockchainClient) Approve(asset string, amount decimal.Decimal) (string
 		return "", errors.Wrap(err, "failed to instantiate token contract")
 	}
 
-	if err := c.checkFeeFn(context.Background(), c.transactOpts.From); err != nil {
+	if err := c.checkFeeFn(ctx, c.transactOpts.From); err != nil {
 		return "", err
 	}
 
-	tx, err := erc20Contract.Approve(c.transactOpts, c.channelHubContractAddress, amountBig)
+	tx, err := c.transact(ctx, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return erc20Contract.Approve(opts, c.channelHubContractAddress, amountBig)
+	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to approve token spending")
 	}
@@ -366,7 +386,7 @@ func (c *BlockchainClient) Approve(asset string, amount decimal.Decimal) (string
 
 // ========= Channel Lifecycle =========
 
-func (c *BlockchainClient) Create(def core.ChannelDefinition, initCCS core.State) (string, error) {
+func (c *BlockchainClient) Create(ctx context.Context, def core.ChannelDefinition, initCCS core.State) (string, error) {
 	contractDef, err := coreDefToContractDef(def, initCCS.Asset, initCCS.UserWallet, c.nodeAddress)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to convert channel definition")
@@ -377,12 +397,13 @@ func (c *BlockchainClient) Create(def core.ChannelDefinition, initCCS core.State
 		return "", errors.Wrap(err, "failed to convert state")
 	}
 
+	var value *big.Int
 	switch contractState.Intent {
 	case core.INTENT_OPERATE:
 	case core.INTENT_WITHDRAW:
 	case core.INTENT_DEPOSIT:
 		if c.requireCheckAllowance {
-			allowance, err := c.getAllowance(initCCS.Asset, initCCS.UserWallet)
+			allowance, err := c.getAllowance(ctx, initCCS.Asset, initCCS.UserWallet)
 			if err != nil {
 				return "", errors.Wrap(err, "failed to get allowance")
 			}
@@ -392,7 +413,7 @@ func (c *BlockchainClient) Create(def core.ChannelDefinition, initCCS core.State
 
 		}
 		if c.requireCheckBalance {
-			tokenBalance, err := c.GetTokenBalance(initCCS.Asset, initCCS.UserWallet)
+			tokenBalance, err := c.GetTokenBalance(ctx, initCCS.Asset, initCCS.UserWallet)
 			if err != nil {
 				return "", errors.Wrap(err, "failed to check token balance")
 			}
@@ -402,23 +423,23 @@ func (c *BlockchainClient) Create(def core.ChannelDefinition, initCCS core.State
 		}
 
 		if contractState.HomeLedger.Token == (common.Address{}) {
-			value, err := core.DecimalToBigInt(initCCS.Transition.Amount, contractState.HomeLedger.Decimals)
+			value, err = core.DecimalToBigInt(initCCS.Transition.Amount, contractState.HomeLedger.Decimals)
 			if err != nil {
 				return "", errors.Wrap(err, "failed to convert native deposit amount to wei")
 			}
-			c.transactOpts.Value = value
-			defer func() { c.transactOpts.Value = nil }()
 		}
 
 	default:
 		return "", errors.New("unsupported intent for create: " + string(contractState.Intent))
 	}
 
-	if err := c.checkFeeFn(context.Background(), c.transactOpts.From); err != nil {
+	if err := c.checkFeeFn(ctx, c.transactOpts.From); err != nil {
 		return "", err
 	}
 
-	tx, err := c.contract.CreateChannel(c.transactOpts, contractDef, contractState)
+	tx, err := c.transact(ctx, value, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.CreateChannel(opts, contractDef, contractState)
+	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to create channel")
 	}
@@ -426,7 +447,7 @@ func (c *BlockchainClient) Create(def core.ChannelDefinition, initCCS core.State
 	return tx.Hash().Hex(), nil
 }
 
-func (c *BlockchainClient) MigrateChannelHere(def core.ChannelDefinition, candidate core.State) (string, error) {
+func (c *BlockchainClient) MigrateChannelHere(ctx context.Context, def core.ChannelDefinition, candidate core.State) (string, error) {
 	contractDef, err := coreDefToContractDef(def, candidate.Asset, candidate.UserWallet, c.nodeAddress)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to convert channel definition")
@@ -437,11 +458,13 @@ func (c *BlockchainClient) MigrateChannelHere(def core.ChannelDefinition, candid
 		return "", errors.Wrap(err, "failed to convert candidate state")
 	}
 
-	if err := c.checkFeeFn(context.Background(), c.transactOpts.From); err != nil {
+	if err := c.checkFeeFn(ctx, c.transactOpts.From); err != nil {
 		return "", err
 	}
 
-	tx, err := c.contract.InitiateMigration(c.transactOpts, contractDef, contractCandidate)
+	tx, err := c.transact(ctx, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.InitiateMigration(opts, contractDef, contractCandidate)
+	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to initiate migration")
 	}
@@ -449,10 +472,13 @@ func (c *BlockchainClient) MigrateChannelHere(def core.ChannelDefinition, candid
 	return tx.Hash().Hex(), nil
 }
 
-func (c *BlockchainClient) Checkpoint(candidate core.State) (string, error) {
+func (c *BlockchainClient) FinalizeMigration(ctx context.Context, candidate core.State) (string, error) {
 	if candidate.HomeChannelID == nil {
 		return "", errors.New("candidate state must have a home channel ID")
 	}
+	if candidate.EscrowLedger == nil {
+		return "", errors.New("candidate state must have an escrow ledger")
+	}
 
 	channelIDBytes, err := hexToBytes32(*candidate.HomeChannelID)
 	if err != nil {
@@ -464,7 +490,40 @@ func (c *BlockchainClient) Checkpoint(candidate core.State) (string, error) {
 		return "", errors.Wrap(err, "failed to convert candidate state")
 	}
 
-	if err := c.checkFeeFn(context.Background(), c.transactOpts.From); err != nil {
+	if contractCandidate.Intent != core.INTENT_FINALIZE_MIGRATION {
+		return "", errors.New("unsupported intent for finalize migration: " + string(contractCandidate.Intent))
+	}
+
+	if err := c.checkFeeFn(ctx, c.transactOpts.From); err != nil {
+		return "", err
+	}
+
+	tx, err := c.transact(ctx, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.FinalizeMigration(opts, channelIDBytes, contractCandidate)
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to finalize migration")
+	}
+
+	return tx.Hash().Hex(), nil
+}
+
+func (c *BlockchainClient) Checkpoint(ctx context.Context, candidate core.State) (string, error) {
+	if candidate.HomeChannelID == nil {
+		return "", errors.New("candidate state must have a home channel ID")
+	}
+
+	channelIDBytes, err := hexToBytes32(*candidate.HomeChannelID)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid channel ID")
+	}
+
+	contractCandidate, err := coreStateToContractState(candidate, c.assetStore.GetTokenDecimals)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to convert candidate state")
+	}
+
+	if err := c.checkFeeFn(ctx, c.transactOpts.From); err != nil {
 		return "", err
 	}
 
@@ -472,10 +531,12 @@ func (c *BlockchainClient) Checkpoint(candidate core.State) (string, error) {
 	switch contractCandidate.Intent {
 	case core.INTENT_OPERATE:
 		// TODO: recheck proofs logic
-		tx, err = c.contract.CheckpointChannel(c.transactOpts, channelIDBytes, contractCandidate)
+		tx, err = c.transact(ctx, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return c.contract.CheckpointChannel(opts, channelIDBytes, contractCandidate)
+		})
 	case core.INTENT_DEPOSIT:
 		if c.requireCheckAllowance {
-			allowance, err := c.getAllowance(candidate.Asset, candidate.UserWallet)
+			allowance, err := c.getAllowance(ctx, candidate.Asset, candidate.UserWallet)
 			if err != nil {
 				return "", errors.Wrap(err, "failed to get allowance")
 			}
@@ -485,7 +546,7 @@ func (c *BlockchainClient) Checkpoint(candidate core.State) (string, error) {
 
 		}
 		if c.requireCheckBalance {
-			tokenBalance, err := c.GetTokenBalance(candidate.Asset, candidate.UserWallet)
+			tokenBalance, err := c.GetTokenBalance(ctx, candidate.Asset, candidate.UserWallet)
 			if err != nil {
 				return "", errors.Wrap(err, "failed to check token balance")
 			}
@@ -494,18 +555,22 @@ func (c *BlockchainClient) Checkpoint(candidate core.State) (string, error) {
 			}
 		}
 
+		var value *big.Int
 		if contractCandidate.HomeLedger.Token == (common.Address{}) {
-			value, valueErr := core.DecimalToBigInt(candidate.Transition.Amount, contractCandidate.HomeLedger.Decimals)
+			var valueErr error
+			value, valueErr = core.DecimalToBigInt(candidate.Transition.Amount, contractCandidate.HomeLedger.Decimals)
 			if valueErr != nil {
 				return "", errors.Wrap(valueErr, "failed to convert native deposit amount to wei")
 			}
-			c.transactOpts.Value = value
-			defer func() { c.transactOpts.Value = nil }()
 		}
 
-		tx, err = c.contract.DepositToChannel(c.transactOpts, channelIDBytes, contractCandidate)
+		tx, err = c.transact(ctx, value, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return c.contract.DepositToChannel(opts, channelIDBytes, contractCandidate)
+		})
 	case core.INTENT_WITHDRAW:
-		tx, err = c.contract.WithdrawFromChannel(c.transactOpts, channelIDBytes, contractCandidate)
+		tx, err = c.transact(ctx, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return c.contract.WithdrawFromChannel(opts, channelIDBytes, contractCandidate)
+		})
 	default:
 		return "", errors.New("unsupported intent for checkpointing: " + string(contractCandidate.Intent))
 	}
@@ -516,7 +581,7 @@ func (c *BlockchainClient) Checkpoint(candidate core.State) (string, error) {
 	return tx.Hash().Hex(), nil
 }
 
-func (c *BlockchainClient) Challenge(candidate core.State, challengerSig []byte, challengerIdx core.ChannelParticipant) (string, error) {
+func (c *BlockchainClient) Challenge(ctx context.Context, candidate core.State, challengerSig []byte, challengerIdx core.ChannelParticipant) (string, error) {
 	if candidate.HomeChannelID == nil {
 		return "", errors.New("candidate state must have a home channel ID")
 	}
@@ -531,12 +596,14 @@ func (c *BlockchainClient) Challenge(candidate core.State, challengerSig []byte,
 		return "", errors.Wrap(err, "failed to convert candidate state")
 	}
 
-	if err := c.checkFeeFn(context.Background(), c.transactOpts.From); err != nil {
+	if err := c.checkFeeFn(ctx, c.transactOpts.From); err != nil {
 		return "", err
 	}
 
 	// TODO: recheck proofs logic
-	tx, err := c.contract.ChallengeChannel(c.transactOpts, channelIDBytes, contractCandidate, challengerSig, uint8(challengerIdx))
+	tx, err := c.transact(ctx, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.ChallengeChannel(opts, channelIDBytes, contractCandidate, challengerSig, uint8(challengerIdx))
+	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to challenge channel")
 	}
@@ -544,7 +611,7 @@ func (c *BlockchainClient) Challenge(candidate core.State, challengerSig []byte,
 	return tx.Hash().Hex(), nil
 }
 
-func (c *BlockchainClient) Close(candidate core.State) (string, error) {
+func (c *BlockchainClient) Close(ctx context.Context, candidate core.State) (string, error) {
 	if candidate.HomeChannelID == nil {
 		return "", errors.New("candidate state must have a home channel ID")
 	}
@@ -560,15 +627,25 @@ func (c *BlockchainClient) Close(candidate core.State) (string, error) {
 	}
 
 	if contractCandidate.Intent != core.INTENT_CLOSE {
-		return "", errors.New("unsupported intent for close: " + string(contractCandidate.Intent))
+		// Once the challenge period of a disputed channel is over, the contract closes it
+		// with the challenged state regardless of the candidate
+		expired, err := c.isChallengeExpired(ctx, channelIDBytes)
+		if err != nil {
+			return "", err
+		}
+		if !expired {
+			return "", errors.New("unsupported intent for close: " + string(contractCandidate.Intent))
+		}
 	}
 
-	if err := c.checkFeeFn(context.Background(), c.transactOpts.From); err != nil {
+	if err := c.checkFeeFn(ctx, c.transactOpts.From); err != nil {
 		return "", err
 	}
 
 	// TODO: recheck proof logic
-	tx, err := c.contract.CloseChannel(c.transactOpts, channelIDBytes, contractCandidate)
+	tx, err := c.transact(ctx, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.CloseChannel(opts, channelIDBytes, contractCandidate)
+	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to close channel")
 	}
@@ -576,9 +653,26 @@ func (c *BlockchainClient) Close(candidate core.State) (string, error) {
 	return tx.Hash().Hex(), nil
 }
 
+// isChallengeExpired reports whether the channel is disputed and its challenge period is over.
+func (c *BlockchainClient) isChallengeExpired(ctx context.Context, channelID [32]byte) (bool, error) {
+	data, err := c.contract.GetChannelData(&bind.CallOpts{Context: ctx}, channelID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get channel data")
+	}
+	if data.ChallengeExpiry == nil || data.ChallengeExpiry.Sign() == 0 {
+		return false, nil
+	}
+
+	header, err := c.evmClient.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get latest block")
+	}
+	return data.ChallengeExpiry.Uint64() < header.Time, nil
+}
+
 // ========= Escrow Deposit =========
 
-func (c *BlockchainClient) InitiateEscrowDeposit(def core.ChannelDefinition, initCCS core.State) (string, error) {
+func (c *BlockchainClient) InitiateEscrowDeposit(ctx context.Context, def core.ChannelDefinition, initCCS core.State) (string, error) {
 	contractDef, err := coreDefToContractDef(def, initCCS.Asset, initCCS.UserWallet, c.nodeAddress)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to convert channel definition")
@@ -593,11 +687,23 @@ func (c *BlockchainClient) InitiateEscrowDeposit(def core.ChannelDefinition, ini
 		return "", errors.New("unsupported intent for initiate escrow deposit: " + string(contractState.Intent))
 	}
 
-	if err := c.checkFeeFn(context.Background(), c.transactOpts.From); err != nil {
+	// On the escrow chain the user's funds are pulled into the escrow, so native tokens are sent as value
+	var value *big.Int
+	if initCCS.EscrowLedger != nil && initCCS.EscrowLedger.BlockchainID == c.blockchainID &&
+		contractState.NonHomeLedger.Token == (common.Address{}) {
+		value, err = core.DecimalToBigInt(initCCS.Transition.Amount, contractState.NonHomeLedger.Decimals)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to convert native deposit amount to wei")
+		}
+	}
+
+	if err := c.checkFeeFn(ctx, c.transactOpts.From); err != nil {
 		return "", err
 	}
 
-	tx, err := c.contract.InitiateEscrowDeposit(c.transactOpts, contractDef, contractState)
+	tx, err := c.transact(ctx, value, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.InitiateEscrowDeposit(opts, contractDef, contractState)
+	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to initiate escrow deposit")
 	}
@@ -605,7 +711,7 @@ func (c *BlockchainClient) InitiateEscrowDeposit(def core.ChannelDefinition, ini
 	return tx.Hash().Hex(), nil
 }
 
-func (c *BlockchainClient) ChallengeEscrowDeposit(candidate core.State, challengerSig []byte, challengerIdx core.ChannelParticipant) (string, error) {
+func (c *BlockchainClient) ChallengeEscrowDeposit(ctx context.Context, candidate core.State, challengerSig []byte, challengerIdx core.ChannelParticipant) (string, error) {
 	if candidate.EscrowChannelID == nil {
 		return "", errors.New("candidate state must have an escrow channel ID")
 	}
@@ -615,11 +721,13 @@ func (c *BlockchainClient) ChallengeEscrowDeposit(candidate core.State, challeng
 		return "", errors.Wrap(err, "invalid escrow ID")
 	}
 
-	if err := c.checkFeeFn(context.Background(), c.transactOpts.From); err != nil {
+	if err := c.checkFeeFn(ctx, c.transactOpts.From); err != nil {
 		return "", err
 	}
 
-	tx, err := c.contract.ChallengeEscrowDeposit(c.transactOpts, escrowIDBytes, challengerSig, uint8(challengerIdx))
+	tx, err := c.transact(ctx, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.ChallengeEscrowDeposit(opts, escrowIDBytes, challengerSig, uint8(challengerIdx))
+	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to challenge escrow deposit")
 	}
@@ -627,11 +735,19 @@ func (c *BlockchainClient) ChallengeEscrowDeposit(candidate core.State, challeng
 	return tx.Hash().Hex(), nil
 }
 
-func (c *BlockchainClient) FinalizeEscrowDeposit(candidate core.State) (string, error) {
+func (c *BlockchainClient) FinalizeEscrowDeposit(ctx context.Context, candidate core.State) (string, error) {
+	if candidate.HomeChannelID == nil {
+		return "", errors.New("candidate state must have a home channel ID")
+	}
 	if candidate.EscrowChannelID == nil {
 		return "", errors.New("candidate state must have an escrow channel ID")
 	}
 
+	channelIDBytes, err := hexToBytes32(*candidate.HomeChannelID)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid channel ID")
+	}
+
 	escrowIDBytes, err := hexToBytes32(*candidate.EscrowChannelID)
 	if err != nil {
 		return "", errors.Wrap(err, "invalid escrow ID")
@@ -646,11 +762,13 @@ func (c *BlockchainClient) FinalizeEscrowDeposit(candidate core.State) (string,
 		return "", errors.New("unsupported intent for finalize escrow deposit: " + string(contractCandidate.Intent))
 	}
 
-	if err := c.checkFeeFn(context.Background(), c.transactOpts.From); err != nil {
+	if err := c.checkFeeFn(ctx, c.transactOpts.From); err != nil {
 		return "", err
 	}
 
-	tx, err := c.contract.FinalizeEscrowDeposit(c.transactOpts, escrowIDBytes, contractCandidate)
+	tx, err := c.transact(ctx, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.FinalizeEscrowDeposit(opts, channelIDBytes, escrowIDBytes, contractCandidate)
+	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to finalize escrow deposit")
 	}
@@ -660,7 +778,7 @@ func (c *BlockchainClient) FinalizeEscrowDeposit(candidate core.State) (string,
 
 // ========= Escrow Withdrawal =========
 
-func (c *BlockchainClient) InitiateEscrowWithdrawal(def core.ChannelDefinition, initCCS core.State) (string, error) {
+func (c *BlockchainClient) InitiateEscrowWithdrawal(ctx context.Context, def core.ChannelDefinition, initCCS core.State) (string, error) {
 	contractDef, err := coreDefToContractDef(def, initCCS.Asset, initCCS.UserWallet, c.nodeAddress)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to convert channel definition")
@@ -675,11 +793,13 @@ func (c *BlockchainClient) InitiateEscrowWithdrawal(def core.ChannelDefinition,
 		return "", errors.New("unsupported intent for initiate escrow withdrawal: " + string(contractState.Intent))
 	}
 
-	if err := c.checkFeeFn(context.Background(), c.transactOpts.From); err != nil {
+	if err := c.checkFeeFn(ctx, c.transactOpts.From); err != nil {
 		return "", err
 	}
 
-	tx, err := c.contract.InitiateEscrowWithdrawal(c.transactOpts, contractDef, contractState)
+	tx, err := c.transact(ctx, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.InitiateEscrowWithdrawal(opts, contractDef, contractState)
+	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to initiate escrow withdrawal")
 	}
@@ -687,7 +807,7 @@ func (c *BlockchainClient) InitiateEscrowWithdrawal(def core.ChannelDefinition,
 	return tx.Hash().Hex(), nil
 }
 
-func (c *BlockchainClient) ChallengeEscrowWithdrawal(candidate core.State, challengerSig []byte, challengerIdx core.ChannelParticipant) (string, error) {
+func (c *BlockchainClient) ChallengeEscrowWithdrawal(ctx context.Context, candidate core.State, challengerSig []byte, challengerIdx core.ChannelParticipant) (string, error) {
 	if candidate.EscrowChannelID == nil {
 		return "", errors.New("candidate state must have an escrow channel ID")
 	}
@@ -697,11 +817,13 @@ func (c *BlockchainClient) ChallengeEscrowWithdrawal(candidate core.State, chall
 		return "", errors.Wrap(err, "invalid escrow ID")
 	}
 
-	if err := c.checkFeeFn(context.Background(), c.transactOpts.From); err != nil {
+	if err := c.checkFeeFn(ctx, c.transactOpts.From); err != nil {
 		return "", err
 	}
 
-	tx, err := c.contract.ChallengeEscrowWithdrawal(c.transactOpts, escrowIDBytes, challengerSig, uint8(challengerIdx))
+	tx, err := c.transact(ctx, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.ChallengeEscrowWithdrawal(opts, escrowIDBytes, challengerSig, uint8(challengerIdx))
+	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to challenge escrow withdrawal")
 	}
@@ -709,12 +831,20 @@ func (c *BlockchainClient) ChallengeEscrowWithdrawal(candidate core.State, chall
 	return tx.Hash().Hex(), nil
 }
 
-func (c *BlockchainClient) FinalizeEscrowWithdrawal(candidate core.State) (string, error) {
+func (c *BlockchainClient) FinalizeEscrowWithdrawal(ctx context.Context, candidate core.State) (string, error) {
+	if candidate.HomeChannelID == nil {
+		return "", errors.New("candidate state must have a home channel ID")
+	}
 	if candidate.EscrowChannelID == nil {
 		return "", errors.New("candidate state must have an escrow channel ID")
 	}
 	if candidate.EscrowLedger == nil {
-		return "", errors.New("candidate state must have an escrow channel ID")
+		return "", errors.New("candidate state must have an escrow ledger")
+	}
+
+	channelIDBytes, err := hexToBytes32(*candidate.HomeChannelID)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid channel ID")
 	}
 
 	escrowIDBytes, err := hexToBytes32(*candidate.EscrowChannelID)
@@ -727,15 +857,17 @@ func (c *BlockchainClient) FinalizeEscrowWithdrawal(candidate core.State) (strin
 		return "", errors.Wrap(err, "failed to convert candidate state")
 	}
 
-	if contractCandidate.Intent != core.INTENT_INITIATE_ESCROW_WITHDRAWAL {
-		return "", errors.New("unsupported intent for initiate escrow withdrawal: " + string(contractCandidate.Intent))
+	if contractCandidate.Intent != core.INTENT_FINALIZE_ESCROW_WITHDRAWAL {
+		return "", errors.New("unsupported intent for finalize escrow withdrawal: " + string(contractCandidate.Intent))
 	}
 
-	if err := c.checkFeeFn(context.Background(), c.transactOpts.From); err != nil {
+	if err := c.checkFeeFn(ctx, c.transactOpts.From); err != nil {
 		return "", err
 	}
 
-	tx, err := c.contract.FinalizeEscrowWithdrawal(c.transactOpts, escrowIDBytes, contractCandidate)
+	tx, err := c.transact(ctx, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.FinalizeEscrowWithdrawal(opts, channelIDBytes, escrowIDBytes, contractCandidate)
+	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to finalize escrow withdrawal")
 	}
@@ -743,10 +875,10 @@ func (c *BlockchainClient) FinalizeEscrowWithdrawal(candidate core.State) (strin
 	return tx.Hash().Hex(), nil
 }
 
-func (c *BlockchainClient) EnsureSigValidatorRegistered(validatorID uint8, validatorAddress string, checkOnly bool) error {
+func (c *BlockchainClient) EnsureSigValidatorRegistered(ctx context.Context, validatorID uint8, validatorAddress string, checkOnly bool) error {
 	validatorAddr := common.HexToAddress(validatorAddress)
 
-	_validatorAddr, err := c.contract.GetNodeValidator(nil, c.nodeAddress, validatorID)
+	_validatorAddr, err := c.contract.GetNodeValidator(&bind.CallOpts{Context: ctx}, c.nodeAddress, validatorID)
 	if err != nil {
 		return errors.Wrapf(err, "failed to check if validator %d is registered", validatorID)
 	}
@@ -760,7 +892,7 @@ func (c *BlockchainClient) EnsureSigValidatorRegistered(validatorID uint8, valid
 		return errors.Errorf("validator ID %d with address %s is not registered; run 'clearnode operator register-validator' to register", validatorID, validatorAddress)
 	}
 
-	if err := c.checkFeeFn(context.Background(), c.transactOpts.From); err != nil {
+	if err := c.checkFeeFn(ctx, c.transactOpts.From); err != nil {
 		return err
 	}
 
@@ -782,7 +914,9 @@ func (c *BlockchainClient) EnsureSigValidatorRegistered(validatorID uint8, valid
 		return errors.Wrap(err, "failed to sign validator registration message")
 	}
 
-	_, err = c.contract.RegisterNodeValidator(c.transactOpts, c.nodeAddress, validatorID, validatorAddr, sig)
+	_, err = c.transact(ctx, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contract.RegisterNodeValidator(opts, c.nodeAddress, validatorID, validatorAddr, sig)
+	})
 	if err != nil {
 		return errors.Wrapf(err, "failed to register validator %d with address %s", validatorID, validatorAddress)
 	}
@@ -1,14 +1,21 @@
 package evm
 
 import (
+	"context"
 	"math/big"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
 	"github.com/layer-3/nitrolite/pkg/sign"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -94,7 +101,7 @@ func TestBlockchainClient_GetAccountsBalances(t *testing.T) {
 	ret := common.LeftPadBytes(big.NewInt(100).Bytes(), 32)
 	mockEVMClient.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(ret, nil)
 
-	balances, err := client.GetAccountsBalances(accounts, tokens)
+	balances, err := client.GetAccountsBalances(context.Background(), accounts, tokens)
 	require.NoError(t, err)
 	assert.Len(t, balances, 2)
 	assert.Len(t, balances[0], 1)
@@ -122,7 +129,7 @@ func TestBlockchainClient_GetNodeBalance(t *testing.T) {
 	ret := common.LeftPadBytes(big.NewInt(1000000000000000000).Bytes(), 32) // 1 ETH
 	mockEVMClient.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(ret, nil)
 
-	balance, err := client.GetNodeBalance(token)
+	balance, err := client.GetNodeBalance(context.Background(), token)
 	require.NoError(t, err)
 	assert.Equal(t, "1", balance.String())
 
@@ -156,7 +163,7 @@ func TestBlockchainClient_GetOpenChannels(t *testing.T) {
 
 	mockEVMClient.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(ret, nil)
 
-	channels, err := client.GetOpenChannels("0xUser")
+	channels, err := client.GetOpenChannels(context.Background(), "0xUser")
 	require.NoError(t, err)
 	assert.Len(t, channels, 1)
 	assert.Equal(t, strings.ToLower(hexutil.Encode(chanID[:])), strings.ToLower(channels[0]))
@@ -164,6 +171,95 @@ func TestBlockchainClient_GetOpenChannels(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, mockEVMClient, mockAssetStore, mockSigner)
 }
 
+func TestBlockchainClient_CallContext(t *testing.T) {
+	t.Parallel()
+	mockEVMClient := new(MockEVMClient)
+	mockSigner := new(MockSigner)
+
+	setupMockSigner(t, mockSigner)
+
+	client, err := NewBlockchainClient(common.Address{}, mockEVMClient, mockSigner, 1, "0xNode", new(MockAssetStore))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mockEVMClient.On("CallContract", ctx, mock.Anything, mock.Anything).Return(nil, ctx.Err())
+
+	_, err = client.GetOpenChannels(ctx, "0xUser")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// sinkCode deploys a contract whose runtime code is a single STOP, so every call to it succeeds.
+var sinkCode = common.FromHex("0x60016000f3")
+
+func TestBlockchainClient_ConcurrentNativeDeposits_Simulated(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	nodeAddress := crypto.PubkeyToAddress(key.PublicKey)
+
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		nodeAddress: {Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(1e18))},
+	})
+	t.Cleanup(func() { backend.Close() })
+
+	chainID, err := backend.Client().ChainID(context.Background())
+	require.NoError(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	require.NoError(t, err)
+	channelHub, _, _, err := bind.DeployContract(opts, abi.ABI{}, sinkCode, backend.Client())
+	require.NoError(t, err)
+	backend.Commit()
+
+	txSigner, err := sign.NewEthereumRawSigner(hexutil.Encode(crypto.FromECDSA(key)))
+	require.NoError(t, err)
+	assetStore := new(MockAssetStore)
+	assetStore.On("GetTokenDecimals", chainID.Uint64(), mock.Anything).Return(uint8(18), nil)
+
+	txManager := NewTxManager(backend.Client(), txSigner, chainID.Uint64(), DefaultTxManagerConfig())
+	client, err := NewBlockchainClient(channelHub, backend.Client(), txSigner, chainID.Uint64(),
+		nodeAddress.Hex(), assetStore, ClientTxManager{TxManager: txManager})
+	require.NoError(t, err)
+
+	// Native deposits carry their amount, withdrawals sent at the same time carry nothing
+	const n = 8
+	nativeToken := common.Address{}.Hex()
+	expectedValues := make(map[string]*big.Int, 2*n)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i := range n {
+		amount := decimal.NewFromInt(int64(i + 1))
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			txHash, err := client.Deposit(context.Background(), nodeAddress.Hex(), nativeToken, amount)
+			assert.NoError(t, err)
+			mu.Lock()
+			expectedValues[txHash] = amount.Shift(18).BigInt()
+			mu.Unlock()
+		}()
+		go func() {
+			defer wg.Done()
+			txHash, err := client.Withdraw(context.Background(), nodeAddress.Hex(), nativeToken, amount)
+			assert.NoError(t, err)
+			mu.Lock()
+			expectedValues[txHash] = new(big.Int)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	backend.Commit()
+
+	require.Len(t, expectedValues, 2*n)
+	for txHash, value := range expectedValues {
+		tx, _, err := backend.Client().TransactionByHash(context.Background(), common.HexToHash(txHash))
+		require.NoError(t, err)
+		assert.Equal(t, value, tx.Value(), "tx %s", txHash)
+	}
+	assert.Nil(t, client.transactOpts.Value)
+}
+
 func setupMockSigner(t *testing.T, mockSigner *MockSigner) {
 	t.Helper()
 	privKey, err := crypto.GenerateKey()
@@ -26,6 +26,7 @@ var (
 	_ = common.Big1
 	_ = types.BloomLookup
 	_ = event.NewSubscription
+	_ = abi.ConvertType
 )
 
 // ChannelDefinition is an auto generated low-level Go binding around an user-defined struct.
@@ -60,10 +61,183 @@ type State struct {
 	NodeSig       []byte
 }
 
+// ChannelEngineMetaData contains all meta data concerning the ChannelEngine contract.
+var ChannelEngineMetaData = &bind.MetaData{
+	ABI: "[]",
+	Bin: "0x608080604052346019576116f0908161001e823930815050f35b5f80fdfe6080806040526004361015610012575f80fd5b5f3560e01c63a8b4483c14610025575f80fd5b604060031936011261122d5760043567ffffffffffffffff811161122d5760a0600319823603011261122d5760a0820182811067ffffffffffffffff821117611299576040528060040135600681101561122d578252602481013567ffffffffffffffff811161122d5761009f90600436918401016113e1565b602083019081526040830192604483013584526100c96084606083019460648101358652016112ec565b6080820190815260243567ffffffffffffffff811161122d576100f09036906004016113e1565b6100f8611498565b50606081019367ffffffffffffffff855151164603610dc45767ffffffffffffffff82511681519067ffffffffffffffff82511610908115611261575b5015610a085784516040810190601260ff83511611611239574667ffffffffffffffff825116146110ff575b505060208201928351600a8110156103585760041480156110eb575b80156110d7575b80156110c3575b80156110af575b801561109b575b1561105e576080830167ffffffffffffffff815151161561103657515167ffffffffffffffff16461461100e575b6101dc865160a06060820151910151906114e6565b6101f1875160c06080820151910151906114f3565b5f8112610fe65761020190611526565b03610fbe578451600681101561035857600214610f7f575b50610222611498565b5061023c608086510151608060608451015101519061150e565b9061025660c08751015160c060608451015101519061150e565b9351600a81101561035857600281036104b65750509050610275611498565b928051600681101561035857159081156104a0575b811561048a575b8115610475575b501561044d575f8113156104255782526020820152600160408201525f6060820152925b6102d96102d1608086019260018452516115a7565b8551906114f3565b926102ea60208601948551906114f3565b5f81126103fd5760a08601938451156103ab575b50508351905f821361036c575b50506040519284518452516020840152604084015193600685101561035857606067ffffffffffffffff9160c09660408701520151166060840152511515608083015251151560a0820152f35b634e487b7160e01b5f52602160045260245ffd5b610377905191611526565b11610383575f8061030b565b7f2e3b1ec0000000000000000000000000000000000000000000000000000000005f5260045ffd5b6103c36103c9915160a06060820151910151906114e6565b91611526565b036103d5575f806102fe565b7f8f9003ee000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fae0bb491000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f1180da8f000000000000000000000000000000000000000000000000000000005f5260045ffd5b7ff2056b18000000000000000000000000000000000000000000000000000000005f5260045ffd5b9050516006811015610358576004145f610298565b8091505160068110156103585760021490610291565b809150516006811015610358576001149061028a565b6003810361055657505090506104ca611498565b92805160068110156103585715908115610540575b811561052a575b8115610515575b501561044d575f8112156104255782526020820152600160408201525f6060820152926102bc565b9050516006811015610358576004145f6104ed565b80915051600681101561035857600214906104e6565b80915051600681101561035857600114906104df565b8061061f5750509050610567611498565b92805160068110156103585715908115610609575b81156105f3575b81156105de575b501561044d576104255760a0835101516105b6576020820152600160408201525f6060820152926102bc565b7fa5eabfa5000000000000000000000000000000000000000000000000000000005f5260045ffd5b9050516006811015610358576004145f61058a565b8091505160068110156103585760021490610583565b809150516006811015610358576001149061057c565b600181036107185750509050610633611498565b928051600681101561035857600114908115610702575b81156106ed575b501561044d5761066c845160a06060820151910151906114e6565b8651106106c55761068a82610685836106858a516115a7565b6114f3565b5f81126103fd5761069f60a0865101516115a7565b136103fd5782526020820152600360408201525f6060820152600160a0820152926102bc565b7f7fa0800f000000000000000000000000000000000000000000000000000000005f5260045ffd5b9050516006811015610358576004145f610651565b809150516006811015610358576002149061064a565b6004810361083857505061072a611498565b938051600681101561035857600114908115610822575b811561080d575b501561044d57610425576080016060815101519081156107e55761077a855160ff604060a0830151920151169061161e565b61078c60ff604084510151168461161e565b036105b65760806107a091510151916115a7565b036107bd576020820152600160408201525f6060820152926102bc565b7f4c66f955000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f2c0a0276000000000000000000000000000000000000000000000000000000005f5260045ffd5b9050516006811015610358576004145f610748565b8091505160068110156103585760021490610741565b909391929060058103610a83575061084e611498565b948051600681101561035857600114908115610a6d575b8115610a58575b501561044d5761087f60208551016114d9565b600a81101561035857600403610a305767ffffffffffffffff81511667ffffffffffffffff6108b1818751511661155b565b1603610a0857608001916060835101516107e55760a0835101516105b65760a0865101516105b657610425576109e05760606080835101510151906080815101516108fb836115a7565b036107bd575160c00151610916610911836115a7565b61157b565b036109b8576060845101519060608084510151015182039182116109a45760ff6040608061094e61095b9584848b510151169061161e565b955101510151169061161e565b0361097c575f81525f6020820152600160408201525f6060820152926102bc565b7f733d14c5000000000000000000000000000000000000000000000000000000005f5260045ffd5b634e487b7160e01b5f52601160045260245ffd5b7f0c18740d000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fd916ea0e000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f07646e49000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f7dcd8ffd000000000000000000000000000000000000000000000000000000005f5260045ffd5b9050516006811015610358576004145f61086c565b8091505160068110156103585760021490610865565b9193909160068103610b3f57505090610a9a611498565b938051600681101561035857600114908115610b29575b8115610b14575b501561044d576104255760a0845101516105b6576080016080815101516107bd576060815101516107e55760c0610af360a0835101516115a7565b91510151036109b8576020820152600160408201525f6060820152926102bc565b9050516006811015610358576004145f610ab8565b8091505160068110156103585760021490610ab1565b60078103610bd957505090610b52611498565b938051600681101561035857600114908115610bc3575b8115610bae575b501561044d576104255760a0845101516105b6576080016060815101516107e55760a0815101516105b657516107a060c0608083015192015161157b565b9050516006811015610358576004145f610b70565b8091505160068110156103585760021490610b69565b60088103610e1557505090610bec611498565b938051600681101561035857158015610e01575b15610ce5575050608001805160600151915081156107e55760a0815101516105b6576060845101516107e557610c44845160ff604060a0830151920151169061161e565b610c5660ff604084510151168461161e565b03610cbd57610c8d9060ff6040610c82610c7c8851848460c0830151920151169061165b565b956115a7565b92510151169061165b565b036109b8576080825101516107bd57610caa60a0835101516115a7565b6020820152600460408201525b926102bc565b7f7b208b9d000000000000000000000000000000000000000000000000000000005f5260045ffd5b8051600681101561035857600114908115610dec575b501561044d574667ffffffffffffffff8651511603610dc457610425576060845101519081156107e55760a0855101516105b657608001906060825101516107e557610d55825160ff604060a0830151920151169061161e565b610d6760ff604088510151168361161e565b03610cbd57610d9f610d90610d8a845160ff604060c0830151920151169061165b565b926115a7565b60ff604088510151169061165b565b036109b85751608001516107bd576020820152600160408201525f6060820152610cb7565b7f67525583000000000000000000000000000000000000000000000000000000005f5260045ffd5b9050516006811015610358576002145f610cfb565b508051600681101561035857600514610c00565b600903610f5757610e24611498565b948051600681101561035857600403610ed957504667ffffffffffffffff8751511603610dc457610e5860208251016114d9565b600a81101561035857600803610a305767ffffffffffffffff82511667ffffffffffffffff610e8a818451511661155b565b1603610a0857606080915101510151606086510151036107e55760a0855101516105b6576080016060815101516107e5575160a001516105b657610425576109e05760016040820152926102bc565b919250508051600681101561035857600114908115610f42575b501561044d576060845101516107e55760a0845101516105b657608001606081510151156107e5575160a001516105b6576020820152600560408201525f6060820152600160a0820152610cb7565b9050516006811015610358576002145f610ef3565b7fc898513c000000000000000000000000000000000000000000000000000000005f5260045ffd5b5167ffffffffffffffff164211610f96575f610219565b7ff06506c5000000000000000000000000000000000000000000000000000000005f5260045ffd5b7ff019de0e000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fe6af4070000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f114a9df4000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f26c21ae4000000000000000000000000000000000000000000000000000000005f5260045ffd5b67ffffffffffffffff60808401515116156101c7577f4c7b586e000000000000000000000000000000000000000000000000000000005f5260045ffd5b508351600a81101561035857600914610199565b508351600a81101561035857600814610192565b508351600a8110156103585760071461018b565b508351600a81101561035857600614610184565b508351600a8110156103585760051461017d565b6020015173ffffffffffffffffffffffffffffffffffffffff168061115b575060ff601291511603611133575b5f80610161565b7f5a8dbaed000000000000000000000000000000000000000000000000000000005f5260045ffd5b906020600492604051938480927f313ce5670000000000000000000000000000000000000000000000000000000082525afa5f92816111f7575b506111c2577f6afa2af9000000000000000000000000000000000000000000000000000000005f5260045ffd5b60ff8091511691161461112c577f5a8dbaed000000000000000000000000000000000000000000000000000000005f5260045ffd5b9092506020813d602011611231575b81611213602093836112c9565b8101031261122d575160ff8116810361122d57915f611195565b5f80fd5b3d9150611206565b7fb016c3f4000000000000000000000000000000000000000000000000000000005f5260045ffd5b60608101515167ffffffffffffffff1615915081611281575b505f610135565b67ffffffffffffffff9150608001515116155f61127a565b634e487b7160e01b5f52604160045260245ffd5b60e0810190811067ffffffffffffffff82111761129957604052565b90601f601f19910116810190811067ffffffffffffffff82111761129957604052565b359067ffffffffffffffff8216820361122d57565b91908260e091031261122d57604051611319816112ad565b8092611324816112ec565b8252602081013573ffffffffffffffffffffffffffffffffffffffff8116810361122d576020830152604081013560ff8116810361122d5760c09182916040850152606081013560608501526080810135608085015260a081013560a08501520135910152565b81601f8201121561122d5780359067ffffffffffffffff821161129957604051926113c06020601f19601f86011601856112c9565b8284526020838301011161122d57815f926020809301838601378301015290565b91906102608382031261122d57604051906113fb826112ad565b8193611406816112ec565b83526020810135600a81101561122d576020840152604081013560408401526114328260608301611301565b6060840152611445826101408301611301565b608084015261022081013567ffffffffffffffff811161122d578261146b91830161138b565b60a08401526102408101359167ffffffffffffffff831161122d5760c092611493920161138b565b910152565b6040519060c0820182811067ffffffffffffffff821117611299576040525f60a0838281528260208201528260408201528260608201528260808201520152565b51600a8110156103585790565b919082018092116109a457565b9190915f83820193841291129080158216911516176109a457565b81810392915f1380158285131691841216176109a457565b5f81126115305790565b7fa8ce4432000000000000000000000000000000000000000000000000000000005f5260045260245ffd5b67ffffffffffffffff60019116019067ffffffffffffffff82116109a457565b7f800000000000000000000000000000000000000000000000000000000000000081146109a4575f0390565b7f7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff81116115d15790565b7f24775e06000000000000000000000000000000000000000000000000000000005f5260045260245ffd5b60ff166012039060ff82116109a457565b60ff16604d81116109a457600a0a90565b9060ff811660128111611239576012146116575761163e611643916115fc565b61160d565b908181029181830414901517156109a45790565b5090565b9060ff811660128111611239576012146116575761163e61167b916115fc565b90818102917f800000000000000000000000000000000000000000000000000000000000000081145f8312166109a45781830514901517156109a4579056fea264697066735822122036f6b0f3261f4d84fa391cd2e29d848110238f6d49d373a5912f2304cae9c86d64736f6c634300081e0033",
+}
+
+// ChannelEngineABI is the input ABI used to generate the binding from.
+// Deprecated: Use ChannelEngineMetaData.ABI instead.
+var ChannelEngineABI = ChannelEngineMetaData.ABI
+
+// ChannelEngineBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ChannelEngineMetaData.Bin instead.
+var ChannelEngineBin = ChannelEngineMetaData.Bin
+
+// DeployChannelEngine deploys a new Ethereum contract, binding an instance of ChannelEngine to it.
+func DeployChannelEngine(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *ChannelEngine, error) {
+	parsed, err := ChannelEngineMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ChannelEngineBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ChannelEngine{ChannelEngineCaller: ChannelEngineCaller{contract: contract}, ChannelEngineTransactor: ChannelEngineTransactor{contract: contract}, ChannelEngineFilterer: ChannelEngineFilterer{contract: contract}}, nil
+}
+
+// ChannelEngine is an auto generated Go binding around an Ethereum contract.
+type ChannelEngine struct {
+	ChannelEngineCaller     // Read-only binding to the contract
+	ChannelEngineTransactor // Write-only binding to the contract
+	ChannelEngineFilterer   // Log filterer for contract events
+}
+
+// ChannelEngineCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ChannelEngineCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ChannelEngineTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ChannelEngineTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ChannelEngineFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ChannelEngineFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ChannelEngineSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ChannelEngineSession struct {
+	Contract     *ChannelEngine    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ChannelEngineCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ChannelEngineCallerSession struct {
+	Contract *ChannelEngineCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// ChannelEngineTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ChannelEngineTransactorSession struct {
+	Contract     *ChannelEngineTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// ChannelEngineRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ChannelEngineRaw struct {
+	Contract *ChannelEngine // Generic contract binding to access the raw methods on
+}
+
+// ChannelEngineCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ChannelEngineCallerRaw struct {
+	Contract *ChannelEngineCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ChannelEngineTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ChannelEngineTransactorRaw struct {
+	Contract *ChannelEngineTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewChannelEngine creates a new instance of ChannelEngine, bound to a specific deployed contract.
+func NewChannelEngine(address common.Address, backend bind.ContractBackend) (*ChannelEngine, error) {
+	contract, err := bindChannelEngine(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ChannelEngine{ChannelEngineCaller: ChannelEngineCaller{contract: contract}, ChannelEngineTransactor: ChannelEngineTransactor{contract: contract}, ChannelEngineFilterer: ChannelEngineFilterer{contract: contract}}, nil
+}
+
+// NewChannelEngineCaller creates a new read-only instance of ChannelEngine, bound to a specific deployed contract.
+func NewChannelEngineCaller(address common.Address, caller bind.ContractCaller) (*ChannelEngineCaller, error) {
+	contract, err := bindChannelEngine(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ChannelEngineCaller{contract: contract}, nil
+}
+
+// NewChannelEngineTransactor creates a new write-only instance of ChannelEngine, bound to a specific deployed contract.
+func NewChannelEngineTransactor(address common.Address, transactor bind.ContractTransactor) (*ChannelEngineTransactor, error) {
+	contract, err := bindChannelEngine(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ChannelEngineTransactor{contract: contract}, nil
+}
+
+// NewChannelEngineFilterer creates a new log filterer instance of ChannelEngine, bound to a specific deployed contract.
+func NewChannelEngineFilterer(address common.Address, filterer bind.ContractFilterer) (*ChannelEngineFilterer, error) {
+	contract, err := bindChannelEngine(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ChannelEngineFilterer{contract: contract}, nil
+}
+
+// bindChannelEngine binds a generic wrapper to an already deployed contract.
+func bindChannelEngine(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ChannelEngineMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ChannelEngine *ChannelEngineRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ChannelEngine.Contract.ChannelEngineCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ChannelEngine *ChannelEngineRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ChannelEngine.Contract.ChannelEngineTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ChannelEngine *ChannelEngineRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ChannelEngine.Contract.ChannelEngineTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ChannelEngine *ChannelEngineCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ChannelEngine.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ChannelEngine *ChannelEngineTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ChannelEngine.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ChannelEngine *ChannelEngineTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ChannelEngine.Contract.contract.Transact(opts, method, params...)
+}
+
 // ChannelHubMetaData contains all meta data concerning the ChannelHub contract.
 var ChannelHubMetaData = &bind.MetaData{
-	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"_defaultSigValidator\",\"type\":\"address\",\"internalType\":\"contractISignatureValidator\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"DEFAULT_SIG_VALIDATOR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractISignatureValidator\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"ESCROW_DEPOSIT_UNLOCK_DELAY\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint32\",\"internalType\":\"uint32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MAX_DEPOSIT_ESCROW_PURGE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint32\",\"internalType\":\"uint32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MIN_CHALLENGE_DURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint32\",\"internalType\":\"uint32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"TRANSFER_GAS_LIMIT\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"VERSION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"challengeChannel\",\"inputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"candidate\",\"type\":\"tuple\",\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"challengerSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"challengerIdx\",\"type\":\"uint8\",\"internalType\":\"enumParticipantIndex\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"challengeEscrowDeposit\",\"inputs\":[{\"name\":\"escrowId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"challengerSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"challengerIdx\",\"type\":\"uint8\",\"internalType\":\"enumParticipantIndex\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"challengeEscrowWithdrawal\",\"inputs\":[{\"name\":\"escrowId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"challengerSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"challengerIdx\",\"type\":\"uint8\",\"internalType\":\"enumParticipantIndex\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"checkpointChannel\",\"inputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"candidate\",\"type\":\"tuple\",\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"claimFunds\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"destination\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"closeChannel\",\"inputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"candidate\",\"type\":\"tuple\",\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"createChannel\",\"inputs\":[{\"name\":\"def\",\"type\":\"tuple\",\"internalType\":\"structChannelDefinition\",\"components\":[{\"name\":\"challengeDuration\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"node\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"approvedSignatureValidators\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"name\":\"initState\",\"type\":\"tuple\",\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"depositToChannel\",\"inputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"candidate\",\"type\":\"tuple\",\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"depositToVault\",\"inputs\":[{\"name\":\"node\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"escrowHead\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"finalizeEscrowDeposit\",\"inputs\":[{\"name\":\"escrowId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"candidate\",\"type\":\"tuple\",\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"finalizeEscrowWithdrawal\",\"inputs\":[{\"name\":\"escrowId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"candidate\",\"type\":\"tuple\",\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"finalizeMigration\",\"inputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"candidate\",\"type\":\"tuple\",\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getAccountBalance\",\"inputs\":[{\"name\":\"node\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getChannelData\",\"inputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"status\",\"type\":\"uint8\",\"internalType\":\"enumChannelStatus\"},{\"name\":\"definition\",\"type\":\"tuple\",\"internalType\":\"structChannelDefinition\",\"components\":[{\"name\":\"challengeDuration\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"node\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"approvedSignatureValidators\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"name\":\"lastState\",\"type\":\"tuple\",\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"challengeExpiry\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"lockedFunds\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getChannelIds\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getEscrowDepositData\",\"inputs\":[{\"name\":\"escrowId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"status\",\"type\":\"uint8\",\"internalType\":\"enumEscrowStatus\"},{\"name\":\"unlockAt\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"challengeExpiry\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"lockedAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initState\",\"type\":\"tuple\",\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getEscrowDepositIds\",\"inputs\":[{\"name\":\"page\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"pageSize\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"ids\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getEscrowWithdrawalData\",\"inputs\":[{\"name\":\"escrowId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"status\",\"type\":\"uint8\",\"internalType\":\"enumEscrowStatus\"},{\"name\":\"challengeExpiry\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"lockedAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initState\",\"type\":\"tuple\",\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getNodeValidator\",\"inputs\":[{\"name\":\"node\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"validatorId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractISignatureValidator\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOpenChannels\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"openChannels\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getReclaimBalance\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initiateEscrowDeposit\",\"inputs\":[{\"name\":\"def\",\"type\":\"tuple\",\"internalType\":\"structChannelDefinition\",\"components\":[{\"name\":\"challengeDuration\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"node\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"approvedSignatureValidators\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"name\":\"candidate\",\"type\":\"tuple\",\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"initiateEscrowWithdrawal\",\"inputs\":[{\"name\":\"def\",\"type\":\"tuple\",\"internalType\":\"structChannelDefinition\",\"components\":[{\"name\":\"challengeDuration\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"node\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"approvedSignatureValidators\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"name\":\"candidate\",\"type\":\"tuple\",\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initiateMigration\",\"inputs\":[{\"name\":\"def\",\"type\":\"tuple\",\"internalType\":\"structChannelDefinition\",\"components\":[{\"name\":\"challengeDuration\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"node\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"approvedSignatureValidators\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"name\":\"candidate\",\"type\":\"tuple\",\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"purgeEscrowDeposits\",\"inputs\":[{\"name\":\"maxToPurge\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"registerNodeValidator\",\"inputs\":[{\"name\":\"node\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"validatorId\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"validator\",\"type\":\"address\",\"internalType\":\"contractISignatureValidator\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"withdrawFromChannel\",\"inputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"candidate\",\"type\":\"tuple\",\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"withdrawFromVault\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"ChannelChallenged\",\"inputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"candidate\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"challengeExpireAt\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ChannelCheckpointed\",\"inputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"candidate\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ChannelClosed\",\"inputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"finalState\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ChannelCreated\",\"inputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"node\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"definition\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structChannelDefinition\",\"components\":[{\"name\":\"challengeDuration\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"node\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"approvedSignatureValidators\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"name\":\"initialState\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ChannelDeposited\",\"inputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"candidate\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ChannelWithdrawn\",\"inputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"candidate\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Deposited\",\"inputs\":[{\"name\":\"wallet\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"token\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EscrowDepositChallenged\",\"inputs\":[{\"name\":\"escrowId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"state\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"challengeExpireAt\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EscrowDepositFinalized\",\"inputs\":[{\"name\":\"escrowId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"state\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EscrowDepositFinalizedOnHome\",\"inputs\":[{\"name\":\"escrowId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"state\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EscrowDepositInitiated\",\"inputs\":[{\"name\":\"escrowId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"state\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EscrowDepositInitiatedOnHome\",\"inputs\":[{\"name\":\"escrowId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"state\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EscrowDepositsPurged\",\"inputs\":[{\"name\":\"purgedCount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EscrowWithdrawalChallenged\",\"inputs\":[{\"name\":\"escrowId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"state\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"challengeExpireAt\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EscrowWithdrawalFinalized\",\"inputs\":[{\"name\":\"escrowId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"state\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EscrowWithdrawalFinalizedOnHome\",\"inputs\":[{\"name\":\"escrowId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"state\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EscrowWithdrawalInitiated\",\"inputs\":[{\"name\":\"escrowId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"state\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EscrowWithdrawalInitiatedOnHome\",\"inputs\":[{\"name\":\"escrowId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"state\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"FundsClaimed\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"token\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"destination\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"MigrationInFinalized\",\"inputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"state\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"MigrationInInitiated\",\"inputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"state\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"MigrationOutFinalized\",\"inputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"state\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"MigrationOutInitiated\",\"inputs\":[{\"name\":\"channelId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"state\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structState\",\"components\":[{\"name\":\"version\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"intent\",\"type\":\"uint8\",\"internalType\":\"enumStateIntent\"},{\"name\":\"metadata\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"homeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"nonHomeLedger\",\"type\":\"tuple\",\"internalType\":\"structLedger\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"userAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"userNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"},{\"name\":\"nodeAllocation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeNetFlow\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"name\":\"userSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"nodeSig\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TransferFailed\",\"inputs\":[{\"name\":\"recipient\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"token\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ValidatorRegistered\",\"inputs\":[{\"name\":\"node\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"validatorId\",\"type\":\"uint8\",\"indexed\":true,\"internalType\":\"uint8\"},{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"contractISignatureValidator\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Withdrawn\",\"inputs\":[{\"name\":\"wallet\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"token\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AddressCollision\",\"inputs\":[{\"name\":\"collision\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ChallengerVersionTooLow\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignature\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureLength\",\"inputs\":[{\"name\":\"length\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureS\",\"inputs\":[{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"EmptySignature\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"IncorrectAmount\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"IncorrectChallengeDuration\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"IncorrectChannelStatus\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"IncorrectSignature\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"IncorrectStateIntent\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"IncorrectValue\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientBalance\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidAddress\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidValidatorId\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NativeTransferFailed\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"OnlyNonHomeEscrowsCanBeChallenged\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ReentrancyGuardReentrantCall\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SafeCastOverflowedIntToUint\",\"inputs\":[{\"name\":\"value\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"type\":\"error\",\"name\":\"SafeERC20FailedOperation\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ValidatorAlreadyRegistered\",\"inputs\":[{\"name\":\"node\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"validatorId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]},{\"type\":\"error\",\"name\":\"ValidatorNotApproved\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ValidatorNotRegistered\",\"inputs\":[{\"name\":\"node\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"validatorId\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]}]",
-	Bin: "0x60a0346100aa57601f61604f38819003918201601f19168301916001600160401b038311848410176100ae578084926020946040528339810103126100aa57516001600160a01b0381168082036100aa5760017f9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f00551561009b57608052604051615f8c90816100c3823960805181818161114f0152614ac40152f35b63e6c4247b60e01b5f5260045ffd5b5f80fd5b634e487b7160e01b5f52604160045260245ffdfe60806040526004361015610011575f80fd5b5f3560e01c806313c380ed1461024457806316b390b11461023f57806317536c061461023a578063187576d8146102355780633115f6301461023057806338a66be21461022b57806341b660ef1461022657806347de477a14610221578063532691981461021c578063587675e8146102175780635a0745b4146102125780635b9acbf91461020d5780635dc46a74146102085780636898234b146102035780636af820bd146101fe57806371a47141146101f9578063735181f0146101f45780637e7985f9146101ef57806382d3e15d146101ea5780638d0b12a5146101e557806394191051146101e05780639691b468146101db578063a5c82680146101d6578063b00b6fd6146101d1578063b25a1d38146101cc578063beed9d5f146101c7578063c74a2d10146101c2578063d888ccae146101bd578063dc23f29e146101b8578063dd73d494146101b3578063e617208c146101ae578063ecf3d7e8146101a9578063f4ac51f5146101a4578063f766f8d61461019f5763ffa1ad741461019a575f80fd5b612680565b6124e1565b612466565b612354565b6122b5565b612137565b611f3b565b611dfb565b611bb7565b611a11565b611701565b611698565b6114fd565b6113a3565b611386565b6111ef565b6111d2565b6111bb565b61117e565b61113a565b61111f565b611033565b611021565b610fff565b610fe3565b610f9d565b610d3b565b610b82565b6108a6565b610852565b6106c6565b610640565b610532565b610326565b61028f565b90816102609103126102585790565b5f80fd5b90604060031983011261025857600435916024359067ffffffffffffffff82116102585761028c91600401610249565b90565b34610258576102a66102a03661025c565b90612c38565b005b9181601f840112156102585782359167ffffffffffffffff8311610258576020838186019501011161025857565b60643590600282101561025857565b90606060031983011261025857600435916024359067ffffffffffffffff821161025857610315916004016102a8565b909160443560028110156102585790565b3461025857610334366102e5565b61040061034c859493945f52600260205260405f2090565b92835461036761036261035e83613f07565b1590565b612e9a565b6103c2600286019461039261038387546001600160a01b031690565b948560038a019a8b5492614aab565b9591600160068b019a01966103b288546001600160a01b039060081c1690565b926103bc8c61300e565b88614bfb565b60c06103cd886142d5565b604051809581927f6666e4c000000000000000000000000000000000000000000000000000000000835260048301613092565b038173__$682d6198b4eca5bc7e038b912a26498e7e$__5af49081156104f0577fba075bd445233f7cad862c72f0343b3503aad9c8e704a2295f122b82abf8e8019661049e956080955f946104b3575b508361047c61046e61048f9697546001600160a01b039060081c1690565b92546001600160a01b031690565b9254936104888a61300e565b908c6146aa565b015167ffffffffffffffff1690565b906104ae604051928392836130a3565b0390a2005b61048f94509461047c6104e061046e9760c03d60c0116104e9575b6104d8818361292e565b8101906129a5565b95505094610450565b503d6104ce565b612a9f565b6001600160a01b0381160361025857565b60031960609101126102585760043561051e816104f5565b9060243561052b816104f5565b9060443590565b6001600160a01b0361054336610506565b9290911690610553821515613200565b61055e83151561322f565b815f5260066020526105848160405f20906001600160a01b03165f5260205260405f2090565b805491848301809311610602577f8752a472e571a816aea92eec8dae9baf628e840f4929fbcc2d155e6233ff68a7926001600160a01b0392556105c5615951565b6105d0858233614d31565b60017f9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f00556040519485521692602090a3005b61325e565b60206040818301928281528451809452019201905f5b81811061062a5750505090565b825184526020938401939092019160010161061d565b34610258576020600319360112610258576001600160a01b03600435610665816104f5565b165f52600160205260405f206040519081602082549182815201915f5260205f20905f5b8181106106b0576106ac856106a08187038261292e565b60405191829182610607565b0390f35b8254845260209093019260019283019201610689565b3461025857602060031936011261025857600354600480549190355f5b82841080610849575b1561083c5761071861070a61070086613496565b90549060031b1c90565b5f52600260205260405f2090565b600181016003610729825460ff1690565b61073281611c46565b1461082a5761074082615bbd565b156107e657915f826107d16107dd9560056107d79601926107c26107ba8554926107a5600d61079361077c60028501546001600160a01b031690565b6001600160a01b03165f52600660205260405f2090565b92015460401c6001600160a01b031690565b6001600160a01b03165f5260205260405f2090565b918254613272565b9055600360ff19825416179055565b55613982565b93613982565b915b91926106e3565b50509290506107f59150600455565b806107fc57005b6040519081527f61815f4b11c6ea4e14a2e448a010bed8efdc3e53a15efbf183d16a31085cd14590602090a1005b50509261083690613982565b916107df565b9290506107f59150600455565b508181106106ec565b34610258575f600319360112610258576020604051620186a08152f35b90600319820160e081126102585760c0136102585760049160c4359067ffffffffffffffff82116102585761028c91600401610249565b6108af3661086f565b90602082019160026108c08461287e565b6108c981611c55565b148015610b67575b8015610b49575b6108e190612888565b6109896108f66108f1368561328d565b614f1d565b9161090084614fdb565b602084019061090e826128b7565b9561092d6040870197610920896128b7565b608089013591858961428c565b60e08261095561094e61094261077c8c6128b7565b6107a5608085016128b7565b54886150e6565b60405196879283927fa8b4483c000000000000000000000000000000000000000000000000000000008452600484016133d1565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af49384156104f057610a846001600160a01b0394610a9c93610a057fb00e209e275d0e1892f1982b34d3f545d1628aebd95322d7ce3585c558f638b498610a8a955f91610b1a575b506109f4368d61328d565b6109fe368a612b8a565b908c61527e565b610a3189610a2c610a15856128b7565b6001600160a01b03165f52600160205260405f2090565b615bf1565b506002610a3d8261287e565b610a4681611c55565b03610aa15750877f6085f5128b19e0d3cc37524413de47259383f0f75265d5d66f4177869620669660405180610a7c898261286d565b0390a26128b7565b976128b7565b918360405194859416981696836133e8565b0390a4005b610aac60039161287e565b610ab581611c55565b03610aea57877f188e0ade7d115cc397426774adb960ae3e8c83e72f0a6cad4b7085e1d60bf98660405180610a7c898261286d565b877f567044ba1cdd4671ac3979c114241e1e3b56c9e9051f63f2f234f7a2795019cc60405180610a7c898261286d565b610b3c915060e03d60e011610b42575b610b34818361292e565b810190613301565b5f6109e9565b503d610b2a565b506108e1610b568461287e565b610b5f81611c55565b1590506108d8565b506003610b738461287e565b610b7c81611c55565b146108d1565b610b8b3661086f565b90610bac6004610b9d6020850161287e565b610ba681611c55565b14612888565b610bb96108f1368361328d565b9160208201610bc7816128b7565b90610be860408501926080610bdb856128b7565b960135958691868961428c565b610bfa610bf484613478565b86615391565b93610c0486613f07565b15610c4c57505050610c4781610c3b7f471c4ebe4e57d25ef7117e141caac31c6b98f067b8098a7a7bbd38f637c2f98093866153c1565b6040519182918261286d565b0390a3005b610c949060c085610c6088979596976142d5565b60405194859283927fbbc42f3400000000000000000000000000000000000000000000000000000000845260048401612a7a565b038173__$682d6198b4eca5bc7e038b912a26498e7e$__5af480156104f0577fede7867afa7cdb9c443667efd8244d98bf9df1dce68e60dc94dca6605125ca7695610c4795610d09945f93610d12575b50610cf1610cf7916128b7565b916128b7565b91610d023687612b8a565b8a8a6146aa565b610c3b846134e5565b610cf7919350610d33610cf19160c03d60c0116104e9576104d8818361292e565b939150610ce4565b3461025857610d493661025c565b610d5a6009610b9d6020840161287e565b610d766001610d70845f525f60205260405f2090565b01613533565b610e55610d8d60208301516001600160a01b031690565b9161077c60e060408301610db9610dab82516001600160a01b031690565b608086015190888a8c61428c565b610e21610e1a610e03610dcc368b612b8a565b9586946101408c018d8d610ddf83613478565b67ffffffffffffffff1646149d8e610f0f575b50505050516001600160a01b031690565b6060840151602001516001600160a01b03166107a5565b54896150e6565b60405195869283927fa8b4483c000000000000000000000000000000000000000000000000000000008452600484016135bf565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af49182156104f057610e87935f93610eee575b508661527e565b15610ebd576104ae7f9a6f675cc94b83b55f1ecc0876affd4332a30c92e6faa2aca0199b1b6df922c3916040519182918261286d565b6104ae7f7b20773c41402791c5f18914dbbeacad38b1ebcc4c55d8eb3bfe0a4cde26c826916040519182918261286d565b610f0891935060e03d60e011610b4257610b34818361292e565b915f610e80565b610f33610f6992610f24610f6e963690612aaa565b60608d01526060369101612aaa565b60808b0152610f406135ab565b60a08b0152610f4d6135ab565b60c08b01526001600160a01b03165f52600160205260405f2090565b615c9a565b505f8d8d82610df2565b600319604091011261025857600435610f90816104f5565b9060243561028c816104f5565b34610258576020610fda6001600160a01b03610fb836610f78565b91165f526006835260405f20906001600160a01b03165f5260205260405f2090565b54604051908152f35b34610258575f600319360112610258576020604051612a308152f35b34610258576040600319360112610258576106ac6106a0602435600435613669565b6102a661102d3661025c565b90613723565b34610258576020600319360112610258576001600160a01b03600435611058816104f5565b165f52600160205261106c60405f20615b75565b5f905f5b815181101561110c5761109e6110976110898385613655565b515f525f60205260405f2090565b5460ff1690565b6110a781612208565b600381141590816110f7575b506110c1575b600101611070565b916110d48184600193106110dc57613982565b9290506110b9565b6110e68585613655565b516110f18286613655565b52613982565b6005915061110481612208565b14155f6110b3565b506106ac91815260405191829182610607565b34610258575f60031936011261025857602060405160408152f35b34610258575f600319360112610258576040517f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03168152602090f35b34610258576020610fda6001600160a01b0361119936610f78565b91165f526008835260405f20906001600160a01b03165f5260205260405f2090565b34610258576102a66111cc3661025c565b90613a5e565b34610258575f600319360112610258576020600454604051908152f35b346102585761127a611200366102e5565b92939190611216855f52600560205260405f2090565b9161122761036261035e8554613f07565b6002830160a061124761124183546001600160a01b031690565b896153dc565b604051809781927f24063eba00000000000000000000000000000000000000000000000000000000835260048301613c4b565b038173__$b69fb814c294bfc16f92e50d7aeced4bde$__5af49485156104f0575f95611355575b508354600185015490949060081c6001600160a01b0316968792546112cc906001600160a01b031690565b809581956003850154976112e1928992614aab565b9a9190946006019a6112f28c61300e565b956112fd968b614bfb565b846113078761300e565b6113119589615444565b6060015167ffffffffffffffff1660405191829161132f91836130a3565b037fb8568a1f475f3c76759a620e08a653d28348c5c09e2e0bc91d533339801fefd891a2005b61137891955060a03d60a01161137f575b611370818361292e565b810190613990565b935f6112a1565b503d611366565b34610258575f600319360112610258576020604051620151808152f35b6114796113af3661025c565b6113d06113c16020839594950161287e565b6113ca81611c55565b15612888565b6113e66001610d70855f525f60205260405f2090565b9060e08161144561143e61094261077c61140a60208901516001600160a01b031690565b6114318b8a604081019389608061142887516001600160a01b031690565b9301519361428c565b516001600160a01b031690565b54876150e6565b60405195869283927fa8b4483c000000000000000000000000000000000000000000000000000000008452600484016133d1565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af49283156104f0577f567044ba1cdd4671ac3979c114241e1e3b56c9e9051f63f2f234f7a2795019cc936104ae93610c3b925f926114dc575b506114d53685612b8a565b908761527e565b6114f691925060e03d60e011610b4257610b34818361292e565b905f6114ca565b346102585761150b3661086f565b9061151d6006610b9d6020850161287e565b61152a6108f1368361328d565b9160208201611538816128b7565b9061154c60408501926080610bdb856128b7565b611558610bf484613478565b9361156286613f07565b1561159957505050610c4781610c3b7f587faad1bcd589ce902468251883e1976a645af8563c773eed7356d78433210c93866153c1565b6115e29060a0856115af611241879896976128b7565b60405194859283927eea54e700000000000000000000000000000000000000000000000000000000845260048401613a47565b038173__$b69fb814c294bfc16f92e50d7aeced4bde$__5af480156104f0577f17eb0a6bd5a0de45d1029ce3444941070e149df35b22176fc439f930f73c09f795610c4795610c3b945f93611651575b50610cf161163f916128b7565b9161164a3687612b8a565b8a8a615444565b61163f919350611672610cf19160a03d60a01161137f57611370818361292e565b939150611632565b6024359060ff8216820361025857565b359060ff8216820361025857565b34610258576040600319360112610258576001600160a01b036116e66004356116c0816104f5565b826116c961167a565b91165f52600760205260405f209060ff165f5260205260405f2090565b541660405180916001600160a01b0360208301911682520390f35b60806003193601126102585760043560243567ffffffffffffffff811161025857611730903690600401610249565b60443567ffffffffffffffff8111610258576117509036906004016102a8565b919061175a6102d6565b9261176c855f525f60205260405f2090565b9161177960018401613533565b916117a3611788855460ff1690565b61179181612208565b600181149081156119fd575b50613c5c565b866117b06005860161300e565b926117f16117bd88613478565b67ffffffffffffffff6117e86117db885167ffffffffffffffff1690565b67ffffffffffffffff1690565b91161015613c8b565b60208501516001600160a01b0316926040860161181581516001600160a01b031690565b9567ffffffffffffffff61183d6117db61182e8d613478565b935167ffffffffffffffff1690565b911611611903575b50946118a77f07b9206d5a6026d3bd2a8f9a9b79f6fa4bfbd6a016975829fbaf07488019f28a99866118d5966118a16118c5978d61189560149f9e9c996118bc9a6118f49f60808c015192614aab565b93919490923690612b8a565b90614bfb565b845460ff191660021785555163ffffffff1690565b63ffffffff1690565b67ffffffffffffffff4216613cba565b9301805467ffffffffffffffff191667ffffffffffffffff8516179055565b6104ae60405192839283613cdc565b8861144561193f61094261077c60e0956114318c9f9e9c9a6119469b8d9f9a9b9d6119336113c160208c0161287e565b8960808d01519361428c565b548d6150e6565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af49384156104f0577f07b9206d5a6026d3bd2a8f9a9b79f6fa4bfbd6a016975829fbaf07488019f28a99896118f4986118a18e8c61189560149f976118a7986118bc9b6119c66118d59f6118c59f5f916119de575b508d6119bf3689612b8a565b9089615581565b9a9f5050995050509750509650969899509950611845565b6119f7915060e03d60e011610b4257610b34818361292e565b5f6119b3565b60049150611a0a81612208565b145f61179d565b3461025857608060031936011261025857600435611a2e816104f5565b611a3661167a565b90604435611a43816104f5565b60643567ffffffffffffffff811161025857611b906001600160a01b0392611b5d611a9e96611b42611b3d611a7d899736906004016102a8565b60ff85169a91611b3790611a928d1515613d04565b8b89169d8e1515613200565b611afa8785611af4611ae8611ae8611adb85611acb866001600160a01b03165f52600760205260405f2090565b9060ff165f5260205260405f2090565b546001600160a01b031690565b6001600160a01b031690565b15613d33565b6040805160ff891660208201526001600160a01b038b169181019190915246606080830191909152815292611b3060808561292e565b3691612b39565b9061578a565b613d78565b611acb856001600160a01b03165f52600760205260405f2090565b906001600160a01b03167fffffffffffffffffffffffff0000000000000000000000000000000000000000825416179055565b167f2366b94a706a0cfc2dca2fe8be9410b6fba2db75e3e9d3f03b3c2fb0b051efad5f80a4005b611bd7611bc33661025c565b6113d06003610b9d6020849695960161287e565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af49283156104f0577f188e0ade7d115cc397426774adb960ae3e8c83e72f0a6cad4b7085e1d60bf986936104ae93610c3b925f926114dc57506114d53685612b8a565b634e487b7160e01b5f52602160045260245ffd5b60041115611c5057565b611c32565b600a1115611c5057565b90600a821015611c505752565b90601f19601f602080948051918291828752018686015e5f8582860101520116010190565b61028c9167ffffffffffffffff8251168152611cb560208301516020830190611c5f565b60408201516040820152611d236060830151606083019060c0809167ffffffffffffffff81511684526001600160a01b03602082015116602085015260ff6040820151166040850152606081015160608501526080810151608085015260a081015160a08501520151910152565b608082810151805167ffffffffffffffff1661014084015260208101516001600160a01b0316610160840152604081015160ff1661018084015260608101516101a0840152908101516101c083015260a08101516101e083015260c0015161020082015260c0611da560a0840151610260610220850152610260840190611c6c565b92015190610240818403910152611c6c565b929367ffffffffffffffff60c09561028c98979482948752611dd881611c46565b602087015216604085015216606083015260808201528160a08201520190611c91565b3461025857602060031936011261025857600435611e17613ddd565b505f52600260205260405f20611e2b612951565b90805482526106ac600182015491611e77611e67611e498560ff1690565b94611e58602088019687613e21565b60081c6001600160a01b031690565b6001600160a01b03166040860152565b611e9e611e8e60028301546001600160a01b031690565b6001600160a01b03166060860152565b60038101546080850152600481015467ffffffffffffffff811660a086019081529490611ed6905b60401c67ffffffffffffffff1690565b67ffffffffffffffff1660c0820190815291611f2a61182e611f06600660058501549460e087019586520161300e565b93610100810194855251965197611f1c89611c46565b5167ffffffffffffffff1690565b905191519260405196879687611db7565b3461025857611f493661086f565b611f5a6008610b9d6020840161287e565b80611fcf611f6b6108f1368661328d565b936020810160e0611f7b826128b7565b91611f9a6040850193611f8d856128b7565b608087013591898c61428c565b610e21610e1a610e0361077c611fb0368b612b8a565b9687958d8a611fbe82613f07565b9d8e15612098575b505050506128b7565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af49182156104f05761200c935f93612073575b5061200690369061328d565b8661527e565b15612042576104ae7f3142fb397e715d80415dff7b527bf1c451def4675da6e1199ee1b4588e3f630a916040519182918261286d565b6104ae7f26afbcb9eb52c21f42eb9cfe8f263718ffb65afbf84abe8ad8cce2acfb2242b8916040519182918261286d565b6120069193506120919060e03d60e011610b4257610b34818361292e565b9290611ffa565b6120f1936120cd610a15926120af610a2c95614fdb565b8c60606120c0366101408501612aaa565b9101526060369101612aaa565b60808c01526120da6135ab565b60a08c01526120e76135ab565b60c08c01526128b7565b505f8d8a8e611fc6565b9160a09367ffffffffffffffff9161028c979693855261211a81611c46565b602085015216604083015260608201528160808201520190611c91565b3461025857602060031936011261025857600435612153613ddd565b505f52600560205260405f20612167612961565b90805482526106ac600182015491612185611e67611e498560ff1690565b61219c611e8e60028301546001600160a01b031690565b60038101546080850152600481015467ffffffffffffffff1667ffffffffffffffff1660a08501908152936121f76121e2600660058501549460c085019586520161300e565b9160e0810192835251945195611f1c87611c46565b9151905191604051958695866120fb565b60061115611c5057565b906006821015611c505752565b919261229761012094612239856122aa959a99989a612212565b602085019060a0809163ffffffff81511684526001600160a01b0360208201511660208501526001600160a01b03604082015116604085015267ffffffffffffffff6060820151166060850152608081015160808501520151910152565b61014060e0840152610140830190611c91565b946101008201520152565b34610258576020600319360112610258576004355f60a06040516122d8816128d5565b82815282602082015282604082015282606082015282608082015201526122fd613ddd565b505f525f60205261231060405f20613e39565b805161231b81612208565b6106ac60208301519260408101519060606123446117db608084015167ffffffffffffffff1690565b910151916040519586958661221f565b346102585761236236610506565b90916123786001600160a01b0382161515613200565b61238382151561322f565b335f5260066020526123a98360405f20906001600160a01b03165f5260205260405f2090565b549082821061243e57828203918211610602578383916123fe936123f8836123e2336001600160a01b03165f52600660205260405f2090565b906001600160a01b03165f5260205260405f2090565b55613f64565b7fd1c19fbcd4551a5edfb66d43d2e337c04837afda3482b42bdf569a8fccdae5fb6001600160a01b0360405193169280610c473394829190602083019252565b7ff4d678b8000000000000000000000000000000000000000000000000000000005f5260045ffd5b6124866124723661025c565b6113d06002610b9d6020849695960161287e565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af49283156104f0577f6085f5128b19e0d3cc37524413de47259383f0f75265d5d66f41778696206696936104ae93610c3b925f926114dc57506114d53685612b8a565b34610258576124ef36610f78565b6124f7615951565b6001600160a01b0381169161250d831515613200565b6001600160a01b03612534826123e2336001600160a01b03165f52600860205260405f2090565b549161254183151561322f565b5f612561826123e2336001600160a01b03165f52600860205260405f2090565b55169181836125db57612584915f808080858a5af161257e613e97565b50613ec6565b60405190815233907f7b8d70738154be94a9a068a6d2f5dd8cfc65c52855859dc8f47de1ff185f8b5590602090a46102a660017f9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f0055565b50506040517fa9059cbb000000000000000000000000000000000000000000000000000000005f52836004528160245260205f60448180875af160015f5114811615612661575b604091909152612584577f5274afe7000000000000000000000000000000000000000000000000000000005f526001600160a01b03821660045260245ffd5b6001811516612677573d15843b15151616612622565b503d5f823e3d90fd5b34610258575f60031936011261025857602060405160018152f35b906126a581611c46565b60ff60ff198354169116179055565b67ffffffffffffffff81160361025857565b35906126d1826126b4565b565b600a111561025857565b35906126d1826126d3565b60c0809167ffffffffffffffff8135612700816126b4565b1684526001600160a01b036020820135612719816104f5565b16602085015260ff61272d6040830161168a565b166040850152606081013560608501526080810135608085015260a081013560a08501520135910152565b90357fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe18236030181121561025857016020813591019167ffffffffffffffff821161025857813603831361025857565b601f8260209493601f1993818652868601375f8582860101520116010190565b61028c9167ffffffffffffffff82356127e0816126b4565b1681526127fe60208301356127f4816126d3565b6020830190611c5f565b6040820135604082015261281860608201606084016126e8565b61282a610140820161014084016126e8565b61285e61285261283e610220850185612758565b6102606102208601526102608501916127a8565b92610240810190612758565b916102408185039101526127a8565b90602061028c9281815201906127c8565b3561028c816126d3565b1561288f57565b7fc898513c000000000000000000000000000000000000000000000000000000005f5260045ffd5b3561028c816104f5565b634e487b7160e01b5f52604160045260245ffd5b60c0810190811067ffffffffffffffff8211176128f157604052565b6128c1565b60e0810190811067ffffffffffffffff8211176128f157604052565b60a0810190811067ffffffffffffffff8211176128f157604052565b90601f601f19910116810190811067ffffffffffffffff8211176128f157604052565b604051906126d16101208361292e565b604051906126d16101008361292e565b604051906126d160e08361292e565b5190600482101561025857565b51906126d1826126b4565b5190811515820361025857565b908160c091031261025857612a0d60a0604051926129c2846128d5565b80518452602081015160208501526129dc60408201612980565b604085015260608101516129ef816126b4565b60608501526080810151612a02816126b4565b608085015201612998565b60a082015290565b908151612a2181611c46565b815260a080612a3f602085015160c0602086015260c0850190611c91565b936040810151604085015267ffffffffffffffff606082015116606085015267ffffffffffffffff6080820151166080850152015191015290565b9091612a9161028c93604084526040840190612a15565b9160208184039101526127c8565b6040513d5f823e3d90fd5b91908260e091031261025857604051612ac2816128f6565b60c08082948035612ad2816126b4565b84526020810135612ae2816104f5565b6020850152612af36040820161168a565b6040850152606081013560608501526080810135608085015260a081013560a08501520135910152565b67ffffffffffffffff81116128f157601f01601f191660200190565b929192612b4582612b1d565b91612b53604051938461292e565b829481845281830111610258578281602093845f960137010152565b9080601f830112156102585781602061028c93359101612b39565b9190916102608184031261025857612ba0612971565b92612baa826126c6565b8452612bb8602083016126dd565b602085015260408201356040850152612bd48160608401612aaa565b6060850152612be7816101408401612aaa565b608085015261022082013567ffffffffffffffff81116102585781612c0d918401612b6f565b60a085015261024082013567ffffffffffffffff811161025857612c319201612b6f565b60c0830152565b612c4a815f52600260205260405f2090565b60018101805490600882901c6001600160a01b031690612c7460028501546001600160a01b031690565b92612c89612c828654613f07565b9160ff1690565b90159081612e86575b5080612e63575b612ddd5750612caf6005610b9d6020880161287e565b8254612cba81613f07565b15612d05575091612cf2612d00927f32e24720f56fd5a7f4cb219d7ff3278ae95196e79c85b5801395894a6f53466c948784546149af565b54936040519182918261286d565b0390a3565b612d5690612d1e6003869496019185878a85549361428c565b60c087610c60612d4f612d42886001600160a01b03165f52600660205260405f2090565b6107a561016085016128b7565b548961437a565b038173__$682d6198b4eca5bc7e038b912a26498e7e$__5af49384156104f0577f1b92e8ef67d8a7c0d29c99efcd180a5e0d98d60ac41d52abbbb5950882c78e4e95612d0095612cf2945f91612dbe575b508554935493612db7368c612b8a565b908a6146aa565b612dd7915060c03d60c0116104e9576104d8818361292e565b5f612da7565b805460ff19166003179055507f1b92e8ef67d8a7c0d29c99efcd180a5e0d98d60ac41d52abbbb5950882c78e4e91612d0091612cf29060058301905f82549255612e4c600485017fffffffffffffffffffffffffffffffff0000000000000000ffffffffffffffff8154169055565b600d84015460401c6001600160a01b031690613f64565b506004840154612e7f9060401c67ffffffffffffffff166117db565b4211612c99565b60029150612e9381611c46565b145f612c92565b15612ea157565b7ff64df366000000000000000000000000000000000000000000000000000000005f5260045ffd5b90604051612ed6816128f6565b60c060048294612f1460ff825467ffffffffffffffff811687526001600160a01b03808260401c1616602088015260e01c16604086019060ff169052565b6001810154606085015260028101546080850152600381015460a08501520154910152565b90600182811c92168015612f67575b6020831014612f5357565b634e487b7160e01b5f52602260045260245ffd5b91607f1691612f48565b5f9291815491612f8083612f39565b8083529260018116908115612fd55750600114612f9c57505050565b5f9081526020812093945091925b838310612fbb575060209250010190565b600181602092949394548385870101520191019190612faa565b9050602094955060ff1991509291921683830152151560051b010190565b906126d16130079260405193848092612f71565b038361292e565b9060405161301b816128f6565b809260ff815467ffffffffffffffff8116845260401c1690600a821015611c5057600d61308d9160c09360208601526001810154604086015261306060028201612ec9565b606086015261307160078201612ec9565b6080860152613082600c8201612ff3565b60a086015201612ff3565b910152565b90602061028c928181520190612a15565b929160206131ef6126d193604087526130d7815467ffffffffffffffff811660408a015260ff60608a019160401c16611c5f565b60018101546080880152600281015467ffffffffffffffff811660a0890152604081901c6001600160a01b031660c089015260e090811c60ff16908801526003810154610100880152600481015461012088015260058101546101408801526006810154610160880152600781015467ffffffffffffffff8116610180890152604081901c6001600160a01b03166101a089015260e01c60ff166101c088015260088101546101e08801526009810154610200880152600a810154610220880152600b81015461024088015261026080880152600d6131bd6102a08901600c8401612f71565b917fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc0898403016102808a015201612f71565b94019067ffffffffffffffff169052565b1561320757565b7fe6c4247b000000000000000000000000000000000000000000000000000000005f5260045ffd5b1561323657565b7f69640e72000000000000000000000000000000000000000000000000000000005f5260045ffd5b634e487b7160e01b5f52601160045260245ffd5b9190820180921161060257565b63ffffffff81160361025857565b91908260c0910312610258576040516132a5816128d5565b60a080829480356132b58161327f565b845260208101356132c5816104f5565b602085015260408101356132d8816104f5565b604085015260608101356132eb816126b4565b6060850152608081013560808501520135910152565b908160e09103126102585760405190613319826128f6565b8051825260208101516020830152604081015160068110156102585761337a9160c091604085015261334d6060820161298d565b606085015261335e60808201612998565b608085015261336f60a08201612998565b60a085015201612998565b60c082015290565b9061338e818351612212565b608067ffffffffffffffff816133b3602086015160a0602087015260a0860190611c91565b94604081015160408601526060810151606086015201511691015290565b9091612a9161028c93604084526040840190613382565b60e09060a061028c949363ffffffff81356134028161327f565b1683526001600160a01b03602082013561341b816104f5565b1660208401526001600160a01b036040820135613437816104f5565b16604084015267ffffffffffffffff6060820135613454816126b4565b16606084015260808101356080840152013560a08201528160c082015201906127c8565b3561028c816126b4565b634e487b7160e01b5f52603260045260245ffd5b6003548110156134ae5760035f5260205f2001905f90565b613482565b80548210156134ae575f5260205f2001905f90565b916134e1918354905f199060031b92831b921b19161790565b9055565b600354680100000000000000008110156128f157600181016003556003548110156134ae5760035f527fc2575a0e9e593c00f959f8c92f12db2869c3395a3b0502d05e2516446f71f85b0155565b90604051613540816128d5565b60a0600382946001600160a01b03815463ffffffff8116865260201c16602085015261359a67ffffffffffffffff60018301546001600160a01b03808216166040880152851c16606086019067ffffffffffffffff169052565b600281015460808501520154910152565b604051906135ba60208361292e565b5f8252565b90916135d661028c93604084526040840190613382565b916020818403910152611c91565b67ffffffffffffffff81116128f15760051b60200190565b6040519061360b60208361292e565b5f808352366020840137565b90613621826135e4565b61362e604051918261292e565b828152601f1961363e82946135e4565b0190602036910137565b9190820391821161060257565b80518210156134ae5760209160051b010190565b9190600354908084029380850482149015171561060257818410156136ed57830190818411610602578082116136e5575b506136ad6136a88483613648565b613617565b92805b8281106136bc57505050565b806136cb610700600193613496565b6136de6136d88584613648565b88613655565b52016136b0565b90505f61369a565b5050905061028c6135fc565b906006811015611c505760ff60ff198354169116179055565b90602061028c928181520190611c91565b906137356001610b9d6020840161287e565b613746825f525f60205260405f2090565b61375260018201613533565b9161375e825460ff1690565b918461376c6005830161300e565b91604086019261378384516001600160a01b031690565b91600261379a60208a01516001600160a01b031690565b976137a481612208565b1480613962575b6138a75750506137d661094e61094261077c61380a9661143160e0978a978c898f608001519361428c565b60405193849283927fa8b4483c000000000000000000000000000000000000000000000000000000008452600484016133d1565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af480156104f057610f6961388194610a1588937f04cd8c68bf83e7bc531ca5a5d75c34e36513c2acf81e07e6470ba79e29da13a898613874965f92613886575b5061386d3689612b8a565b908661527e565b506040519182918261286d565b0390a2565b6138a091925060e03d60e011610b4257610b34818361292e565b905f613862565b7f04cd8c68bf83e7bc531ca5a5d75c34e36513c2acf81e07e6470ba79e29da13a89750613881969195506139559450610f699261390c6014836138f4610a1595600360ff19825416179055565b5f60138201550167ffffffffffffffff198154169055565b606087016139358151606061392b60208301516001600160a01b031690565b9101519086613f64565b5160a061394c60208301516001600160a01b031690565b91015191613f64565b5060405191829182613712565b5061397b6117db601483015467ffffffffffffffff1690565b42116137ab565b5f1981146106025760010190565b908160a0910312610258576139e56080604051926139ad84612912565b80518452602081015160208501526139c760408201612980565b604085015260608101516139da816126b4565b606085015201612998565b608082015290565b9081516139f981611c46565b815260806001600160a01b0381613a1f602086015160a0602087015260a0860190611c91565b946040810151604086015267ffffffffffffffff606082015116606086015201511691015290565b9091612a9161028c936040845260408401906139ed565b613a70815f52600560205260405f2090565b8054600182018054919492600883901c6001600160a01b031691613a9e60028301546001600160a01b031690565b93613aab612c8289613f07565b90159081613c37575b5080613c17575b613bb65750613ad16007610b9d6020870161287e565b613ada86613f07565b15613b11575091610c3b612d00927f6d0cf3d243d63f08f50db493a8af34b27d4e3bc9ec4098e82700abfeffe2d4989483886149af565b6003613b37919492940192613b2a84548287868b61428c565b60a0836115af83896153dc565b038173__$b69fb814c294bfc16f92e50d7aeced4bde$__5af49081156104f0577f2fdac1380dbe23ae259b6871582b7f33e34461547f400bdd20d74991250317d195612d0095610c3b945f94613b95575b50549261164a3687612b8a565b613baf91945060a03d60a01161137f57611370818361292e565b925f613b88565b7f2fdac1380dbe23ae259b6871582b7f33e34461547f400bdd20d74991250317d1949650612cf291925092613bf5612d0094600360ff19825416179055565b60058301905f82549255612e4c6004850167ffffffffffffffff198154169055565b50613c306117db600484015467ffffffffffffffff1690565b4211613abb565b60029150613c4481611c46565b145f613ab4565b90602061028c9281815201906139ed565b15613c6357565b7ff2056b18000000000000000000000000000000000000000000000000000000005f5260045ffd5b15613c9257565b7f7d957361000000000000000000000000000000000000000000000000000000005f5260045ffd5b9067ffffffffffffffff8091169116019067ffffffffffffffff821161060257565b9067ffffffffffffffff613cfd6020929594956040855260408501906127c8565b9416910152565b15613d0b57565b7f06ee4dcd000000000000000000000000000000000000000000000000000000005f5260045ffd5b15613d3c575050565b906001600160a01b0360ff927f0bcc40f3000000000000000000000000000000000000000000000000000000005f52166004521660245260445ffd5b15613d7f57565b7fc1606c2f000000000000000000000000000000000000000000000000000000005f5260045ffd5b60405190613db4826128f6565b5f60c0838281528260208201528260408201528260608201528260808201528260a08201520152565b60405190613dea826128f6565b606060c0835f81525f60208201525f6040820152613e06613da7565b83820152613e12613da7565b60808201528260a08201520152565b613e2a82611c46565b52565b6006821015611c505752565b90604051613e4681612912565b608067ffffffffffffffff60148395613e6360ff82541686613e2d565b613e6f60018201613533565b6020860152613e806005820161300e565b604086015260138101546060860152015416910152565b3d15613ec1573d90613ea882612b1d565b91613eb6604051938461292e565b82523d5f602084013e565b606090565b15613ecf575050565b6001600160a01b03907fa5b05eec000000000000000000000000000000000000000000000000000000005f521660045260245260445ffd5b805f525f60205260ff60405f2054166006811015611c50578015908115613f50575b50613f4b575f525f60205267ffffffffffffffff600760405f20015416461490565b505f90565b60059150613f5d81612208565b145f613f29565b90613f779291613f72615951565b613fac565b60017f9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f0055565b90816020910312610258575190565b9190918115614236576001600160a01b0383169283614050576001600160a01b038216925f8080808488620186a0f1613fe3613e97565b5015613ff0575050505050565b614033612d00926123e27fbf182be802245e8ed88e4b8d3e4344c0863dd2a70334f089fd07265389306fcf956001600160a01b03165f52600860205260405f2090565b61403e828254613272565b90556040519081529081906020820190565b6040517f70a08231000000000000000000000000000000000000000000000000000000008152306004820152602081602481885afa9081156104f0575f91614217575b506040517fa9059cbb00000000000000000000000000000000000000000000000000000000602082019081526001600160a01b0385166024830152604480830187905282525f918291906140e860648261292e565b51908286620186a0f1906140fa613e97565b506040517f70a082310000000000000000000000000000000000000000000000000000000081523060048201526020816024818a5afa9182156104f05786915f936141e6575b50836141db575b836141c7575b5050501561415c575b50505050565b816141a56001600160a01b03926123e27fbf182be802245e8ed88e4b8d3e4344c0863dd2a70334f089fd07265389306fcf956001600160a01b03165f52600860205260405f2090565b6141b0858254613272565b90556040519384521691602090a35f808080614156565b6141d2929350613648565b145f848161414d565b818110159350614147565b61420991935060203d602011614210575b614201818361292e565b810190613f9d565b915f614140565b503d6141f7565b614230915060203d60201161421057614201818361292e565b5f614093565b505050565b9035907fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe181360301821215610258570180359067ffffffffffffffff82116102585760200191813603831361025857565b92916126d1946142bd6142cc926142b283876142ac61022089018961423b565b90614aab565b90878a9493946159c8565b836142ac61024085018561423b565b929091946159c8565b5f604051916142e3836128d5565b818352614376602084016142f5613ddd565b81526143686040860191858352611ec66004606089019288845260808a019589875261433060a08c01998b8b525f52600260205260405f2090565b9160ff60018401541661434281611c46565b8c526143506006840161300e565b90526005820154905201549167ffffffffffffffff83165b67ffffffffffffffff169052565b5290565b9060405191614388836128d5565b5f83526143766020840161439a613ddd565b815261436860408601915f8352611ec6600460608901925f845260808a01955f875261433060a08c01995f8b525f52600260205260405f2090565b9060c060049161440867ffffffffffffffff825116859067ffffffffffffffff1667ffffffffffffffff19825416179055565b602081015184546040808401517fffffff000000000000000000000000000000000000000000ffffffffffffffff90921692901b7bffffffffffffffffffffffffffffffffffffffff0000000000000000169190911760e09190911b7cff0000000000000000000000000000000000000000000000000000000016178455606081015160018501556080810151600285015560a081015160038501550151910155565b601f82116144b857505050565b5f5260205f20906020601f840160051c830193106144f0575b601f0160051c01905b8181106144e5575050565b5f81556001016144da565b90915081906144d1565b919091825167ffffffffffffffff81116128f1576145228161451c8454612f39565b846144ab565b6020601f821160011461455c5781906134e19394955f92614551575b50505f198260011b9260031b1c19161790565b015190505f8061453e565b601f1982169061456f845f5260205f2090565b915f5b8181106145a957509583600195969710614591575b505050811b019055565b01515f1960f88460031b161c191690555f8080614587565b9192602060018192868b015181550194019201614572565b8151815467ffffffffffffffff191667ffffffffffffffff91909116178155602082015191600a831015611c5057600a831015611c505760c0600d916126d1947fffffffffffffffffffffffffffffffffffffffffffffff00ffffffffffffffff68ff000000000000000086549260401b169116178455604081015160018501556146536060820151600286016143d5565b6146646080820151600786016143d5565b61467560a0820151600c86016144fa565b015191016144fa565b7f80000000000000000000000000000000000000000000000000000000000000008114610602575f0390565b60209392916146c761472e929897985f52600260205260405f2090565b966040850180516146d781611c46565b6146e081611c46565b614992575b5060a08501916146f58351151590565b6148fb575b5050606084015167ffffffffffffffff16806148d0575b50608084015167ffffffffffffffff1680614881575b5051151590565b1561486857608001518201516001600160a01b031680935b8251905f8213156148285761476891506147608451615a25565b928391614cef565b61477760058601918254613272565b90555b0180515f8113156147d65750916147b56005926123e261479d6147cb9651615a25565b966001600160a01b03165f52600660205260405f2090565b6147c0858254613648565b905501918254613272565b90555b6126d1614e1b565b9290505f83126147ea575b505050506147ce565b6148076005926123e261479d61480261481d9761467e565b615a25565b614812858254613272565b905501918254613648565b90555f8080806147e1565b5f8212614838575b50505061477a565b61484761480261484f9361467e565b928391613f64565b61485e60058601918254613648565b9055825f80614830565b50600d84015460401c6001600160a01b03168093614746565b6148ca9060048901907fffffffffffffffffffffffffffffffff0000000000000000ffffffffffffffff6fffffffffffffffff000000000000000083549260401b169116179055565b5f614727565b6148f590600489019067ffffffffffffffff1667ffffffffffffffff19825416179055565b5f614711565b6149088560068b016145c1565b88556001880180547fffffffffffffffffffffff0000000000000000000000000000000000000000ff16600889901b74ffffffffffffffffffffffffffffffffffffffff00161790556002880180547fffffffffffffffffffffffff0000000000000000000000000000000000000000166001600160a01b038b1617905560038801555f806146fa565b6149a990516149a081611c46565b60018a0161269b565b5f6146e5565b9091926149dd614a04946149cf6001610d70865f525f60205260405f2090565b92608084015191868661428c565b60e0836109556149fd61094261077c60408701516001600160a01b031690565b54856150e6565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af49283156104f0576126d1945f94614a41575b50614a3b903690612b8a565b9161527e565b614a3b919450614a5f9060e03d60e011610b4257610b34818361292e565b9390614a2f565b15614a6f575050565b906001600160a01b0360ff927f577f5940000000000000000000000000000000000000000000000000000000005f52166004521660245260445ffd5b93929190918215614b7e57843560f81c9081614af857507f000000000000000000000000000000000000000000000000000000000000000094600101925f19019150614af49050565b9091565b600180915f97939594975060ff86161c1603614b5657614b4983614b37611adb614af496611acb8a6001600160a01b03165f52600760205260405f2090565b966001600160a01b0388161515614a66565b600101915f199190910190565b7f1a9073b4000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fac241e11000000000000000000000000000000000000000000000000000000005f5260045ffd5b805191908290602001825e015f815290565b60021115611c5057565b9392606093614bed6001600160a01b0394613cfd949998998852608060208901526080880190611c6c565b9186830360408801526127a8565b906001600160a01b039295602097614c2f9599614c66614c1d614cab95615a5a565b614c58604051998a928e840190614ba6565b7f6368616c6c656e67650000000000000000000000000000000000000000000000815260090190565b03601f19810189528861292e565b614c6f81614bb8565b614ce857505b604051988997889687957f600109bb00000000000000000000000000000000000000000000000000000000875260048701614bc2565b0392165afa80156104f0576126d1915f91614cc9575b501515613d78565b614ce2915060203d60201161421057614201818361292e565b5f614cc1565b9050614c75565b90613f779291614cfd615951565b614d31565b15614d0957565b7fd2ade556000000000000000000000000000000000000000000000000000000005f5260045ffd5b908215614236576001600160a01b03169182158015614e0c57614d55823414614d02565b15614d5f57505050565b6001600160a01b03604051927f23b872dd000000000000000000000000000000000000000000000000000000005f52166004523060245260445260205f60648180865af160015f5114811615614df6575b6040919091525f60605215614dc25750565b7f5274afe7000000000000000000000000000000000000000000000000000000005f526001600160a01b031660045260245ffd5b6001811516612677573d15833b15151616614db0565b614e163415614d02565b614d55565b6003546004545f5b82821080614f13575b15614f0857614e4061070a61070084613496565b600181016003614e51825460ff1690565b614e5a81611c46565b14614ef657614e6882615bbd565b15614eb357915f826107d1614eaa956005614ea49601926107c26107ba8554926107a5600d61079361077c60028501546001600160a01b031690565b91613982565b915b9190614e23565b50509150614ec090600455565b80614ec85750565b6040519081527f61815f4b11c6ea4e14a2e448a010bed8efdc3e53a15efbf183d16a31085cd14590602090a1565b505090614f0290613982565b91614eac565b9150614ec090600455565b5060408110614e2c565b7effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f010000000000000000000000000000000000000000000000000000000000000091604051614fc460208201809360a0809163ffffffff81511684526001600160a01b0360208201511660208501526001600160a01b03604082015116604085015267ffffffffffffffff6060820151166060850152608081015160808501520151910152565b60c08152614fd360e08261292e565b519020161790565b6020810135614fe9816104f5565b6001600160a01b03811690614fff821515613200565b60408301359061500e826104f5565b6150356001600160a01b03831692615027841515613200565b615030836104f5565b6104f5565b61503e816104f5565b50811461508a575063ffffffff62015180913561505a8161327f565b161061506257565b7f0596b15b000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fabfa558d000000000000000000000000000000000000000000000000000000005f5260045260245ffd5b604051906150c282612912565b5f6080838281526150d1613ddd565b60208201528260408201528260608201520152565b90601467ffffffffffffffff916150fb6150b5565b935f525f60205260405f209061511560ff83541686613e2d565b6151216005830161300e565b6020860152601382015460408601526060850152015416608082015290565b9060a060039163ffffffff8151167fffffffffffffffffffffffffffffffffffffffffffffffffffffffff000000008554161784556001600160a01b036020820151167fffffffffffffffff0000000000000000000000000000000000000000ffffffff77ffffffffffffffffffffffffffffffffffffffff0000000086549260201b16911617845561526d6001850161521b6151e760408501516001600160a01b031690565b82906001600160a01b03167fffffffffffffffffffffffff0000000000000000000000000000000000000000825416179055565b606083015181547fffffffff0000000000000000ffffffffffffffffffffffffffffffffffffffff1660a09190911b7bffffffffffffffff000000000000000000000000000000000000000016179055565b608081015160028501550151910155565b926152ba816152f19460c09461529b885f525f60205260405f2090565b976152a7895460ff1690565b6152b081612208565b1561537f57615581565b6040810180516152c981612208565b6152d281612208565b151580615354575b61533a575b5060a0810151615312575b0151151590565b6152f85750565b5f6013820155601401805467ffffffffffffffff19169055565b835460ff191660011784556153356014850167ffffffffffffffff198154169055565b6152ea565b61534e905161534881612208565b856136f9565b5f6152df565b50845460ff1681519061536682612208565b61536f82612208565b61537881612208565b14156152da565b61538c8260018b01615140565b615581565b9067ffffffffffffffff6040519160208301938452166040820152604081526153bb60608261292e565b51902090565b90614a04916149dd6001610d70835f525f60205260405f2090565b906001600160a01b03906153ee6150b5565b925f52600560205267ffffffffffffffff600460405f2060ff60018201541661541681611c46565b86526154246006820161300e565b602087015260058101546040870152015416606084015216608082015290565b602093929161546161472e929897985f52600560205260405f2090565b9660408501805161547181611c46565b61547a81611c46565b61556d575b50608085019161548f8351151590565b6154d6575b5050606084015167ffffffffffffffff16806154b1575051151590565b6148ca90600489019067ffffffffffffffff1667ffffffffffffffff19825416179055565b6154e38560068b016145c1565b88556001880180547fffffffffffffffffffffff0000000000000000000000000000000000000000ff16600889901b74ffffffffffffffffffffffffffffffffffffffff00161790556002880180547fffffffffffffffffffffffff0000000000000000000000000000000000000000166001600160a01b038b1617905560038801555f80615494565b61557b90516149a081611c46565b5f61547f565b6155976060919493945f525f60205260405f2090565b936155a56080850151151590565b615778575b01916155fd60c06155c66020865101516001600160a01b031690565b9280515f811361573a575b506020810180515f81136156f2575b5081515f81126156b1575b50515f8112615666575b500151151590565b80615658575b615614575b505050506126d1614e1b565b61564d9261564060a092615634604060139601516001600160a01b031690565b90848451015191613f64565b5101519201918254613648565b90555f808080615608565b5060a0835101511515615603565b6148026156729161467e565b61568d856123e261077c60408a01516001600160a01b031690565b615698828254613272565b90556156a960138901918254613648565b90555f6155f5565b6148026156bd9161467e565b6156db81876156d660208b01516001600160a01b031690565b613f64565b6156ea60138a01918254613648565b90555f6155eb565b6156fb90615a25565b615716866123e261077c60408b01516001600160a01b031690565b615721828254613648565b905561573260138a01918254613272565b90555f6155e0565b61574390615a25565b615761818661575c60208a01516001600160a01b031690565b614cef565b61577060138901918254613272565b90555f6155d1565b61578581600587016145c1565b6155aa565b805192835f947a184f03e93ff9f4daa797ed6e38ed64bf6a1f010000000000000000821015615929575b806d04ee2d6d415b85acef8100000000600a92101561590d575b662386f26fc100008110156158f8575b6305f5e1008110156158e6575b6127108110156158d6575b60648110156158c7575b10156158bc575b6158505f19602161581a60018901615dac565b978801015b01917f3031323334353637383961626364656600000000000000000000000000000000600a82061a8353600a900490565b90811561586357615850905f199061581f565b50506001600160a01b036158888461587c858498615d40565b60208151910120615d96565b9116931683146158b4576158a691816020611ae89351910120615d96565b146158af575f90565b600190565b505050600190565b600190940193615807565b60029060649004960195615800565b60049061271090049601956157f6565b6008906305f5e10090049601956157eb565b601090662386f26fc1000090049601956157de565b6020906d04ee2d6d415b85acef810000000090049601956157ce565b50604094507a184f03e93ff9f4daa797ed6e38ed64bf6a1f01000000000000000081046157b4565b60027f9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f0054146159a05760027f9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f0055565b7f3ee5aeb5000000000000000000000000000000000000000000000000000000005f5260045ffd5b6001600160a01b0390614cab6159ee6159e960209895999697993690612b8a565b615a5a565b93604051988997889687957f600109bb00000000000000000000000000000000000000000000000000000000875260048701614bc2565b5f8112615a2f5790565b7fa8ce4432000000000000000000000000000000000000000000000000000000005f5260045260245ffd5b67ffffffffffffffff815116906020810151600a811015611c5057615b03826040615b64940151615aa260806060840151930151946040519760208901526040880190611c5f565b6060860152608085019060c0809167ffffffffffffffff81511684526001600160a01b03602082015116602085015260ff6040820151166040850152606081015160608501526080810151608085015260a081015160a08501520151910152565b805167ffffffffffffffff1661016084015260208101516001600160a01b0316610180840152604081015160ff166101a084015260608101516101c084015260808101516101e084015260a081015161020084015260c00151610220830152565b610220815261028c6102408261292e565b90604051918281549182825260208201905f5260205f20925f5b818110615ba45750506126d19250038361292e565b8454835260019485019487945060209093019201615b8f565b67ffffffffffffffff6004820154164210159081615bd9575090565b600180925060ff91015416615bed81611c46565b1490565b6001810190825f528160205260405f2054155f14615c59578054680100000000000000008110156128f157615c46615c308260018794018555846134b3565b81939154905f199060031b92831b921b19161790565b905554915f5260205260405f2055600190565b5050505f90565b80548015615c86575f190190615c7682826134b3565b5f1982549160031b1b1916905555565b634e487b7160e01b5f52603160045260245ffd5b6001810191805f528260205260405f2054928315155f14615d38575f198401848111610602578354935f198501948511610602575f958583615cf597615ce89503615cfb575b505050615c60565b905f5260205260405f2090565b55600190565b615d21615d1b91615d12610700615d2f95886134b3565b928391876134b3565b906134c8565b85905f5260205260405f2090565b555f8080615ce0565b505050505f90565b6126d190615d88615d8294936040519586937f19457468657265756d205369676e6564204d6573736167653a0a0000000000006020860152603a850190614ba6565b90614ba6565b03601f19810184528361292e565b61028c91615da391615dd3565b90929192615e0d565b90615db682612b1d565b615dc3604051918261292e565b828152601f1961363e8294612b1d565b8151919060418303615e0357615dfc9250602082015190606060408401519301515f1a90615ed4565b9192909190565b50505f9160029190565b615e1681611c46565b80615e1f575050565b615e2881611c46565b60018103615e58577ff645eedf000000000000000000000000000000000000000000000000000000005f5260045ffd5b615e6181611c46565b60028103615e9557507ffce698f7000000000000000000000000000000000000000000000000000000005f5260045260245ffd5b80615ea1600392611c46565b14615ea95750565b7fd78bce0c000000000000000000000000000000000000000000000000000000005f5260045260245ffd5b91907f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a08411615f4b579160209360809260ff5f9560405194855216868401526040830152606082015282805260015afa156104f0575f516001600160a01b03811615615f4157905f905f90565b505f906001905f90565b5050505f916003919056fea2646970667358221220d6322a6f79dfa260b6143002f6411140acf47599f685bd2c3432147eed00120464736f6c634300081e0033",
+	ABI: "[{\"inputs\":[{\"internalType\":\"contractISignatureValidator\",\"name\":\"_defaultSigValidator\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"DEFAULT_SIG_VALIDATOR\",\"outputs\":[{\"internalType\":\"contractISignatureValidator\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ESCROW_DEPOSIT_UNLOCK_DELAY\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAX_DEPOSIT_ESCROW_PURGE\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MIN_CHALLENGE_DURATION\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"TRANSFER_GAS_LIMIT\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"VERSION\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"internalType\":\"structState\",\"name\":\"candidate\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"challengerSig\",\"type\":\"bytes\"},{\"internalType\":\"enumParticipantIndex\",\"name\":\"challengerIdx\",\"type\":\"uint8\"}],\"name\":\"challengeChannel\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"escrowId\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"challengerSig\",\"type\":\"bytes\"},{\"internalType\":\"enumParticipantIndex\",\"name\":\"challengerIdx\",\"type\":\"uint8\"}],\"name\":\"challengeEscrowDeposit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"escrowId\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"challengerSig\",\"type\":\"bytes\"},{\"internalType\":\"enumParticipantIndex\",\"name\":\"challengerIdx\",\"type\":\"uint8\"}],\"name\":\"challengeEscrowWithdrawal\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"internalType\":\"structState\",\"name\":\"candidate\",\"type\":\"tuple\"}],\"name\":\"checkpointChannel\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"destination\",\"type\":\"address\"}],\"name\":\"claimFunds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"internalType\":\"structState\",\"name\":\"candidate\",\"type\":\"tuple\"}],\"name\":\"closeChannel\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"challengeDuration\",\"type\":\"uint32\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"node\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"nonce\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"approvedSignatureValidators\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"}],\"internalType\":\"structChannelDefinition\",\"name\":\"def\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"internalType\":\"structState\",\"name\":\"initState\",\"type\":\"tuple\"}],\"name\":\"createChannel\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"internalType\":\"structState\",\"name\":\"candidate\",\"type\":\"tuple\"}],\"name\":\"depositToChannel\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"node\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"depositToVault\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"escrowHead\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"escrowId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"internalType\":\"structState\",\"name\":\"candidate\",\"type\":\"tuple\"}],\"name\":\"finalizeEscrowDeposit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"escrowId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"internalType\":\"structState\",\"name\":\"candidate\",\"type\":\"tuple\"}],\"name\":\"finalizeEscrowWithdrawal\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"internalType\":\"structState\",\"name\":\"candidate\",\"type\":\"tuple\"}],\"name\":\"finalizeMigration\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"node\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"}],\"name\":\"getAccountBalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"}],\"name\":\"getChannelData\",\"outputs\":[{\"internalType\":\"enumChannelStatus\",\"name\":\"status\",\"type\":\"uint8\"},{\"components\":[{\"internalType\":\"uint32\",\"name\":\"challengeDuration\",\"type\":\"uint32\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"node\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"nonce\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"approvedSignatureValidators\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"}],\"internalType\":\"structChannelDefinition\",\"name\":\"definition\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"internalType\":\"structState\",\"name\":\"lastState\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"challengeExpiry\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lockedFunds\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"getChannelIds\",\"outputs\":[{\"internalType\":\"bytes32[]\",\"name\":\"\",\"type\":\"bytes32[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"escrowId\",\"type\":\"bytes32\"}],\"name\":\"getEscrowDepositData\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"internalType\":\"enumEscrowStatus\",\"name\":\"status\",\"type\":\"uint8\"},{\"internalType\":\"uint64\",\"name\":\"unlockAt\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"challengeExpiry\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"lockedAmount\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"internalType\":\"structState\",\"name\":\"initState\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"page\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"pageSize\",\"type\":\"uint256\"}],\"name\":\"getEscrowDepositIds\",\"outputs\":[{\"internalType\":\"bytes32[]\",\"name\":\"ids\",\"type\":\"bytes32[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"escrowId\",\"type\":\"bytes32\"}],\"name\":\"getEscrowWithdrawalData\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"internalType\":\"enumEscrowStatus\",\"name\":\"status\",\"type\":\"uint8\"},{\"internalType\":\"uint64\",\"name\":\"challengeExpiry\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"lockedAmount\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"internalType\":\"structState\",\"name\":\"initState\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"node\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"validatorId\",\"type\":\"uint8\"}],\"name\":\"getNodeValidator\",\"outputs\":[{\"internalType\":\"contractISignatureValidator\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"getOpenChannels\",\"outputs\":[{\"internalType\":\"bytes32[]\",\"name\":\"openChannels\",\"type\":\"bytes32[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"}],\"name\":\"getReclaimBalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"challengeDuration\",\"type\":\"uint32\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"node\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"nonce\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"approvedSignatureValidators\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"}],\"internalType\":\"structChannelDefinition\",\"name\":\"def\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"internalType\":\"structState\",\"name\":\"candidate\",\"type\":\"tuple\"}],\"name\":\"initiateEscrowDeposit\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"challengeDuration\",\"type\":\"uint32\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"node\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"nonce\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"approvedSignatureValidators\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"}],\"internalType\":\"structChannelDefinition\",\"name\":\"def\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"internalType\":\"structState\",\"name\":\"candidate\",\"type\":\"tuple\"}],\"name\":\"initiateEscrowWithdrawal\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"challengeDuration\",\"type\":\"uint32\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"node\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"nonce\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"approvedSignatureValidators\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"}],\"internalType\":\"structChannelDefinition\",\"name\":\"def\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"internalType\":\"structState\",\"name\":\"candidate\",\"type\":\"tuple\"}],\"name\":\"initiateMigration\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"maxToPurge\",\"type\":\"uint256\"}],\"name\":\"purgeEscrowDeposits\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"node\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"validatorId\",\"type\":\"uint8\"},{\"internalType\":\"contractISignatureValidator\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"}],\"name\":\"registerNodeValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"internalType\":\"structState\",\"name\":\"candidate\",\"type\":\"tuple\"}],\"name\":\"withdrawFromChannel\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"withdrawFromVault\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"candidate\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"challengeExpireAt\",\"type\":\"uint64\"}],\"name\":\"ChannelChallenged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"candidate\",\"type\":\"tuple\"}],\"name\":\"ChannelCheckpointed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"finalState\",\"type\":\"tuple\"}],\"name\":\"ChannelClosed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"node\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint32\",\"name\":\"challengeDuration\",\"type\":\"uint32\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"node\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"nonce\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"approvedSignatureValidators\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"}],\"indexed\":false,\"internalType\":\"structChannelDefinition\",\"name\":\"definition\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"initialState\",\"type\":\"tuple\"}],\"name\":\"ChannelCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"candidate\",\"type\":\"tuple\"}],\"name\":\"ChannelDeposited\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"candidate\",\"type\":\"tuple\"}],\"name\":\"ChannelWithdrawn\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"wallet\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Deposited\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"escrowId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"state\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"challengeExpireAt\",\"type\":\"uint64\"}],\"name\":\"EscrowDepositChallenged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"escrowId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"state\",\"type\":\"tuple\"}],\"name\":\"EscrowDepositFinalized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"escrowId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"state\",\"type\":\"tuple\"}],\"name\":\"EscrowDepositFinalizedOnHome\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"escrowId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"state\",\"type\":\"tuple\"}],\"name\":\"EscrowDepositInitiated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"escrowId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"state\",\"type\":\"tuple\"}],\"name\":\"EscrowDepositInitiatedOnHome\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"purgedCount\",\"type\":\"uint256\"}],\"name\":\"EscrowDepositsPurged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"escrowId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"state\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"challengeExpireAt\",\"type\":\"uint64\"}],\"name\":\"EscrowWithdrawalChallenged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"escrowId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"state\",\"type\":\"tuple\"}],\"name\":\"EscrowWithdrawalFinalized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"escrowId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"state\",\"type\":\"tuple\"}],\"name\":\"EscrowWithdrawalFinalizedOnHome\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"escrowId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"state\",\"type\":\"tuple\"}],\"name\":\"EscrowWithdrawalInitiated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"escrowId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"state\",\"type\":\"tuple\"}],\"name\":\"EscrowWithdrawalInitiatedOnHome\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"destination\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"FundsClaimed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"state\",\"type\":\"tuple\"}],\"name\":\"MigrationInFinalized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"state\",\"type\":\"tuple\"}],\"name\":\"MigrationInInitiated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"state\",\"type\":\"tuple\"}],\"name\":\"MigrationOutFinalized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"enumStateIntent\",\"name\":\"intent\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"metadata\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"homeLedger\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"chainId\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"userAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"userNetFlow\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"nodeAllocation\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"nodeNetFlow\",\"type\":\"int256\"}],\"internalType\":\"structLedger\",\"name\":\"nonHomeLedger\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"userSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"nodeSig\",\"type\":\"bytes\"}],\"indexed\":false,\"internalType\":\"structState\",\"name\":\"state\",\"type\":\"tuple\"}],\"name\":\"MigrationOutInitiated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TransferFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"node\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint8\",\"name\":\"validatorId\",\"type\":\"uint8\"},{\"indexed\":true,\"internalType\":\"contractISignatureValidator\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"ValidatorRegistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"wallet\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Withdrawn\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"collision\",\"type\":\"address\"}],\"name\":\"AddressCollision\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ChallengerVersionTooLow\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ECDSAInvalidSignature\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"length\",\"type\":\"uint256\"}],\"name\":\"ECDSAInvalidSignatureLength\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"ECDSAInvalidSignatureS\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"EmptySignature\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"IncorrectAmount\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"IncorrectChallengeDuration\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"IncorrectChannelId\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"IncorrectChannelStatus\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"IncorrectSignature\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"IncorrectStateIntent\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"IncorrectValue\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InsufficientBalance\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidAddress\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidValidatorId\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"NativeTransferFailed\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ReentrancyGuardReentrantCall\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"}],\"name\":\"SafeCastOverflowedIntToUint\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"}],\"name\":\"SafeERC20FailedOperation\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"node\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"validatorId\",\"type\":\"uint8\"}],\"name\":\"ValidatorAlreadyRegistered\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ValidatorNotApproved\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"node\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"validatorId\",\"type\":\"uint8\"}],\"name\":\"ValidatorNotRegistered\",\"type\":\"error\"}]",
+	Bin: "0x60a0346100aa57601f61608238819003918201601f19168301916001600160401b038311848410176100ae578084926020946040528339810103126100aa57516001600160a01b0381168082036100aa5760017f9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f00551561009b57608052604051615fbf90816100c382396080518181816111420152613f180152f35b63e6c4247b60e01b5f5260045ffd5b5f80fd5b634e487b7160e01b5f52604160045260245ffdfe60806040526004361015610011575f80fd5b5f3560e01c806316b390b11461024457806317536c061461023f578063187576d81461023a5780633115f6301461023557806338a66be21461023057806341b660ef1461022b57806347de477a146102265780635326919814610221578063587675e81461021c5780635a0745b4146102175780635b9acbf9146102125780635dc46a741461020d5780636840dbd2146102085780636898234b146102035780636af820bd146101fe57806371a47141146101f9578063735181f0146101f457806382d3e15d146101ef5780638d0b12a5146101ea57806394191051146101e55780639691b468146101e0578063a5c82680146101db578063b00b6fd6146101d6578063b25a1d38146101d1578063beed9d5f146101cc578063c74a2d10146101c7578063d888ccae146101c2578063dc23f29e146101bd578063dd73d494146101b8578063e617208c146101b3578063ecf3d7e8146101ae578063f4ac51f5146101a9578063f766f8d6146101a4578063ff5bc09e1461019f5763ffa1ad741461019a575f80fd5b612650565b612639565b61249a565b61241f565b61230d565b61226e565b6120f0565b611ef5565b611db5565b611b71565b6119d6565b6116c4565b61165b565b6114ba565b611379565b61135c565b6111cb565b6111ae565b611171565b61112d565b611112565b611026565b61100f565b610fc8565b610fa6565b610f8a565b610f44565b610cfc565b610b13565b610850565b6107ea565b61065e565b6105d8565b6104ca565b6102cb565b9181601f840112156102775782359167ffffffffffffffff8311610277576020838186019501011161027757565b5f80fd5b60643590600282101561027757565b90606060031983011261027757600435916024359067ffffffffffffffff8211610277576102ba91600401610249565b909160443560028110156102775790565b34610277576102d93661028a565b6103986102f1859493945f52600260205260405f2090565b9283546102ff81151561266b565b61035a600286019461032a61031b87546001600160a01b031690565b948560038a019a8b5492613eff565b9591600160068b019a019661034a88546001600160a01b039060081c1690565b926103548c6128a0565b8861405d565b60c061036588614161565b604051809581927f6666e4c000000000000000000000000000000000000000000000000000000000835260048301612a25565b038173__$682d6198b4eca5bc7e038b912a26498e7e$__5af4908115610488577fba075bd445233f7cad862c72f0343b3503aad9c8e704a2295f122b82abf8e80196610436956080955f9461044b575b50836104146104066104279697546001600160a01b039060081c1690565b92546001600160a01b031690565b9254936104208a6128a0565b908c61428d565b015167ffffffffffffffff1690565b9061044660405192839283612a41565b0390a2005b6104279450946104146104786104069760c03d60c011610481575b6104708183612707565b810190612950565b955050946103e8565b503d610466565b612a36565b6001600160a01b0381160361027757565b6003196060910112610277576004356104b68161048d565b906024356104c38161048d565b9060443590565b6001600160a01b036104db3661049e565b92909116906104eb821515612b9e565b6104f6831515612bcd565b815f52600660205261051c8160405f20906001600160a01b03165f5260205260405f2090565b80549184830180931161059a577f8752a472e571a816aea92eec8dae9baf628e840f4929fbcc2d155e6233ff68a7926001600160a01b03925561055d615810565b61056885823361458f565b60017f9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f00556040519485521692602090a3005b612bfc565b60206040818301928281528451809452019201905f5b8181106105c25750505090565b82518452602093840193909201916001016105b5565b34610277576020600319360112610277576001600160a01b036004356105fd8161048d565b165f52600160205260405f206040519081602082549182815201915f5260205f20905f5b818110610648576106448561063881870382612707565b6040519182918261059f565b0390f35b8254845260209093019260019283019201610621565b3461027757602060031936011261027757600354600480549190355f5b828410806107e1575b156107d4576106b06106a2610698866131a0565b90549060031b1c90565b5f52600260205260405f2090565b6001810160036106c1825460ff1690565b6106ca81611c00565b146107c2576106d882615b4d565b1561077e57915f8261076961077595600561076f96019261075a61075285549261073d600d61072b61071460028501546001600160a01b031690565b6001600160a01b03165f52600660205260405f2090565b92015460401c6001600160a01b031690565b6001600160a01b03165f5260205260405f2090565b918254612c10565b9055600360ff19825416179055565b556139c6565b936139c6565b915b919261067b565b505092905061078d9150600455565b8061079457005b6040519081527f61815f4b11c6ea4e14a2e448a010bed8efdc3e53a15efbf183d16a31085cd14590602090a1005b5050926107ce906139c6565b91610777565b92905061078d9150600455565b50818110610684565b34610277575f600319360112610277576020604051620186a08152f35b90816102609103126102775790565b90600319820160e081126102775760c0136102775760049160c4359067ffffffffffffffff82116102775761084d91600401610807565b90565b61085936610816565b906020820191600261086a84612c27565b61087381611c0f565b148015610af8575b8015610ada575b61088b90612c31565b61091a6108a061089b3685612c79565b614780565b916108aa8461483e565b60208401906108b882612ced565b956108d760408701976108ca89612ced565b608089013591858961494b565b60c0826108ff6108f86108ec6107148c612ced565b61073d60808501612ced565b54886149c5565b6040519687928392632a2d120f60e21b845260048401612f17565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af493841561048857610a156001600160a01b0394610a2d936109967fb00e209e275d0e1892f1982b34d3f545d1628aebd95322d7ce3585c558f638b498610a1b955f91610aab575b50610985368d612c79565b61098f368a61301c565b908c614b52565b6109c2896109bd6109a685612ced565b6001600160a01b03165f52600160205260405f2090565b615bde565b5060026109ce82612c27565b6109d781611c0f565b03610a325750877f6085f5128b19e0d3cc37524413de47259383f0f75265d5d66f4177869620669660405180610a0d89826130ca565b0390a2612ced565b97612ced565b918360405194859416981696836130db565b0390a4005b610a3d600391612c27565b610a4681611c0f565b03610a7b57877f188e0ade7d115cc397426774adb960ae3e8c83e72f0a6cad4b7085e1d60bf98660405180610a0d89826130ca565b877f567044ba1cdd4671ac3979c114241e1e3b56c9e9051f63f2f234f7a2795019cc60405180610a0d89826130ca565b610acd915060c03d60c011610ad3575b610ac58183612707565b810190612cf7565b5f61097a565b503d610abb565b5061088b610ae784612c27565b610af081611c0f565b159050610882565b506003610b0484612c27565b610b0d81611c0f565b1461087b565b610b1c36610816565b90610b3d6004610b2e60208501612c27565b610b3781611c0f565b14612c31565b610b4a61089b3683612c79565b9160208201610b5881612ced565b90610b7960408501926080610b6c85612ced565b960135958691868961494b565b610b8b610b858461316b565b86614c6c565b93610b9586614c9c565b15610bdd57505050610bd881610bcc7f471c4ebe4e57d25ef7117e141caac31c6b98f067b8098a7a7bbd38f637c2f9809386614cf9565b604051918291826130ca565b0390a3005b610c259060c085610bf18897959697614161565b60405194859283927fbbc42f3400000000000000000000000000000000000000000000000000000000845260048401613175565b038173__$682d6198b4eca5bc7e038b912a26498e7e$__5af48015610488577fede7867afa7cdb9c443667efd8244d98bf9df1dce68e60dc94dca6605125ca7695610bd895610c9a945f93610ca3575b50610c82610c8891612ced565b91612ced565b91610c93368761301c565b8a8a61428d565b610bcc846131ef565b610c88919350610cc4610c829160c03d60c011610481576104708183612707565b939150610c75565b90604060031983011261027757600435916024359067ffffffffffffffff82116102775761084d91600401610807565b3461027757610d0a36610ccc565b610d1b6009610b2e60208401612c27565b610d376001610d31845f525f60205260405f2090565b0161323d565b610dfd610d4e60208301516001600160a01b031690565b9161071460c060408301610d7a610d6c82516001600160a01b031690565b608086015190888a8c61494b565b610de2610ddb610dc4610d8d368b61301c565b9586946101408c018d8d610da08361316b565b67ffffffffffffffff1646149d8e610eb7575b50505050516001600160a01b031690565b6060840151602001516001600160a01b031661073d565b54896149c5565b6040519586928392632a2d120f60e21b8452600484016132c9565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af491821561048857610e2f935f93610e96575b5086614b52565b15610e65576104467f9a6f675cc94b83b55f1ecc0876affd4332a30c92e6faa2aca0199b1b6df922c391604051918291826130ca565b6104467f7b20773c41402791c5f18914dbbeacad38b1ebcc4c55d8eb3bfe0a4cde26c82691604051918291826130ca565b610eb091935060c03d60c011610ad357610ac58183612707565b915f610e28565b610edb610f1092610ecc610f15963690612f3c565b60608d01526060369101612f3c565b60808b0152610ee86132b5565b60a08b0152610ef56132b5565b8b8b01526001600160a01b03165f52600160205260405f2090565b615c88565b505f8d8d82610db3565b600319604091011261027757600435610f378161048d565b9060243561084d8161048d565b34610277576020610f816001600160a01b03610f5f36610f1f565b91165f526006835260405f20906001600160a01b03165f5260205260405f2090565b54604051908152f35b34610277575f600319360112610277576020604051612a308152f35b3461027757604060031936011261027757610644610638602435600435613373565b610fda610fd436610ccc565b9061342d565b005b60606003198201126102775760043591602435916044359067ffffffffffffffff82116102775761084d91600401610807565b3461027757610fda61102036610fdc565b9161378a565b34610277576020600319360112610277576001600160a01b0360043561104b8161048d565b165f52600160205261105f60405f20615b05565b5f905f5b81518110156110ff5761109161108a61107c838561335f565b515f525f60205260405f2090565b5460ff1690565b61109a816121c1565b600381141590816110ea575b506110b4575b600101611063565b916110c78184600193106110cf576139c6565b9290506110ac565b6110d9858561335f565b516110e4828661335f565b526139c6565b600591506110f7816121c1565b14155f6110a6565b506106449181526040519182918261059f565b34610277575f60031936011261027757602060405160408152f35b34610277575f600319360112610277576040517f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03168152602090f35b34610277576020610f816001600160a01b0361118c36610f1f565b91165f526008835260405f20906001600160a01b03165f5260205260405f2090565b34610277575f600319360112610277576020600454604051908152f35b34610277576112556111dc3661028a565b929391906111f2855f52600560205260405f2090565b9182549261120184151561266b565b600281019060a061122261121c84546001600160a01b031690565b8a615053565b604051809881927f24063eba000000000000000000000000000000000000000000000000000000008352600483016139d4565b038173__$b69fb814c294bfc16f92e50d7aeced4bde$__5af4958615610488575f9661132b575b50600181015460081c6001600160a01b0316968792546112a2906001600160a01b031690565b809581956003850154976112b7928992613eff565b9a9190946006019a6112c88c6128a0565b956112d3968b61405d565b846112dd876128a0565b6112e795896150bb565b6060015167ffffffffffffffff166040519182916113059183612a41565b037fb8568a1f475f3c76759a620e08a653d28348c5c09e2e0bc91d533339801fefd891a2005b61134e91965060a03d60a011611355575b6113468183612707565b8101906136bc565b945f61127c565b503d61133c565b34610277575f600319360112610277576020604051620151808152f35b61143661138536610ccc565b6113a661139760208395949501612c27565b6113a081611c0f565b15612c31565b6113bc6001610d31855f525f60205260405f2090565b9060c08161141b6114146108ec6107146113e060208901516001600160a01b031690565b6114078b8a60408101938960806113fe87516001600160a01b031690565b9301519361494b565b516001600160a01b031690565b54876149c5565b6040519586928392632a2d120f60e21b845260048401612f17565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af4928315610488577f567044ba1cdd4671ac3979c114241e1e3b56c9e9051f63f2f234f7a2795019cc9361044693610bcc925f92611499575b50611492368561301c565b9087614b52565b6114b391925060c03d60c011610ad357610ac58183612707565b905f611487565b34610277576114c836610816565b906114da6006610b2e60208501612c27565b6114e761089b3683612c79565b91602082016114f581612ced565b9061150960408501926080610b6c85612ced565b611515610b858461316b565b9361151f86614c9c565b1561155657505050610bd881610bcc7f587faad1bcd589ce902468251883e1976a645af8563c773eed7356d78433210c9386614cf9565b6115a59060a08561157261156c87989697612ced565b89615053565b60405194859283927eea54e700000000000000000000000000000000000000000000000000000000845260048401613773565b038173__$b69fb814c294bfc16f92e50d7aeced4bde$__5af48015610488577f17eb0a6bd5a0de45d1029ce3444941070e149df35b22176fc439f930f73c09f795610bd895610bcc945f93611614575b50610c8261160291612ced565b9161160d368761301c565b8a8a6150bb565b611602919350611635610c829160a03d60a011611355576113468183612707565b9391506115f5565b6024359060ff8216820361027757565b359060ff8216820361027757565b34610277576040600319360112610277576001600160a01b036116a96004356116838161048d565b8261168c61163d565b91165f52600760205260405f209060ff165f5260205260405f2090565b541660405180916001600160a01b0360208301911682520390f35b60806003193601126102775760043560243567ffffffffffffffff8111610277576116f3903690600401610807565b60443567ffffffffffffffff811161027757611713903690600401610249565b919061171d61027b565b9061172f855f525f60205260405f2090565b9161173c6001840161323d565b9161176661174b855460ff1690565b611754816121c1565b600181149081156119c2575b506139e5565b86611773600586016128a0565b916117b46117808861316b565b67ffffffffffffffff6117ab61179e875167ffffffffffffffff1690565b67ffffffffffffffff1690565b91161015613a14565b60208501516001600160a01b0316976117d760408701516001600160a01b031690565b9367ffffffffffffffff6117ff61179e6117f08c61316b565b935167ffffffffffffffff1690565b9116116118c3575b94611867889795857f07b9206d5a6026d3bd2a8f9a9b79f6fa4bfbd6a016975829fbaf07488019f28a9b6118616118859760149d61185561187c996118959c6118b49f60808c015192613eff565b9391949092369061301c565b9061405d565b845460ff191660021785555163ffffffff1690565b63ffffffff1690565b67ffffffffffffffff4216613a43565b9301805467ffffffffffffffff191667ffffffffffffffff8516179055565b61044660405192839283613a65565b909296959397946118df61190a9389888a60808601519361494b565b60c08761141b6119036108ec8c6001600160a01b03165f52600660205260405f2090565b548d6149c5565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af4938415610488577f07b9206d5a6026d3bd2a8f9a9b79f6fa4bfbd6a016975829fbaf07488019f28a99896118b4986118618e8c61185560149f976118679861187c9b61198a6118959f6118859f5f916119a3575b508d611983368961301c565b9089615443565b9a9f5050995050509750509b5095509597985050611807565b6119bc915060c03d60c011610ad357610ac58183612707565b5f611977565b600491506119cf816121c1565b145f611760565b34610277576080600319360112610277576004356119f38161048d565b6119fb61163d565b90604435611a088161048d565b60643567ffffffffffffffff811161027757611b4a6001600160a01b0392611b22611a6396611b07611b02611a4289973690600401610249565b60ff85169a91611afc90611a578d1515613a8d565b8b89169d8e1515612b9e565b611abf8785611ab9611aad611aad611aa085611a90866001600160a01b03165f52600760205260405f2090565b9060ff165f5260205260405f2090565b546001600160a01b031690565b6001600160a01b031690565b15613abc565b6040805160ff891660208201526001600160a01b038b169181019190915246606080830191909152815292611af5608085612707565b3691612fcb565b9061564c565b613b01565b611a90856001600160a01b03165f52600760205260405f2090565b906001600160a01b031673ffffffffffffffffffffffffffffffffffffffff19825416179055565b167f2366b94a706a0cfc2dca2fe8be9410b6fba2db75e3e9d3f03b3c2fb0b051efad5f80a4005b611b91611b7d36610ccc565b6113a66003610b2e60208496959601612c27565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af4928315610488577f188e0ade7d115cc397426774adb960ae3e8c83e72f0a6cad4b7085e1d60bf9869361044693610bcc925f926114995750611492368561301c565b634e487b7160e01b5f52602160045260245ffd5b60041115611c0a57565b611bec565b600a1115611c0a57565b90600a821015611c0a5752565b90601f19601f602080948051918291828752018686015e5f8582860101520116010190565b61084d9167ffffffffffffffff8251168152611c6f60208301516020830190611c19565b60408201516040820152611cdd6060830151606083019060c0809167ffffffffffffffff81511684526001600160a01b03602082015116602085015260ff6040820151166040850152606081015160608501526080810151608085015260a081015160a08501520151910152565b608082810151805167ffffffffffffffff1661014084015260208101516001600160a01b0316610160840152604081015160ff1661018084015260608101516101a0840152908101516101c083015260a08101516101e083015260c0015161020082015260c0611d5f60a0840151610260610220850152610260840190611c26565b92015190610240818403910152611c26565b929367ffffffffffffffff60c09561084d98979482948752611d9281611c00565b602087015216604085015216606083015260808201528160a08201520190611c4b565b3461027757602060031936011261027757600435611dd1613b66565b505f52600260205260405f20611de561272a565b9080548252610644600182015491611e31611e21611e038560ff1690565b94611e12602088019687613baa565b60081c6001600160a01b031690565b6001600160a01b03166040860152565b611e58611e4860028301546001600160a01b031690565b6001600160a01b03166060860152565b60038101546080850152600481015467ffffffffffffffff811660a086019081529490611e90905b60401c67ffffffffffffffff1690565b67ffffffffffffffff1660c0820190815291611ee46117f0611ec0600660058501549460e08701958652016128a0565b93610100810194855251965197611ed689611c00565b5167ffffffffffffffff1690565b905191519260405196879687611d71565b3461027757611f0336610816565b611f146008610b2e60208401612c27565b80611f89611f2561089b3686612c79565b936020810160c0611f3582612ced565b91611f546040850193611f4785612ced565b608087013591898c61494b565b610de2610ddb610dc4610714611f6a368b61301c565b9687958d8a611f7882614c9c565b9d8e15612052575b50505050612ced565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af491821561048857611fc6935f9361202d575b50611fc0903690612c79565b86614b52565b15611ffc576104467f3142fb397e715d80415dff7b527bf1c451def4675da6e1199ee1b4588e3f630a91604051918291826130ca565b6104467f26afbcb9eb52c21f42eb9cfe8f263718ffb65afbf84abe8ad8cce2acfb2242b891604051918291826130ca565b611fc091935061204b9060c03d60c011610ad357610ac58183612707565b9290611fb4565b6120aa936120876109a6926120696109bd9561483e565b8c606061207a366101408501612f3c565b9101526060369101612f3c565b60808c01526120946132b5565b60a08c01526120a16132b5565b8c8c0152612ced565b505f8d8a8e611f80565b9160a09367ffffffffffffffff9161084d97969385526120d381611c00565b602085015216604083015260608201528160808201520190611c4b565b346102775760206003193601126102775760043561210c613b66565b505f52600560205260405f2061212061273c565b908054825261064460018201549161213e611e21611e038560ff1690565b612155611e4860028301546001600160a01b031690565b60038101546080850152600481015467ffffffffffffffff1667ffffffffffffffff1660a08501908152936121b061219b600660058501549460c08501958652016128a0565b9160e0810192835251945195611ed687611c00565b9151905191604051958695866120b4565b60061115611c0a57565b906006821015611c0a5752565b9192612250610120946121f285612263959a99989a6121cb565b602085019060a0809163ffffffff81511684526001600160a01b0360208201511660208501526001600160a01b03604082015116604085015267ffffffffffffffff6060820151166060850152608081015160808501520151910152565b61014060e0840152610140830190611c4b565b946101008201520152565b34610277576020600319360112610277576004355f60a0604051612291816126ae565b82815282602082015282604082015282606082015282608082015201526122b6613b66565b505f525f6020526122c960405f20613bc2565b80516122d4816121c1565b61064460208301519260408101519060606122fd61179e608084015167ffffffffffffffff1690565b91015191604051958695866121d8565b346102775761231b3661049e565b90916123316001600160a01b0382161515612b9e565b61233c821515612bcd565b335f5260066020526123628360405f20906001600160a01b03165f5260205260405f2090565b54908282106123f75782820391821161059a578383916123b7936123b18361239b336001600160a01b03165f52600660205260405f2090565b906001600160a01b03165f5260205260405f2090565b55614d9d565b7fd1c19fbcd4551a5edfb66d43d2e337c04837afda3482b42bdf569a8fccdae5fb6001600160a01b0360405193169280610bd83394829190602083019252565b7ff4d678b8000000000000000000000000000000000000000000000000000000005f5260045ffd5b61243f61242b36610ccc565b6113a66002610b2e60208496959601612c27565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af4928315610488577f6085f5128b19e0d3cc37524413de47259383f0f75265d5d66f417786962066969361044693610bcc925f926114995750611492368561301c565b34610277576124a836610f1f565b6124b0615810565b6001600160a01b038116916124c6831515612b9e565b6001600160a01b036124ed8261239b336001600160a01b03165f52600860205260405f2090565b54916124fa831515612bcd565b5f61251a8261239b336001600160a01b03165f52600860205260405f2090565b55169181836125945761253d915f808080858a5af1612537613c20565b50613c4f565b60405190815233907f7b8d70738154be94a9a068a6d2f5dd8cfc65c52855859dc8f47de1ff185f8b5590602090a4610fda60017f9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f0055565b50506040517fa9059cbb000000000000000000000000000000000000000000000000000000005f52836004528160245260205f60448180875af160015f511481161561261a575b60409190915261253d577f5274afe7000000000000000000000000000000000000000000000000000000005f526001600160a01b03821660045260245ffd5b6001811516612630573d15843b151516166125db565b503d5f823e3d90fd5b3461027757610fda61264a36610fdc565b91613c90565b34610277575f60031936011261027757602060405160018152f35b1561267257565b7fc60f1e78000000000000000000000000000000000000000000000000000000005f5260045ffd5b634e487b7160e01b5f52604160045260245ffd5b60c0810190811067ffffffffffffffff8211176126ca57604052565b61269a565b60e0810190811067ffffffffffffffff8211176126ca57604052565b60a0810190811067ffffffffffffffff8211176126ca57604052565b90601f601f19910116810190811067ffffffffffffffff8211176126ca57604052565b6040519061273a61012083612707565b565b6040519061273a61010083612707565b6040519061273a60e083612707565b90604051612768816126cf565b60c0600482946127a660ff825467ffffffffffffffff811687526001600160a01b03808260401c1616602088015260e01c16604086019060ff169052565b6001810154606085015260028101546080850152600381015460a08501520154910152565b90600182811c921680156127f9575b60208310146127e557565b634e487b7160e01b5f52602260045260245ffd5b91607f16916127da565b5f9291815491612812836127cb565b8083529260018116908115612867575060011461282e57505050565b5f9081526020812093945091925b83831061284d575060209250010190565b60018160209294939454838587010152019101919061283c565b9050602094955060ff1991509291921683830152151560051b010190565b9061273a6128999260405193848092612803565b0383612707565b906040516128ad816126cf565b809260ff815467ffffffffffffffff8116845260401c1690600a821015611c0a57600d61291f9160c0936020860152600181015460408601526128f26002820161275b565b60608601526129036007820161275b565b6080860152612914600c8201612885565b60a086015201612885565b910152565b5190600482101561027757565b67ffffffffffffffff81160361027757565b5190811515820361027757565b908160c0910312610277576129b860a06040519261296d846126ae565b805184526020810151602085015261298760408201612924565b6040850152606081015161299a81612931565b606085015260808101516129ad81612931565b608085015201612943565b60a082015290565b9081516129cc81611c00565b815260a0806129ea602085015160c0602086015260c0850190611c4b565b936040810151604085015267ffffffffffffffff606082015116606085015267ffffffffffffffff6080820151166080850152015191015290565b90602061084d9281815201906129c0565b6040513d5f823e3d90fd5b92916020612b8d61273a9360408752612a75815467ffffffffffffffff811660408a015260ff60608a019160401c16611c19565b60018101546080880152600281015467ffffffffffffffff811660a0890152604081901c6001600160a01b031660c089015260e090811c60ff16908801526003810154610100880152600481015461012088015260058101546101408801526006810154610160880152600781015467ffffffffffffffff8116610180890152604081901c6001600160a01b03166101a089015260e01c60ff166101c088015260088101546101e08801526009810154610200880152600a810154610220880152600b81015461024088015261026080880152600d612b5b6102a08901600c8401612803565b917fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc0898403016102808a015201612803565b94019067ffffffffffffffff169052565b15612ba557565b7fe6c4247b000000000000000000000000000000000000000000000000000000005f5260045ffd5b15612bd457565b7f69640e72000000000000000000000000000000000000000000000000000000005f5260045ffd5b634e487b7160e01b5f52601160045260245ffd5b9190820180921161059a57565b600a111561027757565b3561084d81612c1d565b15612c3857565b7fc898513c000000000000000000000000000000000000000000000000000000005f5260045ffd5b63ffffffff81160361027757565b359061273a82612931565b91908260c091031261027757604051612c91816126ae565b60a08082948035612ca181612c60565b84526020810135612cb18161048d565b60208501526040810135612cc48161048d565b60408501526060810135612cd781612931565b6060850152608081013560808501520135910152565b3561084d8161048d565b908160c09103126102775760405190612d0f826126ae565b805182526020810151602083015260408101516006811015610277576129b89160a09160408501526060810151612d4581612931565b60608501526129ad60808201612943565b90612d628183516121cb565b608067ffffffffffffffff81612d87602086015160a0602087015260a0860190611c4b565b94604081015160408601526060810151606086015201511691015290565b359061273a82612c1d565b60c0809167ffffffffffffffff8135612dc881612931565b1684526001600160a01b036020820135612de18161048d565b16602085015260ff612df56040830161164d565b166040850152606081013560608501526080810135608085015260a081013560a08501520135910152565b9035601e198236030181121561027757016020813591019167ffffffffffffffff821161027757813603831361027757565b601f8260209493601f1993818652868601375f8582860101520116010190565b61084d9167ffffffffffffffff8235612e8a81612931565b168152612ea86020830135612e9e81612c1d565b6020830190611c19565b60408201356040820152612ec26060820160608401612db0565b612ed461014082016101408401612db0565b612f08612efc612ee8610220850185612e20565b610260610220860152610260850191612e52565b92610240810190612e20565b91610240818503910152612e52565b9091612f2e61084d93604084526040840190612d56565b916020818403910152612e72565b91908260e091031261027757604051612f54816126cf565b60c08082948035612f6481612931565b84526020810135612f748161048d565b6020850152612f856040820161164d565b6040850152606081013560608501526080810135608085015260a081013560a08501520135910152565b67ffffffffffffffff81116126ca57601f01601f191660200190565b929192612fd782612faf565b91612fe56040519384612707565b829481845281830111610277578281602093845f960137010152565b9080601f830112156102775781602061084d93359101612fcb565b919091610260818403126102775761303261274c565b9261303c82612c6e565b845261304a60208301612da5565b6020850152604082013560408501526130668160608401612f3c565b6060850152613079816101408401612f3c565b608085015261022082013567ffffffffffffffff8111610277578161309f918401613001565b60a085015261024082013567ffffffffffffffff8111610277576130c39201613001565b60c0830152565b90602061084d928181520190612e72565b60e09060a061084d949363ffffffff81356130f581612c60565b1683526001600160a01b03602082013561310e8161048d565b1660208401526001600160a01b03604082013561312a8161048d565b16604084015267ffffffffffffffff606082013561314781612931565b16606084015260808101356080840152013560a08201528160c08201520190612e72565b3561084d81612931565b9091612f2e61084d936040845260408401906129c0565b634e487b7160e01b5f52603260045260245ffd5b6003548110156131b85760035f5260205f2001905f90565b61318c565b80548210156131b8575f5260205f2001905f90565b916131eb9183549060031b91821b915f19901b19161790565b9055565b600354680100000000000000008110156126ca57600181016003556003548110156131b85760035f527fc2575a0e9e593c00f959f8c92f12db2869c3395a3b0502d05e2516446f71f85b0155565b9060405161324a816126ae565b60a0600382946001600160a01b03815463ffffffff8116865260201c1660208501526132a467ffffffffffffffff60018301546001600160a01b03808216166040880152851c16606086019067ffffffffffffffff169052565b600281015460808501520154910152565b604051906132c4602083612707565b5f8252565b90916132e061084d93604084526040840190612d56565b916020818403910152611c4b565b67ffffffffffffffff81116126ca5760051b60200190565b60405190613315602083612707565b5f808352366020840137565b9061332b826132ee565b6133386040519182612707565b828152601f1961334882946132ee565b0190602036910137565b9190820391821161059a57565b80518210156131b85760209160051b010190565b9190600354908084029380850482149015171561059a57818410156133f75783019081841161059a578082116133ef575b506133b76133b28483613352565b613321565b92805b8281106133c657505050565b806133d56106986001936131a0565b6133e86133e28584613352565b8861335f565b52016133ba565b90505f6133a4565b5050905061084d613306565b906006811015611c0a5760ff60ff198354169116179055565b90602061084d928181520190611c4b565b9061343f825f525f60205260405f2090565b61344b6001820161323d565b91613457825460ff1690565b9184613465600583016128a0565b91604086019261347c84516001600160a01b031690565b91600261349360208a01516001600160a01b031690565b9761349d816121c1565b1480613654575b6135995750506134e16108f86108ec6107146134fc9661140760c0978a978c898f6080906134d96001610b2e60208601612c27565b01519361494b565b6040519384928392632a2d120f60e21b845260048401612f17565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af4801561048857610f10613573946109a688937f04cd8c68bf83e7bc531ca5a5d75c34e36513c2acf81e07e6470ba79e29da13a898613566965f92613578575b5061355f368961301c565b9086614b52565b50604051918291826130ca565b0390a2565b61359291925060c03d60c011610ad357610ac58183612707565b905f613554565b7f04cd8c68bf83e7bc531ca5a5d75c34e36513c2acf81e07e6470ba79e29da13a89750613573969195506136479450610f10926135fe6014836135e66109a695600360ff19825416179055565b5f60138201550167ffffffffffffffff198154169055565b606087016136278151606061361d60208301516001600160a01b031690565b9101519086614d9d565b5160a061363e60208301516001600160a01b031690565b91015191614d9d565b506040519182918261341c565b5061366d61179e601483015467ffffffffffffffff1690565b42116134a4565b1561367b57565b7fdb1ea1ac000000000000000000000000000000000000000000000000000000005f5260045ffd5b906136ad81611c00565b60ff60ff198354169116179055565b908160a0910312610277576137116080604051926136d9846126eb565b80518452602081015160208501526136f360408201612924565b6040850152606081015161370681612931565b606085015201612943565b608082015290565b90815161372581611c00565b815260806001600160a01b038161374b602086015160a0602087015260a0860190611c4b565b946040810151604086015267ffffffffffffffff606082015116606086015201511691015290565b9091612f2e61084d93604084526040840190613719565b9161379483614c9c565b613959576137aa825f52600560205260405f2090565b6137b684825414613674565b60018101918254916137d2836001600160a01b039060081c1690565b9360026137f26137eb828501546001600160a01b031690565b9560ff1690565b6137fb81611c00565b1480613939575b6138bf5750600361383b9161381e6007610b2e60208701612c27565b019261382e84548287868b61494b565b60a0836115728389615053565b038173__$b69fb814c294bfc16f92e50d7aeced4bde$__5af4908115610488577f2fdac1380dbe23ae259b6871582b7f33e34461547f400bdd20d74991250317d19561389995610bcc945f9461389e575b50549261160d368761301c565b0390a3565b6138b891945060a03d60a011611355576113468183612707565b925f61388c565b7f2fdac1380dbe23ae259b6871582b7f33e34461547f400bdd20d74991250317d1945090613899936138fb610bcc93600360ff19825416179055565b613933600d60058401935f855495556139226004820167ffffffffffffffff198154169055565b015460401c6001600160a01b031690565b90614d9d565b5061395261179e600484015467ffffffffffffffff1690565b4211613802565b6138997f6d0cf3d243d63f08f50db493a8af34b27d4e3bc9ec4098e82700abfeffe2d49891610bcc613992865f525f60205260405f2090565b6139be60026139af60018401546001600160a01b039060201c1690565b9201546001600160a01b031690565b908388615025565b5f19811461059a5760010190565b90602061084d928181520190613719565b156139ec57565b7ff2056b18000000000000000000000000000000000000000000000000000000005f5260045ffd5b15613a1b57565b7f7d957361000000000000000000000000000000000000000000000000000000005f5260045ffd5b9067ffffffffffffffff8091169116019067ffffffffffffffff821161059a57565b9067ffffffffffffffff613a86602092959495604085526040850190612e72565b9416910152565b15613a9457565b7f06ee4dcd000000000000000000000000000000000000000000000000000000005f5260045ffd5b15613ac5575050565b906001600160a01b0360ff927f0bcc40f3000000000000000000000000000000000000000000000000000000005f52166004521660245260445ffd5b15613b0857565b7fc1606c2f000000000000000000000000000000000000000000000000000000005f5260045ffd5b60405190613b3d826126cf565b5f60c0838281528260208201528260408201528260608201528260808201528260a08201520152565b60405190613b73826126cf565b606060c0835f81525f60208201525f6040820152613b8f613b30565b83820152613b9b613b30565b60808201528260a08201520152565b613bb382611c00565b52565b6006821015611c0a5752565b90604051613bcf816126eb565b608067ffffffffffffffff60148395613bec60ff82541686613bb6565b613bf86001820161323d565b6020860152613c09600582016128a0565b604086015260138101546060860152015416910152565b3d15613c4a573d90613c3182612faf565b91613c3f6040519384612707565b82523d5f602084013e565b606090565b15613c58575050565b6001600160a01b03907fa5b05eec000000000000000000000000000000000000000000000000000000005f521660045260245260445ffd5b91613c9a83614c9c565b613e8157613cb0825f52600260205260405f2090565b613cbc84825414613674565b6001810191825491613cd8836001600160a01b039060081c1690565b936002613cf16137eb828501546001600160a01b031690565b613cfa81611c00565b1480613e5e575b613de457506003613d6591613d1d6005610b2e60208701612c27565b0192613d2d84548287868b61494b565b60c083610bf1613d5e613d51856001600160a01b03165f52600660205260405f2090565b61073d6101608501612ced565b5489614206565b038173__$682d6198b4eca5bc7e038b912a26498e7e$__5af4908115610488577f1b92e8ef67d8a7c0d29c99efcd180a5e0d98d60ac41d52abbbb5950882c78e4e9561389995610bcc945f94613dc3575b505492610c93368761301c565b613ddd91945060c03d60c011610481576104708183612707565b925f613db6565b7f1b92e8ef67d8a7c0d29c99efcd180a5e0d98d60ac41d52abbbb5950882c78e4e94509061389993613e20610bcc93600360ff19825416179055565b613933600d60058401935f85549555613922600482017fffffffffffffffffffffffffffffffff0000000000000000ffffffffffffffff8154169055565b506004820154613e7a9060401c67ffffffffffffffff1661179e565b4211613d01565b6138997f32e24720f56fd5a7f4cb219d7ff3278ae95196e79c85b5801395894a6f53466c91610bcc613992865f525f60205260405f2090565b15613ec3575050565b906001600160a01b0360ff927f577f5940000000000000000000000000000000000000000000000000000000005f52166004521660245260445ffd5b93929190918215613fd157843560f81c9081613f4c57507f000000000000000000000000000000000000000000000000000000000000000094600101925f19019150613f489050565b9091565b600180915f97939594975060ff86161c1603613fa957613f9d83613f8b611aa0613f4896611a908a6001600160a01b03165f52600760205260405f2090565b966001600160a01b0388161515613eba565b600101915f1990910190565b7f1a9073b4000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fac241e11000000000000000000000000000000000000000000000000000000005f5260045ffd5b805191908290602001825e015f815290565b60021115611c0a57565b90816020910312610277575190565b939260609361404f6001600160a01b0394613a86949998998852608060208901526080880190611c26565b918683036040880152612e52565b906001600160a01b03929560209761409195996140c861407f61410d95615887565b6140ba604051998a928e840190613ff9565b7f6368616c6c656e67650000000000000000000000000000000000000000000000815260090190565b03601f198101895288612707565b6140d18161400b565b61415a57505b604051988997889687957f600109bb00000000000000000000000000000000000000000000000000000000875260048701614024565b0392165afa80156104885761273a915f9161412b575b501515613b01565b61414d915060203d602011614153575b6141458183612707565b810190614015565b5f614123565b503d61413b565b90506140d7565b5f6040519161416f836126ae565b81835261420260208401614181613b66565b81526141f46040860191858352611e806004606089019288845260808a01958987526141bc60a08c01998b8b525f52600260205260405f2090565b9160ff6001840154166141ce81611c00565b8c526141dc600684016128a0565b90526005820154905201549167ffffffffffffffff83165b67ffffffffffffffff169052565b5290565b9060405191614214836126ae565b5f835261420260208401614226613b66565b81526141f460408601915f8352611e80600460608901925f845260808a01955f87526141bc60a08c01995f8b525f52600260205260405f2090565b7f8000000000000000000000000000000000000000000000000000000000000000811461059a575f0390565b6020939291614329919796976142ab815f52600260205260405f2090565b976040860180516142bb81611c00565b6142c481611c00565b61450a575b5089888660a08901956142dc8751151590565b6144f6575b5050505050506142fc606085015167ffffffffffffffff1690565b67ffffffffffffffff81166144cb575b50608084015167ffffffffffffffff168061447c575b5051151590565b1561446357608001518201516001600160a01b031680935b8251905f82131561442357614363915061435b8451615ad0565b928391614527565b61437260058601918254612c10565b90555b0180515f8113156143d15750916143b060059261239b6143986143c69651615ad0565b966001600160a01b03165f52600660205260405f2090565b6143bb858254613352565b905501918254612c10565b90555b61273a61467e565b9290505f83126143e5575b505050506143c9565b61440260059261239b6143986143fd61441897614261565b615ad0565b61440d858254612c10565b905501918254613352565b90555f8080806143dc565b5f8212614433575b505050614375565b6144426143fd61444a93614261565b928391614d9d565b61445960058601918254613352565b9055825f8061442b565b50600d84015460401c6001600160a01b03168093614341565b6144c59060048901907fffffffffffffffffffffffffffffffff0000000000000000ffffffffffffffff6fffffffffffffffff000000000000000083549260401b169116179055565b5f614322565b6144f090600489019067ffffffffffffffff1667ffffffffffffffff19825416179055565b5f61430c565b6144ff956159a2565b5f80898886836142e1565b614521905161451881611c00565b60018b016136a3565b5f6142c9565b9061453a9291614535615810565b61458f565b60017f9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f0055565b1561456757565b7fd2ade556000000000000000000000000000000000000000000000000000000005f5260045ffd5b908215614679576001600160a01b0316918215801561466a576145b3823414614560565b156145bd57505050565b6001600160a01b03604051927f23b872dd000000000000000000000000000000000000000000000000000000005f52166004523060245260445260205f60648180865af160015f5114811615614654575b6040919091525f606052156146205750565b7f5274afe7000000000000000000000000000000000000000000000000000000005f526001600160a01b031660045260245ffd5b6001811516612630573d15833b1515161661460e565b6146743415614560565b6145b3565b505050565b6003546004545f5b82821080614776575b1561476b576146a36106a2610698846131a0565b6001810160036146b4825460ff1690565b6146bd81611c00565b14614759576146cb82615b4d565b1561471657915f8261076961470d95600561470796019261075a61075285549261073d600d61072b61071460028501546001600160a01b031690565b916139c6565b915b9190614686565b5050915061472390600455565b8061472b5750565b6040519081527f61815f4b11c6ea4e14a2e448a010bed8efdc3e53a15efbf183d16a31085cd14590602090a1565b505090614765906139c6565b9161470f565b915061472390600455565b506040811061468f565b7effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f01000000000000000000000000000000000000000000000000000000000000009160405161482760208201809360a0809163ffffffff81511684526001600160a01b0360208201511660208501526001600160a01b03604082015116604085015267ffffffffffffffff6060820151166060850152608081015160808501520151910152565b60c0815261483660e082612707565b519020161790565b602081013561484c8161048d565b6001600160a01b03811690614862821515612b9e565b6040830135906148718261048d565b6148986001600160a01b0383169261488a841515612b9e565b6148938361048d565b61048d565b6148a18161048d565b5081146148ed575063ffffffff6201518091356148bd81612c60565b16106148c557565b7f0596b15b000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fabfa558d000000000000000000000000000000000000000000000000000000005f5260045260245ffd5b903590601e1981360301821215610277570180359067ffffffffffffffff82116102775760200191813603831361027757565b929161273a9461497c61498b92614971838761496b610220890189614918565b90613eff565b90878a949394615b81565b8361496b610240850185614918565b92909194615b81565b604051906149a1826126eb565b5f6080838281526149b0613b66565b60208201528260408201528260608201520152565b90601467ffffffffffffffff916149da614994565b935f525f60205260405f20906149f460ff83541686613bb6565b614a00600583016128a0565b6020860152601382015460408601526060850152015416608082015290565b9060a060039163ffffffff8151167fffffffffffffffffffffffffffffffffffffffffffffffffffffffff000000008554161784556001600160a01b036020820151167fffffffffffffffff0000000000000000000000000000000000000000ffffffff77ffffffffffffffffffffffffffffffffffffffff0000000086549260201b169116178455614b4160018501614aef614ac660408501516001600160a01b031690565b82906001600160a01b031673ffffffffffffffffffffffffffffffffffffffff19825416179055565b606083015181547fffffffff0000000000000000ffffffffffffffffffffffffffffffffffffffff1660a09190911b7bffffffffffffffff000000000000000000000000000000000000000016179055565b608081015160028501550151910155565b92614b8e81614bde9460a094614b6f885f525f60205260405f2090565b97614b7b895460ff1690565b614b84816121c1565b15614c5a57615443565b604081018051614b9d816121c1565b614ba6816121c1565b151580614c2f575b614c15575b50601484018054606083015167ffffffffffffffff9081169116819003614bed575b50500151151590565b614be55750565b60135f910155565b614c0e919067ffffffffffffffff1667ffffffffffffffff19825416179055565b5f80614bd5565b614c299051614c23816121c1565b85613403565b5f614bb3565b50845460ff16815190614c41826121c1565b614c4a826121c1565b614c53816121c1565b1415614bae565b614c678260018b01614a1f565b615443565b9067ffffffffffffffff604051916020830193845216604082015260408152614c96606082612707565b51902090565b805f525f60205260ff60405f2054166006811015611c0a578015908115614ce5575b50614ce0575f525f60205267ffffffffffffffff600760405f20015416461490565b505f90565b60059150614cf2816121c1565b145f614cbe565b90614d3b91614d146001610d31835f525f60205260405f2090565b60c0836108ff614d346108ec61071460408701516001600160a01b031690565b54856149c5565b038173__$c00a153e45d4e7ce60e0acf48b0547b51a$__5af49283156104885761273a945f94614d78575b50614d7290369061301c565b91614b52565b614d72919450614d969060c03d60c011610ad357610ac58183612707565b9390614d66565b9061453a9291614dab615810565b9190918115614679576001600160a01b0383169283614e4f576001600160a01b038216925f8080808488620186a0f1614de2613c20565b5015614def575050505050565b614e326138999261239b7fbf182be802245e8ed88e4b8d3e4344c0863dd2a70334f089fd07265389306fcf956001600160a01b03165f52600860205260405f2090565b614e3d828254612c10565b90556040519081529081906020820190565b6040517f70a08231000000000000000000000000000000000000000000000000000000008152306004820152602081602481885afa908115610488575f91615006575b506040517fa9059cbb00000000000000000000000000000000000000000000000000000000602082019081526001600160a01b0385166024830152604480830187905282525f91829190614ee7606482612707565b51908286620186a0f190614ef9613c20565b506040517f70a082310000000000000000000000000000000000000000000000000000000081523060048201526020816024818a5afa9182156104885786915f93614fe5575b5083614fda575b83614fc6575b50505015614f5b575b50505050565b81614fa46001600160a01b039261239b7fbf182be802245e8ed88e4b8d3e4344c0863dd2a70334f089fd07265389306fcf956001600160a01b03165f52600860205260405f2090565b614faf858254612c10565b90556040519384521691602090a35f808080614f55565b614fd1929350613352565b145f8481614f4c565b818110159350614f46565b614fff91935060203d602011614153576141458183612707565b915f614f3f565b61501f915060203d602011614153576141458183612707565b5f614e92565b909192614d14614d3b946150456001610d31865f525f60205260405f2090565b92608084015191868661494b565b906001600160a01b0390615065614994565b925f52600560205267ffffffffffffffff600460405f2060ff60018201541661508d81611c00565b865261509b600682016128a0565b602087015260058101546040870152015416606084015216608082015290565b6020939291614329919796976150d9815f52600560205260405f2090565b976040860180516150e981611c00565b6150f281611c00565b615179575b50898886608089019561510a8751151590565b615165575b50505050505061512a606085015167ffffffffffffffff1690565b67ffffffffffffffff8116615140575051151590565b6144c590600489019067ffffffffffffffff1667ffffffffffffffff19825416179055565b61516e95615d2e565b5f808988868361510f565b615187905161451881611c00565b5f6150f7565b90600a811015611c0a577fffffffffffffffffffffffffffffffffffffffffffffff00ffffffffffffffff68ff000000000000000083549260401b169116179055565b9060c060049161520367ffffffffffffffff825116859067ffffffffffffffff1667ffffffffffffffff19825416179055565b602081015184546040808401517fffffff000000000000000000000000000000000000000000ffffffffffffffff90921692901b7bffffffffffffffffffffffffffffffffffffffff0000000000000000169190911760e09190911b7cff0000000000000000000000000000000000000000000000000000000016178455606081015160018501556080810151600285015560a081015160038501550151910155565b601f82116152b357505050565b5f5260205f20906020601f840160051c830193106152eb575b601f0160051c01905b8181106152e0575050565b5f81556001016152d5565b90915081906152cc565b919091825167ffffffffffffffff81116126ca5761531d8161531784546127cb565b846152a6565b6020601f82116001146153585781906131eb9394955f9261534d575b50508160011b915f199060031b1c19161790565b015190505f80615339565b601f1982169061536b845f5260205f2090565b915f5b8181106153a55750958360019596971061538d575b505050811b019055565b01515f1960f88460031b161c191690555f8080615383565b9192602060018192868b01518155019401920161536e565b8151815467ffffffffffffffff191667ffffffffffffffff91909116178155602082015191600a831015611c0a5760c0600d916153fd61273a958561518d565b604081015160018501556154186060820151600286016151d0565b6154296080820151600786016151d0565b61543a60a0820151600c86016152f5565b015191016152f5565b6154596060919493945f525f60205260405f2090565b936154676080850151151590565b61563a575b01916154bf60a06154886020865101516001600160a01b031690565b9280515f81136155fc575b506020810180515f81136155b4575b5081515f8112615573575b50515f8112615528575b500151151590565b8061551a575b6154d6575b5050505061273a61467e565b61550f9261550260a0926154f6604060139601516001600160a01b031690565b90848451015191614d9d565b5101519201918254613352565b90555f8080806154ca565b5060a08351015115156154c5565b6143fd61553491614261565b61554f8561239b61071460408a01516001600160a01b031690565b61555a828254612c10565b905561556b60138901918254613352565b90555f6154b7565b6143fd61557f91614261565b61559d818761559860208b01516001600160a01b031690565b614d9d565b6155ac60138a01918254613352565b90555f6154ad565b6155bd90615ad0565b6155d88661239b61071460408b01516001600160a01b031690565b6155e3828254613352565b90556155f460138a01918254612c10565b90555f6154a2565b61560590615ad0565b615623818661561e60208a01516001600160a01b031690565b614527565b61563260138901918254612c10565b90555f615493565b61564781600587016153bd565b61546c565b805192835f947a184f03e93ff9f4daa797ed6e38ed64bf6a1f0100000000000000008210156157e8575b806d04ee2d6d415b85acef8100000000600a9210156157cc575b662386f26fc100008110156157b7575b6305f5e1008110156157a5575b612710811015615795575b6064811015615786575b101561577b575b61571260216156da60018801615ddf565b968701015b5f1901917f3031323334353637383961626364656600000000000000000000000000000000600a82061a8353600a900490565b90811561572257615712906156df565b50506001600160a01b036157478461573b858498615d73565b60208151910120615dc9565b9116931683146157735761576591816020611aad9351910120615dc9565b1461576e575f90565b600190565b505050600190565b6001909401936156c9565b600290606490049601956156c2565b60049061271090049601956156b8565b6008906305f5e10090049601956156ad565b601090662386f26fc1000090049601956156a0565b6020906d04ee2d6d415b85acef81000000009004960195615690565b50604094507a184f03e93ff9f4daa797ed6e38ed64bf6a1f0100000000000000008104615676565b60027f9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f00541461585f5760027f9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f0055565b7f3ee5aeb5000000000000000000000000000000000000000000000000000000005f5260045ffd5b67ffffffffffffffff815116906020810151600a811015611c0a576159308260406159919401516158cf60806060840151930151946040519760208901526040880190611c19565b6060860152608085019060c0809167ffffffffffffffff81511684526001600160a01b03602082015116602085015260ff6040820151166040850152606081015160608501526080810151608085015260a081015160a08501520151910152565b805167ffffffffffffffff1661016084015260208101516001600160a01b0316610180840152604081015160ff166101a084015260608101516101c084015260808101516101e084015260a081015161020084015260c00151610220830152565b610220815261084d61024082612707565b93909291935f52600260205260405f2092835560068301936159e767ffffffffffffffff825116869067ffffffffffffffff1667ffffffffffffffff19825416179055565b602081015192600a841015611c0a57615a5660c0615aa093615a0e615acc9760039a61518d565b60408101516007890155615a29606082015160088a016151d0565b615a3a6080820151600d8a016151d0565b615a4b60a082015160128a016152f5565b0151601387016152f5565b60018501907fffffffffffffffffffffff0000000000000000000000000000000000000000ff74ffffffffffffffffffffffffffffffffffffffff0083549260081b169116179055565b60028301906001600160a01b031673ffffffffffffffffffffffffffffffffffffffff19825416179055565b0155565b5f8112615ada5790565b7fa8ce4432000000000000000000000000000000000000000000000000000000005f5260045260245ffd5b90604051918281549182825260208201905f5260205f20925f5b818110615b3457505061273a92500383612707565b8454835260019485019487945060209093019201615b1f565b67ffffffffffffffff6004820154164210159081615b69575090565b600180925060ff91015416615b7d81611c00565b1490565b6001600160a01b039061410d615ba7615ba26020989599969799369061301c565b615887565b93604051988997889687957f600109bb00000000000000000000000000000000000000000000000000000000875260048701614024565b6001810190825f528160205260405f2054155f14615c46578054680100000000000000008110156126ca57615c33615c1d8260018794018555846131bd565b819391549060031b91821b915f19901b19161790565b905554915f5260205260405f2055600190565b5050505f90565b80548015615c74575f190190615c6382826131bd565b8154905f199060031b1b1916905555565b634e487b7160e01b5f52603160045260245ffd5b6001810191805f528260205260405f2054928315155f14615d26575f19840184811161059a5783545f1981019490851161059a575f958583615ce397615cd69503615ce9575b505050615c4d565b905f5260205260405f2090565b55600190565b615d0f615d0991615d00610698615d1d95886131bd565b928391876131bd565b906131d2565b85905f5260205260405f2090565b555f8080615cce565b505050505f90565b93909291935f52600560205260405f2092835560068301936159e767ffffffffffffffff825116869067ffffffffffffffff1667ffffffffffffffff19825416179055565b61273a90615dbb615db594936040519586937f19457468657265756d205369676e6564204d6573736167653a0a0000000000006020860152603a850190613ff9565b90613ff9565b03601f198101845283612707565b61084d91615dd691615e06565b90929192615e40565b90615de982612faf565b615df66040519182612707565b828152601f196133488294612faf565b8151919060418303615e3657615e2f9250602082015190606060408401519301515f1a90615f07565b9192909190565b50505f9160029190565b615e4981611c00565b80615e52575050565b615e5b81611c00565b60018103615e8b577ff645eedf000000000000000000000000000000000000000000000000000000005f5260045ffd5b615e9481611c00565b60028103615ec857507ffce698f7000000000000000000000000000000000000000000000000000000005f5260045260245ffd5b80615ed4600392611c00565b14615edc5750565b7fd78bce0c000000000000000000000000000000000000000000000000000000005f5260045260245ffd5b91907f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a08411615f7e579160209360809260ff5f9560405194855216868401526040830152606082015282805260015afa15610488575f516001600160a01b03811615615f7457905f905f90565b505f906001905f90565b5050505f916003919056fea2646970667358221220a1d82448e7e3f611b69660be3f5dc6e070ca23bb9e11aefc6fc6b7622d1cdc4e64736f6c634300081e0033",
 }
 
 // ChannelHubABI is the input ABI used to generate the binding from.
@@ -84,6 +258,15 @@ func DeployChannelHub(auth *bind.TransactOpts, backend bind.ContractBackend, _de
 		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
 	}
 
+	escrowDepositEngineAddr, _, _, _ := DeployEscrowDepositEngine(auth, backend)
+	ChannelHubBin = strings.ReplaceAll(ChannelHubBin, "__$682d6198b4eca5bc7e038b912a26498e7e$__", escrowDepositEngineAddr.String()[2:])
+
+	escrowWithdrawalEngineAddr, _, _, _ := DeployEscrowWithdrawalEngine(auth, backend)
+	ChannelHubBin = strings.ReplaceAll(ChannelHubBin, "__$b69fb814c294bfc16f92e50d7aeced4bde$__", escrowWithdrawalEngineAddr.String()[2:])
+
+	channelEngineAddr, _, _, _ := DeployChannelEngine(auth, backend)
+	ChannelHubBin = strings.ReplaceAll(ChannelHubBin, "__$c00a153e45d4e7ce60e0acf48b0547b51a$__", channelEngineAddr.String()[2:])
+
 	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ChannelHubBin), backend, _defaultSigValidator)
 	if err != nil {
 		return common.Address{}, nil, nil, err
@@ -188,11 +371,11 @@ func NewChannelHubFilterer(address common.Address, filterer bind.ContractFiltere
 
 // bindChannelHub binds a generic wrapper to an already deployed contract.
 func bindChannelHub(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
-	parsed, err := abi.JSON(strings.NewReader(ChannelHubABI))
+	parsed, err := ChannelHubMetaData.GetAbi()
 	if err != nil {
 		return nil, err
 	}
-	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
 }
 
 // Call invokes the (constant) contract method with params as input values and
@@ -1010,46 +1193,46 @@ func (_ChannelHub *ChannelHubTransactorSession) DepositToVault(node common.Addre
 	return _ChannelHub.Contract.DepositToVault(&_ChannelHub.TransactOpts, node, token, amount)
 }
 
-// FinalizeEscrowDeposit is a paid mutator transaction binding the contract method 0x13c380ed.
+// FinalizeEscrowDeposit is a paid mutator transaction binding the contract method 0xff5bc09e.
 //
-// Solidity: function finalizeEscrowDeposit(bytes32 escrowId, (uint64,uint8,bytes32,(uint64,address,uint8,uint256,int256,uint256,int256),(uint64,address,uint8,uint256,int256,uint256,int256),bytes,bytes) candidate) returns()
-func (_ChannelHub *ChannelHubTransactor) FinalizeEscrowDeposit(opts *bind.TransactOpts, escrowId [32]byte, candidate State) (*types.Transaction, error) {
-	return _ChannelHub.contract.Transact(opts, "finalizeEscrowDeposit", escrowId, candidate)
+// Solidity: function finalizeEscrowDeposit(bytes32 channelId, bytes32 escrowId, (uint64,uint8,bytes32,(uint64,address,uint8,uint256,int256,uint256,int256),(uint64,address,uint8,uint256,int256,uint256,int256),bytes,bytes) candidate) returns()
+func (_ChannelHub *ChannelHubTransactor) FinalizeEscrowDeposit(opts *bind.TransactOpts, channelId [32]byte, escrowId [32]byte, candidate State) (*types.Transaction, error) {
+	return _ChannelHub.contract.Transact(opts, "finalizeEscrowDeposit", channelId, escrowId, candidate)
 }
 
-// FinalizeEscrowDeposit is a paid mutator transaction binding the contract method 0x13c380ed.
+// FinalizeEscrowDeposit is a paid mutator transaction binding the contract method 0xff5bc09e.
 //
-// Solidity: function finalizeEscrowDeposit(bytes32 escrowId, (uint64,uint8,bytes32,(uint64,address,uint8,uint256,int256,uint256,int256),(uint64,address,uint8,uint256,int256,uint256,int256),bytes,bytes) candidate) returns()
-func (_ChannelHub *ChannelHubSession) FinalizeEscrowDeposit(escrowId [32]byte, candidate State) (*types.Transaction, error) {
-	return _ChannelHub.Contract.FinalizeEscrowDeposit(&_ChannelHub.TransactOpts, escrowId, candidate)
+// Solidity: function finalizeEscrowDeposit(bytes32 channelId, bytes32 escrowId, (uint64,uint8,bytes32,(uint64,address,uint8,uint256,int256,uint256,int256),(uint64,address,uint8,uint256,int256,uint256,int256),bytes,bytes) candidate) returns()
+func (_ChannelHub *ChannelHubSession) FinalizeEscrowDeposit(channelId [32]byte, escrowId [32]byte, candidate State) (*types.Transaction, error) {
+	return _ChannelHub.Contract.FinalizeEscrowDeposit(&_ChannelHub.TransactOpts, channelId, escrowId, candidate)
 }
 
-// FinalizeEscrowDeposit is a paid mutator transaction binding the contract method 0x13c380ed.
+// FinalizeEscrowDeposit is a paid mutator transaction binding the contract method 0xff5bc09e.
 //
-// Solidity: function finalizeEscrowDeposit(bytes32 escrowId, (uint64,uint8,bytes32,(uint64,address,uint8,uint256,int256,uint256,int256),(uint64,address,uint8,uint256,int256,uint256,int256),bytes,bytes) candidate) returns()
-func (_ChannelHub *ChannelHubTransactorSession) FinalizeEscrowDeposit(escrowId [32]byte, candidate State) (*types.Transaction, error) {
-	return _ChannelHub.Contract.FinalizeEscrowDeposit(&_ChannelHub.TransactOpts, escrowId, candidate)
+// Solidity: function finalizeEscrowDeposit(bytes32 channelId, bytes32 escrowId, (uint64,uint8,bytes32,(uint64,address,uint8,uint256,int256,uint256,int256),(uint64,address,uint8,uint256,int256,uint256,int256),bytes,bytes) candidate) returns()
+func (_ChannelHub *ChannelHubTransactorSession) FinalizeEscrowDeposit(channelId [32]byte, escrowId [32]byte, candidate State) (*types.Transaction, error) {
+	return _ChannelHub.Contract.FinalizeEscrowDeposit(&_ChannelHub.TransactOpts, channelId, escrowId, candidate)
 }
 
-// FinalizeEscrowWithdrawal is a paid mutator transaction binding the contract method 0x7e7985f9.
+// FinalizeEscrowWithdrawal is a paid mutator transaction binding the contract method 0x6840dbd2.
 //
-// Solidity: function finalizeEscrowWithdrawal(bytes32 escrowId, (uint64,uint8,bytes32,(uint64,address,uint8,uint256,int256,uint256,int256),(uint64,address,uint8,uint256,int256,uint256,int256),bytes,bytes) candidate) returns()
-func (_ChannelHub *ChannelHubTransactor) FinalizeEscrowWithdrawal(opts *bind.TransactOpts, escrowId [32]byte, candidate State) (*types.Transaction, error) {
-	return _ChannelHub.contract.Transact(opts, "finalizeEscrowWithdrawal", escrowId, candidate)
+// Solidity: function finalizeEscrowWithdrawal(bytes32 channelId, bytes32 escrowId, (uint64,uint8,bytes32,(uint64,address,uint8,uint256,int256,uint256,int256),(uint64,address,uint8,uint256,int256,uint256,int256),bytes,bytes) candidate) returns()
+func (_ChannelHub *ChannelHubTransactor) FinalizeEscrowWithdrawal(opts *bind.TransactOpts, channelId [32]byte, escrowId [32]byte, candidate State) (*types.Transaction, error) {
+	return _ChannelHub.contract.Transact(opts, "finalizeEscrowWithdrawal", channelId, escrowId, candidate)
 }
 
-// FinalizeEscrowWithdrawal is a paid mutator transaction binding the contract method 0x7e7985f9.
+// FinalizeEscrowWithdrawal is a paid mutator transaction binding the contract method 0x6840dbd2.
 //
-// Solidity: function finalizeEscrowWithdrawal(bytes32 escrowId, (uint64,uint8,bytes32,(uint64,address,uint8,uint256,int256,uint256,int256),(uint64,address,uint8,uint256,int256,uint256,int256),bytes,bytes) candidate) returns()
-func (_ChannelHub *ChannelHubSession) FinalizeEscrowWithdrawal(escrowId [32]byte, candidate State) (*types.Transaction, error) {
-	return _ChannelHub.Contract.FinalizeEscrowWithdrawal(&_ChannelHub.TransactOpts, escrowId, candidate)
+// Solidity: function finalizeEscrowWithdrawal(bytes32 channelId, bytes32 escrowId, (uint64,uint8,bytes32,(uint64,address,uint8,uint256,int256,uint256,int256),(uint64,address,uint8,uint256,int256,uint256,int256),bytes,bytes) candidate) returns()
+func (_ChannelHub *ChannelHubSession) FinalizeEscrowWithdrawal(channelId [32]byte, escrowId [32]byte, candidate State) (*types.Transaction, error) {
+	return _ChannelHub.Contract.FinalizeEscrowWithdrawal(&_ChannelHub.TransactOpts, channelId, escrowId, candidate)
 }
 
-// FinalizeEscrowWithdrawal is a paid mutator transaction binding the contract method 0x7e7985f9.
+// FinalizeEscrowWithdrawal is a paid mutator transaction binding the contract method 0x6840dbd2.
 //
-// Solidity: function finalizeEscrowWithdrawal(bytes32 escrowId, (uint64,uint8,bytes32,(uint64,address,uint8,uint256,int256,uint256,int256),(uint64,address,uint8,uint256,int256,uint256,int256),bytes,bytes) candidate) returns()
-func (_ChannelHub *ChannelHubTransactorSession) FinalizeEscrowWithdrawal(escrowId [32]byte, candidate State) (*types.Transaction, error) {
-	return _ChannelHub.Contract.FinalizeEscrowWithdrawal(&_ChannelHub.TransactOpts, escrowId, candidate)
+// Solidity: function finalizeEscrowWithdrawal(bytes32 channelId, bytes32 escrowId, (uint64,uint8,bytes32,(uint64,address,uint8,uint256,int256,uint256,int256),(uint64,address,uint8,uint256,int256,uint256,int256),bytes,bytes) candidate) returns()
+func (_ChannelHub *ChannelHubTransactorSession) FinalizeEscrowWithdrawal(channelId [32]byte, escrowId [32]byte, candidate State) (*types.Transaction, error) {
+	return _ChannelHub.Contract.FinalizeEscrowWithdrawal(&_ChannelHub.TransactOpts, channelId, escrowId, candidate)
 }
 
 // FinalizeMigration is a paid mutator transaction binding the contract method 0x53269198.
@@ -5134,3 +5317,349 @@ func (_ChannelHub *ChannelHubFilterer) ParseWithdrawn(log types.Log) (*ChannelHu
 	event.Raw = log
 	return event, nil
 }
+
+// EscrowDepositEngineMetaData contains all meta data concerning the EscrowDepositEngine contract.
+var EscrowDepositEngineMetaData = &bind.MetaData{
+	ABI: "[]",
+	Bin: "0x60808060405234601957610e55908161001e823930815050f35b5f80fdfe60806040526004361015610011575f80fd5b5f3560e01c80636666e4c0146109095763bbc42f341461002f575f80fd5b604060031936011261085d5760043567ffffffffffffffff811161085d5761005b903690600401610bf4565b60243567ffffffffffffffff811161085d5761007b903690600401610b3d565b610083610cd9565b5081516004811015610343576003146108e15767ffffffffffffffff46169060608101918067ffffffffffffffff84515116146108b957608082019067ffffffffffffffff82515116036108915767ffffffffffffffff8251161561067b5780516040810190601260ff83511611610869574667ffffffffffffffff8251161461072f575b5050805160a06060820151910151810180911161038c57610134825160c0608082015191015190610d09565b5f81126107075761014490610d50565b036106df57610151610cd9565b5060208201928351600a81101561034357600481036104685750909150610176610cd9565b918451600481101561034357610440578051916080606084015193015161019c84610d85565b036104185760a0825101516103f05760c0825101516103c85760ff60406101d26101dd9351838360a08301519201511690610dda565b935101511683610dda565b036103a0576101eb90610d85565b815260016040820152612a3067ffffffffffffffff42160167ffffffffffffffff811161038c5767ffffffffffffffff166060820152600160a0820152915b82511580159061037f575b1561035757825161024c6020850191825190610d09565b928051600a811015610343576004036102a65750505081510361027e5761027a905b60405191829182610c7a565b0390f35b7f8041118f000000000000000000000000000000000000000000000000000000005f5260045ffd5b9290919251600a811015610343576005146102c8575b50505061027a9061026e565b81510361031b576102e36102de60409251610d24565b610d50565b910151036102f3575f80806102bc565b7fb09443e7000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f8b8380f4000000000000000000000000000000000000000000000000000000005f5260045ffd5b634e487b7160e01b5f52602160045260245ffd5b7f17bc734e000000000000000000000000000000000000000000000000000000005f5260045ffd5b5060208301511515610235565b634e487b7160e01b5f52601160045260245ffd5b7fe19f88d5000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f0c18740d000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fa5eabfa5000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f76ac27ca000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fed778779000000000000000000000000000000000000000000000000000000005f5260045ffd5b60050361065357610477610cd9565b92855160048110156103435760011480156106cb575b156106a35767ffffffffffffffff905116916020860192600167ffffffffffffffff855151160167ffffffffffffffff811161038c5767ffffffffffffffff160361067b57602083510151600a811015610343576003190161065357606060808451015101519060808151015161050383610d85565b036104185760c08151015161051f61051a84610d85565b610d24565b036103c85760608151015161062b575160a001516103f057606082510151606080855101510151810390811161038c576105656105799160ff6040865101511690610dda565b9160ff604060808751015101511690610dda565b036106035760a0815101516103f057606060808092510151925101510151908181035f831282808312821692139015161761038c57036105db576105c361051a6040850151610d85565b6020820152600360408201525f60a08201529161022a565b7f1180da8f000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fff0edb30000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f2c0a0276000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fc898513c000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f07646e49000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f392ebf28000000000000000000000000000000000000000000000000000000005f5260045ffd5b50855160048110156103435760021461048d565b7f92ad5c75000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fe6af4070000000000000000000000000000000000000000000000000000000005f5260045ffd5b6020015173ffffffffffffffffffffffffffffffffffffffff168061078b575060ff601291511603610763575b5f80610108565b7f5a8dbaed000000000000000000000000000000000000000000000000000000005f5260045ffd5b906020600492604051938480927f313ce5670000000000000000000000000000000000000000000000000000000082525afa5f9281610827575b506107f2577f6afa2af9000000000000000000000000000000000000000000000000000000005f5260045ffd5b60ff8091511691161461075c577f5a8dbaed000000000000000000000000000000000000000000000000000000005f5260045ffd5b9092506020813d602011610861575b8161084360209383610a25565b8101031261085d575160ff8116810361085d57915f6107c5565b5f80fd5b3d9150610836565b7fb016c3f4000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f9ba78e55000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f21e65f65000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f69155645000000000000000000000000000000000000000000000000000000005f5260045ffd5b602060031936011261085d5760043567ffffffffffffffff811161085d57610935903690600401610bf4565b61093d610cd9565b9080516004811015610343575f19016106a3576060015167ffffffffffffffff164210156109b157600260408201526201518067ffffffffffffffff4216019067ffffffffffffffff821161038c5767ffffffffffffffff61027a921660808201525f60a082015260405191829182610c7a565b7f2b39d042000000000000000000000000000000000000000000000000000000005f5260045ffd5b60e0810190811067ffffffffffffffff8211176109f557604052565b634e487b7160e01b5f52604160045260245ffd5b60c0810190811067ffffffffffffffff8211176109f557604052565b90601f601f19910116810190811067ffffffffffffffff8211176109f557604052565b359067ffffffffffffffff8216820361085d57565b91908260e091031261085d57604051610a75816109d9565b8092610a8081610a48565b8252602081013573ffffffffffffffffffffffffffffffffffffffff8116810361085d576020830152604081013560ff8116810361085d5760c09182916040850152606081013560608501526080810135608085015260a081013560a08501520135910152565b81601f8201121561085d5780359067ffffffffffffffff82116109f55760405192610b1c6020601f19601f8601160185610a25565b8284526020838301011161085d57815f926020809301838601378301015290565b91906102608382031261085d5760405190610b57826109d9565b8193610b6281610a48565b83526020810135600a81101561085d57602084015260408101356040840152610b8e8260608301610a5d565b6060840152610ba1826101408301610a5d565b608084015261022081013567ffffffffffffffff811161085d5782610bc7918301610ae7565b60a08401526102408101359167ffffffffffffffff831161085d5760c092610bef9201610ae7565b910152565b91909160c08184031261085d5760405190610c0e82610a09565b81938135600481101561085d57835260208201359167ffffffffffffffff831161085d57610c4260a0939284938301610b3d565b602085015260408101356040850152610c5d60608201610a48565b6060850152610c6e60808201610a48565b60808501520135910152565b91909160c08101928051825260208101516020830152604081015160048110156103435760a0918291604085015267ffffffffffffffff606082015116606085015267ffffffffffffffff608082015116608085015201511515910152565b60405190610ce682610a09565b5f60a0838281528260208201528260408201528260608201528260808201520152565b9190915f838201938412911290801582169115161761038c57565b7f8000000000000000000000000000000000000000000000000000000000000000811461038c575f0390565b5f8112610d5a5790565b7fa8ce4432000000000000000000000000000000000000000000000000000000005f5260045260245ffd5b7f7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8111610daf5790565b7f24775e06000000000000000000000000000000000000000000000000000000005f5260045260245ffd5b9060ff16601281116108695760128114610e1b5760120360ff811161038c5760ff16604d811161038c57600a0a9081810291818304149015171561038c5790565b509056fea2646970667358221220fc0a93f7abd0c8aae0f4edd1fab1eef03232af831542ee9ea9f3dcf8d76c3da064736f6c634300081e0033",
+}
+
+// EscrowDepositEngineABI is the input ABI used to generate the binding from.
+// Deprecated: Use EscrowDepositEngineMetaData.ABI instead.
+var EscrowDepositEngineABI = EscrowDepositEngineMetaData.ABI
+
+// EscrowDepositEngineBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use EscrowDepositEngineMetaData.Bin instead.
+var EscrowDepositEngineBin = EscrowDepositEngineMetaData.Bin
+
+// DeployEscrowDepositEngine deploys a new Ethereum contract, binding an instance of EscrowDepositEngine to it.
+func DeployEscrowDepositEngine(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *EscrowDepositEngine, error) {
+	parsed, err := EscrowDepositEngineMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(EscrowDepositEngineBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &EscrowDepositEngine{EscrowDepositEngineCaller: EscrowDepositEngineCaller{contract: contract}, EscrowDepositEngineTransactor: EscrowDepositEngineTransactor{contract: contract}, EscrowDepositEngineFilterer: EscrowDepositEngineFilterer{contract: contract}}, nil
+}
+
+// EscrowDepositEngine is an auto generated Go binding around an Ethereum contract.
+type EscrowDepositEngine struct {
+	EscrowDepositEngineCaller     // Read-only binding to the contract
+	EscrowDepositEngineTransactor // Write-only binding to the contract
+	EscrowDepositEngineFilterer   // Log filterer for contract events
+}
+
+// EscrowDepositEngineCaller is an auto generated read-only Go binding around an Ethereum contract.
+type EscrowDepositEngineCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EscrowDepositEngineTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type EscrowDepositEngineTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EscrowDepositEngineFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type EscrowDepositEngineFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EscrowDepositEngineSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type EscrowDepositEngineSession struct {
+	Contract     *EscrowDepositEngine // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts        // Call options to use throughout this session
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// EscrowDepositEngineCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type EscrowDepositEngineCallerSession struct {
+	Contract *EscrowDepositEngineCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts              // Call options to use throughout this session
+}
+
+// EscrowDepositEngineTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type EscrowDepositEngineTransactorSession struct {
+	Contract     *EscrowDepositEngineTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts              // Transaction auth options to use throughout this session
+}
+
+// EscrowDepositEngineRaw is an auto generated low-level Go binding around an Ethereum contract.
+type EscrowDepositEngineRaw struct {
+	Contract *EscrowDepositEngine // Generic contract binding to access the raw methods on
+}
+
+// EscrowDepositEngineCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type EscrowDepositEngineCallerRaw struct {
+	Contract *EscrowDepositEngineCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// EscrowDepositEngineTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type EscrowDepositEngineTransactorRaw struct {
+	Contract *EscrowDepositEngineTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewEscrowDepositEngine creates a new instance of EscrowDepositEngine, bound to a specific deployed contract.
+func NewEscrowDepositEngine(address common.Address, backend bind.ContractBackend) (*EscrowDepositEngine, error) {
+	contract, err := bindEscrowDepositEngine(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &EscrowDepositEngine{EscrowDepositEngineCaller: EscrowDepositEngineCaller{contract: contract}, EscrowDepositEngineTransactor: EscrowDepositEngineTransactor{contract: contract}, EscrowDepositEngineFilterer: EscrowDepositEngineFilterer{contract: contract}}, nil
+}
+
+// NewEscrowDepositEngineCaller creates a new read-only instance of EscrowDepositEngine, bound to a specific deployed contract.
+func NewEscrowDepositEngineCaller(address common.Address, caller bind.ContractCaller) (*EscrowDepositEngineCaller, error) {
+	contract, err := bindEscrowDepositEngine(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EscrowDepositEngineCaller{contract: contract}, nil
+}
+
+// NewEscrowDepositEngineTransactor creates a new write-only instance of EscrowDepositEngine, bound to a specific deployed contract.
+func NewEscrowDepositEngineTransactor(address common.Address, transactor bind.ContractTransactor) (*EscrowDepositEngineTransactor, error) {
+	contract, err := bindEscrowDepositEngine(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EscrowDepositEngineTransactor{contract: contract}, nil
+}
+
+// NewEscrowDepositEngineFilterer creates a new log filterer instance of EscrowDepositEngine, bound to a specific deployed contract.
+func NewEscrowDepositEngineFilterer(address common.Address, filterer bind.ContractFilterer) (*EscrowDepositEngineFilterer, error) {
+	contract, err := bindEscrowDepositEngine(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &EscrowDepositEngineFilterer{contract: contract}, nil
+}
+
+// bindEscrowDepositEngine binds a generic wrapper to an already deployed contract.
+func bindEscrowDepositEngine(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := EscrowDepositEngineMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EscrowDepositEngine *EscrowDepositEngineRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EscrowDepositEngine.Contract.EscrowDepositEngineCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EscrowDepositEngine *EscrowDepositEngineRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EscrowDepositEngine.Contract.EscrowDepositEngineTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EscrowDepositEngine *EscrowDepositEngineRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EscrowDepositEngine.Contract.EscrowDepositEngineTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EscrowDepositEngine *EscrowDepositEngineCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EscrowDepositEngine.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EscrowDepositEngine *EscrowDepositEngineTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EscrowDepositEngine.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EscrowDepositEngine *EscrowDepositEngineTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EscrowDepositEngine.Contract.contract.Transact(opts, method, params...)
+}
+
+// EscrowWithdrawalEngineMetaData contains all meta data concerning the EscrowWithdrawalEngine contract.
+var EscrowWithdrawalEngineMetaData = &bind.MetaData{
+	ABI: "[]",
+	Bin: "0x60808060405234601957610edc908161001e823930815050f35b5f80fdfe60806040526004361015610011575f80fd5b5f3560e01c8062ea54e714610118576324063eba1461002e575f80fd5b60206003193601126101145760043567ffffffffffffffff81116101145761005a903690600401610c2a565b610062610ced565b90516004811015610100575f19016100d857600260408201526201518067ffffffffffffffff4216019067ffffffffffffffff82116100c45767ffffffffffffffff6100c0921660608201525f608082015260405191829182610ca2565b0390f35b634e487b7160e01b5f52601160045260245ffd5b7f392ebf28000000000000000000000000000000000000000000000000000000005f5260045ffd5b634e487b7160e01b5f52602160045260245ffd5b5f80fd5b60406003193601126101145760043567ffffffffffffffff811161011457610144903690600401610c2a565b60243567ffffffffffffffff811161011457610164903690600401610b73565b61016c610ced565b5081516004811015610100576003146109dc5767ffffffffffffffff461660608201908067ffffffffffffffff83515116146109b457608083019067ffffffffffffffff825151160361098c5767ffffffffffffffff835116156107a25780516040810190601260ff83511611610964574667ffffffffffffffff8251161461082e575b5050805160a0606082015191015181018091116100c45761021c825160c0608082015191015190610d17565b5f81126108065761022c90610d5e565b036107de57610239610ced565b5060208301928351600a811015610100576006810361052657505061025c610ced565b9184516004811015610100576104fe576060825101516104d6576080825101516104ae5781519160c060a084015193015161029684610d93565b03610486576102c360ff60406102b88551838360608301519201511690610e0a565b935101511684610e0a565b1161045e575160a00151610436576102da90610d93565b60208201526001604082015260016080820152915b825115801590610429575b15610401578251906103126020850192835190610d17565b928051600a81101561010057600603610366575050510361033e576100c0905b60405191829182610ca2565b7f8041118f000000000000000000000000000000000000000000000000000000005f5260045ffd5b9092915051600a81101561010057600714610387575b50506100c090610332565b8251036103d95760406103a261039d8451610d32565b610d5e565b910151036103b157818061037c565b7fd9132288000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f8b8380f4000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f17bc734e000000000000000000000000000000000000000000000000000000005f5260045ffd5b50602083015115156102fa565b7fa5eabfa5000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fe19f88d5000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f06b4cdae000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f4c66f955000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f2c0a0276000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fed778779000000000000000000000000000000000000000000000000000000005f5260045ffd5b90929060070361077a57610538610ced565b92855160048110156101005760011480156107ca575b156100d85767ffffffffffffffff9051166020860190600167ffffffffffffffff835151160167ffffffffffffffff81116100c45767ffffffffffffffff16036107a257602081510151600a811015610100577ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffa0161077a5760a06080825101510151926060815101516104d6576080815101516105f36105ee86610d93565b610d32565b036104ae5760a081510151610436575160c0015161061084610d93565b036107025760608251015160608083510151015111156107525760608082510151015160608351015181039081116100c4576106559060ff6040855101511690610e0a565b61066b60ff604060808551015101511685610e0a565b0361072a5760c08251015160c06060835101510151905f82820392128183128116918313901516176100c4575f81121561070257604060806106cb6106c56106d89660ff856106ba8298610d32565b925101511690610e47565b96610d93565b9351015101511690610e47565b03610486576106ed6105ee6040850151610d93565b8152600360408201525f6080820152916102ef565b7f0c18740d000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fffda345d000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f25e3e1b8000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fc898513c000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f07646e49000000000000000000000000000000000000000000000000000000005f5260045ffd5b50855160048110156101005760021461054e565b7f92ad5c75000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fe6af4070000000000000000000000000000000000000000000000000000000005f5260045ffd5b6020015173ffffffffffffffffffffffffffffffffffffffff168061088a575060ff601291511603610862575b84806101f0565b7f5a8dbaed000000000000000000000000000000000000000000000000000000005f5260045ffd5b906020600492604051938480927f313ce5670000000000000000000000000000000000000000000000000000000082525afa5f9281610926575b506108f1577f6afa2af9000000000000000000000000000000000000000000000000000000005f5260045ffd5b60ff8091511691161461085b577f5a8dbaed000000000000000000000000000000000000000000000000000000005f5260045ffd5b9092506020813d60201161095c575b8161094260209383610a50565b81010312610114575160ff811681036101145791876108c4565b3d9150610935565b7fb016c3f4000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f9ba78e55000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f21e65f65000000000000000000000000000000000000000000000000000000005f5260045ffd5b7f69155645000000000000000000000000000000000000000000000000000000005f5260045ffd5b60e0810190811067ffffffffffffffff821117610a2057604052565b634e487b7160e01b5f52604160045260245ffd5b60a0810190811067ffffffffffffffff821117610a2057604052565b90601f601f19910116810190811067ffffffffffffffff821117610a2057604052565b359067ffffffffffffffff8216820361011457565b359073ffffffffffffffffffffffffffffffffffffffff8216820361011457565b91908260e091031261011457604051610ac181610a04565b8092610acc81610a73565b8252610ada60208201610a88565b6020830152604081013560ff811681036101145760c09182916040850152606081013560608501526080810135608085015260a081013560a08501520135910152565b81601f820112156101145780359067ffffffffffffffff8211610a205760405192610b526020601f19601f8601160185610a50565b8284526020838301011161011457815f926020809301838601378301015290565b9190610260838203126101145760405190610b8d82610a04565b8193610b9881610a73565b83526020810135600a81101561011457602084015260408101356040840152610bc48260608301610aa9565b6060840152610bd7826101408301610aa9565b608084015261022081013567ffffffffffffffff81116101145782610bfd918301610b1d565b60a08401526102408101359167ffffffffffffffff83116101145760c092610c259201610b1d565b910152565b91909160a0818403126101145760405190610c4482610a34565b81938135600481101561011457835260208201359067ffffffffffffffff82116101145782610c7c60809492610c2594869401610b73565b602086015260408101356040860152610c9760608201610a73565b606086015201610a88565b91909160a0810192805182526020810151602083015260408101516004811015610100576080918291604085015267ffffffffffffffff606082015116606085015201511515910152565b60405190610cfa82610a34565b5f6080838281528260208201528260408201528260608201520152565b9190915f83820193841291129080158216911516176100c457565b7f800000000000000000000000000000000000000000000000000000000000000081146100c4575f0390565b5f8112610d685790565b7fa8ce4432000000000000000000000000000000000000000000000000000000005f5260045260245ffd5b7f7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8111610dbd5790565b7f24775e06000000000000000000000000000000000000000000000000000000005f5260045260245ffd5b60ff166012039060ff82116100c457565b60ff16604d81116100c457600a0a90565b9060ff81166012811161096457601214610e4357610e2a610e2f91610de8565b610df9565b908181029181830414901517156100c45790565b5090565b9060ff81166012811161096457601214610e4357610e2a610e6791610de8565b90818102917f800000000000000000000000000000000000000000000000000000000000000081145f8312166100c45781830514901517156100c4579056fea264697066735822122073585d1c2949228993d38506ffc5f542f9ffb1c023c1893a2f5522e50227b27564736f6c634300081e0033",
+}
+
+// EscrowWithdrawalEngineABI is the input ABI used to generate the binding from.
+// Deprecated: Use EscrowWithdrawalEngineMetaData.ABI instead.
+var EscrowWithdrawalEngineABI = EscrowWithdrawalEngineMetaData.ABI
+
+// EscrowWithdrawalEngineBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use EscrowWithdrawalEngineMetaData.Bin instead.
+var EscrowWithdrawalEngineBin = EscrowWithdrawalEngineMetaData.Bin
+
+// DeployEscrowWithdrawalEngine deploys a new Ethereum contract, binding an instance of EscrowWithdrawalEngine to it.
+func DeployEscrowWithdrawalEngine(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *EscrowWithdrawalEngine, error) {
+	parsed, err := EscrowWithdrawalEngineMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(EscrowWithdrawalEngineBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &EscrowWithdrawalEngine{EscrowWithdrawalEngineCaller: EscrowWithdrawalEngineCaller{contract: contract}, EscrowWithdrawalEngineTransactor: EscrowWithdrawalEngineTransactor{contract: contract}, EscrowWithdrawalEngineFilterer: EscrowWithdrawalEngineFilterer{contract: contract}}, nil
+}
+
+// EscrowWithdrawalEngine is an auto generated Go binding around an Ethereum contract.
+type EscrowWithdrawalEngine struct {
+	EscrowWithdrawalEngineCaller     // Read-only binding to the contract
+	EscrowWithdrawalEngineTransactor // Write-only binding to the contract
+	EscrowWithdrawalEngineFilterer   // Log filterer for contract events
+}
+
+// EscrowWithdrawalEngineCaller is an auto generated read-only Go binding around an Ethereum contract.
+type EscrowWithdrawalEngineCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EscrowWithdrawalEngineTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type EscrowWithdrawalEngineTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EscrowWithdrawalEngineFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type EscrowWithdrawalEngineFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EscrowWithdrawalEngineSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type EscrowWithdrawalEngineSession struct {
+	Contract     *EscrowWithdrawalEngine // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts           // Call options to use throughout this session
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// EscrowWithdrawalEngineCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type EscrowWithdrawalEngineCallerSession struct {
+	Contract *EscrowWithdrawalEngineCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                 // Call options to use throughout this session
+}
+
+// EscrowWithdrawalEngineTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type EscrowWithdrawalEngineTransactorSession struct {
+	Contract     *EscrowWithdrawalEngineTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                 // Transaction auth options to use throughout this session
+}
+
+// EscrowWithdrawalEngineRaw is an auto generated low-level Go binding around an Ethereum contract.
+type EscrowWithdrawalEngineRaw struct {
+	Contract *EscrowWithdrawalEngine // Generic contract binding to access the raw methods on
+}
+
+// EscrowWithdrawalEngineCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type EscrowWithdrawalEngineCallerRaw struct {
+	Contract *EscrowWithdrawalEngineCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// EscrowWithdrawalEngineTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type EscrowWithdrawalEngineTransactorRaw struct {
+	Contract *EscrowWithdrawalEngineTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewEscrowWithdrawalEngine creates a new instance of EscrowWithdrawalEngine, bound to a specific deployed contract.
+func NewEscrowWithdrawalEngine(address common.Address, backend bind.ContractBackend) (*EscrowWithdrawalEngine, error) {
+	contract, err := bindEscrowWithdrawalEngine(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &EscrowWithdrawalEngine{EscrowWithdrawalEngineCaller: EscrowWithdrawalEngineCaller{contract: contract}, EscrowWithdrawalEngineTransactor: EscrowWithdrawalEngineTransactor{contract: contract}, EscrowWithdrawalEngineFilterer: EscrowWithdrawalEngineFilterer{contract: contract}}, nil
+}
+
+// NewEscrowWithdrawalEngineCaller creates a new read-only instance of EscrowWithdrawalEngine, bound to a specific deployed contract.
+func NewEscrowWithdrawalEngineCaller(address common.Address, caller bind.ContractCaller) (*EscrowWithdrawalEngineCaller, error) {
+	contract, err := bindEscrowWithdrawalEngine(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EscrowWithdrawalEngineCaller{contract: contract}, nil
+}
+
+// NewEscrowWithdrawalEngineTransactor creates a new write-only instance of EscrowWithdrawalEngine, bound to a specific deployed contract.
+func NewEscrowWithdrawalEngineTransactor(address common.Address, transactor bind.ContractTransactor) (*EscrowWithdrawalEngineTransactor, error) {
+	contract, err := bindEscrowWithdrawalEngine(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EscrowWithdrawalEngineTransactor{contract: contract}, nil
+}
+
+// NewEscrowWithdrawalEngineFilterer creates a new log filterer instance of EscrowWithdrawalEngine, bound to a specific deployed contract.
+func NewEscrowWithdrawalEngineFilterer(address common.Address, filterer bind.ContractFilterer) (*EscrowWithdrawalEngineFilterer, error) {
+	contract, err := bindEscrowWithdrawalEngine(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &EscrowWithdrawalEngineFilterer{contract: contract}, nil
+}
+
+// bindEscrowWithdrawalEngine binds a generic wrapper to an already deployed contract.
+func bindEscrowWithdrawalEngine(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := EscrowWithdrawalEngineMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EscrowWithdrawalEngine *EscrowWithdrawalEngineRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EscrowWithdrawalEngine.Contract.EscrowWithdrawalEngineCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EscrowWithdrawalEngine *EscrowWithdrawalEngineRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EscrowWithdrawalEngine.Contract.EscrowWithdrawalEngineTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EscrowWithdrawalEngine *EscrowWithdrawalEngineRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EscrowWithdrawalEngine.Contract.EscrowWithdrawalEngineTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EscrowWithdrawalEngine *EscrowWithdrawalEngineCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EscrowWithdrawalEngine.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EscrowWithdrawalEngine *EscrowWithdrawalEngineTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EscrowWithdrawalEngine.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EscrowWithdrawalEngine *EscrowWithdrawalEngineTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EscrowWithdrawalEngine.Contract.contract.Transact(opts, method, params...)
+}
@@ -2,6 +2,7 @@ package evm
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -9,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 
 	"github.com/layer-3/nitrolite/pkg/core"
 	"github.com/layer-3/nitrolite/pkg/log"
@@ -39,6 +41,7 @@ type ChannelHubReactor struct {
 	blockchainID       uint64
 	eventHandler       core.ChannelHubEventHandler
 	storeContractEvent StoreContractEvent
+	assetStore         core.AssetStore
 	onEventProcessed   func(blockchainID uint64, success bool)
 }
 
@@ -55,6 +58,12 @@ func (r *ChannelHubReactor) SetOnEventProcessed(fn func(blockchainID uint64, suc
 	r.onEventProcessed = fn
 }
 
+// SetAssetStore sets the asset store the token amounts of vault events are converted with.
+// Without it, Deposited and Withdrawn events are skipped.
+func (r *ChannelHubReactor) SetAssetStore(assetStore core.AssetStore) {
+	r.assetStore = assetStore
+}
+
 func (r *ChannelHubReactor) HandleEvent(ctx context.Context, l types.Log) error {
 	logger := log.FromContext(ctx)
 
@@ -66,6 +75,18 @@ func (r *ChannelHubReactor) HandleEvent(ctx context.Context, l types.Log) error
 	}
 	logger.Debug("received event", "name", eventName, "blockNumber", l.BlockNumber, "txHash", l.TxHash.String(), "logIndex", l.Index)
 
+	// The handler records its changes for the event, so that they can be reverted on a chain reorganization
+	contractEvent := core.BlockchainEvent{
+		BlockNumber:     l.BlockNumber,
+		BlockHash:       l.BlockHash.Hex(),
+		BlockchainID:    r.blockchainID,
+		Name:            eventName,
+		ContractAddress: l.Address.Hex(),
+		TransactionHash: l.TxHash.String(),
+		LogIndex:        uint32(l.Index),
+	}
+	ctx = core.ContextWithBlockchainEvent(ctx, contractEvent)
+
 	var err error
 	switch eventID {
 	case channelHubAbi.Events["ChannelCreated"].ID:
@@ -100,7 +121,6 @@ func (r *ChannelHubReactor) HandleEvent(ctx context.Context, l types.Log) error
 		err = r.handleEscrowWithdrawalInitiatedOnHome(ctx, l)
 	case channelHubAbi.Events["EscrowWithdrawalFinalizedOnHome"].ID:
 		err = r.handleEscrowWithdrawalFinalizedOnHome(ctx, l)
-	// NOTE: Unimplemented handlers:
 	case channelHubAbi.Events["MigrationInInitiated"].ID:
 		err = r.handleHomeChannelMigrated(ctx, l)
 	case channelHubAbi.Events["MigrationInFinalized"].ID:
@@ -126,14 +146,7 @@ func (r *ChannelHubReactor) HandleEvent(ctx context.Context, l types.Log) error
 		return errors.Wrap(err, "error processing event")
 	}
 
-	if err := r.storeContractEvent(core.BlockchainEvent{
-		BlockNumber:     l.BlockNumber,
-		BlockchainID:    r.blockchainID,
-		Name:            eventName,
-		ContractAddress: l.Address.Hex(),
-		TransactionHash: l.TxHash.String(),
-		LogIndex:        uint32(l.Index),
-	}); err != nil {
+	if err := r.storeContractEvent(contractEvent); err != nil {
 		logger.Warn("error storing contract event", "error", err, "event", eventName, "blockNumber", l.BlockNumber, "txHash", l.TxHash.String(), "logIndex", l.Index)
 		return errors.Wrap(err, "error storing contract event")
 	}
@@ -366,19 +379,20 @@ func (r *ChannelHubReactor) handleEscrowWithdrawalFinalizedOnHome(ctx context.Co
 	return r.eventHandler.HandleHomeChannelCheckpointed(ctx, &ev)
 }
 
-// Additional event handlers for events not yet defined in core.BlockchainEventHandler
-
 func (r *ChannelHubReactor) handleMigrationInFinalized(ctx context.Context, l types.Log) error {
 	event, err := channelHubFilterer.ParseMigrationInFinalized(l)
 	if err != nil {
 		return errors.Wrap(err, "failed to parse MigrationInFinalized event")
 	}
-	logger := log.FromContext(ctx)
-	logger.Info("MigrationInFinalized event",
-		"channelId", hexutil.Encode(event.ChannelId[:]),
-		"stateVersion", event.State.Version)
-	// TODO: Add handler method to core.BlockchainEventHandler interface and implement
-	return nil
+
+	// The finalized candidate has the new home blockchain, where the event is emitted, as its home ledger
+	ev := core.HomeChannelMigrationInFinalizedEvent{
+		ChannelID:    hexutil.Encode(event.ChannelId[:]),
+		StateVersion: event.State.Version,
+		BlockchainID: event.State.HomeLedger.ChainId,
+		TokenAddress: event.State.HomeLedger.Token.Hex(),
+	}
+	return r.eventHandler.HandleHomeChannelMigrationInFinalized(ctx, &ev)
 }
 
 func (r *ChannelHubReactor) handleMigrationOutInitiated(ctx context.Context, l types.Log) error {
@@ -386,12 +400,12 @@ func (r *ChannelHubReactor) handleMigrationOutInitiated(ctx context.Context, l t
 	if err != nil {
 		return errors.Wrap(err, "failed to parse MigrationOutInitiated event")
 	}
-	logger := log.FromContext(ctx)
-	logger.Info("MigrationOutInitiated event",
-		"channelId", hexutil.Encode(event.ChannelId[:]),
-		"stateVersion", event.State.Version)
-	// TODO: Add handler method to core.BlockchainEventHandler interface and implement
-	return nil
+
+	ev := core.HomeChannelMigrationOutInitiatedEvent{
+		ChannelID:    hexutil.Encode(event.ChannelId[:]),
+		StateVersion: event.State.Version,
+	}
+	return r.eventHandler.HandleHomeChannelMigrationOutInitiated(ctx, &ev)
 }
 
 func (r *ChannelHubReactor) handleMigrationOutFinalized(ctx context.Context, l types.Log) error {
@@ -399,12 +413,12 @@ func (r *ChannelHubReactor) handleMigrationOutFinalized(ctx context.Context, l t
 	if err != nil {
 		return errors.Wrap(err, "failed to parse MigrationOutFinalized event")
 	}
-	logger := log.FromContext(ctx)
-	logger.Info("MigrationOutFinalized event",
-		"channelId", hexutil.Encode(event.ChannelId[:]),
-		"stateVersion", event.State.Version)
-	// TODO: Add handler method to core.BlockchainEventHandler interface and implement
-	return nil
+
+	ev := core.HomeChannelMigrationOutFinalizedEvent{
+		ChannelID:    hexutil.Encode(event.ChannelId[:]),
+		StateVersion: event.State.Version,
+	}
+	return r.eventHandler.HandleHomeChannelMigrationOutFinalized(ctx, &ev)
 }
 
 func (r *ChannelHubReactor) handleDeposited(ctx context.Context, l types.Log) error {
@@ -412,13 +426,23 @@ func (r *ChannelHubReactor) handleDeposited(ctx context.Context, l types.Log) er
 	if err != nil {
 		return errors.Wrap(err, "failed to parse Deposited event")
 	}
-	logger := log.FromContext(ctx)
-	logger.Info("Deposited event",
-		"wallet", event.Wallet.Hex(),
-		"token", event.Token.Hex(),
-		"amount", event.Amount.String())
+	if r.assetStore == nil {
+		log.FromContext(ctx).Debug("skipping Deposited event without asset store", "wallet", event.Wallet.Hex(), "token", event.Token.Hex())
+		return nil
+	}
 
-	return nil
+	amount, err := r.tokenAmount(event.Token, event.Amount)
+	if err != nil {
+		return err
+	}
+
+	ev := core.VaultDepositedEvent{
+		Wallet:       event.Wallet.Hex(),
+		BlockchainID: r.blockchainID,
+		TokenAddress: event.Token.Hex(),
+		Amount:       amount,
+	}
+	return r.eventHandler.HandleVaultDeposited(ctx, &ev)
 }
 
 func (r *ChannelHubReactor) handleWithdrawn(ctx context.Context, l types.Log) error {
@@ -426,13 +450,23 @@ func (r *ChannelHubReactor) handleWithdrawn(ctx context.Context, l types.Log) er
 	if err != nil {
 		return errors.Wrap(err, "failed to parse Withdrawn event")
 	}
-	logger := log.FromContext(ctx)
-	logger.Info("Withdrawn event",
-		"wallet", event.Wallet.Hex(),
-		"token", event.Token.Hex(),
-		"amount", event.Amount.String())
+	if r.assetStore == nil {
+		log.FromContext(ctx).Debug("skipping Withdrawn event without asset store", "wallet", event.Wallet.Hex(), "token", event.Token.Hex())
+		return nil
+	}
 
-	return nil
+	amount, err := r.tokenAmount(event.Token, event.Amount)
+	if err != nil {
+		return err
+	}
+
+	ev := core.VaultWithdrawnEvent{
+		Wallet:       event.Wallet.Hex(),
+		BlockchainID: r.blockchainID,
+		TokenAddress: event.Token.Hex(),
+		Amount:       amount,
+	}
+	return r.eventHandler.HandleVaultWithdrawn(ctx, &ev)
 }
 
 func (r *ChannelHubReactor) handleEscrowDepositsPurged(ctx context.Context, l types.Log) error {
@@ -440,8 +474,19 @@ func (r *ChannelHubReactor) handleEscrowDepositsPurged(ctx context.Context, l ty
 	if err != nil {
 		return errors.Wrap(err, "failed to parse EscrowDepositsPurged event")
 	}
-	logger := log.FromContext(ctx)
-	logger.Info("EscrowDepositsPurged event", "purgedCount", event.PurgedCount.String())
 
-	return nil
+	ev := core.EscrowDepositsPurgedEvent{
+		BlockchainID: r.blockchainID,
+		PurgedCount:  event.PurgedCount.Uint64(),
+	}
+	return r.eventHandler.HandleEscrowDepositsPurged(ctx, &ev)
+}
+
+// tokenAmount converts an amount in the smallest units of the token to a decimal amount of the token.
+func (r *ChannelHubReactor) tokenAmount(token common.Address, amount *big.Int) (decimal.Decimal, error) {
+	decimals, err := r.assetStore.GetTokenDecimals(r.blockchainID, token.Hex())
+	if err != nil {
+		return decimal.Zero, errors.Wrapf(err, "failed to get token decimals for token %s", token.Hex())
+	}
+	return decimal.NewFromBigInt(amount, -int32(decimals)), nil
 }
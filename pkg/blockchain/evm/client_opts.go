@@ -23,6 +23,16 @@ func (ch ClientBalanceCheck) apply(c *BlockchainClient) {
 	c.requireCheckBalance = ch.RequireBalanceCheck
 }
 
+// ClientTxManager makes the client send its transactions through the TxManager,
+// which assigns nonces locally and tracks the transactions until they are final.
+type ClientTxManager struct {
+	TxManager *TxManager
+}
+
+func (o ClientTxManager) apply(c *BlockchainClient) {
+	c.txManager = o.TxManager
+}
+
 type ClientFeeCheck struct {
 	RequirePositiveNativeBalance bool
 }
@@ -0,0 +1,234 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package evm
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ECDSAValidatorMetaData contains all meta data concerning the ECDSAValidator contract.
+var ECDSAValidatorMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"ECDSAInvalidSignature\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"length\",\"type\":\"uint256\"}],\"name\":\"ECDSAInvalidSignatureLength\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"ECDSAInvalidSignatureS\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"EmptyChannelId\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidSignerAddress\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"channelId\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"signingData\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"},{\"internalType\":\"address\",\"name\":\"participant\",\"type\":\"address\"}],\"name\":\"validateSignature\",\"outputs\":[{\"internalType\":\"ValidationResult\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"pure\",\"type\":\"function\"}]",
+	Bin: "0x608080604052346015576106d6908161001a8239f35b5f80fdfe60806040526004361015610011575f80fd5b5f3560e01c63600109bb14610024575f80fd5b346100cc5760807ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc3601126100cc5760243567ffffffffffffffff81116100cc576100739036906004016100d0565b9060443567ffffffffffffffff81116100cc576100949036906004016100d0565b6064359173ffffffffffffffffffffffffffffffffffffffff831683036100cc576020946100c4946004356101a0565b604051908152f35b5f80fd5b9181601f840112156100cc5782359167ffffffffffffffff83116100cc57602083818601950101116100cc57565b90601f601f19910116810190811067ffffffffffffffff82111761012157604052565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52604160045260245ffd5b67ffffffffffffffff811161012157601f01601f191660200190565b9291926101768261014e565b9161018460405193846100fe565b8294818452818301116100cc578281602093845f960137010152565b929091949383156102635773ffffffffffffffffffffffffffffffffffffffff85161561023b5761022060806101de6102279561022d99369161016a565b95601f19601f6020604051998a94828601526040808601528051918291826060880152018686015e5f858286010152011681010301601f1981018652856100fe565b369161016a565b9061028b565b1561023757600190565b5f90565b7f4501a919000000000000000000000000000000000000000000000000000000005f5260045ffd5b7fe1b97cf8000000000000000000000000000000000000000000000000000000005f5260045ffd5b91825192835f947a184f03e93ff9f4daa797ed6e38ed64bf6a1f0100000000000000008210156104cc575b806d04ee2d6d415b85acef8100000000600a9210156104b1575b662386f26fc1000081101561049d575b6305f5e10081101561048c575b61271081101561047d575b606481101561046f575b1015610465575b6001850190600a602161033461031e8561014e565b9461032c60405196876100fe565b80865261014e565b97601f19602086019901368a378401015b5f1901917f30313233343536373839616263646566000000000000000000000000000000008282061a83530490811561038057600a90610345565b505073ffffffffffffffffffffffffffffffffffffffff5f9361040c86610415946020610404869b603a604051938492818401967f19457468657265756d205369676e6564204d6573736167653a0a00000000000088525180918486015e83018281019d8e528c8051928391019e8f905e01015f815203601f1981018352826100fe565b5190206104f4565b9094919461052e565b169416841461045c5773ffffffffffffffffffffffffffffffffffffffff9261044d92610444925190206104f4565b9092919261052e565b1614610457575f90565b600190565b50505050600190565b9360010193610309565b606460029104960195610302565b612710600491049601956102f8565b6305f5e100600891049601956102ed565b662386f26fc10000601091049601956102e0565b6d04ee2d6d415b85acef8100000000602091049601956102d0565b50604094507a184f03e93ff9f4daa797ed6e38ed64bf6a1f01000000000000000081046102b6565b81519190604183036105245761051d9250602082015190606060408401519301515f1a90610606565b9192909190565b50505f9160029190565b60048110156105d95780610540575050565b60018103610570577ff645eedf000000000000000000000000000000000000000000000000000000005f5260045ffd5b600281036105a457507ffce698f7000000000000000000000000000000000000000000000000000000005f5260045260245ffd5b6003146105ae5750565b7fd78bce0c000000000000000000000000000000000000000000000000000000005f5260045260245ffd5b7f4e487b71000000000000000000000000000000000000000000000000000000005f52602160045260245ffd5b91907f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a08411610695579160209360809260ff5f9560405194855216868401526040830152606082015282805260015afa1561068a575f5173ffffffffffffffffffffffffffffffffffffffff81161561068057905f905f90565b505f906001905f90565b6040513d5f823e3d90fd5b5050505f916003919056fea2646970667358221220c8e32dfe4c3317faffb02d4b02fddbb5e01dbc789e117442dd5ec08557786de764736f6c634300081e0033",
+}
+
+// ECDSAValidatorABI is the input ABI used to generate the binding from.
+// Deprecated: Use ECDSAValidatorMetaData.ABI instead.
+var ECDSAValidatorABI = ECDSAValidatorMetaData.ABI
+
+// ECDSAValidatorBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ECDSAValidatorMetaData.Bin instead.
+var ECDSAValidatorBin = ECDSAValidatorMetaData.Bin
+
+// DeployECDSAValidator deploys a new Ethereum contract, binding an instance of ECDSAValidator to it.
+func DeployECDSAValidator(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *ECDSAValidator, error) {
+	parsed, err := ECDSAValidatorMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ECDSAValidatorBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ECDSAValidator{ECDSAValidatorCaller: ECDSAValidatorCaller{contract: contract}, ECDSAValidatorTransactor: ECDSAValidatorTransactor{contract: contract}, ECDSAValidatorFilterer: ECDSAValidatorFilterer{contract: contract}}, nil
+}
+
+// ECDSAValidator is an auto generated Go binding around an Ethereum contract.
+type ECDSAValidator struct {
+	ECDSAValidatorCaller     // Read-only binding to the contract
+	ECDSAValidatorTransactor // Write-only binding to the contract
+	ECDSAValidatorFilterer   // Log filterer for contract events
+}
+
+// ECDSAValidatorCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ECDSAValidatorCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ECDSAValidatorTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ECDSAValidatorTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ECDSAValidatorFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ECDSAValidatorFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ECDSAValidatorSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ECDSAValidatorSession struct {
+	Contract     *ECDSAValidator   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ECDSAValidatorCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ECDSAValidatorCallerSession struct {
+	Contract *ECDSAValidatorCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// ECDSAValidatorTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ECDSAValidatorTransactorSession struct {
+	Contract     *ECDSAValidatorTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// ECDSAValidatorRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ECDSAValidatorRaw struct {
+	Contract *ECDSAValidator // Generic contract binding to access the raw methods on
+}
+
+// ECDSAValidatorCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ECDSAValidatorCallerRaw struct {
+	Contract *ECDSAValidatorCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ECDSAValidatorTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ECDSAValidatorTransactorRaw struct {
+	Contract *ECDSAValidatorTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewECDSAValidator creates a new instance of ECDSAValidator, bound to a specific deployed contract.
+func NewECDSAValidator(address common.Address, backend bind.ContractBackend) (*ECDSAValidator, error) {
+	contract, err := bindECDSAValidator(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ECDSAValidator{ECDSAValidatorCaller: ECDSAValidatorCaller{contract: contract}, ECDSAValidatorTransactor: ECDSAValidatorTransactor{contract: contract}, ECDSAValidatorFilterer: ECDSAValidatorFilterer{contract: contract}}, nil
+}
+
+// NewECDSAValidatorCaller creates a new read-only instance of ECDSAValidator, bound to a specific deployed contract.
+func NewECDSAValidatorCaller(address common.Address, caller bind.ContractCaller) (*ECDSAValidatorCaller, error) {
+	contract, err := bindECDSAValidator(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ECDSAValidatorCaller{contract: contract}, nil
+}
+
+// NewECDSAValidatorTransactor creates a new write-only instance of ECDSAValidator, bound to a specific deployed contract.
+func NewECDSAValidatorTransactor(address common.Address, transactor bind.ContractTransactor) (*ECDSAValidatorTransactor, error) {
+	contract, err := bindECDSAValidator(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ECDSAValidatorTransactor{contract: contract}, nil
+}
+
+// NewECDSAValidatorFilterer creates a new log filterer instance of ECDSAValidator, bound to a specific deployed contract.
+func NewECDSAValidatorFilterer(address common.Address, filterer bind.ContractFilterer) (*ECDSAValidatorFilterer, error) {
+	contract, err := bindECDSAValidator(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ECDSAValidatorFilterer{contract: contract}, nil
+}
+
+// bindECDSAValidator binds a generic wrapper to an already deployed contract.
+func bindECDSAValidator(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ECDSAValidatorMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ECDSAValidator *ECDSAValidatorRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ECDSAValidator.Contract.ECDSAValidatorCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ECDSAValidator *ECDSAValidatorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ECDSAValidator.Contract.ECDSAValidatorTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ECDSAValidator *ECDSAValidatorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ECDSAValidator.Contract.ECDSAValidatorTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ECDSAValidator *ECDSAValidatorCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ECDSAValidator.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ECDSAValidator *ECDSAValidatorTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ECDSAValidator.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ECDSAValidator *ECDSAValidatorTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ECDSAValidator.Contract.contract.Transact(opts, method, params...)
+}
+
+// ValidateSignature is a free data retrieval call binding the contract method 0x600109bb.
+//
+// Solidity: function validateSignature(bytes32 channelId, bytes signingData, bytes signature, address participant) pure returns(uint256)
+func (_ECDSAValidator *ECDSAValidatorCaller) ValidateSignature(opts *bind.CallOpts, channelId [32]byte, signingData []byte, signature []byte, participant common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ECDSAValidator.contract.Call(opts, &out, "validateSignature", channelId, signingData, signature, participant)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ValidateSignature is a free data retrieval call binding the contract method 0x600109bb.
+//
+// Solidity: function validateSignature(bytes32 channelId, bytes signingData, bytes signature, address participant) pure returns(uint256)
+func (_ECDSAValidator *ECDSAValidatorSession) ValidateSignature(channelId [32]byte, signingData []byte, signature []byte, participant common.Address) (*big.Int, error) {
+	return _ECDSAValidator.Contract.ValidateSignature(&_ECDSAValidator.CallOpts, channelId, signingData, signature, participant)
+}
+
+// ValidateSignature is a free data retrieval call binding the contract method 0x600109bb.
+//
+// Solidity: function validateSignature(bytes32 channelId, bytes signingData, bytes signature, address participant) pure returns(uint256)
+func (_ECDSAValidator *ECDSAValidatorCallerSession) ValidateSignature(channelId [32]byte, signingData []byte, signature []byte, participant common.Address) (*big.Int, error) {
+	return _ECDSAValidator.Contract.ValidateSignature(&_ECDSAValidator.CallOpts, channelId, signingData, signature, participant)
+}
@@ -0,0 +1,45 @@
+#!/usr/bin/env sh
+# Regenerates the ChannelHub and ECDSAValidator bindings from contracts/src.
+#
+# ChannelHub links the ChannelEngine, EscrowDepositEngine and EscrowWithdrawalEngine
+# libraries, so the binding is generated from combined JSON: abigen then emits a
+# DeployChannelHub that deploys the libraries and links their addresses into the bytecode.
+# The libraries are only deployed for linking, their ABIs are left empty.
+#
+# Requires forge, jq and abigen on PATH.
+set -eu
+
+EVM_DIR=$(cd "$(dirname "$0")" && pwd)
+CONTRACTS_DIR="$EVM_DIR/../../../contracts"
+OUT_DIR="$CONTRACTS_DIR/out"
+
+(cd "$CONTRACTS_DIR" && forge build --skip test --skip script)
+
+COMBINED=$(mktemp)
+trap 'rm -f "$COMBINED"' EXIT
+
+jq -n \
+	--slurpfile hub "$OUT_DIR/ChannelHub.sol/ChannelHub.json" \
+	--slurpfile channel "$OUT_DIR/ChannelEngine.sol/ChannelEngine.json" \
+	--slurpfile deposit "$OUT_DIR/EscrowDepositEngine.sol/EscrowDepositEngine.json" \
+	--slurpfile withdrawal "$OUT_DIR/EscrowWithdrawalEngine.sol/EscrowWithdrawalEngine.json" \
+	'def bin: .bytecode.object | ltrimstr("0x"); {
+		contracts: {
+			"src/ChannelHub.sol:ChannelHub": {abi: $hub[0].abi, bin: ($hub[0] | bin)},
+			"src/ChannelEngine.sol:ChannelEngine": {abi: [], bin: ($channel[0] | bin)},
+			"src/EscrowDepositEngine.sol:EscrowDepositEngine": {abi: [], bin: ($deposit[0] | bin)},
+			"src/EscrowWithdrawalEngine.sol:EscrowWithdrawalEngine": {abi: [], bin: ($withdrawal[0] | bin)}
+		}
+	}' >"$COMBINED"
+
+abigen --combined-json "$COMBINED" --pkg evm --out "$EVM_DIR/channel_hub_abi.go"
+
+# ECDSAValidator is the default signature validator ChannelHub is deployed with
+VALIDATOR_ABI=$(mktemp)
+VALIDATOR_BIN=$(mktemp)
+trap 'rm -f "$COMBINED" "$VALIDATOR_ABI" "$VALIDATOR_BIN"' EXIT
+
+jq '.abi' "$OUT_DIR/ECDSAValidator.sol/ECDSAValidator.json" >"$VALIDATOR_ABI"
+jq -r '.bytecode.object' "$OUT_DIR/ECDSAValidator.sol/ECDSAValidator.json" >"$VALIDATOR_BIN"
+
+abigen --abi "$VALIDATOR_ABI" --bin "$VALIDATOR_BIN" --pkg evm --type ECDSAValidator --out "$EVM_DIR/ecdsa_validator_abi.go"
@@ -1,5 +1,7 @@
 package evm
 
+//go:generate ./gen_bindings.sh
+
 func init() {
 	initChannelHub()
 	initLockingContract()
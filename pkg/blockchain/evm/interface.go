@@ -13,6 +13,13 @@ type HandleEvent func(ctx context.Context, eventLog types.Log) error
 type StoreContractEvent func(ev core.BlockchainEvent) error
 type LatestEventGetter func(contractAddress string, blockchainID uint64) (ev core.BlockchainEvent, err error)
 
+// RollbackEvents removes the stored events of a contract from the given block on and returns them.
+type RollbackEvents func(contractAddress string, blockchainID uint64, fromBlock uint64) ([]core.BlockchainEvent, error)
+
+// CatchUpProgress reports the historical events of a contract fetched up to fetchedBlock,
+// out of the ones up to targetBlock, with blockRange being the current size of the fetched ranges.
+type CatchUpProgress func(blockchainID uint64, contractAddress string, fetchedBlock, targetBlock, blockRange uint64)
+
 type AssetStore interface {
 	// GetAssetDecimals checks if an asset exists and returns its decimals in YN
 	GetAssetDecimals(asset string) (uint8, error)
@@ -2,7 +2,9 @@ package evm
 
 import (
 	"context"
+	"math"
 	"math/big"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -14,10 +16,19 @@ import (
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/layer-3/nitrolite/pkg/core"
 	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/pkg/errors"
 )
 
 const (
 	maxBackOffCount = 5
+
+	// defaultHeadPollInterval is how frequently the listener checks the chain head
+	// to release confirmed events and to detect reorganizations of processed ones.
+	defaultHeadPollInterval = 5 * time.Second
+
+	// maxTrackedEvents is the number of processed events remembered by listeners
+	// that don't persist processed events themselves.
+	maxTrackedEvents = 1024
 )
 
 type Listener struct {
@@ -28,26 +39,55 @@ type Listener struct {
 	logger          log.Logger
 	handleEvent     HandleEvent
 	getLatestEvent  LatestEventGetter
+
+	rollbackEvents   RollbackEvents
+	processedEvents  *processedEventLog
+	confirmations    uint64
+	headPollInterval time.Duration
+	pollInterval     time.Duration
+
+	onCatchUpProgress CatchUpProgress
 }
 
+// NewListener creates a listener of the contract's events.
+// When getLatestEvent is nil, the listener starts from the chain head and keeps track of
+// the events it has processed in memory.
 func NewListener(contractAddress common.Address, client bind.ContractBackend, blockchainID uint64, blockStep uint64, logger log.Logger, eventHandler HandleEvent, getLatestEvent LatestEventGetter) *Listener {
-	if getLatestEvent == nil {
-		getLatestEvent = func(contractAddress string, networkID uint64) (core.BlockchainEvent, error) {
-			return core.BlockchainEvent{
-				BlockNumber: 0,
-				LogIndex:    0,
-			}, nil
-		}
+	l := &Listener{
+		contractAddress:  contractAddress,
+		client:           client,
+		blockchainID:     blockchainID,
+		blockStep:        blockStep,
+		logger:           logger.WithName("evm"),
+		handleEvent:      eventHandler,
+		getLatestEvent:   getLatestEvent,
+		headPollInterval: defaultHeadPollInterval,
 	}
-	return &Listener{
-		contractAddress: contractAddress,
-		client:          client,
-		blockchainID:    blockchainID,
-		blockStep:       blockStep,
-		logger:          logger.WithName("evm"),
-		handleEvent:     eventHandler,
-		getLatestEvent:  getLatestEvent,
+	if getLatestEvent == nil {
+		l.processedEvents = &processedEventLog{}
+		l.getLatestEvent = l.processedEvents.latest
+		l.rollbackEvents = l.processedEvents.rollback
 	}
+	return l
+}
+
+// SetConfirmations sets the number of blocks that must be built on top of an event's block
+// before the event is handled. Events removed by a chain reorganization before that are never handled.
+// Zero, the default, handles events as soon as they are received.
+func (l *Listener) SetConfirmations(confirmations uint64) {
+	l.confirmations = confirmations
+}
+
+// SetRollbackEvents sets the function removing stored events of blocks that were reorganized out of the chain.
+// It is required when processed events are persisted and read back through the LatestEventGetter.
+// The removed events are replayed from the canonical chain.
+func (l *Listener) SetRollbackEvents(rollbackEvents RollbackEvents) {
+	l.rollbackEvents = rollbackEvents
+}
+
+// SetOnCatchUpProgress sets a callback invoked after each block range of historical events is fetched.
+func (l *Listener) SetOnCatchUpProgress(fn CatchUpProgress) {
+	l.onCatchUpProgress = fn
 }
 
 // Listen starts the event listener in a background goroutine.
@@ -85,28 +125,77 @@ func (l *Listener) Listen(ctx context.Context, handleClosure func(err error)) {
 	}()
 }
 
+// listenerState is the progress of a running listener.
+type listenerState struct {
+	// lastBlock, lastIndex and lastHash identify the latest handled event
+	lastBlock uint64
+	lastIndex uint32
+	lastHash  common.Hash
+
+	// pending holds received events waiting for enough confirmations
+	pending []types.Log
+	// processed holds the events handled since the cursor was reset,
+	// as the historical range and the subscription may overlap
+	processed map[logKey]struct{}
+	// reorged is set once a handled event is known to be removed by a chain reorganization
+	reorged bool
+
+	// catchingUp is set while historical events are fetched,
+	// the events received from the subscription meanwhile are buffered in backlog
+	catchingUp bool
+	backlog    []types.Log
+}
+
+type logKey struct {
+	blockNumber uint64
+	blockHash   common.Hash
+	txHash      common.Hash
+	index       uint
+}
+
+func newLogKey(l types.Log) logKey {
+	return logKey{blockNumber: l.BlockNumber, blockHash: l.BlockHash, txHash: l.TxHash, index: l.Index}
+}
+
 // listenEvents listens for blockchain events and processes them with the provided handler
 func (l *Listener) listenEvents(ctx context.Context) error {
-	ev, err := l.getLatestEvent(l.contractAddress.String(), l.blockchainID)
-	if err != nil {
-		l.logger.Error("failed to get latest processed event", "error", err, "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String())
+	st := &listenerState{}
+	if err := l.resetCursor(ctx, st); err != nil {
+		return err
 	}
-	lastBlock := ev.BlockNumber
-	lastIndex := ev.LogIndex
 
 	var backOffCount atomic.Uint64
 	var historicalCh, currentCh chan types.Log
 	var eventSubscription event.Subscription
+	cancelCatchUp := context.CancelFunc(func() {})
+	defer func() { cancelCatchUp() }()
 
-	l.logger.Info("starting listening events", "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String())
+	headTicker := time.NewTicker(l.headPollInterval)
+	defer headTicker.Stop()
+
+	l.logger.Info("starting listening events", "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String(), "confirmations", l.confirmations, "pollInterval", l.pollInterval)
 	for {
 		if eventSubscription == nil {
 			waitForBackOffTimeout(l.logger, int(backOffCount.Load()), "event subscription")
 
-			historicalCh = make(chan types.Log, 1)
+			cancelCatchUp()
+			st.catchingUp = false
+			st.backlog = nil
+			historicalCh = nil
 			currentCh = make(chan types.Log, 100)
 
-			if lastBlock == 0 {
+			// Subscribe before fetching the latest block, so that no block falls between the historical range and the subscription
+			watchFQ := ethereum.FilterQuery{
+				Addresses: []common.Address{l.contractAddress},
+			}
+			eventSub, err := l.subscribeFilterLogs(watchFQ, currentCh)
+			if err != nil {
+				l.logger.Error("failed to subscribe on events", "error", err, "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String())
+				backOffCount.Add(1)
+				continue
+			}
+
+			if st.lastBlock == 0 {
 				l.logger.Info("skipping historical logs fetching",
 					"blockchainID", l.blockchainID,
 					"contractAddress", l.contractAddress.String())
@@ -118,27 +207,15 @@ func (l *Listener) listenEvents(ctx context.Context) error {
 				cancel()
 				if err != nil {
 					l.logger.Error("failed to get latest block", "error", err, "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String())
+					eventSub.Unsubscribe()
 					backOffCount.Add(1)
 					continue
 				}
 
-				// TODO: ensure that new events start to be processed only after all historical ones are processed
-				go l.reconcileBlockRange(
-					header.Number.Uint64(),
-					lastBlock,
-					lastIndex,
-					historicalCh,
-				)
-			}
-
-			watchFQ := ethereum.FilterQuery{
-				Addresses: []common.Address{l.contractAddress},
-			}
-			eventSub, err := l.client.SubscribeFilterLogs(context.Background(), watchFQ, currentCh)
-			if err != nil {
-				l.logger.Error("failed to subscribe on events", "error", err, "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String())
-				backOffCount.Add(1)
-				continue
+				// Live events are buffered until all historical ones are received, so that events are handled in order
+				historicalCh = make(chan types.Log, 1)
+				cancelCatchUp = l.startCatchUp(ctx, header.Number.Uint64(), st.lastBlock, st.lastIndex, historicalCh)
+				st.catchingUp = true
 			}
 
 			eventSubscription = eventSub
@@ -151,19 +228,23 @@ func (l *Listener) listenEvents(ctx context.Context) error {
 			l.logger.Info("stopping event listener", "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String())
 			eventSubscription.Unsubscribe()
 			return nil
-		case eventLog := <-historicalCh:
-			l.logger.Debug("received new event", "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String(), "blockNumber", lastBlock, "logIndex", eventLog.Index)
-
-			ctx := log.SetContextLogger(context.Background(), l.logger)
-			if err := l.handleEvent(ctx, eventLog); err != nil {
+		case eventLog, ok := <-historicalCh:
+			if !ok {
+				historicalCh = nil
+				if err := l.finishCatchUp(st); err != nil {
+					return err
+				}
+			} else if err := l.receiveEvent(st, eventLog); err != nil {
 				return err
 			}
 		case eventLog := <-currentCh:
-			lastBlock = eventLog.BlockNumber
-			l.logger.Debug("received new event", "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String(), "blockNumber", lastBlock, "logIndex", eventLog.Index)
-
-			ctx := log.SetContextLogger(context.Background(), l.logger)
-			if err := l.handleEvent(ctx, eventLog); err != nil {
+			if st.catchingUp {
+				st.backlog = append(st.backlog, eventLog)
+			} else if err := l.receiveEvent(st, eventLog); err != nil {
+				return err
+			}
+		case <-headTicker.C:
+			if err := l.processHead(ctx, st); err != nil {
 				return err
 			}
 		case err := <-eventSubscription.Err():
@@ -177,26 +258,302 @@ func (l *Listener) listenEvents(ctx context.Context) error {
 
 			eventSubscription = nil
 		}
+
+		if st.reorged {
+			l.logger.Warn("chain reorganization removed processed events, replaying from the canonical chain",
+				"blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String(), "blockNumber", st.lastBlock)
+			if eventSubscription != nil {
+				eventSubscription.Unsubscribe()
+				eventSubscription = nil
+			}
+			if err := l.resetCursor(ctx, st); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// startCatchUp fetches the historical events in a background goroutine and closes historicalCh once all are sent.
+// The returned function stops the catch-up.
+func (l *Listener) startCatchUp(ctx context.Context, currentBlock, lastBlock uint64, lastIndex uint32, historicalCh chan types.Log) context.CancelFunc {
+	catchUpCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		defer close(historicalCh)
+		l.reconcileBlockRange(catchUpCtx, currentBlock, lastBlock, lastIndex, historicalCh)
+	}()
+	return cancel
+}
+
+// finishCatchUp is called once all historical events were received.
+// It passes on the events received from the subscription in the meantime that weren't among the historical ones.
+func (l *Listener) finishCatchUp(st *listenerState) error {
+	backlog := st.backlog
+	st.catchingUp = false
+	st.backlog = nil
+
+	l.logger.Info("caught up with historical events", "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String(), "bufferedEvents", len(backlog))
+	for _, eventLog := range backlog {
+		if !eventLog.Removed && (eventLog.BlockNumber < st.lastBlock ||
+			eventLog.BlockNumber == st.lastBlock && eventLog.Index <= uint(st.lastIndex)) {
+			continue
+		}
+		if err := l.receiveEvent(st, eventLog); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// receiveEvent handles the event right away or, when confirmations are required, queues it until it is confirmed.
+func (l *Listener) receiveEvent(st *listenerState, eventLog types.Log) error {
+	if eventLog.Removed {
+		for i, pendingLog := range st.pending {
+			if newLogKey(pendingLog) == newLogKey(eventLog) {
+				st.pending = append(st.pending[:i], st.pending[i+1:]...)
+				l.logger.Info("discarding unconfirmed event removed by a chain reorganization", "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String(), "blockNumber", eventLog.BlockNumber, "logIndex", eventLog.Index)
+				return nil
+			}
+		}
+		if eventLog.BlockNumber <= st.lastBlock {
+			st.reorged = true
+		}
+		return nil
+	}
+
+	if l.confirmations == 0 {
+		return l.processEvent(st, eventLog)
+	}
+
+	for _, pendingLog := range st.pending {
+		if newLogKey(pendingLog) == newLogKey(eventLog) {
+			return nil
+		}
+	}
+	st.pending = append(st.pending, eventLog)
+	return nil
+}
+
+// processEvent passes the event to the handler and advances the cursor.
+func (l *Listener) processEvent(st *listenerState, eventLog types.Log) error {
+	key := newLogKey(eventLog)
+	if _, ok := st.processed[key]; ok {
+		return nil
+	}
+
+	l.logger.Debug("received new event", "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String(), "blockNumber", eventLog.BlockNumber, "logIndex", eventLog.Index)
+
+	ctx := log.SetContextLogger(context.Background(), l.logger)
+	if err := l.handleEvent(ctx, eventLog); err != nil {
+		return err
+	}
+
+	if len(st.processed) >= maxTrackedEvents {
+		st.processed = make(map[logKey]struct{})
+	}
+	st.processed[key] = struct{}{}
+
+	if eventLog.BlockNumber > st.lastBlock || (eventLog.BlockNumber == st.lastBlock && uint32(eventLog.Index) >= st.lastIndex) {
+		st.lastBlock = eventLog.BlockNumber
+		st.lastIndex = uint32(eventLog.Index)
+		st.lastHash = eventLog.BlockHash
+	}
+	if l.processedEvents != nil {
+		l.processedEvents.add(core.BlockchainEvent{
+			ContractAddress: eventLog.Address.Hex(),
+			BlockchainID:    l.blockchainID,
+			BlockNumber:     eventLog.BlockNumber,
+			BlockHash:       eventLog.BlockHash.Hex(),
+			TransactionHash: eventLog.TxHash.Hex(),
+			LogIndex:        uint32(eventLog.Index),
+		})
+	}
+	return nil
+}
+
+// processHead checks that the latest handled event is still on the canonical chain
+// and handles the queued events that have enough confirmations.
+func (l *Listener) processHead(ctx context.Context, st *listenerState) error {
+	if l.confirmations == 0 && st.lastHash == (common.Hash{}) {
+		return nil
+	}
+
+	head, err := l.headerByNumber(ctx, nil)
+	if err != nil {
+		l.logger.Warn("failed to get latest block", "error", err, "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String())
+		return nil
+	}
+
+	if st.lastHash != (common.Hash{}) {
+		canonical, err := l.isCanonical(ctx, st.lastBlock, st.lastHash)
+		if err != nil {
+			l.logger.Warn("failed to check block of the latest event", "error", err, "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String(), "blockNumber", st.lastBlock)
+			return nil
+		}
+		if !canonical {
+			st.reorged = true
+			return nil
+		}
+	}
+
+	if len(st.pending) == 0 || head.Number.Uint64() < l.confirmations {
+		return nil
 	}
+	confirmedBlock := head.Number.Uint64() - l.confirmations
+
+	sort.SliceStable(st.pending, func(i, j int) bool {
+		if st.pending[i].BlockNumber != st.pending[j].BlockNumber {
+			return st.pending[i].BlockNumber < st.pending[j].BlockNumber
+		}
+		return st.pending[i].Index < st.pending[j].Index
+	})
+
+	canonicalBlocks := make(map[common.Hash]bool)
+	for len(st.pending) > 0 && st.pending[0].BlockNumber <= confirmedBlock {
+		eventLog := st.pending[0]
+
+		canonical, ok := canonicalBlocks[eventLog.BlockHash]
+		if !ok {
+			canonical, err = l.isCanonical(ctx, eventLog.BlockNumber, eventLog.BlockHash)
+			if err != nil {
+				l.logger.Warn("failed to check block of a confirmed event", "error", err, "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String(), "blockNumber", eventLog.BlockNumber)
+				return nil
+			}
+			canonicalBlocks[eventLog.BlockHash] = canonical
+		}
+
+		st.pending = st.pending[1:]
+		if !canonical {
+			l.logger.Info("discarding event of a block removed by a chain reorganization", "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String(), "blockNumber", eventLog.BlockNumber, "logIndex", eventLog.Index)
+			continue
+		}
+
+		if err := l.processEvent(st, eventLog); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
+// resetCursor rolls back the processed events of blocks that are no longer canonical
+// and positions the cursor so that the events after the latest remaining one are fetched again.
+func (l *Listener) resetCursor(ctx context.Context, st *listenerState) error {
+	ev, forkBlock, err := l.rollbackReorgedEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	*st = listenerState{
+		lastBlock: ev.BlockNumber,
+		lastIndex: ev.LogIndex,
+		lastHash:  common.HexToHash(ev.BlockHash),
+		processed: make(map[logKey]struct{}),
+	}
+	if ev.BlockNumber == 0 && forkBlock > 1 {
+		// All processed events were rolled back: replay everything from the fork block on
+		st.lastBlock = forkBlock - 1
+		st.lastIndex = math.MaxUint32
+	}
+	return nil
+}
+
+// rollbackReorgedEvents removes the processed events of blocks that are no longer canonical, newest first.
+// It returns the latest remaining event and the lowest block events were removed from, if any.
+// Events stored without a block hash are considered final.
+func (l *Listener) rollbackReorgedEvents(ctx context.Context) (core.BlockchainEvent, uint64, error) {
+	var forkBlock uint64
+	backOffCount := 0
+	for {
+		waitForBackOffTimeout(l.logger, backOffCount, "reorg rollback")
+		if ctx.Err() != nil {
+			return core.BlockchainEvent{}, 0, nil
+		}
+
+		ev, err := l.getLatestEvent(l.contractAddress.String(), l.blockchainID)
+		if err != nil {
+			l.logger.Error("failed to get latest processed event", "error", err, "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String())
+			backOffCount++
+			continue
+		}
+
+		blockHash := common.HexToHash(ev.BlockHash)
+		if ev.BlockNumber == 0 || blockHash == (common.Hash{}) {
+			return ev, forkBlock, nil
+		}
+
+		canonical, err := l.isCanonical(ctx, ev.BlockNumber, blockHash)
+		if err != nil {
+			l.logger.Error("failed to check block of the latest processed event", "error", err, "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String(), "blockNumber", ev.BlockNumber)
+			backOffCount++
+			continue
+		}
+		if canonical {
+			return ev, forkBlock, nil
+		}
+
+		if l.rollbackEvents == nil {
+			return ev, forkBlock, errors.Errorf("events of block %d were removed by a chain reorganization, but processed events can't be rolled back", ev.BlockNumber)
+		}
+		removed, err := l.rollbackEvents(l.contractAddress.String(), l.blockchainID, ev.BlockNumber)
+		if err != nil {
+			l.logger.Error("failed to roll back processed events", "error", err, "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String(), "fromBlock", ev.BlockNumber)
+			backOffCount++
+			continue
+		}
+		if len(removed) == 0 {
+			return ev, forkBlock, errors.Errorf("no processed events were rolled back from block %d", ev.BlockNumber)
+		}
+
+		for _, r := range removed {
+			l.logger.Warn("rolled back event removed by a chain reorganization",
+				"blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String(), "name", r.Name,
+				"blockNumber", r.BlockNumber, "blockHash", r.BlockHash, "txHash", r.TransactionHash, "logIndex", r.LogIndex)
+		}
+		forkBlock = ev.BlockNumber
+		backOffCount = 0
+	}
+}
+
+// isCanonical reports whether the block with the given number and hash is part of the canonical chain.
+func (l *Listener) isCanonical(ctx context.Context, blockNumber uint64, blockHash common.Hash) (bool, error) {
+	header, err := l.headerByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+	if errors.Is(err, ethereum.NotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return header.Hash() == blockHash, nil
+}
+
+func (l *Listener) headerByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	headerCtx, cancel := context.WithTimeout(ctx, 1*time.Minute)
+	defer cancel()
+	return l.client.HeaderByNumber(headerCtx, number)
+}
+
+// reconcileBlockRange fetches the events from lastBlock to currentBlock and sends them to historicalCh in order.
+// The block range is halved when fetching fails, as providers reject too large ranges,
+// and grows back up to blockStep after successful fetches.
 func (l *Listener) reconcileBlockRange(
+	ctx context.Context,
 	currentBlock uint64,
 	lastBlock uint64,
 	lastIndex uint32,
-	historicalCh chan types.Log,
+	historicalCh chan<- types.Log,
 ) {
-	var backOffCount atomic.Uint64
+	backOffCount := 0
+	blockRange := l.blockStep
 	startBlock := lastBlock
-	endBlock := startBlock + l.blockStep
 
-	for currentBlock > startBlock {
-		waitForBackOffTimeout(l.logger, int(backOffCount.Load()), "reconcile block range")
+	for startBlock <= currentBlock {
+		waitForBackOffTimeout(l.logger, backOffCount, "reconcile block range")
+		if ctx.Err() != nil {
+			return
+		}
 
 		// We need to refetch events starting from last known block without adding 1 to it
 		// because it's possible that block includes more than 1 event, and some may be still unprocessed.
-		//
-		// This will cause duplicate key error in logs, but it's completely fine.
+		endBlock := startBlock + blockRange
 		if endBlock > currentBlock {
 			endBlock = currentBlock
 		}
@@ -205,23 +562,37 @@ func (l *Listener) reconcileBlockRange(
 			Addresses: []common.Address{l.contractAddress},
 			FromBlock: new(big.Int).SetUint64(startBlock),
 			ToBlock:   new(big.Int).SetUint64(endBlock),
-			// Topics:    topics,
 		}
 
-		logsCtx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+		logsCtx, cancel := context.WithTimeout(ctx, 1*time.Minute)
 		logs, err := l.client.FilterLogs(logsCtx, fetchFQ)
 		cancel()
 		if err != nil {
-			// TODO: divide previous block range by 2
-			backOffCount.Add(1)
+			if ctx.Err() != nil {
+				return
+			}
+			if endBlock > startBlock {
+				blockRange = (endBlock - startBlock) / 2
+				l.logger.Warn("failed to filter logs, shrinking block range",
+					"error", err,
+					"blockchainID", l.blockchainID,
+					"contractAddress", l.contractAddress.String(),
+					"startBlock", startBlock,
+					"endBlock", endBlock,
+					"blockRange", blockRange)
+				continue
+			}
+
+			backOffCount++
 			l.logger.Error("failed to filter logs",
 				"error", err,
 				"blockchainID", l.blockchainID,
 				"contractAddress", l.contractAddress.String(),
 				"startBlock", startBlock,
 				"endBlock", endBlock)
-			continue // retry with the advised block range
+			continue
 		}
+		backOffCount = 0
 		l.logger.Info("fetched historical logs",
 			"blockchainID", l.blockchainID,
 			"contractAddress", l.contractAddress.String(),
@@ -236,10 +607,63 @@ func (l *Listener) reconcileBlockRange(
 				continue
 			}
 
-			historicalCh <- ethLog
+			select {
+			case historicalCh <- ethLog:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if l.onCatchUpProgress != nil {
+			l.onCatchUpProgress(l.blockchainID, l.contractAddress.String(), endBlock, currentBlock, blockRange)
 		}
 
 		startBlock = endBlock + 1
-		endBlock += l.blockStep
+		blockRange = min(max(blockRange*2, 1), l.blockStep)
+	}
+}
+
+// processedEventLog remembers the latest processed events of listeners that don't persist them.
+type processedEventLog struct {
+	mu     sync.Mutex
+	events []core.BlockchainEvent
+}
+
+func (p *processedEventLog) add(ev core.BlockchainEvent) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = append(p.events, ev)
+	if len(p.events) > maxTrackedEvents {
+		p.events = p.events[len(p.events)-maxTrackedEvents:]
+	}
+}
+
+func (p *processedEventLog) latest(string, uint64) (core.BlockchainEvent, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var latest core.BlockchainEvent
+	for _, ev := range p.events {
+		if ev.BlockNumber > latest.BlockNumber || (ev.BlockNumber == latest.BlockNumber && ev.LogIndex >= latest.LogIndex) {
+			latest = ev
+		}
+	}
+	return latest, nil
+}
+
+func (p *processedEventLog) rollback(_ string, _ uint64, fromBlock uint64) ([]core.BlockchainEvent, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var kept, removed []core.BlockchainEvent
+	for _, ev := range p.events {
+		if ev.BlockNumber >= fromBlock {
+			removed = append(removed, ev)
+		} else {
+			kept = append(kept, ev)
+		}
 	}
+	p.events = kept
+	return removed, nil
 }
@@ -0,0 +1,122 @@
+package evm
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/pkg/errors"
+)
+
+// SetPollInterval switches the listener to polling for events with eth_getLogs at the given interval,
+// for RPC endpoints that don't support subscriptions. Zero, the default, subscribes to events.
+func (l *Listener) SetPollInterval(interval time.Duration) {
+	l.pollInterval = interval
+}
+
+// subscribeFilterLogs subscribes to the logs matching the query, or polls for them in polling mode.
+func (l *Listener) subscribeFilterLogs(q ethereum.FilterQuery, ch chan<- types.Log) (event.Subscription, error) {
+	if l.pollInterval > 0 {
+		return l.pollFilterLogs(q, ch)
+	}
+	return l.client.SubscribeFilterLogs(context.Background(), q, ch)
+}
+
+// pollFilterLogs emulates SubscribeFilterLogs by fetching the logs of new blocks every pollInterval.
+// Like a subscription, it delivers the logs of the blocks built after it was started.
+// Polling doesn't report removed logs, instead the subscription fails when the last polled block
+// is reorganized out of the chain, so that the listener resubscribes and refetches from its cursor.
+func (l *Listener) pollFilterLogs(q ethereum.FilterQuery, ch chan<- types.Log) (event.Subscription, error) {
+	head, err := l.headerByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get latest block")
+	}
+	lastBlock, lastHash := head.Number.Uint64(), head.Hash()
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			select {
+			case <-quit:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		ticker := time.NewTicker(l.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-quit:
+				return nil
+			case <-ticker.C:
+			}
+
+			toBlock, toHash, logs, err := l.pollNewLogs(ctx, q, lastBlock, lastHash)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				if errors.Is(err, errPolledBlockReorged) {
+					return err
+				}
+				l.logger.Warn("failed to poll events", "error", err, "blockchainID", l.blockchainID, "contractAddress", l.contractAddress.String(), "lastBlock", lastBlock)
+				continue
+			}
+
+			for _, eventLog := range logs {
+				select {
+				case ch <- eventLog:
+				case <-quit:
+					return nil
+				}
+			}
+			lastBlock, lastHash = toBlock, toHash
+		}
+	}), nil
+}
+
+var errPolledBlockReorged = errors.New("polled block was reorganized out of the chain")
+
+// pollNewLogs fetches the logs of at most blockStep blocks built on top of the last polled block.
+// It returns the last block fetched, which is the last polled block itself when there is no new block.
+func (l *Listener) pollNewLogs(ctx context.Context, q ethereum.FilterQuery, lastBlock uint64, lastHash common.Hash) (uint64, common.Hash, []types.Log, error) {
+	head, err := l.headerByNumber(ctx, nil)
+	if err != nil {
+		return 0, common.Hash{}, nil, errors.Wrap(err, "failed to get latest block")
+	}
+	if head.Number.Uint64() <= lastBlock {
+		return lastBlock, lastHash, nil, nil
+	}
+
+	canonical, err := l.isCanonical(ctx, lastBlock, lastHash)
+	if err != nil {
+		return 0, common.Hash{}, nil, errors.Wrap(err, "failed to check last polled block")
+	}
+	if !canonical {
+		return 0, common.Hash{}, nil, errPolledBlockReorged
+	}
+
+	toHeader := head
+	if toBlock := lastBlock + max(l.blockStep, 1); toBlock < head.Number.Uint64() {
+		if toHeader, err = l.headerByNumber(ctx, new(big.Int).SetUint64(toBlock)); err != nil {
+			return 0, common.Hash{}, nil, errors.Wrap(err, "failed to get block")
+		}
+	}
+
+	q.FromBlock = new(big.Int).SetUint64(lastBlock + 1)
+	q.ToBlock = toHeader.Number
+	logsCtx, cancel := context.WithTimeout(ctx, 1*time.Minute)
+	defer cancel()
+	logs, err := l.client.FilterLogs(logsCtx, q)
+	if err != nil {
+		return 0, common.Hash{}, nil, errors.Wrap(err, "failed to filter logs")
+	}
+	return toHeader.Number.Uint64(), toHeader.Hash(), logs, nil
+}
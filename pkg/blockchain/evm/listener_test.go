@@ -2,6 +2,7 @@ package evm
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"sync"
 	"sync/atomic"
@@ -9,8 +10,12 @@ import (
 	"time"
 
 	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
 	"github.com/layer-3/nitrolite/pkg/core"
 	"github.com/layer-3/nitrolite/pkg/log"
 	"github.com/stretchr/testify/assert"
@@ -129,7 +134,7 @@ func TestListener_ReconcileBlockRange(t *testing.T) {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		listener.reconcileBlockRange(120, 100, 0, historicalCh)
+		listener.reconcileBlockRange(context.Background(), 120, 100, 0, historicalCh)
 		close(historicalCh)
 	}()
 
@@ -203,3 +208,579 @@ func TestListener_Listen_HistoricalAndCurrent(t *testing.T) {
 		t.Fatal("timeout waiting for events")
 	}
 }
+
+func TestListener_ReconcileBlockRange_AdaptiveRange(t *testing.T) {
+	t.Parallel()
+	mockClient := new(MockEVMClient)
+	listener := NewListener(common.HexToAddress("0x123"), mockClient, 1, 10, log.NewNoopLogger(), nil, nil)
+
+	type progress struct{ fetched, target, blockRange uint64 }
+	var reported []progress
+	listener.SetOnCatchUpProgress(func(blockchainID uint64, contractAddress string, fetchedBlock, targetBlock, blockRange uint64) {
+		assert.Equal(t, uint64(1), blockchainID)
+		assert.Equal(t, common.HexToAddress("0x123").String(), contractAddress)
+		reported = append(reported, progress{fetchedBlock, targetBlock, blockRange})
+	})
+
+	filterRange := func(from, to uint64) interface{} {
+		return mock.MatchedBy(func(q ethereum.FilterQuery) bool {
+			return q.FromBlock.Uint64() == from && q.ToBlock.Uint64() == to
+		})
+	}
+	errTooLarge := fmt.Errorf("query returned more than 10000 results")
+
+	// The range is halved when rejected and grows back after a successful fetch
+	mockClient.On("FilterLogs", mock.Anything, filterRange(100, 110)).Return(nil, errTooLarge).Once()
+	mockClient.On("FilterLogs", mock.Anything, filterRange(100, 105)).Return([]types.Log{{BlockNumber: 101}}, nil).Once()
+	mockClient.On("FilterLogs", mock.Anything, filterRange(106, 116)).Return(nil, errTooLarge).Once()
+	mockClient.On("FilterLogs", mock.Anything, filterRange(106, 111)).Return([]types.Log{{BlockNumber: 107}}, nil).Once()
+	mockClient.On("FilterLogs", mock.Anything, filterRange(112, 120)).Return([]types.Log{{BlockNumber: 120}}, nil).Once()
+
+	historicalCh := make(chan types.Log, 10)
+	listener.reconcileBlockRange(context.Background(), 120, 100, 0, historicalCh)
+	close(historicalCh)
+
+	var received []uint64
+	for l := range historicalCh {
+		received = append(received, l.BlockNumber)
+	}
+	assert.Equal(t, []uint64{101, 107, 120}, received)
+	assert.Equal(t, []progress{{105, 120, 5}, {111, 120, 5}, {120, 120, 10}}, reported)
+	mockClient.AssertExpectations(t)
+}
+
+func TestListener_ReconcileBlockRange_Cancelled(t *testing.T) {
+	t.Parallel()
+	mockClient := new(MockEVMClient)
+	listener := NewListener(common.HexToAddress("0x123"), mockClient, 1, 10, log.NewNoopLogger(), nil, nil)
+
+	mockClient.On("FilterLogs", mock.Anything, mock.Anything).Return([]types.Log{{BlockNumber: 105}, {BlockNumber: 106}}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	historicalCh := make(chan types.Log)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		listener.reconcileBlockRange(ctx, 1000, 100, 0, historicalCh)
+	}()
+
+	<-historicalCh
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("reconcileBlockRange didn't stop after cancellation")
+	}
+}
+
+func TestListener_Listen_HistoricalBeforeLive(t *testing.T) {
+	t.Parallel()
+	mockClient := new(MockEVMClient)
+
+	getLatestEvent := func(contractAddress string, networkID uint64) (core.BlockchainEvent, error) {
+		return core.BlockchainEvent{BlockNumber: 100, LogIndex: 0}, nil
+	}
+
+	var mu sync.Mutex
+	var handled []uint64
+	doneCh := make(chan struct{})
+	handleEvent := func(ctx context.Context, log types.Log) error {
+		mu.Lock()
+		defer mu.Unlock()
+		handled = append(handled, log.BlockNumber)
+		if len(handled) == 3 {
+			close(doneCh)
+		}
+		return nil
+	}
+
+	listener := NewListener(common.HexToAddress("0x123"), mockClient, 1, 10, log.NewNoopLogger(), handleEvent, getLatestEvent)
+
+	mockClient.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(&types.Header{Number: big.NewInt(110)}, nil)
+
+	// Historical logs are fetched slowly, after the live ones were received
+	mockClient.On("FilterLogs", mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) { time.Sleep(50 * time.Millisecond) }).
+		Return([]types.Log{{BlockNumber: 105}, {BlockNumber: 110}}, nil)
+
+	sub := &MockSubscription{errChan: make(chan error)}
+	mockClient.On("SubscribeFilterLogs", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			ch := args.Get(2).(chan<- types.Log)
+			// The subscription overlaps with the historical range
+			ch <- types.Log{BlockNumber: 110}
+			ch <- types.Log{BlockNumber: 111}
+		}).
+		Return(sub, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	go listener.Listen(ctx, func(err error) {})
+
+	select {
+	case <-doneCh:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for events")
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []uint64{105, 110, 111}, handled)
+}
+
+// logEmitterCode deploys a contract that emits LOG2 with the first two calldata words as topics
+// and the rest of the calldata as data.
+var logEmitterCode = common.FromHex(
+	// initcode: codecopy(0, 0x0b, 0x14); return(0, 0x14)
+	"0x601480600b6000396000f3" +
+		// runtime: calldatacopy(0, 0, calldatasize); log2(0x40, calldatasize - 0x40, mload(0), mload(0x20))
+		"366000600037602051600051604036036040a200",
+)
+
+type simulatedEmitter struct {
+	backend *simulated.Backend
+	opts    *bind.TransactOpts
+	emitter *bind.BoundContract
+	address common.Address
+}
+
+func newSimulatedEmitter(t *testing.T) *simulatedEmitter {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	deployer := crypto.PubkeyToAddress(key.PublicKey)
+
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		deployer: {Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(1e18))},
+	})
+	t.Cleanup(func() { backend.Close() })
+
+	chainID, err := backend.Client().ChainID(context.Background())
+	require.NoError(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	require.NoError(t, err)
+
+	address, _, emitter, err := bind.DeployContract(opts, abi.ABI{}, logEmitterCode, backend.Client())
+	require.NoError(t, err)
+	backend.Commit()
+
+	return &simulatedEmitter{backend: backend, opts: opts, emitter: emitter, address: address}
+}
+
+// emit sends a transaction emitting an event without mining it.
+func (e *simulatedEmitter) emit(t *testing.T) {
+	t.Helper()
+	_, err := e.emitter.RawTransact(e.opts, make([]byte, 64))
+	require.NoError(t, err)
+}
+
+func (e *simulatedEmitter) header(t *testing.T, number uint64) *types.Header {
+	t.Helper()
+	header, err := e.backend.Client().HeaderByNumber(context.Background(), new(big.Int).SetUint64(number))
+	require.NoError(t, err)
+	return header
+}
+
+// eventBlocks returns the hashes of the blocks of the canonical chain the emitter's events are in.
+func (e *simulatedEmitter) eventBlocks(t *testing.T) []common.Hash {
+	t.Helper()
+	logs, err := e.backend.Client().FilterLogs(context.Background(), ethereum.FilterQuery{
+		Addresses: []common.Address{e.address},
+		FromBlock: big.NewInt(0),
+	})
+	require.NoError(t, err)
+
+	hashes := make([]common.Hash, len(logs))
+	for i, l := range logs {
+		hashes[i] = l.BlockHash
+	}
+	return hashes
+}
+
+// commitUntilIncluded mines blocks on a forked chain until the transaction removed with the
+// reorganized block is included again, and returns the block of its event.
+func commitUntilIncluded(t *testing.T, chain *simulatedEmitter, emitted int) []common.Hash {
+	t.Helper()
+	var blocks []common.Hash
+	require.Eventually(t, func() bool {
+		chain.backend.Commit()
+		blocks = chain.eventBlocks(t)
+		return len(blocks) == emitted
+	}, 5*time.Second, 10*time.Millisecond)
+	return blocks
+}
+
+// testEventStore persists processed events in memory the way clearnode's contract event store does.
+type testEventStore struct {
+	mu       sync.Mutex
+	events   []core.BlockchainEvent
+	handled  []types.Log
+	rollback []core.BlockchainEvent
+}
+
+func (s *testEventStore) handleEvent(_ context.Context, l types.Log) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, ev := range s.events {
+		if ev.TransactionHash == l.TxHash.Hex() && ev.LogIndex == uint32(l.Index) {
+			return fmt.Errorf("duplicate event %s:%d", ev.TransactionHash, ev.LogIndex)
+		}
+	}
+	s.handled = append(s.handled, l)
+	s.events = append(s.events, core.BlockchainEvent{
+		BlockNumber:     l.BlockNumber,
+		BlockHash:       l.BlockHash.Hex(),
+		TransactionHash: l.TxHash.Hex(),
+		LogIndex:        uint32(l.Index),
+	})
+	return nil
+}
+
+func (s *testEventStore) getLatestEvent(string, uint64) (core.BlockchainEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.events) == 0 {
+		return core.BlockchainEvent{}, nil
+	}
+	return s.events[len(s.events)-1], nil
+}
+
+func (s *testEventStore) rollbackEvents(_ string, _ uint64, fromBlock uint64) ([]core.BlockchainEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var kept, removed []core.BlockchainEvent
+	for _, ev := range s.events {
+		if ev.BlockNumber >= fromBlock {
+			removed = append(removed, ev)
+		} else {
+			kept = append(kept, ev)
+		}
+	}
+	s.events = kept
+	s.rollback = append(s.rollback, removed...)
+	return removed, nil
+}
+
+func (s *testEventStore) handledBlocks() []common.Hash {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hashes := make([]common.Hash, len(s.handled))
+	for i, l := range s.handled {
+		hashes[i] = l.BlockHash
+	}
+	return hashes
+}
+
+// noSubscriptionClient is a client of an RPC endpoint without subscription support, like HTTP ones.
+type noSubscriptionClient struct {
+	simulated.Client
+}
+
+func (noSubscriptionClient) SubscribeFilterLogs(context.Context, ethereum.FilterQuery, chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, fmt.Errorf("notifications not supported")
+}
+
+// startTestListener starts listening from the emitter's deployment block.
+// A non-zero pollInterval starts the listener in polling mode against an endpoint without subscriptions.
+func startTestListener(t *testing.T, chain *simulatedEmitter, store *testEventStore, confirmations uint64, pollInterval ...time.Duration) {
+	t.Helper()
+
+	deployBlock := chain.header(t, 1)
+	store.events = []core.BlockchainEvent{{BlockNumber: 1, BlockHash: deployBlock.Hash().Hex()}}
+
+	var client bind.ContractBackend = chain.backend.Client()
+	if len(pollInterval) > 0 {
+		client = noSubscriptionClient{chain.backend.Client()}
+	}
+	listener := NewListener(chain.address, client, 1337, 100, log.NewNoopLogger(), store.handleEvent, store.getLatestEvent)
+	listener.SetConfirmations(confirmations)
+	listener.SetRollbackEvents(store.rollbackEvents)
+	listener.headPollInterval = 10 * time.Millisecond
+	if len(pollInterval) > 0 {
+		listener.SetPollInterval(pollInterval[0])
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan error, 1)
+	listener.Listen(ctx, func(err error) { stopped <- err })
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-stopped)
+	})
+}
+
+func TestListener_Simulated_Confirmations(t *testing.T) {
+	t.Parallel()
+	chain := newSimulatedEmitter(t)
+	store := &testEventStore{}
+
+	chain.emit(t)
+	chain.backend.Commit()
+	eventBlock := chain.header(t, 2).Hash()
+
+	startTestListener(t, chain, store, 2)
+
+	chain.backend.Commit()
+	assert.Never(t, func() bool { return len(store.handledBlocks()) > 0 }, 200*time.Millisecond, 10*time.Millisecond,
+		"the event has only one confirmation")
+
+	chain.backend.Commit()
+	require.Eventually(t, func() bool { return len(store.handledBlocks()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []common.Hash{eventBlock}, store.handledBlocks())
+}
+
+func TestListener_Simulated_ReorgBeforeConfirmation(t *testing.T) {
+	t.Parallel()
+	chain := newSimulatedEmitter(t)
+	store := &testEventStore{}
+	startTestListener(t, chain, store, 2)
+	time.Sleep(100 * time.Millisecond) // let the listener subscribe
+
+	chain.emit(t)
+	chain.backend.Commit()
+	time.Sleep(100 * time.Millisecond)
+
+	// The transaction is included again in a block of the new chain
+	require.NoError(t, chain.backend.Fork(chain.header(t, 1).Hash()))
+	commitUntilIncluded(t, chain, 1)
+	chain.backend.Commit()
+	chain.backend.Commit()
+
+	require.Eventually(t, func() bool { return len(store.handledBlocks()) > 0 }, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, chain.eventBlocks(t), store.handledBlocks(), "only the event of the canonical block is handled")
+	assert.Empty(t, store.rollback)
+}
+
+func TestListener_Simulated_ReorgAfterProcessing(t *testing.T) {
+	t.Parallel()
+	chain := newSimulatedEmitter(t)
+	store := &testEventStore{}
+	startTestListener(t, chain, store, 0)
+	time.Sleep(100 * time.Millisecond) // let the listener subscribe
+
+	chain.emit(t)
+	chain.backend.Commit()
+	reorgedBlock := chain.header(t, 2).Hash()
+	require.Eventually(t, func() bool { return len(store.handledBlocks()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, chain.backend.Fork(chain.header(t, 1).Hash()))
+	canonicalBlock := commitUntilIncluded(t, chain, 1)[0]
+	require.NotEqual(t, reorgedBlock, canonicalBlock)
+
+	// The processed event is rolled back and the event of the canonical chain is replayed
+	require.Eventually(t, func() bool { return len(store.handledBlocks()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []common.Hash{reorgedBlock, canonicalBlock}, store.handledBlocks())
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	require.Len(t, store.rollback, 1)
+	assert.Equal(t, reorgedBlock.Hex(), store.rollback[0].BlockHash)
+	assert.Equal(t, canonicalBlock.Hex(), store.events[len(store.events)-1].BlockHash)
+}
+
+func TestListener_Simulated_ReorgWhileOffline(t *testing.T) {
+	t.Parallel()
+	chain := newSimulatedEmitter(t)
+
+	chain.emit(t)
+	chain.backend.Commit()
+	reorgedBlock := chain.header(t, 2)
+
+	require.NoError(t, chain.backend.Fork(chain.header(t, 1).Hash()))
+	canonicalBlock := commitUntilIncluded(t, chain, 1)[0]
+
+	// The event of the reorganized block was processed before the listener restarted
+	store := &testEventStore{}
+	deployBlock := chain.header(t, 1)
+	listener := NewListener(chain.address, chain.backend.Client(), 1337, 100, log.NewNoopLogger(), store.handleEvent, store.getLatestEvent)
+	listener.SetRollbackEvents(store.rollbackEvents)
+	store.events = []core.BlockchainEvent{
+		{BlockNumber: 1, BlockHash: deployBlock.Hash().Hex()},
+		{BlockNumber: 2, BlockHash: reorgedBlock.Hash().Hex(), TransactionHash: "0xreorged"},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan error, 1)
+	listener.Listen(ctx, func(err error) { stopped <- err })
+
+	require.Eventually(t, func() bool { return len(store.handledBlocks()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	require.NoError(t, <-stopped)
+
+	assert.Equal(t, []common.Hash{canonicalBlock}, store.handledBlocks())
+	require.Len(t, store.rollback, 1)
+	assert.Equal(t, "0xreorged", store.rollback[0].TransactionHash)
+}
+
+func TestListener_Simulated_ReorgWithoutPersistence(t *testing.T) {
+	t.Parallel()
+	chain := newSimulatedEmitter(t)
+
+	var mu sync.Mutex
+	var handled []common.Hash
+	handleEvent := func(_ context.Context, l types.Log) error {
+		mu.Lock()
+		defer mu.Unlock()
+		handled = append(handled, l.BlockHash)
+		return nil
+	}
+	handledBlocks := func() []common.Hash {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]common.Hash(nil), handled...)
+	}
+
+	listener := NewListener(chain.address, chain.backend.Client(), 1337, 100, log.NewNoopLogger(), handleEvent, nil)
+	listener.headPollInterval = 10 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	listener.Listen(ctx, func(error) {})
+
+	// The listener starts from the chain head, so events are emitted until it has subscribed
+	emitted := 0
+	require.Eventually(t, func() bool {
+		if len(handledBlocks()) > 0 {
+			return true
+		}
+		chain.emit(t)
+		chain.backend.Commit()
+		emitted++
+		return false
+	}, 5*time.Second, 50*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	processed := handledBlocks()
+	reorgedHeader, err := chain.backend.Client().HeaderByHash(context.Background(), processed[0])
+	require.NoError(t, err)
+
+	// All processed events are removed and included again in the new chain
+	require.NoError(t, chain.backend.Fork(reorgedHeader.ParentHash))
+	canonicalBlocks := commitUntilIncluded(t, chain, emitted)
+	replayed := canonicalBlocks[len(canonicalBlocks)-len(processed):]
+
+	require.Eventually(t, func() bool { return len(handledBlocks()) == 2*len(processed) }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, append(processed, replayed...), handledBlocks())
+}
+
+func TestListener_Simulated_Polling(t *testing.T) {
+	t.Parallel()
+	chain := newSimulatedEmitter(t)
+	store := &testEventStore{}
+
+	// Historical event
+	chain.emit(t)
+	chain.backend.Commit()
+
+	startTestListener(t, chain, store, 0, 10*time.Millisecond)
+	require.Eventually(t, func() bool { return len(store.handledBlocks()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// Live events, including two in the same block
+	chain.emit(t)
+	chain.backend.Commit()
+	chain.emit(t)
+	chain.emit(t)
+	chain.backend.Commit()
+	chain.backend.Commit()
+
+	require.Eventually(t, func() bool { return len(store.handledBlocks()) == 4 }, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, chain.eventBlocks(t), store.handledBlocks())
+}
+
+func TestListener_Simulated_PollingConfirmations(t *testing.T) {
+	t.Parallel()
+	chain := newSimulatedEmitter(t)
+	store := &testEventStore{}
+	startTestListener(t, chain, store, 2, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond) // let the listener start polling
+
+	chain.emit(t)
+	chain.backend.Commit()
+	chain.backend.Commit()
+	assert.Never(t, func() bool { return len(store.handledBlocks()) > 0 }, 200*time.Millisecond, 10*time.Millisecond,
+		"the event has only one confirmation")
+
+	chain.backend.Commit()
+	require.Eventually(t, func() bool { return len(store.handledBlocks()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, chain.eventBlocks(t), store.handledBlocks())
+}
+
+func TestListener_Simulated_PollingReorgAfterProcessing(t *testing.T) {
+	t.Parallel()
+	chain := newSimulatedEmitter(t)
+	store := &testEventStore{}
+	startTestListener(t, chain, store, 0, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond) // let the listener start polling
+
+	chain.emit(t)
+	chain.backend.Commit()
+	reorgedBlock := chain.header(t, 2).Hash()
+	require.Eventually(t, func() bool { return len(store.handledBlocks()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, chain.backend.Fork(chain.header(t, 1).Hash()))
+	canonicalBlock := commitUntilIncluded(t, chain, 1)[0]
+	require.NotEqual(t, reorgedBlock, canonicalBlock)
+
+	// The processed event is rolled back and the event of the canonical chain is replayed
+	require.Eventually(t, func() bool { return len(store.handledBlocks()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []common.Hash{reorgedBlock, canonicalBlock}, store.handledBlocks())
+}
+
+func TestListener_PollNewLogs(t *testing.T) {
+	t.Parallel()
+	lastHash := common.HexToHash("0x64")
+	q := ethereum.FilterQuery{Addresses: []common.Address{common.HexToAddress("0x123")}}
+	blockRange := func(from, to uint64) interface{} {
+		return mock.MatchedBy(func(q ethereum.FilterQuery) bool {
+			return q.FromBlock.Uint64() == from && q.ToBlock.Uint64() == to
+		})
+	}
+
+	t.Run("no new block", func(t *testing.T) {
+		mockClient := new(MockEVMClient)
+		listener := NewListener(common.HexToAddress("0x123"), mockClient, 1, 10, log.NewNoopLogger(), nil, nil)
+		mockClient.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(&types.Header{Number: big.NewInt(100)}, nil)
+
+		toBlock, toHash, logs, err := listener.pollNewLogs(context.Background(), q, 100, lastHash)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(100), toBlock)
+		assert.Equal(t, lastHash, toHash)
+		assert.Empty(t, logs)
+		mockClient.AssertNotCalled(t, "FilterLogs", mock.Anything, mock.Anything)
+	})
+
+	t.Run("range is limited to the block step", func(t *testing.T) {
+		mockClient := new(MockEVMClient)
+		listener := NewListener(common.HexToAddress("0x123"), mockClient, 1, 10, log.NewNoopLogger(), nil, nil)
+		lastHeader := &types.Header{Number: big.NewInt(100)}
+		toHeader := &types.Header{Number: big.NewInt(110)}
+		mockClient.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(&types.Header{Number: big.NewInt(1000)}, nil)
+		mockClient.On("HeaderByNumber", mock.Anything, big.NewInt(100)).Return(lastHeader, nil)
+		mockClient.On("HeaderByNumber", mock.Anything, big.NewInt(110)).Return(toHeader, nil)
+		mockClient.On("FilterLogs", mock.Anything, blockRange(101, 110)).Return([]types.Log{{BlockNumber: 105}}, nil)
+
+		toBlock, toHash, logs, err := listener.pollNewLogs(context.Background(), q, 100, lastHeader.Hash())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(110), toBlock)
+		assert.Equal(t, toHeader.Hash(), toHash)
+		assert.Equal(t, []types.Log{{BlockNumber: 105}}, logs)
+	})
+
+	t.Run("last polled block reorganized", func(t *testing.T) {
+		mockClient := new(MockEVMClient)
+		listener := NewListener(common.HexToAddress("0x123"), mockClient, 1, 10, log.NewNoopLogger(), nil, nil)
+		mockClient.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(&types.Header{Number: big.NewInt(105)}, nil)
+		mockClient.On("HeaderByNumber", mock.Anything, big.NewInt(100)).Return(&types.Header{Number: big.NewInt(100), Extra: []byte("fork")}, nil)
+
+		_, _, _, err := listener.pollNewLogs(context.Background(), q, 100, lastHash)
+		assert.ErrorIs(t, err, errPolledBlockReorged)
+	})
+}
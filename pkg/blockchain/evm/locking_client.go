@@ -1,6 +1,7 @@
 package evm
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -12,6 +13,8 @@ import (
 	"github.com/layer-3/nitrolite/pkg/sign"
 )
 
+var _ core.AppRegistryClientV2 = &LockingClient{}
+
 // LockingClient provides access to a Locking contract.
 type LockingClient struct {
 	BaseClient
@@ -64,13 +67,13 @@ func NewLockingClient(lockingContractAddress common.Address, evmClient EVMClient
 
 // GetTokenDecimals returns the number of decimals for the token used in the AppRegistry.
 // This is needed to convert between human-readable amounts and the raw integer amounts used in transactions.
-func (c *LockingClient) GetTokenDecimals() (uint8, error) {
+func (c *LockingClient) GetTokenDecimals(ctx context.Context) (uint8, error) {
 	lockingContract, err := NewAppRegistry(c.lockingContractAddress, c.evmClient)
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to instantiate Locking contract")
 	}
 
-	tokenAddress, err := lockingContract.Asset(&bind.CallOpts{})
+	tokenAddress, err := lockingContract.Asset(&bind.CallOpts{Context: ctx})
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to get asset from the Locking contract")
 	}
@@ -80,7 +83,7 @@ func (c *LockingClient) GetTokenDecimals() (uint8, error) {
 		return 0, errors.Wrap(err, "failed to instantiate ERC20 contract")
 	}
 
-	decimals, err := erc20Contract.Decimals(&bind.CallOpts{})
+	decimals, err := erc20Contract.Decimals(&bind.CallOpts{Context: ctx})
 	if err != nil {
 		return 0, errors.Wrapf(err, "failed to get decimals for token %s", tokenAddress.Hex())
 	}
@@ -90,14 +93,15 @@ func (c *LockingClient) GetTokenDecimals() (uint8, error) {
 
 // Lock locks tokens into the Locking contract for the specified target address.
 // The caller must have approved the Locking contract to spend the token beforehand.
-func (c *LockingClient) Lock(targetWalletAddress string, amount decimal.Decimal) (string, error) {
+func (c *LockingClient) Lock(ctx context.Context, targetWalletAddress string, amount decimal.Decimal) (string, error) {
 	if !common.IsHexAddress(targetWalletAddress) {
 		return "", errors.Errorf("invalid address %q", targetWalletAddress)
 	}
 
 	targetAddr := common.HexToAddress(targetWalletAddress)
-	if c.transactOpts == nil {
-		return "", errors.New("transaction signer not configured")
+	opts, err := c.txOpts(ctx)
+	if err != nil {
+		return "", err
 	}
 
 	amountBig, err := core.DecimalToBigInt(amount, c.tokenDecimals)
@@ -110,7 +114,7 @@ func (c *LockingClient) Lock(targetWalletAddress string, amount decimal.Decimal)
 		return "", errors.Wrap(err, "failed to instantiate Locking contract")
 	}
 
-	tx, err := lockingContract.Lock(c.transactOpts, targetAddr, amountBig)
+	tx, err := lockingContract.Lock(opts, targetAddr, amountBig)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to send lock transaction")
 	}
@@ -118,9 +122,10 @@ func (c *LockingClient) Lock(targetWalletAddress string, amount decimal.Decimal)
 }
 
 // Relock re-locks tokens that are in the unlocking state back to the locked state.
-func (c *LockingClient) Relock() (string, error) {
-	if c.transactOpts == nil {
-		return "", errors.New("transaction signer not configured")
+func (c *LockingClient) Relock(ctx context.Context) (string, error) {
+	opts, err := c.txOpts(ctx)
+	if err != nil {
+		return "", err
 	}
 
 	lockingContract, err := NewAppRegistry(c.lockingContractAddress, c.evmClient)
@@ -128,7 +133,7 @@ func (c *LockingClient) Relock() (string, error) {
 		return "", errors.Wrap(err, "failed to instantiate Locking contract")
 	}
 
-	tx, err := lockingContract.Relock(c.transactOpts)
+	tx, err := lockingContract.Relock(opts)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to send relock transaction")
 	}
@@ -137,9 +142,10 @@ func (c *LockingClient) Relock() (string, error) {
 
 // Unlock initiates the unlock process for the caller's locked tokens.
 // After the unlock period elapses, Withdraw can be called.
-func (c *LockingClient) Unlock() (string, error) {
-	if c.transactOpts == nil {
-		return "", errors.New("transaction signer not configured")
+func (c *LockingClient) Unlock(ctx context.Context) (string, error) {
+	opts, err := c.txOpts(ctx)
+	if err != nil {
+		return "", err
 	}
 
 	lockingContract, err := NewAppRegistry(c.lockingContractAddress, c.evmClient)
@@ -147,7 +153,7 @@ func (c *LockingClient) Unlock() (string, error) {
 		return "", errors.Wrap(err, "failed to instantiate Locking contract")
 	}
 
-	tx, err := lockingContract.Unlock(c.transactOpts)
+	tx, err := lockingContract.Unlock(opts)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to send unlock transaction")
 	}
@@ -156,9 +162,10 @@ func (c *LockingClient) Unlock() (string, error) {
 
 // Withdraw withdraws unlocked tokens to the specified destination address.
 // Can only be called after the unlock period has elapsed.
-func (c *LockingClient) Withdraw(destination string) (string, error) {
-	if c.transactOpts == nil {
-		return "", errors.New("transaction signer not configured")
+func (c *LockingClient) Withdraw(ctx context.Context, destination string) (string, error) {
+	opts, err := c.txOpts(ctx)
+	if err != nil {
+		return "", err
 	}
 
 	destinationAddr := common.HexToAddress(destination)
@@ -168,7 +175,7 @@ func (c *LockingClient) Withdraw(destination string) (string, error) {
 		return "", errors.Wrap(err, "failed to instantiate Locking contract")
 	}
 
-	tx, err := lockingContract.Withdraw(c.transactOpts, destinationAddr)
+	tx, err := lockingContract.Withdraw(opts, destinationAddr)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to send withdraw transaction")
 	}
@@ -177,9 +184,10 @@ func (c *LockingClient) Withdraw(destination string) (string, error) {
 
 // ApproveToken approves the Locking contract to spend the specified amount of tokens.
 // This must be called before Lock.
-func (c *LockingClient) ApproveToken(amount decimal.Decimal) (string, error) {
-	if c.transactOpts == nil {
-		return "", errors.New("transaction signer not configured")
+func (c *LockingClient) ApproveToken(ctx context.Context, amount decimal.Decimal) (string, error) {
+	opts, err := c.txOpts(ctx)
+	if err != nil {
+		return "", err
 	}
 
 	amountBig, err := core.DecimalToBigInt(amount, c.tokenDecimals)
@@ -192,7 +200,7 @@ func (c *LockingClient) ApproveToken(amount decimal.Decimal) (string, error) {
 		return "", errors.Wrap(err, "failed to instantiate Locking contract")
 	}
 
-	tokenAddress, err := lockingContract.Asset(&bind.CallOpts{})
+	tokenAddress, err := lockingContract.Asset(&bind.CallOpts{Context: ctx})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to get asset from Locking contract")
 	}
@@ -202,7 +210,7 @@ func (c *LockingClient) ApproveToken(amount decimal.Decimal) (string, error) {
 		return "", errors.Wrap(err, "failed to instantiate ERC20 contract")
 	}
 
-	tx, err := erc20Contract.Approve(c.transactOpts, c.lockingContractAddress, amountBig)
+	tx, err := erc20Contract.Approve(opts, c.lockingContractAddress, amountBig)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to send approve transaction")
 	}
@@ -210,14 +218,14 @@ func (c *LockingClient) ApproveToken(amount decimal.Decimal) (string, error) {
 }
 
 // GetBalance returns the locked balance of a user in the Locking contraсt.
-func (c *LockingClient) GetBalance(user string) (decimal.Decimal, error) {
+func (c *LockingClient) GetBalance(ctx context.Context, user string) (decimal.Decimal, error) {
 	userAddr := common.HexToAddress(user)
 	lockingContract, err := NewAppRegistry(c.lockingContractAddress, c.evmClient)
 	if err != nil {
 		return decimal.Zero, errors.Wrap(err, "failed to instantiate Locking contract")
 	}
 
-	balance, err := lockingContract.BalanceOf(nil, userAddr)
+	balance, err := lockingContract.BalanceOf(&bind.CallOpts{Context: ctx}, userAddr)
 	if err != nil {
 		return decimal.Zero, errors.Wrap(err, "failed to get balance")
 	}
@@ -225,6 +233,17 @@ func (c *LockingClient) GetBalance(user string) (decimal.Decimal, error) {
 	return decimal.NewFromBigInt(balance, -int32(c.tokenDecimals)), nil
 }
 
+// txOpts returns a copy of the client's transact options bound to ctx,
+// so that concurrent transactions don't share them.
+func (c *LockingClient) txOpts(ctx context.Context) (*bind.TransactOpts, error) {
+	if c.transactOpts == nil {
+		return nil, errors.New("transaction signer not configured")
+	}
+	opts := *c.transactOpts
+	opts.Context = ctx
+	return &opts, nil
+}
+
 // decimalToBigInt converts a decimal amount to *big.Int given token decimals.
 func decimalToBigInt(amount decimal.Decimal, decimals int32) *big.Int {
 	shifted := amount.Shift(decimals)
@@ -73,6 +73,18 @@ func (r *LockingContractReactor) HandleEvent(ctx context.Context, l types.Log) e
 	}
 	logger.Debug("received event", "name", eventName, "blockNumber", l.BlockNumber, "txHash", l.TxHash.String(), "logIndex", l.Index)
 
+	// The handler records its changes for the event, so that they can be reverted on a chain reorganization
+	contractEvent := core.BlockchainEvent{
+		BlockNumber:     l.BlockNumber,
+		BlockHash:       l.BlockHash.Hex(),
+		BlockchainID:    r.blockchainID,
+		Name:            eventName,
+		ContractAddress: l.Address.Hex(),
+		TransactionHash: l.TxHash.String(),
+		LogIndex:        uint32(l.Index),
+	}
+	ctx = core.ContextWithBlockchainEvent(ctx, contractEvent)
+
 	var err error
 	switch eventID {
 	case lockingContractAbi.Events["Locked"].ID:
@@ -94,14 +106,7 @@ func (r *LockingContractReactor) HandleEvent(ctx context.Context, l types.Log) e
 		return errors.Wrap(err, "error processing event")
 	}
 
-	if err := r.storeContractEvent(core.BlockchainEvent{
-		BlockNumber:     l.BlockNumber,
-		BlockchainID:    r.blockchainID,
-		Name:            eventName,
-		ContractAddress: l.Address.Hex(),
-		TransactionHash: l.TxHash.String(),
-		LogIndex:        uint32(l.Index),
-	}); err != nil {
+	if err := r.storeContractEvent(contractEvent); err != nil {
 		logger.Warn("error storing contract event", "error", err, "event", eventName, "blockNumber", l.BlockNumber, "txHash", l.TxHash.String(), "logIndex", l.Index)
 		return errors.Wrap(err, "error storing contract event")
 	}
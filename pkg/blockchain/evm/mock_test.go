@@ -139,3 +139,29 @@ func (m *MockAssetStore) GetTokenAddress(asset string, blockchainID uint64) (str
 	args := m.Called(asset, blockchainID)
 	return args.String(0), args.Error(1)
 }
+
+// MockTxManagerClient implements TxManagerClient interface
+type MockTxManagerClient struct {
+	MockEVMClient
+}
+
+func (m *MockTxManagerClient) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	args := m.Called(ctx, txHash)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).(*types.Transaction), args.Bool(1), args.Error(2)
+}
+
+func (m *MockTxManagerClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	args := m.Called(ctx, txHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*types.Receipt), args.Error(1)
+}
+
+func (m *MockTxManagerClient) BlockNumber(ctx context.Context) (uint64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(uint64), args.Error(1)
+}
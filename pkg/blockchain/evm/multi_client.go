@@ -0,0 +1,440 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	"github.com/layer-3/nitrolite/pkg/log"
+)
+
+// rpcErrorCodeLimitExceeded is the JSON-RPC error code providers return when requests are rate limited.
+const rpcErrorCodeLimitExceeded = -32005
+
+// MultiClientConfig configures a MultiClient.
+type MultiClientConfig struct {
+	// Quorum is the number of endpoints that must return the same result of a contract call.
+	// Contract calls read all contract state, such as home channel data. 1 reads from a single endpoint.
+	Quorum int
+	// MaxBlockLag is the number of blocks an endpoint may be behind the most advanced one
+	// before it is considered unhealthy. Zero disables the lag check.
+	MaxBlockLag uint64
+	// HealthCheckInterval is how frequently the endpoints' heads are checked.
+	HealthCheckInterval time.Duration
+	// RetryAfter is how long an endpoint is avoided after it failed.
+	RetryAfter time.Duration
+}
+
+// DefaultMultiClientConfig returns the configuration used by the node.
+func DefaultMultiClientConfig() MultiClientConfig {
+	return MultiClientConfig{
+		Quorum:              1,
+		MaxBlockLag:         10,
+		HealthCheckInterval: 15 * time.Second,
+		RetryAfter:          30 * time.Second,
+	}
+}
+
+type multiClientEndpoint struct {
+	index  int
+	client TxManagerClient
+
+	// Guarded by MultiClient.mu
+	head        uint64
+	lagging     bool
+	failedUntil time.Time
+}
+
+// MultiClient is an EVM client backed by several RPC endpoints of the same blockchain.
+// Requests go to the first healthy endpoint in the configured order and fail over to the next one
+// on connection errors, rate limiting or lag. Errors returned by the blockchain itself,
+// such as reverts or unknown transactions, are returned as is.
+type MultiClient struct {
+	endpoints []*multiClientEndpoint
+	config    MultiClientConfig
+	logger    log.Logger
+	closers   []func()
+
+	mu  sync.Mutex
+	now func() time.Time
+}
+
+// NewMultiClient creates a client of the given endpoints, ordered by preference.
+func NewMultiClient(clients []TxManagerClient, config MultiClientConfig, logger log.Logger) (*MultiClient, error) {
+	if len(clients) == 0 {
+		return nil, errors.New("at least one endpoint is required")
+	}
+	if config.Quorum < 1 {
+		config.Quorum = 1
+	}
+	if config.Quorum > len(clients) {
+		return nil, fmt.Errorf("quorum of %d exceeds the number of endpoints %d", config.Quorum, len(clients))
+	}
+
+	c := &MultiClient{
+		config: config,
+		logger: logger.WithName("evm-multi-client"),
+		now:    time.Now,
+	}
+	for i, client := range clients {
+		c.endpoints = append(c.endpoints, &multiClientEndpoint{index: i, client: client})
+	}
+	return c, nil
+}
+
+// DialMultiClient connects to the given RPC URLs and creates a MultiClient of them.
+func DialMultiClient(ctx context.Context, rpcURLs []string, config MultiClientConfig, logger log.Logger) (*MultiClient, error) {
+	clients := make([]TxManagerClient, 0, len(rpcURLs))
+	closers := make([]func(), 0, len(rpcURLs))
+	for i, rpcURL := range rpcURLs {
+		client, err := ethclient.DialContext(ctx, rpcURL)
+		if err != nil {
+			for _, closeFn := range closers {
+				closeFn()
+			}
+			return nil, errors.Wrapf(err, "failed to connect to endpoint %d", i)
+		}
+		clients = append(clients, client)
+		closers = append(closers, client.Close)
+	}
+
+	c, err := NewMultiClient(clients, config, logger)
+	if err != nil {
+		for _, closeFn := range closers {
+			closeFn()
+		}
+		return nil, err
+	}
+	c.closers = closers
+	return c, nil
+}
+
+// Close closes the connections opened by DialMultiClient.
+func (c *MultiClient) Close() {
+	for _, closeFn := range c.closers {
+		closeFn()
+	}
+}
+
+// Start checks the endpoints' heads every HealthCheckInterval until the context is done,
+// so that lagging endpoints are avoided.
+func (c *MultiClient) Start(ctx context.Context) {
+	if c.config.HealthCheckInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(c.config.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		c.checkHealth(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkHealth refreshes the heads of all endpoints and marks the ones behind by more than MaxBlockLag as lagging.
+func (c *MultiClient) checkHealth(ctx context.Context) {
+	heads := make([]uint64, len(c.endpoints))
+	errs := make([]error, len(c.endpoints))
+
+	var wg sync.WaitGroup
+	for i, e := range c.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			headCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+			defer cancel()
+			heads[i], errs[i] = e.client.BlockNumber(headCtx)
+		}()
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return
+	}
+
+	for i, e := range c.endpoints {
+		if errs[i] != nil {
+			c.markFailed(e, "BlockNumber", errs[i])
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var maxHead uint64
+	for i, e := range c.endpoints {
+		if errs[i] == nil {
+			e.head = heads[i]
+			maxHead = max(maxHead, heads[i])
+		}
+	}
+	for _, e := range c.endpoints {
+		lagging := c.config.MaxBlockLag > 0 && e.head+c.config.MaxBlockLag < maxHead
+		if lagging && !e.lagging {
+			c.logger.Warn("RPC endpoint is lagging behind", "endpoint", e.index, "head", e.head, "maxHead", maxHead)
+		}
+		e.lagging = lagging
+	}
+}
+
+// candidates returns the healthy endpoints in the order of preference.
+// When no endpoint is healthy, all of them are returned, as one may have recovered.
+func (c *MultiClient) candidates() []*multiClientEndpoint {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	healthy := make([]*multiClientEndpoint, 0, len(c.endpoints))
+	for _, e := range c.endpoints {
+		if !e.lagging && !now.Before(e.failedUntil) {
+			healthy = append(healthy, e)
+		}
+	}
+	if len(healthy) == 0 {
+		return c.endpoints
+	}
+	return healthy
+}
+
+func (c *MultiClient) markFailed(e *multiClientEndpoint, method string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e.failedUntil = c.now().Add(c.config.RetryAfter)
+	c.logger.Warn("RPC endpoint failed", "endpoint", e.index, "method", method, "error", err)
+}
+
+// isEndpointError reports whether the error is caused by the endpoint rather than returned by the blockchain,
+// so that the request may succeed on another endpoint.
+func isEndpointError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, ethereum.NotFound) {
+		return false
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return rpcErr.ErrorCode() == rpcErrorCodeLimitExceeded
+	}
+	return true
+}
+
+// failover runs the request on the candidate endpoints until one of them doesn't fail by itself.
+func failover[T any](ctx context.Context, c *MultiClient, method string, request func(TxManagerClient) (T, error)) (T, error) {
+	var result T
+	var err error
+	for _, e := range c.candidates() {
+		result, err = request(e.client)
+		if err == nil || !isEndpointError(ctx, err) {
+			return result, err
+		}
+		c.markFailed(e, method, err)
+	}
+	return result, err
+}
+
+// CallContract executes the call on Quorum endpoints and returns the result they agree on.
+// Calls on the latest block are made on the lowest head known of the endpoints, so that lag doesn't cause disagreement.
+func (c *MultiClient) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if c.config.Quorum <= 1 {
+		return failover(ctx, c, "CallContract", func(client TxManagerClient) ([]byte, error) {
+			return client.CallContract(ctx, call, blockNumber)
+		})
+	}
+
+	candidates := c.candidates()
+	if len(candidates) < c.config.Quorum {
+		return nil, fmt.Errorf("%d healthy endpoints available, %d required for quorum", len(candidates), c.config.Quorum)
+	}
+	if blockNumber == nil {
+		blockNumber = c.lowestHead(candidates)
+	}
+
+	type callResult struct {
+		endpoint *multiClientEndpoint
+		output   []byte
+		err      error
+	}
+	results := make(chan callResult, len(candidates))
+	for _, e := range candidates {
+		go func() {
+			output, err := e.client.CallContract(ctx, call, blockNumber)
+			results <- callResult{endpoint: e, output: output, err: err}
+		}()
+	}
+
+	// Results are compared by output, or by error message for errors returned by the blockchain
+	votes := make(map[string]int)
+	var lastErr error
+	for range candidates {
+		res := <-results
+		key := "output:" + common.Bytes2Hex(res.output)
+		if res.err != nil {
+			if isEndpointError(ctx, res.err) {
+				c.markFailed(res.endpoint, "CallContract", res.err)
+				lastErr = res.err
+				continue
+			}
+			key = "error:" + res.err.Error()
+		}
+
+		votes[key]++
+		if votes[key] >= c.config.Quorum {
+			return res.output, res.err
+		}
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if lastErr != nil {
+		return nil, errors.Wrapf(lastErr, "no quorum of %d endpoints for contract call", c.config.Quorum)
+	}
+	return nil, fmt.Errorf("no quorum of %d endpoints for contract call: endpoints returned %d different results", c.config.Quorum, len(votes))
+}
+
+// lowestHead returns the lowest head known of the endpoints, or nil for the latest block if any head is unknown.
+func (c *MultiClient) lowestHead(endpoints []*multiClientEndpoint) *big.Int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var lowest uint64
+	for i, e := range endpoints {
+		if e.head == 0 {
+			return nil
+		}
+		if i == 0 || e.head < lowest {
+			lowest = e.head
+		}
+	}
+	return new(big.Int).SetUint64(lowest)
+}
+
+func (c *MultiClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return failover(ctx, c, "BalanceAt", func(client TxManagerClient) (*big.Int, error) {
+		return client.BalanceAt(ctx, account, blockNumber)
+	})
+}
+
+func (c *MultiClient) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	return failover(ctx, c, "StorageAt", func(client TxManagerClient) ([]byte, error) {
+		return client.StorageAt(ctx, account, key, blockNumber)
+	})
+}
+
+func (c *MultiClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return failover(ctx, c, "CodeAt", func(client TxManagerClient) ([]byte, error) {
+		return client.CodeAt(ctx, account, blockNumber)
+	})
+}
+
+func (c *MultiClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return failover(ctx, c, "NonceAt", func(client TxManagerClient) (uint64, error) {
+		return client.NonceAt(ctx, account, blockNumber)
+	})
+}
+
+func (c *MultiClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return failover(ctx, c, "HeaderByNumber", func(client TxManagerClient) (*types.Header, error) {
+		return client.HeaderByNumber(ctx, number)
+	})
+}
+
+func (c *MultiClient) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return failover(ctx, c, "PendingCodeAt", func(client TxManagerClient) ([]byte, error) {
+		return client.PendingCodeAt(ctx, account)
+	})
+}
+
+func (c *MultiClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return failover(ctx, c, "PendingNonceAt", func(client TxManagerClient) (uint64, error) {
+		return client.PendingNonceAt(ctx, account)
+	})
+}
+
+func (c *MultiClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return failover(ctx, c, "SuggestGasPrice", func(client TxManagerClient) (*big.Int, error) {
+		return client.SuggestGasPrice(ctx)
+	})
+}
+
+func (c *MultiClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return failover(ctx, c, "SuggestGasTipCap", func(client TxManagerClient) (*big.Int, error) {
+		return client.SuggestGasTipCap(ctx)
+	})
+}
+
+func (c *MultiClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return failover(ctx, c, "EstimateGas", func(client TxManagerClient) (uint64, error) {
+		return client.EstimateGas(ctx, call)
+	})
+}
+
+func (c *MultiClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	_, err := failover(ctx, c, "SendTransaction", func(client TxManagerClient) (struct{}, error) {
+		return struct{}{}, client.SendTransaction(ctx, tx)
+	})
+	return err
+}
+
+func (c *MultiClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return failover(ctx, c, "FilterLogs", func(client TxManagerClient) ([]types.Log, error) {
+		return client.FilterLogs(ctx, q)
+	})
+}
+
+// SubscribeFilterLogs subscribes on the first endpoint supporting subscriptions.
+// When the subscription fails, subscribing again picks a healthy endpoint.
+func (c *MultiClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	var err error
+	for _, e := range c.candidates() {
+		var sub ethereum.Subscription
+		sub, err = e.client.SubscribeFilterLogs(ctx, q, ch)
+		if err == nil {
+			return sub, nil
+		}
+		if errors.Is(err, rpc.ErrNotificationsUnsupported) {
+			continue
+		}
+		if !isEndpointError(ctx, err) {
+			return nil, err
+		}
+		c.markFailed(e, "SubscribeFilterLogs", err)
+	}
+	return nil, err
+}
+
+func (c *MultiClient) BlockNumber(ctx context.Context) (uint64, error) {
+	return failover(ctx, c, "BlockNumber", func(client TxManagerClient) (uint64, error) {
+		return client.BlockNumber(ctx)
+	})
+}
+
+func (c *MultiClient) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	type txResult struct {
+		tx        *types.Transaction
+		isPending bool
+	}
+	res, err := failover(ctx, c, "TransactionByHash", func(client TxManagerClient) (txResult, error) {
+		tx, isPending, err := client.TransactionByHash(ctx, txHash)
+		return txResult{tx: tx, isPending: isPending}, err
+	})
+	return res.tx, res.isPending, err
+}
+
+func (c *MultiClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return failover(ctx, c, "TransactionReceipt", func(client TxManagerClient) (*types.Receipt, error) {
+		return client.TransactionReceipt(ctx, txHash)
+	})
+}
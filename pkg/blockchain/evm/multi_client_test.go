@@ -0,0 +1,211 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/log"
+)
+
+// testRPCError is an error returned by a JSON-RPC endpoint.
+type testRPCError struct {
+	code int
+	msg  string
+}
+
+func (e testRPCError) Error() string  { return e.msg }
+func (e testRPCError) ErrorCode() int { return e.code }
+
+func newTestMultiClient(t *testing.T, n int, config MultiClientConfig) (*MultiClient, []*MockTxManagerClient, *time.Time) {
+	t.Helper()
+
+	mocks := make([]*MockTxManagerClient, n)
+	clients := make([]TxManagerClient, n)
+	for i := range mocks {
+		mocks[i] = new(MockTxManagerClient)
+		clients[i] = mocks[i]
+	}
+
+	c, err := NewMultiClient(clients, config, log.NewNoopLogger())
+	require.NoError(t, err)
+
+	now := time.Now()
+	c.now = func() time.Time { return now }
+	return c, mocks, &now
+}
+
+func TestNewMultiClient(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewMultiClient(nil, DefaultMultiClientConfig(), log.NewNoopLogger())
+	assert.Error(t, err)
+
+	config := DefaultMultiClientConfig()
+	config.Quorum = 2
+	_, err = NewMultiClient([]TxManagerClient{new(MockTxManagerClient)}, config, log.NewNoopLogger())
+	assert.EqualError(t, err, "quorum of 2 exceeds the number of endpoints 1")
+}
+
+func TestMultiClient_Failover(t *testing.T) {
+	t.Parallel()
+	c, endpoints, now := newTestMultiClient(t, 2, DefaultMultiClientConfig())
+	account := common.HexToAddress("0x1")
+
+	endpoints[0].On("BalanceAt", mock.Anything, account, (*big.Int)(nil)).Return(nil, errors.New("connection refused")).Once()
+	endpoints[1].On("BalanceAt", mock.Anything, account, (*big.Int)(nil)).Return(big.NewInt(7), nil)
+
+	balance, err := c.BalanceAt(context.Background(), account, nil)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(7), balance)
+
+	// The failed endpoint is avoided for a while
+	_, err = c.BalanceAt(context.Background(), account, nil)
+	require.NoError(t, err)
+	endpoints[0].AssertNumberOfCalls(t, "BalanceAt", 1)
+
+	*now = now.Add(DefaultMultiClientConfig().RetryAfter)
+	endpoints[0].On("BalanceAt", mock.Anything, account, (*big.Int)(nil)).Return(big.NewInt(7), nil).Once()
+	_, err = c.BalanceAt(context.Background(), account, nil)
+	require.NoError(t, err)
+	endpoints[0].AssertNumberOfCalls(t, "BalanceAt", 2)
+	endpoints[1].AssertNumberOfCalls(t, "BalanceAt", 2)
+}
+
+func TestMultiClient_BlockchainErrorsAreReturned(t *testing.T) {
+	t.Parallel()
+	c, endpoints, _ := newTestMultiClient(t, 2, DefaultMultiClientConfig())
+	txHash := common.HexToHash("0x1")
+
+	endpoints[0].On("TransactionReceipt", mock.Anything, txHash).Return(nil, ethereum.NotFound)
+	_, err := c.TransactionReceipt(context.Background(), txHash)
+	assert.ErrorIs(t, err, ethereum.NotFound)
+
+	reverted := testRPCError{code: 3, msg: "execution reverted"}
+	endpoints[0].On("EstimateGas", mock.Anything, mock.Anything).Return(uint64(0), reverted)
+	_, err = c.EstimateGas(context.Background(), ethereum.CallMsg{})
+	assert.Equal(t, reverted, err)
+
+	endpoints[1].AssertNotCalled(t, "TransactionReceipt", mock.Anything, mock.Anything)
+	endpoints[1].AssertNotCalled(t, "EstimateGas", mock.Anything, mock.Anything)
+
+	// Rate limiting is specific to the endpoint
+	endpoints[0].On("SuggestGasTipCap", mock.Anything).Return(nil, testRPCError{code: rpcErrorCodeLimitExceeded, msg: "limit exceeded"})
+	endpoints[1].On("SuggestGasTipCap", mock.Anything).Return(big.NewInt(2), nil)
+	tip, err := c.SuggestGasTipCap(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(2), tip)
+}
+
+func TestMultiClient_LaggingEndpoint(t *testing.T) {
+	t.Parallel()
+	c, endpoints, _ := newTestMultiClient(t, 2, DefaultMultiClientConfig())
+
+	endpoints[0].On("BlockNumber", mock.Anything).Return(uint64(80), nil)
+	endpoints[1].On("BlockNumber", mock.Anything).Return(uint64(100), nil)
+	c.checkHealth(context.Background())
+
+	header := &types.Header{Number: big.NewInt(100)}
+	endpoints[1].On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(header, nil)
+	got, err := c.HeaderByNumber(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, header, got)
+	endpoints[0].AssertNotCalled(t, "HeaderByNumber", mock.Anything, mock.Anything)
+
+	// The endpoint is used again once it caught up
+	endpoints[0].ExpectedCalls = nil
+	endpoints[0].On("BlockNumber", mock.Anything).Return(uint64(95), nil)
+	c.checkHealth(context.Background())
+	assert.Len(t, c.candidates(), 2)
+}
+
+func TestMultiClient_CallContractQuorum(t *testing.T) {
+	t.Parallel()
+	config := DefaultMultiClientConfig()
+	config.Quorum = 2
+	call := ethereum.CallMsg{To: &common.Address{}}
+
+	setup := func(t *testing.T) (*MultiClient, []*MockTxManagerClient) {
+		c, endpoints, _ := newTestMultiClient(t, 3, config)
+		for i, e := range endpoints {
+			e.On("BlockNumber", mock.Anything).Return(uint64(100+i), nil)
+		}
+		c.checkHealth(context.Background())
+		return c, endpoints
+	}
+
+	t.Run("majority result on the lowest head", func(t *testing.T) {
+		c, endpoints := setup(t)
+		endpoints[0].On("CallContract", mock.Anything, call, big.NewInt(100)).Return([]byte{1}, nil)
+		endpoints[1].On("CallContract", mock.Anything, call, big.NewInt(100)).Return([]byte{2}, nil)
+		endpoints[2].On("CallContract", mock.Anything, call, big.NewInt(100)).Return([]byte{1}, nil)
+
+		output, err := c.CallContract(context.Background(), call, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1}, output)
+	})
+
+	t.Run("agreed revert", func(t *testing.T) {
+		c, endpoints := setup(t)
+		reverted := testRPCError{code: 3, msg: "execution reverted: channel not found"}
+		endpoints[0].On("CallContract", mock.Anything, call, big.NewInt(50)).Return(nil, reverted)
+		endpoints[1].On("CallContract", mock.Anything, call, big.NewInt(50)).Return(nil, reverted)
+		endpoints[2].On("CallContract", mock.Anything, call, big.NewInt(50)).Return(nil, errors.New("timeout"))
+
+		_, err := c.CallContract(context.Background(), call, big.NewInt(50))
+		assert.Equal(t, reverted, err)
+	})
+
+	t.Run("no agreement", func(t *testing.T) {
+		c, endpoints := setup(t)
+		endpoints[0].On("CallContract", mock.Anything, call, big.NewInt(100)).Return([]byte{1}, nil)
+		endpoints[1].On("CallContract", mock.Anything, call, big.NewInt(100)).Return([]byte{2}, nil)
+		endpoints[2].On("CallContract", mock.Anything, call, big.NewInt(100)).Return(nil, errors.New("connection reset"))
+
+		_, err := c.CallContract(context.Background(), call, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no quorum of 2 endpoints")
+		assert.Len(t, c.candidates(), 2, "the failed endpoint is avoided")
+
+		_, err = c.CallContract(context.Background(), call, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no quorum of 2 endpoints")
+	})
+
+	t.Run("not enough healthy endpoints", func(t *testing.T) {
+		c, endpoints := setup(t)
+		endpoints[0].ExpectedCalls = nil
+		endpoints[0].On("BlockNumber", mock.Anything).Return(uint64(0), errors.New("connection refused"))
+		endpoints[1].ExpectedCalls = nil
+		endpoints[1].On("BlockNumber", mock.Anything).Return(uint64(0), errors.New("connection refused"))
+		c.checkHealth(context.Background())
+
+		_, err := c.CallContract(context.Background(), call, nil)
+		assert.EqualError(t, err, "1 healthy endpoints available, 2 required for quorum")
+	})
+}
+
+func TestMultiClient_SubscribeFilterLogs(t *testing.T) {
+	t.Parallel()
+	c, endpoints, _ := newTestMultiClient(t, 2, DefaultMultiClientConfig())
+	sub := &MockSubscription{errChan: make(chan error)}
+
+	// HTTP endpoints don't support subscriptions, but stay healthy for other requests
+	endpoints[0].On("SubscribeFilterLogs", mock.Anything, mock.Anything, mock.Anything).Return(nil, rpc.ErrNotificationsUnsupported)
+	endpoints[1].On("SubscribeFilterLogs", mock.Anything, mock.Anything, mock.Anything).Return(sub, nil)
+
+	got, err := c.SubscribeFilterLogs(context.Background(), ethereum.FilterQuery{}, make(chan types.Log))
+	require.NoError(t, err)
+	assert.Equal(t, sub, got)
+	assert.Len(t, c.candidates(), 2)
+}
@@ -0,0 +1,440 @@
+package evm
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/layer-3/nitrolite/pkg/blockchain"
+	"github.com/layer-3/nitrolite/pkg/sign"
+)
+
+// minFeeBumpPercent is the minimum fee increase EVM nodes accept for a replacement transaction.
+const minFeeBumpPercent = 10
+
+// TxManagerClient is the EVM node API used by the TxManager.
+type TxManagerClient interface {
+	EVMClient
+	ethereum.BlockNumberReader
+	TransactionByHash(ctx context.Context, txHash common.Hash) (tx *types.Transaction, isPending bool, err error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// TxStatus is the on-chain outcome of a transaction sent by the TxManager.
+type TxStatus = blockchain.TxStatus
+
+const (
+	TxStatusPending   = blockchain.TxStatusPending
+	TxStatusConfirmed = blockchain.TxStatusConfirmed
+	TxStatusReverted  = blockchain.TxStatusReverted
+	TxStatusDropped   = blockchain.TxStatusDropped
+)
+
+// TxResult describes the current on-chain state of a transaction.
+type TxResult = blockchain.TxResult
+
+// TxManagerConfig configures the transaction lifecycle handling of the TxManager.
+type TxManagerConfig struct {
+	// Confirmations is the number of blocks, counting the including one, after which
+	// a transaction outcome is final.
+	Confirmations uint64
+	// StuckTimeout is the time after which a transaction that is still not included
+	// is re-broadcast with bumped fees.
+	StuckTimeout time.Duration
+	// FeeBumpPercent is the percentage the fees are increased by on every re-broadcast.
+	// Values below 10 are raised to 10, as nodes reject smaller replacement bumps.
+	FeeBumpPercent uint64
+	// MaxFeeBumps limits the number of re-broadcasts of a single transaction.
+	MaxFeeBumps int
+}
+
+// DefaultTxManagerConfig returns the TxManagerConfig used by the clearnode.
+func DefaultTxManagerConfig() TxManagerConfig {
+	return TxManagerConfig{
+		Confirmations:  3,
+		StuckTimeout:   2 * time.Minute,
+		FeeBumpPercent: 20,
+		MaxFeeBumps:    5,
+	}
+}
+
+// managedTx holds all broadcast versions of a transaction sharing the same nonce.
+type managedTx struct {
+	nonce      uint64
+	txs        []*types.Transaction // original first, then the fee-bumped replacements
+	lastSentAt time.Time
+}
+
+// TxManager sends transactions of a single account on a single blockchain and tracks them until
+// they are final. It assigns nonces locally, so concurrent senders sharing the manager don't
+// collide, re-broadcasts stuck transactions with bumped fees and reuses the nonces of
+// transactions evicted from the mempool.
+type TxManager struct {
+	client TxManagerClient
+	from   common.Address
+	signFn bind.SignerFn
+	cfg    TxManagerConfig
+	now    func() time.Time
+
+	sendMu     sync.Mutex // serializes nonce assignment and broadcasting
+	nextNonce  uint64
+	freeNonces map[uint64]struct{} // nonces of dropped transactions not used on-chain yet
+
+	mu  sync.Mutex
+	txs map[common.Hash]*managedTx // every broadcast hash -> its transaction
+}
+
+// NewTxManager creates a TxManager sending transactions signed by txSigner on the blockchain.
+func NewTxManager(client TxManagerClient, txSigner sign.Signer, blockchainID uint64, cfg TxManagerConfig) *TxManager {
+	if cfg.FeeBumpPercent < minFeeBumpPercent {
+		cfg.FeeBumpPercent = minFeeBumpPercent
+	}
+	opts := signerTxOpts(txSigner, blockchainID)
+
+	return &TxManager{
+		client: client,
+		from:   opts.From,
+		signFn: opts.Signer,
+		cfg:    cfg,
+		now:    time.Now,
+		txs:    make(map[common.Hash]*managedTx),
+
+		freeNonces: make(map[uint64]struct{}),
+	}
+}
+
+// Transact sends a transaction built by send with a locally assigned nonce and starts tracking it.
+// opts are copied, send must use the provided copy.
+// The nonce is the lowest released nonce of a dropped transaction, if any, so the gap it left
+// is filled. Otherwise it is the highest of the local one and the account's pending nonce
+// on the node, so transactions sent by other means are taken into account.
+// The nonce is only consumed if send succeeds.
+func (m *TxManager) Transact(ctx context.Context, opts *bind.TransactOpts, send func(opts *bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+	m.sendMu.Lock()
+	defer m.sendMu.Unlock()
+
+	pendingNonce, err := m.client.PendingNonceAt(ctx, m.from)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get pending nonce")
+	}
+	nonce := max(m.nextNonce, pendingNonce)
+
+	freeNonce, reused, err := m.lowestFreeNonce(ctx, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if reused {
+		nonce = freeNonce
+	}
+
+	txOpts := *opts
+	txOpts.Nonce = new(big.Int).SetUint64(nonce)
+	txOpts.Context = ctx
+
+	tx, err := send(&txOpts)
+	if err != nil {
+		return nil, err
+	}
+	if reused {
+		m.mu.Lock()
+		delete(m.freeNonces, nonce)
+		m.mu.Unlock()
+	} else {
+		m.nextNonce = nonce + 1
+	}
+
+	m.mu.Lock()
+	m.txs[tx.Hash()] = &managedTx{
+		nonce:      nonce,
+		txs:        []*types.Transaction{tx},
+		lastSentAt: m.now(),
+	}
+	m.mu.Unlock()
+
+	return tx, nil
+}
+
+// Release marks the nonce of a dropped transaction as unused, so the next Transact reuses it.
+// Nonces already used on-chain are discarded by Transact.
+func (m *TxManager) Release(nonce uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.freeNonces[nonce] = struct{}{}
+}
+
+// lowestFreeNonce returns the lowest released nonce that is not used on-chain yet and is below next,
+// the nonce a new transaction would get otherwise. Released nonces that can't be reused are discarded.
+func (m *TxManager) lowestFreeNonce(ctx context.Context, next uint64) (uint64, bool, error) {
+	m.mu.Lock()
+	empty := len(m.freeNonces) == 0
+	m.mu.Unlock()
+	if empty {
+		return 0, false, nil
+	}
+
+	confirmedNonce, err := m.client.NonceAt(ctx, m.from, nil)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "failed to get confirmed nonce")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var lowest uint64
+	found := false
+	for nonce := range m.freeNonces {
+		if nonce < confirmedNonce || nonce >= next {
+			delete(m.freeNonces, nonce)
+			continue
+		}
+		if !found || nonce < lowest {
+			lowest, found = nonce, true
+		}
+	}
+	return lowest, found, nil
+}
+
+// Check returns the current on-chain state of the transaction.
+// Pending transactions sent through the manager that are stuck for longer than the configured
+// timeout are re-broadcast with bumped fees; the returned hash then refers to the replacement.
+// Transactions unknown to the manager, e.g. sent before a restart, are only looked up by hash.
+// A transaction is dropped once its nonce is used by another transaction or none of its versions
+// is known to the node anymore. In the latter case its nonce is released for reuse.
+// Tracking of a transaction stops once it is confirmed, reverted or dropped.
+func (m *TxManager) Check(ctx context.Context, txHash string) (TxResult, error) {
+	hash := common.HexToHash(txHash)
+
+	m.mu.Lock()
+	mtx := m.txs[hash]
+	hashes := []common.Hash{hash}
+	if mtx != nil {
+		hashes = make([]common.Hash, 0, len(mtx.txs))
+		for i := len(mtx.txs) - 1; i >= 0; i-- {
+			hashes = append(hashes, mtx.txs[i].Hash())
+		}
+	}
+	m.mu.Unlock()
+
+	// The confirmed nonce is read before the receipts, so a transaction included in between
+	// is found by the receipt lookup instead of being reported as dropped.
+	var confirmedNonce uint64
+	if mtx != nil {
+		var err error
+		confirmedNonce, err = m.client.NonceAt(ctx, m.from, nil)
+		if err != nil {
+			return TxResult{}, errors.Wrap(err, "failed to get confirmed nonce")
+		}
+	}
+
+	for _, h := range hashes {
+		receipt, err := m.client.TransactionReceipt(ctx, h)
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		}
+		if err != nil {
+			return TxResult{}, errors.Wrap(err, "failed to get transaction receipt")
+		}
+		return m.checkReceipt(ctx, mtx, receipt)
+	}
+
+	if mtx == nil {
+		tx, _, err := m.client.TransactionByHash(ctx, hash)
+		if errors.Is(err, ethereum.NotFound) {
+			return TxResult{Status: TxStatusDropped, TxHash: hash.Hex()}, nil
+		}
+		if err != nil {
+			return TxResult{}, errors.Wrap(err, "failed to get transaction")
+		}
+		return TxResult{Status: TxStatusPending, TxHash: hash.Hex(), Nonce: tx.Nonce()}, nil
+	}
+
+	if confirmedNonce > mtx.nonce {
+		m.forget(mtx)
+		return TxResult{Status: TxStatusDropped, TxHash: hashes[0].Hex(), Nonce: mtx.nonce}, nil
+	}
+
+	evicted, err := m.isEvicted(ctx, hashes)
+	if err != nil {
+		return TxResult{}, err
+	}
+	if evicted {
+		m.forget(mtx)
+		m.Release(mtx.nonce)
+		return TxResult{Status: TxStatusDropped, TxHash: hashes[0].Hex(), Nonce: mtx.nonce}, nil
+	}
+
+	latest, err := m.bumpIfStuck(ctx, mtx)
+	if err != nil {
+		return TxResult{}, err
+	}
+	return TxResult{Status: TxStatusPending, TxHash: latest.Hex(), Nonce: mtx.nonce}, nil
+}
+
+// isEvicted reports whether none of the transaction versions is known to the node anymore.
+func (m *TxManager) isEvicted(ctx context.Context, hashes []common.Hash) (bool, error) {
+	for _, h := range hashes {
+		_, _, err := m.client.TransactionByHash(ctx, h)
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		}
+		if err != nil {
+			return false, errors.Wrap(err, "failed to get transaction")
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+// checkReceipt converts the receipt of an included transaction into a TxResult.
+func (m *TxManager) checkReceipt(ctx context.Context, mtx *managedTx, receipt *types.Receipt) (TxResult, error) {
+	head, err := m.client.BlockNumber(ctx)
+	if err != nil {
+		return TxResult{}, errors.Wrap(err, "failed to get latest block number")
+	}
+
+	result := TxResult{
+		Status:      TxStatusPending,
+		TxHash:      receipt.TxHash.Hex(),
+		BlockNumber: receipt.BlockNumber.Uint64(),
+	}
+	if mtx != nil {
+		result.Nonce = mtx.nonce
+	} else {
+		tx, _, err := m.client.TransactionByHash(ctx, receipt.TxHash)
+		if err != nil {
+			return TxResult{}, errors.Wrap(err, "failed to get transaction")
+		}
+		result.Nonce = tx.Nonce()
+	}
+	if head >= result.BlockNumber {
+		result.Confirmations = head - result.BlockNumber + 1
+	}
+	if result.Confirmations < m.cfg.Confirmations {
+		return result, nil
+	}
+
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		result.Status = TxStatusConfirmed
+	} else {
+		result.Status = TxStatusReverted
+	}
+	if mtx != nil {
+		m.forget(mtx)
+	}
+	return result, nil
+}
+
+// bumpIfStuck re-broadcasts the transaction with bumped fees if its latest version was sent
+// longer than the stuck timeout ago. Returns the hash of the latest broadcast version.
+func (m *TxManager) bumpIfStuck(ctx context.Context, mtx *managedTx) (common.Hash, error) {
+	m.mu.Lock()
+	last := mtx.txs[len(mtx.txs)-1]
+	stuck := m.now().Sub(mtx.lastSentAt) >= m.cfg.StuckTimeout && len(mtx.txs) <= m.cfg.MaxFeeBumps
+	m.mu.Unlock()
+	if !stuck {
+		return last.Hash(), nil
+	}
+
+	replacement, err := m.bumpFees(ctx, last)
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "failed to bump transaction fees")
+	}
+	signed, err := m.signFn(m.from, replacement)
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "failed to sign replacement transaction")
+	}
+	if err := m.client.SendTransaction(ctx, signed); err != nil {
+		return common.Hash{}, errors.Wrap(err, "failed to send replacement transaction")
+	}
+
+	m.mu.Lock()
+	mtx.txs = append(mtx.txs, signed)
+	mtx.lastSentAt = m.now()
+	m.txs[signed.Hash()] = mtx
+	m.mu.Unlock()
+
+	return signed.Hash(), nil
+}
+
+// bumpFees returns an unsigned copy of the transaction with fees increased by the configured percentage,
+// but not lower than the fees currently suggested by the node.
+func (m *TxManager) bumpFees(ctx context.Context, tx *types.Transaction) (*types.Transaction, error) {
+	if tx.Type() == types.LegacyTxType {
+		gasPrice := m.bump(tx.GasPrice())
+		suggested, err := m.client.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: bigMax(gasPrice, suggested),
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		}), nil
+	}
+
+	tipCap := m.bump(tx.GasTipCap())
+	suggestedTip, err := m.client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tipCap = bigMax(tipCap, suggestedTip)
+
+	feeCap := m.bump(tx.GasFeeCap())
+	head, err := m.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if head.BaseFee != nil {
+		// Same headroom for base fee increases as go-ethereum's bind package uses
+		suggestedFeeCap := new(big.Int).Add(tipCap, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+		feeCap = bigMax(feeCap, suggestedFeeCap)
+	}
+	feeCap = bigMax(feeCap, tipCap)
+
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:    tx.ChainId(),
+		Nonce:      tx.Nonce(),
+		GasTipCap:  tipCap,
+		GasFeeCap:  feeCap,
+		Gas:        tx.Gas(),
+		To:         tx.To(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	}), nil
+}
+
+// bump increases the value by the configured fee bump percentage, rounding up.
+func (m *TxManager) bump(value *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(value, new(big.Int).SetUint64(100+m.cfg.FeeBumpPercent))
+	bumped.Add(bumped, big.NewInt(99))
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
+// forget stops tracking all versions of the transaction.
+func (m *TxManager) forget(mtx *managedTx) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, tx := range mtx.txs {
+		delete(m.txs, tx.Hash())
+	}
+}
+
+func bigMax(a, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
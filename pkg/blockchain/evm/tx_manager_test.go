@@ -0,0 +1,301 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/sign"
+)
+
+const testTxManagerChainID = uint64(1337)
+
+func newTestTxManager(t *testing.T) (*TxManager, *MockTxManagerClient, *time.Time) {
+	t.Helper()
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	txSigner, err := sign.NewEthereumRawSigner(hexutil.Encode(crypto.FromECDSA(privKey)))
+	require.NoError(t, err)
+
+	client := new(MockTxManagerClient)
+	m := NewTxManager(client, txSigner, testTxManagerChainID, TxManagerConfig{
+		Confirmations:  3,
+		StuckTimeout:   time.Minute,
+		FeeBumpPercent: 5, // raised to the minimum of 10
+		MaxFeeBumps:    1,
+	})
+
+	now := time.Now()
+	m.now = func() time.Time { return now }
+	return m, client, &now
+}
+
+// sendTestTx builds and signs a transaction the way abigen bindings do with the given options.
+func sendTestTx(opts *bind.TransactOpts) (*types.Transaction, error) {
+	to := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   new(big.Int).SetUint64(testTxManagerChainID),
+		Nonce:     opts.Nonce.Uint64(),
+		GasTipCap: big.NewInt(100),
+		GasFeeCap: big.NewInt(1000),
+		Gas:       21000,
+		To:        &to,
+	})
+	return opts.Signer(opts.From, tx)
+}
+
+func TestTxManager_Transact_Nonces(t *testing.T) {
+	t.Parallel()
+	m, client, _ := newTestTxManager(t)
+	opts := &bind.TransactOpts{From: m.from, Signer: m.signFn}
+
+	client.On("PendingNonceAt", mock.Anything, m.from).Return(uint64(5), nil)
+
+	tx1, err := m.Transact(context.Background(), opts, sendTestTx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), tx1.Nonce())
+
+	// The node doesn't see the first transaction yet, the local nonce is used
+	tx2, err := m.Transact(context.Background(), opts, sendTestTx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), tx2.Nonce())
+	assert.Nil(t, opts.Nonce, "caller's options must not be modified")
+
+	// A failed send doesn't consume the nonce
+	_, err = m.Transact(context.Background(), opts, func(*bind.TransactOpts) (*types.Transaction, error) {
+		return nil, errors.New("execution reverted")
+	})
+	require.Error(t, err)
+
+	tx3, err := m.Transact(context.Background(), opts, sendTestTx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), tx3.Nonce())
+}
+
+func TestTxManager_Transact_UsesHigherPendingNonce(t *testing.T) {
+	t.Parallel()
+	m, client, _ := newTestTxManager(t)
+	opts := &bind.TransactOpts{From: m.from, Signer: m.signFn}
+
+	client.On("PendingNonceAt", mock.Anything, m.from).Return(uint64(1), nil).Once()
+	tx, err := m.Transact(context.Background(), opts, sendTestTx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), tx.Nonce())
+
+	// Transactions were sent by other means in the meantime
+	client.On("PendingNonceAt", mock.Anything, m.from).Return(uint64(10), nil).Once()
+	tx, err = m.Transact(context.Background(), opts, sendTestTx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), tx.Nonce())
+}
+
+func TestTxManager_Check(t *testing.T) {
+	t.Parallel()
+
+	setup := func(t *testing.T) (*TxManager, *MockTxManagerClient, *types.Transaction, *time.Time) {
+		m, client, now := newTestTxManager(t)
+		client.On("PendingNonceAt", mock.Anything, m.from).Return(uint64(3), nil)
+		tx, err := m.Transact(context.Background(), &bind.TransactOpts{From: m.from, Signer: m.signFn}, sendTestTx)
+		require.NoError(t, err)
+		return m, client, tx, now
+	}
+
+	t.Run("confirmed after enough confirmations", func(t *testing.T) {
+		m, client, tx, _ := setup(t)
+		receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: tx.Hash(), BlockNumber: big.NewInt(100)}
+		client.On("NonceAt", mock.Anything, m.from, (*big.Int)(nil)).Return(uint64(4), nil)
+		client.On("TransactionReceipt", mock.Anything, tx.Hash()).Return(receipt, nil)
+
+		client.On("BlockNumber", mock.Anything).Return(uint64(101), nil).Once()
+		result, err := m.Check(context.Background(), tx.Hash().Hex())
+		require.NoError(t, err)
+		assert.Equal(t, TxStatusPending, result.Status)
+		assert.Equal(t, uint64(2), result.Confirmations)
+
+		client.On("BlockNumber", mock.Anything).Return(uint64(102), nil).Once()
+		result, err = m.Check(context.Background(), tx.Hash().Hex())
+		require.NoError(t, err)
+		assert.Equal(t, TxResult{Status: TxStatusConfirmed, TxHash: tx.Hash().Hex(), Nonce: 3, BlockNumber: 100, Confirmations: 3}, result)
+		assert.Empty(t, m.txs, "confirmed transactions are not tracked anymore")
+	})
+
+	t.Run("reverted", func(t *testing.T) {
+		m, client, tx, _ := setup(t)
+		receipt := &types.Receipt{Status: types.ReceiptStatusFailed, TxHash: tx.Hash(), BlockNumber: big.NewInt(100)}
+		client.On("NonceAt", mock.Anything, m.from, (*big.Int)(nil)).Return(uint64(4), nil)
+		client.On("TransactionReceipt", mock.Anything, tx.Hash()).Return(receipt, nil)
+		client.On("BlockNumber", mock.Anything).Return(uint64(200), nil)
+
+		result, err := m.Check(context.Background(), tx.Hash().Hex())
+		require.NoError(t, err)
+		assert.Equal(t, TxStatusReverted, result.Status)
+		assert.Equal(t, uint64(100), result.BlockNumber)
+	})
+
+	t.Run("dropped when the nonce was used by another transaction", func(t *testing.T) {
+		m, client, tx, _ := setup(t)
+		client.On("NonceAt", mock.Anything, m.from, (*big.Int)(nil)).Return(uint64(4), nil)
+		client.On("TransactionReceipt", mock.Anything, tx.Hash()).Return(nil, ethereum.NotFound)
+
+		result, err := m.Check(context.Background(), tx.Hash().Hex())
+		require.NoError(t, err)
+		assert.Equal(t, TxStatusDropped, result.Status)
+		assert.Empty(t, m.txs)
+	})
+
+	t.Run("stuck transaction is re-broadcast with bumped fees", func(t *testing.T) {
+		m, client, tx, now := setup(t)
+		client.On("NonceAt", mock.Anything, m.from, (*big.Int)(nil)).Return(uint64(3), nil)
+		client.On("TransactionReceipt", mock.Anything, mock.Anything).Return(nil, ethereum.NotFound)
+		client.On("TransactionByHash", mock.Anything, mock.Anything).Return(tx, true, nil)
+
+		// Not stuck yet
+		result, err := m.Check(context.Background(), tx.Hash().Hex())
+		require.NoError(t, err)
+		assert.Equal(t, TxResult{Status: TxStatusPending, TxHash: tx.Hash().Hex(), Nonce: 3}, result)
+
+		*now = now.Add(time.Minute)
+		client.On("SuggestGasTipCap", mock.Anything).Return(big.NewInt(50), nil)
+		client.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(&types.Header{BaseFee: big.NewInt(400)}, nil)
+		var replacement *types.Transaction
+		client.On("SendTransaction", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			replacement = args.Get(1).(*types.Transaction)
+		}).Return(nil).Once()
+
+		result, err = m.Check(context.Background(), tx.Hash().Hex())
+		require.NoError(t, err)
+		require.NotNil(t, replacement)
+		assert.Equal(t, TxStatusPending, result.Status)
+		assert.Equal(t, replacement.Hash().Hex(), result.TxHash)
+		assert.Equal(t, tx.Nonce(), replacement.Nonce())
+		assert.Equal(t, big.NewInt(110), replacement.GasTipCap())
+		assert.Equal(t, big.NewInt(1100), replacement.GasFeeCap())
+
+		sender, err := types.LatestSignerForChainID(replacement.ChainId()).Sender(replacement)
+		require.NoError(t, err)
+		assert.Equal(t, m.from, sender)
+
+		// The replacement is found by the original hash
+		receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: replacement.Hash(), BlockNumber: big.NewInt(10)}
+		client.ExpectedCalls = nil
+		client.On("NonceAt", mock.Anything, m.from, (*big.Int)(nil)).Return(uint64(4), nil)
+		client.On("TransactionReceipt", mock.Anything, replacement.Hash()).Return(receipt, nil)
+		client.On("BlockNumber", mock.Anything).Return(uint64(20), nil)
+
+		result, err = m.Check(context.Background(), tx.Hash().Hex())
+		require.NoError(t, err)
+		assert.Equal(t, TxStatusConfirmed, result.Status)
+		assert.Equal(t, replacement.Hash().Hex(), result.TxHash)
+	})
+
+	t.Run("fee bumps are limited", func(t *testing.T) {
+		m, client, tx, now := setup(t)
+		client.On("NonceAt", mock.Anything, m.from, (*big.Int)(nil)).Return(uint64(3), nil)
+		client.On("TransactionReceipt", mock.Anything, mock.Anything).Return(nil, ethereum.NotFound)
+		client.On("TransactionByHash", mock.Anything, mock.Anything).Return(tx, true, nil)
+		client.On("SuggestGasTipCap", mock.Anything).Return(big.NewInt(1), nil)
+		client.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(&types.Header{BaseFee: big.NewInt(1)}, nil)
+		client.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Once()
+
+		*now = now.Add(time.Minute)
+		_, err := m.Check(context.Background(), tx.Hash().Hex())
+		require.NoError(t, err)
+
+		*now = now.Add(time.Minute)
+		_, err = m.Check(context.Background(), tx.Hash().Hex())
+		require.NoError(t, err)
+		client.AssertNumberOfCalls(t, "SendTransaction", 1)
+	})
+
+	t.Run("evicted transaction is dropped and its nonce reused", func(t *testing.T) {
+		m, client, tx, _ := setup(t)
+		client.On("NonceAt", mock.Anything, m.from, (*big.Int)(nil)).Return(uint64(3), nil)
+		client.On("TransactionReceipt", mock.Anything, tx.Hash()).Return(nil, ethereum.NotFound)
+		client.On("TransactionByHash", mock.Anything, tx.Hash()).Return(nil, false, ethereum.NotFound)
+
+		// A later transaction is sent before the eviction is noticed
+		later, err := m.Transact(context.Background(), &bind.TransactOpts{From: m.from, Signer: m.signFn}, sendTestTx)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(4), later.Nonce())
+
+		result, err := m.Check(context.Background(), tx.Hash().Hex())
+		require.NoError(t, err)
+		assert.Equal(t, TxResult{Status: TxStatusDropped, TxHash: tx.Hash().Hex(), Nonce: 3}, result)
+
+		// The resent transaction fills the gap instead of taking the next nonce
+		resent, err := m.Transact(context.Background(), &bind.TransactOpts{From: m.from, Signer: m.signFn}, sendTestTx)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), resent.Nonce())
+
+		next, err := m.Transact(context.Background(), &bind.TransactOpts{From: m.from, Signer: m.signFn}, sendTestTx)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5), next.Nonce())
+	})
+}
+
+func TestTxManager_Release(t *testing.T) {
+	t.Parallel()
+	m, client, _ := newTestTxManager(t)
+	opts := &bind.TransactOpts{From: m.from, Signer: m.signFn}
+
+	client.On("PendingNonceAt", mock.Anything, m.from).Return(uint64(10), nil)
+	client.On("NonceAt", mock.Anything, m.from, (*big.Int)(nil)).Return(uint64(7), nil)
+
+	// Nonces released after a restart, one of them was used on-chain in the meantime
+	m.Release(6)
+	m.Release(8)
+	m.Release(12)
+
+	tx, err := m.Transact(context.Background(), opts, sendTestTx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), tx.Nonce())
+
+	// A failed send doesn't consume the released nonce
+	m.Release(9)
+	_, err = m.Transact(context.Background(), opts, func(*bind.TransactOpts) (*types.Transaction, error) {
+		return nil, errors.New("execution reverted")
+	})
+	require.Error(t, err)
+
+	tx, err = m.Transact(context.Background(), opts, sendTestTx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(9), tx.Nonce())
+
+	tx, err = m.Transact(context.Background(), opts, sendTestTx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), tx.Nonce())
+	assert.Empty(t, m.freeNonces)
+}
+
+func TestTxManager_Check_Untracked(t *testing.T) {
+	t.Parallel()
+	m, client, _ := newTestTxManager(t)
+	known := common.HexToHash("0x01")
+	unknown := common.HexToHash("0x02")
+
+	client.On("TransactionReceipt", mock.Anything, mock.Anything).Return(nil, ethereum.NotFound)
+	client.On("TransactionByHash", mock.Anything, known).Return(types.NewTx(&types.LegacyTx{Nonce: 7}), true, nil)
+	client.On("TransactionByHash", mock.Anything, unknown).Return(nil, false, ethereum.NotFound)
+
+	result, err := m.Check(context.Background(), known.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, TxStatusPending, result.Status)
+	assert.Equal(t, uint64(7), result.Nonce)
+
+	result, err = m.Check(context.Background(), unknown.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, TxStatusDropped, result.Status)
+	client.AssertNotCalled(t, "NonceAt", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,759 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+)
+
+// MockBackendType is the type of in-process mock blockchains in the blockchain configuration.
+const MockBackendType = "mock"
+
+var _ Backend = &MockBackend{}
+
+// MockBackend is an in-process Backend keeping the contract state in memory, with the rules of
+// ChannelHub on state versions and intents. Every transaction is included in a block of its own
+// right away, and its events are delivered to the listeners in order.
+// It is the reference implementation of Backend for tests. Signatures are not verified.
+type MockBackend struct {
+	mu          sync.Mutex
+	blockchain  core.Blockchain
+	nodeAddress string
+	statePacker core.StatePacker
+	now         func() time.Time
+
+	channels       map[string]*mockChannel
+	escrows        map[string]*mockEscrow
+	vault          map[string]map[string]decimal.Decimal
+	walletBalances map[string]map[string]decimal.Decimal
+	txs            map[string]TxResult
+	events         []mockEvent
+	// newEvent is closed and replaced whenever an event is emitted
+	newEvent chan struct{}
+}
+
+type mockChannel struct {
+	def             core.ChannelDefinition
+	lastState       core.State
+	challengeExpiry uint64
+	closed          bool
+}
+
+type mockEscrow struct {
+	def             core.ChannelDefinition
+	lastState       core.State
+	challengeExpiry uint64
+	finalized       bool
+}
+
+type mockEvent struct {
+	core.BlockchainEvent
+	payload any
+}
+
+// NewMockBackend creates a MockBackend without any channel. It is the BackendFactory of mock blockchains.
+func NewMockBackend(_ context.Context, cfg Config) (Backend, error) {
+	return &MockBackend{
+		blockchain:     cfg.Blockchain,
+		nodeAddress:    cfg.NodeAddress,
+		statePacker:    core.NewStatePackerV1(cfg.AssetStore),
+		now:            time.Now,
+		channels:       make(map[string]*mockChannel),
+		escrows:        make(map[string]*mockEscrow),
+		vault:          make(map[string]map[string]decimal.Decimal),
+		walletBalances: make(map[string]map[string]decimal.Decimal),
+		txs:            make(map[string]TxResult),
+		newEvent:       make(chan struct{}),
+	}, nil
+}
+
+func (b *MockBackend) ChannelHubClient() (core.BlockchainClientV2, error) {
+	if b.blockchain.ChannelHubAddress == "" {
+		return nil, fmt.Errorf("channel hub address is not configured")
+	}
+	return mockChannelHub{b}, nil
+}
+
+func (b *MockBackend) TxTracker() TxTracker {
+	return mockTxTracker{b}
+}
+
+func (b *MockBackend) StatePacker() core.StatePacker {
+	return b.statePacker
+}
+
+func (b *MockBackend) ListenChannelHub(ctx context.Context, handler core.ChannelHubEventHandler, cfg ListenerConfig, handleClosure func(err error)) error {
+	if b.blockchain.ChannelHubAddress == "" {
+		return fmt.Errorf("channel hub address is not configured")
+	}
+	return b.listen(ctx, b.blockchain.ChannelHubAddress, func(ctx context.Context, payload any) error {
+		return handleChannelHubEvent(ctx, handler, payload)
+	}, cfg, handleClosure)
+}
+
+func (b *MockBackend) ListenLocking(ctx context.Context, handler core.LockingContractEventHandler, cfg ListenerConfig, handleClosure func(err error)) error {
+	if b.blockchain.LockingContractAddress == "" {
+		return fmt.Errorf("locking contract address is not configured")
+	}
+	return b.listen(ctx, b.blockchain.LockingContractAddress, func(ctx context.Context, payload any) error {
+		return handler.HandleUserLockedBalanceUpdated(ctx, payload.(*core.UserLockedBalanceUpdatedEvent))
+	}, cfg, handleClosure)
+}
+
+func (b *MockBackend) Close() {}
+
+// SetNow replaces the clock challenge expiries are computed with.
+func (b *MockBackend) SetNow(now func() time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.now = now
+}
+
+// SetTokenBalance sets the balance of the asset held by the wallet outside of ChannelHub.
+func (b *MockBackend) SetTokenBalance(asset, wallet string, balance decimal.Decimal) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	setBalance(b.walletBalances, wallet, asset, balance)
+}
+
+// SetLockedBalance emits the event of the Locking contract reporting the new locked balance of the user.
+func (b *MockBackend) SetLockedBalance(user string, balance decimal.Decimal) string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.transact(b.blockchain.LockingContractAddress, "Locked", &core.UserLockedBalanceUpdatedEvent{
+		UserAddress:  user,
+		BlockchainID: b.blockchain.ID,
+		Balance:      balance,
+	})
+}
+
+// transact includes a transaction in a new block and emits its event, if any.
+// It returns the transaction hash. The caller must hold the lock.
+func (b *MockBackend) transact(contractAddress, eventName string, payload any) string {
+	block := uint64(len(b.txs) + 1)
+	txHash := fmt.Sprintf("0x%064x", block)
+	b.txs[txHash] = TxResult{Status: TxStatusConfirmed, TxHash: txHash, BlockNumber: block}
+
+	if payload != nil {
+		b.events = append(b.events, mockEvent{
+			BlockchainEvent: core.BlockchainEvent{
+				ContractAddress: contractAddress,
+				BlockchainID:    b.blockchain.ID,
+				Name:            eventName,
+				BlockNumber:     block,
+				BlockHash:       fmt.Sprintf("0x%064x", block<<32),
+				TransactionHash: txHash,
+			},
+			payload: payload,
+		})
+		close(b.newEvent)
+		b.newEvent = make(chan struct{})
+	}
+	return txHash
+}
+
+// listen delivers the events of the contract emitted after the latest recorded one, then the new ones.
+func (b *MockBackend) listen(ctx context.Context, contractAddress string, handle func(ctx context.Context, payload any) error, cfg ListenerConfig, handleClosure func(err error)) error {
+	latest, err := cfg.GetLatestEvent(contractAddress, b.blockchain.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get latest event: %w", err)
+	}
+
+	go func() {
+		next := 0
+		for {
+			b.mu.Lock()
+			events := b.events[next:]
+			newEvent := b.newEvent
+			b.mu.Unlock()
+
+			for _, ev := range events {
+				next++
+				if !strings.EqualFold(ev.ContractAddress, contractAddress) || ev.BlockNumber <= latest.BlockNumber {
+					continue
+				}
+
+				err := handle(ctx, ev.payload)
+				if cfg.OnEventProcessed != nil {
+					cfg.OnEventProcessed(b.blockchain.ID, err == nil)
+				}
+				if err == nil {
+					err = cfg.StoreContractEvent(ev.BlockchainEvent)
+				}
+				if err != nil {
+					handleClosure(fmt.Errorf("failed to process event %s: %w", ev.Name, err))
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				handleClosure(nil)
+				return
+			case <-newEvent:
+			}
+		}
+	}()
+	return nil
+}
+
+func handleChannelHubEvent(ctx context.Context, handler core.ChannelHubEventHandler, payload any) error {
+	switch ev := payload.(type) {
+	case *core.HomeChannelCreatedEvent:
+		return handler.HandleHomeChannelCreated(ctx, ev)
+	case *core.HomeChannelMigratedEvent:
+		return handler.HandleHomeChannelMigrated(ctx, ev)
+	case *core.HomeChannelMigrationInFinalizedEvent:
+		return handler.HandleHomeChannelMigrationInFinalized(ctx, ev)
+	case *core.HomeChannelMigrationOutInitiatedEvent:
+		return handler.HandleHomeChannelMigrationOutInitiated(ctx, ev)
+	case *core.HomeChannelMigrationOutFinalizedEvent:
+		return handler.HandleHomeChannelMigrationOutFinalized(ctx, ev)
+	case *core.HomeChannelCheckpointedEvent:
+		return handler.HandleHomeChannelCheckpointed(ctx, ev)
+	case *core.HomeChannelChallengedEvent:
+		return handler.HandleHomeChannelChallenged(ctx, ev)
+	case *core.HomeChannelClosedEvent:
+		return handler.HandleHomeChannelClosed(ctx, ev)
+	case *core.EscrowDepositInitiatedEvent:
+		return handler.HandleEscrowDepositInitiated(ctx, ev)
+	case *core.EscrowDepositChallengedEvent:
+		return handler.HandleEscrowDepositChallenged(ctx, ev)
+	case *core.EscrowDepositFinalizedEvent:
+		return handler.HandleEscrowDepositFinalized(ctx, ev)
+	case *core.EscrowDepositsPurgedEvent:
+		return handler.HandleEscrowDepositsPurged(ctx, ev)
+	case *core.EscrowWithdrawalInitiatedEvent:
+		return handler.HandleEscrowWithdrawalInitiated(ctx, ev)
+	case *core.EscrowWithdrawalChallengedEvent:
+		return handler.HandleEscrowWithdrawalChallenged(ctx, ev)
+	case *core.EscrowWithdrawalFinalizedEvent:
+		return handler.HandleEscrowWithdrawalFinalized(ctx, ev)
+	case *core.VaultDepositedEvent:
+		return handler.HandleVaultDeposited(ctx, ev)
+	case *core.VaultWithdrawnEvent:
+		return handler.HandleVaultWithdrawn(ctx, ev)
+	default:
+		return fmt.Errorf("unsupported event %T", payload)
+	}
+}
+
+func getBalance(balances map[string]map[string]decimal.Decimal, account, token string) decimal.Decimal {
+	return balances[strings.ToLower(account)][strings.ToLower(token)]
+}
+
+func setBalance(balances map[string]map[string]decimal.Decimal, account, token string, balance decimal.Decimal) {
+	account = strings.ToLower(account)
+	if balances[account] == nil {
+		balances[account] = make(map[string]decimal.Decimal)
+	}
+	balances[account][strings.ToLower(token)] = balance
+}
+
+type mockTxTracker struct {
+	b *MockBackend
+}
+
+func (t mockTxTracker) Check(_ context.Context, txHash string) (TxResult, error) {
+	t.b.mu.Lock()
+	defer t.b.mu.Unlock()
+
+	result, ok := t.b.txs[txHash]
+	if !ok {
+		return TxResult{}, fmt.Errorf("transaction %s not found", txHash)
+	}
+	result.Confirmations = uint64(len(t.b.txs)) - result.BlockNumber + 1
+	return result, nil
+}
+
+// Release is a no-op, as the mock backend includes every transaction immediately.
+func (mockTxTracker) Release(uint64) {}
+
+// mockChannelHub is the ChannelHub client of a MockBackend.
+type mockChannelHub struct {
+	b *MockBackend
+}
+
+func (c mockChannelHub) GetAccountsBalances(_ context.Context, accounts []string, tokens []string) ([][]decimal.Decimal, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	result := make([][]decimal.Decimal, len(accounts))
+	for i, account := range accounts {
+		result[i] = make([]decimal.Decimal, len(tokens))
+		for j, token := range tokens {
+			result[i][j] = getBalance(c.b.vault, account, token)
+		}
+	}
+	return result, nil
+}
+
+func (c mockChannelHub) GetTokenBalance(_ context.Context, asset string, walletAddress string) (decimal.Decimal, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	return getBalance(c.b.walletBalances, walletAddress, asset), nil
+}
+
+func (c mockChannelHub) Approve(_ context.Context, _ string, _ decimal.Decimal) (string, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	return c.b.transact(c.b.blockchain.ChannelHubAddress, "", nil), nil
+}
+
+func (c mockChannelHub) GetNodeBalance(_ context.Context, token string) (decimal.Decimal, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	return getBalance(c.b.vault, c.b.nodeAddress, token), nil
+}
+
+func (c mockChannelHub) GetOpenChannels(_ context.Context, user string) ([]string, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	channelIDs := []string{}
+	for channelID, channel := range c.b.channels {
+		if !channel.closed && strings.EqualFold(channel.lastState.UserWallet, user) {
+			channelIDs = append(channelIDs, channelID)
+		}
+	}
+	slices.Sort(channelIDs)
+	return channelIDs, nil
+}
+
+func (c mockChannelHub) GetHomeChannelData(_ context.Context, homeChannelID string) (core.HomeChannelDataResponse, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	channel, ok := c.b.channels[strings.ToLower(homeChannelID)]
+	if !ok {
+		return core.HomeChannelDataResponse{}, fmt.Errorf("channel %s not found", homeChannelID)
+	}
+	return core.HomeChannelDataResponse{
+		Definition:      channel.def,
+		Node:            c.b.nodeAddress,
+		LastState:       channel.lastState,
+		ChallengeExpiry: channel.challengeExpiry,
+	}, nil
+}
+
+func (c mockChannelHub) GetEscrowDepositData(_ context.Context, escrowChannelID string) (core.EscrowDepositDataResponse, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	escrow, ok := c.b.escrows[strings.ToLower(escrowChannelID)]
+	if !ok {
+		return core.EscrowDepositDataResponse{}, fmt.Errorf("escrow %s not found", escrowChannelID)
+	}
+	return core.EscrowDepositDataResponse{
+		EscrowChannelID: escrowChannelID,
+		Node:            c.b.nodeAddress,
+		LastState:       escrow.lastState,
+		ChallengeExpiry: escrow.challengeExpiry,
+	}, nil
+}
+
+func (c mockChannelHub) GetEscrowWithdrawalData(_ context.Context, escrowChannelID string) (core.EscrowWithdrawalDataResponse, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	escrow, ok := c.b.escrows[strings.ToLower(escrowChannelID)]
+	if !ok {
+		return core.EscrowWithdrawalDataResponse{}, fmt.Errorf("escrow %s not found", escrowChannelID)
+	}
+	return core.EscrowWithdrawalDataResponse{
+		EscrowChannelID: escrowChannelID,
+		Node:            c.b.nodeAddress,
+		LastState:       escrow.lastState,
+	}, nil
+}
+
+func (c mockChannelHub) Deposit(_ context.Context, node, token string, amount decimal.Decimal) (string, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	setBalance(c.b.vault, node, token, getBalance(c.b.vault, node, token).Add(amount))
+	return c.b.transact(c.b.blockchain.ChannelHubAddress, "Deposited", &core.VaultDepositedEvent{
+		Wallet:       node,
+		BlockchainID: c.b.blockchain.ID,
+		TokenAddress: token,
+		Amount:       amount,
+	}), nil
+}
+
+func (c mockChannelHub) Withdraw(_ context.Context, node, token string, amount decimal.Decimal) (string, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	balance := getBalance(c.b.vault, node, token)
+	if balance.LessThan(amount) {
+		return "", fmt.Errorf("insufficient vault balance: %s < %s", balance, amount)
+	}
+	setBalance(c.b.vault, node, token, balance.Sub(amount))
+	return c.b.transact(c.b.blockchain.ChannelHubAddress, "Withdrawn", &core.VaultWithdrawnEvent{
+		Wallet:       node,
+		BlockchainID: c.b.blockchain.ID,
+		TokenAddress: token,
+		Amount:       amount,
+	}), nil
+}
+
+// EnsureSigValidatorRegistered accepts every validator, the mock chain doesn't verify signatures.
+func (c mockChannelHub) EnsureSigValidatorRegistered(_ context.Context, _ uint8, _ string, _ bool) error {
+	return nil
+}
+
+func (c mockChannelHub) Create(_ context.Context, def core.ChannelDefinition, initCCS core.State) (string, error) {
+	switch intent := core.TransitionToIntent(initCCS.Transition); intent {
+	case core.INTENT_OPERATE, core.INTENT_DEPOSIT, core.INTENT_WITHDRAW:
+	default:
+		return "", fmt.Errorf("unsupported intent for create: %d", intent)
+	}
+	if initCCS.HomeChannelID == nil {
+		return "", fmt.Errorf("state must have a home channel ID")
+	}
+
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	channelID := strings.ToLower(*initCCS.HomeChannelID)
+	if _, ok := c.b.channels[channelID]; ok {
+		return "", fmt.Errorf("channel %s already exists", channelID)
+	}
+	c.b.channels[channelID] = &mockChannel{def: def, lastState: initCCS}
+	return c.b.transact(c.b.blockchain.ChannelHubAddress, "ChannelCreated", &core.HomeChannelCreatedEvent{
+		ChannelID:    *initCCS.HomeChannelID,
+		StateVersion: initCCS.Version,
+	}), nil
+}
+
+func (c mockChannelHub) MigrateChannelHere(_ context.Context, def core.ChannelDefinition, candidate core.State) (string, error) {
+	if candidate.HomeChannelID == nil {
+		return "", fmt.Errorf("candidate state must have a home channel ID")
+	}
+
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	channelID := strings.ToLower(*candidate.HomeChannelID)
+	if channel, ok := c.b.channels[channelID]; ok && !channel.closed {
+		return "", fmt.Errorf("channel %s already exists", channelID)
+	}
+	c.b.channels[channelID] = &mockChannel{def: def, lastState: candidate}
+	return c.b.transact(c.b.blockchain.ChannelHubAddress, "MigrationInInitiated", &core.HomeChannelMigratedEvent{
+		ChannelID:    *candidate.HomeChannelID,
+		StateVersion: candidate.Version,
+	}), nil
+}
+
+func (c mockChannelHub) FinalizeMigration(_ context.Context, candidate core.State) (string, error) {
+	if intent := core.TransitionToIntent(candidate.Transition); intent != core.INTENT_FINALIZE_MIGRATION {
+		return "", fmt.Errorf("unsupported intent for finalizing a migration: %d", intent)
+	}
+	if candidate.EscrowLedger == nil {
+		return "", fmt.Errorf("candidate state must have an escrow ledger")
+	}
+
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	channel, err := c.b.openChannel(candidate)
+	if err != nil {
+		return "", err
+	}
+	if candidate.Version <= channel.lastState.Version {
+		return "", fmt.Errorf("candidate version %d is not newer than the on-chain version %d", candidate.Version, channel.lastState.Version)
+	}
+	channel.lastState = candidate
+	channel.challengeExpiry = 0
+
+	// The escrow ledger of the candidate is the one of the blockchain the channel leaves
+	if candidate.EscrowLedger.BlockchainID == c.b.blockchain.ID {
+		channel.closed = true
+		return c.b.transact(c.b.blockchain.ChannelHubAddress, "MigrationOutFinalized", &core.HomeChannelMigrationOutFinalizedEvent{
+			ChannelID:    *candidate.HomeChannelID,
+			StateVersion: candidate.Version,
+		}), nil
+	}
+	return c.b.transact(c.b.blockchain.ChannelHubAddress, "MigrationInFinalized", &core.HomeChannelMigrationInFinalizedEvent{
+		ChannelID:    *candidate.HomeChannelID,
+		StateVersion: candidate.Version,
+		BlockchainID: candidate.HomeLedger.BlockchainID,
+		TokenAddress: candidate.HomeLedger.TokenAddress,
+	}), nil
+}
+
+func (c mockChannelHub) Checkpoint(_ context.Context, candidate core.State) (string, error) {
+	switch intent := core.TransitionToIntent(candidate.Transition); intent {
+	case core.INTENT_OPERATE, core.INTENT_DEPOSIT, core.INTENT_WITHDRAW:
+	default:
+		return "", fmt.Errorf("unsupported intent for checkpointing: %d", intent)
+	}
+
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	if err := c.b.checkpointChannel(candidate); err != nil {
+		return "", err
+	}
+	return c.b.transact(c.b.blockchain.ChannelHubAddress, "ChannelCheckpointed", &core.HomeChannelCheckpointedEvent{
+		ChannelID:    *candidate.HomeChannelID,
+		StateVersion: candidate.Version,
+	}), nil
+}
+
+// checkpointChannel records the candidate state as the latest one of its open home channel.
+// The caller must hold the lock.
+func (b *MockBackend) checkpointChannel(candidate core.State) error {
+	channel, err := b.openChannel(candidate)
+	if err != nil {
+		return err
+	}
+	if candidate.Version <= channel.lastState.Version {
+		return fmt.Errorf("candidate version %d is not newer than the on-chain version %d", candidate.Version, channel.lastState.Version)
+	}
+	channel.lastState = candidate
+	channel.challengeExpiry = 0
+	return nil
+}
+
+// isHomeChain reports whether the home channel of the state lives on this blockchain.
+func (b *MockBackend) isHomeChain(state core.State) bool {
+	return state.HomeLedger.BlockchainID == b.blockchain.ID
+}
+
+func (c mockChannelHub) Challenge(_ context.Context, candidate core.State, _ []byte, _ core.ChannelParticipant) (string, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	channel, err := c.b.openChannel(candidate)
+	if err != nil {
+		return "", err
+	}
+	if candidate.Version < channel.lastState.Version {
+		return "", fmt.Errorf("candidate version %d is older than the on-chain version %d", candidate.Version, channel.lastState.Version)
+	}
+	channel.lastState = candidate
+	channel.challengeExpiry = uint64(c.b.now().Unix()) + uint64(channel.def.Challenge)
+	return c.b.transact(c.b.blockchain.ChannelHubAddress, "ChannelChallenged", &core.HomeChannelChallengedEvent{
+		ChannelID:       *candidate.HomeChannelID,
+		StateVersion:    candidate.Version,
+		ChallengeExpiry: channel.challengeExpiry,
+	}), nil
+}
+
+func (c mockChannelHub) Close(_ context.Context, candidate core.State) (string, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	channel, err := c.b.openChannel(candidate)
+	if err != nil {
+		return "", err
+	}
+
+	// Once the challenge period of a disputed channel is over, the channel closes with the challenged state
+	if channel.challengeExpiry != 0 && channel.challengeExpiry < uint64(c.b.now().Unix()) {
+		candidate = channel.lastState
+	} else if intent := core.TransitionToIntent(candidate.Transition); intent != core.INTENT_CLOSE {
+		return "", fmt.Errorf("unsupported intent for close: %d", intent)
+	} else if candidate.Version <= channel.lastState.Version {
+		return "", fmt.Errorf("candidate version %d is not newer than the on-chain version %d", candidate.Version, channel.lastState.Version)
+	}
+
+	channel.lastState = candidate
+	channel.closed = true
+	return c.b.transact(c.b.blockchain.ChannelHubAddress, "ChannelClosed", &core.HomeChannelClosedEvent{
+		ChannelID:    *candidate.HomeChannelID,
+		StateVersion: candidate.Version,
+	}), nil
+}
+
+// openChannel returns the open home channel of the candidate state. The caller must hold the lock.
+func (b *MockBackend) openChannel(candidate core.State) (*mockChannel, error) {
+	if candidate.HomeChannelID == nil {
+		return nil, fmt.Errorf("candidate state must have a home channel ID")
+	}
+	channel, ok := b.channels[strings.ToLower(*candidate.HomeChannelID)]
+	if !ok {
+		return nil, fmt.Errorf("channel %s not found", *candidate.HomeChannelID)
+	}
+	if channel.closed {
+		return nil, fmt.Errorf("channel %s is closed", *candidate.HomeChannelID)
+	}
+	return channel, nil
+}
+
+func (c mockChannelHub) InitiateEscrowDeposit(_ context.Context, def core.ChannelDefinition, initCCS core.State) (string, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	// On the home chain the escrow deposit is only checkpointed in the home channel
+	if c.b.isHomeChain(initCCS) {
+		if intent := core.TransitionToIntent(initCCS.Transition); intent != core.INTENT_INITIATE_ESCROW_DEPOSIT {
+			return "", fmt.Errorf("unsupported intent for initiating an escrow: %d", intent)
+		}
+		if err := c.b.checkpointChannel(initCCS); err != nil {
+			return "", err
+		}
+		return c.b.transact(c.b.blockchain.ChannelHubAddress, "EscrowDepositInitiatedOnHome", &core.HomeChannelCheckpointedEvent{
+			ChannelID:    *initCCS.HomeChannelID,
+			StateVersion: initCCS.Version,
+		}), nil
+	}
+
+	escrowID, err := c.b.initiateEscrow(def, initCCS, core.INTENT_INITIATE_ESCROW_DEPOSIT)
+	if err != nil {
+		return "", err
+	}
+	return c.b.transact(c.b.blockchain.ChannelHubAddress, "EscrowDepositInitiated", &core.EscrowDepositInitiatedEvent{
+		ChannelID:    escrowID,
+		StateVersion: initCCS.Version,
+	}), nil
+}
+
+func (c mockChannelHub) ChallengeEscrowDeposit(_ context.Context, candidate core.State, _ []byte, _ core.ChannelParticipant) (string, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	escrow, err := c.b.pendingEscrow(candidate)
+	if err != nil {
+		return "", err
+	}
+	escrow.challengeExpiry = uint64(c.b.now().Unix()) + uint64(escrow.def.Challenge)
+	return c.b.transact(c.b.blockchain.ChannelHubAddress, "EscrowDepositChallenged", &core.EscrowDepositChallengedEvent{
+		ChannelID:       *candidate.EscrowChannelID,
+		StateVersion:    escrow.lastState.Version,
+		ChallengeExpiry: escrow.challengeExpiry,
+	}), nil
+}
+
+func (c mockChannelHub) FinalizeEscrowDeposit(_ context.Context, candidate core.State) (string, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	if c.b.isHomeChain(candidate) {
+		if intent := core.TransitionToIntent(candidate.Transition); intent != core.INTENT_FINALIZE_ESCROW_DEPOSIT {
+			return "", fmt.Errorf("unsupported intent for finalizing an escrow: %d", intent)
+		}
+		if err := c.b.checkpointChannel(candidate); err != nil {
+			return "", err
+		}
+		return c.b.transact(c.b.blockchain.ChannelHubAddress, "EscrowDepositFinalizedOnHome", &core.HomeChannelCheckpointedEvent{
+			ChannelID:    *candidate.HomeChannelID,
+			StateVersion: candidate.Version,
+		}), nil
+	}
+
+	if err := c.b.finalizeEscrow(candidate, core.INTENT_FINALIZE_ESCROW_DEPOSIT); err != nil {
+		return "", err
+	}
+	return c.b.transact(c.b.blockchain.ChannelHubAddress, "EscrowDepositFinalized", &core.EscrowDepositFinalizedEvent{
+		ChannelID:    *candidate.EscrowChannelID,
+		StateVersion: candidate.Version,
+	}), nil
+}
+
+func (c mockChannelHub) InitiateEscrowWithdrawal(_ context.Context, def core.ChannelDefinition, initCCS core.State) (string, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	escrowID, err := c.b.initiateEscrow(def, initCCS, core.INTENT_INITIATE_ESCROW_WITHDRAWAL)
+	if err != nil {
+		return "", err
+	}
+	return c.b.transact(c.b.blockchain.ChannelHubAddress, "EscrowWithdrawalInitiated", &core.EscrowWithdrawalInitiatedEvent{
+		ChannelID:    escrowID,
+		StateVersion: initCCS.Version,
+	}), nil
+}
+
+func (c mockChannelHub) ChallengeEscrowWithdrawal(_ context.Context, candidate core.State, _ []byte, _ core.ChannelParticipant) (string, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	escrow, err := c.b.pendingEscrow(candidate)
+	if err != nil {
+		return "", err
+	}
+	escrow.challengeExpiry = uint64(c.b.now().Unix()) + uint64(escrow.def.Challenge)
+	return c.b.transact(c.b.blockchain.ChannelHubAddress, "EscrowWithdrawalChallenged", &core.EscrowWithdrawalChallengedEvent{
+		ChannelID:       *candidate.EscrowChannelID,
+		StateVersion:    escrow.lastState.Version,
+		ChallengeExpiry: escrow.challengeExpiry,
+	}), nil
+}
+
+func (c mockChannelHub) FinalizeEscrowWithdrawal(_ context.Context, candidate core.State) (string, error) {
+	c.b.mu.Lock()
+	defer c.b.mu.Unlock()
+
+	if err := c.b.finalizeEscrow(candidate, core.INTENT_FINALIZE_ESCROW_WITHDRAWAL); err != nil {
+		return "", err
+	}
+	return c.b.transact(c.b.blockchain.ChannelHubAddress, "EscrowWithdrawalFinalized", &core.EscrowWithdrawalFinalizedEvent{
+		ChannelID:    *candidate.EscrowChannelID,
+		StateVersion: candidate.Version,
+	}), nil
+}
+
+// initiateEscrow records a new escrow with the initial state. The caller must hold the lock.
+func (b *MockBackend) initiateEscrow(def core.ChannelDefinition, initCCS core.State, expectedIntent uint8) (string, error) {
+	if intent := core.TransitionToIntent(initCCS.Transition); intent != expectedIntent {
+		return "", fmt.Errorf("unsupported intent for initiating an escrow: %d", intent)
+	}
+	if initCCS.EscrowChannelID == nil {
+		return "", fmt.Errorf("state must have an escrow channel ID")
+	}
+
+	escrowID := strings.ToLower(*initCCS.EscrowChannelID)
+	if _, ok := b.escrows[escrowID]; ok {
+		return "", fmt.Errorf("escrow %s already exists", escrowID)
+	}
+	b.escrows[escrowID] = &mockEscrow{def: def, lastState: initCCS}
+	return *initCCS.EscrowChannelID, nil
+}
+
+// pendingEscrow returns the escrow of the candidate state, if not finalized yet. The caller must hold the lock.
+func (b *MockBackend) pendingEscrow(candidate core.State) (*mockEscrow, error) {
+	if candidate.EscrowChannelID == nil {
+		return nil, fmt.Errorf("candidate state must have an escrow channel ID")
+	}
+	escrow, ok := b.escrows[strings.ToLower(*candidate.EscrowChannelID)]
+	if !ok {
+		return nil, fmt.Errorf("escrow %s not found", *candidate.EscrowChannelID)
+	}
+	if escrow.finalized {
+		return nil, fmt.Errorf("escrow %s is finalized", *candidate.EscrowChannelID)
+	}
+	return escrow, nil
+}
+
+// finalizeEscrow finalizes the escrow of the candidate state. The caller must hold the lock.
+func (b *MockBackend) finalizeEscrow(candidate core.State, expectedIntent uint8) error {
+	if intent := core.TransitionToIntent(candidate.Transition); intent != expectedIntent {
+		return fmt.Errorf("unsupported intent for finalizing an escrow: %d", intent)
+	}
+	escrow, err := b.pendingEscrow(candidate)
+	if err != nil {
+		return err
+	}
+	if candidate.Version <= escrow.lastState.Version {
+		return fmt.Errorf("candidate version %d is not newer than the on-chain version %d", candidate.Version, escrow.lastState.Version)
+	}
+	escrow.lastState = candidate
+	escrow.challengeExpiry = 0
+	escrow.finalized = true
+	return nil
+}
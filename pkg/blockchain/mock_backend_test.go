@@ -0,0 +1,379 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+)
+
+const (
+	mockChannelHubAddress = "channel_hub"
+	mockLockingAddress    = "locking"
+)
+
+// recordingHandler records the names of the events it handles, in order.
+type recordingHandler struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (h *recordingHandler) record(name string, channelID string, version uint64) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.events = append(h.events, fmt.Sprintf("%s:%s:%d", name, channelID, version))
+	return nil
+}
+
+func (h *recordingHandler) recorded() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]string(nil), h.events...)
+}
+
+func (h *recordingHandler) HandleHomeChannelCreated(_ context.Context, ev *core.HomeChannelCreatedEvent) error {
+	return h.record("created", ev.ChannelID, ev.StateVersion)
+}
+
+func (h *recordingHandler) HandleHomeChannelMigrated(_ context.Context, ev *core.HomeChannelMigratedEvent) error {
+	return h.record("migrated", ev.ChannelID, ev.StateVersion)
+}
+
+func (h *recordingHandler) HandleHomeChannelMigrationInFinalized(_ context.Context, ev *core.HomeChannelMigrationInFinalizedEvent) error {
+	return h.record("migration_in_finalized", ev.ChannelID, ev.StateVersion)
+}
+
+func (h *recordingHandler) HandleHomeChannelMigrationOutInitiated(_ context.Context, ev *core.HomeChannelMigrationOutInitiatedEvent) error {
+	return h.record("migration_out_initiated", ev.ChannelID, ev.StateVersion)
+}
+
+func (h *recordingHandler) HandleHomeChannelMigrationOutFinalized(_ context.Context, ev *core.HomeChannelMigrationOutFinalizedEvent) error {
+	return h.record("migration_out_finalized", ev.ChannelID, ev.StateVersion)
+}
+
+func (h *recordingHandler) HandleHomeChannelCheckpointed(_ context.Context, ev *core.HomeChannelCheckpointedEvent) error {
+	return h.record("checkpointed", ev.ChannelID, ev.StateVersion)
+}
+
+func (h *recordingHandler) HandleHomeChannelChallenged(_ context.Context, ev *core.HomeChannelChallengedEvent) error {
+	return h.record("challenged", ev.ChannelID, ev.StateVersion)
+}
+
+func (h *recordingHandler) HandleHomeChannelClosed(_ context.Context, ev *core.HomeChannelClosedEvent) error {
+	return h.record("closed", ev.ChannelID, ev.StateVersion)
+}
+
+func (h *recordingHandler) HandleEscrowDepositInitiated(_ context.Context, ev *core.EscrowDepositInitiatedEvent) error {
+	return h.record("escrow_deposit_initiated", ev.ChannelID, ev.StateVersion)
+}
+
+func (h *recordingHandler) HandleEscrowDepositChallenged(_ context.Context, ev *core.EscrowDepositChallengedEvent) error {
+	return h.record("escrow_deposit_challenged", ev.ChannelID, ev.StateVersion)
+}
+
+func (h *recordingHandler) HandleEscrowDepositFinalized(_ context.Context, ev *core.EscrowDepositFinalizedEvent) error {
+	return h.record("escrow_deposit_finalized", ev.ChannelID, ev.StateVersion)
+}
+
+func (h *recordingHandler) HandleEscrowDepositsPurged(_ context.Context, ev *core.EscrowDepositsPurgedEvent) error {
+	return h.record("escrow_deposits_purged", "", ev.PurgedCount)
+}
+
+func (h *recordingHandler) HandleEscrowWithdrawalInitiated(_ context.Context, ev *core.EscrowWithdrawalInitiatedEvent) error {
+	return h.record("escrow_withdrawal_initiated", ev.ChannelID, ev.StateVersion)
+}
+
+func (h *recordingHandler) HandleEscrowWithdrawalChallenged(_ context.Context, ev *core.EscrowWithdrawalChallengedEvent) error {
+	return h.record("escrow_withdrawal_challenged", ev.ChannelID, ev.StateVersion)
+}
+
+func (h *recordingHandler) HandleEscrowWithdrawalFinalized(_ context.Context, ev *core.EscrowWithdrawalFinalizedEvent) error {
+	return h.record("escrow_withdrawal_finalized", ev.ChannelID, ev.StateVersion)
+}
+
+func (h *recordingHandler) HandleVaultDeposited(_ context.Context, ev *core.VaultDepositedEvent) error {
+	return h.record("vault_deposited", ev.Wallet, ev.Amount.BigInt().Uint64())
+}
+
+func (h *recordingHandler) HandleVaultWithdrawn(_ context.Context, ev *core.VaultWithdrawnEvent) error {
+	return h.record("vault_withdrawn", ev.Wallet, ev.Amount.BigInt().Uint64())
+}
+
+func (h *recordingHandler) HandleUserLockedBalanceUpdated(_ context.Context, ev *core.UserLockedBalanceUpdatedEvent) error {
+	return h.record("locked", ev.UserAddress, ev.Balance.BigInt().Uint64())
+}
+
+// eventStore records the processed events the way the node's database does.
+type eventStore struct {
+	mu     sync.Mutex
+	events []core.BlockchainEvent
+}
+
+func (s *eventStore) StoreContractEvent(ev core.BlockchainEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = append(s.events, ev)
+	return nil
+}
+
+func (s *eventStore) GetLatestEvent(contractAddress string, blockchainID uint64) (core.BlockchainEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	latest := core.BlockchainEvent{}
+	for _, ev := range s.events {
+		if ev.ContractAddress == contractAddress && ev.BlockchainID == blockchainID {
+			latest = ev
+		}
+	}
+	return latest, nil
+}
+
+func newTestMockBackend(t *testing.T) *MockBackend {
+	t.Helper()
+
+	backend, err := NewMockBackend(context.Background(), Config{
+		Blockchain: core.Blockchain{
+			ID:                     1,
+			Type:                   MockBackendType,
+			ChannelHubAddress:      mockChannelHubAddress,
+			LockingContractAddress: mockLockingAddress,
+		},
+		NodeAddress: "node",
+	})
+	require.NoError(t, err)
+	return backend.(*MockBackend)
+}
+
+func testState(channelID string, version uint64, transitionType core.TransitionType) core.State {
+	return core.State{
+		Transition:    core.Transition{Type: transitionType},
+		UserWallet:    "user",
+		Version:       version,
+		HomeChannelID: &channelID,
+		HomeLedger:    core.Ledger{BlockchainID: 1},
+	}
+}
+
+func listenChannelHub(t *testing.T, ctx context.Context, backend *MockBackend, store *eventStore) *recordingHandler {
+	t.Helper()
+
+	handler := &recordingHandler{}
+	err := backend.ListenChannelHub(ctx, handler, ListenerConfig{
+		StoreContractEvent: store.StoreContractEvent,
+		GetLatestEvent:     store.GetLatestEvent,
+	}, func(err error) {
+		assert.NoError(t, err)
+	})
+	require.NoError(t, err)
+	return handler
+}
+
+func TestMockBackend_HomeChannelLifecycle(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	backend := newTestMockBackend(t)
+	now := time.Unix(1_000_000, 0)
+	backend.SetNow(func() time.Time { return now })
+
+	store := &eventStore{}
+	handler := listenChannelHub(t, ctx, backend, store)
+
+	client, err := backend.ChannelHubClient()
+	require.NoError(t, err)
+
+	def := core.ChannelDefinition{Nonce: 1, Challenge: 3600}
+	createTx, err := client.Create(ctx, def, testState("0xch", 1, core.TransitionTypeHomeDeposit))
+	require.NoError(t, err)
+
+	_, err = client.Create(ctx, def, testState("0xch", 1, core.TransitionTypeHomeDeposit))
+	require.EqualError(t, err, "channel 0xch already exists")
+
+	_, err = client.Checkpoint(ctx, testState("0xch", 2, core.TransitionTypeTransferSend))
+	require.NoError(t, err)
+
+	_, err = client.Checkpoint(ctx, testState("0xch", 2, core.TransitionTypeTransferSend))
+	require.EqualError(t, err, "candidate version 2 is not newer than the on-chain version 2")
+
+	channelIDs, err := client.GetOpenChannels(ctx, "user")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0xch"}, channelIDs)
+
+	_, err = client.Challenge(ctx, testState("0xch", 2, core.TransitionTypeTransferSend), nil, core.ChannelParticipantUser)
+	require.NoError(t, err)
+
+	data, err := client.GetHomeChannelData(ctx, "0xch")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1_000_000+3600), data.ChallengeExpiry)
+
+	_, err = client.Close(ctx, testState("0xch", 3, core.TransitionTypeTransferSend))
+	require.EqualError(t, err, "unsupported intent for close: 0")
+
+	// Once the challenge expired, the channel closes with the challenged state
+	now = now.Add(2 * time.Hour)
+	_, err = client.Close(ctx, testState("0xch", 3, core.TransitionTypeTransferSend))
+	require.NoError(t, err)
+
+	channelIDs, err = client.GetOpenChannels(ctx, "user")
+	require.NoError(t, err)
+	assert.Empty(t, channelIDs)
+
+	expected := []string{"created:0xch:1", "checkpointed:0xch:2", "challenged:0xch:2", "closed:0xch:2"}
+	require.Eventually(t, func() bool { return len(handler.recorded()) == len(expected) }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, expected, handler.recorded())
+
+	// A new listener resumes after the last recorded event
+	resumed := listenChannelHub(t, ctx, backend, store)
+	_, err = client.Create(ctx, def, testState("0xch2", 1, core.TransitionTypeHomeDeposit))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return len(resumed.recorded()) == 1 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"created:0xch2:1"}, resumed.recorded())
+
+	result, err := backend.TxTracker().Check(ctx, createTx)
+	require.NoError(t, err)
+	assert.Equal(t, TxStatusConfirmed, result.Status)
+	assert.Equal(t, uint64(1), result.BlockNumber)
+	assert.Equal(t, uint64(5), result.Confirmations)
+
+	_, err = backend.TxTracker().Check(ctx, "0xunknown")
+	require.EqualError(t, err, "transaction 0xunknown not found")
+}
+
+func TestMockBackend_Escrow(t *testing.T) {
+	ctx := context.Background()
+	backend := newTestMockBackend(t)
+	client, err := backend.ChannelHubClient()
+	require.NoError(t, err)
+
+	// The home channel lives on another blockchain
+	escrowID := "0xescrow"
+	initState := testState("0xch", 2, core.TransitionTypeMutualLock)
+	initState.HomeLedger.BlockchainID = 2
+	initState.EscrowChannelID = &escrowID
+
+	_, err = client.InitiateEscrowWithdrawal(ctx, core.ChannelDefinition{}, initState)
+	require.EqualError(t, err, "unsupported intent for initiating an escrow: 4")
+
+	_, err = client.InitiateEscrowDeposit(ctx, core.ChannelDefinition{}, initState)
+	require.NoError(t, err)
+
+	finalState := testState("0xch", 3, core.TransitionTypeEscrowDeposit)
+	finalState.HomeLedger.BlockchainID = 2
+	finalState.EscrowChannelID = &escrowID
+	_, err = client.FinalizeEscrowDeposit(ctx, finalState)
+	require.NoError(t, err)
+
+	_, err = client.FinalizeEscrowDeposit(ctx, finalState)
+	require.EqualError(t, err, "escrow 0xescrow is finalized")
+
+	data, err := client.GetEscrowDepositData(ctx, escrowID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), data.LastState.Version)
+}
+
+func TestMockBackend_EscrowDepositOnHome(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	backend := newTestMockBackend(t)
+	handler := listenChannelHub(t, ctx, backend, &eventStore{})
+	client, err := backend.ChannelHubClient()
+	require.NoError(t, err)
+
+	def := core.ChannelDefinition{Nonce: 1, Challenge: 3600}
+	_, err = client.Create(ctx, def, testState("0xch", 1, core.TransitionTypeHomeDeposit))
+	require.NoError(t, err)
+
+	escrowID := "0xescrow"
+	initState := testState("0xch", 2, core.TransitionTypeMutualLock)
+	initState.EscrowChannelID = &escrowID
+	_, err = client.InitiateEscrowDeposit(ctx, def, initState)
+	require.NoError(t, err)
+
+	finalState := testState("0xch", 3, core.TransitionTypeEscrowDeposit)
+	finalState.EscrowChannelID = &escrowID
+	_, err = client.FinalizeEscrowDeposit(ctx, finalState)
+	require.NoError(t, err)
+
+	_, err = client.FinalizeEscrowDeposit(ctx, finalState)
+	require.EqualError(t, err, "candidate version 3 is not newer than the on-chain version 3")
+
+	// No escrow is recorded on the home chain
+	_, err = client.GetEscrowDepositData(ctx, escrowID)
+	require.EqualError(t, err, "escrow 0xescrow not found")
+
+	data, err := client.GetHomeChannelData(ctx, "0xch")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), data.LastState.Version)
+
+	expected := []string{"created:0xch:1", "checkpointed:0xch:2", "checkpointed:0xch:3"}
+	require.Eventually(t, func() bool { return len(handler.recorded()) == len(expected) }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, expected, handler.recorded())
+}
+
+func TestMockBackend_Vault(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	backend := newTestMockBackend(t)
+	client, err := backend.ChannelHubClient()
+	require.NoError(t, err)
+
+	store := &eventStore{}
+	handler := &recordingHandler{}
+	err = backend.ListenChannelHub(ctx, handler, ListenerConfig{
+		StoreContractEvent: store.StoreContractEvent,
+		GetLatestEvent:     store.GetLatestEvent,
+	}, func(error) {})
+	require.NoError(t, err)
+
+	_, err = client.Deposit(ctx, "node", "token", decimal.NewFromInt(10))
+	require.NoError(t, err)
+	_, err = client.Withdraw(ctx, "node", "token", decimal.NewFromInt(4))
+	require.NoError(t, err)
+	_, err = client.Withdraw(ctx, "node", "token", decimal.NewFromInt(7))
+	require.EqualError(t, err, "insufficient vault balance: 6 < 7")
+
+	balance, err := client.GetNodeBalance(ctx, "token")
+	require.NoError(t, err)
+	assert.True(t, balance.Equal(decimal.NewFromInt(6)))
+
+	require.Eventually(t, func() bool { return len(handler.recorded()) == 2 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"vault_deposited:node:10", "vault_withdrawn:node:4"}, handler.recorded())
+}
+
+func TestMockBackend_ListenLocking(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	backend := newTestMockBackend(t)
+	store := &eventStore{}
+
+	handler := &recordingHandler{}
+	closed := make(chan error, 1)
+	err := backend.ListenLocking(ctx, handler, ListenerConfig{
+		StoreContractEvent: store.StoreContractEvent,
+		GetLatestEvent:     store.GetLatestEvent,
+	}, func(err error) { closed <- err })
+	require.NoError(t, err)
+
+	backend.SetLockedBalance("user", decimal.NewFromInt(5))
+	require.Eventually(t, func() bool { return len(handler.recorded()) == 1 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"locked:user:5"}, handler.recorded())
+
+	cancel()
+	select {
+	case err := <-closed:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("listener did not stop")
+	}
+}
@@ -0,0 +1,31 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/shopspring/decimal"
+)
+
+// NodeBalanceRouter reads the node's ChannelHub balances with the client of the blockchain they are held on.
+type NodeBalanceRouter struct {
+	clients map[uint64]core.BlockchainClientV2
+}
+
+// NewNodeBalanceRouter creates a NodeBalanceRouter over the ChannelHub clients of each blockchain.
+func NewNodeBalanceRouter(clients map[uint64]core.BlockchainClientV2) *NodeBalanceRouter {
+	return &NodeBalanceRouter{
+		clients: clients,
+	}
+}
+
+// GetNodeBalance returns the node's balance of the token in the ChannelHub of the blockchain,
+// which is the liquidity the node can lock in new escrows there.
+func (r *NodeBalanceRouter) GetNodeBalance(ctx context.Context, blockchainID uint64, token string) (decimal.Decimal, error) {
+	client, ok := r.clients[blockchainID]
+	if !ok {
+		return decimal.Zero, fmt.Errorf("no ChannelHub client for blockchain %d", blockchainID)
+	}
+	return client.GetNodeBalance(ctx, token)
+}
@@ -0,0 +1,29 @@
+package blockchain
+
+import (
+	"github.com/layer-3/nitrolite/pkg/core"
+)
+
+var _ core.StatePacker = &StatePackerRouter{}
+
+// StatePackerRouter packs states with the StatePacker of the blockchain of their home channel,
+// so that states are signed the way the home ChannelHub verifies them.
+type StatePackerRouter struct {
+	packers  map[uint64]core.StatePacker
+	fallback core.StatePacker
+}
+
+// NewStatePackerRouter creates a StatePackerRouter. States of blockchains without a packer are packed with fallback.
+func NewStatePackerRouter(packers map[uint64]core.StatePacker, fallback core.StatePacker) *StatePackerRouter {
+	return &StatePackerRouter{
+		packers:  packers,
+		fallback: fallback,
+	}
+}
+
+func (r *StatePackerRouter) PackState(state core.State) ([]byte, error) {
+	if packer, ok := r.packers[state.HomeLedger.BlockchainID]; ok {
+		return packer.PackState(state)
+	}
+	return r.fallback.PackState(state)
+}
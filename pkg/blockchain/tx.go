@@ -0,0 +1,55 @@
+package blockchain
+
+import "context"
+
+// TxStatus is the on-chain outcome of a transaction sent by the node.
+type TxStatus uint8
+
+const (
+	// TxStatusPending means the transaction is not included yet or doesn't have enough confirmations.
+	TxStatusPending TxStatus = iota
+	// TxStatusConfirmed means the transaction succeeded and has the required confirmations.
+	TxStatusConfirmed
+	// TxStatusReverted means the transaction was included, but reverted.
+	TxStatusReverted
+	// TxStatusDropped means the transaction will never be included: it was evicted from the mempool
+	// or its nonce was used by another transaction.
+	TxStatusDropped
+)
+
+func (s TxStatus) String() string {
+	switch s {
+	case TxStatusPending:
+		return "pending"
+	case TxStatusConfirmed:
+		return "confirmed"
+	case TxStatusReverted:
+		return "reverted"
+	case TxStatusDropped:
+		return "dropped"
+	default:
+		return "unknown"
+	}
+}
+
+// TxResult describes the current on-chain state of a transaction.
+type TxResult struct {
+	Status TxStatus
+	// TxHash is the hash of the included transaction, or of the latest broadcast one while pending.
+	// It differs from the checked hash when the transaction was replaced with bumped fees.
+	TxHash string
+	// Nonce is the nonce of the transaction, zero if the transaction is unknown to the tracker and the node.
+	Nonce uint64
+	// BlockNumber is the number of the block the transaction was included in, zero if not included.
+	BlockNumber uint64
+	// Confirmations is the number of blocks since inclusion, counting the including block.
+	Confirmations uint64
+}
+
+// TxTracker reports the on-chain outcome of the transactions sent by the node.
+type TxTracker interface {
+	Check(ctx context.Context, txHash string) (TxResult, error)
+	// Release marks the nonce of a dropped transaction as unused, so the next sent transaction
+	// takes it instead of leaving a nonce gap that blocks all later transactions.
+	Release(nonce uint64)
+}
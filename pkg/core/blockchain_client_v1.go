@@ -0,0 +1,147 @@
+package core
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+)
+
+// blockchainClientV1 adapts a BlockchainClientV2 to the BlockchainClient interface.
+type blockchainClientV1 struct {
+	client BlockchainClientV2
+}
+
+// NewBlockchainClientV1 returns a BlockchainClient making the calls of client with context.Background().
+func NewBlockchainClientV1(client BlockchainClientV2) BlockchainClient {
+	return blockchainClientV1{client: client}
+}
+
+func (c blockchainClientV1) GetAccountsBalances(accounts []string, tokens []string) ([][]decimal.Decimal, error) {
+	return c.client.GetAccountsBalances(context.Background(), accounts, tokens)
+}
+
+func (c blockchainClientV1) GetTokenBalance(asset string, walletAddress string) (decimal.Decimal, error) {
+	return c.client.GetTokenBalance(context.Background(), asset, walletAddress)
+}
+
+func (c blockchainClientV1) Approve(asset string, amount decimal.Decimal) (string, error) {
+	return c.client.Approve(context.Background(), asset, amount)
+}
+
+func (c blockchainClientV1) GetNodeBalance(token string) (decimal.Decimal, error) {
+	return c.client.GetNodeBalance(context.Background(), token)
+}
+
+func (c blockchainClientV1) GetOpenChannels(user string) ([]string, error) {
+	return c.client.GetOpenChannels(context.Background(), user)
+}
+
+func (c blockchainClientV1) GetHomeChannelData(homeChannelID string) (HomeChannelDataResponse, error) {
+	return c.client.GetHomeChannelData(context.Background(), homeChannelID)
+}
+
+func (c blockchainClientV1) GetEscrowDepositData(escrowChannelID string) (EscrowDepositDataResponse, error) {
+	return c.client.GetEscrowDepositData(context.Background(), escrowChannelID)
+}
+
+func (c blockchainClientV1) GetEscrowWithdrawalData(escrowChannelID string) (EscrowWithdrawalDataResponse, error) {
+	return c.client.GetEscrowWithdrawalData(context.Background(), escrowChannelID)
+}
+
+func (c blockchainClientV1) Deposit(node, token string, amount decimal.Decimal) (string, error) {
+	return c.client.Deposit(context.Background(), node, token, amount)
+}
+
+func (c blockchainClientV1) Withdraw(node, token string, amount decimal.Decimal) (string, error) {
+	return c.client.Withdraw(context.Background(), node, token, amount)
+}
+
+func (c blockchainClientV1) EnsureSigValidatorRegistered(validatorID uint8, validatorAddress string, checkOnly bool) error {
+	return c.client.EnsureSigValidatorRegistered(context.Background(), validatorID, validatorAddress, checkOnly)
+}
+
+func (c blockchainClientV1) Create(def ChannelDefinition, initCCS State) (string, error) {
+	return c.client.Create(context.Background(), def, initCCS)
+}
+
+func (c blockchainClientV1) MigrateChannelHere(def ChannelDefinition, candidate State) (string, error) {
+	return c.client.MigrateChannelHere(context.Background(), def, candidate)
+}
+
+func (c blockchainClientV1) FinalizeMigration(candidate State) (string, error) {
+	return c.client.FinalizeMigration(context.Background(), candidate)
+}
+
+func (c blockchainClientV1) Checkpoint(candidate State) (string, error) {
+	return c.client.Checkpoint(context.Background(), candidate)
+}
+
+func (c blockchainClientV1) Challenge(candidate State, challengerSig []byte, challengerIdx ChannelParticipant) (string, error) {
+	return c.client.Challenge(context.Background(), candidate, challengerSig, challengerIdx)
+}
+
+func (c blockchainClientV1) Close(candidate State) (string, error) {
+	return c.client.Close(context.Background(), candidate)
+}
+
+func (c blockchainClientV1) InitiateEscrowDeposit(def ChannelDefinition, initCCS State) (string, error) {
+	return c.client.InitiateEscrowDeposit(context.Background(), def, initCCS)
+}
+
+func (c blockchainClientV1) ChallengeEscrowDeposit(candidate State, challengerSig []byte, challengerIdx ChannelParticipant) (string, error) {
+	return c.client.ChallengeEscrowDeposit(context.Background(), candidate, challengerSig, challengerIdx)
+}
+
+func (c blockchainClientV1) FinalizeEscrowDeposit(candidate State) (string, error) {
+	return c.client.FinalizeEscrowDeposit(context.Background(), candidate)
+}
+
+func (c blockchainClientV1) InitiateEscrowWithdrawal(def ChannelDefinition, initCCS State) (string, error) {
+	return c.client.InitiateEscrowWithdrawal(context.Background(), def, initCCS)
+}
+
+func (c blockchainClientV1) ChallengeEscrowWithdrawal(candidate State, challengerSig []byte, challengerIdx ChannelParticipant) (string, error) {
+	return c.client.ChallengeEscrowWithdrawal(context.Background(), candidate, challengerSig, challengerIdx)
+}
+
+func (c blockchainClientV1) FinalizeEscrowWithdrawal(candidate State) (string, error) {
+	return c.client.FinalizeEscrowWithdrawal(context.Background(), candidate)
+}
+
+// appRegistryClientV1 adapts an AppRegistryClientV2 to the AppRegistryClient interface.
+type appRegistryClientV1 struct {
+	client AppRegistryClientV2
+}
+
+// NewAppRegistryClientV1 returns an AppRegistryClient making the calls of client with context.Background().
+func NewAppRegistryClientV1(client AppRegistryClientV2) AppRegistryClient {
+	return appRegistryClientV1{client: client}
+}
+
+func (c appRegistryClientV1) ApproveToken(amount decimal.Decimal) (string, error) {
+	return c.client.ApproveToken(context.Background(), amount)
+}
+
+func (c appRegistryClientV1) GetBalance(user string) (decimal.Decimal, error) {
+	return c.client.GetBalance(context.Background(), user)
+}
+
+func (c appRegistryClientV1) GetTokenDecimals() (uint8, error) {
+	return c.client.GetTokenDecimals(context.Background())
+}
+
+func (c appRegistryClientV1) Lock(targetWallet string, amount decimal.Decimal) (string, error) {
+	return c.client.Lock(context.Background(), targetWallet, amount)
+}
+
+func (c appRegistryClientV1) Relock() (string, error) {
+	return c.client.Relock(context.Background())
+}
+
+func (c appRegistryClientV1) Unlock() (string, error) {
+	return c.client.Unlock(context.Background())
+}
+
+func (c appRegistryClientV1) Withdraw(destinationWallet string) (string, error) {
+	return c.client.Withdraw(context.Background(), destinationWallet)
+}
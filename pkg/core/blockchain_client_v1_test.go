@@ -0,0 +1,54 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// checkpointClientV2 records the checkpointed states. Calling any other method panics.
+type checkpointClientV2 struct {
+	BlockchainClientV2
+	candidates []State
+	ctxErr     error
+}
+
+func (c *checkpointClientV2) Checkpoint(ctx context.Context, candidate State) (string, error) {
+	c.candidates = append(c.candidates, candidate)
+	c.ctxErr = ctx.Err()
+	return "0xhash", nil
+}
+
+// lockingClientV2 returns fixed answers. Calling any other method panics.
+type lockingClientV2 struct {
+	AppRegistryClientV2
+}
+
+func (lockingClientV2) GetBalance(_ context.Context, user string) (decimal.Decimal, error) {
+	return decimal.NewFromInt(int64(len(user))), nil
+}
+
+func TestNewBlockchainClientV1(t *testing.T) {
+	t.Parallel()
+	clientV2 := &checkpointClientV2{}
+	client := NewBlockchainClientV1(clientV2)
+
+	candidate := State{ID: "0x1", Version: 3}
+	txHash, err := client.Checkpoint(candidate)
+	require.NoError(t, err)
+	assert.Equal(t, "0xhash", txHash)
+	assert.Equal(t, []State{candidate}, clientV2.candidates)
+	assert.NoError(t, clientV2.ctxErr)
+}
+
+func TestNewAppRegistryClientV1(t *testing.T) {
+	t.Parallel()
+	client := NewAppRegistryClientV1(lockingClientV2{})
+
+	balance, err := client.GetBalance("0xabc")
+	require.NoError(t, err)
+	assert.True(t, decimal.NewFromInt(5).Equal(balance))
+}
@@ -0,0 +1,122 @@
+package core
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/shopspring/decimal"
+)
+
+// ConditionalTransferStatus represents the lifecycle status of a conditional transfer
+type ConditionalTransferStatus uint8
+
+const (
+	ConditionalTransferStatusVoid ConditionalTransferStatus = iota
+	ConditionalTransferStatusLocked
+	ConditionalTransferStatusClaimed
+	ConditionalTransferStatusRefunded
+)
+
+// String returns the human-readable name of the conditional transfer status
+func (s ConditionalTransferStatus) String() string {
+	switch s {
+	case ConditionalTransferStatusLocked:
+		return "locked"
+	case ConditionalTransferStatusClaimed:
+		return "claimed"
+	case ConditionalTransferStatusRefunded:
+		return "refunded"
+	default:
+		return "void"
+	}
+}
+
+// ConditionalTransfer represents a hash-time-locked transfer between two users.
+// The locked funds are credited to the receiver when the preimage of the hash lock is revealed
+// before the expiry, and are returned to the sender once the expiry passes.
+type ConditionalTransfer struct {
+	ID          string                    `json:"id"`                 // Deterministic ID, used as the AccountID of the conditional transitions
+	Sender      string                    `json:"sender"`             // Wallet that locked the funds
+	Receiver    string                    `json:"receiver"`           // Wallet that can claim the funds
+	Asset       string                    `json:"asset"`              // Asset symbol
+	Amount      decimal.Decimal           `json:"amount"`             // Locked amount
+	HashLock    string                    `json:"hash_lock"`          // SHA-256 hash of the preimage
+	ExpiresAt   time.Time                 `json:"expires_at"`         // Time after which the funds can no longer be claimed and are refunded
+	Status      ConditionalTransferStatus `json:"status"`             // Current status
+	Preimage    *string                   `json:"preimage,omitempty"` // Revealed preimage, set once claimed
+	LockStateID string                    `json:"lock_state_id"`      // ID of the sender state with the conditional_lock transition
+	CreatedAt   time.Time                 `json:"created_at"`         // When the transfer was locked
+	UpdatedAt   time.Time                 `json:"updated_at"`         // When the transfer was last updated
+}
+
+// IsExpired reports whether the conditional transfer can no longer be claimed at the given time.
+func (t ConditionalTransfer) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// GetConditionalTransferID derives the ID of a conditional transfer from the sender's lock state ID
+// and the terms of the transfer, so that the user's signature over the lock state also covers the terms.
+// It computes keccak256(abi.encode(lockStateID, receiver, hashLock, expiresAt)).
+func GetConditionalTransferID(lockStateID, receiver, hashLock string, expiresAt uint64) (string, error) {
+	args := abi.Arguments{
+		{Type: abi.Type{T: abi.FixedBytesTy, Size: 32}}, // lockStateID
+		{Type: abi.Type{T: abi.AddressTy}},              // receiver
+		{Type: abi.Type{T: abi.FixedBytesTy, Size: 32}}, // hashLock
+		{Type: uint64Type},                              // expiresAt
+	}
+
+	packed, err := args.Pack(
+		common.HexToHash(lockStateID),
+		common.HexToAddress(receiver),
+		common.HexToHash(hashLock),
+		expiresAt,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to pack conditional transfer ID arguments: %w", err)
+	}
+
+	return crypto.Keccak256Hash(packed).Hex(), nil
+}
+
+// GetHashLock returns the hex-encoded SHA-256 hash of the hex-encoded preimage.
+func GetHashLock(preimage string) (string, error) {
+	preimageBytes, err := hexutil.Decode(preimage)
+	if err != nil {
+		return "", fmt.Errorf("invalid preimage: %w", err)
+	}
+	if len(preimageBytes) != 32 {
+		return "", fmt.Errorf("invalid preimage length: expected 32 bytes, got %d", len(preimageBytes))
+	}
+
+	hash := sha256.Sum256(preimageBytes)
+	return hexutil.Encode(hash[:]), nil
+}
+
+// ValidateHashLock checks that the hash lock is a hex-encoded 32-byte hash.
+func ValidateHashLock(hashLock string) error {
+	hashLockBytes, err := hexutil.Decode(hashLock)
+	if err != nil {
+		return fmt.Errorf("invalid hash lock: %w", err)
+	}
+	if len(hashLockBytes) != 32 {
+		return fmt.Errorf("invalid hash lock length: expected 32 bytes, got %d", len(hashLockBytes))
+	}
+	return nil
+}
+
+// VerifyPreimage checks that the preimage hashes to the hash lock of the conditional transfer.
+func (t ConditionalTransfer) VerifyPreimage(preimage string) error {
+	hashLock, err := GetHashLock(preimage)
+	if err != nil {
+		return err
+	}
+	if common.HexToHash(hashLock) != common.HexToHash(t.HashLock) {
+		return fmt.Errorf("preimage does not match hash lock")
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPreimage = "0x0101010101010101010101010101010101010101010101010101010101010101"
+
+func TestGetHashLock(t *testing.T) {
+	t.Parallel()
+
+	hashLock, err := GetHashLock(testPreimage)
+	require.NoError(t, err)
+	assert.NoError(t, ValidateHashLock(hashLock))
+	assert.Equal(t, "0x72cd6e8422c407fb6d098690f1130b7ded7ec2f7f5e1d30bd9d521f015363793", hashLock)
+
+	_, err = GetHashLock("0x0102")
+	assert.ErrorContains(t, err, "invalid preimage length")
+
+	_, err = GetHashLock("not-hex")
+	assert.ErrorContains(t, err, "invalid preimage")
+}
+
+func TestValidateHashLock(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, ValidateHashLock("0x72cd6e8422c407fb6d098690f1130b7ded7ec2f7f5e1d30bd9d521f015363793"))
+	assert.ErrorContains(t, ValidateHashLock("0x72cd"), "invalid hash lock length")
+	assert.ErrorContains(t, ValidateHashLock("72cd"), "invalid hash lock")
+}
+
+func TestConditionalTransfer_VerifyPreimage(t *testing.T) {
+	t.Parallel()
+
+	hashLock, err := GetHashLock(testPreimage)
+	require.NoError(t, err)
+	transfer := ConditionalTransfer{HashLock: hashLock}
+
+	assert.NoError(t, transfer.VerifyPreimage(testPreimage))
+	assert.ErrorContains(t, transfer.VerifyPreimage("0x0202020202020202020202020202020202020202020202020202020202020202"), "preimage does not match hash lock")
+}
+
+func TestConditionalTransfer_IsExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	transfer := ConditionalTransfer{ExpiresAt: now}
+
+	assert.False(t, transfer.IsExpired(now.Add(-time.Second)))
+	assert.True(t, transfer.IsExpired(now))
+	assert.True(t, transfer.IsExpired(now.Add(time.Second)))
+}
+
+func TestGetConditionalTransferID(t *testing.T) {
+	t.Parallel()
+
+	lockStateID := GetStateID("0x1111111111111111111111111111111111111111", "usdc", 1, 2)
+	receiver := "0x2222222222222222222222222222222222222222"
+	hashLock, err := GetHashLock(testPreimage)
+	require.NoError(t, err)
+
+	id, err := GetConditionalTransferID(lockStateID, receiver, hashLock, 1700000000)
+	require.NoError(t, err)
+	assert.Len(t, id, 66)
+
+	sameID, err := GetConditionalTransferID(lockStateID, receiver, hashLock, 1700000000)
+	require.NoError(t, err)
+	assert.Equal(t, id, sameID)
+
+	otherExpiry, err := GetConditionalTransferID(lockStateID, receiver, hashLock, 1700000001)
+	require.NoError(t, err)
+	assert.NotEqual(t, id, otherExpiry)
+
+	otherReceiver, err := GetConditionalTransferID(lockStateID, "0x3333333333333333333333333333333333333333", hashLock, 1700000000)
+	require.NoError(t, err)
+	assert.NotEqual(t, id, otherReceiver)
+}
+
+func TestConditionalTransferStatus_String(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "locked", ConditionalTransferStatusLocked.String())
+	assert.Equal(t, "claimed", ConditionalTransferStatusClaimed.String())
+	assert.Equal(t, "refunded", ConditionalTransferStatusRefunded.String())
+	assert.Equal(t, "void", ConditionalTransferStatusVoid.String())
+}
@@ -1,15 +1,37 @@
 package core
 
-import "github.com/shopspring/decimal"
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+)
 
 // On-chain events
 
 // HomeChannelCreatedEvent represents the ChannelCreated event
 type HomeChannelCreatedEvent channelEvent
 
-// HomeChannelMigratedEvent represents the ChannelMigrated event
+// HomeChannelMigratedEvent represents the MigrationInInitiated event,
+// emitted on the blockchain the home channel moves to
 type HomeChannelMigratedEvent channelEvent
 
+// HomeChannelMigrationInFinalizedEvent represents the MigrationInFinalized event,
+// emitted on the blockchain the home channel has moved to
+type HomeChannelMigrationInFinalizedEvent struct {
+	ChannelID    string `json:"channel_id"`
+	StateVersion uint64 `json:"state_version"`
+	BlockchainID uint64 `json:"blockchain_id"` // The new home blockchain
+	TokenAddress string `json:"token_address"` // The token of the channel on the new home blockchain
+}
+
+// HomeChannelMigrationOutInitiatedEvent represents the MigrationOutInitiated event,
+// emitted on the blockchain the home channel leaves
+type HomeChannelMigrationOutInitiatedEvent channelEvent
+
+// HomeChannelMigrationOutFinalizedEvent represents the MigrationOutFinalized event,
+// emitted on the blockchain the home channel leaves
+type HomeChannelMigrationOutFinalizedEvent channelEvent
+
 // HomeChannelCheckpointedEvent represents the Checkpointed event
 type HomeChannelCheckpointedEvent channelEvent
 
@@ -37,6 +59,19 @@ type EscrowWithdrawalChallengedEvent channelChallengedEvent
 // EscrowWithdrawalFinalizedEvent represents the EscrowWithdrawalFinalized event
 type EscrowWithdrawalFinalizedEvent channelEvent
 
+// EscrowDepositsPurgedEvent represents the EscrowDepositsPurged event, emitted when the oldest
+// unchallenged escrow deposits of a blockchain are finalized after their unlock delay
+type EscrowDepositsPurgedEvent struct {
+	BlockchainID uint64 `json:"blockchain_id"`
+	PurgedCount  uint64 `json:"purged_count"`
+}
+
+// VaultDepositedEvent represents the Deposited event
+type VaultDepositedEvent vaultEvent
+
+// VaultWithdrawnEvent represents the Withdrawn event
+type VaultWithdrawnEvent vaultEvent
+
 type channelEvent struct {
 	ChannelID    string `json:"channel_id"`
 	StateVersion uint64 `json:"state_version"`
@@ -48,6 +83,13 @@ type channelChallengedEvent struct {
 	ChallengeExpiry uint64 `json:"challenge_expiry"`
 }
 
+type vaultEvent struct {
+	Wallet       string          `json:"wallet"`
+	BlockchainID uint64          `json:"blockchain_id"`
+	TokenAddress string          `json:"token_address"`
+	Amount       decimal.Decimal `json:"amount"`
+}
+
 type UserLockedBalanceUpdatedEvent struct {
 	UserAddress  string          `json:"user_address"`
 	BlockchainID uint64          `json:"blockchain_id"`
@@ -59,6 +101,44 @@ type BlockchainEvent struct {
 	BlockchainID    uint64 `json:"blockchain_id"`
 	Name            string `json:"name"`
 	BlockNumber     uint64 `json:"block_number"`
+	BlockHash       string `json:"block_hash"`
 	TransactionHash string `json:"transaction_hash"`
 	LogIndex        uint32 `json:"log_index"`
 }
+
+type blockchainEventContextKey struct{}
+
+// ContextWithBlockchainEvent returns a copy of ctx carrying the contract event being handled,
+// so that the changes its handler makes can be recorded as BlockchainEventEffects.
+func ContextWithBlockchainEvent(ctx context.Context, ev BlockchainEvent) context.Context {
+	return context.WithValue(ctx, blockchainEventContextKey{}, ev)
+}
+
+// BlockchainEventFromContext returns the contract event being handled, if ctx carries one.
+func BlockchainEventFromContext(ctx context.Context) (BlockchainEvent, bool) {
+	ev, ok := ctx.Value(blockchainEventContextKey{}).(BlockchainEvent)
+	return ev, ok
+}
+
+// BlockchainEventEffectType is the kind of change handling a contract event made to the node's data.
+type BlockchainEventEffectType uint8
+
+const (
+	// BlockchainEventEffectChannelUpdated is an update of a channel, Channel holds the channel before the update.
+	BlockchainEventEffectChannelUpdated BlockchainEventEffectType = 1
+	// BlockchainEventEffectVaultBalanceAdjusted is an adjustment of a vault balance by Amount.
+	BlockchainEventEffectVaultBalanceAdjusted BlockchainEventEffectType = 2
+	// BlockchainEventEffectUserStakedUpdated is an update of a user's staked amount, Amount holds the amount before the update.
+	BlockchainEventEffectUserStakedUpdated BlockchainEventEffectType = 3
+)
+
+// BlockchainEventEffect is a change handling a contract event made to the node's data.
+// Effects are recorded with the event, so that they can be reverted if a chain reorganization removes it.
+type BlockchainEventEffect struct {
+	Type         BlockchainEventEffectType `json:"type"`
+	Channel      *Channel                  `json:"channel,omitempty"`
+	Wallet       string                    `json:"wallet,omitempty"`
+	BlockchainID uint64                    `json:"blockchain_id,omitempty"`
+	TokenAddress string                    `json:"token_address,omitempty"`
+	Amount       decimal.Decimal           `json:"amount"`
+}
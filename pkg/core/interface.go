@@ -8,8 +8,10 @@ import (
 
 // ========= Client Interface =========
 
-// Client defines the interface for interacting with the ChannelsHub smart contract
-// TODO: add context to all methods
+// BlockchainClient defines the interface for interacting with the ChannelsHub smart contract.
+//
+// Deprecated: use BlockchainClientV2, which lets callers cancel and time out chain calls.
+// NewBlockchainClientV1 adapts a BlockchainClientV2 for existing callers.
 type BlockchainClient interface {
 	// Getters - IVault
 	GetAccountsBalances(accounts []string, tokens []string) ([][]decimal.Decimal, error)
@@ -35,6 +37,7 @@ type BlockchainClient interface {
 	// Channel lifecycle
 	Create(def ChannelDefinition, initCCS State) (string, error)
 	MigrateChannelHere(def ChannelDefinition, candidate State) (string, error)
+	FinalizeMigration(candidate State) (string, error)
 	Checkpoint(candidate State) (string, error)
 	Challenge(candidate State, challengerSig []byte, challengerIdx ChannelParticipant) (string, error)
 	Close(candidate State) (string, error)
@@ -50,8 +53,53 @@ type BlockchainClient interface {
 	FinalizeEscrowWithdrawal(candidate State) (string, error)
 }
 
+// BlockchainClientV2 defines the interface for interacting with the ChannelsHub smart contract.
+// Every call is bound to the given context: its deadline and cancellation apply to the RPC requests made,
+// and calls are safe for concurrent use.
+type BlockchainClientV2 interface {
+	// Getters - IVault
+	GetAccountsBalances(ctx context.Context, accounts []string, tokens []string) ([][]decimal.Decimal, error)
+
+	// Getters - Token Balance & Approval
+	GetTokenBalance(ctx context.Context, asset string, walletAddress string) (decimal.Decimal, error)
+	Approve(ctx context.Context, asset string, amount decimal.Decimal) (string, error)
+
+	// Getters - ChannelsHub
+	GetNodeBalance(ctx context.Context, token string) (decimal.Decimal, error)
+	GetOpenChannels(ctx context.Context, user string) ([]string, error)
+	GetHomeChannelData(ctx context.Context, homeChannelID string) (HomeChannelDataResponse, error)
+	GetEscrowDepositData(ctx context.Context, escrowChannelID string) (EscrowDepositDataResponse, error)
+	GetEscrowWithdrawalData(ctx context.Context, escrowChannelID string) (EscrowWithdrawalDataResponse, error)
+
+	// IVault functions
+	Deposit(ctx context.Context, node, token string, amount decimal.Decimal) (string, error)
+	Withdraw(ctx context.Context, node, token string, amount decimal.Decimal) (string, error)
+
+	// Node lifecycle
+	EnsureSigValidatorRegistered(ctx context.Context, validatorID uint8, validatorAddress string, checkOnly bool) error
+
+	// Channel lifecycle
+	Create(ctx context.Context, def ChannelDefinition, initCCS State) (string, error)
+	MigrateChannelHere(ctx context.Context, def ChannelDefinition, candidate State) (string, error)
+	FinalizeMigration(ctx context.Context, candidate State) (string, error)
+	Checkpoint(ctx context.Context, candidate State) (string, error)
+	Challenge(ctx context.Context, candidate State, challengerSig []byte, challengerIdx ChannelParticipant) (string, error)
+	Close(ctx context.Context, candidate State) (string, error)
+
+	// Escrow deposit
+	InitiateEscrowDeposit(ctx context.Context, def ChannelDefinition, initCCS State) (string, error)
+	ChallengeEscrowDeposit(ctx context.Context, candidate State, challengerSig []byte, challengerIdx ChannelParticipant) (string, error)
+	FinalizeEscrowDeposit(ctx context.Context, candidate State) (string, error)
+
+	// Escrow withdrawal
+	InitiateEscrowWithdrawal(ctx context.Context, def ChannelDefinition, initCCS State) (string, error)
+	ChallengeEscrowWithdrawal(ctx context.Context, candidate State, challengerSig []byte, challengerIdx ChannelParticipant) (string, error)
+	FinalizeEscrowWithdrawal(ctx context.Context, candidate State) (string, error)
+}
+
 // ========= AppRegistryClient Interface =========
 
+// Deprecated: use AppRegistryClientV2. NewAppRegistryClientV1 adapts an AppRegistryClientV2 for existing callers.
 type AppRegistryClient interface {
 	ApproveToken(amount decimal.Decimal) (string, error)
 	GetBalance(user string) (decimal.Decimal, error)
@@ -63,6 +111,18 @@ type AppRegistryClient interface {
 	Withdraw(destinationWallet string) (string, error)
 }
 
+// AppRegistryClientV2 is the context-aware interface of the app registry (Locking) contract.
+type AppRegistryClientV2 interface {
+	ApproveToken(ctx context.Context, amount decimal.Decimal) (string, error)
+	GetBalance(ctx context.Context, user string) (decimal.Decimal, error)
+	GetTokenDecimals(ctx context.Context) (uint8, error)
+
+	Lock(ctx context.Context, targetWallet string, amount decimal.Decimal) (string, error)
+	Relock(ctx context.Context) (string, error)
+	Unlock(ctx context.Context) (string, error)
+	Withdraw(ctx context.Context, destinationWallet string) (string, error)
+}
+
 // ========= TransitionValidator Interface =========
 
 // StateAdvancer applies state transitions
@@ -91,15 +151,21 @@ type AssetStore interface {
 type ChannelHubEventHandler interface {
 	HandleHomeChannelCreated(context.Context, *HomeChannelCreatedEvent) error
 	HandleHomeChannelMigrated(context.Context, *HomeChannelMigratedEvent) error
+	HandleHomeChannelMigrationInFinalized(context.Context, *HomeChannelMigrationInFinalizedEvent) error
+	HandleHomeChannelMigrationOutInitiated(context.Context, *HomeChannelMigrationOutInitiatedEvent) error
+	HandleHomeChannelMigrationOutFinalized(context.Context, *HomeChannelMigrationOutFinalizedEvent) error
 	HandleHomeChannelCheckpointed(context.Context, *HomeChannelCheckpointedEvent) error
 	HandleHomeChannelChallenged(context.Context, *HomeChannelChallengedEvent) error
 	HandleHomeChannelClosed(context.Context, *HomeChannelClosedEvent) error
 	HandleEscrowDepositInitiated(context.Context, *EscrowDepositInitiatedEvent) error
 	HandleEscrowDepositChallenged(context.Context, *EscrowDepositChallengedEvent) error
 	HandleEscrowDepositFinalized(context.Context, *EscrowDepositFinalizedEvent) error
+	HandleEscrowDepositsPurged(context.Context, *EscrowDepositsPurgedEvent) error
 	HandleEscrowWithdrawalInitiated(context.Context, *EscrowWithdrawalInitiatedEvent) error
 	HandleEscrowWithdrawalChallenged(context.Context, *EscrowWithdrawalChallengedEvent) error
 	HandleEscrowWithdrawalFinalized(context.Context, *EscrowWithdrawalFinalizedEvent) error
+	HandleVaultDeposited(context.Context, *VaultDepositedEvent) error
+	HandleVaultWithdrawn(context.Context, *VaultWithdrawnEvent) error
 }
 
 type LockingContractEventHandler interface {
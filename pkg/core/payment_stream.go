@@ -0,0 +1,146 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentStreamStatus represents the lifecycle status of a payment stream
+type PaymentStreamStatus uint8
+
+const (
+	PaymentStreamStatusVoid PaymentStreamStatus = iota
+	PaymentStreamStatusActive
+	PaymentStreamStatusCancelled
+	PaymentStreamStatusCompleted
+)
+
+// String returns the human-readable name of the payment stream status
+func (s PaymentStreamStatus) String() string {
+	switch s {
+	case PaymentStreamStatusActive:
+		return "active"
+	case PaymentStreamStatusCancelled:
+		return "cancelled"
+	case PaymentStreamStatusCompleted:
+		return "completed"
+	default:
+		return "void"
+	}
+}
+
+// PaymentStreamAuthorization is the sender-signed authorization of a payment stream.
+// It allows the Node to transfer up to Cap of the asset to the recipient,
+// accruing Rate per second until ExpiresAt.
+type PaymentStreamAuthorization struct {
+	Sender    string          `json:"sender"`     // Wallet paying the stream
+	Recipient string          `json:"recipient"`  // Wallet receiving the stream
+	Asset     string          `json:"asset"`      // Asset symbol
+	Rate      decimal.Decimal `json:"rate"`       // Amount accrued per second
+	Cap       decimal.Decimal `json:"cap"`        // Maximum total amount transferred by the stream
+	ExpiresAt uint64          `json:"expires_at"` // Unix timestamp (in seconds) after which the stream stops accruing
+	Nonce     uint64          `json:"nonce"`      // Nonce distinguishing otherwise identical authorizations
+}
+
+// PaymentStream represents a payment stream settled by the Node as regular transfers.
+type PaymentStream struct {
+	ID            string                     `json:"id"`            // Deterministic ID derived from the authorization
+	Authorization PaymentStreamAuthorization `json:"authorization"` // Sender-signed authorization
+	SenderSig     string                     `json:"sender_sig"`    // Sender's signature over the authorization
+	Status        PaymentStreamStatus        `json:"status"`        // Current status
+	StartsAt      time.Time                  `json:"starts_at"`     // Time the stream started accruing
+	EndsAt        time.Time                  `json:"ends_at"`       // Time the stream stops accruing (expiry or cancellation)
+	Settled       decimal.Decimal            `json:"settled"`       // Total amount transferred so far
+	SettledUntil  time.Time                  `json:"settled_until"` // Time up to which the accrued amount has been settled
+	CreatedAt     time.Time                  `json:"created_at"`    // When the stream was created
+	UpdatedAt     time.Time                  `json:"updated_at"`    // When the stream was last updated
+}
+
+// AccruedAt returns the total amount accrued by the stream at the given time,
+// truncated to the given number of decimals and bounded by the cap.
+func (s PaymentStream) AccruedAt(t time.Time, decimals uint8) decimal.Decimal {
+	if t.After(s.EndsAt) {
+		t = s.EndsAt
+	}
+	if !t.After(s.StartsAt) {
+		return decimal.Zero
+	}
+
+	seconds := decimal.NewFromInt(t.Sub(s.StartsAt).Milliseconds()).Div(decimal.NewFromInt(1000))
+	accrued := s.Authorization.Rate.Mul(seconds).Truncate(int32(decimals))
+	if accrued.GreaterThan(s.Authorization.Cap) {
+		return s.Authorization.Cap
+	}
+	return accrued
+}
+
+// IsFinished reports whether the stream has nothing left to settle after the given time:
+// it either reached its cap or its end.
+func (s PaymentStream) IsFinished(t time.Time) bool {
+	return s.Settled.GreaterThanOrEqual(s.Authorization.Cap) || !t.Before(s.EndsAt)
+}
+
+// PackPaymentStreamAuthorization packs the payment stream authorization for signing using ABI encoding.
+func PackPaymentStreamAuthorization(auth PaymentStreamAuthorization) ([]byte, error) {
+	if !common.IsHexAddress(auth.Sender) {
+		return nil, fmt.Errorf("invalid sender address: %s", auth.Sender)
+	}
+	if !common.IsHexAddress(auth.Recipient) {
+		return nil, fmt.Errorf("invalid recipient address: %s", auth.Recipient)
+	}
+
+	args := abi.Arguments{
+		{Type: abi.Type{T: abi.AddressTy}}, // sender
+		{Type: abi.Type{T: abi.AddressTy}}, // recipient
+		{Type: abi.Type{T: abi.StringTy}},  // asset
+		{Type: abi.Type{T: abi.StringTy}},  // rate
+		{Type: abi.Type{T: abi.StringTy}},  // cap
+		{Type: uint64Type},                 // expiresAt
+		{Type: uint64Type},                 // nonce
+	}
+
+	packed, err := args.Pack(
+		common.HexToAddress(auth.Sender),
+		common.HexToAddress(auth.Recipient),
+		auth.Asset,
+		auth.Rate.String(),
+		auth.Cap.String(),
+		auth.ExpiresAt,
+		auth.Nonce,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack payment stream authorization: %w", err)
+	}
+
+	return crypto.Keccak256(packed), nil
+}
+
+// GetPaymentStreamID returns the deterministic ID of the payment stream with the given authorization.
+func GetPaymentStreamID(auth PaymentStreamAuthorization) (string, error) {
+	packed, err := PackPaymentStreamAuthorization(auth)
+	if err != nil {
+		return "", err
+	}
+	return common.BytesToHash(packed).Hex(), nil
+}
+
+// PackPaymentStreamCancellation packs the cancellation of a payment stream for signing using ABI encoding.
+// Either the sender or the recipient of the stream may sign it.
+func PackPaymentStreamCancellation(streamID string) ([]byte, error) {
+	args := abi.Arguments{
+		{Type: abi.Type{T: abi.StringTy}},               // action
+		{Type: abi.Type{T: abi.FixedBytesTy, Size: 32}}, // streamID
+	}
+
+	packed, err := args.Pack("cancel_payment_stream", common.HexToHash(streamID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack payment stream cancellation: %w", err)
+	}
+
+	return crypto.Keccak256(packed), nil
+}
@@ -0,0 +1,109 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPaymentStreamAuthorization() PaymentStreamAuthorization {
+	return PaymentStreamAuthorization{
+		Sender:    "0x1111111111111111111111111111111111111111",
+		Recipient: "0x2222222222222222222222222222222222222222",
+		Asset:     "usdc",
+		Rate:      decimal.RequireFromString("0.5"),
+		Cap:       decimal.NewFromInt(100),
+		ExpiresAt: 1700003600,
+		Nonce:     1,
+	}
+}
+
+func TestPaymentStream_AccruedAt(t *testing.T) {
+	t.Parallel()
+
+	start := time.Unix(1700000000, 0)
+	stream := PaymentStream{
+		Authorization: newTestPaymentStreamAuthorization(),
+		StartsAt:      start,
+		EndsAt:        start.Add(time.Hour),
+	}
+
+	assert.True(t, stream.AccruedAt(start.Add(-time.Second), 6).IsZero())
+	assert.True(t, stream.AccruedAt(start, 6).IsZero())
+	assert.Equal(t, "5", stream.AccruedAt(start.Add(10*time.Second), 6).String())
+	assert.Equal(t, "0.75", stream.AccruedAt(start.Add(1500*time.Millisecond), 6).String())
+	assert.Equal(t, "0.7", stream.AccruedAt(start.Add(1500*time.Millisecond), 1).String())
+
+	// Bounded by the cap
+	assert.Equal(t, "100", stream.AccruedAt(start.Add(10*time.Minute), 6).String())
+
+	// Bounded by the end
+	stream.Authorization.Cap = decimal.NewFromInt(1_000_000)
+	assert.Equal(t, "1800", stream.AccruedAt(start.Add(2*time.Hour), 6).String())
+}
+
+func TestPaymentStream_IsFinished(t *testing.T) {
+	t.Parallel()
+
+	start := time.Unix(1700000000, 0)
+	stream := PaymentStream{
+		Authorization: newTestPaymentStreamAuthorization(),
+		StartsAt:      start,
+		EndsAt:        start.Add(time.Hour),
+		Settled:       decimal.NewFromInt(10),
+	}
+
+	assert.False(t, stream.IsFinished(start.Add(time.Minute)))
+	assert.True(t, stream.IsFinished(start.Add(time.Hour)))
+
+	stream.Settled = decimal.NewFromInt(100)
+	assert.True(t, stream.IsFinished(start.Add(time.Minute)))
+}
+
+func TestGetPaymentStreamID(t *testing.T) {
+	t.Parallel()
+
+	auth := newTestPaymentStreamAuthorization()
+	id, err := GetPaymentStreamID(auth)
+	require.NoError(t, err)
+	assert.Len(t, id, 66)
+
+	sameID, err := GetPaymentStreamID(auth)
+	require.NoError(t, err)
+	assert.Equal(t, id, sameID)
+
+	auth.Nonce = 2
+	otherNonce, err := GetPaymentStreamID(auth)
+	require.NoError(t, err)
+	assert.NotEqual(t, id, otherNonce)
+
+	auth.Sender = "invalid"
+	_, err = GetPaymentStreamID(auth)
+	assert.ErrorContains(t, err, "invalid sender address")
+}
+
+func TestPackPaymentStreamCancellation(t *testing.T) {
+	t.Parallel()
+
+	id, err := GetPaymentStreamID(newTestPaymentStreamAuthorization())
+	require.NoError(t, err)
+
+	packed, err := PackPaymentStreamCancellation(id)
+	require.NoError(t, err)
+	assert.Len(t, packed, 32)
+
+	otherPacked, err := PackPaymentStreamCancellation("0x" + "ab" + id[4:])
+	require.NoError(t, err)
+	assert.NotEqual(t, packed, otherPacked)
+}
+
+func TestPaymentStreamStatus_String(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "active", PaymentStreamStatusActive.String())
+	assert.Equal(t, "cancelled", PaymentStreamStatusCancelled.String())
+	assert.Equal(t, "completed", PaymentStreamStatusCompleted.String())
+	assert.Equal(t, "void", PaymentStreamStatusVoid.String())
+}
@@ -91,8 +91,17 @@ func (v *StateAdvancerV1) ValidateAdvancement(currentState, proposedState State)
 		_, err = expectedState.ApplyHomeWithdrawalTransition(newTransition.Amount)
 	case TransitionTypeTransferSend:
 		_, err = expectedState.ApplyTransferSendTransition(newTransition.AccountID, newTransition.Amount)
+	case TransitionTypeSwapSend:
+		_, err = expectedState.ApplySwapSendTransition(newTransition.AccountID, newTransition.Amount)
 	case TransitionTypeCommit:
 		_, err = expectedState.ApplyCommitTransition(newTransition.AccountID, newTransition.Amount)
+	case TransitionTypeConditionalLock:
+		if !newTransition.Amount.IsPositive() {
+			return fmt.Errorf("conditional lock amount must be positive")
+		}
+		_, err = expectedState.ApplyConditionalLockTransition(newTransition.AccountID, newTransition.Amount)
+	case TransitionTypeConditionalClaim, TransitionTypeConditionalRefund:
+		return fmt.Errorf("%s transition can only be issued by the node", newTransition.Type.String())
 	case TransitionTypeMutualLock:
 		if proposedState.EscrowLedger == nil {
 			return fmt.Errorf("proposed state escrow ledger is nil")
@@ -128,7 +137,22 @@ func (v *StateAdvancerV1) ValidateAdvancement(currentState, proposedState State)
 			return fmt.Errorf("escrow withdraw transition must follow an escrow lock transition")
 		}
 	case TransitionTypeMigrate:
-		_, err = expectedState.ApplyMigrateTransition(newTransition.Amount)
+		if proposedState.EscrowLedger == nil {
+			return fmt.Errorf("proposed state escrow ledger is nil")
+		}
+		_, err = expectedState.ApplyMigrateTransition(
+			proposedState.EscrowLedger.BlockchainID,
+			proposedState.EscrowLedger.TokenAddress,
+			newTransition.Amount)
+	case TransitionTypeFinalizeMigration:
+		if lastTransition.Type == TransitionTypeMigrate {
+			if !lastTransition.Amount.Equal(newTransition.Amount) {
+				return fmt.Errorf("finalize migration amount must be the same as migrate amount")
+			}
+			_, err = expectedState.ApplyFinalizeMigrationTransition(newTransition.Amount)
+		} else {
+			return fmt.Errorf("finalize migration transition must follow a migrate transition")
+		}
 	case TransitionTypeFinalize:
 		_, err = expectedState.ApplyFinalizeTransition()
 
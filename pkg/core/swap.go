@@ -0,0 +1,54 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/shopspring/decimal"
+)
+
+// SwapLeg is the part of an atomic swap given by one of its parties.
+type SwapLeg struct {
+	Wallet string          // Wallet giving the asset
+	Asset  string          // Asset symbol
+	Amount decimal.Decimal // Amount given
+}
+
+// GetSwapID derives the ID of an atomic swap from both of its legs and a nonce agreed by the parties.
+// The ID is the AccountID of the swap_send transitions of both parties, so that the signature of
+// each party over its own leg also covers the counterparty, asset and amount it receives.
+// The ID doesn't depend on the order of the legs: it computes
+// keccak256(abi.encode(walletA, assetA, amountA, walletB, assetB, amountB, nonce)),
+// where leg A is the leg of the lower wallet address.
+func GetSwapID(leg, counterpartyLeg SwapLeg, nonce uint64) (string, error) {
+	walletA, walletB := common.HexToAddress(leg.Wallet), common.HexToAddress(counterpartyLeg.Wallet)
+	legA, legB := leg, counterpartyLeg
+	if bytes.Compare(walletA.Bytes(), walletB.Bytes()) > 0 {
+		walletA, walletB = walletB, walletA
+		legA, legB = legB, legA
+	}
+
+	args := abi.Arguments{
+		{Type: abi.Type{T: abi.AddressTy}}, // walletA
+		{Type: abi.Type{T: abi.StringTy}},  // assetA
+		{Type: abi.Type{T: abi.StringTy}},  // amountA
+		{Type: abi.Type{T: abi.AddressTy}}, // walletB
+		{Type: abi.Type{T: abi.StringTy}},  // assetB
+		{Type: abi.Type{T: abi.StringTy}},  // amountB
+		{Type: uint64Type},                 // nonce
+	}
+
+	packed, err := args.Pack(
+		walletA, legA.Asset, legA.Amount.String(),
+		walletB, legB.Asset, legB.Amount.String(),
+		nonce,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to pack swap ID arguments: %w", err)
+	}
+
+	return crypto.Keccak256Hash(packed).Hex(), nil
+}
@@ -0,0 +1,46 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSwapID(t *testing.T) {
+	t.Parallel()
+
+	leg := SwapLeg{Wallet: "0x2222222222222222222222222222222222222222", Asset: "usdc", Amount: decimal.NewFromInt(100)}
+	counterpartyLeg := SwapLeg{Wallet: "0x1111111111111111111111111111111111111111", Asset: "eth", Amount: decimal.NewFromInt(2)}
+
+	id, err := GetSwapID(leg, counterpartyLeg, 7)
+	require.NoError(t, err)
+	assert.Len(t, id, 66)
+
+	swappedLegs, err := GetSwapID(counterpartyLeg, leg, 7)
+	require.NoError(t, err)
+	assert.Equal(t, id, swappedLegs, "both parties derive the same ID")
+
+	otherNonce, err := GetSwapID(leg, counterpartyLeg, 8)
+	require.NoError(t, err)
+	assert.NotEqual(t, id, otherNonce)
+
+	otherAmount := counterpartyLeg
+	otherAmount.Amount = decimal.RequireFromString("0.000000000000000001")
+	otherAmountID, err := GetSwapID(leg, otherAmount, 7)
+	require.NoError(t, err)
+	assert.NotEqual(t, id, otherAmountID)
+
+	otherAsset := counterpartyLeg
+	otherAsset.Asset = "weth"
+	otherAssetID, err := GetSwapID(leg, otherAsset, 7)
+	require.NoError(t, err)
+	assert.NotEqual(t, id, otherAssetID)
+
+	otherCounterparty := counterpartyLeg
+	otherCounterparty.Wallet = "0x3333333333333333333333333333333333333333"
+	otherCounterpartyID, err := GetSwapID(leg, otherCounterparty, 7)
+	require.NoError(t, err)
+	assert.NotEqual(t, id, otherCounterpartyID)
+}
@@ -89,6 +89,31 @@ func (s *ChannelStatus) scanString(v string) error {
 	return nil
 }
 
+// ChannelMigrationStatus represents the progress of moving a home channel to another blockchain
+type ChannelMigrationStatus uint8
+
+var (
+	ChannelMigrationStatusNone        ChannelMigrationStatus = 0 // The channel has never been migrated
+	ChannelMigrationStatusInitiated   ChannelMigrationStatus = 1 // The migration is initiated on the new or the old home blockchain
+	ChannelMigrationStatusMigratedOut ChannelMigrationStatus = 2 // The old home blockchain has released the channel
+	ChannelMigrationStatusCompleted   ChannelMigrationStatus = 3 // The channel operates on the new home blockchain
+)
+
+func (s ChannelMigrationStatus) String() string {
+	switch s {
+	case ChannelMigrationStatusNone:
+		return "none"
+	case ChannelMigrationStatusInitiated:
+		return "initiated"
+	case ChannelMigrationStatusMigratedOut:
+		return "migrated_out"
+	case ChannelMigrationStatusCompleted:
+		return "completed"
+	default:
+		return "unknown"
+	}
+}
+
 const (
 	INTENT_OPERATE                    = 0
 	INTENT_CLOSE                      = 1
@@ -104,18 +129,19 @@ const (
 
 // Channel represents an on-chain channel
 type Channel struct {
-	ChannelID             string        `json:"channel_id"`                     // Unique identifier for the channel
-	UserWallet            string        `json:"user_wallet"`                    // User wallet address
-	Asset                 string        `json:"asset"`                          // Asset symbol (e.g. USDC, ETH)
-	Type                  ChannelType   `json:"type"`                           // Type of the channel (home, escrow)
-	BlockchainID          uint64        `json:"blockchain_id"`                  // Unique identifier for the blockchain
-	TokenAddress          string        `json:"token_address"`                  // Address of the token used in the channel
-	ChallengeDuration     uint32        `json:"challenge_duration"`             // Challenge period for the channel in seconds
-	ChallengeExpiresAt    *time.Time    `json:"challenge_expires_at,omitempty"` // Timestamp when the challenge period elapses
-	Nonce                 uint64        `json:"nonce"`                          // Nonce for the channel
-	ApprovedSigValidators string        `json:"approved_sig_validators"`        // Bitmask representing approved signature validators for the channel
-	Status                ChannelStatus `json:"status"`                         // Current status of the channel (void, open, challenged, closed)
-	StateVersion          uint64        `json:"state_version"`                  // On-chain state version of the channel
+	ChannelID             string                 `json:"channel_id"`                     // Unique identifier for the channel
+	UserWallet            string                 `json:"user_wallet"`                    // User wallet address
+	Asset                 string                 `json:"asset"`                          // Asset symbol (e.g. USDC, ETH)
+	Type                  ChannelType            `json:"type"`                           // Type of the channel (home, escrow)
+	BlockchainID          uint64                 `json:"blockchain_id"`                  // Unique identifier for the blockchain
+	TokenAddress          string                 `json:"token_address"`                  // Address of the token used in the channel
+	ChallengeDuration     uint32                 `json:"challenge_duration"`             // Challenge period for the channel in seconds
+	ChallengeExpiresAt    *time.Time             `json:"challenge_expires_at,omitempty"` // Timestamp when the challenge period elapses
+	Nonce                 uint64                 `json:"nonce"`                          // Nonce for the channel
+	ApprovedSigValidators string                 `json:"approved_sig_validators"`        // Bitmask representing approved signature validators for the channel
+	Status                ChannelStatus          `json:"status"`                         // Current status of the channel (void, open, challenged, closed)
+	StateVersion          uint64                 `json:"state_version"`                  // On-chain state version of the channel
+	MigrationStatus       ChannelMigrationStatus `json:"migration_status"`               // Progress of the migration of a home channel to another blockchain
 }
 
 func NewChannel(channelID, userWallet, asset string, ChType ChannelType, blockchainID uint64, tokenAddress string, nonce uint64, challenge uint32, approvedSigValidators string) *Channel {
@@ -210,7 +236,7 @@ func (state State) NextState() *State {
 			NodeNetFlow:  state.EscrowLedger.NodeNetFlow,
 		}
 
-		if transitionType := state.Transition.Type; transitionType == TransitionTypeEscrowDeposit || transitionType == TransitionTypeEscrowWithdraw {
+		if transitionType := state.Transition.Type; transitionType == TransitionTypeEscrowDeposit || transitionType == TransitionTypeEscrowWithdraw || transitionType == TransitionTypeFinalizeMigration {
 			// escrowChannelID, escrowLedger: not-nil -> nil
 			nextState.EscrowChannelID = nil
 			nextState.EscrowLedger = nil
@@ -221,6 +247,25 @@ func (state State) NextState() *State {
 	return nextState
 }
 
+// NextNodeIssuedState returns the next state for a transition the node issues on its own, such as
+// the receiving side of a transfer. The version following a mutual lock, an escrow lock or a migration
+// is skipped, as it is reserved for the state the user signs to complete it.
+func (state State) NextNodeIssuedState() *State {
+	nextState := state.NextState()
+	if transitionType := state.Transition.Type; transitionType == TransitionTypeMutualLock || transitionType == TransitionTypeEscrowLock || transitionType == TransitionTypeMigrate {
+		nextState.Version++
+		nextState.ID = GetStateID(nextState.UserWallet, nextState.Asset, nextState.Epoch, nextState.Version)
+	}
+
+	return nextState
+}
+
+// DefersNodeSignatures reports whether the node leaves the states it issues after this signed state unsigned.
+// This is the case while the mutual or escrow lock started by the state is not completed.
+func (state State) DefersNodeSignatures() bool {
+	return state.Transition.Type == TransitionTypeMutualLock || state.Transition.Type == TransitionTypeEscrowLock
+}
+
 // ApplyChannelCreation applies channel creation parameters to the state and returns the calculated home channel ID.
 func (state *State) ApplyChannelCreation(channelDef ChannelDefinition, blockchainID uint64, tokenAddress, nodeAddress string) (string, error) {
 	// Set home ledger
@@ -338,6 +383,81 @@ func (state *State) ApplyTransferReceiveTransition(sender string, amount decimal
 	return *newTransition, nil
 }
 
+// ApplySwapSendTransition applies the user's leg of the atomic swap with the given ID, see GetSwapID.
+func (state *State) ApplySwapSendTransition(swapID string, amount decimal.Decimal) (Transition, error) {
+	if state.Transition.Type != TransitionTypeVoid {
+		return Transition{}, fmt.Errorf("state already has a transition: %s", state.Transition.Type.String())
+	}
+	accountID := swapID
+	txID, err := GetSenderTransactionID(accountID, state.ID)
+	if err != nil {
+		return Transition{}, err
+	}
+
+	newTransition := NewTransition(TransitionTypeSwapSend, txID, accountID, amount)
+	state.Transition = *newTransition
+	state.HomeLedger.UserBalance = state.HomeLedger.UserBalance.Sub(newTransition.Amount)
+	state.HomeLedger.NodeNetFlow = state.HomeLedger.NodeNetFlow.Sub(newTransition.Amount)
+
+	return *newTransition, nil
+}
+
+func (state *State) ApplySwapReceiveTransition(counterparty string, amount decimal.Decimal, txID string) (Transition, error) {
+	if state.Transition.Type != TransitionTypeVoid {
+		return Transition{}, fmt.Errorf("state already has a transition: %s", state.Transition.Type.String())
+	}
+	accountID := counterparty
+
+	newTransition := NewTransition(TransitionTypeSwapReceive, txID, accountID, amount)
+	state.Transition = *newTransition
+	state.HomeLedger.UserBalance = state.HomeLedger.UserBalance.Add(newTransition.Amount)
+	state.HomeLedger.NodeNetFlow = state.HomeLedger.NodeNetFlow.Add(newTransition.Amount)
+	return *newTransition, nil
+}
+
+func (state *State) ApplyConditionalLockTransition(conditionalTransferID string, amount decimal.Decimal) (Transition, error) {
+	if state.Transition.Type != TransitionTypeVoid {
+		return Transition{}, fmt.Errorf("state already has a transition: %s", state.Transition.Type.String())
+	}
+	accountID := conditionalTransferID
+	txID, err := GetSenderTransactionID(accountID, state.ID)
+	if err != nil {
+		return Transition{}, err
+	}
+
+	newTransition := NewTransition(TransitionTypeConditionalLock, txID, accountID, amount)
+	state.Transition = *newTransition
+	state.HomeLedger.UserBalance = state.HomeLedger.UserBalance.Sub(newTransition.Amount)
+	state.HomeLedger.NodeNetFlow = state.HomeLedger.NodeNetFlow.Sub(newTransition.Amount)
+
+	return *newTransition, nil
+}
+
+func (state *State) ApplyConditionalClaimTransition(conditionalTransferID string, amount decimal.Decimal) (Transition, error) {
+	return state.applyConditionalSettlementTransition(TransitionTypeConditionalClaim, conditionalTransferID, amount)
+}
+
+func (state *State) ApplyConditionalRefundTransition(conditionalTransferID string, amount decimal.Decimal) (Transition, error) {
+	return state.applyConditionalSettlementTransition(TransitionTypeConditionalRefund, conditionalTransferID, amount)
+}
+
+func (state *State) applyConditionalSettlementTransition(transitionType TransitionType, conditionalTransferID string, amount decimal.Decimal) (Transition, error) {
+	if state.Transition.Type != TransitionTypeVoid {
+		return Transition{}, fmt.Errorf("state already has a transition: %s", state.Transition.Type.String())
+	}
+	accountID := conditionalTransferID
+	txID, err := GetReceiverTransactionID(accountID, state.ID)
+	if err != nil {
+		return Transition{}, err
+	}
+
+	newTransition := NewTransition(transitionType, txID, accountID, amount)
+	state.Transition = *newTransition
+	state.HomeLedger.UserBalance = state.HomeLedger.UserBalance.Add(newTransition.Amount)
+	state.HomeLedger.NodeNetFlow = state.HomeLedger.NodeNetFlow.Add(newTransition.Amount)
+	return *newTransition, nil
+}
+
 func (state *State) ApplyCommitTransition(accountID string, amount decimal.Decimal) (Transition, error) {
 	if state.Transition.Type != TransitionTypeVoid {
 		return Transition{}, fmt.Errorf("state already has a transition: %s", state.Transition.Type.String())
@@ -437,8 +557,9 @@ func (state *State) ApplyEscrowDepositTransition(amount decimal.Decimal) (Transi
 	newTransition := NewTransition(TransitionTypeEscrowDeposit, txID, accountID, amount)
 	state.Transition = *newTransition
 
+	// The funds the node locked in the home channel for the mutual lock are credited to the user
 	state.HomeLedger.UserBalance = state.HomeLedger.UserBalance.Add(newTransition.Amount)
-	state.HomeLedger.NodeNetFlow = state.HomeLedger.NodeNetFlow.Add(newTransition.Amount)
+	state.HomeLedger.NodeBalance = state.HomeLedger.NodeBalance.Sub(newTransition.Amount)
 
 	state.EscrowLedger.UserBalance = state.EscrowLedger.UserBalance.Sub(newTransition.Amount)
 	state.EscrowLedger.NodeNetFlow = state.EscrowLedger.NodeNetFlow.Sub(newTransition.Amount)
@@ -516,11 +637,106 @@ func (state *State) ApplyEscrowWithdrawTransition(amount decimal.Decimal) (Trans
 	return *newTransition, nil
 }
 
-func (state *State) ApplyMigrateTransition(amount decimal.Decimal) (Transition, error) {
+// ApplyMigrateTransition prepares the migration of the home channel to the blockchain, where the node
+// locks an amount equal to the user's home balance. The amount must be the whole user's home balance.
+func (state *State) ApplyMigrateTransition(blockchainID uint64, tokenAddress string, amount decimal.Decimal) (Transition, error) {
+	if state.Transition.Type != TransitionTypeVoid {
+		return Transition{}, fmt.Errorf("state already has a transition: %s", state.Transition.Type.String())
+	}
+	if state.HomeChannelID == nil {
+		return Transition{}, fmt.Errorf("missing home channel ID")
+	}
+	if state.EscrowLedger != nil {
+		return Transition{}, fmt.Errorf("cannot migrate while an escrow is in progress")
+	}
+	if blockchainID == 0 {
+		return Transition{}, fmt.Errorf("invalid blockchain ID")
+	}
+	if blockchainID == state.HomeLedger.BlockchainID {
+		return Transition{}, fmt.Errorf("home channel is already on blockchain %d", blockchainID)
+	}
+	if tokenAddress == "" {
+		return Transition{}, fmt.Errorf("invalid token address")
+	}
+	if !state.HomeLedger.NodeBalance.IsZero() {
+		return Transition{}, fmt.Errorf("node balance must be zero to migrate, got %s", state.HomeLedger.NodeBalance)
+	}
+	if !amount.IsPositive() {
+		return Transition{}, fmt.Errorf("nothing to migrate")
+	}
+	if !amount.Equal(state.HomeLedger.UserBalance) {
+		return Transition{}, fmt.Errorf("migration amount must be the user balance: %s != %s", amount, state.HomeLedger.UserBalance)
+	}
+
+	escrowChannelID, err := GetEscrowChannelID(*state.HomeChannelID, state.Version)
+	if err != nil {
+		return Transition{}, err
+	}
+	state.EscrowChannelID = &escrowChannelID
+	accountID := escrowChannelID
+
+	txID, err := GetSenderTransactionID(accountID, state.ID)
+	if err != nil {
+		return Transition{}, err
+	}
+
+	newTransition := NewTransition(TransitionTypeMigrate, txID, accountID, amount)
+	state.Transition = *newTransition
+
+	state.EscrowLedger = &Ledger{
+		BlockchainID: blockchainID,
+		TokenAddress: tokenAddress,
+		UserBalance:  decimal.Zero,
+		UserNetFlow:  decimal.Zero,
+		NodeBalance:  decimal.Zero.Add(newTransition.Amount),
+		NodeNetFlow:  decimal.Zero.Add(newTransition.Amount),
+	}
+
+	return *newTransition, nil
+}
+
+// ApplyFinalizeMigrationTransition completes the migration of the home channel: the ledger of the new
+// blockchain becomes the home ledger, where the user holds the funds the node locked for the migration,
+// and the ledger of the old home blockchain, where the user's funds are released to the node, becomes
+// the escrow ledger under a new escrow channel ID.
+func (state *State) ApplyFinalizeMigrationTransition(amount decimal.Decimal) (Transition, error) {
 	if state.Transition.Type != TransitionTypeVoid {
 		return Transition{}, fmt.Errorf("state already has a transition: %s", state.Transition.Type.String())
 	}
-	return Transition{}, fmt.Errorf("migrate transition not implemented yet")
+	if state.HomeChannelID == nil {
+		return Transition{}, fmt.Errorf("missing home channel ID")
+	}
+	if state.EscrowLedger == nil {
+		return Transition{}, fmt.Errorf("escrow ledger is nil")
+	}
+
+	escrowChannelID, err := GetEscrowChannelID(*state.HomeChannelID, state.Version)
+	if err != nil {
+		return Transition{}, err
+	}
+	state.EscrowChannelID = &escrowChannelID
+	accountID := escrowChannelID
+
+	txID, err := GetSenderTransactionID(accountID, state.ID)
+	if err != nil {
+		return Transition{}, err
+	}
+
+	newTransition := NewTransition(TransitionTypeFinalizeMigration, txID, accountID, amount)
+	state.Transition = *newTransition
+
+	oldHomeLedger := state.HomeLedger
+	oldHomeLedger.UserBalance = oldHomeLedger.UserBalance.Sub(newTransition.Amount)
+	oldHomeLedger.NodeNetFlow = oldHomeLedger.NodeNetFlow.Sub(newTransition.Amount)
+
+	newHomeLedger := *state.EscrowLedger
+	newHomeLedger.UserBalance = newHomeLedger.UserBalance.Add(newTransition.Amount)
+	newHomeLedger.NodeBalance = newHomeLedger.NodeBalance.Sub(newTransition.Amount)
+
+	state.HomeLedger = newHomeLedger
+	state.EscrowLedger = &oldHomeLedger
+
+	return *newTransition, nil
 }
 
 func (state *State) ApplyFinalizeTransition() (Transition, error) {
@@ -547,6 +763,30 @@ func (state *State) ApplyFinalizeTransition() (Transition, error) {
 	return *newTransition, nil
 }
 
+// ReapplyTransition applies a transition the node issued on an earlier state again, keeping its
+// transaction ID. Only transitions moving funds within the home ledger off-chain are supported.
+func (state *State) ReapplyTransition(transition Transition) (Transition, error) {
+	if state.Transition.Type != TransitionTypeVoid {
+		return Transition{}, fmt.Errorf("state already has a transition: %s", state.Transition.Type.String())
+	}
+
+	newTransition := NewTransition(transition.Type, transition.TxID, transition.AccountID, transition.Amount)
+	switch transition.Type {
+	case TransitionTypeTransferReceive, TransitionTypeSwapReceive, TransitionTypeRelease,
+		TransitionTypeConditionalClaim, TransitionTypeConditionalRefund:
+		state.HomeLedger.UserBalance = state.HomeLedger.UserBalance.Add(newTransition.Amount)
+		state.HomeLedger.NodeNetFlow = state.HomeLedger.NodeNetFlow.Add(newTransition.Amount)
+	case TransitionTypeTransferSend:
+		state.HomeLedger.UserBalance = state.HomeLedger.UserBalance.Sub(newTransition.Amount)
+		state.HomeLedger.NodeNetFlow = state.HomeLedger.NodeNetFlow.Sub(newTransition.Amount)
+	default:
+		return Transition{}, fmt.Errorf("transition %s cannot be reapplied", transition.Type.String())
+	}
+	state.Transition = *newTransition
+
+	return *newTransition, nil
+}
+
 // Ledger represents ledger balances
 type Ledger struct {
 	TokenAddress string          `json:"token_address"` // Address of the token used in this channel
@@ -612,14 +852,22 @@ const (
 	TransactionTypeEscrowWithdraw TransactionType = 21
 
 	TransactionTypeTransfer TransactionType = 30
+	TransactionTypeSwap     TransactionType = 31
 
 	TransactionTypeCommit    TransactionType = 40
 	TransactionTypeRelease   TransactionType = 41
 	TransactionTypeRebalance TransactionType = 42
 
-	TransactionTypeMigrate    TransactionType = 100
-	TransactionTypeEscrowLock TransactionType = 110
-	TransactionTypeMutualLock TransactionType = 120
+	TransactionTypeFee TransactionType = 50
+
+	TransactionTypeConditionalLock   TransactionType = 60
+	TransactionTypeConditionalClaim  TransactionType = 61
+	TransactionTypeConditionalRefund TransactionType = 62
+
+	TransactionTypeMigrate           TransactionType = 100
+	TransactionTypeFinalizeMigration TransactionType = 101
+	TransactionTypeEscrowLock        TransactionType = 110
+	TransactionTypeMutualLock        TransactionType = 120
 
 	TransactionTypeFinalize = 200
 )
@@ -629,6 +877,8 @@ func (t TransactionType) String() string {
 	switch t {
 	case TransactionTypeTransfer:
 		return "transfer"
+	case TransactionTypeSwap:
+		return "swap"
 	case TransactionTypeRelease:
 		return "release"
 	case TransactionTypeCommit:
@@ -647,8 +897,18 @@ func (t TransactionType) String() string {
 		return "escrow_withdraw"
 	case TransactionTypeMigrate:
 		return "migrate"
+	case TransactionTypeFinalizeMigration:
+		return "finalize_migration"
 	case TransactionTypeRebalance:
 		return "rebalance"
+	case TransactionTypeFee:
+		return "fee"
+	case TransactionTypeConditionalLock:
+		return "conditional_lock"
+	case TransactionTypeConditionalClaim:
+		return "conditional_claim"
+	case TransactionTypeConditionalRefund:
+		return "conditional_refund"
 	case TransactionTypeFinalize:
 		return "finalize"
 	default:
@@ -684,13 +944,28 @@ func NewTransaction(id, asset string, txType TransactionType, fromAccount, toAcc
 	}
 }
 
+// NewFeeTransaction creates a transaction that moves a fee charged on an operation
+// from the paying account to the node fee account. The stateID identifies the state
+// of the operation the fee was charged on.
+func NewFeeTransaction(asset, fromAccount, feeAccount, stateID string, amount decimal.Decimal) (*Transaction, error) {
+	txID, err := GetSenderTransactionID(feeAccount, stateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTransaction(txID, asset, TransactionTypeFee, fromAccount, feeAccount, &stateID, nil, amount), nil
+}
+
 // NewTransactionFromTransition maps the transition type to the appropriate transaction type and returns a pointer to a Transaction.
 func NewTransactionFromTransition(senderState *State, receiverState *State, transition Transition) (*Transaction, error) {
 	var txType TransactionType
 	var toAccount, fromAccount string
 	// Transition validator is expected to make sure that all the fields are present and valid.
 
-	if transition.Type != TransitionTypeRelease && senderState == nil {
+	isReceiverTransition := transition.Type == TransitionTypeRelease ||
+		transition.Type == TransitionTypeConditionalClaim ||
+		transition.Type == TransitionTypeConditionalRefund
+	if !isReceiverTransition && senderState == nil {
 		return nil, fmt.Errorf("sender state must not be nil for non-release transitions")
 	}
 
@@ -751,6 +1026,15 @@ func NewTransactionFromTransition(senderState *State, receiverState *State, tran
 		fromAccount = senderState.UserWallet
 		toAccount = transition.AccountID
 
+	case TransitionTypeSwapSend:
+		if receiverState == nil {
+			return nil, fmt.Errorf("receiver state must not be nil for 'swap_send' transition")
+		}
+
+		txType = TransactionTypeSwap
+		fromAccount = senderState.UserWallet
+		toAccount = receiverState.UserWallet
+
 	case TransitionTypeCommit:
 		txType = TransactionTypeCommit
 		fromAccount = senderState.UserWallet
@@ -764,6 +1048,23 @@ func NewTransactionFromTransition(senderState *State, receiverState *State, tran
 			return nil, fmt.Errorf("receiver state must not be nil for 'release' transition")
 		}
 
+	case TransitionTypeConditionalLock:
+		txType = TransactionTypeConditionalLock
+		fromAccount = senderState.UserWallet
+		toAccount = transition.AccountID
+
+	case TransitionTypeConditionalClaim, TransitionTypeConditionalRefund:
+		if receiverState == nil {
+			return nil, fmt.Errorf("receiver state must not be nil for '%s' transition", transition.Type.String())
+		}
+
+		txType = TransactionTypeConditionalClaim
+		if transition.Type == TransitionTypeConditionalRefund {
+			txType = TransactionTypeConditionalRefund
+		}
+		fromAccount = transition.AccountID
+		toAccount = receiverState.UserWallet
+
 	case TransitionTypeMutualLock:
 		if senderState.EscrowChannelID == nil || senderState.HomeChannelID == nil {
 			return nil, fmt.Errorf("sender state has no escrow or home channel ID")
@@ -790,6 +1091,15 @@ func NewTransactionFromTransition(senderState *State, receiverState *State, tran
 		txType = TransactionTypeMigrate
 		fromAccount = *senderState.HomeChannelID
 		toAccount = *senderState.EscrowChannelID
+
+	case TransitionTypeFinalizeMigration:
+		if senderState.EscrowChannelID == nil || senderState.HomeChannelID == nil {
+			return nil, fmt.Errorf("sender state has no escrow or home channel ID")
+		}
+
+		txType = TransactionTypeFinalizeMigration
+		fromAccount = *senderState.EscrowChannelID
+		toAccount = *senderState.HomeChannelID
 	case TransitionTypeFinalize:
 		txType = TransactionTypeFinalize
 		fromAccount = senderState.UserWallet
@@ -838,13 +1148,20 @@ const (
 
 	TransitionTypeTransferSend    TransitionType = 30 // AccountID: Receiver's UserWallet
 	TransitionTypeTransferReceive TransitionType = 31 // AccountID: Sender's UserWallet
+	TransitionTypeSwapSend        TransitionType = 32 // AccountID: SwapID
+	TransitionTypeSwapReceive     TransitionType = 33 // AccountID: Counterparty's UserWallet
 
 	TransitionTypeCommit  TransitionType = 40 // AccountID: AppSessionID
 	TransitionTypeRelease TransitionType = 41 // AccountID: AppSessionID
 
-	TransitionTypeMigrate    TransitionType = 100 // AccountID: EscrowChannelID
-	TransitionTypeEscrowLock TransitionType = 110 // AccountID: EscrowChannelID
-	TransitionTypeMutualLock TransitionType = 120 // AccountID: EscrowChannelID
+	TransitionTypeConditionalLock   TransitionType = 60 // AccountID: ConditionalTransferID
+	TransitionTypeConditionalClaim  TransitionType = 61 // AccountID: ConditionalTransferID
+	TransitionTypeConditionalRefund TransitionType = 62 // AccountID: ConditionalTransferID
+
+	TransitionTypeMigrate           TransitionType = 100 // AccountID: EscrowChannelID
+	TransitionTypeFinalizeMigration TransitionType = 101 // AccountID: EscrowChannelID
+	TransitionTypeEscrowLock        TransitionType = 110 // AccountID: EscrowChannelID
+	TransitionTypeMutualLock        TransitionType = 120 // AccountID: EscrowChannelID
 
 	TransitionTypeFinalize TransitionType = 200 // AccountID: HomeChannelID
 )
@@ -860,10 +1177,20 @@ func (t TransitionType) String() string {
 		return "transfer_receive"
 	case TransitionTypeTransferSend:
 		return "transfer_send"
+	case TransitionTypeSwapSend:
+		return "swap_send"
+	case TransitionTypeSwapReceive:
+		return "swap_receive"
 	case TransitionTypeRelease:
 		return "release"
 	case TransitionTypeCommit:
 		return "commit"
+	case TransitionTypeConditionalLock:
+		return "conditional_lock"
+	case TransitionTypeConditionalClaim:
+		return "conditional_claim"
+	case TransitionTypeConditionalRefund:
+		return "conditional_refund"
 	case TransitionTypeHomeDeposit:
 		return "home_deposit"
 	case TransitionTypeHomeWithdrawal:
@@ -878,6 +1205,8 @@ func (t TransitionType) String() string {
 		return "escrow_withdraw"
 	case TransitionTypeMigrate:
 		return "migrate"
+	case TransitionTypeFinalizeMigration:
+		return "finalize_migration"
 	case TransitionTypeFinalize:
 		return "finalize"
 	default:
@@ -887,7 +1216,7 @@ func (t TransitionType) String() string {
 
 func (t TransitionType) GatedAction() GatedAction {
 	switch t {
-	case TransitionTypeTransferSend:
+	case TransitionTypeTransferSend, TransitionTypeSwapSend, TransitionTypeConditionalLock:
 		return GatedActionTransfer
 	default:
 		return ""
@@ -936,6 +1265,11 @@ type Blockchain struct {
 	ChannelHubAddress      string `json:"channel_hub_address"`      // Address of the ChannelHub contract on this blockchain
 	LockingContractAddress string `json:"locking_contract_address"` // Address of the Locking contract on this blockchain
 	BlockStep              uint64 `json:"block_step"`               // Number of blocks between each channel update
+	Confirmations          uint64 `json:"confirmations"`            // Number of blocks built on top of an event's block before the event is processed
+
+	Type              string        `json:"-"` // Type of the blockchain backend, e.g. "evm"
+	EventPollInterval time.Duration `json:"-"` // Interval of polling for events with eth_getLogs, zero subscribes to events instead
+	RPCQuorum         int           `json:"-"` // Number of RPC endpoints that must agree on the result of contract reads
 }
 
 // Asset represents information about a supported asset
@@ -1004,6 +1338,21 @@ func GatedActionFromID(id uint8) (GatedAction, bool) {
 	}
 }
 
+// FeeRule describes the fee charged by the node for a gated action on an asset.
+// The fee is the sum of a flat amount and a percentage of the operation amount.
+type FeeRule struct {
+	Asset      string
+	Action     GatedAction
+	Flat       decimal.Decimal
+	Percentage decimal.Decimal
+}
+
+// Calculate returns the fee charged on the amount, rounded up to the asset decimals.
+func (r FeeRule) Calculate(amount decimal.Decimal, decimals uint8) decimal.Decimal {
+	fee := r.Flat.Add(amount.Mul(r.Percentage).Div(decimal.NewFromInt(100)))
+	return fee.RoundUp(int32(decimals))
+}
+
 // ActionAllowance represents the allowance information for a specific gated action,
 // including the time window for which the allowance applies, the total allowance, and the amount used.
 type ActionAllowance struct {
@@ -1093,4 +1442,7 @@ type NodeConfig struct {
 
 	// Blockchains is the list of supported blockchain networks
 	Blockchains []Blockchain
+
+	// FeeSchedule is the list of fees charged by the node
+	FeeSchedule []FeeRule
 }
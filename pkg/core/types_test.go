@@ -67,6 +67,79 @@ func TestState_NextState(t *testing.T) {
 	assert.Nil(t, nextEscrowCleanup.EscrowLedger)
 }
 
+func TestState_NextNodeIssuedState(t *testing.T) {
+	t.Parallel()
+	state := NewVoidState("USDC", "0xUser")
+	state.Version = 1
+	state.Epoch = 1
+
+	// Same as the next state
+	next := state.NextNodeIssuedState()
+	assert.Equal(t, uint64(2), next.Version)
+	assert.Equal(t, GetStateID("0xUser", "USDC", 1, 2), next.ID)
+
+	// The version after a lock is reserved
+	for _, transitionType := range []TransitionType{TransitionTypeMutualLock, TransitionTypeEscrowLock} {
+		state.Transition.Type = transitionType
+		state.EscrowLedger = &Ledger{BlockchainID: 2}
+		nextAfterLock := state.NextNodeIssuedState()
+		assert.Equal(t, uint64(3), nextAfterLock.Version)
+		assert.Equal(t, GetStateID("0xUser", "USDC", 1, 3), nextAfterLock.ID)
+		assert.NotNil(t, nextAfterLock.EscrowLedger)
+	}
+}
+
+func TestState_DefersNodeSignatures(t *testing.T) {
+	t.Parallel()
+	state := NewVoidState("USDC", "0xUser")
+
+	for transitionType, defers := range map[TransitionType]bool{
+		TransitionTypeMutualLock:    true,
+		TransitionTypeEscrowLock:    true,
+		TransitionTypeMigrate:       false,
+		TransitionTypeTransferSend:  false,
+		TransitionTypeEscrowDeposit: false,
+	} {
+		state.Transition.Type = transitionType
+		assert.Equal(t, defers, state.DefersNodeSignatures(), transitionType.String())
+	}
+}
+
+func TestState_ReapplyTransition(t *testing.T) {
+	t.Parallel()
+
+	received := NewVoidState("USDC", "0xUser")
+	received.ID = "0xStateID"
+	_, err := received.ApplyReleaseTransition("0xAppSession", decimal.NewFromInt(10))
+	require.NoError(t, err)
+
+	state := NewVoidState("USDC", "0xUser")
+	state.HomeLedger.UserBalance = decimal.NewFromInt(100)
+	transition, err := state.ReapplyTransition(received.Transition)
+	require.NoError(t, err)
+	assert.NoError(t, transition.Equal(received.Transition))
+	assert.Equal(t, "110", state.HomeLedger.UserBalance.String())
+	assert.Equal(t, "10", state.HomeLedger.NodeNetFlow.String())
+
+	// Transfers sent on behalf of the user are reapplied too
+	sent := NewVoidState("USDC", "0xUser")
+	sent.ID = "0xStateID"
+	_, err = sent.ApplyTransferSendTransition("0xRecipient", decimal.NewFromInt(30))
+	require.NoError(t, err)
+
+	state.Transition = Transition{}
+	_, err = state.ReapplyTransition(sent.Transition)
+	require.NoError(t, err)
+	assert.Equal(t, "80", state.HomeLedger.UserBalance.String())
+	assert.Equal(t, "-20", state.HomeLedger.NodeNetFlow.String())
+
+	// Transitions signed by the user can't be
+	deposit := Transition{Type: TransitionTypeHomeDeposit, Amount: decimal.NewFromInt(5)}
+	state.Transition = Transition{}
+	_, err = state.ReapplyTransition(deposit)
+	assert.Error(t, err)
+}
+
 func TestState_ApplyChannelCreation(t *testing.T) {
 	t.Parallel()
 	state := NewVoidState("USDC", "0xUser")
@@ -165,6 +238,71 @@ func TestState_ApplyTransferReceiveTransition(t *testing.T) {
 	assert.Equal(t, "10", state.HomeLedger.NodeNetFlow.String())
 }
 
+func TestState_ApplySwapSendTransition(t *testing.T) {
+	t.Parallel()
+	state := NewVoidState("USDC", "0xUser")
+	state.ID = "0xStateID"
+	state.HomeLedger.UserBalance = decimal.NewFromInt(100)
+
+	amount := decimal.NewFromInt(10)
+	swapID := "0x5f6a1e2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7"
+
+	transition, err := state.ApplySwapSendTransition(swapID, amount)
+	require.NoError(t, err)
+	assert.Equal(t, TransitionTypeSwapSend, transition.Type)
+	assert.Equal(t, swapID, transition.AccountID)
+	assert.Equal(t, "90", state.HomeLedger.UserBalance.String())
+	assert.Equal(t, "-10", state.HomeLedger.NodeNetFlow.String())
+}
+
+func TestState_ApplySwapReceiveTransition(t *testing.T) {
+	t.Parallel()
+	state := NewVoidState("ETH", "0xUser")
+	amount := decimal.NewFromInt(2)
+	counterparty := "0xCounterparty"
+	txID := "0xTx"
+
+	transition, err := state.ApplySwapReceiveTransition(counterparty, amount, txID)
+	require.NoError(t, err)
+	assert.Equal(t, TransitionTypeSwapReceive, transition.Type)
+	assert.Equal(t, txID, transition.TxID)
+	assert.Equal(t, "2", state.HomeLedger.UserBalance.String())
+	assert.Equal(t, "2", state.HomeLedger.NodeNetFlow.String())
+}
+
+func TestState_ApplyConditionalTransitions(t *testing.T) {
+	t.Parallel()
+	conditionalTransferID := "0x6b2a4c3d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809"
+
+	sender := NewVoidState("USDC", "0xSender")
+	sender.ID = "0xStateID"
+	sender.HomeLedger.UserBalance = decimal.NewFromInt(100)
+
+	transition, err := sender.ApplyConditionalLockTransition(conditionalTransferID, decimal.NewFromInt(30))
+	require.NoError(t, err)
+	assert.Equal(t, TransitionTypeConditionalLock, transition.Type)
+	assert.Equal(t, conditionalTransferID, transition.AccountID)
+	assert.Equal(t, "70", sender.HomeLedger.UserBalance.String())
+	assert.Equal(t, "-30", sender.HomeLedger.NodeNetFlow.String())
+
+	_, err = sender.ApplyConditionalRefundTransition(conditionalTransferID, decimal.NewFromInt(30))
+	assert.ErrorContains(t, err, "state already has a transition")
+
+	refunded := sender.NextState()
+	transition, err = refunded.ApplyConditionalRefundTransition(conditionalTransferID, decimal.NewFromInt(30))
+	require.NoError(t, err)
+	assert.Equal(t, TransitionTypeConditionalRefund, transition.Type)
+	assert.Equal(t, "100", refunded.HomeLedger.UserBalance.String())
+	assert.Equal(t, "0", refunded.HomeLedger.NodeNetFlow.String())
+
+	receiver := NewVoidState("USDC", "0xReceiver")
+	transition, err = receiver.ApplyConditionalClaimTransition(conditionalTransferID, decimal.NewFromInt(30))
+	require.NoError(t, err)
+	assert.Equal(t, TransitionTypeConditionalClaim, transition.Type)
+	assert.Equal(t, "30", receiver.HomeLedger.UserBalance.String())
+	assert.Equal(t, "30", receiver.HomeLedger.NodeNetFlow.String())
+}
+
 func TestState_ApplyCommitTransition(t *testing.T) {
 	t.Parallel()
 	state := NewVoidState("USDC", "0xUser")
@@ -227,12 +365,16 @@ func TestState_ApplyEscrowDepositTransition(t *testing.T) {
 	escrowID := "0xEscrow"
 	state.EscrowChannelID = &escrowID
 	state.EscrowLedger = &Ledger{UserBalance: decimal.NewFromInt(100)}
+	state.HomeLedger.NodeBalance = decimal.NewFromInt(10)
+	state.HomeLedger.NodeNetFlow = decimal.NewFromInt(10)
 
 	amount := decimal.NewFromInt(10)
 	transition, err := state.ApplyEscrowDepositTransition(amount)
 	require.NoError(t, err)
 	assert.Equal(t, TransitionTypeEscrowDeposit, transition.Type)
 	assert.Equal(t, "10", state.HomeLedger.UserBalance.String())
+	assert.Equal(t, "0", state.HomeLedger.NodeBalance.String())
+	assert.Equal(t, "10", state.HomeLedger.NodeNetFlow.String())
 	assert.Equal(t, "90", state.EscrowLedger.UserBalance.String())
 }
 
@@ -272,8 +414,84 @@ func TestState_ApplyEscrowWithdrawTransition(t *testing.T) {
 func TestState_ApplyMigrateTransition(t *testing.T) {
 	t.Parallel()
 	state := NewVoidState("USDC", "0xUser")
-	_, err := state.ApplyMigrateTransition(decimal.Zero)
-	assert.Error(t, err) // Not implemented
+	chanID := "0xChan"
+	state.HomeChannelID = &chanID
+	state.ID = "0xStateID"
+	state.HomeLedger.BlockchainID = 1
+	state.HomeLedger.UserBalance = decimal.NewFromInt(100)
+	state.HomeLedger.UserNetFlow = decimal.NewFromInt(150)
+	state.HomeLedger.NodeNetFlow = decimal.NewFromInt(-50)
+
+	amount := decimal.NewFromInt(100)
+	transition, err := state.ApplyMigrateTransition(2, "0xT", amount)
+	require.NoError(t, err)
+	assert.Equal(t, TransitionTypeMigrate, transition.Type)
+	assert.NotNil(t, state.EscrowChannelID)
+	assert.Equal(t, *state.EscrowChannelID, transition.AccountID)
+	require.NotNil(t, state.EscrowLedger)
+	assert.Equal(t, uint64(2), state.EscrowLedger.BlockchainID)
+	assert.Equal(t, "0xT", state.EscrowLedger.TokenAddress)
+	assert.Equal(t, "0", state.EscrowLedger.UserBalance.String())
+	assert.Equal(t, "100", state.EscrowLedger.NodeBalance.String())
+	assert.Equal(t, "100", state.EscrowLedger.NodeNetFlow.String())
+	assert.Equal(t, "100", state.HomeLedger.UserBalance.String())
+
+	// Failures
+	state.Transition.Type = TransitionTypeVoid
+	state.EscrowLedger = nil
+	_, err = state.ApplyMigrateTransition(1, "0xT", amount)
+	assert.Error(t, err) // Already on the blockchain
+	_, err = state.ApplyMigrateTransition(2, "0xT", decimal.NewFromInt(50))
+	assert.Error(t, err) // Not the whole user balance
+	state.HomeLedger.NodeBalance = decimal.NewFromInt(1)
+	_, err = state.ApplyMigrateTransition(2, "0xT", amount)
+	assert.Error(t, err) // Node balance is not zero
+}
+
+func TestState_ApplyFinalizeMigrationTransition(t *testing.T) {
+	t.Parallel()
+	state := NewVoidState("USDC", "0xUser")
+	chanID := "0xChan"
+	state.HomeChannelID = &chanID
+	state.ID = "0xStateID"
+	state.HomeLedger = Ledger{
+		BlockchainID: 1,
+		TokenAddress: "0xA",
+		UserBalance:  decimal.NewFromInt(100),
+		UserNetFlow:  decimal.NewFromInt(150),
+		NodeBalance:  decimal.Zero,
+		NodeNetFlow:  decimal.NewFromInt(-50),
+	}
+
+	amount := decimal.NewFromInt(100)
+	_, err := state.ApplyMigrateTransition(2, "0xB", amount)
+	require.NoError(t, err)
+
+	nextState := state.NextState()
+	transition, err := nextState.ApplyFinalizeMigrationTransition(amount)
+	require.NoError(t, err)
+	assert.Equal(t, TransitionTypeFinalizeMigration, transition.Type)
+	require.NotNil(t, nextState.EscrowChannelID)
+	assert.NotEqual(t, *state.EscrowChannelID, *nextState.EscrowChannelID)
+	assert.Equal(t, *nextState.EscrowChannelID, transition.AccountID)
+	assert.Equal(t, uint64(2), nextState.HomeLedger.BlockchainID)
+	assert.Equal(t, "0xB", nextState.HomeLedger.TokenAddress)
+	assert.Equal(t, "100", nextState.HomeLedger.UserBalance.String())
+	assert.Equal(t, "0", nextState.HomeLedger.UserNetFlow.String())
+	assert.Equal(t, "0", nextState.HomeLedger.NodeBalance.String())
+	assert.Equal(t, "100", nextState.HomeLedger.NodeNetFlow.String())
+	require.NotNil(t, nextState.EscrowLedger)
+	assert.Equal(t, uint64(1), nextState.EscrowLedger.BlockchainID)
+	assert.Equal(t, "0", nextState.EscrowLedger.UserBalance.String())
+	assert.Equal(t, "150", nextState.EscrowLedger.UserNetFlow.String())
+	assert.Equal(t, "0", nextState.EscrowLedger.NodeBalance.String())
+	assert.Equal(t, "-150", nextState.EscrowLedger.NodeNetFlow.String())
+
+	// The escrow is dropped from the state following the migration
+	followingState := nextState.NextState()
+	assert.Nil(t, followingState.EscrowLedger)
+	assert.Nil(t, followingState.EscrowChannelID)
+	assert.Equal(t, uint64(2), followingState.HomeLedger.BlockchainID)
 }
 
 func TestState_ApplyFinalizeTransition(t *testing.T) {
@@ -330,6 +548,10 @@ func TestLedger_Equal_Validate(t *testing.T) {
 func TestTransactionType_String(t *testing.T) {
 	t.Parallel()
 	assert.Equal(t, "transfer", TransactionTypeTransfer.String())
+	assert.Equal(t, "swap", TransactionTypeSwap.String())
+	assert.Equal(t, "conditional_lock", TransactionTypeConditionalLock.String())
+	assert.Equal(t, "conditional_refund", TransactionTypeConditionalRefund.String())
+	assert.Equal(t, "fee", TransactionTypeFee.String())
 	assert.Equal(t, "unknown", TransactionType(255).String())
 }
 
@@ -340,6 +562,34 @@ func TestNewTransaction(t *testing.T) {
 	assert.False(t, tx.CreatedAt.IsZero())
 }
 
+func TestNewFeeTransaction(t *testing.T) {
+	t.Parallel()
+	stateID := "0x1111111111111111111111111111111111111111111111111111111111111111"
+	tx, err := NewFeeTransaction("usdc", "0xUser", "0xFee", stateID, decimal.NewFromInt(1))
+	require.NoError(t, err)
+
+	expectedID, err := GetSenderTransactionID("0xFee", stateID)
+	require.NoError(t, err)
+	assert.Equal(t, expectedID, tx.ID)
+	assert.Equal(t, TransactionTypeFee, tx.TxType)
+	assert.Equal(t, "0xUser", tx.FromAccount)
+	assert.Equal(t, "0xFee", tx.ToAccount)
+	require.NotNil(t, tx.SenderNewStateID)
+	assert.Equal(t, stateID, *tx.SenderNewStateID)
+	assert.Nil(t, tx.ReceiverNewStateID)
+}
+
+func TestFeeRule_Calculate(t *testing.T) {
+	t.Parallel()
+	rule := FeeRule{Flat: decimal.RequireFromString("0.1"), Percentage: decimal.RequireFromString("0.25")}
+
+	// 0.1 + 100 * 0.25% = 0.35
+	assert.True(t, decimal.RequireFromString("0.35").Equal(rule.Calculate(decimal.NewFromInt(100), 6)))
+	// 0.1 + 0.000001 * 0.25% is rounded up to the asset decimals
+	assert.True(t, decimal.RequireFromString("0.100001").Equal(rule.Calculate(decimal.RequireFromString("0.000001"), 6)))
+	assert.True(t, decimal.Zero.Equal(FeeRule{}.Calculate(decimal.NewFromInt(100), 6)))
+}
+
 func TestNewTransactionFromTransition(t *testing.T) {
 	t.Parallel()
 	senderState := NewVoidState("A", "U")
@@ -366,6 +616,45 @@ func TestNewTransactionFromTransition(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "receiver state must not be nil")
 
+	// SwapSend
+	transition = Transition{Type: TransitionTypeSwapSend, Amount: decimal.NewFromInt(10), AccountID: "SWAP"}
+	receiverState = NewVoidState("A", "CP")
+	tx, err = NewTransactionFromTransition(senderState, receiverState, transition)
+	require.NoError(t, err)
+	assert.Equal(t, TransactionTypeSwap, tx.TxType)
+	assert.Equal(t, "U", tx.FromAccount)
+	assert.Equal(t, "CP", tx.ToAccount)
+
+	// SwapSend error: nil receiverState
+	_, err = NewTransactionFromTransition(senderState, nil, transition)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "receiver state must not be nil")
+
+	// ConditionalLock
+	transition = Transition{Type: TransitionTypeConditionalLock, Amount: decimal.NewFromInt(10), AccountID: "CT"}
+	tx, err = NewTransactionFromTransition(senderState, nil, transition)
+	require.NoError(t, err)
+	assert.Equal(t, TransactionTypeConditionalLock, tx.TxType)
+	assert.Equal(t, "U", tx.FromAccount)
+	assert.Equal(t, "CT", tx.ToAccount)
+
+	// ConditionalClaim and ConditionalRefund
+	transition = Transition{Type: TransitionTypeConditionalClaim, Amount: decimal.NewFromInt(10), AccountID: "CT"}
+	tx, err = NewTransactionFromTransition(nil, receiverState, transition)
+	require.NoError(t, err)
+	assert.Equal(t, TransactionTypeConditionalClaim, tx.TxType)
+	assert.Equal(t, "CT", tx.FromAccount)
+	assert.Equal(t, receiverState.UserWallet, tx.ToAccount)
+
+	transition = Transition{Type: TransitionTypeConditionalRefund, Amount: decimal.NewFromInt(10), AccountID: "CT"}
+	tx, err = NewTransactionFromTransition(nil, senderState, transition)
+	require.NoError(t, err)
+	assert.Equal(t, TransactionTypeConditionalRefund, tx.TxType)
+	assert.Equal(t, "U", tx.ToAccount)
+
+	_, err = NewTransactionFromTransition(nil, nil, transition)
+	assert.Error(t, err)
+
 	// Invalid
 	transition = Transition{Type: 255}
 	_, err = NewTransactionFromTransition(senderState, nil, transition)
@@ -29,6 +29,11 @@ func TransitionToIntent(transition Transition) uint8 {
 	switch transition.Type {
 	case TransitionTypeTransferSend,
 		TransitionTypeTransferReceive,
+		TransitionTypeSwapSend,
+		TransitionTypeSwapReceive,
+		TransitionTypeConditionalLock,
+		TransitionTypeConditionalClaim,
+		TransitionTypeConditionalRefund,
 		TransitionTypeCommit,
 		TransitionTypeRelease:
 		return INTENT_OPERATE
@@ -48,11 +53,11 @@ func TransitionToIntent(transition Transition) uint8 {
 		return INTENT_FINALIZE_ESCROW_WITHDRAWAL
 	case TransitionTypeMigrate:
 		return INTENT_INITIATE_MIGRATION
+	case TransitionTypeFinalizeMigration:
+		return INTENT_FINALIZE_MIGRATION
 	default:
 		return INTENT_OPERATE
 	}
-	// TODO: Add:
-	// FINALIZE_MIGRATION.
 }
 
 // ValidateDecimalPrecision validates that an amount doesn't exceed the maximum allowed decimal places.
@@ -587,6 +587,11 @@ func TestTransitionToIntent_OperateIntents(t *testing.T) {
 	}{
 		{"TransferSend", TransitionTypeTransferSend, INTENT_OPERATE},
 		{"TransferReceive", TransitionTypeTransferReceive, INTENT_OPERATE},
+		{"SwapSend", TransitionTypeSwapSend, INTENT_OPERATE},
+		{"SwapReceive", TransitionTypeSwapReceive, INTENT_OPERATE},
+		{"ConditionalLock", TransitionTypeConditionalLock, INTENT_OPERATE},
+		{"ConditionalClaim", TransitionTypeConditionalClaim, INTENT_OPERATE},
+		{"ConditionalRefund", TransitionTypeConditionalRefund, INTENT_OPERATE},
 		{"Commit", TransitionTypeCommit, INTENT_OPERATE},
 		{"Release", TransitionTypeRelease, INTENT_OPERATE},
 	}
@@ -616,6 +621,7 @@ func TestTransitionToIntent_AllTransitionTypes(t *testing.T) {
 		{"EscrowLock", TransitionTypeEscrowLock, INTENT_INITIATE_ESCROW_WITHDRAWAL},
 		{"EscrowWithdraw", TransitionTypeEscrowWithdraw, INTENT_FINALIZE_ESCROW_WITHDRAWAL},
 		{"Migrate", TransitionTypeMigrate, INTENT_INITIATE_MIGRATION},
+		{"FinalizeMigration", TransitionTypeFinalizeMigration, INTENT_FINALIZE_MIGRATION},
 	}
 
 	for _, tt := range tests {
@@ -127,6 +127,76 @@ type ChannelsV1SubmitStateResponse struct {
 	Signature string `json:"signature"`
 }
 
+// ChannelsV1SubmitSwapRequest submits both legs of an atomic swap between two users.
+// Each state carries a swap_send transition signed by its owner, whose account ID is the swap ID
+// derived from both legs and the nonce agreed by the users.
+type ChannelsV1SubmitSwapRequest struct {
+	// State is the submitter's swap_send state
+	State StateV1 `json:"state"`
+	// CounterpartyState is the counterparty's swap_send state
+	CounterpartyState StateV1 `json:"counterparty_state"`
+	// Nonce is the nonce agreed by the users, distinguishing swaps with the same legs
+	Nonce string `json:"nonce"`
+}
+
+// ChannelsV1SubmitSwapResponse returns the Node's signatures for both swap_send states.
+type ChannelsV1SubmitSwapResponse struct {
+	// Signature is the Node's signature for the submitter's state
+	Signature string `json:"signature"`
+	// CounterpartySignature is the Node's signature for the counterparty's state
+	CounterpartySignature string `json:"counterparty_signature"`
+	// SwapID is the ID of the swap
+	SwapID string `json:"swap_id"`
+}
+
+// ChannelsV1SubmitConditionalLockRequest submits a state locking funds in a hash-time-locked transfer.
+// The state carries a conditional_lock transition whose account ID is the conditional transfer ID
+// derived from the state ID and the transfer terms.
+type ChannelsV1SubmitConditionalLockRequest struct {
+	// State is the sender's conditional_lock state
+	State StateV1 `json:"state"`
+	// Receiver is the wallet that can claim the locked funds
+	Receiver string `json:"receiver"`
+	// HashLock is the SHA-256 hash of the preimage that unlocks the funds
+	HashLock string `json:"hash_lock"`
+	// ExpiresAt is the Unix timestamp (in seconds) after which the funds are refunded to the sender
+	ExpiresAt string `json:"expires_at"`
+}
+
+// ChannelsV1SubmitConditionalLockResponse returns the Node's signature for the conditional_lock state.
+type ChannelsV1SubmitConditionalLockResponse struct {
+	// Signature is the Node's signature for the state
+	Signature string `json:"signature"`
+	// ConditionalTransferID is the ID of the created conditional transfer
+	ConditionalTransferID string `json:"conditional_transfer_id"`
+}
+
+// ChannelsV1ClaimConditionalTransferRequest claims a conditional transfer by revealing its preimage.
+type ChannelsV1ClaimConditionalTransferRequest struct {
+	// ConditionalTransferID is the ID of the conditional transfer
+	ConditionalTransferID string `json:"conditional_transfer_id"`
+	// Preimage is the hex-encoded 32-byte preimage of the hash lock
+	Preimage string `json:"preimage"`
+}
+
+// ChannelsV1ClaimConditionalTransferResponse returns the receiver's state crediting the claimed funds.
+type ChannelsV1ClaimConditionalTransferResponse struct {
+	// State is the receiver's new state issued by the Node
+	State StateV1 `json:"state"`
+}
+
+// ChannelsV1RefundConditionalTransferRequest refunds an expired conditional transfer to its sender.
+type ChannelsV1RefundConditionalTransferRequest struct {
+	// ConditionalTransferID is the ID of the conditional transfer
+	ConditionalTransferID string `json:"conditional_transfer_id"`
+}
+
+// ChannelsV1RefundConditionalTransferResponse returns the sender's state crediting the refunded funds.
+type ChannelsV1RefundConditionalTransferResponse struct {
+	// State is the sender's new state issued by the Node
+	State StateV1 `json:"state"`
+}
+
 // ChannelsV1HomeChannelCreatedEvent is emitted when a home channel is created.
 type ChannelsV1HomeChannelCreatedEvent struct {
 	// Channel is the created home channel information
@@ -158,6 +228,57 @@ type ChannelsV1GetLastKeyStatesResponse struct {
 	States []ChannelSessionKeyStateV1 `json:"states"`
 }
 
+// ChannelsV1SubscribeRequest subscribes the connection to channel events of a user.
+type ChannelsV1SubscribeRequest struct {
+	// Wallet is the user's wallet address
+	Wallet string `json:"wallet"`
+}
+
+// ChannelsV1SubscribeResponse confirms the subscription.
+type ChannelsV1SubscribeResponse struct {
+}
+
+// ChannelsV1UnsubscribeRequest cancels the connection's subscription to channel events of a user.
+type ChannelsV1UnsubscribeRequest struct {
+	// Wallet is the user's wallet address
+	Wallet string `json:"wallet"`
+}
+
+// ChannelsV1UnsubscribeResponse confirms the cancellation of the subscription.
+type ChannelsV1UnsubscribeResponse struct {
+}
+
+// ChannelsV1ChannelUpdatedEvent is emitted when the status or version of a user's channel changes.
+type ChannelsV1ChannelUpdatedEvent struct {
+	// Channel is the updated channel information
+	Channel ChannelV1 `json:"channel"`
+}
+
+// ChannelsV1TransferReceivedEvent is emitted when a user receives an off-chain transfer.
+type ChannelsV1TransferReceivedEvent struct {
+	// State is the receiver's new state issued by the Node
+	State StateV1 `json:"state"`
+}
+
+// ChannelsV1BlockchainActionCompletedEvent is emitted when the Node has submitted a user's state on-chain.
+type ChannelsV1BlockchainActionCompletedEvent struct {
+	// ActionType is the type of the completed action (e.g., checkpoint, initiate_escrow_deposit)
+	ActionType string `json:"action_type"`
+	// BlockchainID is the ID of the blockchain the action was submitted to
+	BlockchainID string `json:"blockchain_id"`
+	// TxHash is the hash of the submitted transaction
+	TxHash string `json:"tx_hash"`
+	// State is the state that was submitted on-chain
+	State StateV1 `json:"state"`
+}
+
+// ChannelsV1ConditionalTransferUpdatedEvent is emitted to both parties when a conditional transfer
+// is locked, claimed or refunded.
+type ChannelsV1ConditionalTransferUpdatedEvent struct {
+	// ConditionalTransfer is the updated conditional transfer
+	ConditionalTransfer ConditionalTransferV1 `json:"conditional_transfer"`
+}
+
 // ============================================================================
 // App Sessions Group - V1 API
 // ============================================================================
@@ -286,6 +407,32 @@ type AppSessionsV1GetLastKeyStatesResponse struct {
 	States []AppSessionKeyStateV1 `json:"states"`
 }
 
+// AppSessionsV1SubscribeRequest subscribes the connection to app session events of a participant.
+type AppSessionsV1SubscribeRequest struct {
+	// Wallet is the participant's wallet address
+	Wallet string `json:"wallet"`
+}
+
+// AppSessionsV1SubscribeResponse confirms the subscription.
+type AppSessionsV1SubscribeResponse struct {
+}
+
+// AppSessionsV1UnsubscribeRequest cancels the connection's subscription to app session events of a participant.
+type AppSessionsV1UnsubscribeRequest struct {
+	// Wallet is the participant's wallet address
+	Wallet string `json:"wallet"`
+}
+
+// AppSessionsV1UnsubscribeResponse confirms the cancellation of the subscription.
+type AppSessionsV1UnsubscribeResponse struct {
+}
+
+// AppSessionsV1AppSessionUpdatedEvent is emitted to all participants when an app session advances to a new version.
+type AppSessionsV1AppSessionUpdatedEvent struct {
+	// AppSession is the updated app session information
+	AppSession AppSessionInfoV1 `json:"app_session"`
+}
+
 // ============================================================================
 // Apps Group - V1 API
 // ============================================================================
@@ -320,6 +467,52 @@ type AppsV1SubmitAppVersionRequest struct {
 type AppsV1SubmitAppVersionResponse struct {
 }
 
+// ============================================================================
+// Streams Group - V1 API
+// ============================================================================
+
+// StreamsV1CreateRequest creates a payment stream authorized by the sender.
+type StreamsV1CreateRequest struct {
+	// Authorization contains the payment stream terms
+	Authorization PaymentStreamAuthorizationV1 `json:"authorization"`
+	// SenderSig is the sender's signature over the packed authorization
+	SenderSig string `json:"sender_sig"`
+}
+
+// StreamsV1CreateResponse returns the created payment stream.
+type StreamsV1CreateResponse struct {
+	// Stream is the created payment stream
+	Stream PaymentStreamV1 `json:"stream"`
+}
+
+// StreamsV1CancelRequest stops a payment stream. It can be signed by either the sender or the recipient.
+type StreamsV1CancelRequest struct {
+	// StreamID is the payment stream ID
+	StreamID string `json:"stream_id"`
+	// Signature is the sender's or recipient's signature over the packed cancellation
+	Signature string `json:"signature"`
+}
+
+// StreamsV1CancelResponse returns the cancelled payment stream.
+type StreamsV1CancelResponse struct {
+	// Stream is the cancelled payment stream
+	Stream PaymentStreamV1 `json:"stream"`
+}
+
+// StreamsV1GetRequest retrieves payment streams by ID or by participating wallet.
+type StreamsV1GetRequest struct {
+	// StreamID filters by payment stream ID
+	StreamID *string `json:"stream_id,omitempty"`
+	// Wallet filters by the wallet sending or receiving the streams
+	Wallet *string `json:"wallet,omitempty"`
+}
+
+// StreamsV1GetResponse returns the list of payment streams.
+type StreamsV1GetResponse struct {
+	// Streams is the list of payment streams
+	Streams []PaymentStreamV1 `json:"streams"`
+}
+
 // ============================================================================
 // User Group - V1 API
 // ============================================================================
@@ -372,6 +565,37 @@ type UserV1GetActionAllowancesResponse struct {
 	Allowances []ActionAllowanceV1 `json:"allowances"`
 }
 
+// ============================================================================
+// Auth Group - V1 API
+// ============================================================================
+
+// AuthV1RequestChallengeRequest requests a challenge to authenticate the connection with.
+type AuthV1RequestChallengeRequest struct{}
+
+// AuthV1RequestChallengeResponse returns the challenge to sign.
+type AuthV1RequestChallengeResponse struct {
+	// Challenge is the node-issued nonce, valid for a single authentication attempt on this connection
+	Challenge string `json:"challenge"`
+	// ExpiresAt is the Unix timestamp (in seconds) after which the challenge is no longer accepted
+	ExpiresAt string `json:"expires_at"`
+}
+
+// AuthV1AuthenticateRequest binds the connection to a wallet by signing the challenge.
+type AuthV1AuthenticateRequest struct {
+	// Wallet is the user's wallet address
+	Wallet string `json:"wallet"`
+	// Challenge is the challenge returned by auth.v1.request_challenge
+	Challenge string `json:"challenge"`
+	// Signature is the channel signature over PackAuthChallengeV1, made by the wallet or one of its channel session keys
+	Signature string `json:"signature"`
+}
+
+// AuthV1AuthenticateResponse confirms the authentication.
+type AuthV1AuthenticateResponse struct {
+	// Wallet is the wallet the connection is authenticated as
+	Wallet string `json:"wallet"`
+}
+
 // ============================================================================
 // Node Group - V1 API
 // ============================================================================
@@ -395,6 +619,8 @@ type NodeV1GetConfigResponse struct {
 	SupportedSigValidators []core.ChannelSignerType `json:"supported_sig_validators"`
 	// Blockchains is the list of supported networks
 	Blockchains []BlockchainInfoV1 `json:"blockchains"`
+	// FeeSchedule is the list of fees charged by the node
+	FeeSchedule []FeeRuleV1 `json:"fee_schedule"`
 }
 
 // NodeV1GetAssetsRequest retrieves all supported assets with optional chain filter.
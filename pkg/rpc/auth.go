@@ -0,0 +1,13 @@
+package rpc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PackAuthChallengeV1 returns the message signed to authenticate a connection as the wallet
+// with a challenge issued by auth.v1.request_challenge. The fixed, human-readable layout
+// keeps the signature from being usable as a signature over a channel state.
+func PackAuthChallengeV1(wallet, challenge string) []byte {
+	return []byte(fmt.Sprintf("Nitrolite RPC authentication\nWallet: %s\nChallenge: %s", strings.ToLower(wallet), challenge))
+}
@@ -6,11 +6,19 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+// SignedMessageWindow is the maximum difference between the timestamp of a verified message
+// and the local clock. It bounds the time a signed message can be replayed for
+// and tolerates clock skew between the client and the Node.
+const SignedMessageWindow = time.Minute
+
 // Client provides a high-level interface for interacting with the Nitrolite Node V1 RPC API.
 // It wraps a Dialer to provide convenient methods for all V1 RPC operations.
 //
@@ -40,6 +48,13 @@ import (
 //	}
 type Client struct {
 	dialer Dialer
+
+	// verifyMu protects the response verification settings
+	verifyMu sync.RWMutex
+	// nodeAddress is the address responses must be signed by, empty if verification is disabled
+	nodeAddress string
+	// strictVerify makes the client reject unsigned responses
+	strictVerify bool
 }
 
 // NewClient creates a new V1 RPC client using the provided dialer.
@@ -56,6 +71,37 @@ func (c *Client) Start(ctx context.Context, url string, handleClosure func(err e
 	return c.dialer.Dial(ctx, url, handleClosure)
 }
 
+// VerifyResponses makes the client verify that responses are signed by the Node
+// with the nodeAddress as the responses to the requests they answer. Responses with
+// an invalid signature are rejected with ErrInvalidSignature, and signed responses
+// created more than SignedMessageWindow away from now with ErrStaleMessage.
+// In strict mode, unsigned responses are rejected as well with ErrUnsignedMessage;
+// otherwise they are accepted, which allows talking to Nodes that do not sign their responses.
+//
+// The same settings apply to server-push events checked with VerifyEvent.
+// An empty nodeAddress disables verification.
+func (c *Client) VerifyResponses(nodeAddress string, strict bool) {
+	c.verifyMu.Lock()
+	defer c.verifyMu.Unlock()
+
+	c.nodeAddress = nodeAddress
+	c.strictVerify = strict
+}
+
+// EventCh returns a read-only channel for receiving server-push events
+// for the subscriptions made on this client's connection.
+// This is a convenience method that wraps the dialer's EventCh method.
+// The events are delivered as received; check them with VerifyEvent before use.
+func (c *Client) EventCh() <-chan *Message {
+	return c.dialer.EventCh()
+}
+
+// VerifyEvent checks the signature and the timestamp of a server-push event
+// according to the settings of VerifyResponses.
+func (c *Client) VerifyEvent(event *Message) error {
+	return c.verifyMessage(event, nil)
+}
+
 // ============================================================================
 // Channels Group - V1 API Methods
 // ============================================================================
@@ -123,6 +169,42 @@ func (c *Client) ChannelsV1SubmitState(ctx context.Context, req ChannelsV1Submit
 	return resp, nil
 }
 
+// ChannelsV1SubmitSwap submits both legs of an atomic swap between two users.
+func (c *Client) ChannelsV1SubmitSwap(ctx context.Context, req ChannelsV1SubmitSwapRequest) (ChannelsV1SubmitSwapResponse, error) {
+	var resp ChannelsV1SubmitSwapResponse
+	if err := c.call(ctx, ChannelsV1SubmitSwapMethod, req, &resp); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// ChannelsV1SubmitConditionalLock submits a state locking funds in a hash-time-locked transfer.
+func (c *Client) ChannelsV1SubmitConditionalLock(ctx context.Context, req ChannelsV1SubmitConditionalLockRequest) (ChannelsV1SubmitConditionalLockResponse, error) {
+	var resp ChannelsV1SubmitConditionalLockResponse
+	if err := c.call(ctx, ChannelsV1SubmitConditionalLockMethod, req, &resp); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// ChannelsV1ClaimConditionalTransfer claims a conditional transfer by revealing its preimage.
+func (c *Client) ChannelsV1ClaimConditionalTransfer(ctx context.Context, req ChannelsV1ClaimConditionalTransferRequest) (ChannelsV1ClaimConditionalTransferResponse, error) {
+	var resp ChannelsV1ClaimConditionalTransferResponse
+	if err := c.call(ctx, ChannelsV1ClaimConditionalTransferMethod, req, &resp); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// ChannelsV1RefundConditionalTransfer refunds an expired conditional transfer to its sender.
+func (c *Client) ChannelsV1RefundConditionalTransfer(ctx context.Context, req ChannelsV1RefundConditionalTransferRequest) (ChannelsV1RefundConditionalTransferResponse, error) {
+	var resp ChannelsV1RefundConditionalTransferResponse
+	if err := c.call(ctx, ChannelsV1RefundConditionalTransferMethod, req, &resp); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
 // ChannelsV1SubmitSessionKeyState submits a channel session key state for registration or update.
 func (c *Client) ChannelsV1SubmitSessionKeyState(ctx context.Context, req ChannelsV1SubmitSessionKeyStateRequest) (ChannelsV1SubmitSessionKeyStateResponse, error) {
 	var resp ChannelsV1SubmitSessionKeyStateResponse
@@ -141,6 +223,24 @@ func (c *Client) ChannelsV1GetLastKeyStates(ctx context.Context, req ChannelsV1G
 	return resp, nil
 }
 
+// ChannelsV1Subscribe subscribes the connection to channel events of a user.
+func (c *Client) ChannelsV1Subscribe(ctx context.Context, req ChannelsV1SubscribeRequest) (ChannelsV1SubscribeResponse, error) {
+	var resp ChannelsV1SubscribeResponse
+	if err := c.call(ctx, ChannelsV1SubscribeMethod, req, &resp); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// ChannelsV1Unsubscribe cancels the connection's subscription to channel events of a user.
+func (c *Client) ChannelsV1Unsubscribe(ctx context.Context, req ChannelsV1UnsubscribeRequest) (ChannelsV1UnsubscribeResponse, error) {
+	var resp ChannelsV1UnsubscribeResponse
+	if err := c.call(ctx, ChannelsV1UnsubscribeMethod, req, &resp); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
 // ============================================================================
 // App Sessions Group - V1 API Methods
 // ============================================================================
@@ -217,6 +317,24 @@ func (c *Client) AppSessionsV1GetLastKeyStates(ctx context.Context, req AppSessi
 	return resp, nil
 }
 
+// AppSessionsV1Subscribe subscribes the connection to app session events of a participant.
+func (c *Client) AppSessionsV1Subscribe(ctx context.Context, req AppSessionsV1SubscribeRequest) (AppSessionsV1SubscribeResponse, error) {
+	var resp AppSessionsV1SubscribeResponse
+	if err := c.call(ctx, AppSessionsV1SubscribeMethod, req, &resp); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// AppSessionsV1Unsubscribe cancels the connection's subscription to app session events of a participant.
+func (c *Client) AppSessionsV1Unsubscribe(ctx context.Context, req AppSessionsV1UnsubscribeRequest) (AppSessionsV1UnsubscribeResponse, error) {
+	var resp AppSessionsV1UnsubscribeResponse
+	if err := c.call(ctx, AppSessionsV1UnsubscribeMethod, req, &resp); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
 // ============================================================================
 // Apps Group - V1 API Methods
 // ============================================================================
@@ -239,6 +357,37 @@ func (c *Client) AppsV1SubmitAppVersion(ctx context.Context, req AppsV1SubmitApp
 	return resp, nil
 }
 
+// ============================================================================
+// Streams Group - V1 API Methods
+// ============================================================================
+
+// StreamsV1Create creates a payment stream authorized by the sender.
+func (c *Client) StreamsV1Create(ctx context.Context, req StreamsV1CreateRequest) (StreamsV1CreateResponse, error) {
+	var resp StreamsV1CreateResponse
+	if err := c.call(ctx, StreamsV1CreateMethod, req, &resp); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// StreamsV1Cancel stops a payment stream on behalf of its sender or recipient.
+func (c *Client) StreamsV1Cancel(ctx context.Context, req StreamsV1CancelRequest) (StreamsV1CancelResponse, error) {
+	var resp StreamsV1CancelResponse
+	if err := c.call(ctx, StreamsV1CancelMethod, req, &resp); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// StreamsV1Get retrieves payment streams by ID or by participating wallet.
+func (c *Client) StreamsV1Get(ctx context.Context, req StreamsV1GetRequest) (StreamsV1GetResponse, error) {
+	var resp StreamsV1GetResponse
+	if err := c.call(ctx, StreamsV1GetMethod, req, &resp); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
 // ============================================================================
 // User Group - V1 API Methods
 // ============================================================================
@@ -270,6 +419,29 @@ func (c *Client) UserV1GetActionAllowances(ctx context.Context, req UserV1GetAct
 	return resp, nil
 }
 
+// ============================================================================
+// Auth Group - V1 API Methods
+// ============================================================================
+
+// AuthV1RequestChallenge requests a challenge to authenticate the connection with.
+func (c *Client) AuthV1RequestChallenge(ctx context.Context) (AuthV1RequestChallengeResponse, error) {
+	req := AuthV1RequestChallengeRequest{}
+	var resp AuthV1RequestChallengeResponse
+	if err := c.call(ctx, AuthV1RequestChallengeMethod, req, &resp); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// AuthV1Authenticate binds the connection to a wallet using a signed challenge.
+func (c *Client) AuthV1Authenticate(ctx context.Context, req AuthV1AuthenticateRequest) (AuthV1AuthenticateResponse, error) {
+	var resp AuthV1AuthenticateResponse
+	if err := c.call(ctx, AuthV1AuthenticateMethod, req, &resp); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
 // ============================================================================
 // Node Group - V1 API Methods
 // ============================================================================
@@ -322,6 +494,10 @@ func (c *Client) call(ctx context.Context, method Method, reqParams any, respPar
 		return fmt.Errorf("rpc call failed: %w", err)
 	}
 
+	if err := c.verifyMessage(res, &req); err != nil {
+		return fmt.Errorf("response verification failed: %w", err)
+	}
+
 	if err := res.Error(); err != nil {
 		return fmt.Errorf("rpc returned error: %w", err)
 	}
@@ -332,3 +508,23 @@ func (c *Client) call(ctx context.Context, method Method, reqParams any, respPar
 
 	return nil
 }
+
+// verifyMessage checks the signature and the timestamp of a response to the request,
+// or of an event if the request is nil, according to the settings of VerifyResponses.
+func (c *Client) verifyMessage(msg *Message, request *Message) error {
+	c.verifyMu.RLock()
+	nodeAddress, strict := c.nodeAddress, c.strictVerify
+	c.verifyMu.RUnlock()
+
+	if nodeAddress == "" {
+		return nil
+	}
+
+	if err := msg.VerifySignature(nodeAddress, request); err != nil {
+		if errors.Is(err, ErrUnsignedMessage) && !strict {
+			return nil
+		}
+		return err
+	}
+	return msg.VerifyTimestamp(time.Now(), SignedMessageWindow)
+}
@@ -3,6 +3,7 @@ package rpc_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/layer-3/nitrolite/pkg/app"
 	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/layer-3/nitrolite/pkg/sign"
 )
 
 // Test helpers for V1 client
@@ -24,6 +26,7 @@ var (
 	testAssetV1    = "usdc"
 	testChannelID  = "ch123"
 	testAppSession = "app123"
+	testNodeKey    = "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
 )
 
 // setupClient creates a test V1 client with mock dialer
@@ -321,6 +324,106 @@ func TestClientV1_ChannelsV1SubmitState(t *testing.T) {
 	assert.Equal(t, "0xsig456", resp.Signature)
 }
 
+func TestClientV1_ChannelsV1SubmitSwap(t *testing.T) {
+	t.Parallel()
+
+	client, dialer := setupClient()
+
+	response := rpc.ChannelsV1SubmitSwapResponse{
+		Signature:             "0xsig456",
+		CounterpartySignature: "0xsig789",
+		SwapID:                "0xswap",
+	}
+
+	registerSimpleHandlerV1(dialer, "channels.v1.submit_swap", response)
+
+	resp, err := client.ChannelsV1SubmitSwap(testCtxV1, rpc.ChannelsV1SubmitSwapRequest{
+		State: rpc.StateV1{
+			ID:      "state123",
+			Version: "2",
+		},
+		CounterpartyState: rpc.StateV1{
+			ID:      "state456",
+			Version: "5",
+		},
+		Nonce: "7",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0xsig456", resp.Signature)
+	assert.Equal(t, "0xsig789", resp.CounterpartySignature)
+}
+
+func TestClientV1_ChannelsV1SubmitConditionalLock(t *testing.T) {
+	t.Parallel()
+
+	client, dialer := setupClient()
+
+	response := rpc.ChannelsV1SubmitConditionalLockResponse{
+		Signature:             "0xsig456",
+		ConditionalTransferID: "0xtransfer123",
+	}
+
+	registerSimpleHandlerV1(dialer, "channels.v1.submit_conditional_lock", response)
+
+	resp, err := client.ChannelsV1SubmitConditionalLock(testCtxV1, rpc.ChannelsV1SubmitConditionalLockRequest{
+		State: rpc.StateV1{
+			ID:      "state123",
+			Version: "2",
+		},
+		Receiver:  testWallet2V1,
+		HashLock:  "0xhash",
+		ExpiresAt: "1700000000",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0xsig456", resp.Signature)
+	assert.Equal(t, "0xtransfer123", resp.ConditionalTransferID)
+}
+
+func TestClientV1_ChannelsV1ClaimConditionalTransfer(t *testing.T) {
+	t.Parallel()
+
+	client, dialer := setupClient()
+
+	response := rpc.ChannelsV1ClaimConditionalTransferResponse{
+		State: rpc.StateV1{
+			ID:      "state456",
+			Version: "3",
+		},
+	}
+
+	registerSimpleHandlerV1(dialer, "channels.v1.claim_conditional_transfer", response)
+
+	resp, err := client.ChannelsV1ClaimConditionalTransfer(testCtxV1, rpc.ChannelsV1ClaimConditionalTransferRequest{
+		ConditionalTransferID: "0xtransfer123",
+		Preimage:              "0xpreimage",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "state456", resp.State.ID)
+	assert.Equal(t, "3", resp.State.Version)
+}
+
+func TestClientV1_ChannelsV1RefundConditionalTransfer(t *testing.T) {
+	t.Parallel()
+
+	client, dialer := setupClient()
+
+	response := rpc.ChannelsV1RefundConditionalTransferResponse{
+		State: rpc.StateV1{
+			ID:      "state789",
+			Version: "4",
+		},
+	}
+
+	registerSimpleHandlerV1(dialer, "channels.v1.refund_conditional_transfer", response)
+
+	resp, err := client.ChannelsV1RefundConditionalTransfer(testCtxV1, rpc.ChannelsV1RefundConditionalTransferRequest{
+		ConditionalTransferID: "0xtransfer123",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "state789", resp.State.ID)
+	assert.Equal(t, "4", resp.State.Version)
+}
+
 // ============================================================================
 // App Sessions Group Tests
 // ============================================================================
@@ -635,6 +738,88 @@ func TestClientV1_AppsV1SubmitAppVersion(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// ============================================================================
+// Streams Group Tests
+// ============================================================================
+
+func testPaymentStreamV1() rpc.PaymentStreamV1 {
+	return rpc.PaymentStreamV1{
+		PaymentStreamAuthorizationV1: rpc.PaymentStreamAuthorizationV1{
+			Sender:    testWalletV1,
+			Recipient: testWallet2V1,
+			Asset:     testAssetV1,
+			Rate:      "0.5",
+			Cap:       "100",
+			ExpiresAt: "1700003600",
+			Nonce:     "1",
+		},
+		ID:           "0xstream123",
+		Status:       "active",
+		StartsAt:     "1700000000",
+		EndsAt:       "1700003600",
+		Settled:      "0",
+		SettledUntil: "1700000000",
+		CreatedAt:    "1700000000",
+		UpdatedAt:    "1700000000",
+	}
+}
+
+func TestClientV1_StreamsV1Create(t *testing.T) {
+	t.Parallel()
+
+	client, dialer := setupClient()
+
+	stream := testPaymentStreamV1()
+	registerSimpleHandlerV1(dialer, rpc.StreamsV1CreateMethod.String(), rpc.StreamsV1CreateResponse{Stream: stream})
+
+	resp, err := client.StreamsV1Create(testCtxV1, rpc.StreamsV1CreateRequest{
+		Authorization: stream.PaymentStreamAuthorizationV1,
+		SenderSig:     "0xsig123",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0xstream123", resp.Stream.ID)
+	assert.Equal(t, testWalletV1, resp.Stream.Sender)
+	assert.Equal(t, testWallet2V1, resp.Stream.Recipient)
+	assert.Equal(t, "0.5", resp.Stream.Rate)
+	assert.Equal(t, "active", resp.Stream.Status)
+}
+
+func TestClientV1_StreamsV1Cancel(t *testing.T) {
+	t.Parallel()
+
+	client, dialer := setupClient()
+
+	stream := testPaymentStreamV1()
+	stream.Status = "cancelled"
+	stream.EndsAt = "1700000060"
+	registerSimpleHandlerV1(dialer, rpc.StreamsV1CancelMethod.String(), rpc.StreamsV1CancelResponse{Stream: stream})
+
+	resp, err := client.StreamsV1Cancel(testCtxV1, rpc.StreamsV1CancelRequest{
+		StreamID:  "0xstream123",
+		Signature: "0xsig123",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "cancelled", resp.Stream.Status)
+	assert.Equal(t, "1700000060", resp.Stream.EndsAt)
+}
+
+func TestClientV1_StreamsV1Get(t *testing.T) {
+	t.Parallel()
+
+	client, dialer := setupClient()
+
+	registerSimpleHandlerV1(dialer, rpc.StreamsV1GetMethod.String(), rpc.StreamsV1GetResponse{
+		Streams: []rpc.PaymentStreamV1{testPaymentStreamV1()},
+	})
+
+	wallet := testWalletV1
+	resp, err := client.StreamsV1Get(testCtxV1, rpc.StreamsV1GetRequest{Wallet: &wallet})
+	require.NoError(t, err)
+	require.Len(t, resp.Streams, 1)
+	assert.Equal(t, "0xstream123", resp.Streams[0].ID)
+	assert.Equal(t, "100", resp.Streams[0].Cap)
+}
+
 // ============================================================================
 // User Group Tests
 // ============================================================================
@@ -695,6 +880,151 @@ func TestClientV1_UserV1GetTransactions(t *testing.T) {
 	assert.Equal(t, "100", resp.Transactions[0].Amount)
 }
 
+// ============================================================================
+// Auth Group Tests
+// ============================================================================
+
+func TestClientV1_AuthV1RequestChallenge(t *testing.T) {
+	t.Parallel()
+
+	client, dialer := setupClient()
+
+	registerSimpleHandlerV1(dialer, "auth.v1.request_challenge", rpc.AuthV1RequestChallengeResponse{
+		Challenge: "0xchallenge",
+		ExpiresAt: "1700000000",
+	})
+
+	resp, err := client.AuthV1RequestChallenge(testCtxV1)
+	require.NoError(t, err)
+	assert.Equal(t, "0xchallenge", resp.Challenge)
+	assert.Equal(t, "1700000000", resp.ExpiresAt)
+}
+
+func TestClientV1_AuthV1Authenticate(t *testing.T) {
+	t.Parallel()
+
+	client, dialer := setupClient()
+
+	registerSimpleHandlerV1(dialer, "auth.v1.authenticate", rpc.AuthV1AuthenticateResponse{
+		Wallet: testWalletV1,
+	})
+
+	resp, err := client.AuthV1Authenticate(testCtxV1, rpc.AuthV1AuthenticateRequest{
+		Wallet:    testWalletV1,
+		Challenge: "0xchallenge",
+		Signature: "0xsig",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, testWalletV1, resp.Wallet)
+}
+
+// ============================================================================
+// Response Verification Tests
+// ============================================================================
+
+func TestClientV1_VerifyResponses(t *testing.T) {
+	t.Parallel()
+
+	signer, err := sign.NewEthereumMsgSigner(testNodeKey)
+	require.NoError(t, err)
+	nodeAddress := signer.PublicKey().Address().String()
+
+	otherSigner, err := sign.NewEthereumMsgSigner("0x" + strings.Repeat("11", 32))
+	require.NoError(t, err)
+
+	tcs := []struct {
+		name        string
+		signer      sign.Signer
+		nodeAddress string
+		strict      bool
+		modify      func(res *rpc.Message) error
+		expectedErr error
+	}{
+		{name: "verification disabled", signer: nil, nodeAddress: "", strict: true},
+		{name: "signed by the node", signer: signer, nodeAddress: nodeAddress, strict: true},
+		{name: "signed by another key", signer: otherSigner, nodeAddress: nodeAddress, strict: false, expectedErr: rpc.ErrInvalidSignature},
+		{name: "unsigned, non-strict", signer: nil, nodeAddress: nodeAddress, strict: false},
+		{name: "unsigned, strict", signer: nil, nodeAddress: nodeAddress, strict: true, expectedErr: rpc.ErrUnsignedMessage},
+		{
+			name:        "replayed response to another request",
+			signer:      signer,
+			nodeAddress: nodeAddress,
+			strict:      true,
+			modify: func(res *rpc.Message) error {
+				otherRequest := rpc.NewRequest(res.RequestID, rpc.NodeV1PingMethod.String(), nil)
+				otherRequest.Timestamp--
+				return res.Sign(signer, &otherRequest)
+			},
+			expectedErr: rpc.ErrInvalidSignature,
+		},
+		{
+			name:        "stale response",
+			signer:      signer,
+			nodeAddress: nodeAddress,
+			strict:      true,
+			modify: func(res *rpc.Message) error {
+				res.Timestamp -= uint64(2 * rpc.SignedMessageWindow.Milliseconds())
+				return nil
+			},
+			expectedErr: rpc.ErrStaleMessage,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			client, dialer := setupClient()
+			dialer.SignResponses(tc.signer)
+			dialer.RegisterHandler(rpc.NodeV1PingMethod, func(params rpc.Payload, publish MockNotificationPublisher) (*rpc.Message, error) {
+				res, err := createResponseV1(rpc.NodeV1PingMethod.String(), rpc.NodeV1PingResponse{})
+				if err != nil || tc.modify == nil {
+					return res, err
+				}
+				return res, tc.modify(res)
+			})
+			client.VerifyResponses(tc.nodeAddress, tc.strict)
+
+			err := client.NodeV1Ping(testCtxV1)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClientV1_VerifyEvent(t *testing.T) {
+	t.Parallel()
+
+	signer, err := sign.NewEthereumMsgSigner(testNodeKey)
+	require.NoError(t, err)
+	nodeAddress := signer.PublicKey().Address().String()
+
+	newEvent := func(t *testing.T, request *rpc.Message) *rpc.Message {
+		event := rpc.NewEvent(0, rpc.ChannelsV1ChannelUpdatedEventName.String(), nil)
+		require.NoError(t, event.Sign(signer, request))
+		return &event
+	}
+
+	client, _ := setupClient()
+	assert.NoError(t, client.VerifyEvent(&rpc.Message{}), "events are not verified by default")
+
+	client.VerifyResponses(nodeAddress, true)
+	assert.NoError(t, client.VerifyEvent(newEvent(t, nil)))
+
+	unsigned := rpc.NewEvent(0, rpc.ChannelsV1ChannelUpdatedEventName.String(), nil)
+	assert.ErrorIs(t, client.VerifyEvent(&unsigned), rpc.ErrUnsignedMessage)
+
+	// A response can't be passed off as an event
+	request := rpc.NewRequest(1, rpc.NodeV1PingMethod.String(), nil)
+	assert.ErrorIs(t, client.VerifyEvent(newEvent(t, &request)), rpc.ErrInvalidSignature)
+
+	stale := rpc.NewEvent(0, rpc.ChannelsV1ChannelUpdatedEventName.String(), nil)
+	stale.Timestamp -= uint64(2 * rpc.SignedMessageWindow.Milliseconds())
+	require.NoError(t, stale.Sign(signer, nil))
+	assert.ErrorIs(t, client.VerifyEvent(&stale), rpc.ErrStaleMessage)
+}
+
 // ============================================================================
 // Error Handling Tests
 // ============================================================================
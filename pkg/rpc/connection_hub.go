@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 )
 
@@ -19,12 +20,18 @@ type ObserveConnectionsFn func(region, origin string, count uint32)
 //   - User-to-connection mapping for authenticated sessions
 //   - Automatic cleanup of auth mappings when connections close
 //   - Support for re-authentication (updating user associations)
-//   - Broadcast capabilities to all connections for a specific user
+//   - Topic subscriptions with broadcast to all subscribed connections
 type ConnectionHub struct {
 	// connections maps connection IDs to RPCConnection instances
 	connections map[string]Connection
 	// authMapping maps UserIDs to their active connections.
 	authMapping map[string]map[string]bool
+	// connUsers maps connection IDs to the UserID they are authenticated as.
+	connUsers map[string]string
+	// subscriptions maps topics to the IDs of subscribed connections.
+	subscriptions map[string]map[string]bool
+	// connTopics maps connection IDs to the topics they are subscribed to.
+	connTopics map[string]map[string]bool
 	// mu protects concurrent access to the maps
 	mu sync.RWMutex
 
@@ -41,6 +48,9 @@ func NewConnectionHub(observeConnections ObserveConnectionsFn) *ConnectionHub {
 	return &ConnectionHub{
 		connections:        make(map[string]Connection),
 		authMapping:        make(map[string]map[string]bool),
+		connUsers:          make(map[string]string),
+		subscriptions:      make(map[string]map[string]bool),
+		connTopics:         make(map[string]map[string]bool),
 		sourceMap:          make(map[string]uint32),
 		observeConnections: observeConnections,
 	}
@@ -96,8 +106,8 @@ func (hub *ConnectionHub) Get(connID string) Connection {
 // Remove unregisters a connection from the hub.
 // This method:
 //   - Removes the connection from the main connection map
-//   - Cleans up any user-to-connection mappings
-//   - Removes empty user entries to prevent memory leaks
+//   - Cleans up any user-to-connection mappings and topic subscriptions
+//   - Removes empty user and topic entries to prevent memory leaks
 //
 // If the connection doesn't exist, this method does nothing (no-op).
 // This method is safe for concurrent access.
@@ -110,6 +120,11 @@ func (hub *ConnectionHub) Remove(connID string) {
 		return // No connection to remove
 	}
 	delete(hub.connections, connID)
+	hub.removeAuthMapping(connID)
+
+	for topic := range hub.connTopics[connID] {
+		hub.removeSubscription(connID, topic)
+	}
 
 	sourceID := getSourceID(conn.Origin())
 	if count, exists := hub.sourceMap[sourceID]; exists && count > 0 {
@@ -121,27 +136,139 @@ func (hub *ConnectionHub) Remove(connID string) {
 	hub.observeConnections(defaultConnectionRegion, conn.Origin(), uint32(hub.sourceMap[sourceID]))
 }
 
-// Publish broadcasts a message to all active connections for a specific user.
-// This enables server-initiated notifications to be sent to all of a user's
-// connected clients (e.g., multiple browser tabs or devices).
+// Authenticate associates the connection with a user. If the connection was
+// authenticated as another user before, the previous association is replaced.
+// An empty userID removes the association. User IDs are matched case-insensitively.
+//
+// Returns an error if the connection is not registered with the hub.
+// This method is safe for concurrent access.
+func (hub *ConnectionHub) Authenticate(connID, userID string) error {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	if _, exists := hub.connections[connID]; !exists {
+		return fmt.Errorf("connection with ID %s does not exist", connID)
+	}
+
+	hub.removeAuthMapping(connID)
+	if userID == "" {
+		return nil
+	}
+
+	userID = strings.ToLower(userID)
+	if hub.authMapping[userID] == nil {
+		hub.authMapping[userID] = make(map[string]bool)
+	}
+	hub.authMapping[userID][connID] = true
+	hub.connUsers[connID] = userID
+
+	return nil
+}
+
+// UserConnections returns the IDs of all connections authenticated as the user.
+// This method is safe for concurrent access.
+func (hub *ConnectionHub) UserConnections(userID string) []string {
+	hub.mu.RLock()
+	defer hub.mu.RUnlock()
+
+	connIDs := make([]string, 0, len(hub.authMapping[strings.ToLower(userID)]))
+	for connID := range hub.authMapping[strings.ToLower(userID)] {
+		connIDs = append(connIDs, connID)
+	}
+	return connIDs
+}
+
+// removeAuthMapping deletes the user association of the connection and drops empty user entries.
+// The caller must hold the write lock.
+func (hub *ConnectionHub) removeAuthMapping(connID string) {
+	userID, ok := hub.connUsers[connID]
+	if !ok {
+		return
+	}
+	delete(hub.connUsers, connID)
+
+	if connIDs, ok := hub.authMapping[userID]; ok {
+		delete(connIDs, connID)
+		if len(connIDs) == 0 {
+			delete(hub.authMapping, userID)
+		}
+	}
+}
+
+// Subscribe registers a connection to receive messages published to the topic.
+// Subscribing to a topic the connection is already subscribed to is a no-op.
+//
+// Returns an error if the connection is not registered with the hub.
+// This method is safe for concurrent access.
+func (hub *ConnectionHub) Subscribe(connID, topic string) error {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	if _, exists := hub.connections[connID]; !exists {
+		return fmt.Errorf("connection with ID %s does not exist", connID)
+	}
+
+	if hub.subscriptions[topic] == nil {
+		hub.subscriptions[topic] = make(map[string]bool)
+	}
+	hub.subscriptions[topic][connID] = true
+
+	if hub.connTopics[connID] == nil {
+		hub.connTopics[connID] = make(map[string]bool)
+	}
+	hub.connTopics[connID][topic] = true
+
+	return nil
+}
+
+// Unsubscribe removes the connection's subscription to the topic.
+// If the connection is not subscribed to the topic, this method does nothing (no-op).
+// This method is safe for concurrent access.
+func (hub *ConnectionHub) Unsubscribe(connID, topic string) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	hub.removeSubscription(connID, topic)
+}
+
+// removeSubscription deletes the subscription from both indexes and drops empty entries.
+// The caller must hold the write lock.
+func (hub *ConnectionHub) removeSubscription(connID, topic string) {
+	if connIDs, ok := hub.subscriptions[topic]; ok {
+		delete(connIDs, connID)
+		if len(connIDs) == 0 {
+			delete(hub.subscriptions, topic)
+		}
+	}
+
+	if topics, ok := hub.connTopics[connID]; ok {
+		delete(topics, topic)
+		if len(topics) == 0 {
+			delete(hub.connTopics, connID)
+		}
+	}
+}
+
+// Publish broadcasts a message to all active connections subscribed to a topic.
+// This enables server-initiated notifications to be sent to all clients interested
+// in a topic (e.g., multiple browser tabs or devices tracking the same wallet).
 //
 // The method:
-//   - Looks up all connections associated with the user
+//   - Looks up all connections subscribed to the topic
 //   - Attempts to send the message to each connection
 //   - Silently skips any connections that fail to accept the message
 //
-// If the user has no active connections, the message is silently dropped.
+// If the topic has no subscribers, the message is silently dropped.
 // This method is safe for concurrent access.
-// TODO: refine with subscription topics capability
-func (hub *ConnectionHub) Publish(userID string, response []byte) {
+func (hub *ConnectionHub) Publish(topic string, response []byte) {
 	hub.mu.RLock()
 	defer hub.mu.RUnlock()
-	connIDs, ok := hub.authMapping[userID]
+	connIDs, ok := hub.subscriptions[topic]
 	if !ok {
 		return
 	}
 
-	// Iterate over all connections for this user and send the message
+	// Iterate over all subscribed connections and send the message
 	for connID := range connIDs {
 		conn := hub.connections[connID]
 		if conn == nil {
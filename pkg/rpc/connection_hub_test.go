@@ -1,135 +1,178 @@
 package rpc_test
 
-// TODO: refine with subscription topics capability
-// func TestConnectionHub(t *testing.T) {
-// 	t.Parallel()
-
-// 	hub := rpc.NewConnectionHub()
-// 	var err error
-
-// 	userID1 := "user1"
-// 	userID2 := "user2"
-
-// 	// Add connections
-// 	connID1 := "conn1"
-// 	conn1 := newMockConnection(connID1, userID1)
-// 	err = hub.Add(conn1)
-// 	require.NoError(t, err)
-
-// 	connID2 := "conn2"
-// 	conn2 := newMockConnection(connID2, userID1)
-// 	err = hub.Add(conn2)
-// 	require.NoError(t, err)
-
-// 	connID3 := "conn3"
-// 	conn3 := newMockConnection(connID3, userID2)
-// 	err = hub.Add(conn3)
-// 	require.NoError(t, err)
-
-// 	err = hub.Add(conn1) // Duplicate
-// 	require.Equal(t, "connection with ID conn1 already exists", err.Error())
-
-// 	// Verify connections
-// 	assert.Equal(t, conn1, hub.Get(connID1))
-// 	assert.Equal(t, conn2, hub.Get(connID2))
-// 	assert.Equal(t, conn3, hub.Get(connID3))
-
-// 	// Publish to user1
-// 	message1 := []byte("message for user1")
-// 	hub.Publish(userID1, message1)
-
-// 	// Both user1 connections should receive
-// 	require.Equal(t, message1, conn1.getLastResponse())
-// 	require.Equal(t, message1, conn2.getLastResponse())
-
-// 	// user2 should not receive
-// 	assert.Empty(t, conn3.getLastResponse())
-
-// 	// Remove one connection for user1
-// 	hub.Remove(connID1)
-// 	assert.Nil(t, hub.Get(connID1))
-
-// 	// Publish again
-// 	message2 := []byte("second message")
-// 	hub.Publish(userID2, message2)
-
-// 	// Only conn2 should receive now
-// 	require.Equal(t, message2, conn2.getLastResponse())
-// 	require.Equal(t, message2, conn3.getLastResponse())
-// 	require.NotEqual(t, message2, conn1.getLastResponse())
-
-// 	// Remove all connections
-// 	hub.Remove(connID2)
-// 	hub.Remove(connID3)
-// }
-
-// type mockConnection struct {
-// 	connectionID string
-// 	userID       string
-
-// 	rawRequests   chan []byte
-// 	lastResponse  []byte
-// 	handleClosure func(error)
-// 	mu            sync.RWMutex
-// }
-
-// func newMockConnection(connID, userID string) *mockConnection {
-// 	return &mockConnection{
-// 		connectionID: connID,
-// 		userID:       userID,
-// 		rawRequests:  make(chan []byte, 10),
-// 	}
-// }
-
-// func (mc *mockConnection) ConnectionID() string {
-// 	return mc.connectionID
-// }
-
-// func (mc *mockConnection) UserID() string {
-// 	mc.mu.RLock()
-// 	defer mc.mu.RUnlock()
-
-// 	return mc.userID
-// }
-
-// func (mc *mockConnection) SetUserID(userID string) {
-// 	mc.mu.Lock()
-// 	defer mc.mu.Unlock()
-
-// 	mc.userID = userID
-// }
-
-// func (mc *mockConnection) RawRequests() <-chan []byte {
-// 	return mc.rawRequests
-// }
-
-// func (mc *mockConnection) WriteRawResponse(response []byte) bool {
-// 	mc.mu.Lock()
-// 	defer mc.mu.Unlock()
-
-// 	mc.lastResponse = response
-// 	return true
-// }
-
-// func (mc *mockConnection) getLastResponse() []byte {
-// 	mc.mu.RLock()
-// 	defer mc.mu.RUnlock()
-
-// 	return mc.lastResponse
-// }
-
-// func (mc *mockConnection) Serve(_ context.Context, handleClosure func(error)) {
-// 	mc.mu.Lock()
-// 	defer mc.mu.Unlock()
-
-// 	mc.handleClosure = handleClosure
-// }
-
-// func (mc *mockConnection) closeWithError(err error) {
-// 	mc.mu.RLock()
-// 	defer mc.mu.RUnlock()
-
-// 	if mc.handleClosure != nil {
-// 		mc.handleClosure(err)
-// 	}
-// }
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+func TestConnectionHub(t *testing.T) {
+	t.Parallel()
+
+	hub := rpc.NewConnectionHub(func(region, origin string, count uint32) {})
+	var err error
+
+	topic1 := "channels.v1:0xuser1"
+	topic2 := "channels.v1:0xuser2"
+
+	// Add connections
+	connID1 := "conn1"
+	conn1 := newMockConnection(connID1)
+	err = hub.Add(conn1)
+	require.NoError(t, err)
+
+	connID2 := "conn2"
+	conn2 := newMockConnection(connID2)
+	err = hub.Add(conn2)
+	require.NoError(t, err)
+
+	connID3 := "conn3"
+	conn3 := newMockConnection(connID3)
+	err = hub.Add(conn3)
+	require.NoError(t, err)
+
+	err = hub.Add(conn1) // Duplicate
+	require.Equal(t, "connection with ID conn1 already exists", err.Error())
+
+	// Verify connections
+	assert.Equal(t, conn1, hub.Get(connID1))
+	assert.Equal(t, conn2, hub.Get(connID2))
+	assert.Equal(t, conn3, hub.Get(connID3))
+
+	// Subscribe connections to topics
+	require.NoError(t, hub.Subscribe(connID1, topic1))
+	require.NoError(t, hub.Subscribe(connID2, topic1))
+	require.NoError(t, hub.Subscribe(connID2, topic2))
+	require.NoError(t, hub.Subscribe(connID3, topic2))
+
+	err = hub.Subscribe("unknown", topic1)
+	require.Equal(t, "connection with ID unknown does not exist", err.Error())
+
+	// Publish to topic1
+	message1 := []byte("message for topic1")
+	hub.Publish(topic1, message1)
+
+	// Both topic1 subscribers should receive
+	require.Equal(t, message1, conn1.getLastResponse())
+	require.Equal(t, message1, conn2.getLastResponse())
+
+	// topic2-only subscriber should not receive
+	assert.Empty(t, conn3.getLastResponse())
+
+	// Unsubscribe conn2 from topic1
+	hub.Unsubscribe(connID2, topic1)
+
+	message2 := []byte("second message for topic1")
+	hub.Publish(topic1, message2)
+	require.Equal(t, message2, conn1.getLastResponse())
+	require.Equal(t, message1, conn2.getLastResponse())
+
+	// Remove conn1, its subscriptions should be dropped
+	hub.Remove(connID1)
+	assert.Nil(t, hub.Get(connID1))
+
+	message3 := []byte("third message for topic1")
+	hub.Publish(topic1, message3)
+	require.Equal(t, message2, conn1.getLastResponse())
+
+	// Publish to topic2
+	message4 := []byte("message for topic2")
+	hub.Publish(topic2, message4)
+	require.Equal(t, message4, conn2.getLastResponse())
+	require.Equal(t, message4, conn3.getLastResponse())
+
+	// Publishing to a topic without subscribers is a no-op
+	hub.Publish("unknown", []byte("dropped"))
+	require.Equal(t, message4, conn2.getLastResponse())
+
+	// Remove all connections
+	hub.Remove(connID2)
+	hub.Remove(connID3)
+}
+
+func TestConnectionHub_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	hub := rpc.NewConnectionHub(func(region, origin string, count uint32) {})
+
+	conn1 := newMockConnection("conn1")
+	require.NoError(t, hub.Add(conn1))
+	conn2 := newMockConnection("conn2")
+	require.NoError(t, hub.Add(conn2))
+
+	err := hub.Authenticate("unknown", "0xUser1")
+	require.Equal(t, "connection with ID unknown does not exist", err.Error())
+
+	// Both connections authenticate as the same user
+	require.NoError(t, hub.Authenticate("conn1", "0xUser1"))
+	require.NoError(t, hub.Authenticate("conn2", "0xuser1"))
+	assert.ElementsMatch(t, []string{"conn1", "conn2"}, hub.UserConnections("0xUSER1"))
+
+	// Re-authentication moves the connection to the new user
+	require.NoError(t, hub.Authenticate("conn2", "0xUser2"))
+	assert.ElementsMatch(t, []string{"conn1"}, hub.UserConnections("0xuser1"))
+	assert.ElementsMatch(t, []string{"conn2"}, hub.UserConnections("0xuser2"))
+
+	// An empty user ID drops the association
+	require.NoError(t, hub.Authenticate("conn2", ""))
+	assert.Empty(t, hub.UserConnections("0xuser2"))
+
+	// Removing the connection drops its association
+	hub.Remove("conn1")
+	assert.Empty(t, hub.UserConnections("0xuser1"))
+}
+
+type mockConnection struct {
+	connectionID string
+
+	rawRequests   chan []byte
+	lastResponse  []byte
+	handleClosure func(error)
+	mu            sync.RWMutex
+}
+
+func newMockConnection(connID string) *mockConnection {
+	return &mockConnection{
+		connectionID: connID,
+		rawRequests:  make(chan []byte, 10),
+	}
+}
+
+func (mc *mockConnection) ConnectionID() string {
+	return mc.connectionID
+}
+
+func (mc *mockConnection) Origin() string {
+	return ""
+}
+
+func (mc *mockConnection) RawRequests() <-chan []byte {
+	return mc.rawRequests
+}
+
+func (mc *mockConnection) WriteRawResponse(response []byte) bool {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	mc.lastResponse = response
+	return true
+}
+
+func (mc *mockConnection) getLastResponse() []byte {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	return mc.lastResponse
+}
+
+func (mc *mockConnection) Serve(_ context.Context, handleClosure func(error)) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	mc.handleClosure = handleClosure
+}
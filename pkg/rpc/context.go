@@ -32,6 +32,11 @@ type SendResponseFunc func(method string, params Payload)
 type Context struct {
 	// Context is the standard Go context for the request
 	Context context.Context
+	// ConnectionID is the unique identifier of the connection the request was received on
+	ConnectionID string
+	// UserID is the wallet the connection is authenticated as, empty if it is not authenticated.
+	// Handlers may set it to (re-)authenticate the connection.
+	UserID string
 	// Request is the original RPC request message
 	Request Message
 	// Response is the response message to be sent back to the client
@@ -247,6 +247,10 @@
 //	http.Handle("/ws", node)
 //	http.ListenAndServe(":8080", nil)
 //
+// HTTPNode serves the same handlers over plain HTTP request/response calls, with
+// HTTPDialer as the matching client transport. Server push is not available over
+// HTTP, so subscriptions fail with ErrPushNotSupported.
+//
 // Writing handlers:
 //
 //	func handleGetBalances(c *rpc.Context) {
@@ -27,10 +27,24 @@ var (
 	ErrNoResponse           = fmt.Errorf("no response received")
 	ErrSendingPing          = fmt.Errorf("error sending ping")
 
+	// Signature errors
+	ErrUnsignedMessage  = fmt.Errorf("message is not signed")
+	ErrInvalidSignature = fmt.Errorf("invalid message signature")
+	ErrStaleMessage     = fmt.Errorf("message timestamp is outside the accepted window")
+
 	// WebSocket-specific errors
 	ErrDialingWebsocket = fmt.Errorf("error dialing websocket server")
+
+	// HTTP-specific errors
+	ErrUnexpectedHTTPStatus = fmt.Errorf("unexpected HTTP status")
+	ErrReadingResponse      = fmt.Errorf("error reading response")
 )
 
+// ErrPushNotSupported is returned by transports that cannot deliver server-initiated
+// events, such as HTTP, when a subscription is requested. It is an Error, so
+// its message is passed on to the client in the RPC response.
+var ErrPushNotSupported = Errorf("server push is not supported by this transport, use the WebSocket endpoint to subscribe to events")
+
 // Error represents an error in the RPC protocol that should be sent back to the client
 // in the RPC response. Unlike generic errors, Error messages are guaranteed to be
 // included in the error response sent to the client.
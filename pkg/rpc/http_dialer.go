@@ -0,0 +1,156 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// HTTPDialerConfig contains configuration options for the HTTP dialer
+type HTTPDialerConfig struct {
+	// RequestTimeout is the maximum duration of a single RPC call,
+	// applied in addition to the deadline of the call context
+	RequestTimeout time.Duration
+
+	// HTTPClient is the client used to send requests (default: a new http.Client)
+	HTTPClient *http.Client
+}
+
+// DefaultHTTPDialerConfig provides sensible defaults for HTTP calls
+var DefaultHTTPDialerConfig = HTTPDialerConfig{
+	RequestTimeout: 30 * time.Second,
+}
+
+// HTTPDialer implements the Dialer interface over plain HTTP request/response calls
+// to an HTTPNode. Every Call is an independent HTTP POST request, so no connection is
+// kept open: Dial only records the endpoint URL, and the dialer stays "connected"
+// until the Dial context is cancelled.
+//
+// The HTTP transport cannot receive server-initiated events. The event channel
+// never receives messages and is closed once the dialer is disconnected.
+type HTTPDialer struct {
+	cfg     HTTPDialerConfig
+	url     string
+	ctx     context.Context
+	eventCh chan *Message
+	mu      sync.RWMutex // Protects url, ctx and eventCh
+}
+
+// Ensure HTTPDialer implements the Dialer interface
+var _ Dialer = (*HTTPDialer)(nil)
+
+// NewHTTPDialer creates a new HTTP dialer with the given configuration
+func NewHTTPDialer(cfg HTTPDialerConfig) *HTTPDialer {
+	if cfg.RequestTimeout <= 0 {
+		cfg.RequestTimeout = DefaultHTTPDialerConfig.RequestTimeout
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{}
+	}
+	return &HTTPDialer{
+		cfg:     cfg,
+		eventCh: make(chan *Message),
+	}
+}
+
+// Dial sets the URL of the HTTPNode endpoint that calls are sent to.
+// No request is made, so an unreachable endpoint is only reported by Call.
+// The handleClosure callback is invoked once the context is cancelled.
+//
+// Example:
+//
+//	dialer := NewHTTPDialer(DefaultHTTPDialerConfig)
+//	err := dialer.Dial(ctx, "https://clearnode.example.com/rpc", func(err error) {})
+func (d *HTTPDialer) Dial(ctx context.Context, url string, handleClosure func(err error)) error {
+	if d.IsConnected() {
+		return ErrAlreadyConnected
+	}
+
+	eventCh := make(chan *Message)
+
+	d.mu.Lock()
+	d.url = url
+	d.ctx = ctx
+	d.eventCh = eventCh
+	d.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		close(eventCh)
+		handleClosure(nil)
+	}()
+
+	return nil
+}
+
+// IsConnected returns true if Dial was called and its context is not cancelled yet
+func (d *HTTPDialer) IsConnected() bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.ctx != nil && d.ctx.Err() == nil
+}
+
+// Call sends the RPC request as the body of an HTTP POST request and returns
+// the RPC response Message from the response body.
+// The method is thread-safe and can be called concurrently.
+func (d *HTTPDialer) Call(ctx context.Context, req *Message) (*Message, error) {
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	d.mu.RLock()
+	url, connCtx := d.url, d.ctx
+	d.mu.RUnlock()
+	if connCtx == nil || connCtx.Err() != nil {
+		return nil, ErrNotConnected
+	}
+
+	reqJSON, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMarshalingRequest, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.RequestTimeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(reqJSON))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSendingRequest, err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpRes, err := d.cfg.HTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSendingRequest, err)
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(httpRes.Body, 512))
+		return nil, fmt.Errorf("%w %d: %s", ErrUnexpectedHTTPStatus, httpRes.StatusCode, bytes.TrimSpace(body))
+	}
+
+	var res Message
+	if err := json.NewDecoder(httpRes.Body).Decode(&res); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrReadingResponse, err)
+	}
+	if res.RequestID != req.RequestID {
+		return nil, fmt.Errorf("%w for request %d: got response to request %d", ErrNoResponse, req.RequestID, res.RequestID)
+	}
+	return &res, nil
+}
+
+// EventCh returns a channel that never receives events, as the HTTP transport
+// does not support server push. The channel is closed when the dialer disconnects.
+func (d *HTTPDialer) EventCh() <-chan *Message {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.eventCh
+}
@@ -0,0 +1,382 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/sign"
+)
+
+var (
+	_ Node         = &HTTPNode{}
+	_ http.Handler = &HTTPNode{}
+
+	_ HandlerGroup = &HTTPHandlerGroup{}
+)
+
+// NotifyFn delivers a server-initiated event concerning a user.
+type NotifyFn func(userID string, event Event, params Payload)
+
+// HTTPNode implements the Node interface over plain HTTP request/response calls.
+// Every HTTP POST request carries a single RPC request Message in its body and
+// receives the RPC response Message in the response body, using the same encoding
+// as the WebSocket transport. Handlers, groups and middleware registered on an
+// HTTPNode behave exactly as on a WebsocketNode.
+//
+// HTTP has no persistent connection the server could push messages to:
+//   - Subscribe always fails with ErrPushNotSupported
+//   - Notify drops events, unless NotifyFn is configured to deliver them
+//     through another transport
+//
+// Each request gets its own connection ID and an empty Context Storage, so no
+// state set by handlers, such as authentication challenges, is shared between
+// requests. Only the ClientIPStorageKeys, e.g. the rate limiter state, are kept
+// per client IP address, and only when TrustedProxies is set, so that the
+// address is read from the X-Forwarded-For header of the proxies in front of the
+// node. They are discarded once the client stays idle for StorageIdleTimeout.
+//
+// Requests are never authenticated: Context.UserID is always empty, and
+// authentication set by handlers is not carried over to later requests.
+type HTTPNode struct {
+	// cfg contains configuration for the node
+	cfg HTTPNodeConfig
+	// groupId identifies this node's handler group (defaults to "group.root")
+	groupId string
+	// handlerChain maps handler IDs to their middleware/handler chains
+	handlerChain map[string][]Handler
+	// routes maps RPC method names to their handler chain path (e.g., ["group.root", "group.private", "method"])
+	routes map[string][]string
+
+	// trustedProxies are the parsed TrustedProxies
+	trustedProxies []netip.Prefix
+	// storages keeps the per-client storage, keyed by the client IP address
+	storages   map[string]*httpClientStorage
+	storagesMu sync.Mutex
+	lastSweep  time.Time
+}
+
+// httpClientStorage is the storage of a client along with the time of its last request.
+type httpClientStorage struct {
+	storage  *SafeStorage
+	lastSeen time.Time
+}
+
+// HTTPNodeConfig contains all configuration options for creating an HTTPNode.
+// The only required field is Logger; all others have sensible defaults.
+type HTTPNodeConfig struct {
+	// Logger is used for structured logging throughout the node (required).
+	Logger log.Logger
+
+	// NotifyFn receives the events passed to Notify, e.g. to deliver them to the
+	// subscribers of a WebsocketNode serving the same handlers. Events are dropped by default.
+	NotifyFn NotifyFn
+
+	// Signer signs every response sent by the node, if set. Responses are not signed by default.
+	Signer sign.Signer
+
+	// MaxRequestBodySize is the maximum size of a request body in bytes (default: 1MB).
+	MaxRequestBodySize int64
+	// StorageIdleTimeout is the time after which the storage of an idle client is discarded (default: 10m).
+	StorageIdleTimeout time.Duration
+
+	// TrustedProxies are the IP addresses or CIDR ranges of the proxies in front of the node,
+	// whose X-Forwarded-For header is used to find the client IP address.
+	// Client IP storage is disabled unless set.
+	TrustedProxies []string
+	// ClientIPStorageKeys are the Context Storage keys kept across the requests of the same
+	// client IP address, e.g. the rate limiter state. All other keys are per request.
+	ClientIPStorageKeys []string
+}
+
+// NewHTTPNode creates a new HTTPNode instance with the provided configuration.
+//
+// Required configuration:
+//   - Logger: Used for structured logging
+//
+// Returns an error if required configuration is missing.
+func NewHTTPNode(config HTTPNodeConfig) (*HTTPNode, error) {
+	if config.Logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+	config.Logger = config.Logger.WithName("rpc-http-node")
+
+	if config.NotifyFn == nil {
+		config.NotifyFn = func(userID string, event Event, params Payload) {}
+	}
+	if config.MaxRequestBodySize <= 0 {
+		config.MaxRequestBodySize = 1 << 20
+	}
+	if config.StorageIdleTimeout <= 0 {
+		config.StorageIdleTimeout = 10 * time.Minute
+	}
+
+	trustedProxies := make([]netip.Prefix, 0, len(config.TrustedProxies))
+	for _, proxy := range config.TrustedProxies {
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(proxy)
+			if addrErr != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+			}
+			prefix = netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen())
+		}
+		trustedProxies = append(trustedProxies, prefix.Masked())
+	}
+
+	return &HTTPNode{
+		cfg:            config,
+		groupId:        nodeGroupHandlerPrefix + nodeGroupRoot,
+		handlerChain:   make(map[string][]Handler),
+		routes:         make(map[string][]string),
+		trustedProxies: trustedProxies,
+		storages:       make(map[string]*httpClientStorage),
+	}, nil
+}
+
+// ServeHTTP implements http.Handler. It accepts POST requests whose body is an
+// RPC request Message, runs the handler chain of the requested method and writes
+// the RPC response Message as JSON.
+//
+// RPC-level failures (unknown method, handler errors, malformed messages) are
+// reported as error responses with status 200, like on the WebSocket transport.
+// Only transport-level failures use HTTP error statuses.
+func (hn *HTTPNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	messageBytes, err := io.ReadAll(http.MaxBytesReader(w, r.Body, hn.cfg.MaxRequestBodySize))
+	if err != nil {
+		http.Error(w, "failed to read request body", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	req := Message{}
+	if err := json.Unmarshal(messageBytes, &req); err != nil {
+		hn.cfg.Logger.Debug("invalid message format", "error", err, "message", string(messageBytes))
+		hn.writeResponse(w, req, NewErrorResponse(req.RequestID, "", "invalid message format"))
+		return
+	}
+
+	routeHandlers := resolveRouteHandlers(hn.routes, hn.handlerChain, req.Method)
+	if len(routeHandlers) == 0 {
+		hn.cfg.Logger.Debug("no handlers' route found for method", "method", req.Method)
+		hn.writeResponse(w, req, NewErrorResponse(req.RequestID, req.Method, fmt.Sprintf("unknown method: %s", req.Method)))
+		return
+	}
+
+	ctx := &Context{
+		Context:      r.Context(),
+		ConnectionID: uuid.NewString(),
+		Request:      req,
+		handlers:     routeHandlers,
+		Storage:      NewSafeStorage(),
+	}
+
+	var clientStorage *SafeStorage
+	if len(hn.trustedProxies) > 0 && len(hn.cfg.ClientIPStorageKeys) > 0 {
+		clientStorage = hn.clientStorage(hn.clientIP(r))
+		copyStorageKeys(ctx.Storage, clientStorage, hn.cfg.ClientIPStorageKeys)
+	}
+
+	ctx.Next() // Start processing the handlers
+
+	if clientStorage != nil {
+		copyStorageKeys(clientStorage, ctx.Storage, hn.cfg.ClientIPStorageKeys)
+	}
+
+	hn.writeResponse(w, req, ctx.Response)
+}
+
+// writeResponse writes the RPC response Message to the request as the JSON body of the HTTP response.
+func (hn *HTTPNode) writeResponse(w http.ResponseWriter, req Message, res Message) {
+	responseBytes, err := marshalMessage(res, &req, hn.cfg.Signer)
+	if err != nil {
+		hn.cfg.Logger.Error("failed to marshal response", "error", err, "method", res.Method)
+		responseBytes, _ = json.Marshal(NewErrorResponse(res.RequestID, res.Method, defaultNodeErrorMessage))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(responseBytes); err != nil {
+		hn.cfg.Logger.Debug("failed to write response", "error", err, "method", res.Method)
+	}
+}
+
+// clientStorage returns the storage of the client, creating it if needed.
+// Storages of idle clients are swept at most once per StorageIdleTimeout.
+func (hn *HTTPNode) clientStorage(clientKey string) *SafeStorage {
+	hn.storagesMu.Lock()
+	defer hn.storagesMu.Unlock()
+
+	now := time.Now()
+	if now.Sub(hn.lastSweep) >= hn.cfg.StorageIdleTimeout {
+		for key, entry := range hn.storages {
+			if now.Sub(entry.lastSeen) >= hn.cfg.StorageIdleTimeout {
+				delete(hn.storages, key)
+			}
+		}
+		hn.lastSweep = now
+	}
+
+	entry, ok := hn.storages[clientKey]
+	if !ok {
+		entry = &httpClientStorage{storage: NewSafeStorage()}
+		hn.storages[clientKey] = entry
+	}
+	entry.lastSeen = now
+	return entry.storage
+}
+
+// NewGroup creates a new handler group with the specified name.
+// See WebsocketNode.NewGroup for details.
+func (hn *HTTPNode) NewGroup(name string) HandlerGroup {
+	return &HTTPHandlerGroup{
+		groupId:     nodeGroupHandlerPrefix + name,
+		routePrefix: []string{hn.groupId},
+		root:        hn,
+	}
+}
+
+// Handle registers a handler function for the specified RPC method.
+// The handler executes after all global middleware registered with Use().
+//
+// Panics if:
+//   - method is empty
+//   - handler is nil
+func (hn *HTTPNode) Handle(method string, handler Handler) {
+	hn.handle(method, handler)
+	hn.routes[method] = []string{hn.groupId, method}
+}
+
+// handle is the internal method for registering handlers.
+// It validates inputs and stores the handler in the handler chain.
+func (hn *HTTPNode) handle(method string, handler Handler) {
+	if method == "" {
+		panic("HTTP method cannot be empty")
+	}
+	if handler == nil {
+		panic(fmt.Sprintf("HTTP handler cannot be nil for method %s", method))
+	}
+
+	hn.handlerChain[method] = []Handler{handler}
+}
+
+// Use adds global middleware that executes for all requests,
+// in the order it was registered, before any method-specific handlers.
+func (hn *HTTPNode) Use(middleware Handler) {
+	hn.use(hn.groupId, middleware)
+}
+
+// use is the internal method for adding middleware to a specific group.
+func (hn *HTTPNode) use(groupId string, middleware Handler) {
+	if middleware == nil {
+		panic("HTTP middleware handler cannot be nil for group")
+	}
+
+	hn.handlerChain[groupId] = append(hn.handlerChain[groupId], middleware)
+}
+
+// Notify passes the event to the configured NotifyFn.
+// HTTP clients cannot receive server-initiated events.
+func (hn *HTTPNode) Notify(userID string, event Event, params Payload) {
+	hn.cfg.NotifyFn(userID, event, params)
+}
+
+// Subscribe always returns ErrPushNotSupported, as HTTP clients cannot receive events.
+func (hn *HTTPNode) Subscribe(connID string, group Group, userID string) error {
+	return ErrPushNotSupported
+}
+
+// Unsubscribe does nothing, as there are no subscriptions on the HTTP transport.
+func (hn *HTTPNode) Unsubscribe(connID string, group Group, userID string) {}
+
+// copyStorageKeys sets the given keys of dst to their values in src, if present.
+func copyStorageKeys(dst, src *SafeStorage, keys []string) {
+	for _, key := range keys {
+		if value, ok := src.Get(key); ok {
+			dst.Set(key, value)
+		}
+	}
+}
+
+// clientIP returns the IP address of the client that sent the request.
+// The X-Forwarded-For header is followed back from the closest hop while it is appended
+// by a trusted proxy; addresses listed before the first untrusted hop may be forged by the client.
+func (hn *HTTPNode) clientIP(r *http.Request) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0 && hn.isTrustedProxy(ip); i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		ip = hop
+	}
+	return ip
+}
+
+// isTrustedProxy reports whether the IP address belongs to one of the TrustedProxies.
+func (hn *HTTPNode) isTrustedProxy(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range hn.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// HTTPHandlerGroup implements the HandlerGroup interface for an HTTPNode.
+// It has the same semantics as WebsocketHandlerGroup.
+type HTTPHandlerGroup struct {
+	// groupId is the unique identifier for this group
+	groupId string
+	// routePrefix contains the chain of group IDs leading to this group
+	routePrefix []string
+	// root is a reference to the Node this group belongs to
+	root *HTTPNode
+}
+
+// NewGroup creates a nested handler group within this group.
+// The nested group inherits the middleware chain from all parent groups.
+func (hg *HTTPHandlerGroup) NewGroup(name string) HandlerGroup {
+	return &HTTPHandlerGroup{
+		groupId:     fmt.Sprintf("%s.%s", hg.groupId, name),
+		routePrefix: append(append([]string{}, hg.routePrefix...), hg.groupId),
+		root:        hg.root,
+	}
+}
+
+// Handle registers a handler for the specified RPC method within this group.
+// The method parameter must be unique across the entire node.
+func (hg *HTTPHandlerGroup) Handle(method string, handler Handler) {
+	hg.root.handle(method, handler)
+	hg.root.routes[method] = append(append([]string{}, hg.routePrefix...), hg.groupId, method)
+}
+
+// Use adds middleware to this handler group and any nested groups.
+//
+// Panics if middleware is nil.
+func (hg *HTTPHandlerGroup) Use(middleware Handler) {
+	hg.root.use(hg.groupId, middleware)
+}
@@ -0,0 +1,301 @@
+package rpc_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/layer-3/nitrolite/pkg/sign"
+)
+
+func newTestHTTPNode(t *testing.T, notifyFn rpc.NotifyFn) (*rpc.HTTPNode, *httptest.Server) {
+	t.Helper()
+
+	node, err := rpc.NewHTTPNode(rpc.HTTPNodeConfig{
+		Logger:   log.NewNoopLogger(),
+		NotifyFn: notifyFn,
+	})
+	require.NoError(t, err)
+
+	server := httptest.NewServer(node)
+	t.Cleanup(server.Close)
+	return node, server
+}
+
+func newTestHTTPClient(t *testing.T, url string) (*rpc.Client, *rpc.HTTPDialer) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	dialer := rpc.NewHTTPDialer(rpc.DefaultHTTPDialerConfig)
+	client := rpc.NewClient(dialer)
+	require.NoError(t, client.Start(ctx, url, func(err error) {}))
+	return client, dialer
+}
+
+func TestNewHTTPNode(t *testing.T) {
+	t.Parallel()
+
+	_, err := rpc.NewHTTPNode(rpc.HTTPNodeConfig{})
+	require.EqualError(t, err, "logger cannot be nil")
+}
+
+func TestHTTPNode_RoundTrip(t *testing.T) {
+	t.Parallel()
+	node, server := newTestHTTPNode(t, nil)
+
+	var calls []string
+	node.Use(func(c *rpc.Context) {
+		calls = append(calls, "global")
+		c.Next()
+	})
+	nodeGroup := node.NewGroup(rpc.NodeV1Group.String())
+	nodeGroup.Use(func(c *rpc.Context) {
+		calls = append(calls, "group")
+		c.Next()
+	})
+	nodeGroup.Handle(rpc.NodeV1PingMethod.String(), func(c *rpc.Context) {
+		calls = append(calls, "handler")
+		payload, err := rpc.NewPayload(rpc.NodeV1PingResponse{})
+		require.NoError(t, err)
+		c.Succeed(c.Request.Method, payload)
+	})
+
+	client, _ := newTestHTTPClient(t, server.URL)
+	require.NoError(t, client.NodeV1Ping(context.Background()))
+	assert.Equal(t, []string{"global", "group", "handler"}, calls)
+}
+
+func TestHTTPNode_SignedResponses(t *testing.T) {
+	t.Parallel()
+
+	signer, err := sign.NewEthereumMsgSigner(testNodeKey)
+	require.NoError(t, err)
+
+	node, err := rpc.NewHTTPNode(rpc.HTTPNodeConfig{
+		Logger: log.NewNoopLogger(),
+		Signer: signer,
+	})
+	require.NoError(t, err)
+	node.Handle(rpc.NodeV1PingMethod.String(), func(c *rpc.Context) {
+		c.Succeed(c.Request.Method, nil)
+	})
+	server := httptest.NewServer(node)
+	t.Cleanup(server.Close)
+
+	client, _ := newTestHTTPClient(t, server.URL)
+	client.VerifyResponses(signer.PublicKey().Address().String(), true)
+	require.NoError(t, client.NodeV1Ping(context.Background()))
+
+	// Error responses are signed as well
+	_, err = client.NodeV1GetConfig(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown method")
+
+	client.VerifyResponses("0x0000000000000000000000000000000000000001", true)
+	assert.ErrorIs(t, client.NodeV1Ping(context.Background()), rpc.ErrInvalidSignature)
+}
+
+func TestHTTPNode_UnknownMethod(t *testing.T) {
+	t.Parallel()
+	_, server := newTestHTTPNode(t, nil)
+
+	client, _ := newTestHTTPClient(t, server.URL)
+	err := client.NodeV1Ping(context.Background())
+	require.ErrorContains(t, err, "unknown method: node.v1.ping")
+}
+
+func TestHTTPNode_InvalidRequests(t *testing.T) {
+	t.Parallel()
+	_, server := newTestHTTPNode(t, nil)
+
+	res, err := http.Get(server.URL)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+
+	res, err = http.Post(server.URL, "application/json", bytes.NewBufferString("not json"))
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var msg rpc.Message
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&msg))
+	assert.Equal(t, rpc.MsgTypeRespErr, msg.Type)
+	require.EqualError(t, msg.Error(), "invalid message format")
+}
+
+func TestHTTPNode_Push(t *testing.T) {
+	t.Parallel()
+
+	var notified []string
+	node, server := newTestHTTPNode(t, func(userID string, event rpc.Event, params rpc.Payload) {
+		notified = append(notified, userID+":"+event.String())
+	})
+	node.Handle(rpc.ChannelsV1SubscribeMethod.String(), func(c *rpc.Context) {
+		err := node.Subscribe(c.ConnectionID, rpc.ChannelV1Group, "0xUser")
+		require.True(t, errors.Is(err, rpc.ErrPushNotSupported))
+		c.Fail(err, "failed to subscribe")
+	})
+
+	client, _ := newTestHTTPClient(t, server.URL)
+	_, err := client.ChannelsV1Subscribe(context.Background(), rpc.ChannelsV1SubscribeRequest{Wallet: "0xUser"})
+	require.ErrorContains(t, err, "server push is not supported")
+
+	node.Notify("0xUser", rpc.ChannelsV1ChannelUpdatedEventName, rpc.Payload{})
+	assert.Equal(t, []string{"0xUser:" + rpc.ChannelsV1ChannelUpdatedEventName.String()}, notified)
+}
+
+func TestHTTPNode_StoragePerRequest(t *testing.T) {
+	t.Parallel()
+	node, server := newTestHTTPNode(t, nil)
+
+	var connIDs []string
+	node.Handle(rpc.NodeV1PingMethod.String(), func(c *rpc.Context) {
+		connIDs = append(connIDs, c.ConnectionID)
+		if _, ok := c.Storage.Get("challenge"); ok {
+			c.Fail(rpc.Errorf("storage shared between requests"), "")
+			return
+		}
+		c.Storage.Set("challenge", "value")
+		c.Succeed(c.Request.Method, rpc.Payload{})
+	})
+
+	client, _ := newTestHTTPClient(t, server.URL)
+	require.NoError(t, client.NodeV1Ping(context.Background()))
+	require.NoError(t, client.NodeV1Ping(context.Background()))
+
+	// Requests share neither the storage nor the connection ID
+	require.Len(t, connIDs, 2)
+	assert.NotEqual(t, connIDs[0], connIDs[1])
+}
+
+func TestHTTPNode_ClientIPStorage(t *testing.T) {
+	t.Parallel()
+
+	newNode := func(t *testing.T, trustedProxies []string) *rpc.HTTPNode {
+		node, err := rpc.NewHTTPNode(rpc.HTTPNodeConfig{
+			Logger:              log.NewNoopLogger(),
+			TrustedProxies:      trustedProxies,
+			ClientIPStorageKeys: []string{"count"},
+		})
+		require.NoError(t, err)
+
+		node.Handle(rpc.NodeV1PingMethod.String(), func(c *rpc.Context) {
+			count := 0
+			if val, ok := c.Storage.Get("count"); ok {
+				count = val.(int)
+			}
+			c.Storage.Set("count", count+1)
+
+			payload, err := rpc.NewPayload(map[string]int{"count": count})
+			require.NoError(t, err)
+			c.Succeed(c.Request.Method, payload)
+		})
+		return node
+	}
+
+	// serve sends a ping and returns the number of previous pings seen from the same client
+	serve := func(t *testing.T, node *rpc.HTTPNode, remoteAddr, forwardedFor string) int {
+		body, err := json.Marshal(rpc.NewRequest(1, rpc.NodeV1PingMethod.String(), rpc.Payload{}))
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+		req.RemoteAddr = remoteAddr
+		if forwardedFor != "" {
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+		rec := httptest.NewRecorder()
+		node.ServeHTTP(rec, req)
+
+		var msg rpc.Message
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&msg))
+		require.NoError(t, msg.Error())
+		var res struct {
+			Count int `json:"count"`
+		}
+		require.NoError(t, msg.Payload.Translate(&res))
+		return res.Count
+	}
+
+	t.Run("clients behind trusted proxies", func(t *testing.T) {
+		t.Parallel()
+		node := newNode(t, []string{"10.0.0.0/8", "192.0.2.1"})
+
+		assert.Equal(t, 0, serve(t, node, "10.0.0.1:1000", "203.0.113.1"))
+		assert.Equal(t, 1, serve(t, node, "10.0.0.2:1000", "203.0.113.1"))
+		assert.Equal(t, 2, serve(t, node, "192.0.2.1:1000", "203.0.113.1, 10.0.0.3"), "chained trusted proxies")
+		assert.Equal(t, 0, serve(t, node, "10.0.0.1:1000", "203.0.113.2"), "another client behind the same proxy")
+		assert.Equal(t, 0, serve(t, node, "10.0.0.1:1000", "203.0.113.1, 198.51.100.1"), "hops before an untrusted one are ignored")
+		assert.Equal(t, 0, serve(t, node, "198.51.100.2:1000", "203.0.113.1"), "headers of untrusted peers are ignored")
+		assert.Equal(t, 1, serve(t, node, "198.51.100.2:1000", ""))
+	})
+
+	t.Run("without trusted proxies", func(t *testing.T) {
+		t.Parallel()
+		node := newNode(t, nil)
+
+		assert.Equal(t, 0, serve(t, node, "198.51.100.2:1000", ""))
+		assert.Equal(t, 0, serve(t, node, "198.51.100.2:1000", ""))
+	})
+
+	t.Run("invalid trusted proxy", func(t *testing.T) {
+		t.Parallel()
+		_, err := rpc.NewHTTPNode(rpc.HTTPNodeConfig{
+			Logger:         log.NewNoopLogger(),
+			TrustedProxies: []string{"proxy.internal"},
+		})
+		require.ErrorContains(t, err, `invalid trusted proxy "proxy.internal"`)
+	})
+}
+
+func TestHTTPDialer(t *testing.T) {
+	t.Parallel()
+
+	dialer := rpc.NewHTTPDialer(rpc.DefaultHTTPDialerConfig)
+	req := rpc.NewRequest(1, rpc.NodeV1PingMethod.String(), rpc.Payload{})
+
+	_, err := dialer.Call(context.Background(), &req)
+	require.ErrorIs(t, err, rpc.ErrNotConnected)
+	_, err = dialer.Call(context.Background(), nil)
+	require.ErrorIs(t, err, rpc.ErrNilRequest)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bad gateway", http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	closed := make(chan struct{})
+	require.NoError(t, dialer.Dial(ctx, server.URL, func(err error) {
+		assert.NoError(t, err)
+		close(closed)
+	}))
+	assert.True(t, dialer.IsConnected())
+	require.ErrorIs(t, dialer.Dial(ctx, server.URL, func(err error) {}), rpc.ErrAlreadyConnected)
+
+	_, err = dialer.Call(context.Background(), &req)
+	require.ErrorIs(t, err, rpc.ErrUnexpectedHTTPStatus)
+	require.ErrorContains(t, err, "502: bad gateway")
+
+	cancel()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("closure handler was not called")
+	}
+	_, ok := <-dialer.EventCh()
+	assert.False(t, ok)
+	assert.False(t, dialer.IsConnected())
+}
@@ -5,6 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/layer-3/nitrolite/pkg/sign"
 )
 
 type MsgType uint8
@@ -37,6 +41,9 @@ func (t MsgType) String() string {
 // Messages are encoded as JSON arrays for compact transmission:
 // [Type, RequestID, Method, Params, Timestamp]
 //
+// Signed messages carry the signature as an additional sixth element:
+// [Type, RequestID, Method, Params, Timestamp, Signature]
+//
 // This encoding reduces message size while maintaining human readability
 // and allows for efficient parsing. The array format is automatically
 // handled by the custom JSON marshaling methods.
@@ -62,6 +69,11 @@ type Message struct {
 	// This is used for replay protection and request expiration checks.
 	// Servers should validate that timestamps are within an acceptable time window.
 	Timestamp uint64 `json:"ts"`
+
+	// Signature is the Node's signature over SigningData, empty if the message is not signed.
+	// Nodes configured with a Signer sign every response and notification they send,
+	// responses together with the hash of the request they respond to.
+	Signature sign.Signature `json:"sig,omitempty"`
 }
 
 // NewMessage creates a new Message with the given request ID, type, method, and parameters.
@@ -187,15 +199,99 @@ func (r Message) Error() error {
 	return r.Payload.Error()
 }
 
+// Hash returns the keccak256 hash of the compact array encoding of the message without the Signature element.
+func (p Message) Hash() ([]byte, error) {
+	p.Signature = nil
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(data), nil
+}
+
+// SigningData returns the bytes covered by the message signature: the compact array encoding
+// of the message without the Signature element, followed by the Hash of the request the message
+// responds to. Binding responses to their request prevents a signed response from being replayed
+// as the response to another request. The request is nil for events, which respond to no request.
+func (p Message) SigningData(request *Message) ([]byte, error) {
+	p.Signature = nil
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return data, nil
+	}
+
+	requestHash, err := request.Hash()
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash request: %w", err)
+	}
+	return append(data, requestHash...), nil
+}
+
+// Sign signs the SigningData of the message with the signer and stores the signature in the message.
+// The request is the one the message responds to, nil for events.
+func (p *Message) Sign(signer sign.Signer, request *Message) error {
+	data, err := p.SigningData(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message for signing: %w", err)
+	}
+
+	sig, err := signer.Sign(data)
+	if err != nil {
+		return fmt.Errorf("failed to sign message: %w", err)
+	}
+
+	p.Signature = sig
+	return nil
+}
+
+// VerifySignature checks that the message is signed by the address as the response to the request,
+// or as an event if the request is nil. The signature is expected to be an Ethereum personal message
+// signature, as produced by sign.EthereumMsgSigner.
+//
+// Returns ErrUnsignedMessage if the message carries no signature.
+func (p Message) VerifySignature(address string, request *Message) error {
+	if len(p.Signature) == 0 {
+		return ErrUnsignedMessage
+	}
+
+	data, err := p.SigningData(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message for verification: %w", err)
+	}
+
+	validator, err := sign.NewSigValidator(sign.TypeEthereumMsg)
+	if err != nil {
+		return err
+	}
+	if err := validator.Verify(address, data, p.Signature); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	return nil
+}
+
+// VerifyTimestamp checks that the message was created at most window away from now.
+// Returns ErrStaleMessage otherwise.
+func (p Message) VerifyTimestamp(now time.Time, window time.Duration) error {
+	created := time.UnixMilli(int64(p.Timestamp))
+	if diff := now.Sub(created); diff > window || diff < -window {
+		return fmt.Errorf("%w: created at %s", ErrStaleMessage, created.UTC().Format(time.RFC3339Nano))
+	}
+	return nil
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface for Message.
-// It expects data in the compact array format: [Type, RequestID, Method, Params, Timestamp]
+// It expects data in the compact array format: [Type, RequestID, Method, Params, Timestamp],
+// optionally followed by the Signature.
 //
 // This custom unmarshaling ensures backward compatibility with the array-based
 // protocol format while providing a clean struct-based API for Go code.
 //
 // The method validates that:
 // - The input is a valid JSON array
-// - The array contains exactly 5 elements
+// - The array contains 5 elements, or 6 if the message is signed
 // - Each element has the correct type
 //
 // Returns an error if the JSON format is invalid or any element has the wrong type.
@@ -204,8 +300,8 @@ func (p *Message) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &rawArr); err != nil {
 		return fmt.Errorf("error reading RPCData as array: %w", err)
 	}
-	if len(rawArr) != 5 {
-		return errors.New("invalid RPCData: expected 5 elements in array")
+	if len(rawArr) != 5 && len(rawArr) != 6 {
+		return errors.New("invalid RPCData: expected 5 or 6 elements in array")
 	}
 
 	// Element 0: uint8 Type - Message type (request, response, or event)
@@ -233,11 +329,20 @@ func (p *Message) UnmarshalJSON(data []byte) error {
 		return fmt.Errorf("invalid timestamp: %w", err)
 	}
 
+	// Element 5 (optional): Signature - Hex-encoded signature over the first 5 elements
+	p.Signature = nil
+	if len(rawArr) == 6 {
+		if err := json.Unmarshal(rawArr[5], &p.Signature); err != nil {
+			return fmt.Errorf("invalid signature: %w", err)
+		}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements the json.Marshaler interface for Message.
-// It always emits the compact array format: [Type, RequestID, Method, Params, Timestamp]
+// It always emits the compact array format: [Type, RequestID, Method, Params, Timestamp],
+// followed by the Signature if the message is signed.
 //
 // This ensures consistent wire format regardless of how the Message struct
 // is modified in the future, maintaining protocol compatibility.
@@ -246,13 +351,17 @@ func (p *Message) UnmarshalJSON(data []byte) error {
 //
 //	[1, 12345, "wallet_transfer", {"to": "0xabc", "amount": "100"}, 1634567890123]
 func (p Message) MarshalJSON() ([]byte, error) {
-	return json.Marshal([]any{
+	arr := []any{
 		p.Type,
 		p.RequestID,
 		p.Method,
 		p.Payload,
 		p.Timestamp,
-	})
+	}
+	if len(p.Signature) > 0 {
+		arr = append(arr, p.Signature)
+	}
+	return json.Marshal(arr)
 }
 
 // Payload represents method-specific parameters as a map of JSON raw messages.
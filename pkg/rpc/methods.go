@@ -1,22 +1,30 @@
 // Package rpc provides common method and event type definitions.
 package rpc
 
+import "strings"
+
 // Method represents an RPC method name that can be called on the server.
 type Group string
 type Method string
 
 const (
 	// Channels Group - V1 Methods
-	ChannelV1Group                        Group  = "channels.v1"
-	ChannelsV1GetHomeChannelMethod        Method = "channels.v1.get_home_channel"
-	ChannelsV1GetEscrowChannelMethod      Method = "channels.v1.get_escrow_channel"
-	ChannelsV1GetChannelsMethod           Method = "channels.v1.get_channels"
-	ChannelsV1GetLatestStateMethod        Method = "channels.v1.get_latest_state"
-	ChannelsV1GetStatesMethod             Method = "channels.v1.get_states"
-	ChannelsV1RequestCreationMethod       Method = "channels.v1.request_creation"
-	ChannelsV1SubmitStateMethod           Method = "channels.v1.submit_state"
-	ChannelsV1SubmitSessionKeyStateMethod Method = "channels.v1.submit_session_key_state"
-	ChannelsV1GetLastKeyStatesMethod      Method = "channels.v1.get_last_key_states"
+	ChannelV1Group                            Group  = "channels.v1"
+	ChannelsV1GetHomeChannelMethod            Method = "channels.v1.get_home_channel"
+	ChannelsV1GetEscrowChannelMethod          Method = "channels.v1.get_escrow_channel"
+	ChannelsV1GetChannelsMethod               Method = "channels.v1.get_channels"
+	ChannelsV1GetLatestStateMethod            Method = "channels.v1.get_latest_state"
+	ChannelsV1GetStatesMethod                 Method = "channels.v1.get_states"
+	ChannelsV1RequestCreationMethod           Method = "channels.v1.request_creation"
+	ChannelsV1SubmitStateMethod               Method = "channels.v1.submit_state"
+	ChannelsV1SubmitSwapMethod                Method = "channels.v1.submit_swap"
+	ChannelsV1SubmitConditionalLockMethod     Method = "channels.v1.submit_conditional_lock"
+	ChannelsV1ClaimConditionalTransferMethod  Method = "channels.v1.claim_conditional_transfer"
+	ChannelsV1RefundConditionalTransferMethod Method = "channels.v1.refund_conditional_transfer"
+	ChannelsV1SubmitSessionKeyStateMethod     Method = "channels.v1.submit_session_key_state"
+	ChannelsV1GetLastKeyStatesMethod          Method = "channels.v1.get_last_key_states"
+	ChannelsV1SubscribeMethod                 Method = "channels.v1.subscribe"
+	ChannelsV1UnsubscribeMethod               Method = "channels.v1.unsubscribe"
 
 	// App Sessions Group - V1 Methods
 	AppSessionsV1Group                       Group  = "app_sessions.v1"
@@ -28,18 +36,31 @@ const (
 	AppSessionsV1CreateAppSessionMethod      Method = "app_sessions.v1.create_app_session"
 	AppSessionsV1SubmitSessionKeyStateMethod Method = "app_sessions.v1.submit_session_key_state"
 	AppSessionsV1GetLastKeyStatesMethod      Method = "app_sessions.v1.get_last_key_states"
+	AppSessionsV1SubscribeMethod             Method = "app_sessions.v1.subscribe"
+	AppSessionsV1UnsubscribeMethod           Method = "app_sessions.v1.unsubscribe"
 
 	// Apps Group - V1 Methods
 	AppsV1Group                  Group  = "apps.v1"
 	AppsV1GetAppsMethod          Method = "apps.v1.get_apps"
 	AppsV1SubmitAppVersionMethod Method = "apps.v1.submit_app_version"
 
+	// Streams Group - V1 Methods
+	StreamsV1Group        Group  = "streams.v1"
+	StreamsV1CreateMethod Method = "streams.v1.create"
+	StreamsV1CancelMethod Method = "streams.v1.cancel"
+	StreamsV1GetMethod    Method = "streams.v1.get"
+
 	// User Group - V1 Methods
 	UserV1Group                     Group  = "user.v1"
 	UserV1GetBalancesMethod         Method = "user.v1.get_balances"
 	UserV1GetTransactionsMethod     Method = "user.v1.get_transactions"
 	UserV1GetActionAllowancesMethod Method = "user.v1.get_action_allowances"
 
+	// Auth Group - V1 Methods
+	AuthV1Group                  Group  = "auth.v1"
+	AuthV1RequestChallengeMethod Method = "auth.v1.request_challenge"
+	AuthV1AuthenticateMethod     Method = "auth.v1.authenticate"
+
 	// Node Group - V1 Methods
 	NodeV1Group           Group  = "node.v1"
 	NodeV1PingMethod      Method = "node.v1.ping"
@@ -61,9 +82,29 @@ func (g Group) String() string {
 // Events are unsolicited notifications sent to connected clients.
 type Event string
 
-const ()
+const (
+	// Channels Group - V1 Events
+	ChannelsV1ChannelUpdatedEventName             Event = "channels.v1.channel_updated"
+	ChannelsV1TransferReceivedEventName           Event = "channels.v1.transfer_received"
+	ChannelsV1BlockchainActionCompletedEventName  Event = "channels.v1.blockchain_action_completed"
+	ChannelsV1ConditionalTransferUpdatedEventName Event = "channels.v1.conditional_transfer_updated"
+
+	// App Sessions Group - V1 Events
+	AppSessionsV1AppSessionUpdatedEventName Event = "app_sessions.v1.app_session_updated"
+)
 
 // String returns the string representation of the event.
 func (e Event) String() string {
 	return string(e)
 }
+
+// Group returns the group the event belongs to, e.g. "channels.v1" for "channels.v1.channel_updated".
+// Clients subscribe to events on a per-group basis.
+func (e Event) Group() Group {
+	s := string(e)
+	idx := strings.LastIndex(s, ".")
+	if idx < 0 {
+		return ""
+	}
+	return Group(s[:idx])
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/layer-3/nitrolite/pkg/sign"
 )
 
 // MockCallHandler is a function type that handles RPC calls in the mock dialer.
@@ -26,6 +27,8 @@ type MockDialer struct {
 	handlers map[rpc.Method]MockCallHandler
 	// eventCh is the channel for publishing notifications to the client
 	eventCh chan *rpc.Message
+	// signer signs the responses to the requests, if set
+	signer sign.Signer
 }
 
 // NewMockDialer creates a new mock dialer for testing.
@@ -43,6 +46,12 @@ func (d *MockDialer) RegisterHandler(method rpc.Method, handler MockCallHandler)
 	d.handlers[method] = handler
 }
 
+// SignResponses makes the dialer sign the responses of the handlers with the signer
+// as the responses to the requests they answer.
+func (d *MockDialer) SignResponses(signer sign.Signer) {
+	d.signer = signer
+}
+
 // Dial is a no-op for the mock dialer since no actual connection is made.
 // It simulates an always-connected state.
 func (d *MockDialer) Dial(ctx context.Context, url string, handleClosure func(err error)) error {
@@ -77,6 +86,11 @@ func (d *MockDialer) Call(ctx context.Context, req *rpc.Message) (*rpc.Message,
 		return &res, nil
 	}
 
+	if d.signer != nil && len(res.Signature) == 0 {
+		if err := res.Sign(d.signer, req); err != nil {
+			return nil, err
+		}
+	}
 	return res, nil
 }
 
@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/sign"
 )
 
 const (
@@ -41,10 +43,19 @@ type Node interface {
 	// the handler will be invoked with the request context.
 	Handle(method string, handler Handler)
 
-	// Notify sends a server-initiated notification to a specific user.
-	// All active connections for the user will receive the notification.
-	// If the user has no active connections, the notification is dropped.
-	Notify(userID string, method string, params Payload)
+	// Notify sends a server-initiated event concerning a specific user.
+	// All connections subscribed to the user's events of the event group
+	// will receive the notification. If there are no such subscriptions,
+	// the notification is dropped.
+	Notify(userID string, event Event, params Payload)
+
+	// Subscribe registers the connection to receive events of the group
+	// concerning the specified user.
+	Subscribe(connID string, group Group, userID string) error
+
+	// Unsubscribe removes the connection's subscription to events of the
+	// group concerning the specified user.
+	Unsubscribe(connID string, group Group, userID string)
 
 	// Use adds global middleware that will be executed for all requests.
 	// Middleware is executed in the order it was added, before any
@@ -76,7 +87,7 @@ var (
 //   - WebSocket connection management with automatic cleanup
 //   - Request routing based on method names
 //   - Middleware support at global and group levels
-//   - Cryptographic signing of all responses
+//   - Optional cryptographic signing of all responses and notifications
 //   - Connection authentication and re-authentication
 //   - Server-initiated notifications to specific users
 //   - Configurable timeouts and buffer sizes
@@ -108,6 +119,11 @@ type WebsocketNodeConfig struct {
 	// Logger is used for structured logging throughout the node (required).
 	Logger log.Logger
 
+	// Signer signs every response and notification sent by the node, if set.
+	// Clients verify the signatures against the node address (see Message.VerifySignature),
+	// so it should be an Ethereum message signer of the node's key. Messages are not signed by default.
+	Signer sign.Signer
+
 	// Connection lifecycle callbacks:
 	// ObserveConnections is called with the current number of active connections whenever a connection is established or closed.
 	ObserveConnections ObserveConnectionsFn
@@ -258,7 +274,7 @@ func (wn *WebsocketNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 //  2. Unmarshals and validates incoming requests
 //  3. Looks up the appropriate handler chain for the method
 //  4. Creates a Context with the request and executes the handler chain
-//  5. Sends the signed response back to the client
+//  5. Sends the response back to the client, signed if a Signer is configured
 //  6. Handles re-authentication if the UserID changes
 //
 // The method runs until the connection closes or the context is cancelled.
@@ -267,6 +283,7 @@ func (wn *WebsocketNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 func (wn *WebsocketNode) processRequests(conn Connection, parentCtx context.Context, handleClosure func(error)) {
 	defer handleClosure(nil) // Stop other goroutines when done
 	safeStorage := NewSafeStorage()
+	userID := ""
 
 	for {
 		var messageBytes []byte
@@ -283,41 +300,39 @@ func (wn *WebsocketNode) processRequests(conn Connection, parentCtx context.Cont
 		req := Message{}
 		if err := json.Unmarshal(messageBytes, &req); err != nil {
 			wn.cfg.Logger.Debug("invalid message format", "error", err, "message", string(messageBytes))
-			wn.sendErrorResponse(conn, req.RequestID, "", "invalid message format")
-			continue
-		}
-
-		methodRoute, ok := wn.routes[req.Method]
-		if !ok || len(methodRoute) == 0 {
-			wn.cfg.Logger.Debug("no handlers' route found for method", "method", req.Method)
-			wn.sendErrorResponse(conn, req.RequestID, req.Method, fmt.Sprintf("unknown method: %s", req.Method))
+			wn.sendErrorResponse(conn, req, "", "invalid message format")
 			continue
 		}
 
-		var routeHandlers []Handler
-		for _, handlersId := range methodRoute {
-			handlers := wn.handlerChain[handlersId]
-			if len(handlers) > 0 {
-				routeHandlers = append(routeHandlers, handlers...)
-			}
-		}
+		routeHandlers := resolveRouteHandlers(wn.routes, wn.handlerChain, req.Method)
 		if len(routeHandlers) == 0 {
-			wn.sendErrorResponse(conn, req.RequestID, req.Method, fmt.Sprintf("unknown method: %s", req.Method))
+			wn.cfg.Logger.Debug("no handlers' route found for method", "method", req.Method)
+			wn.sendErrorResponse(conn, req, req.Method, fmt.Sprintf("unknown method: %s", req.Method))
 			continue
 		}
 
 		ctx := &Context{
-			Context:  parentCtx,
-			Request:  req,
-			handlers: routeHandlers,
-			Storage:  safeStorage,
+			Context:      parentCtx,
+			ConnectionID: conn.ConnectionID(),
+			UserID:       userID,
+			Request:      req,
+			handlers:     routeHandlers,
+			Storage:      safeStorage,
 		}
 		ctx.Next() // Start processing the handlers
 
+		if ctx.UserID != userID {
+			if err := wn.connHub.Authenticate(conn.ConnectionID(), ctx.UserID); err != nil {
+				wn.cfg.Logger.Error("failed to update connection authentication", "error", err, "connectionID", conn.ConnectionID())
+			} else {
+				userID = ctx.UserID
+			}
+		}
+
 		// Marshal the response
-		responseBytes, err := json.Marshal(ctx.Response)
+		responseBytes, err := marshalMessage(ctx.Response, &req, wn.cfg.Signer)
 		if err != nil {
-			wn.sendErrorResponse(conn, req.RequestID, req.Method, defaultNodeErrorMessage)
+			wn.sendErrorResponse(conn, req, req.Method, defaultNodeErrorMessage)
 			wn.cfg.Logger.Error("failed to marshal response", "error", err, "method", req.Method)
 			continue
 		}
@@ -411,32 +426,46 @@ func (wn *WebsocketNode) use(groupId string, middleware Handler) {
 	wn.handlerChain[groupId] = append(wn.handlerChain[groupId], middleware)
 }
 
-// Notify sends a server-initiated notification to all connections of a specific user.
-// This enables the server to push updates to clients without a prior request.
-// Common use cases include:
+// Notify sends a server-initiated notification to all connections subscribed
+// to the events of a specific user. This enables the server to push updates
+// to clients without a prior request. Common use cases include:
 //   - Balance updates after transactions
 //   - Status changes in long-running operations
 //   - Real-time notifications for user events
 //
-// The notification is sent to all active connections for the user.
-// If the user has no active connections, the notification is silently dropped.
+// The notification is sent to all connections subscribed to the event group for the user.
+// If there are no such subscriptions, the notification is silently dropped.
 //
 // Notifications have RequestID=0 to distinguish them from responses.
-func (wn *WebsocketNode) Notify(userID, method string, params Payload) {
-	// message, err := prepareRawNotification(method, params)
-	// if err != nil {
-	// 	wn.cfg.Logger.Error("failed to prepare notification message", "error", err, "userID", userID, "method", method)
-	// 	return
-	// }
-
-	// wn.connHub.Publish(userID, message)
+func (wn *WebsocketNode) Notify(userID string, event Event, params Payload) {
+	message, err := prepareRawNotification(event.String(), params, wn.cfg.Signer)
+	if err != nil {
+		wn.cfg.Logger.Error("failed to prepare notification message", "error", err, "userID", userID, "event", event)
+		return
+	}
+
+	wn.connHub.Publish(subscriptionTopic(event.Group(), userID), message)
+}
+
+// Subscribe registers the connection to receive all events of the group
+// concerning the specified user. User IDs are matched case-insensitively.
+//
+// Returns an error if the connection is not active.
+func (wn *WebsocketNode) Subscribe(connID string, group Group, userID string) error {
+	return wn.connHub.Subscribe(connID, subscriptionTopic(group, userID))
+}
+
+// Unsubscribe removes the connection's subscription to the events of the group
+// concerning the specified user. It does nothing if no such subscription exists.
+func (wn *WebsocketNode) Unsubscribe(connID string, group Group, userID string) {
+	wn.connHub.Unsubscribe(connID, subscriptionTopic(group, userID))
 }
 
 // getSendResponseFunc creates a SendResponseFunc for a specific connection.
 // The returned function can be used to send notifications to that connection.
 func (wn *WebsocketNode) getSendResponseFunc(conn Connection) SendResponseFunc {
 	return func(method string, params Payload) {
-		responseBytes, err := prepareRawNotification(method, params)
+		responseBytes, err := prepareRawNotification(method, params, wn.cfg.Signer)
 		if err != nil {
 			wn.cfg.Logger.Error("failed to prepare notification message", "error", err, "method", method)
 			return
@@ -451,16 +480,16 @@ func (wn *WebsocketNode) getSendResponseFunc(conn Connection) SendResponseFunc {
 	}
 }
 
-// sendErrorResponse sends an error response to a connection.
+// sendErrorResponse sends an error response to the request to a connection.
 // It's used for protocol-level errors before request processing.
-func (wn *WebsocketNode) sendErrorResponse(conn Connection, requestID uint64, method string, message string) {
+func (wn *WebsocketNode) sendErrorResponse(conn Connection, req Message, method string, message string) {
 	if conn == nil {
-		wn.cfg.Logger.Error("connection is nil, cannot send error response", "requestID", requestID)
+		wn.cfg.Logger.Error("connection is nil, cannot send error response", "requestID", req.RequestID)
 		return
 	}
 
-	res := NewErrorResponse(requestID, method, message)
-	responseBytes, err := json.Marshal(res)
+	res := NewErrorResponse(req.RequestID, method, message)
+	responseBytes, err := marshalMessage(res, &req, wn.cfg.Signer)
 	if err != nil {
 		wn.cfg.Logger.Error("failed to marshal error response", "error", err)
 		return
@@ -469,12 +498,27 @@ func (wn *WebsocketNode) sendErrorResponse(conn Connection, requestID uint64, me
 	conn.WriteRawResponse(responseBytes)
 }
 
+// resolveRouteHandlers collects the middleware and handler chain registered for the method,
+// following its route through the handler groups. Returns nil if the method is unknown.
+func resolveRouteHandlers(routes map[string][]string, handlerChain map[string][]Handler, method string) []Handler {
+	var routeHandlers []Handler
+	for _, handlersId := range routes[method] {
+		routeHandlers = append(routeHandlers, handlerChain[handlersId]...)
+	}
+	return routeHandlers
+}
+
+// subscriptionTopic builds the ConnectionHub topic for the events of a group concerning a user.
+func subscriptionTopic(group Group, userID string) string {
+	return group.String() + ":" + strings.ToLower(userID)
+}
+
 // prepareRawNotification creates a server-initiated notification message.
 // Unlike responses, notifications don't correspond to a specific request.
-func prepareRawNotification(method string, params Payload) ([]byte, error) {
+func prepareRawNotification(method string, params Payload, signer sign.Signer) ([]byte, error) {
 	msg := NewEvent(0, method, params) // RequestID=0 for notifications
 
-	responseBytes, err := json.Marshal(msg)
+	responseBytes, err := marshalMessage(msg, nil, signer)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal notification: %w", err)
 	}
@@ -482,6 +526,17 @@ func prepareRawNotification(method string, params Payload) ([]byte, error) {
 	return responseBytes, nil
 }
 
+// marshalMessage encodes the message, signing it first if a signer is given.
+// The request is the one the message responds to, nil for notifications.
+func marshalMessage(msg Message, request *Message, signer sign.Signer) ([]byte, error) {
+	if signer != nil {
+		if err := msg.Sign(signer, request); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(msg)
+}
+
 // WebsocketHandlerGroup implements the HandlerGroup interface for organizing
 // related handlers with shared middleware. Groups support nesting, allowing
 // for hierarchical organization of endpoints with inherited middleware chains.
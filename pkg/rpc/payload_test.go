@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/layer-3/nitrolite/pkg/sign"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -54,7 +55,25 @@ func TestPayloadUnmarshalJSON(t *testing.T) {
 		{
 			name:   "wrong number of elements",
 			input:  `[1, "testMethod", {"param1": "value1"}]`,
-			errMsg: "invalid RPCData: expected 5 elements in array",
+			errMsg: "invalid RPCData: expected 5 or 6 elements in array",
+		},
+		{
+			name:  "signed payload",
+			input: `[2, 1, "testMethod", {}, 1700000000000, "0x0102"]`,
+			expected: rpc.Message{
+				Type:      2,
+				RequestID: 1,
+				Method:    "testMethod",
+				Payload:   rpc.Payload{},
+				Timestamp: 1700000000000,
+				Signature: sign.Signature{0x01, 0x02},
+			},
+			errMsg: "",
+		},
+		{
+			name:   "invalid signature type",
+			input:  `[2, 1, "testMethod", {}, 1700000000000, 12]`,
+			errMsg: "invalid signature",
 		},
 		{
 			name:   "invalid request_id type",
@@ -126,6 +145,18 @@ func TestPayloadMarshalJSON(t *testing.T) {
 			},
 			expected: `[1,2,"anotherMethod",{},1700000001000]`,
 		},
+		{
+			name: "signed payload",
+			input: rpc.Message{
+				Type:      2,
+				RequestID: 3,
+				Method:    "testMethod",
+				Payload:   rpc.Payload{},
+				Timestamp: 1700000002000,
+				Signature: sign.Signature{0x01, 0x02},
+			},
+			expected: `[2,3,"testMethod",{},1700000002000,"0x0102"]`,
+		},
 	}
 
 	for _, tc := range tcs {
@@ -137,6 +168,54 @@ func TestPayloadMarshalJSON(t *testing.T) {
 	}
 }
 
+func TestMessageSignature(t *testing.T) {
+	t.Parallel()
+
+	signer, err := sign.NewEthereumMsgSigner(testNodeKey)
+	require.NoError(t, err)
+	nodeAddress := signer.PublicKey().Address().String()
+
+	request := rpc.NewRequest(1, "user.v1.get_balances", nil)
+	params, err := rpc.NewPayload(map[string]string{"balance": "100"})
+	require.NoError(t, err)
+	msg := rpc.NewResponse(1, "user.v1.get_balances", params)
+
+	assert.ErrorIs(t, msg.VerifySignature(nodeAddress, &request), rpc.ErrUnsignedMessage)
+
+	require.NoError(t, msg.Sign(signer, &request))
+	assert.NoError(t, msg.VerifySignature(nodeAddress, &request))
+	assert.ErrorIs(t, msg.VerifySignature("0x0000000000000000000000000000000000000001", &request), rpc.ErrInvalidSignature)
+
+	// The signature is bound to the request
+	otherRequest := request
+	otherRequest.Timestamp++
+	assert.ErrorIs(t, msg.VerifySignature(nodeAddress, &otherRequest), rpc.ErrInvalidSignature)
+	assert.ErrorIs(t, msg.VerifySignature(nodeAddress, nil), rpc.ErrInvalidSignature)
+
+	// The signature survives the wire encoding
+	data, err := json.Marshal(msg)
+	require.NoError(t, err)
+	var received rpc.Message
+	require.NoError(t, json.Unmarshal(data, &received))
+	assert.NoError(t, received.VerifySignature(nodeAddress, &request))
+
+	// Any change to the signed message invalidates the signature
+	received.Payload["balance"] = json.RawMessage(`"1000"`)
+	assert.ErrorIs(t, received.VerifySignature(nodeAddress, &request), rpc.ErrInvalidSignature)
+}
+
+func TestMessageVerifyTimestamp(t *testing.T) {
+	t.Parallel()
+
+	now := time.UnixMilli(time.Now().UnixMilli())
+	msg := rpc.Message{Timestamp: uint64(now.UnixMilli())}
+	assert.NoError(t, msg.VerifyTimestamp(now, time.Minute))
+	assert.NoError(t, msg.VerifyTimestamp(now.Add(time.Minute), time.Minute))
+	assert.NoError(t, msg.VerifyTimestamp(now.Add(-time.Minute), time.Minute), "small clock skews are tolerated")
+	assert.ErrorIs(t, msg.VerifyTimestamp(now.Add(2*time.Minute), time.Minute), rpc.ErrStaleMessage)
+	assert.ErrorIs(t, msg.VerifyTimestamp(now.Add(-2*time.Minute), time.Minute), rpc.ErrStaleMessage)
+}
+
 func TestNewParams(t *testing.T) {
 	t.Parallel()
 
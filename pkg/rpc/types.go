@@ -287,6 +287,18 @@ type BlockchainInfoV1 struct {
 	LockingContractAddress string `json:"locking_contract_address"`
 }
 
+// FeeRuleV1 represents a fee charged by the node on an operation with an asset.
+type FeeRuleV1 struct {
+	// Asset is the asset symbol
+	Asset string `json:"asset"`
+	// Action is the charged operation (transfer, app_session_deposit, app_session_withdrawal)
+	Action string `json:"action"`
+	// Flat is the flat fee amount
+	Flat string `json:"flat"`
+	// Percentage is the fee percentage of the operation amount
+	Percentage string `json:"percentage"`
+}
+
 // ============================================================================
 // Balance and Transaction Types
 // ============================================================================
@@ -321,6 +333,67 @@ type TransactionV1 struct {
 	CreatedAt string `json:"created_at"`
 }
 
+// ConditionalTransferV1 represents a hash-time-locked transfer between two users.
+type ConditionalTransferV1 struct {
+	// ID is the conditional transfer ID
+	ID string `json:"id"`
+	// Sender is the wallet that locked the funds
+	Sender string `json:"sender"`
+	// Receiver is the wallet that can claim the funds
+	Receiver string `json:"receiver"`
+	// Asset is the asset symbol
+	Asset string `json:"asset"`
+	// Amount is the locked amount
+	Amount string `json:"amount"`
+	// HashLock is the SHA-256 hash of the preimage
+	HashLock string `json:"hash_lock"`
+	// ExpiresAt is the Unix timestamp (in seconds) after which the funds are refunded
+	ExpiresAt string `json:"expires_at"`
+	// Status is the transfer status (locked, claimed, refunded)
+	Status string `json:"status"`
+	// Preimage is the revealed preimage, set once the transfer is claimed
+	Preimage *string `json:"preimage,omitempty"`
+}
+
+// PaymentStreamAuthorizationV1 represents the sender-signed terms of a payment stream.
+type PaymentStreamAuthorizationV1 struct {
+	// Sender is the wallet paying the stream
+	Sender string `json:"sender"`
+	// Recipient is the wallet receiving the stream
+	Recipient string `json:"recipient"`
+	// Asset is the asset symbol
+	Asset string `json:"asset"`
+	// Rate is the amount accrued per second
+	Rate string `json:"rate"`
+	// Cap is the maximum total amount transferred by the stream
+	Cap string `json:"cap"`
+	// ExpiresAt is the Unix timestamp (in seconds) after which the stream stops accruing
+	ExpiresAt string `json:"expires_at"`
+	// Nonce distinguishes otherwise identical authorizations
+	Nonce string `json:"nonce"`
+}
+
+// PaymentStreamV1 represents a payment stream settled by the node as transfers.
+type PaymentStreamV1 struct {
+	PaymentStreamAuthorizationV1
+	// ID is the payment stream ID
+	ID string `json:"id"`
+	// Status is the stream status (active, cancelled, completed)
+	Status string `json:"status"`
+	// StartsAt is the Unix timestamp (in seconds) the stream started accruing
+	StartsAt string `json:"starts_at"`
+	// EndsAt is the Unix timestamp (in seconds) the stream stops accruing
+	EndsAt string `json:"ends_at"`
+	// Settled is the total amount transferred so far
+	Settled string `json:"settled"`
+	// SettledUntil is the Unix timestamp (in seconds) up to which the stream has been settled
+	SettledUntil string `json:"settled_until"`
+	// CreatedAt is the creation timestamp (unix seconds)
+	CreatedAt string `json:"created_at"`
+	// UpdatedAt is the last update timestamp (unix seconds)
+	UpdatedAt string `json:"updated_at"`
+}
+
 // ============================================================================
 // Action Gateway Types
 // ============================================================================
@@ -0,0 +1,31 @@
+package watchtower
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+)
+
+// MockBlockchainClient mocks the challenge responses of core.BlockchainClientV2.
+// Calling any other method panics.
+type MockBlockchainClient struct {
+	core.BlockchainClientV2
+	mock.Mock
+}
+
+func (m *MockBlockchainClient) Checkpoint(_ context.Context, candidate core.State) (string, error) {
+	args := m.Called(candidate)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockBlockchainClient) FinalizeEscrowDeposit(_ context.Context, candidate core.State) (string, error) {
+	args := m.Called(candidate)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockBlockchainClient) FinalizeEscrowWithdrawal(_ context.Context, candidate core.State) (string, error) {
+	args := m.Called(candidate)
+	return args.String(0), args.Error(1)
+}
@@ -0,0 +1,146 @@
+package watchtower
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/blockchain/evm"
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+)
+
+// logEmitterCode deploys a contract that emits LOG2 with the first two calldata words as topics
+// and the rest of the calldata as data. It lets tests produce ChannelHub events without deploying ChannelHub.
+var logEmitterCode = common.FromHex(
+	// initcode: codecopy(0, 0x0b, 0x14); return(0, 0x14)
+	"0x601480600b6000396000f3" +
+		// runtime: calldatacopy(0, 0, calldatasize); log2(0x40, calldatasize - 0x40, mload(0), mload(0x20))
+		"366000600037602051600051604036036040a200",
+)
+
+type simulatedChain struct {
+	backend *simulated.Backend
+	opts    *bind.TransactOpts
+	emitter *bind.BoundContract
+	address common.Address
+}
+
+func newSimulatedChain(t *testing.T) *simulatedChain {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	deployer := crypto.PubkeyToAddress(key.PublicKey)
+
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		deployer: {Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(1e18))},
+	})
+	t.Cleanup(func() { backend.Close() })
+
+	chainID, err := backend.Client().ChainID(context.Background())
+	require.NoError(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	require.NoError(t, err)
+
+	address, _, emitter, err := bind.DeployContract(opts, abi.ABI{}, logEmitterCode, backend.Client())
+	require.NoError(t, err)
+	backend.Commit()
+
+	return &simulatedChain{
+		backend: backend,
+		opts:    opts,
+		emitter: emitter,
+		address: address,
+	}
+}
+
+// emitChallenge emits a ChannelHub challenge event and mines it.
+func (c *simulatedChain) emitChallenge(t *testing.T, eventName, channelID string, version, expiry uint64) {
+	t.Helper()
+
+	channelHubAbi, err := evm.ChannelHubMetaData.GetAbi()
+	require.NoError(t, err)
+	event := channelHubAbi.Events[eventName]
+
+	ledger := evm.Ledger{
+		UserAllocation: big.NewInt(0),
+		UserNetFlow:    big.NewInt(0),
+		NodeAllocation: big.NewInt(0),
+		NodeNetFlow:    big.NewInt(0),
+	}
+	data, err := event.Inputs.NonIndexed().Pack(evm.State{
+		Version:       version,
+		HomeLedger:    ledger,
+		NonHomeLedger: ledger,
+		UserSig:       []byte{},
+		NodeSig:       []byte{},
+	}, expiry)
+	require.NoError(t, err)
+
+	calldata := append(event.ID.Bytes(), common.HexToHash(channelID).Bytes()...)
+	calldata = append(calldata, data...)
+
+	_, err = c.emitter.RawTransact(c.opts, calldata)
+	require.NoError(t, err)
+	c.backend.Commit()
+}
+
+func TestWatchtower_SimulatedBackend(t *testing.T) {
+	t.Parallel()
+	chain := newSimulatedChain(t)
+
+	client := new(MockBlockchainClient)
+	w := newTestWatchtower(client)
+
+	latest := newTestState(t, 5, true)
+	require.NoError(t, w.Watch(latest))
+
+	otherChannelID := "0x" + common.Bytes2Hex(crypto.Keccak256([]byte("other")))
+	upToDate := newTestState(t, 2, true)
+	upToDate.HomeChannelID = &otherChannelID
+	signTestState(t, &upToDate, testUserSigner, testNodeSigner)
+	require.NoError(t, w.Watch(upToDate))
+
+	responded := make(chan core.State, 1)
+	client.On("Checkpoint", mock.Anything).Return("0xtx", nil).Run(func(args mock.Arguments) {
+		responded <- args.Get(0).(core.State)
+	})
+
+	// A challenge with the latest known state must be left alone,
+	// the stale one must be answered with the newer state
+	chain.emitChallenge(t, "ChannelChallenged", otherChannelID, 2, futureExpiry())
+	chain.emitChallenge(t, "ChannelChallenged", testHomeChannelID, 3, futureExpiry())
+
+	reactor := evm.NewChannelHubReactor(1, w, func(core.BlockchainEvent) error { return nil })
+	getLatestEvent := func(string, uint64) (core.BlockchainEvent, error) {
+		return core.BlockchainEvent{BlockNumber: 1}, nil
+	}
+	listener := evm.NewListener(chain.address, chain.backend.Client(), 1, 100, log.NewNoopLogger(), reactor.HandleEvent, getLatestEvent)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan error, 1)
+	listener.Listen(ctx, func(err error) { stopped <- err })
+
+	select {
+	case state := <-responded:
+		require.Equal(t, latest, state)
+	case <-time.After(10 * time.Second):
+		t.Fatal("watchtower did not respond to the stale challenge")
+	}
+
+	cancel()
+	require.NoError(t, <-stopped)
+
+	client.AssertNumberOfCalls(t, "Checkpoint", 1)
+}
@@ -0,0 +1,247 @@
+package watchtower
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+)
+
+// maxStoredEvents is the number of processed events remembered by the state stores,
+// enough to roll back any chain reorganization.
+const maxStoredEvents = 1024
+
+// StateStore persists the latest co-signed states the watchtower can respond to challenges with,
+// together with the ChannelHub events already processed, so that listening resumes after them on restart.
+type StateStore interface {
+	// StoreState saves the state if it is newer than the one stored for its channels
+	StoreState(state core.State) error
+
+	// GetLatestStateByChannelID returns the latest state stored for a home or escrow channel.
+	// Returns nil if no state is known for the channel.
+	GetLatestStateByChannelID(channelID string) (*core.State, error)
+
+	// StoreContractEvent records a processed contract event.
+	// This function matches the signature required by evm.StoreContractEvent.
+	StoreContractEvent(ev core.BlockchainEvent) error
+
+	// GetLatestEvent returns the latest processed event of a contract, or a zero event if none was processed.
+	// This function matches the signature required by evm.LatestEventGetter.
+	GetLatestEvent(contractAddress string, blockchainID uint64) (core.BlockchainEvent, error)
+
+	// RollbackContractEvents removes the processed events of a contract from the given block on and returns them.
+	// This function matches the signature required by evm.RollbackEvents.
+	RollbackContractEvents(contractAddress string, blockchainID uint64, fromBlock uint64) ([]core.BlockchainEvent, error)
+}
+
+// MemoryStateStore is an in-memory StateStore.
+type MemoryStateStore struct {
+	mu     sync.RWMutex
+	states map[string]core.State
+	events []core.BlockchainEvent
+}
+
+// NewMemoryStateStore creates an empty in-memory state store.
+func NewMemoryStateStore() *MemoryStateStore {
+	return &MemoryStateStore{
+		states: make(map[string]core.State),
+	}
+}
+
+func (s *MemoryStateStore) StoreState(state core.State) error {
+	channelIDs := stateChannelIDs(state)
+	if len(channelIDs) == 0 {
+		return errors.New("state is not bound to any channel")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, channelID := range channelIDs {
+		if stored, ok := s.states[channelID]; ok && stored.Version >= state.Version {
+			continue
+		}
+		s.states[channelID] = state
+	}
+	return nil
+}
+
+func (s *MemoryStateStore) GetLatestStateByChannelID(channelID string) (*core.State, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	state, ok := s.states[strings.ToLower(channelID)]
+	if !ok {
+		return nil, nil
+	}
+	return &state, nil
+}
+
+func (s *MemoryStateStore) StoreContractEvent(ev core.BlockchainEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = append(s.events, ev)
+	if len(s.events) > maxStoredEvents {
+		s.events = s.events[len(s.events)-maxStoredEvents:]
+	}
+	return nil
+}
+
+func (s *MemoryStateStore) GetLatestEvent(contractAddress string, blockchainID uint64) (core.BlockchainEvent, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var latest core.BlockchainEvent
+	for _, ev := range s.events {
+		if !isContractEvent(ev, contractAddress, blockchainID) {
+			continue
+		}
+		if ev.BlockNumber > latest.BlockNumber || (ev.BlockNumber == latest.BlockNumber && ev.LogIndex >= latest.LogIndex) {
+			latest = ev
+		}
+	}
+	return latest, nil
+}
+
+func (s *MemoryStateStore) RollbackContractEvents(contractAddress string, blockchainID uint64, fromBlock uint64) ([]core.BlockchainEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var kept, removed []core.BlockchainEvent
+	for _, ev := range s.events {
+		if isContractEvent(ev, contractAddress, blockchainID) && ev.BlockNumber >= fromBlock {
+			removed = append(removed, ev)
+		} else {
+			kept = append(kept, ev)
+		}
+	}
+	s.events = kept
+	return removed, nil
+}
+
+// FileStateStore is a StateStore kept in memory and written to a JSON file after every change,
+// so that the states and the listening progress survive restarts of the watchtower.
+type FileStateStore struct {
+	path string
+
+	mu     sync.Mutex // serializes changes together with their writes
+	memory *MemoryStateStore
+}
+
+// fileStateStoreData is the content of the file of a FileStateStore.
+type fileStateStoreData struct {
+	States map[string]core.State  `json:"states"`
+	Events []core.BlockchainEvent `json:"events"`
+}
+
+// NewFileStateStore opens the state store kept in the file at the given path.
+// The file is created on the first change if it doesn't exist.
+func NewFileStateStore(path string) (*FileStateStore, error) {
+	memory := NewMemoryStateStore()
+
+	content, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read state store file: %w", err)
+	}
+	if len(content) > 0 {
+		var data fileStateStoreData
+		if err := json.Unmarshal(content, &data); err != nil {
+			return nil, fmt.Errorf("failed to decode state store file: %w", err)
+		}
+		for channelID, state := range data.States {
+			memory.states[channelID] = state
+		}
+		memory.events = data.Events
+	}
+
+	return &FileStateStore{
+		path:   path,
+		memory: memory,
+	}, nil
+}
+
+func (s *FileStateStore) StoreState(state core.State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.memory.StoreState(state); err != nil {
+		return err
+	}
+	return s.save()
+}
+
+func (s *FileStateStore) GetLatestStateByChannelID(channelID string) (*core.State, error) {
+	return s.memory.GetLatestStateByChannelID(channelID)
+}
+
+func (s *FileStateStore) StoreContractEvent(ev core.BlockchainEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.memory.StoreContractEvent(ev); err != nil {
+		return err
+	}
+	return s.save()
+}
+
+func (s *FileStateStore) GetLatestEvent(contractAddress string, blockchainID uint64) (core.BlockchainEvent, error) {
+	return s.memory.GetLatestEvent(contractAddress, blockchainID)
+}
+
+func (s *FileStateStore) RollbackContractEvents(contractAddress string, blockchainID uint64, fromBlock uint64) ([]core.BlockchainEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	removed, err := s.memory.RollbackContractEvents(contractAddress, blockchainID, fromBlock)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.save(); err != nil {
+		return nil, err
+	}
+	return removed, nil
+}
+
+// save replaces the file with the current content of the store.
+// The content is written to a temporary file first, so that a crash never leaves a partial file behind.
+func (s *FileStateStore) save() error {
+	s.memory.mu.RLock()
+	content, err := json.Marshal(fileStateStoreData{
+		States: s.memory.states,
+		Events: s.memory.events,
+	})
+	s.memory.mu.RUnlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode state store: %w", err)
+	}
+
+	tmpPath := s.path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0o600); err != nil {
+		return fmt.Errorf("failed to write state store file: %w", err)
+	}
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("failed to replace state store file: %w", err)
+	}
+	return nil
+}
+
+// stateChannelIDs returns the normalized IDs of the home and escrow channels of the state.
+func stateChannelIDs(state core.State) []string {
+	var channelIDs []string
+	if state.HomeChannelID != nil && *state.HomeChannelID != "" {
+		channelIDs = append(channelIDs, strings.ToLower(*state.HomeChannelID))
+	}
+	if state.EscrowChannelID != nil && *state.EscrowChannelID != "" {
+		channelIDs = append(channelIDs, strings.ToLower(*state.EscrowChannelID))
+	}
+	return channelIDs
+}
+
+func isContractEvent(ev core.BlockchainEvent, contractAddress string, blockchainID uint64) bool {
+	return ev.BlockchainID == blockchainID && strings.EqualFold(ev.ContractAddress, contractAddress)
+}
@@ -0,0 +1,63 @@
+package watchtower
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+)
+
+const testContractAddress = "0xcccccccccccccccccccccccccccccccccccccccc"
+
+func TestFileStateStore(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "watchtower.json")
+
+	store, err := NewFileStateStore(path)
+	require.NoError(t, err)
+
+	latest, err := store.GetLatestEvent(testContractAddress, 1)
+	require.NoError(t, err)
+	assert.Zero(t, latest, "a new store has no processed events")
+
+	require.NoError(t, store.StoreState(newTestState(t, 3, true)))
+	for _, block := range []uint64{10, 11, 12} {
+		require.NoError(t, store.StoreContractEvent(core.BlockchainEvent{
+			BlockNumber:     block,
+			BlockHash:       "0xblock",
+			BlockchainID:    1,
+			ContractAddress: testContractAddress,
+		}))
+	}
+	removed, err := store.RollbackContractEvents(testContractAddress, 1, 12)
+	require.NoError(t, err)
+	require.Len(t, removed, 1)
+
+	t.Run("survives reopening", func(t *testing.T) {
+		reopened, err := NewFileStateStore(path)
+		require.NoError(t, err)
+
+		state, err := reopened.GetLatestStateByChannelID(testHomeChannelID)
+		require.NoError(t, err)
+		require.NotNil(t, state)
+		assert.Equal(t, uint64(3), state.Version)
+		assert.Equal(t, testWallet, state.UserWallet)
+
+		latest, err := reopened.GetLatestEvent(testContractAddress, 1)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(11), latest.BlockNumber, "rolled back events are not persisted")
+	})
+
+	t.Run("events of other contracts are ignored", func(t *testing.T) {
+		latest, err := store.GetLatestEvent("0xdddddddddddddddddddddddddddddddddddddddd", 1)
+		require.NoError(t, err)
+		assert.Zero(t, latest)
+
+		latest, err = store.GetLatestEvent(testContractAddress, 2)
+		require.NoError(t, err)
+		assert.Zero(t, latest)
+	})
+}
@@ -0,0 +1,365 @@
+package watchtower
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+)
+
+// DefaultRetryInterval is how often failed challenge responses are resubmitted.
+const DefaultRetryInterval = 15 * time.Second
+
+var _ core.ChannelHubEventHandler = &Watchtower{}
+
+// ChallengeKind identifies the on-chain entity a challenge was raised against.
+type ChallengeKind string
+
+const (
+	ChallengeKindHomeChannel      ChallengeKind = "home_channel"
+	ChallengeKindEscrowDeposit    ChallengeKind = "escrow_deposit"
+	ChallengeKindEscrowWithdrawal ChallengeKind = "escrow_withdrawal"
+)
+
+// challenge describes a challenge observed on-chain that the watchtower may have to respond to.
+type challenge struct {
+	kind         ChallengeKind
+	channelID    string
+	stateVersion uint64
+	expiry       uint64
+}
+
+// Watchtower monitors ChannelHub events on a single blockchain on behalf of a set of wallets.
+// When a channel of a watched wallet is challenged with a state older than the latest co-signed
+// state known to the watchtower, it submits that newer state before the challenge expires.
+// States are only accepted with valid signatures of both the user and the node, so that
+// the responses are never rejected by the ChannelHub.
+//
+// Watchtower implements core.ChannelHubEventHandler and is meant to be plugged into
+// an evm.ChannelHubReactor driven by an evm.Listener.
+type Watchtower struct {
+	blockchainID  uint64
+	client        core.BlockchainClientV2
+	store         StateStore
+	statePacker   core.StatePacker
+	sigValidator  *core.ChannelSigValidator
+	nodeAddress   string
+	logger        log.Logger
+	retryInterval time.Duration
+	now           func() time.Time
+
+	mu      sync.Mutex
+	wallets map[string]struct{}
+	pending map[string]challenge
+}
+
+// NewWatchtower creates a watchtower submitting challenge responses through the provided client.
+// The states it watches are packed with statePacker to verify they are signed by the user and by the node
+// with the given address.
+func NewWatchtower(blockchainID uint64, client core.BlockchainClientV2, store StateStore, statePacker core.StatePacker, nodeAddress string, logger log.Logger) *Watchtower {
+	// Session key permissions are enforced by the node before it co-signs a state,
+	// so any session key authorized by the wallet is accepted
+	sigValidator := core.NewChannelSigValidator(func(wallet, sessionKey, metadataHash string) (bool, error) {
+		return true, nil
+	})
+
+	return &Watchtower{
+		blockchainID:  blockchainID,
+		client:        client,
+		store:         store,
+		statePacker:   statePacker,
+		sigValidator:  sigValidator,
+		nodeAddress:   nodeAddress,
+		logger:        logger.WithName("watchtower").WithKV("blockchainID", blockchainID),
+		retryInterval: DefaultRetryInterval,
+		now:           time.Now,
+		wallets:       make(map[string]struct{}),
+		pending:       make(map[string]challenge),
+	}
+}
+
+// SetRetryInterval sets how often failed challenge responses are resubmitted by Start.
+func (w *Watchtower) SetRetryInterval(interval time.Duration) {
+	w.retryInterval = interval
+}
+
+// AddWallet adds a wallet whose channels should be protected.
+func (w *Watchtower) AddWallet(wallet string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.wallets[strings.ToLower(wallet)] = struct{}{}
+}
+
+// RemoveWallet stops accepting new states for the wallet.
+// States already stored are still used to respond to challenges.
+func (w *Watchtower) RemoveWallet(wallet string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.wallets, strings.ToLower(wallet))
+}
+
+// Watch records a state the watchtower can respond to challenges with.
+// The state must belong to a watched wallet and be signed by both the user and the node.
+// States can come from the node as well as from the user, e.g. from the state archive of the SDK.
+func (w *Watchtower) Watch(state core.State) error {
+	w.mu.Lock()
+	_, ok := w.wallets[strings.ToLower(state.UserWallet)]
+	w.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("wallet %s is not watched", state.UserWallet)
+	}
+
+	if state.UserSig == nil || state.NodeSig == nil {
+		return errors.New("state must be signed by both the user and the node")
+	}
+	if err := w.verifySignatures(state); err != nil {
+		return err
+	}
+
+	if err := w.store.StoreState(state); err != nil {
+		return fmt.Errorf("failed to store state: %w", err)
+	}
+	return nil
+}
+
+// verifySignatures checks the signatures of the user and the node against the packed state.
+func (w *Watchtower) verifySignatures(state core.State) error {
+	packedState, err := w.statePacker.PackState(state)
+	if err != nil {
+		return fmt.Errorf("failed to pack state: %w", err)
+	}
+
+	userSig, err := hexutil.Decode(*state.UserSig)
+	if err != nil {
+		return fmt.Errorf("invalid user signature encoding: %w", err)
+	}
+	if err := w.sigValidator.Verify(state.UserWallet, packedState, userSig); err != nil {
+		return fmt.Errorf("invalid user signature: %w", err)
+	}
+
+	nodeSig, err := hexutil.Decode(*state.NodeSig)
+	if err != nil {
+		return fmt.Errorf("invalid node signature encoding: %w", err)
+	}
+	if err := w.sigValidator.Verify(w.nodeAddress, packedState, nodeSig); err != nil {
+		return fmt.Errorf("invalid node signature: %w", err)
+	}
+	return nil
+}
+
+// Start resubmits challenge responses that failed until they succeed or their challenge expires.
+// It blocks until the context is cancelled.
+func (w *Watchtower) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.retryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.retryPending(ctx)
+		}
+	}
+}
+
+func (w *Watchtower) HandleHomeChannelChallenged(ctx context.Context, event *core.HomeChannelChallengedEvent) error {
+	w.respond(ctx, challenge{
+		kind:         ChallengeKindHomeChannel,
+		channelID:    event.ChannelID,
+		stateVersion: event.StateVersion,
+		expiry:       event.ChallengeExpiry,
+	})
+	return nil
+}
+
+func (w *Watchtower) HandleEscrowDepositChallenged(ctx context.Context, event *core.EscrowDepositChallengedEvent) error {
+	w.respond(ctx, challenge{
+		kind:         ChallengeKindEscrowDeposit,
+		channelID:    event.ChannelID,
+		stateVersion: event.StateVersion,
+		expiry:       event.ChallengeExpiry,
+	})
+	return nil
+}
+
+func (w *Watchtower) HandleEscrowWithdrawalChallenged(ctx context.Context, event *core.EscrowWithdrawalChallengedEvent) error {
+	w.respond(ctx, challenge{
+		kind:         ChallengeKindEscrowWithdrawal,
+		channelID:    event.ChannelID,
+		stateVersion: event.StateVersion,
+		expiry:       event.ChallengeExpiry,
+	})
+	return nil
+}
+
+func (w *Watchtower) HandleHomeChannelCheckpointed(ctx context.Context, event *core.HomeChannelCheckpointedEvent) error {
+	w.resolve(event.ChannelID, event.StateVersion)
+	return nil
+}
+
+func (w *Watchtower) HandleHomeChannelClosed(ctx context.Context, event *core.HomeChannelClosedEvent) error {
+	w.removePending(event.ChannelID)
+	return nil
+}
+
+func (w *Watchtower) HandleEscrowDepositFinalized(ctx context.Context, event *core.EscrowDepositFinalizedEvent) error {
+	w.removePending(event.ChannelID)
+	return nil
+}
+
+func (w *Watchtower) HandleEscrowWithdrawalFinalized(ctx context.Context, event *core.EscrowWithdrawalFinalizedEvent) error {
+	w.removePending(event.ChannelID)
+	return nil
+}
+
+func (w *Watchtower) HandleHomeChannelCreated(ctx context.Context, event *core.HomeChannelCreatedEvent) error {
+	return nil
+}
+
+func (w *Watchtower) HandleHomeChannelMigrated(ctx context.Context, event *core.HomeChannelMigratedEvent) error {
+	return nil
+}
+
+func (w *Watchtower) HandleHomeChannelMigrationInFinalized(ctx context.Context, event *core.HomeChannelMigrationInFinalizedEvent) error {
+	return nil
+}
+
+func (w *Watchtower) HandleHomeChannelMigrationOutInitiated(ctx context.Context, event *core.HomeChannelMigrationOutInitiatedEvent) error {
+	return nil
+}
+
+func (w *Watchtower) HandleHomeChannelMigrationOutFinalized(ctx context.Context, event *core.HomeChannelMigrationOutFinalizedEvent) error {
+	return nil
+}
+
+func (w *Watchtower) HandleEscrowDepositsPurged(ctx context.Context, event *core.EscrowDepositsPurgedEvent) error {
+	return nil
+}
+
+func (w *Watchtower) HandleVaultDeposited(ctx context.Context, event *core.VaultDepositedEvent) error {
+	return nil
+}
+
+func (w *Watchtower) HandleVaultWithdrawn(ctx context.Context, event *core.VaultWithdrawnEvent) error {
+	return nil
+}
+
+func (w *Watchtower) HandleEscrowDepositInitiated(ctx context.Context, event *core.EscrowDepositInitiatedEvent) error {
+	return nil
+}
+
+func (w *Watchtower) HandleEscrowWithdrawalInitiated(ctx context.Context, event *core.EscrowWithdrawalInitiatedEvent) error {
+	return nil
+}
+
+// PendingChallenges returns the number of challenges whose response has not been confirmed yet.
+func (w *Watchtower) PendingChallenges() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return len(w.pending)
+}
+
+// respond submits the latest known state if it supersedes the challenged one.
+// Errors are logged rather than returned, so that a failed response never stops the event listener.
+// Failed responses are kept pending and retried by Start.
+func (w *Watchtower) respond(ctx context.Context, ch challenge) {
+	logger := w.logger.WithKV("channelID", ch.channelID).WithKV("kind", string(ch.kind))
+
+	state, err := w.store.GetLatestStateByChannelID(ch.channelID)
+	if err != nil {
+		logger.Error("failed to get latest state", "error", err)
+		return
+	}
+	if state == nil {
+		logger.Debug("ignoring challenge of an unknown channel", "challengedVersion", ch.stateVersion)
+		return
+	}
+	if state.Version <= ch.stateVersion {
+		logger.Debug("challenged state is up to date", "challengedVersion", ch.stateVersion, "latestVersion", state.Version)
+		w.removePending(ch.channelID)
+		return
+	}
+	if w.isExpired(ch) {
+		logger.Error("challenge expired before it could be responded to", "challengedVersion", ch.stateVersion, "latestVersion", state.Version, "expiry", ch.expiry)
+		w.removePending(ch.channelID)
+		return
+	}
+
+	logger.Info("responding to stale challenge", "challengedVersion", ch.stateVersion, "latestVersion", state.Version, "expiry", ch.expiry)
+
+	txHash, err := w.submit(ctx, ch.kind, *state)
+	if err != nil {
+		logger.Warn("failed to submit challenge response, will retry", "error", err)
+		w.mu.Lock()
+		w.pending[strings.ToLower(ch.channelID)] = ch
+		w.mu.Unlock()
+		return
+	}
+
+	logger.Info("submitted challenge response", "txHash", txHash, "version", state.Version)
+	w.removePending(ch.channelID)
+}
+
+// submit sends the state on-chain using the operation that resolves the given kind of challenge.
+func (w *Watchtower) submit(ctx context.Context, kind ChallengeKind, state core.State) (string, error) {
+	switch kind {
+	case ChallengeKindHomeChannel:
+		return w.client.Checkpoint(ctx, state)
+	case ChallengeKindEscrowDeposit:
+		return w.client.FinalizeEscrowDeposit(ctx, state)
+	case ChallengeKindEscrowWithdrawal:
+		return w.client.FinalizeEscrowWithdrawal(ctx, state)
+	default:
+		return "", fmt.Errorf("unsupported challenge kind: %s", kind)
+	}
+}
+
+// retryPending responds again to every challenge whose previous response failed.
+func (w *Watchtower) retryPending(ctx context.Context) {
+	w.mu.Lock()
+	challenges := make([]challenge, 0, len(w.pending))
+	for _, ch := range w.pending {
+		challenges = append(challenges, ch)
+	}
+	w.mu.Unlock()
+
+	for _, ch := range challenges {
+		if ctx.Err() != nil {
+			return
+		}
+		w.respond(ctx, ch)
+	}
+}
+
+// resolve drops the pending response of a channel once a state newer than the challenged one lands on-chain.
+func (w *Watchtower) resolve(channelID string, version uint64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	key := strings.ToLower(channelID)
+	if ch, ok := w.pending[key]; ok && version > ch.stateVersion {
+		delete(w.pending, key)
+	}
+}
+
+func (w *Watchtower) removePending(channelID string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.pending, strings.ToLower(channelID))
+}
+
+func (w *Watchtower) isExpired(ch challenge) bool {
+	return uint64(w.now().Unix()) >= ch.expiry
+}
@@ -0,0 +1,283 @@
+package watchtower
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/sign"
+)
+
+const (
+	testHomeChannelID   = "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	testEscrowChannelID = "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+)
+
+var (
+	testUserSigner, testWallet      = newTestSigner("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	testNodeSigner, testNodeAddress = newTestSigner("59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d")
+	testStatePacker                 = core.NewStatePackerV1(testAssetStore{})
+)
+
+// testAssetStore resolves every asset and token to 6 decimals.
+type testAssetStore struct{}
+
+func (testAssetStore) GetAssetDecimals(string) (uint8, error)         { return 6, nil }
+func (testAssetStore) GetTokenDecimals(uint64, string) (uint8, error) { return 6, nil }
+
+func newTestSigner(privateKey string) (*core.ChannelDefaultSigner, string) {
+	msgSigner, err := sign.NewEthereumMsgSigner(privateKey)
+	if err != nil {
+		panic(err)
+	}
+	signer, err := core.NewChannelDefaultSigner(msgSigner)
+	if err != nil {
+		panic(err)
+	}
+	return signer, signer.PublicKey().Address().String()
+}
+
+// signTestState signs the state with the given user and node signers.
+func signTestState(t *testing.T, state *core.State, userSigner, nodeSigner sign.Signer) {
+	t.Helper()
+
+	packedState, err := testStatePacker.PackState(*state)
+	require.NoError(t, err)
+	userSig, err := userSigner.Sign(packedState)
+	require.NoError(t, err)
+	nodeSig, err := nodeSigner.Sign(packedState)
+	require.NoError(t, err)
+
+	userSigHex, nodeSigHex := userSig.String(), nodeSig.String()
+	state.UserSig = &userSigHex
+	state.NodeSig = &nodeSigHex
+}
+
+func newTestState(t *testing.T, version uint64, signed bool) core.State {
+	homeChannelID := testHomeChannelID
+	state := core.State{
+		ID:            "state-id",
+		Asset:         "usdc",
+		UserWallet:    testWallet,
+		Version:       version,
+		HomeChannelID: &homeChannelID,
+		HomeLedger: core.Ledger{
+			BlockchainID: 1,
+			UserBalance:  decimal.NewFromInt(10),
+			UserNetFlow:  decimal.NewFromInt(10),
+			NodeBalance:  decimal.Zero,
+			NodeNetFlow:  decimal.Zero,
+		},
+	}
+	if signed {
+		signTestState(t, &state, testUserSigner, testNodeSigner)
+	}
+	return state
+}
+
+func newTestWatchtower(client core.BlockchainClientV2) *Watchtower {
+	w := NewWatchtower(1, client, NewMemoryStateStore(), testStatePacker, testNodeAddress, log.NewNoopLogger())
+	w.AddWallet(testWallet)
+	return w
+}
+
+func futureExpiry() uint64 {
+	return uint64(time.Now().Add(time.Hour).Unix())
+}
+
+func TestWatchtower_Watch(t *testing.T) {
+	t.Parallel()
+	w := newTestWatchtower(new(MockBlockchainClient))
+
+	t.Run("unwatched wallet", func(t *testing.T) {
+		state := newTestState(t, 1, true)
+		state.UserWallet = "0x2222222222222222222222222222222222222222"
+		assert.ErrorContains(t, w.Watch(state), "is not watched")
+	})
+
+	t.Run("missing signatures", func(t *testing.T) {
+		assert.ErrorContains(t, w.Watch(newTestState(t, 1, false)), "signed by both")
+	})
+
+	t.Run("user signature of another wallet", func(t *testing.T) {
+		state := newTestState(t, 1, false)
+		signTestState(t, &state, testNodeSigner, testNodeSigner)
+		assert.ErrorContains(t, w.Watch(state), "invalid user signature")
+	})
+
+	t.Run("node signature of another node", func(t *testing.T) {
+		state := newTestState(t, 1, false)
+		signTestState(t, &state, testUserSigner, testUserSigner)
+		assert.ErrorContains(t, w.Watch(state), "invalid node signature")
+	})
+
+	t.Run("state changed after signing", func(t *testing.T) {
+		state := newTestState(t, 1, true)
+		state.HomeLedger.UserBalance = decimal.NewFromInt(1000)
+		assert.ErrorContains(t, w.Watch(state), "invalid user signature")
+	})
+
+	t.Run("keeps the latest version", func(t *testing.T) {
+		require.NoError(t, w.Watch(newTestState(t, 5, true)))
+		require.NoError(t, w.Watch(newTestState(t, 3, true)))
+
+		stored, err := w.store.GetLatestStateByChannelID(testHomeChannelID)
+		require.NoError(t, err)
+		require.NotNil(t, stored)
+		assert.Equal(t, uint64(5), stored.Version)
+	})
+}
+
+func TestWatchtower_HandleHomeChannelChallenged(t *testing.T) {
+	t.Parallel()
+
+	t.Run("responds to stale challenge", func(t *testing.T) {
+		client := new(MockBlockchainClient)
+		w := newTestWatchtower(client)
+		latest := newTestState(t, 5, true)
+		require.NoError(t, w.Watch(latest))
+
+		client.On("Checkpoint", latest).Return("0xtx", nil).Once()
+
+		err := w.HandleHomeChannelChallenged(context.Background(), &core.HomeChannelChallengedEvent{
+			ChannelID:       testHomeChannelID,
+			StateVersion:    3,
+			ChallengeExpiry: futureExpiry(),
+		})
+		require.NoError(t, err)
+		client.AssertExpectations(t)
+		assert.Zero(t, w.PendingChallenges())
+	})
+
+	t.Run("ignores up to date challenge", func(t *testing.T) {
+		client := new(MockBlockchainClient)
+		w := newTestWatchtower(client)
+		require.NoError(t, w.Watch(newTestState(t, 5, true)))
+
+		err := w.HandleHomeChannelChallenged(context.Background(), &core.HomeChannelChallengedEvent{
+			ChannelID:       testHomeChannelID,
+			StateVersion:    5,
+			ChallengeExpiry: futureExpiry(),
+		})
+		require.NoError(t, err)
+		client.AssertNotCalled(t, "Checkpoint", mock.Anything)
+	})
+
+	t.Run("ignores unknown channel", func(t *testing.T) {
+		client := new(MockBlockchainClient)
+		w := newTestWatchtower(client)
+
+		err := w.HandleHomeChannelChallenged(context.Background(), &core.HomeChannelChallengedEvent{
+			ChannelID:       testHomeChannelID,
+			StateVersion:    1,
+			ChallengeExpiry: futureExpiry(),
+		})
+		require.NoError(t, err)
+		client.AssertNotCalled(t, "Checkpoint", mock.Anything)
+	})
+
+	t.Run("ignores expired challenge", func(t *testing.T) {
+		client := new(MockBlockchainClient)
+		w := newTestWatchtower(client)
+		require.NoError(t, w.Watch(newTestState(t, 5, true)))
+
+		err := w.HandleHomeChannelChallenged(context.Background(), &core.HomeChannelChallengedEvent{
+			ChannelID:       testHomeChannelID,
+			StateVersion:    3,
+			ChallengeExpiry: uint64(time.Now().Add(-time.Minute).Unix()),
+		})
+		require.NoError(t, err)
+		client.AssertNotCalled(t, "Checkpoint", mock.Anything)
+	})
+
+	t.Run("keeps failed response pending", func(t *testing.T) {
+		client := new(MockBlockchainClient)
+		w := newTestWatchtower(client)
+		latest := newTestState(t, 5, true)
+		require.NoError(t, w.Watch(latest))
+
+		client.On("Checkpoint", latest).Return("", errors.New("rpc unavailable")).Once()
+
+		err := w.HandleHomeChannelChallenged(context.Background(), &core.HomeChannelChallengedEvent{
+			ChannelID:       testHomeChannelID,
+			StateVersion:    3,
+			ChallengeExpiry: futureExpiry(),
+		})
+		require.NoError(t, err, "failed responses must not stop the listener")
+		assert.Equal(t, 1, w.PendingChallenges())
+
+		client.On("Checkpoint", latest).Return("0xtx", nil).Once()
+		w.retryPending(context.Background())
+
+		client.AssertExpectations(t)
+		assert.Zero(t, w.PendingChallenges())
+	})
+
+	t.Run("checkpoint of a newer state resolves pending response", func(t *testing.T) {
+		client := new(MockBlockchainClient)
+		w := newTestWatchtower(client)
+		latest := newTestState(t, 5, true)
+		require.NoError(t, w.Watch(latest))
+
+		client.On("Checkpoint", latest).Return("", errors.New("rpc unavailable")).Once()
+
+		require.NoError(t, w.HandleHomeChannelChallenged(context.Background(), &core.HomeChannelChallengedEvent{
+			ChannelID:       testHomeChannelID,
+			StateVersion:    3,
+			ChallengeExpiry: futureExpiry(),
+		}))
+		require.Equal(t, 1, w.PendingChallenges())
+
+		require.NoError(t, w.HandleHomeChannelCheckpointed(context.Background(), &core.HomeChannelCheckpointedEvent{
+			ChannelID:    testHomeChannelID,
+			StateVersion: 5,
+		}))
+		assert.Zero(t, w.PendingChallenges())
+	})
+}
+
+func TestWatchtower_HandleEscrowChallenged(t *testing.T) {
+	t.Parallel()
+
+	escrowChannelID := testEscrowChannelID
+	latest := newTestState(t, 7, true)
+	latest.EscrowChannelID = &escrowChannelID
+
+	t.Run("escrow deposit", func(t *testing.T) {
+		client := new(MockBlockchainClient)
+		w := newTestWatchtower(client)
+		require.NoError(t, w.Watch(latest))
+
+		client.On("FinalizeEscrowDeposit", latest).Return("0xtx", nil).Once()
+
+		require.NoError(t, w.HandleEscrowDepositChallenged(context.Background(), &core.EscrowDepositChallengedEvent{
+			ChannelID:       testEscrowChannelID,
+			StateVersion:    6,
+			ChallengeExpiry: futureExpiry(),
+		}))
+		client.AssertExpectations(t)
+	})
+
+	t.Run("escrow withdrawal", func(t *testing.T) {
+		client := new(MockBlockchainClient)
+		w := newTestWatchtower(client)
+		require.NoError(t, w.Watch(latest))
+
+		client.On("FinalizeEscrowWithdrawal", latest).Return("0xtx", nil).Once()
+
+		require.NoError(t, w.HandleEscrowWithdrawalChallenged(context.Background(), &core.EscrowWithdrawalChallengedEvent{
+			ChannelID:       testEscrowChannelID,
+			StateVersion:    6,
+			ChallengeExpiry: futureExpiry(),
+		}))
+		client.AssertExpectations(t)
+	})
+}
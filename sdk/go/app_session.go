@@ -147,6 +147,8 @@ func (c *Client) CreateAppSession(ctx context.Context, definition app.AppDefinit
 
 // SubmitAppSessionDeposit submits a deposit to an app session.
 // This updates both the app session state and the user's channel state.
+// If the node charges an app_session_deposit fee (see GetConfig), depositAmount
+// must include the fee on top of the deposited allocations.
 //
 // Parameters:
 //   - appStateUpdate: The app state update with deposit intent
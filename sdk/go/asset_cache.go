@@ -22,10 +22,23 @@ func newClientAssetStore(client *Client) *clientAssetStore {
 }
 
 // populateCache fetches all assets from the node and populates the cache.
+// Fetched assets are recorded in the StateStore, which is used instead if the node is unreachable.
 func (s *clientAssetStore) populateCache() error {
+	store := s.client.config.StateStore
 	assets, err := s.client.GetAssets(context.Background(), nil)
 	if err != nil {
-		return fmt.Errorf("failed to fetch assets: %w", err)
+		if !isConnectionError(err) || store == nil {
+			return fmt.Errorf("failed to fetch assets: %w", err)
+		}
+		stored, storeErr := store.GetAssets()
+		if storeErr != nil || len(stored) == 0 {
+			return fmt.Errorf("failed to fetch assets: %w", err)
+		}
+		assets = stored
+	} else if store != nil {
+		if err := store.SaveAssets(assets); err != nil {
+			s.client.reportError(fmt.Errorf("failed to record assets: %w", err))
+		}
 	}
 	for _, a := range assets {
 		s.cache[strings.ToLower(a.Symbol)] = a
@@ -0,0 +1,59 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// ============================================================================
+// Authentication Methods
+// ============================================================================
+
+// Authenticate binds the connection to the user's wallet by signing a challenge issued
+// by the Node with the state signer, which may be the wallet itself or one of its channel
+// session keys. Nodes may restrict private reads such as GetBalances and GetTransactions
+// to authenticated connections.
+//
+// Once authenticated, the client authenticates again after every reconnect.
+//
+// Example:
+//
+//	if err := client.Authenticate(ctx); err != nil {
+//	    log.Fatal(err)
+//	}
+//	balances, _ := client.GetBalances(ctx, client.GetUserAddress())
+func (c *Client) Authenticate(ctx context.Context) error {
+	if err := c.authenticate(ctx); err != nil {
+		return err
+	}
+	c.authenticated.Store(true)
+	return nil
+}
+
+// authenticate performs the challenge-response login on the current connection.
+func (c *Client) authenticate(ctx context.Context) error {
+	challengeResp, err := c.rpcClient.AuthV1RequestChallenge(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to request challenge: %w", err)
+	}
+
+	wallet := c.GetUserAddress()
+	sig, err := c.stateSigner.Sign(rpc.PackAuthChallengeV1(wallet, challengeResp.Challenge))
+	if err != nil {
+		return fmt.Errorf("failed to sign challenge: %w", err)
+	}
+
+	_, err = c.rpcClient.AuthV1Authenticate(ctx, rpc.AuthV1AuthenticateRequest{
+		Wallet:    wallet,
+		Challenge: challengeResp.Challenge,
+		Signature: hexutil.Encode(sig),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to authenticate: %w", err)
+	}
+	return nil
+}
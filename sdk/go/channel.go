@@ -3,6 +3,8 @@ package sdk
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/layer-3/nitrolite/pkg/core"
@@ -17,12 +19,16 @@ const (
 )
 
 // Deposit prepares a deposit state for the user's channel.
-// This method handles two scenarios automatically:
+// This method handles three scenarios automatically:
 //  1. If no channel exists: Creates a new channel with the initial deposit
-//  2. If channel exists: Advances the state with a deposit transition
+//  2. If channel exists on blockchainID: Advances the state with a deposit transition
+//  3. If channel exists on another blockchain: Advances the state with a mutual lock transition,
+//     which moves the funds deposited on blockchainID into the home channel through an escrow
 //
 // The returned state is signed by both the user and the node, but has not yet been
 // submitted to the blockchain. Use Checkpoint to execute the on-chain transaction.
+// For a deposit from another blockchain, Checkpoint initiates the escrow deposit on
+// blockchainID, after which CompleteEscrowDeposit credits the funds to the home channel.
 //
 // Parameters:
 //   - ctx: Context for the operation
@@ -105,6 +111,23 @@ func (c *Client) Deposit(ctx context.Context, blockchainID uint64, asset string,
 		return newState, nil
 	}
 
+	// Scenario C: Channel exists on another blockchain - lock funds for an escrow deposit
+	if blockchainID != state.HomeLedger.BlockchainID {
+		nextState := state.NextState()
+
+		_, err = nextState.ApplyMutualLockTransition(blockchainID, tokenAddress, amount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply mutual lock transition: %w", err)
+		}
+
+		_, err = c.signAndSubmitState(ctx, nextState)
+		if err != nil {
+			return nil, err
+		}
+
+		return nextState, nil
+	}
+
 	// Scenario B: Channel exists - checkpoint deposit
 	// Create next state
 	nextState := state.NextState()
@@ -124,13 +147,64 @@ func (c *Client) Deposit(ctx context.Context, blockchainID uint64, asset string,
 	return nextState, nil
 }
 
+// CompleteEscrowDeposit credits the funds of a pending escrow deposit to the user's home channel.
+// It advances the latest signed state, which must hold the mutual lock prepared by Deposit from
+// a non-home blockchain, with an escrow deposit transition of the locked amount.
+// The node then finalizes the escrow deposit on the escrow blockchain.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - asset: The asset symbol of the escrow deposit (e.g., "usdc")
+//
+// Returns:
+//   - The co-signed state with the escrow deposit transition applied
+//   - Error if there is no pending escrow deposit or the operation fails
+//
+// Requirements:
+//   - The escrow deposit must be initiated on the escrow blockchain (use Checkpoint after Deposit)
+//
+// Example:
+//
+//	state, err := client.Deposit(ctx, 11155111, "usdc", decimal.NewFromInt(100)) // home channel on another chain
+//	txHash, err := client.Checkpoint(ctx, "usdc")                                 // initiates the escrow deposit
+//	state, err = client.CompleteEscrowDeposit(ctx, "usdc")
+func (c *Client) CompleteEscrowDeposit(ctx context.Context, asset string) (*core.State, error) {
+	userWallet := c.GetUserAddress()
+
+	state, err := c.GetLatestState(ctx, userWallet, asset, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest signed state: %w", err)
+	}
+	if state.Transition.Type != core.TransitionTypeMutualLock {
+		return nil, fmt.Errorf("no pending escrow deposit for asset %s", asset)
+	}
+
+	nextState := state.NextState()
+
+	_, err = nextState.ApplyEscrowDepositTransition(state.Transition.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply escrow deposit transition: %w", err)
+	}
+
+	_, err = c.signAndSubmitState(ctx, nextState)
+	if err != nil {
+		return nil, err
+	}
+
+	return nextState, nil
+}
+
 // Withdraw prepares a withdrawal state to remove funds from the user's channel.
-// This operation handles two scenarios automatically:
+// This operation handles three scenarios automatically:
 //  1. If no channel exists: Creates a new channel with the withdrawal transition
-//  2. If channel exists: Advances the state with a withdrawal transition
+//  2. If channel exists on blockchainID: Advances the state with a withdrawal transition
+//  3. If channel exists on another blockchain: Advances the state with an escrow lock transition,
+//     for which the node locks its own funds in an escrow on blockchainID
 //
 // The returned state is signed by both the user and the node, but has not yet been
 // submitted to the blockchain. Use Checkpoint to execute the on-chain transaction.
+// For a withdrawal to another blockchain, the node initiates the escrow withdrawal on
+// blockchainID, after which CompleteEscrowWithdrawal releases the funds to the user.
 //
 // Parameters:
 //   - ctx: Context for the operation
@@ -215,6 +289,23 @@ func (c *Client) Withdraw(ctx context.Context, blockchainID uint64, asset string
 		return newState, nil
 	}
 
+	// Channel exists on another blockchain - lock the node's funds for an escrow withdrawal
+	if blockchainID != state.HomeLedger.BlockchainID {
+		nextState := state.NextState()
+
+		_, err = nextState.ApplyEscrowLockTransition(blockchainID, tokenAddress, amount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply escrow lock transition: %w", err)
+		}
+
+		_, err = c.signAndSubmitState(ctx, nextState)
+		if err != nil {
+			return nil, err
+		}
+
+		return nextState, nil
+	}
+
 	// Create next state
 	nextState := state.NextState()
 
@@ -233,6 +324,157 @@ func (c *Client) Withdraw(ctx context.Context, blockchainID uint64, asset string
 	return nextState, nil
 }
 
+// CompleteEscrowWithdrawal withdraws the funds of a pending escrow withdrawal from the user's home channel.
+// It advances the latest signed state, which must hold the escrow lock prepared by Withdraw to
+// a non-home blockchain, with an escrow withdrawal transition of the locked amount.
+// Checkpoint then finalizes the escrow withdrawal, releasing the funds to the user on the escrow blockchain.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - asset: The asset symbol of the escrow withdrawal (e.g., "usdc")
+//
+// Returns:
+//   - The co-signed state with the escrow withdrawal transition applied
+//   - Error if there is no pending escrow withdrawal or the operation fails
+//
+// Requirements:
+//   - The node must have initiated the escrow withdrawal on the escrow blockchain
+//
+// Example:
+//
+//	state, err := client.Withdraw(ctx, 11155111, "usdc", decimal.NewFromInt(25)) // home channel on another chain
+//	state, err = client.CompleteEscrowWithdrawal(ctx, "usdc")                   // once the escrow is initiated
+//	txHash, err := client.Checkpoint(ctx, "usdc")                                // finalizes the escrow withdrawal
+func (c *Client) CompleteEscrowWithdrawal(ctx context.Context, asset string) (*core.State, error) {
+	userWallet := c.GetUserAddress()
+
+	state, err := c.GetLatestState(ctx, userWallet, asset, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest signed state: %w", err)
+	}
+	if state.Transition.Type != core.TransitionTypeEscrowLock {
+		return nil, fmt.Errorf("no pending escrow withdrawal for asset %s", asset)
+	}
+
+	nextState := state.NextState()
+
+	_, err = nextState.ApplyEscrowWithdrawTransition(state.Transition.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply escrow withdraw transition: %w", err)
+	}
+
+	_, err = c.signAndSubmitState(ctx, nextState)
+	if err != nil {
+		return nil, err
+	}
+
+	return nextState, nil
+}
+
+// MigrateHomeChannel prepares the move of the user's home channel to another blockchain, without closing it.
+// It advances the latest state with a migrate transition of the whole user balance, which the node locks
+// in the channel on the target blockchain once it initiates the migration there.
+// Use CompleteHomeChannelMigration once the migration is initiated to move the channel to the target blockchain.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - asset: The asset symbol of the home channel (e.g., "usdc")
+//   - blockchainID: The blockchain ID the home channel moves to (e.g., 11155111 for Sepolia)
+//
+// Returns:
+//   - The co-signed state with the migrate transition applied
+//   - Error if the operation fails
+//
+// Errors:
+//   - Returns error if no channel exists for the asset
+//   - Returns error if the home channel is already on the blockchain
+//   - Returns error if the asset is not supported on the blockchain
+//
+// Example:
+//
+//	state, err := client.MigrateHomeChannel(ctx, "usdc", 11155111)
+//	fmt.Printf("Migrating %s to chain %d\n", state.Transition.Amount, state.EscrowLedger.BlockchainID)
+func (c *Client) MigrateHomeChannel(ctx context.Context, asset string, blockchainID uint64) (*core.State, error) {
+	userWallet := c.GetUserAddress()
+
+	state, err := c.GetLatestState(ctx, userWallet, asset, false)
+	if err != nil {
+		return nil, err
+	}
+	if state.HomeChannelID == nil {
+		return nil, fmt.Errorf("no channel exists for asset %s", asset)
+	}
+	if blockchainID == state.HomeLedger.BlockchainID {
+		return nil, fmt.Errorf("home channel for asset %s is already on blockchain %d", asset, blockchainID)
+	}
+
+	// Get token address for this asset on the target blockchain
+	tokenAddress, err := c.getTokenAddress(ctx, blockchainID, asset)
+	if err != nil {
+		return nil, err
+	}
+
+	nextState := state.NextState()
+
+	_, err = nextState.ApplyMigrateTransition(blockchainID, tokenAddress, state.HomeLedger.UserBalance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply migrate transition: %w", err)
+	}
+
+	_, err = c.signAndSubmitState(ctx, nextState)
+	if err != nil {
+		return nil, err
+	}
+
+	return nextState, nil
+}
+
+// CompleteHomeChannelMigration moves the user's home channel to the blockchain of a pending migration.
+// It advances the latest signed state, which must hold the migration prepared by MigrateHomeChannel,
+// with a finalize migration transition: the user's balance is then held on the new home blockchain.
+// The node releases the channel on the old home blockchain.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - asset: The asset symbol of the home channel (e.g., "usdc")
+//
+// Returns:
+//   - The co-signed state with the finalize migration transition applied
+//   - Error if there is no pending migration or the operation fails
+//
+// Requirements:
+//   - The node must have initiated the migration on the new home blockchain
+//
+// Example:
+//
+//	state, err := client.MigrateHomeChannel(ctx, "usdc", 11155111)
+//	state, err = client.CompleteHomeChannelMigration(ctx, "usdc") // once the migration is initiated
+func (c *Client) CompleteHomeChannelMigration(ctx context.Context, asset string) (*core.State, error) {
+	userWallet := c.GetUserAddress()
+
+	state, err := c.GetLatestState(ctx, userWallet, asset, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest signed state: %w", err)
+	}
+	if state.Transition.Type != core.TransitionTypeMigrate {
+		return nil, fmt.Errorf("no pending home channel migration for asset %s", asset)
+	}
+
+	nextState := state.NextState()
+
+	_, err = nextState.ApplyFinalizeMigrationTransition(state.Transition.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply finalize migration transition: %w", err)
+	}
+
+	_, err = c.signAndSubmitState(ctx, nextState)
+	if err != nil {
+		return nil, err
+	}
+
+	return nextState, nil
+}
+
 // Transfer prepares a transfer state to send funds to another wallet address.
 // This method handles two scenarios automatically:
 //  1. If no channel exists: Creates a new channel with the transfer transition
@@ -348,6 +590,233 @@ func (c *Client) Transfer(ctx context.Context, recipientWallet string, asset str
 	return nextState, nil
 }
 
+// PrepareSwap prepares the user's leg of an atomic swap with another wallet.
+// The returned state carries a swap_send transition of the given asset, whose account ID is the
+// swap ID derived from both legs and the nonce (see core.GetSwapID), and is signed by the user only.
+// The signature thus covers what the user receives: the node rejects the leg unless it is paired
+// with the counterparty's leg of the very same terms. The state is not submitted to the node:
+// one of the two parties submits both legs together with SubmitSwap.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - counterpartyWallet: The counterparty's wallet address (e.g., "0x1234...")
+//   - asset: The asset symbol the user gives (e.g., "usdc")
+//   - amount: The amount the user gives
+//   - counterpartyAsset: The asset symbol the counterparty gives (e.g., "eth")
+//   - counterpartyAmount: The amount the counterparty gives
+//   - nonce: A nonce agreed with the counterparty, distinguishing swaps with the same legs
+//
+// Returns:
+//   - The user-signed state with the swap send transition applied
+//   - Error if the operation fails
+//
+// Requirements:
+//   - An existing home channel for the asset with sufficient balance
+//
+// Example:
+//
+//	myLeg, err := client.PrepareSwap(ctx, "0xCounterparty...", "usdc", decimal.NewFromInt(100), "eth", decimal.NewFromInt(2), nonce)
+//	// exchange myLeg and the counterparty's leg off-band, then either party submits both
+func (c *Client) PrepareSwap(ctx context.Context, counterpartyWallet string, asset string, amount decimal.Decimal, counterpartyAsset string, counterpartyAmount decimal.Decimal, nonce uint64) (*core.State, error) {
+	userWallet := c.GetUserAddress()
+	state, err := c.GetLatestState(ctx, userWallet, asset, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest state: %w", err)
+	}
+	if state.HomeChannelID == nil {
+		return nil, fmt.Errorf("no channel exists for asset %s", asset)
+	}
+
+	swapID, err := core.GetSwapID(
+		core.SwapLeg{Wallet: userWallet, Asset: asset, Amount: amount},
+		core.SwapLeg{Wallet: counterpartyWallet, Asset: counterpartyAsset, Amount: counterpartyAmount},
+		nonce,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive swap ID: %w", err)
+	}
+
+	nextState := state.NextState()
+	_, err = nextState.ApplySwapSendTransition(swapID, amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply swap transition: %w", err)
+	}
+
+	sig, err := c.SignState(nextState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign state: %w", err)
+	}
+	nextState.UserSig = &sig
+
+	return nextState, nil
+}
+
+// SubmitSwap submits both legs of an atomic swap to the node.
+// The node co-signs both swap_send states and credits each party with the other's asset
+// in a single operation: either both legs are applied or neither is.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - state: The user's leg, as returned by PrepareSwap
+//   - counterpartyState: The counterparty's leg, as returned by their PrepareSwap
+//   - nonce: The nonce both legs were prepared with
+//
+// Returns:
+//   - The user's and the counterparty's co-signed swap send states
+//   - Error if the operation fails
+//
+// Example:
+//
+//	myState, theirState, err := client.SubmitSwap(ctx, *myLeg, *theirLeg, nonce)
+func (c *Client) SubmitSwap(ctx context.Context, state, counterpartyState core.State, nonce uint64) (*core.State, *core.State, error) {
+	req := rpc.ChannelsV1SubmitSwapRequest{
+		State:             transformStateToRPC(state),
+		CounterpartyState: transformStateToRPC(counterpartyState),
+		Nonce:             strconv.FormatUint(nonce, 10),
+	}
+	resp, err := c.rpcClient.ChannelsV1SubmitSwap(ctx, req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to submit swap: %w", err)
+	}
+
+	state.NodeSig = &resp.Signature
+	counterpartyState.NodeSig = &resp.CounterpartySignature
+	c.recordState(state)
+
+	return &state, &counterpartyState, nil
+}
+
+// LockConditionalTransfer locks funds of the user in a hash-time-locked transfer to another wallet.
+// The receiver can claim the funds with ClaimConditionalTransfer by revealing the preimage of
+// the hash lock before the expiry. Once the transfer expires, the node refunds the funds to the user.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - receiverWallet: The receiver's wallet address (e.g., "0x1234...")
+//   - asset: The asset symbol to lock (e.g., "usdc")
+//   - amount: The amount to lock
+//   - hashLock: The SHA-256 hash of a 32-byte preimage (see core.GetHashLock)
+//   - expiresAt: The time after which the transfer can no longer be claimed
+//
+// Returns:
+//   - The co-signed state with the conditional lock transition applied
+//   - The ID of the created conditional transfer
+//   - Error if the operation fails
+//
+// Requirements:
+//   - An existing home channel for the asset with sufficient balance
+//
+// Example:
+//
+//	hashLock, _ := core.GetHashLock(preimage)
+//	state, transferID, err := client.LockConditionalTransfer(ctx, "0xReceiver...", "usdc", decimal.NewFromInt(100), hashLock, time.Now().Add(time.Hour))
+func (c *Client) LockConditionalTransfer(ctx context.Context, receiverWallet string, asset string, amount decimal.Decimal, hashLock string, expiresAt time.Time) (*core.State, string, error) {
+	userWallet := c.GetUserAddress()
+	state, err := c.GetLatestState(ctx, userWallet, asset, false)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get latest state: %w", err)
+	}
+	if state.HomeChannelID == nil {
+		return nil, "", fmt.Errorf("no channel exists for asset %s", asset)
+	}
+
+	nextState := state.NextState()
+	transferID, err := core.GetConditionalTransferID(nextState.ID, receiverWallet, hashLock, uint64(expiresAt.Unix()))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to compute conditional transfer ID: %w", err)
+	}
+	_, err = nextState.ApplyConditionalLockTransition(transferID, amount)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to apply conditional lock transition: %w", err)
+	}
+
+	sig, err := c.SignState(nextState)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to sign state: %w", err)
+	}
+	nextState.UserSig = &sig
+
+	req := rpc.ChannelsV1SubmitConditionalLockRequest{
+		State:     transformStateToRPC(*nextState),
+		Receiver:  receiverWallet,
+		HashLock:  hashLock,
+		ExpiresAt: strconv.FormatInt(expiresAt.Unix(), 10),
+	}
+	resp, err := c.rpcClient.ChannelsV1SubmitConditionalLock(ctx, req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to submit conditional lock: %w", err)
+	}
+
+	nextState.NodeSig = &resp.Signature
+	c.recordState(*nextState)
+
+	return nextState, resp.ConditionalTransferID, nil
+}
+
+// ClaimConditionalTransfer claims a conditional transfer by revealing the preimage of its hash lock.
+// The node credits the locked funds to the receiver of the transfer.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - transferID: The ID of the conditional transfer
+//   - preimage: The hex-encoded 32-byte preimage of the hash lock
+//
+// Returns:
+//   - The receiver's new state issued by the node
+//   - Error if the operation fails
+//
+// Example:
+//
+//	state, err := client.ClaimConditionalTransfer(ctx, transferID, preimage)
+func (c *Client) ClaimConditionalTransfer(ctx context.Context, transferID, preimage string) (*core.State, error) {
+	req := rpc.ChannelsV1ClaimConditionalTransferRequest{
+		ConditionalTransferID: transferID,
+		Preimage:              preimage,
+	}
+	resp, err := c.rpcClient.ChannelsV1ClaimConditionalTransfer(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim conditional transfer: %w", err)
+	}
+
+	state, err := transformState(resp.State)
+	if err != nil {
+		return nil, fmt.Errorf("failed to transform state: %w", err)
+	}
+
+	return &state, nil
+}
+
+// RefundConditionalTransfer refunds an expired conditional transfer to its sender.
+// Expired transfers are also refunded automatically by the node.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - transferID: The ID of the conditional transfer
+//
+// Returns:
+//   - The sender's new state issued by the node
+//   - Error if the operation fails
+//
+// Example:
+//
+//	state, err := client.RefundConditionalTransfer(ctx, transferID)
+func (c *Client) RefundConditionalTransfer(ctx context.Context, transferID string) (*core.State, error) {
+	req := rpc.ChannelsV1RefundConditionalTransferRequest{
+		ConditionalTransferID: transferID,
+	}
+	resp, err := c.rpcClient.ChannelsV1RefundConditionalTransfer(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refund conditional transfer: %w", err)
+	}
+
+	state, err := transformState(resp.State)
+	if err != nil {
+		return nil, fmt.Errorf("failed to transform state: %w", err)
+	}
+
+	return &state, nil
+}
+
 // CloseHomeChannel prepares a finalize state to close the user's channel for a specific asset.
 // This creates a final state with zero user balance and submits it to the node.
 //
@@ -403,8 +872,8 @@ func (c *Client) CloseHomeChannel(ctx context.Context, asset string) (*core.Stat
 }
 
 // Acknowledge prepares an acknowledgement state for the given asset.
-// This is used when a user receives a transfer but hasn't yet acknowledged the state,
-// or to acknowledge channel creation without a deposit.
+// This is used when a user receives a transfer or is debited by payment stream settlements
+// but hasn't yet acknowledged the state, or to acknowledge channel creation without a deposit.
 //
 // This method handles two scenarios automatically:
 //  1. If no channel exists: Creates a new channel with the acknowledgement transition
@@ -530,6 +999,8 @@ func (c *Client) Acknowledge(ctx context.Context, asset string) (*core.State, er
 // Blockchain method mapping:
 //   - Channel not yet on-chain (status Void): Creates the channel via blockchainClient.Create
 //   - HomeDeposit/HomeWithdrawal on existing channel: Checkpoints via blockchainClient.Checkpoint
+//   - MutualLock: Initiates the escrow deposit on the escrow blockchain via blockchainClient.InitiateEscrowDeposit
+//   - EscrowWithdraw: Finalizes the escrow withdrawal on the escrow blockchain via blockchainClient.FinalizeEscrowWithdrawal
 //   - Finalize: Closes the channel via blockchainClient.Close
 //
 // Parameters:
@@ -583,6 +1054,11 @@ func (c *Client) Checkpoint(ctx context.Context, asset string) (string, error) {
 		core.TransitionTypeHomeWithdrawal,
 		core.TransitionTypeTransferSend,
 		core.TransitionTypeTransferReceive,
+		core.TransitionTypeSwapSend,
+		core.TransitionTypeSwapReceive,
+		core.TransitionTypeConditionalLock,
+		core.TransitionTypeConditionalClaim,
+		core.TransitionTypeConditionalRefund,
 		core.TransitionTypeCommit,
 		core.TransitionTypeRelease:
 		if channel.Status == core.ChannelStatusVoid {
@@ -592,7 +1068,7 @@ func (c *Client) Checkpoint(ctx context.Context, asset string) (string, error) {
 				Challenge:             channel.ChallengeDuration,
 				ApprovedSigValidators: channel.ApprovedSigValidators,
 			}
-			txHash, err := blockchainClient.Create(channelDef, *state)
+			txHash, err := blockchainClient.Create(ctx, channelDef, *state)
 			if err != nil {
 				return "", fmt.Errorf("failed to create channel on blockchain: %w", err)
 			}
@@ -600,14 +1076,58 @@ func (c *Client) Checkpoint(ctx context.Context, asset string) (string, error) {
 		}
 
 		// Checkpoint existing channel for deposit/withdrawal
-		txHash, err := blockchainClient.Checkpoint(*state)
+		txHash, err := blockchainClient.Checkpoint(ctx, *state)
 		if err != nil {
 			return "", fmt.Errorf("failed to checkpoint on blockchain: %w", err)
 		}
 		return txHash, nil
 
+	case core.TransitionTypeMutualLock:
+		if state.EscrowLedger == nil {
+			return "", fmt.Errorf("mutual lock state has no escrow ledger")
+		}
+
+		escrowBlockchainClient, err := c.getOrInitBlockchainClient(ctx, state.EscrowLedger.BlockchainID)
+		if err != nil {
+			return "", err
+		}
+
+		// The escrow is bound to the definition of the home channel
+		channelDef := core.ChannelDefinition{
+			Nonce:                 channel.Nonce,
+			Challenge:             channel.ChallengeDuration,
+			ApprovedSigValidators: channel.ApprovedSigValidators,
+		}
+		txHash, err := escrowBlockchainClient.InitiateEscrowDeposit(ctx, channelDef, *state)
+		if err != nil {
+			return "", fmt.Errorf("failed to initiate escrow deposit on blockchain: %w", err)
+		}
+		return txHash, nil
+
+	case core.TransitionTypeEscrowLock:
+		return "", fmt.Errorf("escrow withdrawal is initiated by the node, use CompleteEscrowWithdrawal once it is")
+
+	case core.TransitionTypeMigrate:
+		return "", fmt.Errorf("home channel migration is initiated by the node, use CompleteHomeChannelMigration once it is")
+
+	case core.TransitionTypeEscrowWithdraw:
+		if state.EscrowLedger == nil {
+			return "", fmt.Errorf("escrow withdraw state has no escrow ledger")
+		}
+
+		escrowBlockchainClient, err := c.getOrInitBlockchainClient(ctx, state.EscrowLedger.BlockchainID)
+		if err != nil {
+			return "", err
+		}
+
+		txHash, err := escrowBlockchainClient.FinalizeEscrowWithdrawal(ctx, *state)
+		if err != nil {
+			return "", fmt.Errorf("failed to finalize escrow withdrawal on blockchain: %w", err)
+		}
+		return txHash, nil
+
 	case core.TransitionTypeFinalize:
-		txHash, err := blockchainClient.Close(*state)
+		txHash, err := blockchainClient.Close(ctx, *state)
 		if err != nil {
 			return "", fmt.Errorf("failed to close channel on blockchain: %w", err)
 		}
@@ -703,7 +1223,7 @@ func (c *Client) Challenge(ctx context.Context, state core.State) (string, error
 		return "", err
 	}
 
-	txHash, err := blockchainClient.Challenge(state, challengerSig, core.ChannelParticipantUser)
+	txHash, err := blockchainClient.Challenge(ctx, state, challengerSig, core.ChannelParticipantUser)
 	if err != nil {
 		return "", fmt.Errorf("failed to challenge on blockchain: %w", err)
 	}
@@ -800,7 +1320,11 @@ func (c *Client) GetLatestState(ctx context.Context, wallet, asset string, onlyS
 		Asset:      asset,
 		OnlySigned: onlySigned,
 	}
-	resp, err := c.rpcClient.ChannelsV1GetLatestState(ctx, req)
+	var resp rpc.ChannelsV1GetLatestStateResponse
+	err := c.withRetry(ctx, func() (err error) {
+		resp, err = c.rpcClient.ChannelsV1GetLatestState(ctx, req)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get latest state: %w", err)
 	}
@@ -808,6 +1332,7 @@ func (c *Client) GetLatestState(ctx context.Context, wallet, asset string, onlyS
 	if err != nil {
 		return nil, fmt.Errorf("failed to transform state: %w", err)
 	}
+	c.recordState(state)
 	return &state, nil
 }
 
@@ -837,6 +1362,10 @@ func (c *Client) requestChannelCreation(ctx context.Context, state core.State, c
 	if err != nil {
 		return "", fmt.Errorf("failed to request channel creation: %w", err)
 	}
+
+	state.NodeSig = &resp.Signature
+	c.recordState(state)
+
 	return resp.Signature, nil
 }
 
@@ -984,7 +1513,7 @@ func (c *Client) ApproveToken(ctx context.Context, chainID uint64, asset string,
 		return "", err
 	}
 
-	return blockchainClient.Approve(asset, amount)
+	return blockchainClient.Approve(ctx, asset, amount)
 }
 
 // GetOnChainBalance queries the on-chain token balance (ERC-20 or native ETH) for a wallet on a specific blockchain.
@@ -1007,5 +1536,5 @@ func (c *Client) GetOnChainBalance(ctx context.Context, chainID uint64, asset st
 		return decimal.Zero, err
 	}
 
-	return blockchainClient.GetTokenBalance(asset, wallet)
+	return blockchainClient.GetTokenBalance(ctx, asset, wallet)
 }
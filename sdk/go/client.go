@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -52,13 +53,18 @@ type Client struct {
 	config                   Config
 	exitCh                   chan struct{}
 	closeOnce                sync.Once
+	connMu                   sync.Mutex
+	cancelConn               context.CancelFunc
+	subsMu                   sync.Mutex
+	subscriptions            map[eventSubscription]struct{}
 	chainsMu                 sync.Mutex
-	blockchainClients        map[uint64]core.BlockchainClient
+	blockchainClients        map[uint64]core.BlockchainClientV2
 	blockchainLockingClients map[uint64]*evm.LockingClient
 	homeBlockchains          map[string]uint64
 	stateSigner              core.ChannelSigner
 	rawSigner                sign.Signer
 	assetStore               *clientAssetStore
+	authenticated            atomic.Bool
 }
 
 // NewClient creates a new Clearnode client with both high-level and low-level methods.
@@ -93,6 +99,19 @@ func NewClient(wsURL string, stateSigner core.ChannelSigner, rawSigner sign.Sign
 	for _, opt := range opts {
 		opt(&config)
 	}
+	if config.Reconnect != nil {
+		if err := config.Reconnect.validate(); err != nil {
+			return nil, fmt.Errorf("invalid reconnect config: %w", err)
+		}
+	}
+	if config.ResponseVerification != nil {
+		if err := config.ResponseVerification.validate(); err != nil {
+			return nil, fmt.Errorf("invalid response verification config: %w", err)
+		}
+	}
+	if config.StateStore == nil {
+		config.StateStore = NewMemoryStateStore()
+	}
 
 	// Create WebSocket dialer with configuration
 	dialerConfig := rpc.DefaultWebsocketDialerConfig
@@ -107,30 +126,31 @@ func NewClient(wsURL string, stateSigner core.ChannelSigner, rawSigner sign.Sign
 		rpcClient:                rpcClient,
 		config:                   config,
 		exitCh:                   make(chan struct{}),
-		blockchainClients:        make(map[uint64]core.BlockchainClient),
+		blockchainClients:        make(map[uint64]core.BlockchainClientV2),
 		blockchainLockingClients: make(map[uint64]*evm.LockingClient),
 		homeBlockchains:          make(map[string]uint64),
 		stateSigner:              stateSigner,
 		rawSigner:                rawSigner,
+		subscriptions:            make(map[eventSubscription]struct{}),
 	}
 
 	// Create asset store
 	client.assetStore = newClientAssetStore(client)
 
-	// Error handler wrapper
-	handleError := func(err error) {
-		if config.ErrorHandler != nil {
-			config.ErrorHandler(err)
-		}
-		client.doClose()
-	}
-
 	// Establish connection
-	err := rpcClient.Start(context.Background(), wsURL, handleError)
-	if err != nil {
+	if err := client.connect(); err != nil {
 		return nil, fmt.Errorf("failed to connect to clearnode: %w", err)
 	}
 
+	if config.ResponseVerification != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), config.HandshakeTimeout)
+		defer cancel()
+		if err := client.enableResponseVerification(ctx); err != nil {
+			client.doClose()
+			return nil, err
+		}
+	}
+
 	return client, nil
 }
 
@@ -191,14 +211,22 @@ func (c *Client) Close() error {
 	return nil
 }
 
-// doClose closes exitCh exactly once, safe for concurrent callers.
+// doClose closes exitCh and the active connection exactly once, safe for concurrent callers.
 func (c *Client) doClose() {
 	c.closeOnce.Do(func() {
 		close(c.exitCh)
+
+		c.connMu.Lock()
+		if c.cancelConn != nil {
+			c.cancelConn()
+		}
+		c.connMu.Unlock()
 	})
 }
 
 // WaitCh returns a channel that closes when the connection is lost or closed.
+// If reconnection is enabled with WithReconnect, the channel only closes when the client
+// is closed or all reconnection attempts have failed.
 // This is useful for monitoring connection health in long-running applications.
 //
 // Example:
@@ -266,6 +294,7 @@ func (c *Client) signAndSubmitState(ctx context.Context, state *core.State) (str
 
 	// Update state with node signature
 	state.NodeSig = &nodeSig
+	c.recordState(*state)
 
 	return nodeSig, nil
 }
@@ -301,7 +330,7 @@ func WithBlockchainRPC(chainID uint64, rpcURL string) Option {
 }
 
 // getOrInitBlockchainClient returns the blockchain client for a specific chain.
-func (c *Client) getOrInitBlockchainClient(ctx context.Context, chainID uint64) (core.BlockchainClient, error) {
+func (c *Client) getOrInitBlockchainClient(ctx context.Context, chainID uint64) (core.BlockchainClientV2, error) {
 	c.chainsMu.Lock()
 	defer c.chainsMu.Unlock()
 
@@ -381,7 +410,7 @@ func (c *Client) getTokenAddress(ctx context.Context, blockchainID uint64, asset
 
 // getChannelHubAddress retrieves the channel hub contract address for a specific blockchain from node config.
 func (c *Client) getChannelHubAddress(ctx context.Context, blockchainID uint64) (string, error) {
-	nodeConfig, err := c.GetConfig(ctx)
+	nodeConfig, err := c.getNodeConfig(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to get node config: %w", err)
 	}
@@ -400,7 +429,7 @@ func (c *Client) getChannelHubAddress(ctx context.Context, blockchainID uint64)
 
 // getLockingContractAddress retrieves the Locking contract address for a specific blockchain from node config.
 func (c *Client) getLockingContractAddress(ctx context.Context, blockchainID uint64) (string, error) {
-	nodeConfig, err := c.GetConfig(ctx)
+	nodeConfig, err := c.getNodeConfig(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to get node config: %w", err)
 	}
@@ -456,7 +485,7 @@ func (c *Client) getOrInitLockingClient(ctx context.Context, chainID uint64) (*e
 
 // getNodeAddress retrieves the node's Ethereum address from the node config.
 func (c *Client) getNodeAddress(ctx context.Context) (string, error) {
-	nodeConfig, err := c.GetConfig(ctx)
+	nodeConfig, err := c.getNodeConfig(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to get node config: %w", err)
 	}
@@ -466,7 +495,7 @@ func (c *Client) getNodeAddress(ctx context.Context) (string, error) {
 // getSupportedSigValidatorsBitmap fetches the node config and builds a hex bitmap
 // from the supported signature validators. This bitmap is used in ChannelDefinition.
 func (c *Client) getSupportedSigValidatorsBitmap(ctx context.Context) (string, error) {
-	nodeConfig, err := c.GetConfig(ctx)
+	nodeConfig, err := c.getNodeConfig(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to get node config: %w", err)
 	}
@@ -495,7 +524,7 @@ func (c *Client) EscrowSecurityTokens(ctx context.Context, targetWalletAddress s
 	if err != nil {
 		return "", err
 	}
-	return lc.Lock(targetWalletAddress, amount)
+	return lc.Lock(ctx, targetWalletAddress, amount)
 }
 
 // InitiateSecurityTokensWithdrawal initiates the unlock process for locked tokens in the Locking contract.
@@ -514,7 +543,7 @@ func (c *Client) InitiateSecurityTokensWithdrawal(ctx context.Context, blockchai
 		return "", err
 	}
 
-	return lc.Unlock()
+	return lc.Unlock(ctx)
 }
 
 // CancelSecurityTokensWithdrawal re-locks tokens that are currently in the unlocking state,
@@ -533,7 +562,7 @@ func (c *Client) CancelSecurityTokensWithdrawal(ctx context.Context, blockchainI
 		return "", err
 	}
 
-	return lc.Relock()
+	return lc.Relock(ctx)
 }
 
 // WithdrawSecurityTokens withdraws unlocked tokens from the Locking contract to the specified destination.
@@ -553,7 +582,7 @@ func (c *Client) WithdrawSecurityTokens(ctx context.Context, blockchainID uint64
 		return "", err
 	}
 
-	return lc.Withdraw(destinationWalletAddress)
+	return lc.Withdraw(ctx, destinationWalletAddress)
 }
 
 // ApproveSecurityToken approves the Locking contract to spend tokens on behalf of the caller.
@@ -573,7 +602,7 @@ func (c *Client) ApproveSecurityToken(ctx context.Context, chainID uint64, amoun
 		return "", err
 	}
 
-	return lc.ApproveToken(amount)
+	return lc.ApproveToken(ctx, amount)
 }
 
 // GetLockedBalance returns the locked balance of a user in the Locking contract.
@@ -592,5 +621,5 @@ func (c *Client) GetLockedBalance(ctx context.Context, chainID uint64, wallet st
 		return decimal.Zero, err
 	}
 
-	return lc.GetBalance(wallet)
+	return lc.GetBalance(ctx, wallet)
 }
@@ -442,6 +442,82 @@ func TestClient_GetLastAppKeyStates(t *testing.T) {
 // simultaneously does not panic. Before the sync.Once fix, the select+close
 // pattern on exitCh could race: two goroutines could both see the channel as
 // open and both call close(), causing a "close of closed channel" panic.
+func testPaymentStreamRPC() rpc.PaymentStreamV1 {
+	return rpc.PaymentStreamV1{
+		PaymentStreamAuthorizationV1: rpc.PaymentStreamAuthorizationV1{
+			Sender:    "0xsender",
+			Recipient: "0xrecipient",
+			Asset:     "USDC",
+			Rate:      "0.5",
+			Cap:       "100",
+			ExpiresAt: "1700003600",
+			Nonce:     "1",
+		},
+		ID:           "0xStreamID",
+		Status:       "active",
+		StartsAt:     "1700000000",
+		EndsAt:       "1700003600",
+		Settled:      "10",
+		SettledUntil: "1700000020",
+		CreatedAt:    "1700000000",
+		UpdatedAt:    "1700000020",
+	}
+}
+
+func TestClient_CreatePaymentStream(t *testing.T) {
+	t.Parallel()
+	mockDialer := NewMockDialer()
+	mockDialer.Dial(context.Background(), "", nil)
+	mockDialer.RegisterResponse(rpc.StreamsV1CreateMethod.String(), rpc.StreamsV1CreateResponse{Stream: testPaymentStreamRPC()})
+
+	pk, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	rawSigner, err := sign.NewEthereumRawSigner(hexutil.Encode(crypto.FromECDSA(pk)))
+	require.NoError(t, err)
+
+	client := &Client{
+		rpcClient: rpc.NewClient(mockDialer),
+		rawSigner: rawSigner,
+	}
+
+	stream, err := client.CreatePaymentStream(context.Background(), "0x2222222222222222222222222222222222222222", "USDC",
+		decimal.RequireFromString("0.5"), decimal.NewFromInt(100), time.Unix(1700003600, 0), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "0xStreamID", stream.ID)
+	assert.Equal(t, core.PaymentStreamStatusActive, stream.Status)
+	assert.Equal(t, "0.5", stream.Authorization.Rate.String())
+	assert.Equal(t, uint64(1700003600), stream.Authorization.ExpiresAt)
+	assert.Equal(t, "10", stream.Settled.String())
+	assert.Equal(t, int64(1700000020), stream.SettledUntil.Unix())
+}
+
+func TestClient_GetPaymentStreams(t *testing.T) {
+	t.Parallel()
+	mockDialer := NewMockDialer()
+	mockDialer.Dial(context.Background(), "", nil)
+
+	cancelled := testPaymentStreamRPC()
+	cancelled.ID = "0xCancelled"
+	cancelled.Status = "cancelled"
+	mockDialer.RegisterResponse(rpc.StreamsV1GetMethod.String(), rpc.StreamsV1GetResponse{
+		Streams: []rpc.PaymentStreamV1{testPaymentStreamRPC(), cancelled},
+	})
+
+	client := &Client{
+		rpcClient: rpc.NewClient(mockDialer),
+	}
+
+	streams, err := client.GetPaymentStreams(context.Background(), "0xsender")
+	require.NoError(t, err)
+	require.Len(t, streams, 2)
+	assert.Equal(t, core.PaymentStreamStatusActive, streams[0].Status)
+	assert.Equal(t, core.PaymentStreamStatusCancelled, streams[1].Status)
+
+	stream, err := client.GetPaymentStream(context.Background(), "0xStreamID")
+	require.NoError(t, err)
+	assert.Equal(t, "0xStreamID", stream.ID)
+}
+
 func TestDoCloseConcurrent(t *testing.T) {
 	t.Parallel()
 	client := &Client{
@@ -551,3 +627,420 @@ func TestClient_SignSessionKeyState(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, rawSigner.PublicKey().Address().String(), recoveredAddr.String())
 }
+
+// newEscrowTestClient returns a client with a state signer and a node holding a home channel
+// on blockchain 137, where lastState is the latest state of the user.
+func newEscrowTestClient(t *testing.T, lastState func(userWallet string) rpc.StateV1) *Client {
+	t.Helper()
+
+	mockDialer := NewMockDialer()
+	mockDialer.Dial(context.Background(), "", nil)
+
+	mockDialer.RegisterResponse(rpc.NodeV1GetAssetsMethod.String(), rpc.NodeV1GetAssetsResponse{
+		Assets: []rpc.AssetV1{
+			{
+				Name:                  "USDC",
+				Symbol:                "USDC",
+				Decimals:              6,
+				SuggestedBlockchainID: "137",
+				Tokens: []rpc.TokenV1{
+					{BlockchainID: "137", Address: "0xToken", Decimals: 6},
+					{BlockchainID: "42161", Address: "0xEscrowToken", Decimals: 6},
+				},
+			},
+		},
+	})
+	mockDialer.RegisterResponse(rpc.NodeV1GetConfigMethod.String(), rpc.NodeV1GetConfigResponse{
+		NodeAddress: "0x1111111111111111111111111111111111111111",
+	})
+	mockDialer.RegisterResponse(rpc.ChannelsV1SubmitStateMethod.String(), rpc.ChannelsV1SubmitStateResponse{
+		Signature: "0xNodeSig",
+	})
+
+	pk, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	rawSigner, err := sign.NewEthereumRawSigner(hexutil.Encode(crypto.FromECDSA(pk)))
+	require.NoError(t, err)
+	msgSigner, err := sign.NewEthereumMsgSignerFromRaw(rawSigner)
+	require.NoError(t, err)
+	stateSigner, err := core.NewChannelDefaultSigner(msgSigner)
+	require.NoError(t, err)
+
+	client := &Client{
+		rpcClient:   rpc.NewClient(mockDialer),
+		stateSigner: stateSigner,
+		rawSigner:   rawSigner,
+	}
+	client.assetStore = newClientAssetStore(client)
+
+	mockDialer.RegisterResponse(rpc.ChannelsV1GetLatestStateMethod.String(), rpc.ChannelsV1GetLatestStateResponse{
+		State: lastState(client.GetUserAddress()),
+	})
+	return client
+}
+
+func TestClient_Deposit_FromNonHomeBlockchain(t *testing.T) {
+	t.Parallel()
+
+	homeChannelID := "0x0000000000000000000000000000000000000000000000000000000000000abc"
+	client := newEscrowTestClient(t, func(userWallet string) rpc.StateV1 {
+		return rpc.StateV1{
+			ID:            core.GetStateID(userWallet, "USDC", 1, 1),
+			Epoch:         "1",
+			Version:       "1",
+			UserWallet:    userWallet,
+			Asset:         "USDC",
+			HomeChannelID: &homeChannelID,
+			Transition: rpc.TransitionV1{
+				Type:   core.TransitionTypeHomeDeposit,
+				Amount: "100",
+			},
+			HomeLedger: rpc.LedgerV1{
+				BlockchainID: "137",
+				TokenAddress: "0xToken",
+				UserBalance:  "100",
+				UserNetFlow:  "100",
+				NodeBalance:  "0",
+				NodeNetFlow:  "0",
+			},
+		}
+	})
+
+	state, err := client.Deposit(context.Background(), 42161, "USDC", decimal.NewFromInt(25))
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(2), state.Version)
+	assert.Equal(t, core.TransitionTypeMutualLock, state.Transition.Type)
+	assert.True(t, state.Transition.Amount.Equal(decimal.NewFromInt(25)))
+	require.NotNil(t, state.EscrowChannelID)
+	require.NotNil(t, state.EscrowLedger)
+	assert.Equal(t, uint64(42161), state.EscrowLedger.BlockchainID)
+	assert.Equal(t, "0xEscrowToken", state.EscrowLedger.TokenAddress)
+	assert.True(t, state.EscrowLedger.UserBalance.Equal(decimal.NewFromInt(25)))
+	assert.True(t, state.HomeLedger.NodeBalance.Equal(decimal.NewFromInt(25)))
+	require.NotNil(t, state.UserSig)
+	require.NotNil(t, state.NodeSig)
+	assert.Equal(t, "0xNodeSig", *state.NodeSig)
+}
+
+func TestClient_CompleteEscrowDeposit(t *testing.T) {
+	t.Parallel()
+
+	homeChannelID := "0x0000000000000000000000000000000000000000000000000000000000000abc"
+	escrowChannelID, err := core.GetEscrowChannelID(homeChannelID, 2)
+	require.NoError(t, err)
+	userSig := "0xUserSig"
+	nodeSig := "0xNodeSig"
+
+	client := newEscrowTestClient(t, func(userWallet string) rpc.StateV1 {
+		return rpc.StateV1{
+			ID:              core.GetStateID(userWallet, "USDC", 1, 2),
+			Epoch:           "1",
+			Version:         "2",
+			UserWallet:      userWallet,
+			Asset:           "USDC",
+			HomeChannelID:   &homeChannelID,
+			EscrowChannelID: &escrowChannelID,
+			Transition: rpc.TransitionV1{
+				Type:      core.TransitionTypeMutualLock,
+				AccountID: escrowChannelID,
+				Amount:    "25",
+			},
+			HomeLedger: rpc.LedgerV1{
+				BlockchainID: "137",
+				TokenAddress: "0xToken",
+				UserBalance:  "100",
+				UserNetFlow:  "100",
+				NodeBalance:  "25",
+				NodeNetFlow:  "25",
+			},
+			EscrowLedger: &rpc.LedgerV1{
+				BlockchainID: "42161",
+				TokenAddress: "0xEscrowToken",
+				UserBalance:  "25",
+				UserNetFlow:  "25",
+				NodeBalance:  "0",
+				NodeNetFlow:  "0",
+			},
+			UserSig: &userSig,
+			NodeSig: &nodeSig,
+		}
+	})
+
+	state, err := client.CompleteEscrowDeposit(context.Background(), "USDC")
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(3), state.Version)
+	assert.Equal(t, core.TransitionTypeEscrowDeposit, state.Transition.Type)
+	assert.True(t, state.Transition.Amount.Equal(decimal.NewFromInt(25)))
+	assert.True(t, state.HomeLedger.UserBalance.Equal(decimal.NewFromInt(125)))
+	require.NotNil(t, state.EscrowLedger)
+	assert.True(t, state.EscrowLedger.UserBalance.IsZero())
+	require.NotNil(t, state.NodeSig)
+	assert.Equal(t, "0xNodeSig", *state.NodeSig)
+}
+
+func TestClient_CompleteEscrowDeposit_NoPendingDeposit(t *testing.T) {
+	t.Parallel()
+
+	homeChannelID := "0x0000000000000000000000000000000000000000000000000000000000000abc"
+	client := newEscrowTestClient(t, func(userWallet string) rpc.StateV1 {
+		return rpc.StateV1{
+			ID:            core.GetStateID(userWallet, "USDC", 1, 1),
+			Epoch:         "1",
+			Version:       "1",
+			UserWallet:    userWallet,
+			Asset:         "USDC",
+			HomeChannelID: &homeChannelID,
+			Transition: rpc.TransitionV1{
+				Type:   core.TransitionTypeHomeDeposit,
+				Amount: "100",
+			},
+			HomeLedger: rpc.LedgerV1{
+				BlockchainID: "137",
+				TokenAddress: "0xToken",
+				UserBalance:  "100",
+				UserNetFlow:  "100",
+				NodeBalance:  "0",
+				NodeNetFlow:  "0",
+			},
+		}
+	})
+
+	_, err := client.CompleteEscrowDeposit(context.Background(), "USDC")
+	require.EqualError(t, err, "no pending escrow deposit for asset USDC")
+}
+
+func TestClient_Withdraw_ToNonHomeBlockchain(t *testing.T) {
+	t.Parallel()
+
+	homeChannelID := "0x0000000000000000000000000000000000000000000000000000000000000abc"
+	client := newEscrowTestClient(t, func(userWallet string) rpc.StateV1 {
+		return rpc.StateV1{
+			ID:            core.GetStateID(userWallet, "USDC", 1, 1),
+			Epoch:         "1",
+			Version:       "1",
+			UserWallet:    userWallet,
+			Asset:         "USDC",
+			HomeChannelID: &homeChannelID,
+			Transition: rpc.TransitionV1{
+				Type:   core.TransitionTypeHomeDeposit,
+				Amount: "100",
+			},
+			HomeLedger: rpc.LedgerV1{
+				BlockchainID: "137",
+				TokenAddress: "0xToken",
+				UserBalance:  "100",
+				UserNetFlow:  "100",
+				NodeBalance:  "0",
+				NodeNetFlow:  "0",
+			},
+		}
+	})
+
+	state, err := client.Withdraw(context.Background(), 42161, "USDC", decimal.NewFromInt(25))
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(2), state.Version)
+	assert.Equal(t, core.TransitionTypeEscrowLock, state.Transition.Type)
+	assert.True(t, state.Transition.Amount.Equal(decimal.NewFromInt(25)))
+	require.NotNil(t, state.EscrowChannelID)
+	require.NotNil(t, state.EscrowLedger)
+	assert.Equal(t, uint64(42161), state.EscrowLedger.BlockchainID)
+	assert.Equal(t, "0xEscrowToken", state.EscrowLedger.TokenAddress)
+	assert.True(t, state.EscrowLedger.NodeBalance.Equal(decimal.NewFromInt(25)))
+	assert.True(t, state.HomeLedger.UserBalance.Equal(decimal.NewFromInt(100)))
+	require.NotNil(t, state.NodeSig)
+	assert.Equal(t, "0xNodeSig", *state.NodeSig)
+}
+
+func TestClient_CompleteEscrowWithdrawal(t *testing.T) {
+	t.Parallel()
+
+	homeChannelID := "0x0000000000000000000000000000000000000000000000000000000000000abc"
+	escrowChannelID, err := core.GetEscrowChannelID(homeChannelID, 2)
+	require.NoError(t, err)
+	userSig := "0xUserSig"
+	nodeSig := "0xNodeSig"
+
+	client := newEscrowTestClient(t, func(userWallet string) rpc.StateV1 {
+		return rpc.StateV1{
+			ID:              core.GetStateID(userWallet, "USDC", 1, 2),
+			Epoch:           "1",
+			Version:         "2",
+			UserWallet:      userWallet,
+			Asset:           "USDC",
+			HomeChannelID:   &homeChannelID,
+			EscrowChannelID: &escrowChannelID,
+			Transition: rpc.TransitionV1{
+				Type:      core.TransitionTypeEscrowLock,
+				AccountID: escrowChannelID,
+				Amount:    "25",
+			},
+			HomeLedger: rpc.LedgerV1{
+				BlockchainID: "137",
+				TokenAddress: "0xToken",
+				UserBalance:  "100",
+				UserNetFlow:  "100",
+				NodeBalance:  "0",
+				NodeNetFlow:  "0",
+			},
+			EscrowLedger: &rpc.LedgerV1{
+				BlockchainID: "42161",
+				TokenAddress: "0xEscrowToken",
+				UserBalance:  "0",
+				UserNetFlow:  "0",
+				NodeBalance:  "25",
+				NodeNetFlow:  "25",
+			},
+			UserSig: &userSig,
+			NodeSig: &nodeSig,
+		}
+	})
+
+	state, err := client.CompleteEscrowWithdrawal(context.Background(), "USDC")
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(3), state.Version)
+	assert.Equal(t, core.TransitionTypeEscrowWithdraw, state.Transition.Type)
+	assert.True(t, state.Transition.Amount.Equal(decimal.NewFromInt(25)))
+	assert.True(t, state.HomeLedger.UserBalance.Equal(decimal.NewFromInt(75)))
+	require.NotNil(t, state.EscrowLedger)
+	assert.True(t, state.EscrowLedger.NodeBalance.IsZero())
+	assert.True(t, state.EscrowLedger.UserNetFlow.Equal(decimal.NewFromInt(-25)))
+	require.NotNil(t, state.NodeSig)
+	assert.Equal(t, "0xNodeSig", *state.NodeSig)
+}
+
+func TestClient_CompleteEscrowWithdrawal_NoPendingWithdrawal(t *testing.T) {
+	t.Parallel()
+
+	homeChannelID := "0x0000000000000000000000000000000000000000000000000000000000000abc"
+	client := newEscrowTestClient(t, func(userWallet string) rpc.StateV1 {
+		return rpc.StateV1{
+			ID:            core.GetStateID(userWallet, "USDC", 1, 1),
+			Epoch:         "1",
+			Version:       "1",
+			UserWallet:    userWallet,
+			Asset:         "USDC",
+			HomeChannelID: &homeChannelID,
+			Transition: rpc.TransitionV1{
+				Type:   core.TransitionTypeHomeDeposit,
+				Amount: "100",
+			},
+			HomeLedger: rpc.LedgerV1{
+				BlockchainID: "137",
+				TokenAddress: "0xToken",
+				UserBalance:  "100",
+				UserNetFlow:  "100",
+				NodeBalance:  "0",
+				NodeNetFlow:  "0",
+			},
+		}
+	})
+
+	_, err := client.CompleteEscrowWithdrawal(context.Background(), "USDC")
+	require.EqualError(t, err, "no pending escrow withdrawal for asset USDC")
+}
+
+func TestClient_MigrateHomeChannel(t *testing.T) {
+	t.Parallel()
+
+	homeChannelID := "0x0000000000000000000000000000000000000000000000000000000000000abc"
+	client := newEscrowTestClient(t, func(userWallet string) rpc.StateV1 {
+		return rpc.StateV1{
+			ID:            core.GetStateID(userWallet, "USDC", 1, 1),
+			Epoch:         "1",
+			Version:       "1",
+			UserWallet:    userWallet,
+			Asset:         "USDC",
+			HomeChannelID: &homeChannelID,
+			Transition: rpc.TransitionV1{
+				Type:   core.TransitionTypeHomeDeposit,
+				Amount: "100",
+			},
+			HomeLedger: rpc.LedgerV1{
+				BlockchainID: "137",
+				TokenAddress: "0xToken",
+				UserBalance:  "100",
+				UserNetFlow:  "100",
+				NodeBalance:  "0",
+				NodeNetFlow:  "0",
+			},
+		}
+	})
+
+	state, err := client.MigrateHomeChannel(context.Background(), "USDC", 42161)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(2), state.Version)
+	assert.Equal(t, core.TransitionTypeMigrate, state.Transition.Type)
+	assert.True(t, state.Transition.Amount.Equal(decimal.NewFromInt(100)))
+	require.NotNil(t, state.EscrowChannelID)
+	require.NotNil(t, state.EscrowLedger)
+	assert.Equal(t, uint64(42161), state.EscrowLedger.BlockchainID)
+	assert.Equal(t, "0xEscrowToken", state.EscrowLedger.TokenAddress)
+	assert.True(t, state.EscrowLedger.NodeBalance.Equal(decimal.NewFromInt(100)))
+	require.NotNil(t, state.NodeSig)
+
+	_, err = client.MigrateHomeChannel(context.Background(), "USDC", 137)
+	require.EqualError(t, err, "home channel for asset USDC is already on blockchain 137")
+}
+
+func TestClient_CompleteHomeChannelMigration(t *testing.T) {
+	t.Parallel()
+
+	homeChannelID := "0x0000000000000000000000000000000000000000000000000000000000000abc"
+	escrowChannelID, err := core.GetEscrowChannelID(homeChannelID, 2)
+	require.NoError(t, err)
+	userSig := "0xUserSig"
+	nodeSig := "0xNodeSig"
+
+	client := newEscrowTestClient(t, func(userWallet string) rpc.StateV1 {
+		return rpc.StateV1{
+			ID:              core.GetStateID(userWallet, "USDC", 1, 2),
+			Epoch:           "1",
+			Version:         "2",
+			UserWallet:      userWallet,
+			Asset:           "USDC",
+			HomeChannelID:   &homeChannelID,
+			EscrowChannelID: &escrowChannelID,
+			Transition: rpc.TransitionV1{
+				Type:      core.TransitionTypeMigrate,
+				AccountID: escrowChannelID,
+				Amount:    "100",
+			},
+			HomeLedger: rpc.LedgerV1{
+				BlockchainID: "137",
+				TokenAddress: "0xToken",
+				UserBalance:  "100",
+				UserNetFlow:  "100",
+				NodeBalance:  "0",
+				NodeNetFlow:  "0",
+			},
+			EscrowLedger: &rpc.LedgerV1{
+				BlockchainID: "42161",
+				TokenAddress: "0xEscrowToken",
+				UserBalance:  "0",
+				UserNetFlow:  "0",
+				NodeBalance:  "100",
+				NodeNetFlow:  "100",
+			},
+			UserSig: &userSig,
+			NodeSig: &nodeSig,
+		}
+	})
+
+	state, err := client.CompleteHomeChannelMigration(context.Background(), "USDC")
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(3), state.Version)
+	assert.Equal(t, core.TransitionTypeFinalizeMigration, state.Transition.Type)
+	assert.Equal(t, uint64(42161), state.HomeLedger.BlockchainID)
+	assert.Equal(t, "0xEscrowToken", state.HomeLedger.TokenAddress)
+	assert.True(t, state.HomeLedger.UserBalance.Equal(decimal.NewFromInt(100)))
+	require.NotNil(t, state.EscrowLedger)
+	assert.Equal(t, uint64(137), state.EscrowLedger.BlockchainID)
+	assert.True(t, state.EscrowLedger.UserBalance.IsZero())
+	require.NotNil(t, state.NodeSig)
+}
@@ -4,6 +4,9 @@ import (
 	"log"
 	"os"
 	"time"
+
+	"github.com/layer-3/nitrolite/pkg/app"
+	"github.com/layer-3/nitrolite/pkg/core"
 )
 
 // Config holds the configuration options for the Clearnode client.
@@ -24,6 +27,37 @@ type Config struct {
 	// BlockchainRPCs maps blockchain IDs to their RPC endpoints
 	// Used by SDKClient for on-chain operations
 	BlockchainRPCs map[uint64]string
+
+	// ChannelUpdatedHandler is called when a subscribed channel changes its status or version
+	ChannelUpdatedHandler func(core.Channel)
+
+	// TransferReceivedHandler is called when a subscribed wallet receives a transfer
+	TransferReceivedHandler func(core.State)
+
+	// ConditionalTransferUpdatedHandler is called when a conditional transfer of a subscribed wallet
+	// is locked, claimed or refunded
+	ConditionalTransferUpdatedHandler func(core.ConditionalTransfer)
+
+	// BlockchainActionCompletedHandler is called when the Node submits a state of a subscribed wallet on-chain
+	BlockchainActionCompletedHandler func(BlockchainActionCompleted)
+
+	// AppSessionUpdatedHandler is called when an app session of a subscribed wallet advances to a new version
+	AppSessionUpdatedHandler func(app.AppSessionInfoV1)
+
+	// Reconnect enables automatic reconnection after the connection is lost.
+	// If nil, the client closes when the connection is lost.
+	Reconnect *ReconnectConfig
+
+	// ResyncHandler is called after a successful reconnect with the latest signed state per asset
+	ResyncHandler func(map[string]core.State)
+
+	// StateStore records co-signed states and node metadata for offline disputes.
+	// If nil, NewClient uses an in-memory store.
+	StateStore StateStore
+
+	// ResponseVerification enables the verification of the Node's signatures on RPC responses.
+	// If nil, responses are not verified.
+	ResponseVerification *ResponseVerificationConfig
 }
 
 // Option is a functional option for configuring the Client.
@@ -64,3 +98,78 @@ func WithErrorHandler(fn func(error)) Option {
 		c.ErrorHandler = fn
 	}
 }
+
+// WithChannelUpdatedHandler sets the handler for channel update events.
+// Events are only delivered after calling SubscribeChannelEvents.
+func WithChannelUpdatedHandler(fn func(core.Channel)) Option {
+	return func(c *Config) {
+		c.ChannelUpdatedHandler = fn
+	}
+}
+
+// WithTransferReceivedHandler sets the handler for incoming transfer events.
+// Events are only delivered after calling SubscribeChannelEvents.
+func WithTransferReceivedHandler(fn func(core.State)) Option {
+	return func(c *Config) {
+		c.TransferReceivedHandler = fn
+	}
+}
+
+// WithConditionalTransferUpdatedHandler sets the handler for conditional transfer update events.
+// Events are only delivered after calling SubscribeChannelEvents.
+func WithConditionalTransferUpdatedHandler(fn func(core.ConditionalTransfer)) Option {
+	return func(c *Config) {
+		c.ConditionalTransferUpdatedHandler = fn
+	}
+}
+
+// WithBlockchainActionCompletedHandler sets the handler for completed on-chain action events.
+// Events are only delivered after calling SubscribeChannelEvents.
+func WithBlockchainActionCompletedHandler(fn func(BlockchainActionCompleted)) Option {
+	return func(c *Config) {
+		c.BlockchainActionCompletedHandler = fn
+	}
+}
+
+// WithAppSessionUpdatedHandler sets the handler for app session update events.
+// Events are only delivered after calling SubscribeAppSessionEvents.
+func WithAppSessionUpdatedHandler(fn func(app.AppSessionInfoV1)) Option {
+	return func(c *Config) {
+		c.AppSessionUpdatedHandler = fn
+	}
+}
+
+// WithReconnect enables automatic reconnection with the provided backoff configuration.
+// After reconnecting, event subscriptions are restored and idempotent calls failed
+// due to the connection loss are retried.
+func WithReconnect(rc ReconnectConfig) Option {
+	return func(c *Config) {
+		c.Reconnect = &rc
+	}
+}
+
+// WithResyncHandler sets the handler receiving the latest signed state of every asset
+// of the user after the client has reconnected. It requires WithReconnect.
+func WithResyncHandler(fn func(map[string]core.State)) Option {
+	return func(c *Config) {
+		c.ResyncHandler = fn
+	}
+}
+
+// WithStateStore sets the store recording co-signed states of the user.
+// Use a persistent store (see sdk/go/store/sqlite) for ChallengeWithLatest and CloseUnilaterally
+// to work after a restart while the Node is unreachable.
+func WithStateStore(store StateStore) Option {
+	return func(c *Config) {
+		c.StateStore = store
+	}
+}
+
+// WithResponseVerification makes the client verify that RPC responses are signed by the Node,
+// protecting balances, states and configuration against tampering by proxies on the way.
+// The node address responses are verified against must be set in the config.
+func WithResponseVerification(rv ResponseVerificationConfig) Option {
+	return func(c *Config) {
+		c.ResponseVerification = &rv
+	}
+}
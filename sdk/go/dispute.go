@@ -0,0 +1,150 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+)
+
+// ============================================================================
+// Offline Dispute Methods
+// ============================================================================
+
+// ErrChallengePeriodActive is returned by CloseUnilaterally while the channel
+// is challenged and the challenge period has not expired yet.
+var ErrChallengePeriodActive = errors.New("challenge period has not expired yet")
+
+// ChallengeWithLatest challenges the home channel of the asset on-chain with the latest
+// co-signed state recorded in the StateStore.
+//
+// Unlike Challenge, the state does not have to be fetched from the Node, so the dispute
+// can be raised while the Node is unreachable, as long as the node configuration and assets
+// were recorded while it was online.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - asset: The asset symbol (e.g., "usdc")
+//
+// Returns:
+//   - Transaction hash of the on-chain challenge transaction
+//   - Error if no state was recorded for the asset or the challenge fails
+//
+// Example:
+//
+//	txHash, err := client.ChallengeWithLatest(ctx, "usdc")
+//	fmt.Printf("Challenge transaction: %s\n", txHash)
+func (c *Client) ChallengeWithLatest(ctx context.Context, asset string) (string, error) {
+	state, err := c.getRecordedState(asset)
+	if err != nil {
+		return "", err
+	}
+	return c.Challenge(ctx, *state)
+}
+
+// CloseUnilaterally closes the home channel of the asset on-chain without the cooperation
+// of the Node, using the latest co-signed state recorded in the StateStore.
+//
+// Closing unilaterally is a multi-step process, every call performs the next step:
+//   - If the recorded state finalizes the channel, the channel is closed right away.
+//   - If the channel is not challenged, it is challenged with the recorded state.
+//   - If the channel is challenged with an older state, the recorded state is checkpointed
+//     to supersede it. The channel then has to be challenged again.
+//   - If the challenge period is still running, ErrChallengePeriodActive is returned.
+//   - Once the challenge period is over, the channel is closed.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - asset: The asset symbol (e.g., "usdc")
+//
+// Returns:
+//   - Transaction hash of the submitted transaction
+//   - Error if the operation fails
+//
+// Example:
+//
+//	txHash, err := client.CloseUnilaterally(ctx, "usdc") // challenges the channel
+//	// ... wait for the challenge period to expire
+//	txHash, err = client.CloseUnilaterally(ctx, "usdc") // closes the channel
+func (c *Client) CloseUnilaterally(ctx context.Context, asset string) (string, error) {
+	state, err := c.getRecordedState(asset)
+	if err != nil {
+		return "", err
+	}
+	if state.HomeChannelID == nil {
+		return "", fmt.Errorf("recorded state for asset %s has no home channel", asset)
+	}
+
+	blockchainClient, err := c.getOrInitBlockchainClient(ctx, state.HomeLedger.BlockchainID)
+	if err != nil {
+		return "", err
+	}
+
+	if state.IsFinal() {
+		txHash, err := blockchainClient.Close(ctx, *state)
+		if err != nil {
+			return "", fmt.Errorf("failed to close channel on blockchain: %w", err)
+		}
+		return txHash, nil
+	}
+
+	channelData, err := blockchainClient.GetHomeChannelData(ctx, *state.HomeChannelID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get on-chain channel data: %w", err)
+	}
+
+	if channelData.ChallengeExpiry == 0 {
+		return c.Challenge(ctx, *state)
+	}
+
+	challengeExpiry := time.Unix(int64(channelData.ChallengeExpiry), 0)
+	if time.Now().Before(challengeExpiry) {
+		if channelData.LastState.Version < state.Version {
+			txHash, err := blockchainClient.Checkpoint(ctx, *state)
+			if err != nil {
+				return "", fmt.Errorf("failed to checkpoint on blockchain: %w", err)
+			}
+			return txHash, nil
+		}
+		return "", fmt.Errorf("%w: it ends at %s", ErrChallengePeriodActive, challengeExpiry.UTC().Format(time.RFC3339))
+	}
+
+	txHash, err := blockchainClient.Close(ctx, *state)
+	if err != nil {
+		return "", fmt.Errorf("failed to close channel on blockchain: %w", err)
+	}
+	return txHash, nil
+}
+
+// getRecordedState returns the latest co-signed state of the user for the asset from the StateStore.
+func (c *Client) getRecordedState(asset string) (*core.State, error) {
+	if c.config.StateStore == nil {
+		return nil, errors.New("state store is not configured")
+	}
+
+	state, err := c.config.StateStore.GetLatestState(c.GetUserAddress(), asset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recorded state: %w", err)
+	}
+	if state == nil {
+		return nil, fmt.Errorf("no state recorded for asset %s", asset)
+	}
+	return state, nil
+}
+
+// recordState saves a co-signed state of the user in the StateStore. Failures are reported
+// to the ErrorHandler rather than failing the operation the state originates from.
+func (c *Client) recordState(state core.State) {
+	if c.config.StateStore == nil || state.UserSig == nil || state.NodeSig == nil {
+		return
+	}
+	if !strings.EqualFold(state.UserWallet, c.GetUserAddress()) {
+		return
+	}
+	if err := c.config.StateStore.SaveState(state); err != nil {
+		c.reportError(fmt.Errorf("failed to record state: %w", err))
+	}
+}
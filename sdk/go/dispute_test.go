@@ -0,0 +1,258 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/layer-3/nitrolite/pkg/sign"
+)
+
+const (
+	disputeTestChainID = uint64(137)
+	disputeTestToken   = "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174"
+)
+
+// disputeBlockchainClient records the dispute operations submitted by the client.
+type disputeBlockchainClient struct {
+	core.BlockchainClientV2
+	channelData core.HomeChannelDataResponse
+	calls       []string
+}
+
+func (m *disputeBlockchainClient) Challenge(_ context.Context, candidate core.State, challengerSig []byte, challengerIdx core.ChannelParticipant) (string, error) {
+	m.calls = append(m.calls, "Challenge")
+	return "0xChallengeTx", nil
+}
+
+func (m *disputeBlockchainClient) Checkpoint(_ context.Context, candidate core.State) (string, error) {
+	m.calls = append(m.calls, "Checkpoint")
+	return "0xCheckpointTx", nil
+}
+
+func (m *disputeBlockchainClient) Close(_ context.Context, candidate core.State) (string, error) {
+	m.calls = append(m.calls, "Close")
+	return "0xCloseTx", nil
+}
+
+func (m *disputeBlockchainClient) GetHomeChannelData(_ context.Context, homeChannelID string) (core.HomeChannelDataResponse, error) {
+	return m.channelData, nil
+}
+
+func newTestSigner(t *testing.T) (sign.Signer, core.ChannelSigner) {
+	t.Helper()
+
+	pk, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	rawSigner, err := sign.NewEthereumRawSigner(hexutil.Encode(crypto.FromECDSA(pk)))
+	require.NoError(t, err)
+	msgSigner, err := sign.NewEthereumMsgSignerFromRaw(rawSigner)
+	require.NoError(t, err)
+	channelSigner, err := core.NewChannelDefaultSigner(msgSigner)
+	require.NoError(t, err)
+
+	return rawSigner, channelSigner
+}
+
+// newOfflineDisputeClient creates a client that cannot reach the Node, with node metadata
+// and a state co-signed by the user and the node recorded in its StateStore.
+func newOfflineDisputeClient(t *testing.T, blockchainClient core.BlockchainClientV2, transitionType core.TransitionType) *Client {
+	t.Helper()
+
+	userRawSigner, userSigner := newTestSigner(t)
+	nodeRawSigner, nodeSigner := newTestSigner(t)
+
+	store := NewMemoryStateStore()
+	require.NoError(t, store.SaveNodeConfig(core.NodeConfig{
+		NodeAddress: nodeRawSigner.PublicKey().Address().String(),
+		Blockchains: []core.Blockchain{{ID: disputeTestChainID, ChannelHubAddress: "0xChannelHub"}},
+	}))
+	require.NoError(t, store.SaveAssets([]core.Asset{{
+		Symbol:   "usdc",
+		Decimals: 6,
+		Tokens:   []core.Token{{BlockchainID: disputeTestChainID, Address: disputeTestToken, Decimals: 6}},
+	}}))
+
+	// The mock dialer is never started, so every RPC call fails with rpc.ErrNotConnected
+	client := &Client{
+		rpcClient:         rpc.NewClient(NewMockDialer()),
+		config:            Config{StateStore: store},
+		exitCh:            make(chan struct{}),
+		blockchainClients: map[uint64]core.BlockchainClientV2{disputeTestChainID: blockchainClient},
+		stateSigner:       userSigner,
+		rawSigner:         userRawSigner,
+	}
+	client.assetStore = newClientAssetStore(client)
+
+	homeChannelID := "0x" + hexutil.Encode(crypto.Keccak256([]byte("channel")))[2:]
+	state := core.State{
+		ID:            "0xState",
+		Transition:    core.Transition{Type: transitionType, Amount: decimal.Zero},
+		Asset:         "usdc",
+		UserWallet:    client.GetUserAddress(),
+		Version:       5,
+		HomeChannelID: &homeChannelID,
+		HomeLedger: core.Ledger{
+			TokenAddress: disputeTestToken,
+			BlockchainID: disputeTestChainID,
+			UserBalance:  decimal.NewFromInt(10),
+			UserNetFlow:  decimal.NewFromInt(10),
+			NodeBalance:  decimal.Zero,
+			NodeNetFlow:  decimal.Zero,
+		},
+	}
+
+	userSig, err := client.SignState(&state)
+	require.NoError(t, err)
+	packed, err := core.PackState(state, client.assetStore)
+	require.NoError(t, err)
+	nodeSigBytes, err := nodeSigner.Sign(packed)
+	require.NoError(t, err)
+	nodeSig := hexutil.Encode(nodeSigBytes)
+	state.UserSig = &userSig
+	state.NodeSig = &nodeSig
+
+	client.recordState(state)
+	return client
+}
+
+func TestClient_ChallengeWithLatest_Offline(t *testing.T) {
+	t.Parallel()
+	blockchainClient := &disputeBlockchainClient{}
+	client := newOfflineDisputeClient(t, blockchainClient, core.TransitionTypeTransferSend)
+
+	txHash, err := client.ChallengeWithLatest(context.Background(), "USDC")
+	require.NoError(t, err)
+	assert.Equal(t, "0xChallengeTx", txHash)
+	assert.Equal(t, []string{"Challenge"}, blockchainClient.calls)
+}
+
+func TestClient_ChallengeWithLatest_NoRecordedState(t *testing.T) {
+	t.Parallel()
+	client := newOfflineDisputeClient(t, &disputeBlockchainClient{}, core.TransitionTypeTransferSend)
+
+	_, err := client.ChallengeWithLatest(context.Background(), "weth")
+	require.ErrorContains(t, err, "no state recorded for asset weth")
+}
+
+func TestClient_CloseUnilaterally(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name           string
+		transitionType core.TransitionType
+		channelData    core.HomeChannelDataResponse
+		expectedCalls  []string
+		expectedTx     string
+		expectedErr    error
+	}{
+		{
+			name:           "finalized state closes right away",
+			transitionType: core.TransitionTypeFinalize,
+			expectedCalls:  []string{"Close"},
+			expectedTx:     "0xCloseTx",
+		},
+		{
+			name:           "unchallenged channel is challenged",
+			transitionType: core.TransitionTypeTransferSend,
+			expectedCalls:  []string{"Challenge"},
+			expectedTx:     "0xChallengeTx",
+		},
+		{
+			name:           "challenge with an older state is superseded",
+			transitionType: core.TransitionTypeTransferSend,
+			channelData: core.HomeChannelDataResponse{
+				LastState:       core.State{Version: 3},
+				ChallengeExpiry: uint64(time.Now().Add(time.Hour).Unix()),
+			},
+			expectedCalls: []string{"Checkpoint"},
+			expectedTx:    "0xCheckpointTx",
+		},
+		{
+			name:           "active challenge period",
+			transitionType: core.TransitionTypeTransferSend,
+			channelData: core.HomeChannelDataResponse{
+				LastState:       core.State{Version: 5},
+				ChallengeExpiry: uint64(time.Now().Add(time.Hour).Unix()),
+			},
+			expectedErr: ErrChallengePeriodActive,
+		},
+		{
+			name:           "expired challenge closes the channel",
+			transitionType: core.TransitionTypeTransferSend,
+			channelData: core.HomeChannelDataResponse{
+				LastState:       core.State{Version: 5},
+				ChallengeExpiry: uint64(time.Now().Add(-time.Minute).Unix()),
+			},
+			expectedCalls: []string{"Close"},
+			expectedTx:    "0xCloseTx",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			blockchainClient := &disputeBlockchainClient{channelData: tc.channelData}
+			client := newOfflineDisputeClient(t, blockchainClient, tc.transitionType)
+
+			txHash, err := client.CloseUnilaterally(context.Background(), "usdc")
+			if tc.expectedErr != nil {
+				require.True(t, errors.Is(err, tc.expectedErr), "unexpected error: %v", err)
+				assert.Empty(t, blockchainClient.calls)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTx, txHash)
+			assert.Equal(t, tc.expectedCalls, blockchainClient.calls)
+		})
+	}
+}
+
+func TestClient_SignAndSubmitState_RecordsState(t *testing.T) {
+	t.Parallel()
+	mockDialer := NewMockDialer()
+	mockDialer.Dial(context.Background(), "", nil)
+	mockDialer.RegisterResponse(rpc.NodeV1GetAssetsMethod.String(), rpc.NodeV1GetAssetsResponse{
+		Assets: []rpc.AssetV1{{
+			Symbol:                "usdc",
+			Decimals:              6,
+			SuggestedBlockchainID: "137",
+			Tokens:                []rpc.TokenV1{{Address: disputeTestToken, BlockchainID: "137", Decimals: 6}},
+		}},
+	})
+	mockDialer.RegisterResponse(rpc.ChannelsV1SubmitStateMethod.String(), rpc.ChannelsV1SubmitStateResponse{
+		Signature: "0xNodeSig",
+	})
+
+	blockchainClient := &disputeBlockchainClient{}
+	offline := newOfflineDisputeClient(t, blockchainClient, core.TransitionTypeTransferSend)
+	client := &Client{
+		rpcClient:   rpc.NewClient(mockDialer),
+		config:      offline.config,
+		stateSigner: offline.stateSigner,
+		rawSigner:   offline.rawSigner,
+	}
+	client.assetStore = newClientAssetStore(client)
+
+	recorded, err := client.getRecordedState("usdc")
+	require.NoError(t, err)
+	next := recorded.NextState()
+	next.Transition = core.Transition{Type: core.TransitionTypeTransferSend, Amount: decimal.NewFromInt(1)}
+
+	_, err = client.signAndSubmitState(context.Background(), next)
+	require.NoError(t, err)
+
+	latest, err := client.getRecordedState("usdc")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), latest.Version)
+	require.NotNil(t, latest.NodeSig)
+	assert.Equal(t, "0xNodeSig", *latest.NodeSig)
+}
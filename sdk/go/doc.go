@@ -94,6 +94,24 @@
 //   - `WithHandshakeTimeout(duration)`: Sets the timeout for the initial WebSocket handshake.
 //   - `WithPingInterval(duration)`: Sets the interval for WebSocket ping/pong keepalives.
 //   - `WithErrorHandler(func(error))`: Sets a callback for handling background connection errors.
+//   - `WithChannelUpdatedHandler`, `WithTransferReceivedHandler`, `WithConditionalTransferUpdatedHandler`, `WithBlockchainActionCompletedHandler`
+//     and `WithAppSessionUpdatedHandler`: Set callbacks for server-pushed events.
+//   - `WithReconnect(ReconnectConfig)`: Reconnects with exponential backoff when the connection is lost,
+//     restores event subscriptions and retries idempotent calls (GetLatestState, GetBalances).
+//   - `WithResyncHandler(func(map[string]core.State))`: Receives the latest signed state per asset after a reconnect.
+//   - `WithStateStore(StateStore)`: Sets the archive of co-signed states (default: in-memory).
+//
+// # Offline Disputes
+//
+// Every co-signed state is recorded in the client's StateStore along with the node configuration
+// and assets. `ChallengeWithLatest(ctx, asset)` and `CloseUnilaterally(ctx, asset)` settle the recorded
+// state on-chain without contacting the Node. The SQLite store in sdk/go/store/sqlite persists the
+// archive across restarts.
+//
+// # Events
+//
+// The Node pushes events to connections that subscribed to a wallet. Register handlers with the options
+// above and subscribe with `SubscribeChannelEvents(ctx, wallet)` or `SubscribeAppSessionEvents(ctx, wallet)`.
 //
 // # Error Handling
 //
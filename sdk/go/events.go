@@ -0,0 +1,240 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// ============================================================================
+// Event Subscription Methods
+// ============================================================================
+
+// BlockchainActionCompleted describes an on-chain operation submitted by the Node on behalf of the user.
+type BlockchainActionCompleted struct {
+	// ActionType is the type of the completed action (e.g. checkpoint)
+	ActionType string
+	// BlockchainID is the blockchain the action was submitted to
+	BlockchainID uint64
+	// TxHash is the hash of the submitted transaction
+	TxHash string
+	// State is the state that was submitted on-chain
+	State core.State
+}
+
+// eventSubscription identifies a subscription to the events of a group concerning a wallet.
+type eventSubscription struct {
+	group  rpc.Group
+	wallet string
+}
+
+// SubscribeChannelEvents subscribes the connection to channel events of the given wallet.
+// Events are delivered to the handlers registered with WithChannelUpdatedHandler,
+// WithTransferReceivedHandler, WithConditionalTransferUpdatedHandler and WithBlockchainActionCompletedHandler.
+//
+// Example:
+//
+//	client, _ := sdk.NewClient(wsURL, stateSigner, txSigner,
+//	    sdk.WithTransferReceivedHandler(func(state core.State) {
+//	        fmt.Printf("Received transfer, new balance: %s\n", state.HomeLedger.UserBalance)
+//	    }),
+//	)
+//	if err := client.SubscribeChannelEvents(ctx, client.GetUserAddress()); err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *Client) SubscribeChannelEvents(ctx context.Context, wallet string) error {
+	if _, err := c.rpcClient.ChannelsV1Subscribe(ctx, rpc.ChannelsV1SubscribeRequest{Wallet: wallet}); err != nil {
+		return fmt.Errorf("failed to subscribe to channel events: %w", err)
+	}
+	c.trackSubscription(eventSubscription{group: rpc.ChannelV1Group, wallet: wallet}, true)
+	return nil
+}
+
+// UnsubscribeChannelEvents stops the delivery of channel events of the given wallet.
+func (c *Client) UnsubscribeChannelEvents(ctx context.Context, wallet string) error {
+	if _, err := c.rpcClient.ChannelsV1Unsubscribe(ctx, rpc.ChannelsV1UnsubscribeRequest{Wallet: wallet}); err != nil {
+		return fmt.Errorf("failed to unsubscribe from channel events: %w", err)
+	}
+	c.trackSubscription(eventSubscription{group: rpc.ChannelV1Group, wallet: wallet}, false)
+	return nil
+}
+
+// SubscribeAppSessionEvents subscribes the connection to updates of the app sessions
+// the given wallet participates in. Events are delivered to the handler registered with
+// WithAppSessionUpdatedHandler.
+func (c *Client) SubscribeAppSessionEvents(ctx context.Context, wallet string) error {
+	if _, err := c.rpcClient.AppSessionsV1Subscribe(ctx, rpc.AppSessionsV1SubscribeRequest{Wallet: wallet}); err != nil {
+		return fmt.Errorf("failed to subscribe to app session events: %w", err)
+	}
+	c.trackSubscription(eventSubscription{group: rpc.AppSessionsV1Group, wallet: wallet}, true)
+	return nil
+}
+
+// UnsubscribeAppSessionEvents stops the delivery of app session events of the given wallet.
+func (c *Client) UnsubscribeAppSessionEvents(ctx context.Context, wallet string) error {
+	if _, err := c.rpcClient.AppSessionsV1Unsubscribe(ctx, rpc.AppSessionsV1UnsubscribeRequest{Wallet: wallet}); err != nil {
+		return fmt.Errorf("failed to unsubscribe from app session events: %w", err)
+	}
+	c.trackSubscription(eventSubscription{group: rpc.AppSessionsV1Group, wallet: wallet}, false)
+	return nil
+}
+
+// trackSubscription records or forgets a subscription so it can be restored after a reconnect.
+func (c *Client) trackSubscription(sub eventSubscription, subscribed bool) {
+	c.subsMu.Lock()
+	defer c.subsMu.Unlock()
+
+	if !subscribed {
+		delete(c.subscriptions, sub)
+		return
+	}
+	if c.subscriptions == nil {
+		c.subscriptions = make(map[eventSubscription]struct{})
+	}
+	c.subscriptions[sub] = struct{}{}
+}
+
+// resubscribe repeats all tracked subscriptions on the current connection.
+func (c *Client) resubscribe(ctx context.Context) error {
+	c.subsMu.Lock()
+	subs := make([]eventSubscription, 0, len(c.subscriptions))
+	for sub := range c.subscriptions {
+		subs = append(subs, sub)
+	}
+	c.subsMu.Unlock()
+
+	for _, sub := range subs {
+		var err error
+		switch sub.group {
+		case rpc.ChannelV1Group:
+			err = c.SubscribeChannelEvents(ctx, sub.wallet)
+		case rpc.AppSessionsV1Group:
+			err = c.SubscribeAppSessionEvents(ctx, sub.wallet)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listenEvents dispatches server-pushed events to the configured handlers
+// until the event channel is closed, the connection is done or the client is closed.
+func (c *Client) listenEvents(eventCh <-chan *rpc.Message, connDone <-chan struct{}) {
+	for {
+		select {
+		case <-c.exitCh:
+			return
+		case <-connDone:
+			return
+		case msg, ok := <-eventCh:
+			if !ok {
+				return
+			}
+			if msg == nil {
+				continue
+			}
+			if err := c.rpcClient.VerifyEvent(msg); err != nil {
+				c.reportError(fmt.Errorf("dropped %s event: %w", msg.Method, err))
+				continue
+			}
+			if err := c.handleEvent(msg); err != nil {
+				c.reportError(err)
+			}
+		}
+	}
+}
+
+// handleEvent decodes a single event message and invokes the matching handler.
+// Events without a registered handler are ignored.
+func (c *Client) handleEvent(msg *rpc.Message) error {
+	if msg == nil || msg.Type != rpc.MsgTypeEvent {
+		return nil
+	}
+
+	switch rpc.Event(msg.Method) {
+	case rpc.ChannelsV1ChannelUpdatedEventName:
+		if c.config.ChannelUpdatedHandler == nil {
+			return nil
+		}
+		var event rpc.ChannelsV1ChannelUpdatedEvent
+		if err := msg.Payload.Translate(&event); err != nil {
+			return fmt.Errorf("failed to parse %s event: %w", msg.Method, err)
+		}
+		channel, err := transformChannel(event.Channel)
+		if err != nil {
+			return fmt.Errorf("failed to transform channel: %w", err)
+		}
+		c.config.ChannelUpdatedHandler(channel)
+
+	case rpc.ChannelsV1TransferReceivedEventName:
+		if c.config.TransferReceivedHandler == nil {
+			return nil
+		}
+		var event rpc.ChannelsV1TransferReceivedEvent
+		if err := msg.Payload.Translate(&event); err != nil {
+			return fmt.Errorf("failed to parse %s event: %w", msg.Method, err)
+		}
+		state, err := transformState(event.State)
+		if err != nil {
+			return fmt.Errorf("failed to transform state: %w", err)
+		}
+		c.config.TransferReceivedHandler(state)
+
+	case rpc.ChannelsV1ConditionalTransferUpdatedEventName:
+		if c.config.ConditionalTransferUpdatedHandler == nil {
+			return nil
+		}
+		var event rpc.ChannelsV1ConditionalTransferUpdatedEvent
+		if err := msg.Payload.Translate(&event); err != nil {
+			return fmt.Errorf("failed to parse %s event: %w", msg.Method, err)
+		}
+		transfer, err := transformConditionalTransfer(event.ConditionalTransfer)
+		if err != nil {
+			return fmt.Errorf("failed to transform conditional transfer: %w", err)
+		}
+		c.config.ConditionalTransferUpdatedHandler(transfer)
+
+	case rpc.ChannelsV1BlockchainActionCompletedEventName:
+		if c.config.BlockchainActionCompletedHandler == nil {
+			return nil
+		}
+		var event rpc.ChannelsV1BlockchainActionCompletedEvent
+		if err := msg.Payload.Translate(&event); err != nil {
+			return fmt.Errorf("failed to parse %s event: %w", msg.Method, err)
+		}
+		blockchainID, err := strconv.ParseUint(event.BlockchainID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse blockchain ID: %w", err)
+		}
+		state, err := transformState(event.State)
+		if err != nil {
+			return fmt.Errorf("failed to transform state: %w", err)
+		}
+		c.config.BlockchainActionCompletedHandler(BlockchainActionCompleted{
+			ActionType:   event.ActionType,
+			BlockchainID: blockchainID,
+			TxHash:       event.TxHash,
+			State:        state,
+		})
+
+	case rpc.AppSessionsV1AppSessionUpdatedEventName:
+		if c.config.AppSessionUpdatedHandler == nil {
+			return nil
+		}
+		var event rpc.AppSessionsV1AppSessionUpdatedEvent
+		if err := msg.Payload.Translate(&event); err != nil {
+			return fmt.Errorf("failed to parse %s event: %w", msg.Method, err)
+		}
+		sessions, err := transformAppSessions([]rpc.AppSessionInfoV1{event.AppSession})
+		if err != nil {
+			return fmt.Errorf("failed to transform app session: %w", err)
+		}
+		c.config.AppSessionUpdatedHandler(sessions[0])
+	}
+
+	return nil
+}
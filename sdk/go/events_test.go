@@ -0,0 +1,208 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/app"
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+func TestClient_SubscribeChannelEvents(t *testing.T) {
+	t.Parallel()
+	mockDialer := NewMockDialer()
+	mockDialer.Dial(context.Background(), "", nil)
+	mockDialer.RegisterResponse(rpc.ChannelsV1SubscribeMethod.String(), rpc.ChannelsV1SubscribeResponse{})
+	mockDialer.RegisterResponse(rpc.ChannelsV1UnsubscribeMethod.String(), rpc.ChannelsV1UnsubscribeResponse{})
+
+	client := &Client{
+		rpcClient: rpc.NewClient(mockDialer),
+	}
+
+	require.NoError(t, client.SubscribeChannelEvents(context.Background(), "0xWallet"))
+	require.NoError(t, client.UnsubscribeChannelEvents(context.Background(), "0xWallet"))
+}
+
+func TestClient_SubscribeAppSessionEvents(t *testing.T) {
+	t.Parallel()
+	mockDialer := NewMockDialer()
+	mockDialer.Dial(context.Background(), "", nil)
+	mockDialer.RegisterResponse(rpc.AppSessionsV1SubscribeMethod.String(), rpc.AppSessionsV1SubscribeResponse{})
+
+	client := &Client{
+		rpcClient: rpc.NewClient(mockDialer),
+	}
+
+	require.NoError(t, client.SubscribeAppSessionEvents(context.Background(), "0xWallet"))
+
+	// Unsubscribe has no registered response
+	require.Error(t, client.UnsubscribeAppSessionEvents(context.Background(), "0xWallet"))
+}
+
+func TestClient_ListenEvents(t *testing.T) {
+	t.Parallel()
+	mockDialer := NewMockDialer()
+	mockDialer.Dial(context.Background(), "", nil)
+
+	channelUpdates := make(chan core.Channel, 1)
+	transfers := make(chan core.State, 1)
+	conditionalTransfers := make(chan core.ConditionalTransfer, 1)
+	actions := make(chan BlockchainActionCompleted, 1)
+	appSessions := make(chan app.AppSessionInfoV1, 1)
+
+	client := &Client{
+		rpcClient: rpc.NewClient(mockDialer),
+		exitCh:    make(chan struct{}),
+		config: Config{
+			ChannelUpdatedHandler:             func(ch core.Channel) { channelUpdates <- ch },
+			TransferReceivedHandler:           func(s core.State) { transfers <- s },
+			ConditionalTransferUpdatedHandler: func(t core.ConditionalTransfer) { conditionalTransfers <- t },
+			BlockchainActionCompletedHandler:  func(a BlockchainActionCompleted) { actions <- a },
+			AppSessionUpdatedHandler:          func(s app.AppSessionInfoV1) { appSessions <- s },
+		},
+	}
+	go client.listenEvents(mockDialer.EventCh(), nil)
+	defer client.Close()
+
+	pushEvent := func(event rpc.Event, data any) {
+		payload, err := rpc.NewPayload(data)
+		require.NoError(t, err)
+		mockDialer.eventCh <- &rpc.Message{Type: rpc.MsgTypeEvent, Method: event.String(), Payload: payload}
+	}
+
+	rpcState := rpc.StateV1{
+		ID:         "0xState",
+		Transition: rpc.TransitionV1{Type: core.TransitionTypeTransferReceive, Amount: "10"},
+		Asset:      "usdc",
+		UserWallet: "0xWallet",
+		Epoch:      "0",
+		Version:    "3",
+		HomeLedger: rpc.LedgerV1{
+			BlockchainID: "137",
+			UserBalance:  "10",
+			UserNetFlow:  "0",
+			NodeBalance:  "0",
+			NodeNetFlow:  "10",
+		},
+	}
+
+	pushEvent(rpc.ChannelsV1ChannelUpdatedEventName, rpc.ChannelsV1ChannelUpdatedEvent{
+		Channel: rpc.ChannelV1{
+			ChannelID:    "0xChannelID",
+			UserWallet:   "0xWallet",
+			Type:         "home",
+			BlockchainID: "137",
+			Status:       "open",
+			StateVersion: "2",
+			Nonce:        "1",
+		},
+	})
+	select {
+	case ch := <-channelUpdates:
+		assert.Equal(t, "0xChannelID", ch.ChannelID)
+		assert.Equal(t, core.ChannelStatusOpen, ch.Status)
+		assert.Equal(t, uint64(2), ch.StateVersion)
+	case <-time.After(time.Second):
+		t.Fatal("channel updated event was not delivered")
+	}
+
+	pushEvent(rpc.ChannelsV1TransferReceivedEventName, rpc.ChannelsV1TransferReceivedEvent{State: rpcState})
+	select {
+	case s := <-transfers:
+		assert.Equal(t, "0xState", s.ID)
+		assert.Equal(t, uint64(3), s.Version)
+	case <-time.After(time.Second):
+		t.Fatal("transfer received event was not delivered")
+	}
+
+	pushEvent(rpc.ChannelsV1ConditionalTransferUpdatedEventName, rpc.ChannelsV1ConditionalTransferUpdatedEvent{
+		ConditionalTransfer: rpc.ConditionalTransferV1{
+			ID:        "0xTransfer",
+			Sender:    "0xWallet",
+			Receiver:  "0xReceiver",
+			Asset:     "usdc",
+			Amount:    "10",
+			HashLock:  "0xHashLock",
+			ExpiresAt: "1700000000",
+			Status:    "refunded",
+		},
+	})
+	select {
+	case transfer := <-conditionalTransfers:
+		assert.Equal(t, "0xTransfer", transfer.ID)
+		assert.Equal(t, "10", transfer.Amount.String())
+		assert.Equal(t, int64(1700000000), transfer.ExpiresAt.Unix())
+		assert.Equal(t, core.ConditionalTransferStatusRefunded, transfer.Status)
+		assert.Nil(t, transfer.Preimage)
+	case <-time.After(time.Second):
+		t.Fatal("conditional transfer updated event was not delivered")
+	}
+
+	pushEvent(rpc.ChannelsV1BlockchainActionCompletedEventName, rpc.ChannelsV1BlockchainActionCompletedEvent{
+		ActionType:   "checkpoint",
+		BlockchainID: "137",
+		TxHash:       "0xTxHash",
+		State:        rpcState,
+	})
+	select {
+	case a := <-actions:
+		assert.Equal(t, "checkpoint", a.ActionType)
+		assert.Equal(t, uint64(137), a.BlockchainID)
+		assert.Equal(t, "0xTxHash", a.TxHash)
+		assert.Equal(t, "0xState", a.State.ID)
+	case <-time.After(time.Second):
+		t.Fatal("blockchain action completed event was not delivered")
+	}
+
+	pushEvent(rpc.AppSessionsV1AppSessionUpdatedEventName, rpc.AppSessionsV1AppSessionUpdatedEvent{
+		AppSession: rpc.AppSessionInfoV1{
+			AppSessionID: "0xSession",
+			Status:       "open",
+			AppDefinitionV1: rpc.AppDefinitionV1{
+				Application: "app",
+				Participants: []rpc.AppParticipantV1{
+					{WalletAddress: "0xWallet", SignatureWeight: 1},
+				},
+				Quorum: 1,
+				Nonce:  "1",
+			},
+			Version:     "5",
+			Allocations: []rpc.AppAllocationV1{{Participant: "0xWallet", Asset: "usdc", Amount: "7"}},
+		},
+	})
+	select {
+	case s := <-appSessions:
+		assert.Equal(t, "0xSession", s.AppSessionID)
+		assert.Equal(t, uint64(5), s.Version)
+		require.Len(t, s.Allocations, 1)
+		assert.Equal(t, "7", s.Allocations[0].Amount.String())
+	case <-time.After(time.Second):
+		t.Fatal("app session updated event was not delivered")
+	}
+}
+
+func TestClient_HandleEvent_InvalidPayload(t *testing.T) {
+	t.Parallel()
+	client := &Client{
+		config: Config{
+			ChannelUpdatedHandler: func(core.Channel) { t.Fatal("handler must not be called") },
+		},
+	}
+
+	payload, err := rpc.NewPayload(rpc.ChannelsV1ChannelUpdatedEvent{
+		Channel: rpc.ChannelV1{ChannelID: "0xChannelID", Type: "home", BlockchainID: "invalid", StateVersion: "1", Nonce: "1"},
+	})
+	require.NoError(t, err)
+
+	err = client.handleEvent(&rpc.Message{Type: rpc.MsgTypeEvent, Method: rpc.ChannelsV1ChannelUpdatedEventName.String(), Payload: payload})
+	require.Error(t, err)
+
+	// Events without a registered handler are ignored
+	err = client.handleEvent(&rpc.Message{Type: rpc.MsgTypeEvent, Method: rpc.ChannelsV1TransferReceivedEventName.String(), Payload: payload})
+	require.NoError(t, err)
+}
@@ -6,29 +6,74 @@ import (
 	"sync"
 
 	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/layer-3/nitrolite/pkg/sign"
 )
 
 type MockDialer struct {
-	mu        sync.Mutex
-	responses map[string]interface{}
-	connected bool
-	eventCh   chan *rpc.Message
+	mu            sync.Mutex
+	responses     map[string]interface{}
+	connected     bool
+	eventCh       chan *rpc.Message
+	handleClosure func(err error)
+	dialCount     int
+	failDials     int
+	calls         map[string]int
+	signer        sign.Signer
 }
 
 func NewMockDialer() *MockDialer {
 	return &MockDialer{
 		responses: make(map[string]interface{}),
 		eventCh:   make(chan *rpc.Message, 10),
+		calls:     make(map[string]int),
 	}
 }
 
 func (m *MockDialer) Dial(ctx context.Context, url string, handleClosure func(err error)) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	m.dialCount++
+	if m.failDials > 0 {
+		m.failDials--
+		return fmt.Errorf("dial failed")
+	}
 	m.connected = true
+	m.handleClosure = handleClosure
 	return nil
 }
 
+// Disconnect simulates a transport loss, invoking the closure handler passed to Dial.
+func (m *MockDialer) Disconnect(err error) {
+	m.mu.Lock()
+	m.connected = false
+	handleClosure := m.handleClosure
+	m.handleClosure = nil
+	m.mu.Unlock()
+
+	if handleClosure != nil {
+		handleClosure(err)
+	}
+}
+
+// FailDials makes the next n Dial calls fail.
+func (m *MockDialer) FailDials(n int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.failDials = n
+}
+
+func (m *MockDialer) DialCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.dialCount
+}
+
+func (m *MockDialer) CallCount(method string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls[method]
+}
+
 func (m *MockDialer) IsConnected() bool {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -39,8 +84,9 @@ func (m *MockDialer) Call(ctx context.Context, req *rpc.Message) (*rpc.Message,
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	m.calls[req.Method]++
 	if !m.connected {
-		return nil, fmt.Errorf("not connected")
+		return nil, rpc.ErrNotConnected
 	}
 
 	respData, ok := m.responses[req.Method]
@@ -54,12 +100,20 @@ func (m *MockDialer) Call(ctx context.Context, req *rpc.Message) (*rpc.Message,
 		return nil, err
 	}
 
-	return &rpc.Message{
-		Type:      rpc.MsgTypeResp,
-		RequestID: req.RequestID,
-		Method:    req.Method,
-		Payload:   payload,
-	}, nil
+	res := rpc.NewResponse(req.RequestID, req.Method, payload)
+	if m.signer != nil {
+		if err := res.Sign(m.signer, req); err != nil {
+			return nil, err
+		}
+	}
+	return &res, nil
+}
+
+// SignResponses makes the dialer sign all further responses with the signer.
+func (m *MockDialer) SignResponses(signer sign.Signer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.signer = signer
 }
 
 func (m *MockDialer) EventCh() <-chan *rpc.Message {
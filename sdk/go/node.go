@@ -31,7 +31,7 @@ func (c *Client) Ping(ctx context.Context) error {
 // GetConfig retrieves the clearnode configuration including node identity and supported blockchains.
 //
 // Returns:
-//   - NodeConfig containing the node address, version, and list of supported blockchain networks
+//   - NodeConfig containing the node address, version, list of supported blockchain networks and fee schedule
 //   - Error if the request fails
 //
 // Example:
@@ -49,6 +49,29 @@ func (c *Client) GetConfig(ctx context.Context) (*core.NodeConfig, error) {
 	return transformNodeConfig(resp)
 }
 
+// getNodeConfig retrieves the node configuration and records it in the StateStore.
+// If the Node is unreachable, the last recorded configuration is returned instead.
+func (c *Client) getNodeConfig(ctx context.Context) (*core.NodeConfig, error) {
+	config, err := c.GetConfig(ctx)
+	if err == nil {
+		if c.config.StateStore != nil {
+			if saveErr := c.config.StateStore.SaveNodeConfig(*config); saveErr != nil {
+				c.reportError(fmt.Errorf("failed to record node config: %w", saveErr))
+			}
+		}
+		return config, nil
+	}
+
+	if !isConnectionError(err) || c.config.StateStore == nil {
+		return nil, err
+	}
+	stored, storeErr := c.config.StateStore.GetNodeConfig()
+	if storeErr != nil || stored == nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
 // GetBlockchains retrieves the list of supported blockchain networks.
 // This is a convenience method that calls GetConfig and extracts the blockchains list.
 //
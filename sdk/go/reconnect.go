@@ -0,0 +1,242 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+)
+
+// ============================================================================
+// Connection Recovery
+// ============================================================================
+
+// ReconnectConfig configures how the client recovers from a lost connection to the Node.
+type ReconnectConfig struct {
+	// MaxAttempts is the maximum number of consecutive reconnection attempts.
+	// Zero means the client keeps trying until it is closed.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first reconnection attempt, must be positive
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the delay between reconnection attempts
+	MaxBackoff time.Duration
+
+	// Multiplier is the factor the delay grows by after every failed attempt
+	Multiplier float64
+
+	// MaxCallRetries is how many times idempotent calls (GetLatestState, GetBalances)
+	// are retried when they fail because the connection is down
+	MaxCallRetries int
+
+	// ResyncTimeout bounds the re-subscription and state resync performed after reconnecting
+	ResyncTimeout time.Duration
+}
+
+// DefaultReconnectConfig reconnects indefinitely with an exponential backoff between 500ms and 30s.
+var DefaultReconnectConfig = ReconnectConfig{
+	MaxAttempts:    0,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     30 * time.Second,
+	Multiplier:     2,
+	MaxCallRetries: 3,
+	ResyncTimeout:  30 * time.Second,
+}
+
+// validate checks that the configuration lets the client back off between attempts.
+func (rc ReconnectConfig) validate() error {
+	if rc.InitialBackoff <= 0 {
+		return fmt.Errorf("initial backoff must be positive, got %s", rc.InitialBackoff)
+	}
+	return nil
+}
+
+// nextBackoff returns the delay to wait after the provided one.
+func (rc ReconnectConfig) nextBackoff(current time.Duration) time.Duration {
+	next := time.Duration(float64(current) * rc.Multiplier)
+	if next <= current {
+		next = current
+	}
+	if rc.MaxBackoff > 0 && next > rc.MaxBackoff {
+		next = rc.MaxBackoff
+	}
+	return next
+}
+
+// connect dials the Node and starts dispatching events received on the new connection.
+// The connection is closed when the client is closed.
+func (c *Client) connect() error {
+	connCtx, cancel := context.WithCancel(context.Background())
+	connDone := make(chan struct{})
+
+	handleClosure := func(err error) {
+		cancel()
+		close(connDone)
+		c.handleDisconnect(err)
+	}
+
+	if err := c.rpcClient.Start(connCtx, c.config.URL, handleClosure); err != nil {
+		cancel()
+		return err
+	}
+
+	c.connMu.Lock()
+	c.cancelConn = cancel
+	c.connMu.Unlock()
+
+	// The client may have been closed while dialing
+	if c.isClosed() {
+		cancel()
+		return nil
+	}
+
+	go c.listenEvents(c.rpcClient.EventCh(), connDone)
+	return nil
+}
+
+// handleDisconnect reports the connection loss and either starts reconnecting
+// or closes the client if reconnection is disabled.
+func (c *Client) handleDisconnect(err error) {
+	if c.isClosed() {
+		return
+	}
+
+	c.reportError(err)
+
+	if c.config.Reconnect == nil {
+		c.doClose()
+		return
+	}
+
+	go c.reconnect()
+}
+
+// reconnect redials the Node with exponential backoff. Once connected, it restores
+// event subscriptions and resyncs the latest signed states. If all attempts fail, the client is closed.
+func (c *Client) reconnect() {
+	rc := *c.config.Reconnect
+	backoff := rc.InitialBackoff
+
+	for attempt := 1; rc.MaxAttempts == 0 || attempt <= rc.MaxAttempts; attempt++ {
+		select {
+		case <-c.exitCh:
+			return
+		case <-time.After(backoff):
+		}
+
+		if err := c.connect(); err != nil {
+			c.reportError(fmt.Errorf("reconnect attempt %d failed: %w", attempt, err))
+			backoff = rc.nextBackoff(backoff)
+			continue
+		}
+
+		c.resync()
+		return
+	}
+
+	c.reportError(fmt.Errorf("failed to reconnect after %d attempts", rc.MaxAttempts))
+	c.doClose()
+}
+
+// resync restores the authentication and event subscriptions of the previous connection
+// and passes the latest signed state of every asset of the user to the ResyncHandler.
+func (c *Client) resync() {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.Reconnect.ResyncTimeout)
+	defer cancel()
+
+	if c.authenticated.Load() {
+		if err := c.authenticate(ctx); err != nil {
+			c.reportError(fmt.Errorf("failed to restore authentication: %w", err))
+		}
+	}
+
+	if err := c.resubscribe(ctx); err != nil {
+		c.reportError(fmt.Errorf("failed to restore subscriptions: %w", err))
+	}
+
+	if c.config.ResyncHandler == nil {
+		return
+	}
+
+	states, err := c.fetchLatestSignedStates(ctx)
+	if err != nil {
+		c.reportError(fmt.Errorf("failed to resync states: %w", err))
+		return
+	}
+	c.config.ResyncHandler(states)
+}
+
+// fetchLatestSignedStates returns the latest signed state of the user keyed by asset,
+// for every asset the user holds a balance in.
+func (c *Client) fetchLatestSignedStates(ctx context.Context) (map[string]core.State, error) {
+	wallet := c.GetUserAddress()
+
+	balances, err := c.GetBalances(ctx, wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make(map[string]core.State, len(balances))
+	for _, balance := range balances {
+		state, err := c.GetLatestState(ctx, wallet, balance.Asset, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get latest state for asset %s: %w", balance.Asset, err)
+		}
+		states[balance.Asset] = *state
+	}
+	return states, nil
+}
+
+// withRetry runs an idempotent call, retrying it with backoff while it fails
+// because the connection is down. Calls are not retried if reconnection is disabled.
+func (c *Client) withRetry(ctx context.Context, call func() error) error {
+	err := call()
+	if c.config.Reconnect == nil {
+		return err
+	}
+
+	rc := *c.config.Reconnect
+	backoff := rc.InitialBackoff
+	for attempt := 0; attempt < rc.MaxCallRetries && isConnectionError(err); attempt++ {
+		select {
+		case <-ctx.Done():
+			return err
+		case <-c.exitCh:
+			return err
+		case <-time.After(backoff):
+		}
+
+		err = call()
+		backoff = rc.nextBackoff(backoff)
+	}
+	return err
+}
+
+// isConnectionError reports whether the error was caused by a missing connection
+// rather than by the Node rejecting the request.
+func isConnectionError(err error) bool {
+	return errors.Is(err, rpc.ErrNotConnected) ||
+		errors.Is(err, rpc.ErrNoResponse) ||
+		errors.Is(err, rpc.ErrSendingRequest)
+}
+
+// isClosed reports whether the client was closed.
+func (c *Client) isClosed() bool {
+	select {
+	case <-c.exitCh:
+		return true
+	default:
+		return false
+	}
+}
+
+// reportError passes the error to the configured ErrorHandler, if any.
+func (c *Client) reportError(err error) {
+	if c.config.ErrorHandler != nil {
+		c.config.ErrorHandler(err)
+	}
+}
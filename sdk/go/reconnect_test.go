@@ -0,0 +1,236 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/layer-3/nitrolite/pkg/sign"
+)
+
+var testReconnectConfig = ReconnectConfig{
+	MaxAttempts:    5,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     10 * time.Millisecond,
+	Multiplier:     2,
+	MaxCallRetries: 3,
+	ResyncTimeout:  time.Second,
+}
+
+func newReconnectTestClient(t *testing.T, mockDialer *MockDialer, config Config) *Client {
+	t.Helper()
+
+	pk, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	rawSigner, err := sign.NewEthereumRawSigner(hexutil.Encode(crypto.FromECDSA(pk)))
+	require.NoError(t, err)
+
+	client := &Client{
+		rpcClient: rpc.NewClient(mockDialer),
+		config:    config,
+		exitCh:    make(chan struct{}),
+		rawSigner: rawSigner,
+	}
+	require.NoError(t, client.connect())
+	t.Cleanup(func() { client.Close() })
+
+	return client
+}
+
+func TestClient_Reconnect_RestoresSubscriptionsAndResyncs(t *testing.T) {
+	t.Parallel()
+	mockDialer := NewMockDialer()
+	mockDialer.RegisterResponse(rpc.ChannelsV1SubscribeMethod.String(), rpc.ChannelsV1SubscribeResponse{})
+	mockDialer.RegisterResponse(rpc.UserV1GetBalancesMethod.String(), rpc.UserV1GetBalancesResponse{
+		Balances: []rpc.BalanceEntryV1{{Asset: "usdc", Amount: "10"}},
+	})
+	mockDialer.RegisterResponse(rpc.ChannelsV1GetLatestStateMethod.String(), rpc.ChannelsV1GetLatestStateResponse{
+		State: rpc.StateV1{
+			ID:         "0xState",
+			Transition: rpc.TransitionV1{Amount: "0"},
+			Asset:      "usdc",
+			UserWallet: "0xWallet",
+			Epoch:      "0",
+			Version:    "4",
+			HomeLedger: rpc.LedgerV1{
+				BlockchainID: "137",
+				UserBalance:  "10",
+				UserNetFlow:  "10",
+				NodeBalance:  "0",
+				NodeNetFlow:  "0",
+			},
+		},
+	})
+
+	resynced := make(chan map[string]core.State, 1)
+	config := Config{
+		Reconnect:     &testReconnectConfig,
+		ResyncHandler: func(states map[string]core.State) { resynced <- states },
+	}
+	client := newReconnectTestClient(t, mockDialer, config)
+
+	require.NoError(t, client.SubscribeChannelEvents(context.Background(), "0xWallet"))
+	assert.Equal(t, 1, mockDialer.CallCount(rpc.ChannelsV1SubscribeMethod.String()))
+
+	// Two failed dials before the connection is restored
+	mockDialer.FailDials(2)
+	mockDialer.Disconnect(errors.New("connection reset"))
+
+	select {
+	case states := <-resynced:
+		require.Contains(t, states, "usdc")
+		assert.Equal(t, "0xState", states["usdc"].ID)
+		assert.Equal(t, uint64(4), states["usdc"].Version)
+	case <-time.After(time.Second):
+		t.Fatal("resync handler was not called")
+	}
+
+	assert.Equal(t, 4, mockDialer.DialCount())
+	assert.Equal(t, 2, mockDialer.CallCount(rpc.ChannelsV1SubscribeMethod.String()))
+
+	select {
+	case <-client.WaitCh():
+		t.Fatal("client must stay open after reconnecting")
+	default:
+	}
+}
+
+func TestClient_Reconnect_GivesUpAfterMaxAttempts(t *testing.T) {
+	t.Parallel()
+	mockDialer := NewMockDialer()
+
+	var reportedErrs []error
+	errCh := make(chan error, 10)
+	config := Config{
+		Reconnect:    &testReconnectConfig,
+		ErrorHandler: func(err error) { errCh <- err },
+	}
+	client := newReconnectTestClient(t, mockDialer, config)
+
+	mockDialer.FailDials(testReconnectConfig.MaxAttempts)
+	mockDialer.Disconnect(errors.New("connection reset"))
+
+	select {
+	case <-client.WaitCh():
+	case <-time.After(time.Second):
+		t.Fatal("client must close after exhausting reconnection attempts")
+	}
+
+	for len(errCh) > 0 {
+		reportedErrs = append(reportedErrs, <-errCh)
+	}
+	// The disconnect, every failed attempt and the final give up are reported
+	assert.Len(t, reportedErrs, testReconnectConfig.MaxAttempts+2)
+	assert.Equal(t, testReconnectConfig.MaxAttempts+1, mockDialer.DialCount())
+}
+
+func TestClient_Disconnect_WithoutReconnect_Closes(t *testing.T) {
+	t.Parallel()
+	mockDialer := NewMockDialer()
+	client := newReconnectTestClient(t, mockDialer, Config{})
+
+	mockDialer.Disconnect(errors.New("connection reset"))
+
+	select {
+	case <-client.WaitCh():
+	case <-time.After(time.Second):
+		t.Fatal("client must close when reconnection is disabled")
+	}
+	assert.Equal(t, 1, mockDialer.DialCount())
+}
+
+func TestClient_IdempotentCall_RetriedWhileReconnecting(t *testing.T) {
+	t.Parallel()
+	mockDialer := NewMockDialer()
+	mockDialer.RegisterResponse(rpc.UserV1GetBalancesMethod.String(), rpc.UserV1GetBalancesResponse{
+		Balances: []rpc.BalanceEntryV1{{Asset: "usdc", Amount: "10"}},
+	})
+
+	rc := testReconnectConfig
+	rc.InitialBackoff = 20 * time.Millisecond
+	client := newReconnectTestClient(t, mockDialer, Config{Reconnect: &rc})
+
+	// The first call hits the dropped connection, the retry succeeds after reconnecting
+	mockDialer.Disconnect(errors.New("connection reset"))
+
+	balances, err := client.GetBalances(context.Background(), "0xWallet")
+	require.NoError(t, err)
+	require.Len(t, balances, 1)
+	assert.GreaterOrEqual(t, mockDialer.CallCount(rpc.UserV1GetBalancesMethod.String()), 2)
+}
+
+func TestClient_IdempotentCall_NotRetriedWithoutReconnect(t *testing.T) {
+	t.Parallel()
+	mockDialer := NewMockDialer()
+
+	client := &Client{
+		rpcClient: rpc.NewClient(mockDialer),
+		exitCh:    make(chan struct{}),
+	}
+
+	_, err := client.GetLatestState(context.Background(), "0xWallet", "usdc", true)
+	require.ErrorIs(t, err, rpc.ErrNotConnected)
+	assert.Equal(t, 1, mockDialer.CallCount(rpc.ChannelsV1GetLatestStateMethod.String()))
+}
+
+func TestReconnectConfig_NextBackoff(t *testing.T) {
+	t.Parallel()
+	rc := ReconnectConfig{Multiplier: 2, MaxBackoff: 3 * time.Second}
+
+	assert.Equal(t, 2*time.Second, rc.nextBackoff(time.Second))
+	assert.Equal(t, 3*time.Second, rc.nextBackoff(2*time.Second))
+	assert.Equal(t, 3*time.Second, rc.nextBackoff(3*time.Second))
+}
+
+func TestReconnectConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, DefaultReconnectConfig.validate())
+
+	rc := DefaultReconnectConfig
+	rc.InitialBackoff = 0
+	assert.ErrorContains(t, rc.validate(), "initial backoff must be positive")
+
+	// The client is not created, rather than spinning against the Node once the connection is lost
+	_, err := NewClient("ws://127.0.0.1:0", nil, nil, WithReconnect(rc))
+	assert.ErrorContains(t, err, "invalid reconnect config")
+}
+
+func TestClient_Reconnect_RestoresAuthentication(t *testing.T) {
+	t.Parallel()
+	mockDialer := NewMockDialer()
+	mockDialer.RegisterResponse(rpc.AuthV1RequestChallengeMethod.String(), rpc.AuthV1RequestChallengeResponse{Challenge: "0xchallenge"})
+	mockDialer.RegisterResponse(rpc.AuthV1AuthenticateMethod.String(), rpc.AuthV1AuthenticateResponse{})
+
+	errCh := make(chan error, 10)
+	config := Config{
+		Reconnect:    &testReconnectConfig,
+		ErrorHandler: func(err error) { errCh <- err },
+	}
+	client := newReconnectTestClient(t, mockDialer, config)
+	msgSigner, err := sign.NewEthereumMsgSignerFromRaw(client.rawSigner)
+	require.NoError(t, err)
+	client.stateSigner, err = core.NewChannelDefaultSigner(msgSigner)
+	require.NoError(t, err)
+
+	require.NoError(t, client.Authenticate(context.Background()))
+	assert.Equal(t, 1, mockDialer.CallCount(rpc.AuthV1AuthenticateMethod.String()))
+
+	mockDialer.Disconnect(errors.New("connection reset"))
+
+	require.Eventually(t, func() bool {
+		return mockDialer.CallCount(rpc.AuthV1AuthenticateMethod.String()) == 2
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, 2, mockDialer.CallCount(rpc.AuthV1RequestChallengeMethod.String()))
+
+	// Only the disconnect is reported
+	assert.Len(t, errCh, 1)
+}
@@ -0,0 +1,124 @@
+package sdk
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+)
+
+// ============================================================================
+// Local State Archive
+// ============================================================================
+
+// StateStore persists the co-signed states of the user together with the node metadata
+// required to settle them on-chain. It allows disputes to be raised while the Node is unreachable.
+//
+// Two implementations are provided: NewMemoryStateStore and the SQLite store in sdk/go/store/sqlite.
+type StateStore interface {
+	// SaveState records a co-signed state. States older than the stored one
+	// for the same wallet and asset are ignored.
+	SaveState(state core.State) error
+
+	// GetLatestState returns the latest recorded state of the wallet for the asset.
+	// Returns nil if no state was recorded.
+	GetLatestState(wallet, asset string) (*core.State, error)
+
+	// SaveNodeConfig records the last known configuration of the Node.
+	SaveNodeConfig(config core.NodeConfig) error
+
+	// GetNodeConfig returns the recorded configuration of the Node, or nil if none was recorded.
+	GetNodeConfig() (*core.NodeConfig, error)
+
+	// SaveAssets records the last known assets supported by the Node.
+	SaveAssets(assets []core.Asset) error
+
+	// GetAssets returns the recorded assets, or nil if none were recorded.
+	GetAssets() ([]core.Asset, error)
+}
+
+// IsNewerState reports whether state a supersedes state b.
+// States of a later epoch always supersede states of earlier epochs.
+func IsNewerState(a, b core.State) bool {
+	if a.Epoch != b.Epoch {
+		return a.Epoch > b.Epoch
+	}
+	return a.Version > b.Version
+}
+
+// MemoryStateStore is a StateStore that keeps everything in memory.
+// Recorded states are lost when the process exits.
+type MemoryStateStore struct {
+	mu         sync.RWMutex
+	states     map[string]core.State
+	nodeConfig *core.NodeConfig
+	assets     []core.Asset
+}
+
+// NewMemoryStateStore creates an empty in-memory state store.
+func NewMemoryStateStore() *MemoryStateStore {
+	return &MemoryStateStore{
+		states: make(map[string]core.State),
+	}
+}
+
+func (s *MemoryStateStore) SaveState(state core.State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := stateStoreKey(state.UserWallet, state.Asset)
+	if stored, ok := s.states[key]; ok && !IsNewerState(state, stored) {
+		return nil
+	}
+	s.states[key] = state
+	return nil
+}
+
+func (s *MemoryStateStore) GetLatestState(wallet, asset string) (*core.State, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	state, ok := s.states[stateStoreKey(wallet, asset)]
+	if !ok {
+		return nil, nil
+	}
+	return &state, nil
+}
+
+func (s *MemoryStateStore) SaveNodeConfig(config core.NodeConfig) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nodeConfig = &config
+	return nil
+}
+
+func (s *MemoryStateStore) GetNodeConfig() (*core.NodeConfig, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.nodeConfig == nil {
+		return nil, nil
+	}
+	config := *s.nodeConfig
+	return &config, nil
+}
+
+func (s *MemoryStateStore) SaveAssets(assets []core.Asset) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.assets = append([]core.Asset(nil), assets...)
+	return nil
+}
+
+func (s *MemoryStateStore) GetAssets() ([]core.Asset, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]core.Asset(nil), s.assets...), nil
+}
+
+func stateStoreKey(wallet, asset string) string {
+	return strings.ToLower(wallet) + ":" + strings.ToLower(asset)
+}
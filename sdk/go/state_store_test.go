@@ -0,0 +1,69 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+)
+
+func TestMemoryStateStore_SaveState(t *testing.T) {
+	t.Parallel()
+	store := NewMemoryStateStore()
+
+	state, err := store.GetLatestState("0xWallet", "usdc")
+	require.NoError(t, err)
+	assert.Nil(t, state)
+
+	require.NoError(t, store.SaveState(core.State{ID: "v2", UserWallet: "0xWallet", Asset: "usdc", Version: 2}))
+	require.NoError(t, store.SaveState(core.State{ID: "v1", UserWallet: "0xWallet", Asset: "usdc", Version: 1}))
+
+	// Lookups are case-insensitive and older versions are ignored
+	state, err = store.GetLatestState("0xwallet", "USDC")
+	require.NoError(t, err)
+	require.NotNil(t, state)
+	assert.Equal(t, "v2", state.ID)
+
+	// A new epoch supersedes any version of the previous one
+	require.NoError(t, store.SaveState(core.State{ID: "e1v0", UserWallet: "0xWallet", Asset: "usdc", Epoch: 1, Version: 0}))
+	state, err = store.GetLatestState("0xWallet", "usdc")
+	require.NoError(t, err)
+	assert.Equal(t, "e1v0", state.ID)
+}
+
+func TestMemoryStateStore_Metadata(t *testing.T) {
+	t.Parallel()
+	store := NewMemoryStateStore()
+
+	config, err := store.GetNodeConfig()
+	require.NoError(t, err)
+	assert.Nil(t, config)
+
+	assets, err := store.GetAssets()
+	require.NoError(t, err)
+	assert.Empty(t, assets)
+
+	require.NoError(t, store.SaveNodeConfig(core.NodeConfig{NodeAddress: "0xNode"}))
+	require.NoError(t, store.SaveAssets([]core.Asset{{Symbol: "usdc", Decimals: 6}}))
+
+	config, err = store.GetNodeConfig()
+	require.NoError(t, err)
+	require.NotNil(t, config)
+	assert.Equal(t, "0xNode", config.NodeAddress)
+
+	assets, err = store.GetAssets()
+	require.NoError(t, err)
+	require.Len(t, assets, 1)
+	assert.Equal(t, "usdc", assets[0].Symbol)
+}
+
+func TestIsNewerState(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsNewerState(core.State{Version: 2}, core.State{Version: 1}))
+	assert.False(t, IsNewerState(core.State{Version: 1}, core.State{Version: 1}))
+	assert.True(t, IsNewerState(core.State{Epoch: 1, Version: 0}, core.State{Epoch: 0, Version: 9}))
+	assert.False(t, IsNewerState(core.State{Epoch: 0, Version: 9}, core.State{Epoch: 1, Version: 0}))
+}
@@ -0,0 +1,160 @@
+// Package sqlite provides a SQLite-backed implementation of the SDK state store,
+// so that co-signed states survive restarts of the application.
+//
+// Example:
+//
+//	store, err := sqlite.NewStateStore(filepath.Join(dataDir, "states.db"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer store.Close()
+//
+//	client, err := sdk.NewClient(wsURL, stateSigner, txSigner, sdk.WithStateStore(store))
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+)
+
+const (
+	nodeConfigKey = "node_config"
+	assetsKey     = "assets"
+)
+
+// StateStore is a sdk.StateStore persisting states and node metadata in a SQLite database.
+type StateStore struct {
+	db *sql.DB
+}
+
+// NewStateStore opens or creates the SQLite database at the given path.
+func NewStateStore(path string) (*StateStore, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS states (
+			wallet TEXT NOT NULL,
+			asset TEXT NOT NULL,
+			epoch INTEGER NOT NULL,
+			version INTEGER NOT NULL,
+			state TEXT NOT NULL,
+			PRIMARY KEY (wallet, asset)
+		);
+		CREATE TABLE IF NOT EXISTS metadata (
+			key TEXT PRIMARY KEY,
+			value TEXT NOT NULL
+		);
+	`)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create tables: %w", err)
+	}
+
+	return &StateStore{db: db}, nil
+}
+
+// Close closes the underlying database.
+func (s *StateStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *StateStore) SaveState(state core.State) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to encode state: %w", err)
+	}
+
+	// Only newer states replace the stored one
+	_, err = s.db.Exec(`
+		INSERT INTO states (wallet, asset, epoch, version, state) VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (wallet, asset) DO UPDATE SET
+			epoch = excluded.epoch,
+			version = excluded.version,
+			state = excluded.state
+		WHERE excluded.epoch > states.epoch
+			OR (excluded.epoch = states.epoch AND excluded.version > states.version)`,
+		strings.ToLower(state.UserWallet), strings.ToLower(state.Asset), state.Epoch, state.Version, string(data))
+	if err != nil {
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+	return nil
+}
+
+func (s *StateStore) GetLatestState(wallet, asset string) (*core.State, error) {
+	var data string
+	err := s.db.QueryRow("SELECT state FROM states WHERE wallet = ? AND asset = ?",
+		strings.ToLower(wallet), strings.ToLower(asset)).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get state: %w", err)
+	}
+
+	var state core.State
+	if err := json.Unmarshal([]byte(data), &state); err != nil {
+		return nil, fmt.Errorf("failed to decode state: %w", err)
+	}
+	return &state, nil
+}
+
+func (s *StateStore) SaveNodeConfig(config core.NodeConfig) error {
+	return s.setMetadata(nodeConfigKey, config)
+}
+
+func (s *StateStore) GetNodeConfig() (*core.NodeConfig, error) {
+	var config core.NodeConfig
+	found, err := s.getMetadata(nodeConfigKey, &config)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &config, nil
+}
+
+func (s *StateStore) SaveAssets(assets []core.Asset) error {
+	return s.setMetadata(assetsKey, assets)
+}
+
+func (s *StateStore) GetAssets() ([]core.Asset, error) {
+	var assets []core.Asset
+	if _, err := s.getMetadata(assetsKey, &assets); err != nil {
+		return nil, err
+	}
+	return assets, nil
+}
+
+func (s *StateStore) setMetadata(key string, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", key, err)
+	}
+	if _, err := s.db.Exec("INSERT OR REPLACE INTO metadata (key, value) VALUES (?, ?)", key, string(data)); err != nil {
+		return fmt.Errorf("failed to save %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *StateStore) getMetadata(key string, value any) (bool, error) {
+	var data string
+	err := s.db.QueryRow("SELECT value FROM metadata WHERE key = ?", key).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get %s: %w", key, err)
+	}
+	if err := json.Unmarshal([]byte(data), value); err != nil {
+		return false, fmt.Errorf("failed to decode %s: %w", key, err)
+	}
+	return true, nil
+}
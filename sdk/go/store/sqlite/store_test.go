@@ -0,0 +1,98 @@
+package sqlite_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	sdk "github.com/layer-3/nitrolite/sdk/go"
+	"github.com/layer-3/nitrolite/sdk/go/store/sqlite"
+)
+
+var _ sdk.StateStore = &sqlite.StateStore{}
+
+func newTestStore(t *testing.T, path string) *sqlite.StateStore {
+	t.Helper()
+
+	store, err := sqlite.NewStateStore(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestStateStore_SaveState(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t, filepath.Join(t.TempDir(), "states.db"))
+
+	state, err := store.GetLatestState("0xWallet", "usdc")
+	require.NoError(t, err)
+	assert.Nil(t, state)
+
+	require.NoError(t, store.SaveState(core.State{ID: "v2", UserWallet: "0xWallet", Asset: "usdc", Version: 2}))
+	require.NoError(t, store.SaveState(core.State{ID: "v1", UserWallet: "0xWallet", Asset: "usdc", Version: 1}))
+
+	// Lookups are case-insensitive and older versions are ignored
+	state, err = store.GetLatestState("0xwallet", "USDC")
+	require.NoError(t, err)
+	require.NotNil(t, state)
+	assert.Equal(t, "v2", state.ID)
+	assert.Equal(t, uint64(2), state.Version)
+
+	// A new epoch supersedes any version of the previous one
+	require.NoError(t, store.SaveState(core.State{ID: "e1v0", UserWallet: "0xWallet", Asset: "usdc", Epoch: 1, Version: 0}))
+	state, err = store.GetLatestState("0xWallet", "usdc")
+	require.NoError(t, err)
+	assert.Equal(t, "e1v0", state.ID)
+}
+
+func TestStateStore_Metadata(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t, filepath.Join(t.TempDir(), "states.db"))
+
+	config, err := store.GetNodeConfig()
+	require.NoError(t, err)
+	assert.Nil(t, config)
+
+	assets, err := store.GetAssets()
+	require.NoError(t, err)
+	assert.Empty(t, assets)
+
+	require.NoError(t, store.SaveNodeConfig(core.NodeConfig{NodeAddress: "0xNode"}))
+	require.NoError(t, store.SaveAssets([]core.Asset{{Symbol: "usdc", Decimals: 6}}))
+
+	config, err = store.GetNodeConfig()
+	require.NoError(t, err)
+	require.NotNil(t, config)
+	assert.Equal(t, "0xNode", config.NodeAddress)
+
+	assets, err = store.GetAssets()
+	require.NoError(t, err)
+	require.Len(t, assets, 1)
+	assert.Equal(t, "usdc", assets[0].Symbol)
+}
+
+func TestStateStore_Persistence(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "states.db")
+
+	store, err := sqlite.NewStateStore(path)
+	require.NoError(t, err)
+	require.NoError(t, store.SaveState(core.State{ID: "v3", UserWallet: "0xWallet", Asset: "usdc", Version: 3}))
+	require.NoError(t, store.SaveNodeConfig(core.NodeConfig{NodeAddress: "0xNode"}))
+	require.NoError(t, store.Close())
+
+	reopened := newTestStore(t, path)
+
+	state, err := reopened.GetLatestState("0xWallet", "usdc")
+	require.NoError(t, err)
+	require.NotNil(t, state)
+	assert.Equal(t, "v3", state.ID)
+
+	config, err := reopened.GetNodeConfig()
+	require.NoError(t, err)
+	require.NotNil(t, config)
+	assert.Equal(t, "0xNode", config.NodeAddress)
+}
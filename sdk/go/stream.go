@@ -0,0 +1,298 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/layer-3/nitrolite/pkg/sign"
+)
+
+// ============================================================================
+// Payment Stream Methods
+// ============================================================================
+
+// CreatePaymentStream authorizes a payment stream from the client's wallet to the recipient.
+// The stream accrues rate per second from its creation until expiresAt, up to streamCap in total.
+// The node settles the accrued amount periodically as regular transfers from the
+// client's home channel.
+//
+// The authorization is signed with the client's main wallet signer; session key
+// signers are not allowed to perform this action.
+//
+// Parameters:
+//   - recipientWallet: The recipient's wallet address
+//   - asset: The asset symbol to stream (e.g., "usdc")
+//   - rate: The amount accrued per second
+//   - streamCap: The maximum total amount transferred by the stream
+//   - expiresAt: The time after which the stream stops accruing
+//   - nonce: A nonce distinguishing otherwise identical streams
+//
+// Returns:
+//   - The created payment stream
+//   - Error if the request fails
+//
+// Example:
+//
+//	stream, err := client.CreatePaymentStream(ctx, "0xRecipient...", "usdc",
+//	    decimal.RequireFromString("0.01"), decimal.NewFromInt(100), time.Now().Add(24*time.Hour), 1)
+//	fmt.Printf("Stream %s created\n", stream.ID)
+func (c *Client) CreatePaymentStream(ctx context.Context, recipientWallet, asset string, rate, streamCap decimal.Decimal, expiresAt time.Time, nonce uint64) (*core.PaymentStream, error) {
+	auth := core.PaymentStreamAuthorization{
+		Sender:    c.GetUserAddress(),
+		Recipient: recipientWallet,
+		Asset:     asset,
+		Rate:      rate,
+		Cap:       streamCap,
+		ExpiresAt: uint64(expiresAt.Unix()),
+		Nonce:     nonce,
+	}
+
+	packed, err := core.PackPaymentStreamAuthorization(auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack payment stream authorization: %w", err)
+	}
+
+	sig, err := c.signPaymentStreamMessage(packed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign payment stream authorization: %w", err)
+	}
+
+	req := rpc.StreamsV1CreateRequest{
+		Authorization: transformPaymentStreamAuthorizationToRPC(auth),
+		SenderSig:     sig,
+	}
+	resp, err := c.rpcClient.StreamsV1Create(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payment stream: %w", err)
+	}
+
+	stream, err := transformPaymentStream(resp.Stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to transform payment stream: %w", err)
+	}
+	return &stream, nil
+}
+
+// CancelPaymentStream stops a payment stream the client's wallet sends or receives.
+// The stream stops accruing immediately; the amount accrued until then is still settled.
+//
+// Parameters:
+//   - streamID: The payment stream ID
+//
+// Returns:
+//   - The cancelled payment stream
+//   - Error if the request fails
+//
+// Example:
+//
+//	stream, err := client.CancelPaymentStream(ctx, streamID)
+func (c *Client) CancelPaymentStream(ctx context.Context, streamID string) (*core.PaymentStream, error) {
+	packed, err := core.PackPaymentStreamCancellation(streamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack payment stream cancellation: %w", err)
+	}
+
+	sig, err := c.signPaymentStreamMessage(packed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign payment stream cancellation: %w", err)
+	}
+
+	req := rpc.StreamsV1CancelRequest{
+		StreamID:  streamID,
+		Signature: sig,
+	}
+	resp, err := c.rpcClient.StreamsV1Cancel(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel payment stream: %w", err)
+	}
+
+	stream, err := transformPaymentStream(resp.Stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to transform payment stream: %w", err)
+	}
+	return &stream, nil
+}
+
+// GetPaymentStream retrieves a payment stream by ID.
+//
+// Parameters:
+//   - streamID: The payment stream ID
+//
+// Returns:
+//   - The payment stream
+//   - Error if the stream is not found or the request fails
+//
+// Example:
+//
+//	stream, err := client.GetPaymentStream(ctx, streamID)
+//	fmt.Printf("Settled %s of %s\n", stream.Settled, stream.Authorization.Cap)
+func (c *Client) GetPaymentStream(ctx context.Context, streamID string) (*core.PaymentStream, error) {
+	resp, err := c.rpcClient.StreamsV1Get(ctx, rpc.StreamsV1GetRequest{StreamID: &streamID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment stream: %w", err)
+	}
+	if len(resp.Streams) == 0 {
+		return nil, fmt.Errorf("payment stream %s not found", streamID)
+	}
+
+	stream, err := transformPaymentStream(resp.Streams[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to transform payment stream: %w", err)
+	}
+	return &stream, nil
+}
+
+// GetPaymentStreams retrieves the payment streams the wallet sends or receives.
+//
+// Parameters:
+//   - wallet: The wallet address
+//
+// Returns:
+//   - Slice of payment streams
+//   - Error if the request fails
+//
+// Example:
+//
+//	streams, err := client.GetPaymentStreams(ctx, client.GetUserAddress())
+//	for _, s := range streams {
+//	    fmt.Printf("%s -> %s: %s\n", s.Authorization.Sender, s.Authorization.Recipient, s.Status)
+//	}
+func (c *Client) GetPaymentStreams(ctx context.Context, wallet string) ([]core.PaymentStream, error) {
+	resp, err := c.rpcClient.StreamsV1Get(ctx, rpc.StreamsV1GetRequest{Wallet: &wallet})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment streams: %w", err)
+	}
+
+	streams := make([]core.PaymentStream, 0, len(resp.Streams))
+	for _, s := range resp.Streams {
+		stream, err := transformPaymentStream(s)
+		if err != nil {
+			return nil, fmt.Errorf("failed to transform payment stream: %w", err)
+		}
+		streams = append(streams, stream)
+	}
+	return streams, nil
+}
+
+// signPaymentStreamMessage signs packed payment stream data with the client's main wallet signer.
+func (c *Client) signPaymentStreamMessage(packed []byte) (string, error) {
+	ethMsgSigner, err := sign.NewEthereumMsgSignerFromRaw(c.rawSigner)
+	if err != nil {
+		return "", fmt.Errorf("failed to create Ethereum message signer: %w", err)
+	}
+
+	sig, err := ethMsgSigner.Sign(packed)
+	if err != nil {
+		return "", err
+	}
+	return sig.String(), nil
+}
+
+// ============================================================================
+// Payment Stream Transformations
+// ============================================================================
+
+// transformPaymentStreamAuthorizationToRPC converts core.PaymentStreamAuthorization to rpc.PaymentStreamAuthorizationV1.
+func transformPaymentStreamAuthorizationToRPC(auth core.PaymentStreamAuthorization) rpc.PaymentStreamAuthorizationV1 {
+	return rpc.PaymentStreamAuthorizationV1{
+		Sender:    auth.Sender,
+		Recipient: auth.Recipient,
+		Asset:     auth.Asset,
+		Rate:      auth.Rate.String(),
+		Cap:       auth.Cap.String(),
+		ExpiresAt: strconv.FormatUint(auth.ExpiresAt, 10),
+		Nonce:     strconv.FormatUint(auth.Nonce, 10),
+	}
+}
+
+// transformPaymentStream converts rpc.PaymentStreamV1 to core.PaymentStream.
+func transformPaymentStream(stream rpc.PaymentStreamV1) (core.PaymentStream, error) {
+	rate, err := decimal.NewFromString(stream.Rate)
+	if err != nil {
+		return core.PaymentStream{}, fmt.Errorf("failed to parse rate: %w", err)
+	}
+	streamCap, err := decimal.NewFromString(stream.Cap)
+	if err != nil {
+		return core.PaymentStream{}, fmt.Errorf("failed to parse cap: %w", err)
+	}
+	settled, err := decimal.NewFromString(stream.Settled)
+	if err != nil {
+		return core.PaymentStream{}, fmt.Errorf("failed to parse settled: %w", err)
+	}
+	expiresAt, err := strconv.ParseUint(stream.ExpiresAt, 10, 64)
+	if err != nil {
+		return core.PaymentStream{}, fmt.Errorf("failed to parse expires_at: %w", err)
+	}
+	nonce, err := strconv.ParseUint(stream.Nonce, 10, 64)
+	if err != nil {
+		return core.PaymentStream{}, fmt.Errorf("failed to parse nonce: %w", err)
+	}
+
+	var status core.PaymentStreamStatus
+	switch stream.Status {
+	case core.PaymentStreamStatusActive.String():
+		status = core.PaymentStreamStatusActive
+	case core.PaymentStreamStatusCancelled.String():
+		status = core.PaymentStreamStatusCancelled
+	case core.PaymentStreamStatusCompleted.String():
+		status = core.PaymentStreamStatusCompleted
+	default:
+		return core.PaymentStream{}, fmt.Errorf("unknown payment stream status: %s", stream.Status)
+	}
+
+	startsAt, err := parseUnixSeconds(stream.StartsAt, "starts_at")
+	if err != nil {
+		return core.PaymentStream{}, err
+	}
+	endsAt, err := parseUnixSeconds(stream.EndsAt, "ends_at")
+	if err != nil {
+		return core.PaymentStream{}, err
+	}
+	settledUntil, err := parseUnixSeconds(stream.SettledUntil, "settled_until")
+	if err != nil {
+		return core.PaymentStream{}, err
+	}
+	createdAt, err := parseUnixSeconds(stream.CreatedAt, "created_at")
+	if err != nil {
+		return core.PaymentStream{}, err
+	}
+	updatedAt, err := parseUnixSeconds(stream.UpdatedAt, "updated_at")
+	if err != nil {
+		return core.PaymentStream{}, err
+	}
+
+	return core.PaymentStream{
+		ID: stream.ID,
+		Authorization: core.PaymentStreamAuthorization{
+			Sender:    stream.Sender,
+			Recipient: stream.Recipient,
+			Asset:     stream.Asset,
+			Rate:      rate,
+			Cap:       streamCap,
+			ExpiresAt: expiresAt,
+			Nonce:     nonce,
+		},
+		Status:       status,
+		StartsAt:     startsAt,
+		EndsAt:       endsAt,
+		Settled:      settled,
+		SettledUntil: settledUntil,
+		CreatedAt:    createdAt,
+		UpdatedAt:    updatedAt,
+	}, nil
+}
+
+// parseUnixSeconds parses a unix timestamp in seconds of the named field.
+func parseUnixSeconds(value, field string) (time.Time, error) {
+	sec, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse %s: %w", field, err)
+	}
+	return time.Unix(sec, 0), nil
+}
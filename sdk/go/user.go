@@ -32,7 +32,11 @@ func (c *Client) GetBalances(ctx context.Context, wallet string) ([]core.Balance
 	req := rpc.UserV1GetBalancesRequest{
 		Wallet: wallet,
 	}
-	resp, err := c.rpcClient.UserV1GetBalances(ctx, req)
+	var resp rpc.UserV1GetBalancesResponse
+	err := c.withRetry(ctx, func() (err error) {
+		resp, err = c.rpcClient.UserV1GetBalances(ctx, req)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get balances: %w", err)
 	}
@@ -34,11 +34,31 @@ func transformNodeConfig(resp rpc.NodeV1GetConfigResponse) (*core.NodeConfig, er
 		})
 	}
 
+	feeSchedule := make([]core.FeeRule, 0, len(resp.FeeSchedule))
+	for _, rule := range resp.FeeSchedule {
+		flat, err := decimal.NewFromString(rule.Flat)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse flat fee: %w", err)
+		}
+		percentage, err := decimal.NewFromString(rule.Percentage)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse fee percentage: %w", err)
+		}
+
+		feeSchedule = append(feeSchedule, core.FeeRule{
+			Asset:      rule.Asset,
+			Action:     core.GatedAction(rule.Action),
+			Flat:       flat,
+			Percentage: percentage,
+		})
+	}
+
 	return &core.NodeConfig{
 		NodeAddress:            resp.NodeAddress,
 		NodeVersion:            resp.NodeVersion,
 		SupportedSigValidators: resp.SupportedSigValidators,
 		Blockchains:            blockchains,
+		FeeSchedule:            feeSchedule,
 	}, nil
 }
 
@@ -194,6 +214,40 @@ func transformTransactions(transactions []rpc.TransactionV1) ([]core.Transaction
 	return result, nil
 }
 
+// transformConditionalTransfer converts RPC ConditionalTransferV1 to core.ConditionalTransfer.
+func transformConditionalTransfer(transfer rpc.ConditionalTransferV1) (core.ConditionalTransfer, error) {
+	amount, err := decimal.NewFromString(transfer.Amount)
+	if err != nil {
+		return core.ConditionalTransfer{}, fmt.Errorf("failed to parse amount: %w", err)
+	}
+	expiresAt, err := strconv.ParseInt(transfer.ExpiresAt, 10, 64)
+	if err != nil {
+		return core.ConditionalTransfer{}, fmt.Errorf("failed to parse expires at: %w", err)
+	}
+
+	var status core.ConditionalTransferStatus
+	switch transfer.Status {
+	case "locked":
+		status = core.ConditionalTransferStatusLocked
+	case "claimed":
+		status = core.ConditionalTransferStatusClaimed
+	case "refunded":
+		status = core.ConditionalTransferStatusRefunded
+	}
+
+	return core.ConditionalTransfer{
+		ID:        transfer.ID,
+		Sender:    transfer.Sender,
+		Receiver:  transfer.Receiver,
+		Asset:     transfer.Asset,
+		Amount:    amount,
+		HashLock:  transfer.HashLock,
+		ExpiresAt: time.Unix(expiresAt, 0),
+		Status:    status,
+		Preimage:  transfer.Preimage,
+	}, nil
+}
+
 // ============================================================================
 // Pagination Transformations
 // ============================================================================
@@ -24,6 +24,9 @@ func TestTransformNodeConfig(t *testing.T) {
 				ChannelHubAddress: "0xHubAddress",
 			},
 		},
+		FeeSchedule: []rpc.FeeRuleV1{
+			{Asset: "usdc", Action: "transfer", Flat: "0.01", Percentage: "0.1"},
+		},
 	}
 
 	config, err := transformNodeConfig(rpcResp)
@@ -35,8 +38,15 @@ func TestTransformNodeConfig(t *testing.T) {
 	assert.Len(t, config.Blockchains, 1)
 	assert.Equal(t, uint64(137), config.Blockchains[0].ID)
 	assert.Equal(t, "Polygon", config.Blockchains[0].Name)
+	require.Len(t, config.FeeSchedule, 1)
+	assert.Equal(t, core.GatedActionTransfer, config.FeeSchedule[0].Action)
+	assert.Equal(t, "0.01", config.FeeSchedule[0].Flat.String())
+
+	// Test error cases
+	rpcResp.FeeSchedule[0].Percentage = "invalid"
+	_, err = transformNodeConfig(rpcResp)
+	assert.Error(t, err)
 
-	// Test error case
 	rpcResp.Blockchains[0].BlockchainID = "invalid"
 	_, err = transformNodeConfig(rpcResp)
 	assert.Error(t, err)
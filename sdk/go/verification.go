@@ -0,0 +1,50 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ============================================================================
+// Response Verification
+// ============================================================================
+
+// ResponseVerificationConfig configures the verification of the Node's signatures on RPC responses.
+type ResponseVerificationConfig struct {
+	// NodeAddress is the address responses must be signed by (required). It must be obtained
+	// from a trusted source: the node address returned by node.v1.get_config is not trusted,
+	// as that response could be forged by whoever the client is talking to.
+	NodeAddress string
+
+	// Strict rejects unsigned responses. Otherwise unsigned responses are accepted,
+	// so the client keeps working with Nodes that do not sign their responses,
+	// while responses with an invalid signature are still rejected.
+	Strict bool
+}
+
+// validate checks that the node address to verify the responses against is configured.
+func (rv ResponseVerificationConfig) validate() error {
+	if rv.NodeAddress == "" {
+		return errors.New("node address is required")
+	}
+	if !common.IsHexAddress(rv.NodeAddress) {
+		return fmt.Errorf("invalid node address: %s", rv.NodeAddress)
+	}
+	return nil
+}
+
+// enableResponseVerification makes the RPC client verify the signatures of all further responses.
+// The Node is pinged once verification is enabled, so a Node that does not sign with the
+// expected key is detected when connecting rather than on the first call.
+func (c *Client) enableResponseVerification(ctx context.Context) error {
+	rv := c.config.ResponseVerification
+
+	c.rpcClient.VerifyResponses(rv.NodeAddress, rv.Strict)
+	if err := c.rpcClient.NodeV1Ping(ctx); err != nil {
+		return fmt.Errorf("failed to verify node signature: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/rpc"
+	"github.com/layer-3/nitrolite/pkg/sign"
+)
+
+func newTestNodeSigner(t *testing.T) sign.Signer {
+	t.Helper()
+
+	pk, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer, err := sign.NewEthereumMsgSigner(hexutil.Encode(crypto.FromECDSA(pk)))
+	require.NoError(t, err)
+	return signer
+}
+
+func TestClient_ResponseVerification(t *testing.T) {
+	t.Parallel()
+
+	nodeSigner := newTestNodeSigner(t)
+	nodeAddress := nodeSigner.PublicKey().Address().String()
+	otherSigner := newTestNodeSigner(t)
+
+	tcs := []struct {
+		name        string
+		nodeSigner  sign.Signer
+		config      ResponseVerificationConfig
+		expectedErr error
+	}{
+		{
+			name:       "pinned node address",
+			nodeSigner: nodeSigner,
+			config:     ResponseVerificationConfig{NodeAddress: nodeAddress, Strict: true},
+		},
+		{
+			name:        "signed by another key",
+			nodeSigner:  otherSigner,
+			config:      ResponseVerificationConfig{NodeAddress: nodeAddress},
+			expectedErr: rpc.ErrInvalidSignature,
+		},
+		{
+			name:   "unsigned, non-strict",
+			config: ResponseVerificationConfig{NodeAddress: nodeAddress},
+		},
+		{
+			name:        "unsigned, strict",
+			config:      ResponseVerificationConfig{NodeAddress: nodeAddress, Strict: true},
+			expectedErr: rpc.ErrUnsignedMessage,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDialer := NewMockDialer()
+			mockDialer.RegisterResponse(rpc.NodeV1GetConfigMethod.String(), rpc.NodeV1GetConfigResponse{NodeAddress: nodeAddress})
+			mockDialer.RegisterResponse(rpc.NodeV1PingMethod.String(), rpc.NodeV1PingResponse{})
+			mockDialer.SignResponses(tc.nodeSigner)
+
+			client := newReconnectTestClient(t, mockDialer, Config{ResponseVerification: &tc.config})
+			err := client.enableResponseVerification(context.Background())
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Zero(t, mockDialer.CallCount(rpc.NodeV1GetConfigMethod.String()), "the node address is not taken from the node")
+
+			// Further calls are verified as well
+			mockDialer.SignResponses(otherSigner)
+			_, err = client.GetConfig(context.Background())
+			assert.ErrorIs(t, err, rpc.ErrInvalidSignature)
+		})
+	}
+}
+
+func TestNewClient_ResponseVerificationRequiresNodeAddress(t *testing.T) {
+	t.Parallel()
+
+	for _, rv := range []ResponseVerificationConfig{{Strict: true}, {NodeAddress: "0xNode"}} {
+		_, err := NewClient("ws://127.0.0.1:0", nil, nil, WithResponseVerification(rv))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid response verification config")
+	}
+}
+
+func TestClient_EventVerification(t *testing.T) {
+	t.Parallel()
+
+	nodeSigner := newTestNodeSigner(t)
+	nodeAddress := nodeSigner.PublicKey().Address().String()
+
+	mockDialer := NewMockDialer()
+	mockDialer.RegisterResponse(rpc.NodeV1PingMethod.String(), rpc.NodeV1PingResponse{})
+	mockDialer.SignResponses(nodeSigner)
+
+	channelUpdates := make(chan core.Channel, 1)
+	errs := make(chan error, 1)
+	client := newReconnectTestClient(t, mockDialer, Config{
+		ResponseVerification:  &ResponseVerificationConfig{NodeAddress: nodeAddress, Strict: true},
+		ChannelUpdatedHandler: func(ch core.Channel) { channelUpdates <- ch },
+		ErrorHandler:          func(err error) { errs <- err },
+	})
+	require.NoError(t, client.enableResponseVerification(context.Background()))
+
+	pushEvent := func(signer sign.Signer) {
+		payload, err := rpc.NewPayload(rpc.ChannelsV1ChannelUpdatedEvent{
+			Channel: rpc.ChannelV1{ChannelID: "0xChannelID", Type: "home", BlockchainID: "1", StateVersion: "1", Nonce: "1"},
+		})
+		require.NoError(t, err)
+		event := rpc.NewEvent(0, rpc.ChannelsV1ChannelUpdatedEventName.String(), payload)
+		require.NoError(t, event.Sign(signer, nil))
+		mockDialer.eventCh <- &event
+	}
+
+	// Events signed by another key are dropped
+	pushEvent(newTestNodeSigner(t))
+	select {
+	case err := <-errs:
+		assert.ErrorIs(t, err, rpc.ErrInvalidSignature)
+	case <-channelUpdates:
+		t.Fatal("event signed by another key was delivered")
+	case <-time.After(time.Second):
+		t.Fatal("invalid event was not reported")
+	}
+
+	pushEvent(nodeSigner)
+	select {
+	case ch := <-channelUpdates:
+		assert.Equal(t, "0xChannelID", ch.ChannelID)
+	case err := <-errs:
+		t.Fatalf("event signed by the node was dropped: %v", err)
+	case <-time.After(time.Second):
+		t.Fatal("event signed by the node was not delivered")
+	}
+}
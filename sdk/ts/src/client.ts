@@ -778,6 +778,11 @@ export class Client {
       case core.TransitionType.HomeWithdrawal:
       case core.TransitionType.TransferSend:
       case core.TransitionType.TransferReceive:
+      case core.TransitionType.SwapSend:
+      case core.TransitionType.SwapReceive:
+      case core.TransitionType.ConditionalLock:
+      case core.TransitionType.ConditionalClaim:
+      case core.TransitionType.ConditionalRefund:
       case core.TransitionType.Commit:
       case core.TransitionType.Release:  
       {
@@ -1963,8 +1968,13 @@ export class Client {
       [core.TransitionType.EscrowWithdraw]: 'escrow_withdraw',
       [core.TransitionType.TransferSend]: 'transfer_send',
       [core.TransitionType.TransferReceive]: 'transfer_receive',
+      [core.TransitionType.SwapSend]: 'swap_send',
+      [core.TransitionType.SwapReceive]: 'swap_receive',
       [core.TransitionType.Commit]: 'commit',
       [core.TransitionType.Release]: 'release',
+      [core.TransitionType.ConditionalLock]: 'conditional_lock',
+      [core.TransitionType.ConditionalClaim]: 'conditional_claim',
+      [core.TransitionType.ConditionalRefund]: 'conditional_refund',
       [core.TransitionType.Migrate]: 'migrate',
       [core.TransitionType.EscrowLock]: 'escrow_lock',
       [core.TransitionType.MutualLock]: 'mutual_lock',
@@ -16,7 +16,7 @@ import { getHomeChannelId, getEscrowChannelId, getStateId, getSenderTransactionI
 
 /**
  * GetLastTransition returns the transition, or null if the state has a void transition
- * or if the transition is a receive type (TransferReceive or Release)
+ * or if the transition is a receive type (TransferReceive, SwapReceive, Release, ConditionalClaim or ConditionalRefund)
  * @param state - The state to query
  * @returns The transition or null
  */
@@ -27,7 +27,10 @@ export function getLastTransition(state: State): Transition | null {
 
   if (
     state.transition.type === TransitionType.TransferReceive ||
-    state.transition.type === TransitionType.Release
+    state.transition.type === TransitionType.SwapReceive ||
+    state.transition.type === TransitionType.Release ||
+    state.transition.type === TransitionType.ConditionalClaim ||
+    state.transition.type === TransitionType.ConditionalRefund
   ) {
     return null;
   }
@@ -403,8 +406,9 @@ export function applyEscrowDepositTransition(state: State, amount: Decimal): Tra
   const newTransitionObj = newTransition(TransitionType.EscrowDeposit, txId, accountId, amount);
   state.transition = newTransitionObj;
 
+  // The funds the node locked in the home channel for the mutual lock are credited to the user
   state.homeLedger.userBalance = state.homeLedger.userBalance.add(newTransitionObj.amount);
-  state.homeLedger.nodeNetFlow = state.homeLedger.nodeNetFlow.add(newTransitionObj.amount);
+  state.homeLedger.nodeBalance = state.homeLedger.nodeBalance.sub(newTransitionObj.amount);
 
   state.escrowLedger.userBalance = state.escrowLedger.userBalance.sub(newTransitionObj.amount);
   state.escrowLedger.nodeNetFlow = state.escrowLedger.nodeNetFlow.sub(newTransitionObj.amount);
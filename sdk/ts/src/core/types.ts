@@ -36,8 +36,13 @@ export enum TransitionType {
   EscrowWithdraw = 21,
   TransferSend = 30,
   TransferReceive = 31,
+  SwapSend = 32,
+  SwapReceive = 33,
   Commit = 40,
   Release = 41,
+  ConditionalLock = 60,
+  ConditionalClaim = 61,
+  ConditionalRefund = 62,
   Migrate = 100,
   EscrowLock = 110,
   MutualLock = 120,
@@ -50,9 +55,14 @@ export enum TransactionType {
   EscrowDeposit = 20,
   EscrowWithdraw = 21,
   Transfer = 30,
+  Swap = 31,
   Commit = 40,
   Release = 41,
   Rebalance = 42,
+  Fee = 50,
+  ConditionalLock = 60,
+  ConditionalClaim = 61,
+  ConditionalRefund = 62,
   Migrate = 100,
   EscrowLock = 110,
   MutualLock = 120,
@@ -237,11 +247,19 @@ export interface PaginationMetadata {
 // Node Configuration
 // ============================================================================
 
+export interface FeeRule {
+  asset: string;
+  action: string;
+  flat: Decimal;
+  percentage: Decimal;
+}
+
 export interface NodeConfig {
   nodeAddress: Address;
   nodeVersion: string;
   supportedSigValidators: number[];
   blockchains: Blockchain[];
+  feeSchedule: FeeRule[];
 }
 
 // ============================================================================
@@ -387,10 +405,20 @@ export function transitionToString(type: TransitionType): string {
       return 'TransferSend';
     case TransitionType.TransferReceive:
       return 'TransferReceive';
+    case TransitionType.SwapSend:
+      return 'SwapSend';
+    case TransitionType.SwapReceive:
+      return 'SwapReceive';
     case TransitionType.Commit:
       return 'Commit';
     case TransitionType.Release:
       return 'Release';
+    case TransitionType.ConditionalLock:
+      return 'ConditionalLock';
+    case TransitionType.ConditionalClaim:
+      return 'ConditionalClaim';
+    case TransitionType.ConditionalRefund:
+      return 'ConditionalRefund';
     case TransitionType.Migrate:
       return 'Migrate';
     case TransitionType.EscrowLock:
@@ -405,7 +433,13 @@ export function transitionToString(type: TransitionType): string {
 }
 
 export function transitionRequiresOpenChannel(type: TransitionType): boolean {
-  return type !== TransitionType.TransferReceive && type !== TransitionType.Release;
+  return (
+    type !== TransitionType.TransferReceive &&
+    type !== TransitionType.SwapReceive &&
+    type !== TransitionType.ConditionalClaim &&
+    type !== TransitionType.ConditionalRefund &&
+    type !== TransitionType.Release
+  );
 }
 
 export function transitionsEqual(a: Transition, b: Transition): string | null {
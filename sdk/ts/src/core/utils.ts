@@ -39,8 +39,13 @@ export function transitionToIntent(transition: Transition): number {
     case TransitionType.Void:
     case TransitionType.TransferSend:
     case TransitionType.TransferReceive:
+    case TransitionType.SwapSend:
+    case TransitionType.SwapReceive:
     case TransitionType.Commit:
     case TransitionType.Release:
+    case TransitionType.ConditionalLock:
+    case TransitionType.ConditionalClaim:
+    case TransitionType.ConditionalRefund:
       return INTENT_OPERATE;
     case TransitionType.Finalize:
       return INTENT_CLOSE;
@@ -16,6 +16,7 @@ import {
   PaginationMetadataV1,
   AssetV1,
   BlockchainInfoV1,
+  FeeRuleV1,
   AppV1,
   AppInfoV1,
   ActionAllowanceV1,
@@ -384,6 +385,8 @@ export interface NodeV1GetConfigResponse {
   supported_sig_validators: number[];
   /** List of supported networks */
   blockchains: BlockchainInfoV1[];
+  /** List of fees charged by the node */
+  fee_schedule?: FeeRuleV1[];
 }
 
 export interface NodeV1GetAssetsRequest {
@@ -51,6 +51,12 @@ export interface Message {
    * This is used for replay protection and request expiration checks.
    */
   timestamp: number; // uint64 (milliseconds)
+
+  /**
+   * Signature is the hex-encoded Node signature over the message without its signature,
+   * followed by the hash of the request for responses. Present only when the Node signs its responses.
+   */
+  signature?: string;
 }
 
 /**
@@ -183,11 +189,11 @@ export function marshalMessage(message: Message): string {
  */
 export function unmarshalMessage(data: string): Message {
   const arr = JSON.parse(data);
-  if (!Array.isArray(arr) || arr.length !== 5) {
-    throw new Error('invalid RPCData: expected 5 elements in array');
+  if (!Array.isArray(arr) || (arr.length !== 5 && arr.length !== 6)) {
+    throw new Error('invalid RPCData: expected 5 or 6 elements in array');
   }
 
-  const [type, requestId, method, payload, timestamp] = arr;
+  const [type, requestId, method, payload, timestamp, signature] = arr;
 
   if (typeof type !== 'number') {
     throw new Error('invalid type: expected number');
@@ -204,6 +210,9 @@ export function unmarshalMessage(data: string): Message {
   if (typeof timestamp !== 'number') {
     throw new Error('invalid timestamp: expected number');
   }
+  if (signature !== undefined && typeof signature !== 'string') {
+    throw new Error('invalid signature: expected string');
+  }
 
   return {
     type: type as MsgType,
@@ -211,5 +220,6 @@ export function unmarshalMessage(data: string): Message {
     method,
     payload: payload as Payload,
     timestamp,
+    ...(signature !== undefined && { signature }),
   };
 }
@@ -227,6 +227,20 @@ export interface BlockchainInfoV1 {
   locking_contract_address?: Address;
 }
 
+/**
+ * FeeRuleV1 represents a fee charged by the node on an operation with an asset
+ */
+export interface FeeRuleV1 {
+  /** Asset symbol */
+  asset: string;
+  /** Charged operation (transfer, app_session_deposit, app_session_withdrawal) */
+  action: string;
+  /** Flat fee amount */
+  flat: string; // decimal as string
+  /** Fee percentage of the operation amount */
+  percentage: string; // decimal as string
+}
+
 // ============================================================================
 // Balance and Transaction Types
 // ============================================================================
@@ -45,11 +45,19 @@ export function transformNodeConfig(resp: API.NodeV1GetConfigResponse): core.Nod
     blockStep: 0n, // Not provided in RPC response
   }));
 
+  const feeSchedule: core.FeeRule[] = (resp.fee_schedule ?? []).map((rule) => ({
+    asset: rule.asset,
+    action: rule.action,
+    flat: new Decimal(rule.flat),
+    percentage: new Decimal(rule.percentage),
+  }));
+
   return {
     nodeAddress: resp.node_address as Address,
     nodeVersion: resp.node_version,
     supportedSigValidators: decodeSigValidators(resp.supported_sig_validators),
     blockchains,
+    feeSchedule,
   };
 }
 
@@ -0,0 +1,35 @@
+import Decimal from 'decimal.js';
+import { Address } from 'viem';
+import { applyEscrowDepositTransition } from '../../../src/core/state';
+import { TransitionType, newVoidState } from '../../../src/core/types';
+
+describe('applyEscrowDepositTransition', () => {
+  test('credits the node funds locked in the home channel to the user', () => {
+    const state = newVoidState('USDC', '0x1111111111111111111111111111111111111111' as Address);
+    state.id = '0x3e9dd25a843e3a234c278c6f3fab3983949e2404b276cacb3c47ada06e00f74b';
+    state.escrowChannelId = '0x7a1cdb9f9c6e8b0fbb5c9e2a0d7ad4e5a2e0f4b5c3d8e1f2a3b4c5d6e7f8a9b0';
+    state.escrowLedger = {
+      blockchainId: 0n,
+      tokenAddress: '0x0' as Address,
+      userBalance: new Decimal(100),
+      userNetFlow: new Decimal(0),
+      nodeBalance: new Decimal(0),
+      nodeNetFlow: new Decimal(0),
+    };
+    state.homeLedger.nodeBalance = new Decimal(10);
+    state.homeLedger.nodeNetFlow = new Decimal(10);
+
+    const transition = applyEscrowDepositTransition(state, new Decimal(10));
+
+    expect(transition.type).toBe(TransitionType.EscrowDeposit);
+    expect(state.homeLedger.userBalance.toString()).toBe('10');
+    expect(state.homeLedger.nodeBalance.toString()).toBe('0');
+    expect(state.homeLedger.nodeNetFlow.toString()).toBe('10');
+    expect(state.escrowLedger?.userBalance.toString()).toBe('90');
+  });
+
+  test('requires an escrow channel', () => {
+    const state = newVoidState('USDC', '0x1111111111111111111111111111111111111111' as Address);
+    expect(() => applyEscrowDepositTransition(state, new Decimal(10))).toThrow('escrow channel ID is nil');
+  });
+});
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/layer-3/nitrolite/pkg/core"
+)
+
+// assetStore resolves token decimals from the assets supported by the node.
+type assetStore struct {
+	assets map[string]core.Asset
+}
+
+func newAssetStore(assets []core.Asset) *assetStore {
+	s := &assetStore{assets: make(map[string]core.Asset, len(assets))}
+	for _, a := range assets {
+		s.assets[strings.ToLower(a.Symbol)] = a
+	}
+	return s
+}
+
+func (s *assetStore) GetAssetDecimals(asset string) (uint8, error) {
+	a, ok := s.assets[strings.ToLower(asset)]
+	if !ok {
+		return 0, fmt.Errorf("asset %s not found", asset)
+	}
+	return a.Decimals, nil
+}
+
+func (s *assetStore) GetTokenDecimals(blockchainID uint64, tokenAddress string) (uint8, error) {
+	for _, a := range s.assets {
+		for _, token := range a.Tokens {
+			if token.BlockchainID == blockchainID && strings.EqualFold(token.Address, tokenAddress) {
+				return token.Decimals, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("token %s on blockchain %d not found", tokenAddress, blockchainID)
+}
+
+func (s *assetStore) GetTokenAddress(asset string, blockchainID uint64) (string, error) {
+	a, ok := s.assets[strings.ToLower(asset)]
+	if !ok {
+		return "", fmt.Errorf("asset %s not found", asset)
+	}
+	for _, token := range a.Tokens {
+		if token.BlockchainID == blockchainID {
+			return token.Address, nil
+		}
+	}
+	return "", fmt.Errorf("asset %s not available on blockchain %d", asset, blockchainID)
+}
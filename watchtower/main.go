@@ -0,0 +1,255 @@
+package main
+
+import (
+	"context"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/joho/godotenv"
+
+	"github.com/layer-3/nitrolite/pkg/blockchain/evm"
+	"github.com/layer-3/nitrolite/pkg/core"
+	"github.com/layer-3/nitrolite/pkg/log"
+	"github.com/layer-3/nitrolite/pkg/sign"
+	"github.com/layer-3/nitrolite/pkg/watchtower"
+	sdk "github.com/layer-3/nitrolite/sdk/go"
+	"github.com/layer-3/nitrolite/sdk/go/store/sqlite"
+)
+
+type Config struct {
+	ClearnodeURL      string        `env:"WATCHTOWER_CLEARNODE_URL" env-required:"true"`
+	BlockchainID      uint64        `env:"WATCHTOWER_BLOCKCHAIN_ID" env-required:"true"`
+	BlockchainRPCs    []string      `env:"WATCHTOWER_BLOCKCHAIN_RPC" env-required:"true" env-separator:","`
+	BlockStep         uint64        `env:"WATCHTOWER_BLOCK_STEP" env-default:"10000"`
+	Confirmations     uint64        `env:"WATCHTOWER_CONFIRMATIONS" env-default:"0"`
+	EventPollInterval time.Duration `env:"WATCHTOWER_EVENT_POLL_INTERVAL" env-default:"0s"`
+	PrivateKey        string        `env:"WATCHTOWER_PRIVATE_KEY" env-required:"true"` // pays for challenge responses
+	Wallets           []string      `env:"WATCHTOWER_WALLETS" env-required:"true" env-separator:","`
+	StateSyncInterval time.Duration `env:"WATCHTOWER_STATE_SYNC_INTERVAL" env-default:"30s"`
+	StateArchives     []string      `env:"WATCHTOWER_STATE_ARCHIVES" env-separator:","` // SDK SQLite state archives of the users
+	DataFile          string        `env:"WATCHTOWER_DATA_FILE" env-default:"watchtower.json"`
+	RetryInterval     time.Duration `env:"WATCHTOWER_RETRY_INTERVAL" env-default:"15s"`
+}
+
+func main() {
+	var loggerConf log.Config
+	if err := cleanenv.ReadEnv(&loggerConf); err != nil {
+		panic("failed to read logger config from env: " + err.Error())
+	}
+	logger := log.NewZapLogger(loggerConf).WithName("main")
+
+	if err := godotenv.Load(); err != nil {
+		logger.Warn(".env file not found")
+	}
+
+	var conf Config
+	if err := cleanenv.ReadEnv(&conf); err != nil {
+		logger.Fatal("failed to read env", "err", err)
+	}
+
+	// The watchtower only reads states from the node, but the SDK client needs a signer
+	txSigner, err := sign.NewEthereumRawSigner(conf.PrivateKey)
+	if err != nil {
+		logger.Fatal("failed to create transaction signer", "error", err)
+	}
+	msgSigner, err := sign.NewEthereumMsgSigner(conf.PrivateKey)
+	if err != nil {
+		logger.Fatal("failed to create message signer", "error", err)
+	}
+	stateSigner, err := core.NewChannelDefaultSigner(msgSigner)
+	if err != nil {
+		logger.Fatal("failed to create state signer", "error", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	nodeClient, err := sdk.NewClient(conf.ClearnodeURL, stateSigner, txSigner,
+		sdk.WithReconnect(sdk.DefaultReconnectConfig),
+		sdk.WithErrorHandler(func(err error) {
+			logger.Warn("clearnode connection error", "error", err)
+		}),
+	)
+	if err != nil {
+		logger.Fatal("failed to connect to clearnode", "error", err)
+	}
+	defer nodeClient.Close()
+
+	nodeConfig, err := nodeClient.GetConfig(ctx)
+	if err != nil {
+		logger.Fatal("failed to get node config", "error", err)
+	}
+	channelHubAddress := ""
+	for _, b := range nodeConfig.Blockchains {
+		if b.ID == conf.BlockchainID {
+			channelHubAddress = b.ChannelHubAddress
+		}
+	}
+	if channelHubAddress == "" {
+		logger.Fatal("channel hub is not deployed on blockchain", "blockchainID", conf.BlockchainID)
+	}
+
+	assets, err := nodeClient.GetAssets(ctx, nil)
+	if err != nil {
+		logger.Fatal("failed to get assets", "error", err)
+	}
+
+	ethClient, err := evm.DialMultiClient(ctx, conf.BlockchainRPCs, evm.DefaultMultiClientConfig(), logger)
+	if err != nil {
+		logger.Fatal("failed to connect to EVM Node", "error", err)
+	}
+	defer ethClient.Close()
+	go ethClient.Start(ctx)
+
+	// Challenge responses only submit already signed states, so balance and allowance checks are not needed
+	blockchainClient, err := evm.NewBlockchainClient(
+		common.HexToAddress(channelHubAddress),
+		ethClient,
+		txSigner,
+		conf.BlockchainID,
+		nodeConfig.NodeAddress,
+		newAssetStore(assets),
+		evm.ClientBalanceCheck{RequireBalanceCheck: false},
+		evm.ClientAllowanceCheck{RequireAllowanceCheck: false},
+	)
+	if err != nil {
+		logger.Fatal("failed to create blockchain client", "error", err)
+	}
+
+	// States and processed events are persisted, so that challenges raised while offline are answered on restart
+	store, err := watchtower.NewFileStateStore(conf.DataFile)
+	if err != nil {
+		logger.Fatal("failed to open state store", "error", err, "path", conf.DataFile)
+	}
+
+	var archives []sdk.StateStore
+	for _, path := range conf.StateArchives {
+		archive, err := sqlite.NewStateStore(strings.TrimSpace(path))
+		if err != nil {
+			logger.Fatal("failed to open state archive", "error", err, "path", path)
+		}
+		defer archive.Close()
+		archives = append(archives, archive)
+	}
+
+	statePacker := core.NewStatePackerV1(newAssetStore(assets))
+	wt := watchtower.NewWatchtower(conf.BlockchainID, blockchainClient, store, statePacker, nodeConfig.NodeAddress, logger)
+	wt.SetRetryInterval(conf.RetryInterval)
+	for _, wallet := range conf.Wallets {
+		wt.AddWallet(strings.TrimSpace(wallet))
+	}
+
+	syncer := &stateSync{
+		nodeClient: nodeClient,
+		archives:   archives,
+		assets:     assets,
+		wt:         wt,
+		wallets:    conf.Wallets,
+		logger:     logger,
+	}
+	// Load the latest states before listening, so challenges raised while offline can be answered
+	syncer.sync(ctx)
+	go syncer.run(ctx, conf.StateSyncInterval)
+	go wt.Start(ctx)
+
+	reactor := evm.NewChannelHubReactor(conf.BlockchainID, wt, store.StoreContractEvent)
+	listener := evm.NewListener(common.HexToAddress(channelHubAddress), ethClient, conf.BlockchainID, conf.BlockStep, logger, reactor.HandleEvent, store.GetLatestEvent)
+	listener.SetRollbackEvents(store.RollbackContractEvents)
+	listener.SetConfirmations(conf.Confirmations)
+	listener.SetPollInterval(conf.EventPollInterval)
+	listener.Listen(ctx, func(err error) {
+		if err != nil {
+			logger.Fatal("blockchain listener stopped", "error", err, "blockchainID", conf.BlockchainID)
+		}
+	})
+
+	logger.Info("watchtower started", "blockchainID", conf.BlockchainID, "channelHub", channelHubAddress, "wallets", len(conf.Wallets))
+
+	select {
+	case <-ctx.Done():
+	case <-nodeClient.WaitCh():
+		logger.Error("clearnode connection closed")
+	}
+
+	logger.Info("shutting down")
+}
+
+// stateSync feeds the watchtower with the latest co-signed states of the watched wallets,
+// fetched from the node and read from the state archives of the users.
+type stateSync struct {
+	nodeClient *sdk.Client
+	archives   []sdk.StateStore
+	assets     []core.Asset
+	wt         *watchtower.Watchtower
+	wallets    []string
+	logger     log.Logger
+}
+
+// run periodically refreshes the states of the watched wallets until the context is cancelled.
+func (s *stateSync) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.sync(ctx)
+		}
+	}
+}
+
+// sync hands the latest states of the watched wallets to the watchtower.
+// The watchtower keeps the newest of the states it receives for each channel.
+func (s *stateSync) sync(ctx context.Context) {
+	for _, wallet := range s.wallets {
+		wallet = strings.TrimSpace(wallet)
+		s.syncFromNode(ctx, wallet)
+		s.syncFromArchives(wallet)
+	}
+}
+
+// syncFromNode fetches the latest co-signed state of every asset of the wallet from the node.
+func (s *stateSync) syncFromNode(ctx context.Context, wallet string) {
+	balances, err := s.nodeClient.GetBalances(ctx, wallet)
+	if err != nil {
+		s.logger.Warn("failed to get balances", "error", err, "wallet", wallet)
+		return
+	}
+
+	for _, balance := range balances {
+		state, err := s.nodeClient.GetLatestState(ctx, wallet, balance.Asset, true)
+		if err != nil {
+			s.logger.Warn("failed to get latest state", "error", err, "wallet", wallet, "asset", balance.Asset)
+			continue
+		}
+		if err := s.wt.Watch(*state); err != nil {
+			s.logger.Warn("failed to watch state", "error", err, "wallet", wallet, "asset", balance.Asset)
+		}
+	}
+}
+
+// syncFromArchives reads the latest state of every asset of the wallet recorded by the user,
+// so that states the node withholds can still be responded with.
+func (s *stateSync) syncFromArchives(wallet string) {
+	for _, archive := range s.archives {
+		for _, asset := range s.assets {
+			state, err := archive.GetLatestState(wallet, asset.Symbol)
+			if err != nil {
+				s.logger.Warn("failed to read archived state", "error", err, "wallet", wallet, "asset", asset.Symbol)
+				continue
+			}
+			if state == nil {
+				continue
+			}
+			if err := s.wt.Watch(*state); err != nil {
+				s.logger.Warn("failed to watch archived state", "error", err, "wallet", wallet, "asset", asset.Symbol)
+			}
+		}
+	}
+}